@@ -34,6 +34,7 @@ import (
 	cPebble "github.com/matrixorigin/matrixcube/storage/kv/pebble"
 	"github.com/matrixorigin/matrixcube/vfs"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -48,6 +49,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	aoeEngine "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/engine"
 	aoeStorage "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	taeDB "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	tpeEngine "github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -71,6 +74,7 @@ const (
 	WaitCubeStartExit       = 11
 	StartMOExit             = 12
 	CreateTpeExit           = 13
+	CreateTaeExit           = 14
 )
 
 var (
@@ -153,6 +157,11 @@ func main() {
 		os.Exit(0)
 	}
 
+	// restore a TAE db from a backup without starting the server
+	if isRestoreCommand(os.Args) {
+		os.Exit(runRestoreCommand(os.Args))
+	}
+
 	flag.Parse()
 	args := flag.Args()
 
@@ -178,6 +187,11 @@ func main() {
 		os.Exit(LoadConfigExit)
 	}
 
+	if err := compress.SetZstdLevel(int(config.GlobalSystemVariables.GetZstdCompressionLevel())); err != nil {
+		logutil.Infof("Load config error:%v\n", err)
+		os.Exit(LoadConfigExit)
+	}
+
 	if *cpuProfilePathFlag != "" {
 		stop := startCPUProfile()
 		defer stop()
@@ -290,6 +304,20 @@ func main() {
 			os.Exit(CreateTpeExit)
 		}
 		eng = te
+	} else if config.GlobalSystemVariables.GetEnableTae() {
+		tae, err := taeDB.Open(config.GlobalSystemVariables.GetTaeDir(), nil)
+		if err != nil {
+			logutil.Infof("open tae error:%v\n", err)
+			os.Exit(CreateTaeExit)
+		}
+		// Close flushes the blocks and checkpoints the catalog which are
+		// replayed by the next Open
+		defer func() {
+			if err := tae.Close(); err != nil {
+				logutil.Errorf("close tae error:%v", err)
+			}
+		}()
+		eng = moengine.NewTAEEngine(tae)
 	} else {
 		eng = aoeEngine.New(c, &cngineConfig)
 	}
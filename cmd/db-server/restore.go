@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	taeDB "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+const (
+	RestoreCmd = "restore"
+)
+
+const (
+	RestoreExit = 21
+)
+
+func isRestoreCommand(args []string) bool {
+	return len(args) > 1 && args[1] == RestoreCmd
+}
+
+// runRestoreCommand restores a TAE db from a backup taken by the
+// "BACKUP TO '<target>'" statement of a running server:
+//
+//	db-server restore -backup <backup dir|backup.tar.gz> -dir <db dir> [-ts <ts>]
+func runRestoreCommand(args []string) int {
+	fs := flag.NewFlagSet(RestoreCmd, flag.ExitOnError)
+	dir := fs.String("dir", "", "the directory of the restored TAE db")
+	backup := fs.String("backup", "", "the backup directory or tarball to restore from")
+	ts := fs.Uint64("ts", 0, "the commit timestamp to restore to, 0 means the timestamp of the backup")
+	fs.Parse(args[2:])
+
+	if *dir == "" || *backup == "" {
+		fs.Usage()
+		return RestoreExit
+	}
+	db, err := taeDB.Restore(*backup, *dir, *ts, nil)
+	if err != nil {
+		fmt.Printf("restore %s failed. error: %v\n", *backup, err)
+		return RestoreExit
+	}
+	if err = db.Close(); err != nil {
+		fmt.Printf("close %s failed. error: %v\n", *dir, err)
+		return RestoreExit
+	}
+	fmt.Printf("restore %s to %s\n", *backup, *dir)
+	return NormalExit
+}
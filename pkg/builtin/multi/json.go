@@ -0,0 +1,123 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// jsonArgTypes are the types of the first argument of json_array and
+// json_object, T_any is for the calls without arguments.
+var jsonArgTypes = []types.T{
+	types.T_any,
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64,
+	types.T_char, types.T_varchar, types.T_json,
+}
+
+// jsonValues returns the json values of the rows of vec, a string is a
+// json string and a null is the json null.
+func jsonValues(vec *vector.Vector) ([]bytejson.ByteJson, error) {
+	n := vector.Length(vec)
+	rs := make([]bytejson.ByteJson, n)
+	for i := 0; i < n; i++ {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			rs[i] = bytejson.Null
+			continue
+		}
+		switch vec.Typ.Oid {
+		case types.T_int8:
+			rs[i] = bytejson.NewInt64(int64(vec.Col.([]int8)[i]))
+		case types.T_int16:
+			rs[i] = bytejson.NewInt64(int64(vec.Col.([]int16)[i]))
+		case types.T_int32:
+			rs[i] = bytejson.NewInt64(int64(vec.Col.([]int32)[i]))
+		case types.T_int64:
+			rs[i] = bytejson.NewInt64(vec.Col.([]int64)[i])
+		case types.T_uint8:
+			rs[i] = bytejson.NewUint64(uint64(vec.Col.([]uint8)[i]))
+		case types.T_uint16:
+			rs[i] = bytejson.NewUint64(uint64(vec.Col.([]uint16)[i]))
+		case types.T_uint32:
+			rs[i] = bytejson.NewUint64(uint64(vec.Col.([]uint32)[i]))
+		case types.T_uint64:
+			rs[i] = bytejson.NewUint64(vec.Col.([]uint64)[i])
+		case types.T_float32:
+			rs[i] = bytejson.NewFloat64(float64(vec.Col.([]float32)[i]))
+		case types.T_float64:
+			rs[i] = bytejson.NewFloat64(vec.Col.([]float64)[i])
+		case types.T_char, types.T_varchar:
+			rs[i] = bytejson.NewString(string(vec.Col.(*types.Bytes).Get(int64(i))))
+		case types.T_json:
+			bj, err := bytejson.Unmarshal(vec.Col.(*types.Bytes).Get(int64(i)))
+			if err != nil {
+				return nil, err
+			}
+			rs[i] = bj
+		default:
+			return nil, fmt.Errorf("json value not yet implemented for %s", vec.Typ)
+		}
+	}
+	return rs, nil
+}
+
+// jsonDocument returns the json document of the constant vec which is a
+// json value or a json text
+func jsonDocument(vec *vector.Vector, c bool) (bytejson.ByteJson, error) {
+	if !c || vector.Length(vec) != 1 || nulls.Contains(vec.Nsp, 0) {
+		return bytejson.ByteJson{}, fmt.Errorf("the json document must be a constant")
+	}
+	switch vec.Typ.Oid {
+	case types.T_json:
+		return bytejson.Unmarshal(vec.Col.(*types.Bytes).Get(0))
+	case types.T_char, types.T_varchar:
+		return bytejson.ParseFromByteSlice(vec.Col.(*types.Bytes).Get(0))
+	}
+	return bytejson.ByteJson{}, fmt.Errorf("the json document must be a json or a string, not %s", vec.Typ)
+}
+
+// jsonPaths returns the json paths of the constant string vectors
+func jsonPaths(vecs []*vector.Vector, cs []bool) ([]*bytejson.Path, error) {
+	ps := make([]*bytejson.Path, len(vecs))
+	for i, vec := range vecs {
+		if !cs[i] || (vec.Typ.Oid != types.T_char && vec.Typ.Oid != types.T_varchar) || nulls.Contains(vec.Nsp, 0) {
+			return nil, fmt.Errorf("the json path must be a string constant")
+		}
+		p, err := bytejson.ParsePath(string(vec.Col.(*types.Bytes).Get(0)))
+		if err != nil {
+			return nil, err
+		}
+		ps[i] = p
+	}
+	return ps, nil
+}
+
+// jsonRows returns the number of rows of the arguments, which is one if
+// all of them are constants.
+func jsonRows(vecs []*vector.Vector) int {
+	rows := 1
+	for _, vec := range vecs {
+		if n := vector.Length(vec); n > rows {
+			rows = n
+		}
+	}
+	return rows
+}
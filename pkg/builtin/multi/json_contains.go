@@ -0,0 +1,95 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/jsoncontains"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// json_contains(target, candidate[, path]) returns 1 if the candidate is
+// contained in the target, or in the value of the target at the path.
+func init() {
+	extend.FunctionRegistry["json_contains"] = builtin.JsonContains
+	overload.OpTypes[builtin.JsonContains] = overload.Multi
+	extend.MultiReturnTypes[builtin.JsonContains] = func(_ []extend.Extend) types.T {
+		return types.T_int64
+	}
+	extend.MultiStrings[builtin.JsonContains] = func(es []extend.Extend) string {
+		args := make([]string, len(es))
+		for i, e := range es {
+			args[i] = e.String()
+		}
+		return fmt.Sprintf("json_contains(%s)", strings.Join(args, ", "))
+	}
+	fn := func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		if len(vecs) != 2 && len(vecs) != 3 {
+			return nil, errors.New("json_contains takes a target, a candidate and an optional path")
+		}
+		candidate, err := jsonDocument(vecs[1], cs[1])
+		if err != nil {
+			return nil, err
+		}
+		var p *bytejson.Path
+		if len(vecs) == 3 {
+			ps, err := jsonPaths(vecs[2:], cs[2:])
+			if err != nil {
+				return nil, err
+			}
+			if p = ps[0]; p.Wildcard() {
+				return nil, errors.New("the path of json_contains may not contain wildcards")
+			}
+		}
+		xs := vecs[0].Col.(*types.Bytes)
+		rows := len(xs.Lengths)
+		vec, err := process.Get(proc, 8*int64(rows), types.Type{Oid: types.T_int64, Size: 8})
+		if err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, vecs[0].Nsp)
+		rs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+		if vecs[0].Typ.Oid == types.T_json {
+			rs, err = jsoncontains.JsonContains(xs, candidate, p, vec.Nsp, rs)
+		} else {
+			rs, err = jsoncontains.JsonContainsFromText(xs, candidate, p, vec.Nsp, rs)
+		}
+		if err != nil {
+			return nil, err
+		}
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	for _, typ := range []types.T{types.T_json, types.T_char, types.T_varchar} {
+		overload.MultiOps[builtin.JsonContains] = append(overload.MultiOps[builtin.JsonContains], &overload.MultiOp{
+			Min:        2,
+			Max:        3,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn:         fn,
+		})
+	}
+}
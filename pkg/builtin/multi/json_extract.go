@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/jsonextract"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// json_extract(doc, path, ...) returns the values of the json document
+// matched by the paths, the document is a json column or a json text.
+func init() {
+	extend.FunctionRegistry["json_extract"] = builtin.JsonExtract
+	overload.OpTypes[builtin.JsonExtract] = overload.Multi
+	extend.MultiReturnTypes[builtin.JsonExtract] = func(_ []extend.Extend) types.T {
+		return types.T_json
+	}
+	extend.MultiStrings[builtin.JsonExtract] = func(es []extend.Extend) string {
+		args := make([]string, len(es))
+		for i, e := range es {
+			args[i] = e.String()
+		}
+		return fmt.Sprintf("json_extract(%s)", strings.Join(args, ", "))
+	}
+	fn := func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		if len(vecs) < 2 {
+			return nil, errors.New("json_extract takes a json document and at least one path")
+		}
+		ps, err := jsonPaths(vecs[1:], cs[1:])
+		if err != nil {
+			return nil, err
+		}
+		xs := vecs[0].Col.(*types.Bytes)
+		rows := len(xs.Lengths)
+		vec, err := process.Get(proc, 24*int64(rows), types.Type{Oid: types.T_json, Size: 24})
+		if err != nil {
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, vecs[0].Nsp)
+		rs := &types.Bytes{
+			Offsets: make([]uint32, 0, rows),
+			Lengths: make([]uint32, 0, rows),
+		}
+		if vecs[0].Typ.Oid == types.T_json {
+			rs, err = jsonextract.JsonExtract(xs, ps, vec.Nsp, rs)
+		} else {
+			rs, err = jsonextract.JsonExtractFromText(xs, ps, vec.Nsp, rs)
+		}
+		if err != nil {
+			return nil, err
+		}
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	for _, typ := range []types.T{types.T_json, types.T_char, types.T_varchar} {
+		overload.MultiOps[builtin.JsonExtract] = append(overload.MultiOps[builtin.JsonExtract], &overload.MultiOp{
+			Min:        2,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_json,
+			Fn:         fn,
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/jsonobject"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// json_object(key, value, ...) returns a json object of the key value pairs
+func init() {
+	extend.FunctionRegistry["json_object"] = builtin.JsonObject
+	overload.OpTypes[builtin.JsonObject] = overload.Multi
+	extend.MultiReturnTypes[builtin.JsonObject] = func(_ []extend.Extend) types.T {
+		return types.T_json
+	}
+	extend.MultiStrings[builtin.JsonObject] = func(es []extend.Extend) string {
+		args := make([]string, len(es))
+		for i, e := range es {
+			args[i] = e.String()
+		}
+		return fmt.Sprintf("json_object(%s)", strings.Join(args, ", "))
+	}
+	fn := func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+		if len(vecs)%2 != 0 {
+			return nil, errors.New("json_object takes an even number of arguments")
+		}
+		args := make([][]bytejson.ByteJson, len(vecs))
+		for i, vec := range vecs {
+			vs, err := jsonValues(vec)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = vs
+		}
+		rows := jsonRows(vecs)
+		vec, err := process.Get(proc, 24*int64(rows), types.Type{Oid: types.T_json, Size: 24})
+		if err != nil {
+			return nil, err
+		}
+		rs := &types.Bytes{
+			Offsets: make([]uint32, rows),
+			Lengths: make([]uint32, rows),
+		}
+		if rs, err = jsonobject.JsonObject(args, rs); err != nil {
+			return nil, err
+		}
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	for _, typ := range jsonArgTypes {
+		overload.MultiOps[builtin.JsonObject] = append(overload.MultiOps[builtin.JsonObject], &overload.MultiOp{
+			Min:        0,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_json,
+			Fn:         fn,
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/match"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// match_against(col, ..., search string, mode, search) is the MATCH (col,
+// ...) AGAINST (search string [modifier]) expression, mode is a
+// fulltext.Mode and search is the marshaled fulltext.Search which the
+// search string is resolved to against the whole table.
+func init() {
+	extend.FunctionRegistry["match_against"] = builtin.MatchAgainst
+	overload.OpTypes[builtin.MatchAgainst] = overload.Multi
+	extend.MultiReturnTypes[builtin.MatchAgainst] = func(_ []extend.Extend) types.T {
+		return types.T_float64
+	}
+	extend.MultiStrings[builtin.MatchAgainst] = func(es []extend.Extend) string {
+		n := len(es) - 3
+		cols := make([]string, n)
+		for i, e := range es[:n] {
+			cols[i] = e.String()
+		}
+		return fmt.Sprintf("match(%s) against(%s, %s)", strings.Join(cols, ", "), es[n], es[n+1])
+	}
+	fn := func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		if len(vecs) < 4 {
+			return nil, errors.New("match against takes at least one column")
+		}
+		n := len(vecs) - 3
+		qv, mv, sv := vecs[n], vecs[n+1], vecs[n+2]
+		if !cs[n] || (qv.Typ.Oid != types.T_char && qv.Typ.Oid != types.T_varchar) {
+			return nil, errors.New("the search string of match against must be a string constant")
+		}
+		if !cs[n+1] || mv.Typ.Oid != types.T_int64 {
+			return nil, errors.New("the search modifier of match against must be an int64 constant")
+		}
+		if !cs[n+2] || sv.Typ.Oid != types.T_varchar {
+			return nil, errors.New("the resolved search of match against must be a string constant")
+		}
+		search := new(fulltext.Search)
+		if err := search.Unmarshal(sv.Col.(*types.Bytes).Get(0)); err != nil {
+			return nil, err
+		}
+		xs := make([]*types.Bytes, n)
+		nsps := make([]*nulls.Nulls, n)
+		for i, vec := range vecs[:n] {
+			if vec.Typ.Oid != types.T_char && vec.Typ.Oid != types.T_varchar {
+				return nil, fmt.Errorf("match against not yet implemented for %s", vec.Typ)
+			}
+			xs[i] = vec.Col.(*types.Bytes)
+			nsps[i] = vec.Nsp
+		}
+		rows := len(xs[0].Lengths)
+		vec, err := process.Get(proc, 8*int64(rows), types.Type{Oid: types.T_float64, Size: 8})
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeFloat64Slice(vec.Data)[:rows]
+		vector.SetCol(vec, match.MatchAgainst(xs, nsps, search, rs))
+		return vec, nil
+	}
+	overload.MultiOps[builtin.MatchAgainst] = []*overload.MultiOp{
+		{
+			Min:        4,
+			Max:        -1,
+			Typ:        types.T_char,
+			ReturnType: types.T_float64,
+			Fn:         fn,
+		},
+		{
+			Min:        4,
+			Max:        -1,
+			Typ:        types.T_varchar,
+			ReturnType: types.T_float64,
+			Fn:         fn,
+		},
+	}
+}
@@ -51,4 +51,10 @@ const (
 	Weekday
 	EndsWith
 	Date
+	MatchAgainst
+	JsonExtract
+	JsonUnquote
+	JsonContains
+	JsonArray
+	JsonObject
 )
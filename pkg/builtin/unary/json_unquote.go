@@ -0,0 +1,81 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/jsonunquote"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var JsonUnquoteArgAndRets = []argsAndRet{
+	{[]types.T{types.T_json}, types.T_varchar},
+	{[]types.T{types.T_char}, types.T_varchar},
+	{[]types.T{types.T_varchar}, types.T_varchar},
+}
+
+func init() {
+	extend.FunctionRegistry["json_unquote"] = builtin.JsonUnquote
+	overload.OpTypes[builtin.JsonUnquote] = overload.Unary
+
+	for _, item := range JsonUnquoteArgAndRets {
+		overload.AppendFunctionRets(builtin.JsonUnquote, item.args, item.ret)
+	}
+
+	extend.UnaryReturnTypes[builtin.JsonUnquote] = func(extend extend.Extend) types.T {
+		return getUnaryReturnType(builtin.JsonUnquote, extend)
+	}
+	extend.UnaryStrings[builtin.JsonUnquote] = func(extend extend.Extend) string {
+		return fmt.Sprintf("json_unquote(%s)", extend)
+	}
+
+	fn := func(inputVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+		inputVecCol := inputVec.Col.(*types.Bytes)
+		rows := len(inputVecCol.Lengths)
+		resultVec, err := process.Get(proc, 24*int64(rows), types.Type{Oid: types.T_varchar, Size: 24})
+		if err != nil {
+			return nil, err
+		}
+		nulls.Set(resultVec.Nsp, inputVec.Nsp)
+		results := &types.Bytes{
+			Offsets: make([]uint32, 0, rows),
+			Lengths: make([]uint32, 0, rows),
+		}
+		if inputVec.Typ.Oid == types.T_json {
+			results, err = jsonunquote.JsonUnquote(inputVecCol, resultVec.Nsp, results)
+		} else {
+			results, err = jsonunquote.JsonUnquoteFromText(inputVecCol, resultVec.Nsp, results)
+		}
+		if err != nil {
+			return nil, err
+		}
+		vector.SetCol(resultVec, results)
+		return resultVec, nil
+	}
+	for _, item := range JsonUnquoteArgAndRets {
+		overload.UnaryOps[builtin.JsonUnquote] = append(overload.UnaryOps[builtin.JsonUnquote], &overload.UnaryOp{
+			Typ:        item.args[0],
+			ReturnType: item.ret,
+			Fn:         fn,
+		})
+	}
+}
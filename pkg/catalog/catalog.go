@@ -25,9 +25,11 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/pb"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/event"
@@ -42,12 +44,14 @@ const (
 	cCatalogShardIDPrefix = "ShardID"
 	cTablePrefix          = "Table"
 	cTableIDPrefix        = "TID"
+	cStatsPrefix          = "Stats"
 	cRoutePrefix          = "Route"
 	cPreSplitPrefix       = "PreSplit"
 	cSplitPrefix          = "Split"
 	cDeletedTablePrefix   = "DeletedTableQueue"
 	cRuleName             = "RuleTable"
 	cLabelName            = "LabelTable"
+	cUserPrefix           = "User"
 	timeout               = 2000 * time.Millisecond
 	idPoolSize            = 20
 )
@@ -508,6 +512,9 @@ func (c *Catalog) DropTable(epoch, dbId uint64, tableName string) (tid uint64, e
 	if err = c.Driver.Delete(c.tableKey(dbId, tb.Id)); err != nil {
 		return tid, err
 	}
+	if err = c.Driver.Delete(c.statsKey(dbId, tb.Id)); err != nil {
+		return tid, err
+	}
 	return tb.Id, err
 }
 
@@ -555,6 +562,11 @@ func (c *Catalog) CreateIndex(epoch uint64, idxInfo aoe.IndexInfo) error {
 						return ErrInvalidIndexType
 					}
 				}
+				if idxInfo.Type == aoe.FullText {
+					if col.Type.Oid != types.T_char && col.Type.Oid != types.T_varchar {
+						return ErrInvalidIndexType
+					}
+				}
 			}
 		}
 		if !columnExist {
@@ -683,6 +695,56 @@ func (c *Catalog) GetTable(dbId uint64, tableName string) (*aoe.TableInfo, error
 	}
 }
 
+// SetTableStatistics replaces the statistics of the given columns of the table,
+// the statistics of the other columns are kept.
+func (c *Catalog) SetTableStatistics(epoch, dbId uint64, tableName string, stats map[string]*engine.ColumnStatistics) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("SetTableStatistics cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tb, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return err
+	}
+	mp, err := c.getStatistics(dbId, tb.Id)
+	if err != nil {
+		return err
+	}
+	if mp == nil {
+		mp = make(map[string]*engine.ColumnStatistics)
+	}
+	for attr, st := range stats {
+		mp[attr] = st
+	}
+	value, err := encoding.Encode(mp)
+	if err != nil {
+		return err
+	}
+	return c.Driver.Set(c.statsKey(dbId, tb.Id), value)
+}
+
+// GetTableStatistics returns the statistics of the analyzed columns of the table,
+// it returns nil if the table has not been analyzed.
+func (c *Catalog) GetTableStatistics(dbId uint64, tableName string) (map[string]*engine.ColumnStatistics, error) {
+	tb, err := c.GetTable(dbId, tableName)
+	if err != nil {
+		return nil, err
+	}
+	return c.getStatistics(dbId, tb.Id)
+}
+
+func (c *Catalog) getStatistics(dbId, tId uint64) (map[string]*engine.ColumnStatistics, error) {
+	value, err := c.Driver.Get(c.statsKey(dbId, tId))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	var mp map[string]*engine.ColumnStatistics
+	if err = encoding.Decode(value, &mp); err != nil {
+		return nil, err
+	}
+	return mp, nil
+}
+
 // GetTablets gets all the tablets of the table in database with dbId and tableName.
 func (c *Catalog) GetTablets(dbId uint64, tableName string) (tablets []aoe.TabletInfo, err error) {
 	t0 := time.Now()
@@ -868,6 +930,9 @@ func (c *Catalog) dropTables(epoch, dbId uint64) (err error) {
 		if err = c.Driver.Delete(c.tableKey(dbId, tbl.Id)); err != nil {
 			return err
 		}
+		if err = c.Driver.Delete(c.statsKey(dbId, tbl.Id)); err != nil {
+			return err
+		}
 	}
 	return err
 }
@@ -998,6 +1063,11 @@ func (c *Catalog) tableKey(dbId, tId uint64) []byte {
 	return EncodeKey(cPrefix, defaultCatalogId, cTablePrefix, dbId, tId)
 }
 
+//statsKey returns the encoded tID with prefix "meta1Stats$dbId$"
+func (c *Catalog) statsKey(dbId, tId uint64) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cStatsPrefix, dbId, tId)
+}
+
 //tablePrefix returns the prefix "meta1Table$dbId$"
 func (c *Catalog) tablePrefix(dbId uint64) []byte {
 	return EncodeKey(cPrefix, defaultCatalogId, cTablePrefix, dbId)
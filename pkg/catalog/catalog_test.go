@@ -29,6 +29,7 @@ import (
 	aoe3 "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/config"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
 	"github.com/stretchr/testify/require"
@@ -336,6 +337,24 @@ func TestCatalogWithUtil(t *testing.T) {
 		require.Equal(t, tablets[i].Table.Name, testTables[0].Name, "GetTablets: Wrong Name")
 	}
 
+	//test TableStatistics
+	stats, err := catalog.GetTableStatistics(dbids[0], testTables[0].Name)
+	require.NoError(t, err, "GetTableStatistics Fail")
+	require.Nil(t, stats, "GetTableStatistics: not analyzed")
+	err = catalog.SetTableStatistics(0, dbids[0], testTables[0].Name, map[string]*engine.ColumnStatistics{
+		"a": {Rows: 10, NDV: 5, Min: int64(0), Max: int64(9)},
+	})
+	require.NoError(t, err, "SetTableStatistics Fail")
+	err = catalog.SetTableStatistics(0, dbids[0], testTables[0].Name, map[string]*engine.ColumnStatistics{
+		"b": {Rows: 10, NDV: 2},
+	})
+	require.NoError(t, err, "SetTableStatistics Fail")
+	stats, err = catalog.GetTableStatistics(dbids[0], testTables[0].Name)
+	require.NoError(t, err, "GetTableStatistics Fail")
+	require.Equal(t, 2, len(stats), "GetTableStatistics: Wrong len")
+	require.Equal(t, int64(9), stats["a"].Max, "GetTableStatistics: Wrong max")
+	require.Equal(t, int64(2), stats["b"].NDV, "GetTableStatistics: Wrong ndv")
+
 	//test DropTable
 	dropId, err := catalog.DropTable(0, dbids[0], testTables[0].Name)
 	require.NoError(t, err, "DropTable Fail")
@@ -53,4 +53,18 @@ var (
 	ErrIndexNotExist = errors.New("index not exist")
 	//ErrShardPending is for pending shards
 	ErrShardPending = errors.New("shard is pending")
+	//ErrUserExists is the error for creating a user which exists.
+	ErrUserExists = errors.New("user already exists")
+	//ErrUserNotExists is the error for user not exist.
+	ErrUserNotExists = errors.New("user not exist")
+	//ErrRoleExists is the error for creating a role which exists.
+	ErrRoleExists = errors.New("role already exists")
+	//ErrRoleNotExists is the error for role not exist.
+	ErrRoleNotExists = errors.New("role not exist")
+	//ErrCircularRole is the error for granting a role to itself directly or indirectly.
+	ErrCircularRole = errors.New("role is granted to itself")
+	//ErrIllegalGrant is the error for the privilege which can not be granted on the object.
+	ErrIllegalGrant = errors.New("illegal privilege level")
+	//ErrGrantNotExists is the error for revoking the privileges which are not granted.
+	ErrGrantNotExists = errors.New("there is no such grant")
 )
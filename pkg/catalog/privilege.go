@@ -0,0 +1,648 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/json"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// Account is the name and the host name of a user or a role,
+// the accounts with the same name and different hosts are different accounts.
+type Account struct {
+	Name string `json:"name"`
+	Host string `json:"host"`
+}
+
+// UserInfo is the meta of an account.
+// The users and the roles share the same name space,
+// a role is an account which can not log in.
+type UserInfo struct {
+	Name   string `json:"name"`
+	Host   string `json:"host"`
+	IsRole bool   `json:"is_role"`
+	// AuthPlugin is the authentication method of the user, like mysql_native_password.
+	AuthPlugin string `json:"auth_plugin"`
+	// AuthString is the password hashed by the AuthPlugin.
+	AuthString []byte `json:"auth_string"`
+	// Roles are the roles granted to the account.
+	Roles []Account `json:"roles"`
+	// Grants are the privileges granted to the account.
+	Grants []GrantInfo `json:"grants"`
+}
+
+// MatchHost checks whether the account can connect from the host, which is the address of the client.
+// The host name of the account is a pattern like MySQL's: '%' matches any characters, '_' matches
+// one character, 'localhost' matches the loopback addresses and 'ip/netmask' matches the addresses
+// in the network.
+func (u *UserInfo) MatchHost(host string) bool {
+	pattern := strings.ToLower(u.Host)
+	host = strings.ToLower(host)
+	switch {
+	case len(pattern) == 0 || pattern == "%":
+		return true
+	case pattern == "localhost":
+		if ip := net.ParseIP(host); ip != nil {
+			return ip.IsLoopback()
+		}
+		return host == "localhost"
+	case strings.Contains(pattern, "/"):
+		i := strings.Index(pattern, "/")
+		network, mask, ip := net.ParseIP(pattern[:i]).To4(), net.ParseIP(pattern[i+1:]).To4(), net.ParseIP(host).To4()
+		if network == nil || mask == nil || ip == nil {
+			return false
+		}
+		return ip.Mask(net.IPMask(mask)).Equal(network)
+	}
+	return matchWildcard(pattern, host)
+}
+
+// hostSpecificity returns how specific the host name of an account is, the
+// account with the most specific host name is chosen when several match.
+// It is the position of the first wildcard, the host names without wildcards
+// are the most specific ones.
+func hostSpecificity(host string) int {
+	if i := strings.IndexAny(host, "%_"); i >= 0 {
+		return i
+	}
+	return math.MaxInt32
+}
+
+// accountHost returns the host name kept in the catalog,
+// the host names are case-insensitive and the empty one is '%'.
+func accountHost(host string) string {
+	if len(host) == 0 {
+		return "%"
+	}
+	return strings.ToLower(host)
+}
+
+// matchWildcard matches the string with the pattern in which '%' matches any characters
+// and '_' matches one character.
+func matchWildcard(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '%':
+			for i := 0; i <= len(s); i++ {
+				if matchWildcard(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '_':
+			if len(s) == 0 {
+				return false
+			}
+		default:
+			if len(s) == 0 || s[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern, s = pattern[1:], s[1:]
+	}
+	return len(s) == 0
+}
+
+// GrantInfo is the privileges granted on an object.
+// The empty Database means all the databases (*.*),
+// the empty Table means all the tables in the Database (db.*),
+// the empty Column means the whole Table.
+type GrantInfo struct {
+	Database   string               `json:"database"`
+	Table      string               `json:"table"`
+	Column     string               `json:"column"`
+	Privileges []tree.PrivilegeType `json:"privileges"`
+}
+
+var (
+	// the privileges which can be granted on a database
+	databasePrivileges = []tree.PrivilegeType{
+		tree.PRIVILEGE_TYPE_STATIC_ALTER,
+		tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW,
+		tree.PRIVILEGE_TYPE_STATIC_DELETE,
+		tree.PRIVILEGE_TYPE_STATIC_DROP,
+		tree.PRIVILEGE_TYPE_STATIC_EVENT,
+		tree.PRIVILEGE_TYPE_STATIC_EXECUTE,
+		tree.PRIVILEGE_TYPE_STATIC_INDEX,
+		tree.PRIVILEGE_TYPE_STATIC_INSERT,
+		tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES,
+		tree.PRIVILEGE_TYPE_STATIC_REFERENCES,
+		tree.PRIVILEGE_TYPE_STATIC_SELECT,
+		tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW,
+		tree.PRIVILEGE_TYPE_STATIC_TRIGGER,
+		tree.PRIVILEGE_TYPE_STATIC_UPDATE,
+	}
+	// the privileges which can be granted on a table
+	tablePrivileges = []tree.PrivilegeType{
+		tree.PRIVILEGE_TYPE_STATIC_ALTER,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW,
+		tree.PRIVILEGE_TYPE_STATIC_DELETE,
+		tree.PRIVILEGE_TYPE_STATIC_DROP,
+		tree.PRIVILEGE_TYPE_STATIC_INDEX,
+		tree.PRIVILEGE_TYPE_STATIC_INSERT,
+		tree.PRIVILEGE_TYPE_STATIC_REFERENCES,
+		tree.PRIVILEGE_TYPE_STATIC_SELECT,
+		tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW,
+		tree.PRIVILEGE_TYPE_STATIC_TRIGGER,
+		tree.PRIVILEGE_TYPE_STATIC_UPDATE,
+	}
+	// the privileges which can be granted on a column
+	columnPrivileges = []tree.PrivilegeType{
+		tree.PRIVILEGE_TYPE_STATIC_INSERT,
+		tree.PRIVILEGE_TYPE_STATIC_REFERENCES,
+		tree.PRIVILEGE_TYPE_STATIC_SELECT,
+		tree.PRIVILEGE_TYPE_STATIC_UPDATE,
+	}
+	// the privileges which are not included by ALL
+	globalExcludedPrivileges = []tree.PrivilegeType{
+		tree.PRIVILEGE_TYPE_STATIC_ALL,
+		tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION,
+		tree.PRIVILEGE_TYPE_STATIC_PROXY,
+		tree.PRIVILEGE_TYPE_STATIC_USAGE,
+	}
+)
+
+// level returns the privileges which can be granted on the object of the grant.
+func (g *GrantInfo) level() []tree.PrivilegeType {
+	switch {
+	case len(g.Database) == 0:
+		var privs []tree.PrivilegeType
+		for p := tree.PRIVILEGE_TYPE_STATIC_ALL; p <= tree.PRIVILEGE_TYPE_STATIC_USAGE; p++ {
+			if !containsPrivilege(globalExcludedPrivileges, p) {
+				privs = append(privs, p)
+			}
+		}
+		return privs
+	case len(g.Table) == 0:
+		return databasePrivileges
+	case len(g.Column) == 0:
+		return tablePrivileges
+	}
+	return columnPrivileges
+}
+
+// Normalize expands ALL into the privileges of the level and drops USAGE.
+// It returns ErrIllegalGrant when a privilege can not be granted on the object.
+func (g *GrantInfo) Normalize() error {
+	if len(g.Database) == 0 && (len(g.Table) != 0 || len(g.Column) != 0) {
+		return ErrIllegalGrant
+	}
+	if len(g.Table) == 0 && len(g.Column) != 0 {
+		return ErrIllegalGrant
+	}
+	level := g.level()
+	var privs []tree.PrivilegeType
+	for _, p := range g.Privileges {
+		switch {
+		case p == tree.PRIVILEGE_TYPE_STATIC_USAGE:
+			continue
+		case p == tree.PRIVILEGE_TYPE_STATIC_ALL:
+			privs = appendPrivileges(privs, level...)
+		case p == tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION && len(g.Column) == 0:
+			privs = appendPrivileges(privs, p)
+		case containsPrivilege(level, p):
+			privs = appendPrivileges(privs, p)
+		default:
+			return ErrIllegalGrant
+		}
+	}
+	g.Privileges = privs
+	return nil
+}
+
+// sameObject checks whether two grants are on the same object.
+func (g *GrantInfo) sameObject(o *GrantInfo) bool {
+	return g.Database == o.Database && g.Table == o.Table && g.Column == o.Column
+}
+
+// covers checks whether the object of the grant contains the object.
+// The empty table or column means the database or the table itself.
+func (g *GrantInfo) covers(db, table, column string) bool {
+	switch {
+	case len(g.Database) == 0:
+		return true
+	case g.Database != db:
+		return false
+	case len(g.Table) == 0:
+		return true
+	case g.Table != table:
+		return false
+	case len(g.Column) == 0:
+		return true
+	}
+	return g.Column == column
+}
+
+func containsPrivilege(privs []tree.PrivilegeType, p tree.PrivilegeType) bool {
+	for _, priv := range privs {
+		if priv == p {
+			return true
+		}
+	}
+	return false
+}
+
+// appendPrivileges appends the privileges which are not in the privs.
+func appendPrivileges(privs []tree.PrivilegeType, ps ...tree.PrivilegeType) []tree.PrivilegeType {
+	for _, p := range ps {
+		if !containsPrivilege(privs, p) {
+			privs = append(privs, p)
+		}
+	}
+	return privs
+}
+
+// Privileges are all the privileges of an account,
+// including the privileges of the roles granted to it.
+type Privileges struct {
+	grants []GrantInfo
+}
+
+// HasPrivilege checks whether the privilege is granted on the object.
+// The empty table means the database itself and the empty column means the table itself.
+func (p *Privileges) HasPrivilege(priv tree.PrivilegeType, db, table, column string) bool {
+	for i := range p.grants {
+		if p.grants[i].covers(db, table, column) && containsPrivilege(p.grants[i].Privileges, priv) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyPrivilege checks whether any privilege except USAGE is granted on the object
+// or on an object in it. The empty table means the database itself and the empty
+// column means the table itself.
+func (p *Privileges) HasAnyPrivilege(db, table, column string) bool {
+	for i := range p.grants {
+		if !p.grants[i].overlaps(db, table, column) {
+			continue
+		}
+		for _, priv := range p.grants[i].Privileges {
+			if priv != tree.PRIVILEGE_TYPE_STATIC_USAGE {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// overlaps checks whether the object of the grant contains the object or is in it.
+func (g *GrantInfo) overlaps(db, table, column string) bool {
+	switch {
+	case len(g.Database) == 0 || len(db) == 0:
+		return true
+	case g.Database != db:
+		return false
+	case len(g.Table) == 0 || len(table) == 0:
+		return true
+	case g.Table != table:
+		return false
+	case len(g.Column) == 0 || len(column) == 0:
+		return true
+	}
+	return g.Column == column
+}
+
+// CreateUser creates a user or a role.
+func (c *Catalog) CreateUser(user UserInfo) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("CreateUser cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	user.Host = accountHost(user.Host)
+	if u, _ := c.getUser(user.Name, user.Host); u != nil {
+		if u.IsRole {
+			return ErrRoleExists
+		}
+		return ErrUserExists
+	}
+	for i := range user.Roles {
+		user.Roles[i].Host = accountHost(user.Roles[i].Host)
+	}
+	return c.setUser(&user)
+}
+
+// DropUser drops a user or a role.
+// The dropped role is revoked from all the accounts.
+func (c *Catalog) DropUser(name, host string, isRole bool) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("DropUser cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	user, err := c.checkUserExists(name, host, isRole)
+	if err != nil {
+		return err
+	}
+	if err = c.Driver.Delete(c.userKey(user.Name, user.Host)); err != nil {
+		return err
+	}
+	if !isRole {
+		return nil
+	}
+	users, err := c.ListUsers()
+	if err != nil {
+		return err
+	}
+	role := Account{Name: user.Name, Host: user.Host}
+	for i := range users {
+		roles := removeAccount(users[i].Roles, role)
+		if len(roles) == len(users[i].Roles) {
+			continue
+		}
+		users[i].Roles = roles
+		if err = c.setUser(&users[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetUser gets the user with the name and the host name.
+func (c *Catalog) GetUser(name, host string) (*UserInfo, error) {
+	return c.checkUserExists(name, host, false)
+}
+
+// MatchUser gets the user with the name which can connect from the address of the client.
+// The user with the most specific host name is chosen when several ones match.
+func (c *Catalog) MatchUser(name, addr string) (*UserInfo, error) {
+	users, err := c.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+	var user *UserInfo
+	for i := range users {
+		if users[i].IsRole || users[i].Name != name || !users[i].MatchHost(addr) {
+			continue
+		}
+		if user == nil || hostSpecificity(users[i].Host) > hostSpecificity(user.Host) {
+			user = &users[i]
+		}
+	}
+	if user == nil {
+		return nil, ErrUserNotExists
+	}
+	return user, nil
+}
+
+// ListUsers returns all the users and roles.
+func (c *Catalog) ListUsers() ([]UserInfo, error) {
+	values, err := c.Driver.PrefixScan(c.userPrefix(), 0)
+	if err != nil {
+		return nil, err
+	}
+	var users []UserInfo
+	for i := 1; i < len(values); i = i + 2 {
+		user := UserInfo{}
+		if err = json.Unmarshal(values[i], &user); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// SetPassword changes the authentication method and the hashed password of the user.
+func (c *Catalog) SetPassword(name, host, authPlugin string, authString []byte) error {
+	user, err := c.checkUserExists(name, host, false)
+	if err != nil {
+		return err
+	}
+	user.AuthPlugin, user.AuthString = authPlugin, authString
+	return c.setUser(user)
+}
+
+// GrantPrivileges grants the privileges to the user or the role.
+func (c *Catalog) GrantPrivileges(name, host string, grants []GrantInfo) error {
+	user, err := c.getUser(name, host)
+	if err != nil {
+		return err
+	}
+	for _, g := range grants {
+		if err = g.Normalize(); err != nil {
+			return err
+		}
+		if len(g.Privileges) == 0 {
+			continue
+		}
+		found := false
+		for i := range user.Grants {
+			if user.Grants[i].sameObject(&g) {
+				user.Grants[i].Privileges = appendPrivileges(user.Grants[i].Privileges, g.Privileges...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			user.Grants = append(user.Grants, g)
+		}
+	}
+	return c.setUser(user)
+}
+
+// RevokePrivileges revokes the privileges from the user or the role.
+func (c *Catalog) RevokePrivileges(name, host string, grants []GrantInfo) error {
+	user, err := c.getUser(name, host)
+	if err != nil {
+		return err
+	}
+	for _, g := range grants {
+		if err = g.Normalize(); err != nil {
+			return err
+		}
+		found := false
+		for i := 0; i < len(user.Grants); i++ {
+			if !user.Grants[i].sameObject(&g) {
+				continue
+			}
+			found = true
+			var privs []tree.PrivilegeType
+			for _, p := range user.Grants[i].Privileges {
+				if !containsPrivilege(g.Privileges, p) {
+					privs = append(privs, p)
+				}
+			}
+			if len(privs) == 0 {
+				user.Grants = append(user.Grants[:i], user.Grants[i+1:]...)
+			} else {
+				user.Grants[i].Privileges = privs
+			}
+			break
+		}
+		if !found {
+			return ErrGrantNotExists
+		}
+	}
+	return c.setUser(user)
+}
+
+// GrantRoles grants the roles to the user or the role.
+func (c *Catalog) GrantRoles(name, host string, roles []Account) error {
+	user, err := c.getUser(name, host)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		role, err := c.checkUserExists(r.Name, r.Host, true)
+		if err != nil {
+			return err
+		}
+		//the grantee can not be reached from the role
+		reachable, err := c.reachableRoles(role)
+		if err != nil {
+			return err
+		}
+		if _, ok := reachable[Account{Name: user.Name, Host: user.Host}]; ok {
+			return ErrCircularRole
+		}
+		user.Roles = appendAccount(user.Roles, Account{Name: role.Name, Host: role.Host})
+	}
+	return c.setUser(user)
+}
+
+// RevokeRoles revokes the roles from the user or the role.
+func (c *Catalog) RevokeRoles(name, host string, roles []Account) error {
+	user, err := c.getUser(name, host)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		role, err := c.checkUserExists(r.Name, r.Host, true)
+		if err != nil {
+			return err
+		}
+		user.Roles = removeAccount(user.Roles, Account{Name: role.Name, Host: role.Host})
+	}
+	return c.setUser(user)
+}
+
+// GetPrivileges returns the privileges of the user,
+// the privileges of the roles granted to the user directly or indirectly are included.
+func (c *Catalog) GetPrivileges(name, host string) (*Privileges, error) {
+	user, err := c.getUser(name, host)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := c.reachableRoles(user)
+	if err != nil {
+		return nil, err
+	}
+	p := &Privileges{}
+	for _, account := range accounts {
+		p.grants = append(p.grants, account.Grants...)
+	}
+	return p, nil
+}
+
+// reachableRoles returns the account and all the roles granted to it directly or indirectly.
+// The roles which have been dropped are ignored.
+func (c *Catalog) reachableRoles(user *UserInfo) (map[Account]*UserInfo, error) {
+	accounts := map[Account]*UserInfo{{Name: user.Name, Host: user.Host}: user}
+	queue := []*UserInfo{user}
+	for len(queue) > 0 {
+		account := queue[0]
+		queue = queue[1:]
+		for _, r := range account.Roles {
+			if _, ok := accounts[r]; ok {
+				continue
+			}
+			role, err := c.getUser(r.Name, r.Host)
+			if err == ErrUserNotExists {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			accounts[r] = role
+			queue = append(queue, role)
+		}
+	}
+	return accounts, nil
+}
+
+// checkUserExists gets the user or the role with the name and the host name.
+// It returns ErrUserNotExists or ErrRoleNotExists when the account does not exist
+// or it is not the kind of the account.
+func (c *Catalog) checkUserExists(name, host string, isRole bool) (*UserInfo, error) {
+	user, err := c.getUser(name, host)
+	if (err == nil && user.IsRole != isRole) || err == ErrUserNotExists {
+		if isRole {
+			return nil, ErrRoleNotExists
+		}
+		return nil, ErrUserNotExists
+	}
+	return user, err
+}
+
+// getUser gets the account with the name and the host name whatever it is a user or a role.
+func (c *Catalog) getUser(name, host string) (*UserInfo, error) {
+	v, err := c.Driver.Get(c.userKey(name, host))
+	if err != nil || v == nil {
+		return nil, ErrUserNotExists
+	}
+	user := &UserInfo{}
+	if err = json.Unmarshal(v, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (c *Catalog) setUser(user *UserInfo) error {
+	value, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+	return c.Driver.Set(c.userKey(user.Name, user.Host), value)
+}
+
+// userKey returns the encoded account with prefix "meta1User",
+// the name is prefixed by its length to be separated from the host name.
+func (c *Catalog) userKey(name, host string) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cUserPrefix, len(name), name, accountHost(host))
+}
+
+// userPrefix returns the prefix "meta1User"
+func (c *Catalog) userPrefix() []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cUserPrefix)
+}
+
+func appendAccount(accounts []Account, a Account) []Account {
+	for _, v := range accounts {
+		if v == a {
+			return accounts
+		}
+	}
+	return append(accounts, a)
+}
+
+func removeAccount(accounts []Account, a Account) []Account {
+	var rs []Account
+	for _, v := range accounts {
+		if v != a {
+			rs = append(rs, v)
+		}
+	}
+	return rs
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/driver"
+	"github.com/stretchr/testify/require"
+)
+
+// memDriver keeps the keys in memory, only the operations used by the privileges are implemented.
+type memDriver struct {
+	driver.CubeDriver
+	kv map[string][]byte
+}
+
+func newMemDriver() *memDriver {
+	return &memDriver{kv: make(map[string][]byte)}
+}
+
+func (d *memDriver) Set(k, v []byte) error {
+	d.kv[string(k)] = append([]byte{}, v...)
+	return nil
+}
+
+func (d *memDriver) Get(k []byte) ([]byte, error) {
+	return d.kv[string(k)], nil
+}
+
+func (d *memDriver) Delete(k []byte) error {
+	delete(d.kv, string(k))
+	return nil
+}
+
+func (d *memDriver) PrefixScan(prefix []byte, limit uint64) ([][]byte, error) {
+	var keys []string
+	for k := range d.kv {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var values [][]byte
+	for _, k := range keys {
+		values = append(values, []byte(k), d.kv[k])
+	}
+	return values, nil
+}
+
+func TestPrivileges(t *testing.T) {
+	c := &Catalog{Driver: newMemDriver()}
+
+	//users and roles
+	require.NoError(t, c.CreateUser(UserInfo{Name: "u1", Host: "%", AuthPlugin: "mysql_native_password", AuthString: []byte("x")}))
+	require.NoError(t, c.CreateUser(UserInfo{Name: "r1", IsRole: true}))
+	require.NoError(t, c.CreateUser(UserInfo{Name: "r2", IsRole: true}))
+	require.Equal(t, ErrUserExists, c.CreateUser(UserInfo{Name: "u1"}))
+	require.Equal(t, ErrRoleExists, c.CreateUser(UserInfo{Name: "r1"}))
+
+	user, err := c.GetUser("u1", "%")
+	require.NoError(t, err)
+	require.Equal(t, []byte("x"), user.AuthString)
+	_, err = c.GetUser("r1", "%")
+	require.Equal(t, ErrUserNotExists, err)
+	_, err = c.GetUser("u2", "%")
+	require.Equal(t, ErrUserNotExists, err)
+
+	require.NoError(t, c.SetPassword("u1", "%", "caching_sha2_password", []byte("y")))
+	user, err = c.GetUser("u1", "%")
+	require.NoError(t, err)
+	require.Equal(t, "caching_sha2_password", user.AuthPlugin)
+	require.Equal(t, []byte("y"), user.AuthString)
+
+	users, err := c.ListUsers()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(users))
+
+	//the accounts with the same name and different hosts
+	require.NoError(t, c.CreateUser(UserInfo{Name: "u1", Host: "LocalHost", AuthPlugin: "mysql_native_password", AuthString: []byte("z")}))
+	require.Equal(t, ErrUserExists, c.CreateUser(UserInfo{Name: "u1", Host: "localhost"}))
+	user, err = c.GetUser("u1", "localhost")
+	require.NoError(t, err)
+	require.Equal(t, []byte("z"), user.AuthString)
+	user, err = c.GetUser("u1", "%")
+	require.NoError(t, err)
+	require.Equal(t, []byte("y"), user.AuthString)
+	_, err = c.GetUser("u1", "10.%")
+	require.Equal(t, ErrUserNotExists, err)
+	require.NoError(t, c.DropUser("u1", "localhost", false))
+	require.Equal(t, ErrUserNotExists, c.DropUser("u1", "localhost", false))
+	_, err = c.GetUser("u1", "%")
+	require.NoError(t, err)
+
+	//privileges on the objects
+	select_ := tree.PRIVILEGE_TYPE_STATIC_SELECT
+	insert := tree.PRIVILEGE_TYPE_STATIC_INSERT
+	require.NoError(t, c.GrantPrivileges("u1", "%", []GrantInfo{
+		{Database: "db1", Privileges: []tree.PrivilegeType{select_}},
+		{Database: "db2", Table: "t1", Column: "a", Privileges: []tree.PrivilegeType{insert}},
+	}))
+	p, err := c.GetPrivileges("u1", "%")
+	require.NoError(t, err)
+	require.True(t, p.HasPrivilege(select_, "db1", "", ""))
+	require.True(t, p.HasPrivilege(select_, "db1", "t1", ""))
+	require.True(t, p.HasPrivilege(select_, "db1", "t1", "a"))
+	require.False(t, p.HasPrivilege(select_, "db2", "t1", ""))
+	require.False(t, p.HasPrivilege(insert, "db2", "t1", ""))
+	require.True(t, p.HasPrivilege(insert, "db2", "t1", "a"))
+	require.False(t, p.HasPrivilege(insert, "db2", "t1", "b"))
+	require.True(t, p.HasAnyPrivilege("db1", "t2", "b"))
+	require.True(t, p.HasAnyPrivilege("db2", "", ""))
+	require.True(t, p.HasAnyPrivilege("db2", "t1", ""))
+	require.False(t, p.HasAnyPrivilege("db2", "t1", "b"))
+	require.False(t, p.HasAnyPrivilege("db2", "t2", ""))
+	require.False(t, p.HasAnyPrivilege("db3", "", ""))
+
+	//the privileges which can not be granted on the level
+	err = c.GrantPrivileges("u1", "%", []GrantInfo{{Database: "db1", Privileges: []tree.PrivilegeType{tree.PRIVILEGE_TYPE_STATIC_CREATE_USER}}})
+	require.Equal(t, ErrIllegalGrant, err)
+	err = c.GrantPrivileges("u1", "%", []GrantInfo{{Database: "db1", Table: "t1", Column: "a", Privileges: []tree.PrivilegeType{tree.PRIVILEGE_TYPE_STATIC_DROP}}})
+	require.Equal(t, ErrIllegalGrant, err)
+	require.Equal(t, ErrUserNotExists, c.GrantPrivileges("u2", "%", nil))
+
+	//privileges from the roles
+	require.NoError(t, c.GrantPrivileges("r2", "%", []GrantInfo{{Privileges: []tree.PrivilegeType{tree.PRIVILEGE_TYPE_STATIC_ALL}}}))
+	require.NoError(t, c.GrantRoles("r1", "%", []Account{{Name: "r2", Host: "%"}}))
+	require.NoError(t, c.GrantRoles("u1", "%", []Account{{Name: "r1", Host: "%"}}))
+	require.Equal(t, ErrCircularRole, c.GrantRoles("r2", "%", []Account{{Name: "r1", Host: "%"}}))
+	require.Equal(t, ErrRoleNotExists, c.GrantRoles("u1", "%", []Account{{Name: "u1", Host: "%"}}))
+	p, err = c.GetPrivileges("u1", "%")
+	require.NoError(t, err)
+	require.True(t, p.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER, "", "", ""))
+	require.True(t, p.HasPrivilege(insert, "db2", "t1", "b"))
+	require.False(t, p.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION, "", "", ""))
+
+	require.NoError(t, c.RevokeRoles("u1", "%", []Account{{Name: "r1", Host: "%"}}))
+	p, err = c.GetPrivileges("u1", "%")
+	require.NoError(t, err)
+	require.False(t, p.HasPrivilege(insert, "db2", "t1", "b"))
+
+	//revoke
+	require.NoError(t, c.RevokePrivileges("u1", "%", []GrantInfo{{Database: "db1", Privileges: []tree.PrivilegeType{tree.PRIVILEGE_TYPE_STATIC_ALL}}}))
+	require.Equal(t, ErrGrantNotExists, c.RevokePrivileges("u1", "%", []GrantInfo{{Database: "db1", Privileges: []tree.PrivilegeType{select_}}}))
+	p, err = c.GetPrivileges("u1", "%")
+	require.NoError(t, err)
+	require.False(t, p.HasPrivilege(select_, "db1", "t1", ""))
+	require.True(t, p.HasPrivilege(insert, "db2", "t1", "a"))
+
+	//the dropped role is revoked from the accounts
+	require.NoError(t, c.GrantRoles("u1", "%", []Account{{Name: "r2", Host: "%"}}))
+	require.Equal(t, ErrRoleNotExists, c.DropUser("u1", "%", true))
+	require.NoError(t, c.DropUser("r2", "%", true))
+	user, err = c.GetUser("u1", "%")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(user.Roles))
+	r1, err := c.checkUserExists("r1", "%", true)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(r1.Roles))
+
+	require.NoError(t, c.DropUser("u1", "%", false))
+	require.Equal(t, ErrUserNotExists, c.DropUser("u1", "%", false))
+}
+
+func TestUserInfo_MatchHost(t *testing.T) {
+	for _, c := range []struct {
+		pattern, host string
+		match         bool
+	}{
+		{"", "10.0.0.1", true},
+		{"%", "10.0.0.1", true},
+		{"localhost", "127.0.0.1", true},
+		{"localhost", "::1", true},
+		{"localhost", "10.0.0.1", false},
+		{"10.0.%", "10.0.3.4", true},
+		{"10.0.%", "10.1.3.4", false},
+		{"10.0.0._", "10.0.0.7", true},
+		{"10.0.0._", "10.0.0.17", false},
+		{"Host.Example.com", "host.example.com", true},
+		{"10.0.0.0/255.255.0.0", "10.0.200.1", true},
+		{"10.0.0.0/255.255.0.0", "10.1.0.1", false},
+		{"10.0.0.0/255.255.0.0", "host", false},
+	} {
+		u := &UserInfo{Name: "u", Host: c.pattern}
+		require.Equal(t, c.match, u.MatchHost(c.host), "%s@%s", c.pattern, c.host)
+	}
+}
+
+func TestCatalog_MatchUser(t *testing.T) {
+	c := &Catalog{Driver: newMemDriver()}
+	for _, host := range []string{"%", "10.0.%", "10.0.0.1", "localhost"} {
+		require.NoError(t, c.CreateUser(UserInfo{Name: "u", Host: host}))
+	}
+	require.NoError(t, c.CreateUser(UserInfo{Name: "r", Host: "%", IsRole: true}))
+	require.NoError(t, c.CreateUser(UserInfo{Name: "v", Host: "10.%"}))
+
+	for _, m := range []struct {
+		name, addr, host string
+	}{
+		{"u", "10.0.0.1", "10.0.0.1"},
+		{"u", "10.0.0.2", "10.0.%"},
+		{"u", "127.0.0.1", "localhost"},
+		{"u", "192.168.0.1", "%"},
+		{"v", "10.0.0.1", "10.%"},
+		{"v", "192.168.0.1", ""},
+		{"r", "10.0.0.1", ""},
+		{"w", "10.0.0.1", ""},
+	} {
+		user, err := c.MatchUser(m.name, m.addr)
+		if len(m.host) == 0 {
+			require.Equal(t, ErrUserNotExists, err, "%s@%s", m.name, m.addr)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, m.host, user.Host, "%s@%s", m.name, m.addr)
+	}
+}
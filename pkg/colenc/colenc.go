@@ -0,0 +1,458 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colenc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+/*
+ * the encoded data is:
+ *		magic | encoding | type | bitmap size | bitmap | payload
+ * Dict payload:
+ *		rows | dict count | dict lengths | dict data | code width | codes
+ * RLE payload:
+ *		rows | run count | run values | run lengths
+ * Delta payload:
+ *		rows | first value | bit width | packed deltas
+ * FOR payload:
+ *		rows | min value | bit width | packed offsets
+ * Integer values are stored as order-preserving uint64 keys.
+ */
+
+// Encode returns the persisted data of the vector. The column data is
+// encoded with the smallest of the lightweight encodings which apply to
+// the type of the vector, the plain data of vector.Show is returned if no
+// encoding is smaller than it.
+func Encode(v *vector.Vector) ([]byte, error) {
+	data, err := v.Show()
+	if err != nil {
+		return nil, err
+	}
+	return EncodeData(data), nil
+}
+
+// EncodeData encodes the plain data of vector.Show.
+func EncodeData(data []byte) []byte {
+	typ := encoding.DecodeType(data[:encoding.TypeSize])
+	n := headSize(data)
+	head, body := data[:n], data[n:]
+	var enc T
+	var payload []byte
+	switch {
+	case isBytes(typ.Oid):
+		enc, payload = encodeBytes(body)
+	case isFixed(typ.Oid):
+		enc, payload = encodeFixed(body, typ.Oid)
+	}
+	if enc == Plain || 2+len(head)+len(payload) >= len(data) {
+		return data
+	}
+	buf := make([]byte, 0, 2+len(head)+len(payload))
+	buf = append(buf, magic, byte(enc))
+	buf = append(buf, head...)
+	return append(buf, payload...)
+}
+
+// IsEncoded returns true if the data is encoded by EncodeData.
+func IsEncoded(data []byte) bool {
+	return len(data) > 1 && data[0] == magic
+}
+
+// Encoding returns the encoding of the data.
+func Encoding(data []byte) T {
+	if !IsEncoded(data) {
+		return Plain
+	}
+	return T(data[1])
+}
+
+// Decode returns the plain data of vector.Show of the encoded data,
+// data which is not encoded is returned as it is.
+func Decode(data []byte) ([]byte, error) {
+	buf, _, _, err := decode(data)
+	return buf, err
+}
+
+// Read reads the vector from the persisted data. The dictionary of a
+// dictionary encoded column is attached to the vector, see vector.GetDict.
+func Read(v *vector.Vector, data []byte) error {
+	buf, values, codes, err := decode(data)
+	if err != nil {
+		return err
+	}
+	v.Col = vector.New(encoding.DecodeType(buf[:encoding.TypeSize])).Col
+	v.Dict = nil
+	if err := v.Read(buf); err != nil {
+		return err
+	}
+	if values != nil {
+		vector.SetDict(v, values, codes)
+	}
+	return nil
+}
+
+func decode(data []byte) ([]byte, *types.Bytes, []uint32, error) {
+	if !IsEncoded(data) {
+		return data, nil, nil, nil
+	}
+	enc := T(data[1])
+	data = data[2:]
+	if len(data) < encoding.TypeSize+4 {
+		return nil, nil, nil, ErrInvalidData
+	}
+	typ := encoding.DecodeType(data[:encoding.TypeSize])
+	n := headSize(data)
+	if len(data) < n+4 {
+		return nil, nil, nil, ErrInvalidData
+	}
+	head, payload := data[:n], data[n:]
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)*2))
+	buf.Write(head)
+	switch enc {
+	case Dict:
+		if !isBytes(typ.Oid) {
+			return nil, nil, nil, ErrInvalidData
+		}
+		values, codes := decodeDict(payload)
+		buf.Write(encoding.EncodeInt32(int32(len(codes))))
+		for _, c := range codes {
+			buf.Write(encoding.EncodeUint32(values.Lengths[c]))
+		}
+		for _, c := range codes {
+			buf.Write(values.Get(int64(c)))
+		}
+		return buf.Bytes(), values, codes, nil
+	case RLE:
+		if !isFixed(typ.Oid) {
+			return nil, nil, nil, ErrInvalidData
+		}
+		decodeRLE(buf, payload, typ.Oid.TypeLen())
+	case Delta, FOR:
+		if !isInteger(typ.Oid) {
+			return nil, nil, nil, ErrInvalidData
+		}
+		decodePacked(buf, payload, enc, typ.Oid)
+	default:
+		return nil, nil, nil, ErrInvalidData
+	}
+	return buf.Bytes(), nil, nil, nil
+}
+
+// headSize returns the size of the type and the bitmap of the plain data.
+func headSize(data []byte) int {
+	return encoding.TypeSize + 4 + int(encoding.DecodeUint32(data[encoding.TypeSize:]))
+}
+
+func isBytes(oid types.T) bool {
+	return oid == types.T_char || oid == types.T_varchar || oid == types.T_json
+}
+
+func isFixed(oid types.T) bool {
+	switch oid {
+	case types.T_float32, types.T_float64, types.T_decimal128:
+		return true
+	}
+	return isInteger(oid)
+}
+
+func isInteger(oid types.T) bool {
+	switch oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_date, types.T_datetime, types.T_timestamp, types.T_decimal64:
+		return true
+	}
+	return false
+}
+
+func isSigned(oid types.T) bool {
+	switch oid {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return false
+	}
+	return true
+}
+
+func encodeBytes(body []byte) (T, []byte) {
+	cnt := int(encoding.DecodeInt32(body))
+	if cnt == 0 {
+		return Plain, nil
+	}
+	lengths := encoding.DecodeUint32Slice(body[4 : 4+4*cnt])
+	data := body[4+4*cnt:]
+	dict := make(map[string]uint32)
+	codes := make([]uint32, cnt)
+	var values [][]byte
+	o := uint32(0)
+	for i, n := range lengths {
+		v := data[o : o+n]
+		o += n
+		c, ok := dict[string(v)]
+		if !ok {
+			if len(values) >= cnt/2 {
+				return Plain, nil
+			}
+			c = uint32(len(values))
+			dict[string(v)] = c
+			values = append(values, v)
+		}
+		codes[i] = c
+	}
+	width := codeWidth(len(values))
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeUint32(uint32(cnt)))
+	buf.Write(encoding.EncodeUint32(uint32(len(values))))
+	for _, v := range values {
+		buf.Write(encoding.EncodeUint32(uint32(len(v))))
+	}
+	for _, v := range values {
+		buf.Write(v)
+	}
+	buf.WriteByte(byte(width))
+	code := make([]byte, 4)
+	for _, c := range codes {
+		binary.LittleEndian.PutUint32(code, c)
+		buf.Write(code[:width])
+	}
+	return Dict, buf.Bytes()
+}
+
+func decodeDict(payload []byte) (*types.Bytes, []uint32) {
+	rows := int(encoding.DecodeUint32(payload))
+	cnt := int(encoding.DecodeUint32(payload[4:]))
+	payload = payload[8:]
+	values := &types.Bytes{
+		Offsets: make([]uint32, cnt),
+		Lengths: make([]uint32, cnt),
+	}
+	o := uint32(0)
+	for i := range values.Lengths {
+		values.Lengths[i] = encoding.DecodeUint32(payload[4*i:])
+		values.Offsets[i] = o
+		o += values.Lengths[i]
+	}
+	payload = payload[4*cnt:]
+	values.Data = append([]byte{}, payload[:o]...)
+	payload = payload[o:]
+	width := int(payload[0])
+	payload = payload[1:]
+	codes := make([]uint32, rows)
+	code := make([]byte, 4)
+	for i := range codes {
+		copy(code, payload[i*width:(i+1)*width])
+		codes[i] = binary.LittleEndian.Uint32(code)
+	}
+	return values, codes
+}
+
+// codeWidth returns the number of bytes of the codes of a dictionary.
+func codeWidth(cnt int) int {
+	switch {
+	case cnt <= 1<<8:
+		return 1
+	case cnt <= 1<<16:
+		return 2
+	}
+	return 4
+}
+
+func encodeFixed(body []byte, oid types.T) (T, []byte) {
+	size := oid.TypeLen()
+	rows := len(body) / size
+	if rows == 0 {
+		return Plain, nil
+	}
+	enc, payload := RLE, encodeRLE(body, size, rows)
+	if isInteger(oid) {
+		keys := make([]uint64, rows)
+		for i := range keys {
+			keys[i] = toKey(body[i*size:(i+1)*size], isSigned(oid))
+		}
+		if p := encodeFOR(keys); len(p) < len(payload) {
+			enc, payload = FOR, p
+		}
+		if p := encodeDelta(keys); p != nil && len(p) < len(payload) {
+			enc, payload = Delta, p
+		}
+	}
+	return enc, payload
+}
+
+func encodeRLE(body []byte, size, rows int) []byte {
+	var values, lengths bytes.Buffer
+	var runs uint32
+	for i := 0; i < rows; {
+		v := body[i*size : (i+1)*size]
+		j := i + 1
+		for j < rows && bytes.Equal(v, body[j*size:(j+1)*size]) {
+			j++
+		}
+		values.Write(v)
+		lengths.Write(encoding.EncodeUint32(uint32(j - i)))
+		runs++
+		i = j
+	}
+	buf := make([]byte, 0, 8+values.Len()+lengths.Len())
+	buf = append(buf, encoding.EncodeUint32(uint32(rows))...)
+	buf = append(buf, encoding.EncodeUint32(runs)...)
+	buf = append(buf, values.Bytes()...)
+	return append(buf, lengths.Bytes()...)
+}
+
+func decodeRLE(buf *bytes.Buffer, payload []byte, size int) {
+	runs := int(encoding.DecodeUint32(payload[4:]))
+	values := payload[8 : 8+runs*size]
+	lengths := payload[8+runs*size:]
+	for i := 0; i < runs; i++ {
+		v := values[i*size : (i+1)*size]
+		for j := encoding.DecodeUint32(lengths[4*i:]); j > 0; j-- {
+			buf.Write(v)
+		}
+	}
+}
+
+func encodeFOR(keys []uint64) []byte {
+	min, max := keys[0], keys[0]
+	for _, k := range keys {
+		if k < min {
+			min = k
+		}
+		if k > max {
+			max = k
+		}
+	}
+	width := bits.Len64(max - min)
+	offsets := make([]uint64, len(keys))
+	for i, k := range keys {
+		offsets[i] = k - min
+	}
+	return packedPayload(len(keys), min, width, offsets)
+}
+
+// encodeDelta returns nil if the keys are not sorted.
+func encodeDelta(keys []uint64) []byte {
+	var max uint64
+	deltas := make([]uint64, len(keys)-1)
+	for i := 1; i < len(keys); i++ {
+		if keys[i] < keys[i-1] {
+			return nil
+		}
+		deltas[i-1] = keys[i] - keys[i-1]
+		if deltas[i-1] > max {
+			max = deltas[i-1]
+		}
+	}
+	return packedPayload(len(keys), keys[0], bits.Len64(max), deltas)
+}
+
+func packedPayload(rows int, base uint64, width int, vs []uint64) []byte {
+	buf := make([]byte, 0, 13+(len(vs)*width+7)/8)
+	buf = append(buf, encoding.EncodeUint32(uint32(rows))...)
+	buf = append(buf, encoding.EncodeUint64(base)...)
+	buf = append(buf, byte(width))
+	return append(buf, pack(vs, width)...)
+}
+
+func decodePacked(buf *bytes.Buffer, payload []byte, enc T, oid types.T) {
+	rows := int(encoding.DecodeUint32(payload))
+	base := encoding.DecodeUint64(payload[4:])
+	width := int(payload[12])
+	size, signed := oid.TypeLen(), isSigned(oid)
+	v := make([]byte, size)
+	if enc == FOR {
+		for _, o := range unpack(payload[13:], width, rows) {
+			fromKey(v, base+o, signed)
+			buf.Write(v)
+		}
+		return
+	}
+	fromKey(v, base, signed)
+	buf.Write(v)
+	for _, d := range unpack(payload[13:], width, rows-1) {
+		base += d
+		fromKey(v, base, signed)
+		buf.Write(v)
+	}
+}
+
+// toKey returns the uint64 key of an integer value, the order of
+// the keys is the same as the order of the values.
+func toKey(v []byte, signed bool) uint64 {
+	var u uint64
+	for i := len(v) - 1; i >= 0; i-- {
+		u = u<<8 | uint64(v[i])
+	}
+	if !signed {
+		return u
+	}
+	shift := 64 - 8*len(v)
+	return uint64(int64(u<<shift)>>shift) ^ (1 << 63)
+}
+
+func fromKey(v []byte, k uint64, signed bool) {
+	if signed {
+		k ^= 1 << 63
+	}
+	for i := range v {
+		v[i] = byte(k)
+		k >>= 8
+	}
+}
+
+// pack packs the low width bits of the values.
+func pack(vs []uint64, width int) []byte {
+	buf := make([]byte, (len(vs)*width+7)/8)
+	pos := 0
+	for _, v := range vs {
+		for n := 0; n < width; {
+			i, off := pos/8, pos%8
+			k := 8 - off
+			if k > width-n {
+				k = width - n
+			}
+			buf[i] |= byte((v>>n)&(1<<k-1)) << off
+			n += k
+			pos += k
+		}
+	}
+	return buf
+}
+
+func unpack(buf []byte, width, cnt int) []uint64 {
+	vs := make([]uint64, cnt)
+	pos := 0
+	for j := range vs {
+		var v uint64
+		for n := 0; n < width; {
+			i, off := pos/8, pos%8
+			k := 8 - off
+			if k > width-n {
+				k = width - n
+			}
+			v |= uint64(buf[i]>>off&(1<<k-1)) << n
+			n += k
+			pos += k
+		}
+		vs[j] = v
+	}
+	return vs
+}
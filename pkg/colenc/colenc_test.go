@@ -0,0 +1,131 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colenc
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	const rows = 1000
+
+	sorted := make([]int64, rows)
+	repeated := make([]float64, rows)
+	narrow := make([]int32, rows)
+	negative := make([]int16, rows)
+	wide := make([]uint64, rows)
+	dates := make([]types.Date, rows)
+	random := make([]float64, rows)
+	for i := 0; i < rows; i++ {
+		sorted[i] = int64(1<<40 + i*3)
+		repeated[i] = float64(i / 100)
+		narrow[i] = int32(rand.Intn(1000)) - 500
+		negative[i] = int16(-i)
+		wide[i] = math.MaxUint64 - uint64(rand.Intn(100))
+		dates[i] = types.Date(730000 + rand.Intn(365))
+		random[i] = rand.Float64()
+	}
+	strs := make([][]byte, rows)
+	uniques := make([][]byte, rows)
+	for i := range strs {
+		strs[i] = []byte(fmt.Sprintf("city-%d", i%7))
+		uniques[i] = []byte(fmt.Sprintf("user-%d", i))
+	}
+
+	tests := []struct {
+		typ types.T
+		vs  interface{}
+		enc T
+	}{
+		{types.T_int64, sorted, Delta},
+		{types.T_float64, repeated, RLE},
+		{types.T_int32, narrow, FOR},
+		{types.T_int16, negative, FOR},
+		{types.T_uint64, wide, FOR},
+		{types.T_date, dates, FOR},
+		{types.T_float64, random, Plain},
+		{types.T_varchar, strs, Dict},
+		{types.T_varchar, uniques, Plain},
+	}
+	for _, tt := range tests {
+		v := vector.New(types.Type{Oid: tt.typ, Size: int32(tt.typ.TypeLen())})
+		require.NoError(t, vector.Append(v, tt.vs))
+		for i := 0; i < rows; i += 11 {
+			nulls.Add(v.Nsp, uint64(i))
+		}
+		plain, err := v.Show()
+		require.NoError(t, err)
+		data, err := Encode(v)
+		require.NoError(t, err)
+		require.Equal(t, tt.enc, Encoding(data), "%s", tt.typ)
+		if tt.enc != Plain {
+			require.Less(t, len(data), len(plain), "%s", tt.typ)
+		}
+		buf, err := Decode(data)
+		require.NoError(t, err)
+		require.Equal(t, plain, buf, "%s", tt.typ)
+
+		w := vector.New(v.Typ)
+		require.NoError(t, Read(w, data))
+		require.Equal(t, v.String(), w.String())
+		require.Equal(t, tt.enc == Dict, vector.GetDict(w) != nil)
+	}
+}
+
+func TestDict(t *testing.T) {
+	v := vector.New(types.Type{Oid: types.T_char, Size: 24})
+	require.NoError(t, vector.Append(v, [][]byte{
+		[]byte("a"), []byte("b"), []byte("a"), []byte("a"), []byte("b"), []byte("a"),
+	}))
+	data, err := Encode(v)
+	require.NoError(t, err)
+	require.Equal(t, Dict, Encoding(data))
+
+	w := vector.New(v.Typ)
+	require.NoError(t, Read(w, data))
+	d := vector.GetDict(w)
+	require.NotNil(t, d)
+	require.Equal(t, 2, len(d.Values.Offsets))
+	require.Equal(t, []uint32{0, 1, 0, 0, 1, 0}, d.Codes)
+	col := w.Col.(*types.Bytes)
+	for i, c := range d.Codes {
+		require.Equal(t, col.Get(int64(i)), d.Values.Get(int64(c)))
+	}
+
+	// the dictionary is dropped once the column data changes
+	vector.Shrink(w, []int64{0, 1})
+	require.Nil(t, vector.GetDict(w))
+}
+
+func TestPack(t *testing.T) {
+	for _, width := range []int{0, 1, 3, 8, 13, 33, 64} {
+		vs := make([]uint64, 100)
+		for i := range vs {
+			vs[i] = rand.Uint64()
+			if width < 64 {
+				vs[i] &= 1<<width - 1
+			}
+		}
+		require.Equal(t, vs, unpack(pack(vs, width), width, len(vs)))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colenc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// T is the lightweight encoding of the persisted column data,
+// the encoded data is compressed afterwards.
+type T uint8
+
+const (
+	// Plain is the data serialized by vector.Show
+	Plain T = iota
+	// Dict stores the distinct values of a char/varchar column
+	// and the code of each row
+	Dict
+	// RLE stores the runs of equal values of a fixed-length column
+	RLE
+	// Delta bit-packs the differences between the adjacent values
+	// of a sorted integer column
+	Delta
+	// FOR bit-packs the differences between the values and the minimum
+	// value of an integer column
+	FOR
+)
+
+// magic is the first byte of the encoded data, the first byte of
+// the plain data is the type id of the column which is never 0xff.
+const magic = 0xff
+
+var ErrInvalidData = errors.New("invalid encoded column data")
+
+func (t T) String() string {
+	switch t {
+	case Plain:
+		return "PLAIN"
+	case Dict:
+		return "DICT"
+	case RLE:
+		return "RLE"
+	case Delta:
+		return "DELTA"
+	case FOR:
+		return "FOR"
+	}
+	return fmt.Sprintf("unexpected column encoding: %d", t)
+}
@@ -15,12 +15,56 @@
 package compress
 
 import (
+	"fmt"
+
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
 	"github.com/pierrec/lz4"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+const (
+	ZstdMinLevel     = zstd.BestSpeed
+	ZstdMaxLevel     = zstd.BestCompression
+	ZstdDefaultLevel = zstd.DefaultCompression
+)
+
+// zstdLevel is the compression level used by Zstd,
+// the level only affects compression and the data compressed with any level can be decompressed.
+var zstdLevel = ZstdDefaultLevel
+
+// SetZstdLevel sets the compression level used by Zstd.
+func SetZstdLevel(level int) error {
+	if level < ZstdMinLevel || level > ZstdMaxLevel {
+		return fmt.Errorf("invalid zstd compression level %d, it should be between %d and %d", level, ZstdMinLevel, ZstdMaxLevel)
+	}
+	zstdLevel = level
+	return nil
+}
+
+// ZstdLevel returns the compression level used by Zstd.
+func ZstdLevel() int {
+	return zstdLevel
+}
+
+// CompressBound returns the max size of the data compressed from size bytes with the algorithm typ,
+// dst of Compress should be at least that large.
+func CompressBound(size int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(size)
+	case Zstd:
+		return zstd.CompressBound(size)
+	case Snappy:
+		return snappy.MaxEncodedLen(size)
+	}
+	return size
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
@@ -31,6 +75,10 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstd.CompressLevel(dst, src, zstdLevel)
+	case Snappy:
+		return snappy.Encode(dst, src), nil
 	}
 	return nil, nil
 }
@@ -43,6 +91,10 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstd.Decompress(dst, src)
+	case Snappy:
+		return snappy.Decode(dst, src)
 	}
 	return nil, nil
 }
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/pierrec/lz4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -40,3 +41,37 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestAlgorithms(t *testing.T) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i % 10)
+	}
+	raw := encoding.EncodeInt64Slice(xs)
+	for name, typ := range Algorithms {
+		if typ == None {
+			continue
+		}
+		buf, err := Compress(raw, make([]byte, CompressBound(len(raw), typ)), typ)
+		require.NoError(t, err, name)
+		require.Less(t, len(buf), len(raw), name)
+		data, err := Decompress(buf, make([]byte, len(raw)), typ)
+		require.NoError(t, err, name)
+		require.Equal(t, raw, data, name)
+	}
+}
+
+func TestZstdLevel(t *testing.T) {
+	defer SetZstdLevel(ZstdDefaultLevel)
+	require.Error(t, SetZstdLevel(ZstdMaxLevel+1))
+	require.Equal(t, ZstdDefaultLevel, ZstdLevel())
+	require.NoError(t, SetZstdLevel(ZstdMaxLevel))
+	require.Equal(t, ZstdMaxLevel, ZstdLevel())
+
+	raw := []byte("aaaaaaaaaabbbbbbbbbbaaaaaaaaaabbbbbbbbbb")
+	buf, err := Compress(raw, nil, Zstd)
+	require.NoError(t, err)
+	data, err := Decompress(buf, make([]byte, len(raw)), Zstd)
+	require.NoError(t, err)
+	require.Equal(t, raw, data)
+}
@@ -0,0 +1,428 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+var Null = ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralNull}}
+
+// ParseFromString parses a json text into the binary format
+func ParseFromString(s string) (ByteJson, error) {
+	return ParseFromByteSlice([]byte(s))
+}
+
+func ParseFromByteSlice(s []byte) (ByteJson, error) {
+	dec := json.NewDecoder(bytes.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return ByteJson{}, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text: %v", err))
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return ByteJson{}, errors.New(errno.DataException, "Invalid JSON text: the document root must not be followed by other values")
+	}
+	return CreateByteJson(v)
+}
+
+// CreateByteJson creates the json value of a go value, which is one of nil,
+// bool, json.Number, string, the numbers, []interface{},
+// map[string]interface{} and ByteJson.
+func CreateByteJson(in interface{}) (ByteJson, error) {
+	switch v := in.(type) {
+	case nil:
+		return Null, nil
+	case ByteJson:
+		return v, nil
+	case bool:
+		if v {
+			return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralTrue}}, nil
+		}
+		return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralFalse}}, nil
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return NewInt64(i), nil
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return NewUint64(u), nil
+		}
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return ByteJson{}, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text: %v", err))
+		}
+		return NewFloat64(f), nil
+	case string:
+		return NewString(v), nil
+	case int64:
+		return NewInt64(v), nil
+	case uint64:
+		return NewUint64(v), nil
+	case float64:
+		return NewFloat64(v), nil
+	case []interface{}:
+		elems := make([]ByteJson, len(v))
+		for i, e := range v {
+			bj, err := CreateByteJson(e)
+			if err != nil {
+				return ByteJson{}, err
+			}
+			elems[i] = bj
+		}
+		return NewArray(elems), nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		vals := make([]ByteJson, 0, len(v))
+		for key, e := range v {
+			bj, err := CreateByteJson(e)
+			if err != nil {
+				return ByteJson{}, err
+			}
+			keys = append(keys, key)
+			vals = append(vals, bj)
+		}
+		return NewObject(keys, vals)
+	}
+	return ByteJson{}, errors.New(errno.DatatypeMismatch, fmt.Sprintf("unsupported type %T for json", in))
+}
+
+func NewInt64(v int64) ByteJson {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, uint64(v))
+	return ByteJson{Type: TpCodeInt64, Data: data}
+}
+
+func NewUint64(v uint64) ByteJson {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, v)
+	return ByteJson{Type: TpCodeUint64, Data: data}
+}
+
+func NewFloat64(v float64) ByteJson {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, math.Float64bits(v))
+	return ByteJson{Type: TpCodeFloat64, Data: data}
+}
+
+func NewString(v string) ByteJson {
+	data := make([]byte, binary.MaxVarintLen64+len(v))
+	n := binary.PutUvarint(data, uint64(len(v)))
+	n += copy(data[n:], v)
+	return ByteJson{Type: TpCodeString, Data: data[:n]}
+}
+
+func NewArray(elems []ByteJson) ByteJson {
+	size := headerSize + len(elems)*valueEntrySize
+	for _, e := range elems {
+		size += len(e.Data)
+	}
+	data := make([]byte, headerSize+len(elems)*valueEntrySize, size)
+	binary.LittleEndian.PutUint32(data, uint32(len(elems)))
+	binary.LittleEndian.PutUint32(data[4:], uint32(size))
+	for i, e := range elems {
+		entry := data[headerSize+i*valueEntrySize:]
+		entry[0] = byte(e.Type)
+		binary.LittleEndian.PutUint32(entry[1:], uint32(len(data)))
+		data = append(data, e.Data...)
+	}
+	return ByteJson{Type: TpCodeArray, Data: data}
+}
+
+// NewObject creates an object of keys and values, the last value of a
+// duplicated key wins.
+func NewObject(keys []string, vals []ByteJson) (ByteJson, error) {
+	idx := make(map[string]int, len(keys))
+	for i, key := range keys {
+		if len(key) > math.MaxUint16 {
+			return ByteJson{}, errors.New(errno.DataException, "JSON key is too long")
+		}
+		idx[key] = i
+	}
+	sorted := make([]string, 0, len(idx))
+	for key := range idx {
+		sorted = append(sorted, key)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return compareKey(sorted[i], sorted[j]) < 0
+	})
+	n := len(sorted)
+	size := headerSize + n*(keyEntrySize+valueEntrySize)
+	for _, key := range sorted {
+		size += len(key) + len(vals[idx[key]].Data)
+	}
+	data := make([]byte, headerSize+n*(keyEntrySize+valueEntrySize), size)
+	binary.LittleEndian.PutUint32(data, uint32(n))
+	binary.LittleEndian.PutUint32(data[4:], uint32(size))
+	for i, key := range sorted {
+		entry := data[headerSize+i*keyEntrySize:]
+		binary.LittleEndian.PutUint32(entry, uint32(len(data)))
+		binary.LittleEndian.PutUint16(entry[4:], uint16(len(key)))
+		data = append(data, key...)
+	}
+	for i, key := range sorted {
+		v := vals[idx[key]]
+		entry := data[headerSize+n*keyEntrySize+i*valueEntrySize:]
+		entry[0] = byte(v.Type)
+		binary.LittleEndian.PutUint32(entry[1:], uint32(len(data)))
+		data = append(data, v.Data...)
+	}
+	return ByteJson{Type: TpCodeObject, Data: data}, nil
+}
+
+// compareKey orders the keys of an object as mysql does
+func compareKey(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Marshal returns the binary format of bj stored in a json column
+func (bj ByteJson) Marshal() []byte {
+	buf := make([]byte, len(bj.Data)+1)
+	buf[0] = byte(bj.Type)
+	copy(buf[1:], bj.Data)
+	return buf
+}
+
+// Unmarshal returns the json value of the binary format, the value shares
+// the memory of buf.
+func Unmarshal(buf []byte) (ByteJson, error) {
+	if len(buf) < 2 {
+		return ByteJson{}, errInvalidJsonData
+	}
+	bj := ByteJson{Type: TpCode(buf[0]), Data: buf[1:]}
+	if n, ok := valueLen(bj.Type, bj.Data); !ok || n != len(bj.Data) {
+		return ByteJson{}, errInvalidJsonData
+	}
+	return bj, nil
+}
+
+// valueLen returns the length of the data of a value of type tp at the
+// start of data
+func valueLen(tp TpCode, data []byte) (int, bool) {
+	switch tp {
+	case TpCodeLiteral:
+		return 1, len(data) >= 1
+	case TpCodeInt64, TpCodeUint64, TpCodeFloat64:
+		return 8, len(data) >= 8
+	case TpCodeString:
+		l, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < l {
+			return 0, false
+		}
+		return n + int(l), true
+	case TpCodeArray, TpCodeObject:
+		if len(data) < headerSize {
+			return 0, false
+		}
+		size := int(binary.LittleEndian.Uint32(data[4:]))
+		return size, size <= len(data)
+	}
+	return 0, false
+}
+
+func (bj ByteJson) GetInt64() int64 {
+	return int64(binary.LittleEndian.Uint64(bj.Data))
+}
+
+func (bj ByteJson) GetUint64() uint64 {
+	return binary.LittleEndian.Uint64(bj.Data)
+}
+
+func (bj ByteJson) GetFloat64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(bj.Data))
+}
+
+func (bj ByteJson) GetString() []byte {
+	l, n := binary.Uvarint(bj.Data)
+	return bj.Data[n : n+int(l)]
+}
+
+func (bj ByteJson) IsNull() bool {
+	return bj.Type == TpCodeLiteral && bj.Data[0] == LiteralNull
+}
+
+// Len returns the number of elements of an array or members of an object
+func (bj ByteJson) Len() int {
+	return int(binary.LittleEndian.Uint32(bj.Data))
+}
+
+// ArrayElem returns the ith element of an array
+func (bj ByteJson) ArrayElem(i int) ByteJson {
+	return bj.valueEntry(headerSize + i*valueEntrySize)
+}
+
+// ObjectKey returns the ith key of an object
+func (bj ByteJson) ObjectKey(i int) []byte {
+	entry := bj.Data[headerSize+i*keyEntrySize:]
+	off := binary.LittleEndian.Uint32(entry)
+	l := binary.LittleEndian.Uint16(entry[4:])
+	return bj.Data[off : off+uint32(l)]
+}
+
+// ObjectValue returns the value of the ith key of an object
+func (bj ByteJson) ObjectValue(i int) ByteJson {
+	return bj.valueEntry(headerSize + bj.Len()*keyEntrySize + i*valueEntrySize)
+}
+
+// ObjectGet returns the value of key of an object
+func (bj ByteJson) ObjectGet(key string) (ByteJson, bool) {
+	n := bj.Len()
+	i := sort.Search(n, func(i int) bool {
+		return compareKey(string(bj.ObjectKey(i)), key) >= 0
+	})
+	if i < n && string(bj.ObjectKey(i)) == key {
+		return bj.ObjectValue(i), true
+	}
+	return ByteJson{}, false
+}
+
+func (bj ByteJson) valueEntry(pos int) ByteJson {
+	tp := TpCode(bj.Data[pos])
+	off := binary.LittleEndian.Uint32(bj.Data[pos+1:])
+	n, _ := valueLen(tp, bj.Data[off:])
+	return ByteJson{Type: tp, Data: bj.Data[off : int(off)+n]}
+}
+
+// Unquote returns the text of a string without quotes and the json text of
+// other values
+func (bj ByteJson) Unquote() string {
+	if bj.Type == TpCodeString {
+		return string(bj.GetString())
+	}
+	return bj.String()
+}
+
+// String returns the json text in the format of mysql
+func (bj ByteJson) String() string {
+	var buf bytes.Buffer
+	bj.toString(&buf)
+	return buf.String()
+}
+
+func (bj ByteJson) toString(buf *bytes.Buffer) {
+	switch bj.Type {
+	case TpCodeLiteral:
+		switch bj.Data[0] {
+		case LiteralNull:
+			buf.WriteString("null")
+		case LiteralTrue:
+			buf.WriteString("true")
+		default:
+			buf.WriteString("false")
+		}
+	case TpCodeInt64:
+		buf.WriteString(strconv.FormatInt(bj.GetInt64(), 10))
+	case TpCodeUint64:
+		buf.WriteString(strconv.FormatUint(bj.GetUint64(), 10))
+	case TpCodeFloat64:
+		buf.WriteString(formatFloat(bj.GetFloat64()))
+	case TpCodeString:
+		quoteString(buf, bj.GetString())
+	case TpCodeArray:
+		buf.WriteByte('[')
+		for i, n := 0, bj.Len(); i < n; i++ {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			bj.ArrayElem(i).toString(buf)
+		}
+		buf.WriteByte(']')
+	case TpCodeObject:
+		buf.WriteByte('{')
+		for i, n := 0, bj.Len(); i < n; i++ {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			quoteString(buf, bj.ObjectKey(i))
+			buf.WriteString(": ")
+			bj.ObjectValue(i).toString(buf)
+		}
+		buf.WriteByte('}')
+	}
+}
+
+func formatFloat(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1e15 {
+		return strconv.FormatFloat(f, 'f', 1, 64)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+const hexDigits = "0123456789abcdef"
+
+func quoteString(buf *bytes.Buffer, s []byte) {
+	buf.WriteByte('"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			r, n := utf8.DecodeRune(s[i:])
+			if r == utf8.RuneError && n == 1 {
+				buf.WriteString(`�`)
+			} else {
+				buf.Write(s[i : i+n])
+			}
+			i += n
+			continue
+		}
+		switch c {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if c < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hexDigits[c>>4])
+				buf.WriteByte(hexDigits[c&0xf])
+			} else {
+				buf.WriteByte(c)
+			}
+		}
+		i++
+	}
+	buf.WriteByte('"')
+}
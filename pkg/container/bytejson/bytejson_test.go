@@ -0,0 +1,127 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	kases := []struct {
+		text string
+		want string
+	}{
+		{`null`, `null`},
+		{` true `, `true`},
+		{`-12`, `-12`},
+		{`18446744073709551615`, `18446744073709551615`},
+		{`1.5e3`, `1500.0`},
+		{`1e300`, `1e+300`},
+		{`"a\"b\n中"`, `"a\"b\n中"`},
+		{`[1, "a", [], {}]`, `[1, "a", [], {}]`},
+		{`{"bb": 1, "a": {"x": [true, null]}, "c": 2, "a": 3}`, `{"a": 3, "c": 2, "bb": 1}`},
+	}
+	for _, kase := range kases {
+		bj, err := ParseFromString(kase.text)
+		require.NoError(t, err, kase.text)
+		require.Equal(t, kase.want, bj.String())
+		other, err := Unmarshal(bj.Marshal())
+		require.NoError(t, err)
+		require.Equal(t, bj, other)
+	}
+	for _, text := range []string{``, `{`, `[1,]`, `{"a"}`, `1 2`, `abc`} {
+		_, err := ParseFromString(text)
+		require.Error(t, err, text)
+	}
+	_, err := Unmarshal([]byte{byte(TpCodeArray), 1, 0})
+	require.Error(t, err)
+}
+
+func TestPath(t *testing.T) {
+	for _, s := range []string{`$`, `$.a`, `$ . "b c"`, `$[0]`, `$[last - 1].x`, `$.*`, `$[*]`, `$**.a`} {
+		_, err := ParsePath(s)
+		require.NoError(t, err, s)
+	}
+	for _, s := range []string{``, `a`, `$.`, `$[`, `$[a]`, `$[-1]`, `$**`, `$."a`} {
+		_, err := ParsePath(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestExtract(t *testing.T) {
+	bj, err := ParseFromString(`{"a": 1, "b": [10, 20, {"c": "x"}], "d": {"c": "y"}, "e f": null}`)
+	require.NoError(t, err)
+	kases := []struct {
+		paths []string
+		want  string
+	}{
+		{[]string{`$.a`}, `1`},
+		{[]string{`$.b[1]`}, `20`},
+		{[]string{`$.b[last]`}, `{"c": "x"}`},
+		{[]string{`$.b[last-2]`}, `10`},
+		{[]string{`$.a[0]`}, `1`},
+		{[]string{`$."e f"`}, `null`},
+		{[]string{`$.b[*]`}, `[10, 20, {"c": "x"}]`},
+		{[]string{`$**.c`}, `["x", "y"]`},
+		{[]string{`$.a`, `$.d.c`}, `[1, "y"]`},
+		{[]string{`$.a`, `$.z`}, `[1]`},
+		{[]string{`$.z`}, ``},
+		{[]string{`$.b[3]`}, ``},
+	}
+	for _, kase := range kases {
+		ps := make([]*Path, len(kase.paths))
+		for i, s := range kase.paths {
+			ps[i], err = ParsePath(s)
+			require.NoError(t, err)
+		}
+		v, ok := bj.Extract(ps)
+		if kase.want == "" {
+			require.False(t, ok, kase.paths)
+			continue
+		}
+		require.True(t, ok, kase.paths)
+		require.Equal(t, kase.want, v.String(), kase.paths)
+	}
+	v, _ := bj.Extract([]*Path{{legs: []pathLeg{{typ: legKey, key: "d"}, {typ: legKey, key: "c"}}}})
+	require.Equal(t, "y", v.Unquote())
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		want      bool
+	}{
+		{`1`, `1.0`, true},
+		{`"a"`, `"a"`, true},
+		{`"a"`, `"b"`, false},
+		{`[1, 2, [3, 4]]`, `2`, true},
+		{`[1, 2, [3, 4]]`, `[1, 3]`, true},
+		{`[1, 2, [3, 4]]`, `[1, 5]`, false},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"b": {"c": 2}}`, true},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"a": 1, "d": 1}`, false},
+		{`{"a": 1}`, `1`, false},
+		{`[{"a": 1}]`, `{"a": 1}`, true},
+	}
+	for _, kase := range kases {
+		target, err := ParseFromString(kase.target)
+		require.NoError(t, err)
+		candidate, err := ParseFromString(kase.candidate)
+		require.NoError(t, err)
+		require.Equal(t, kase.want, target.Contains(candidate), "%s %s", kase.target, kase.candidate)
+	}
+}
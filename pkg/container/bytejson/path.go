@@ -0,0 +1,186 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type legType byte
+
+const (
+	legKey legType = iota
+	legIndex
+	legDoubleStar
+)
+
+// pathLeg is a step of a path, a key leg with an empty key and wildcard
+// set is .*, an index leg with wildcard set is [*] and fromLast means the
+// index is counted from the last element, i.e. [last-index].
+type pathLeg struct {
+	typ      legType
+	key      string
+	index    int
+	fromLast bool
+	wildcard bool
+}
+
+// Path is a json path expression, e.g. $.a[0], $.*, $[*] and $**.b
+type Path struct {
+	legs []pathLeg
+	// set if the path may match more than one value
+	wildcard bool
+}
+
+// ParsePath parses a json path expression of the syntax of mysql
+func ParsePath(s string) (*Path, error) {
+	p := &Path{}
+	s = strings.TrimSpace(s)
+	if len(s) == 0 || s[0] != '$' {
+		return nil, errInvalidPath
+	}
+	s = strings.TrimLeftFunc(s[1:], unicode.IsSpace)
+	for len(s) > 0 {
+		var leg pathLeg
+		var err error
+		switch {
+		case s[0] == '.':
+			s, leg, err = parseKeyLeg(strings.TrimLeftFunc(s[1:], unicode.IsSpace))
+		case s[0] == '[':
+			s, leg, err = parseIndexLeg(s[1:])
+		case strings.HasPrefix(s, "**"):
+			s, leg = s[2:], pathLeg{typ: legDoubleStar}
+		default:
+			err = errInvalidPath
+		}
+		if err != nil {
+			return nil, err
+		}
+		if leg.wildcard || leg.typ == legDoubleStar {
+			p.wildcard = true
+		}
+		p.legs = append(p.legs, leg)
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	}
+	if n := len(p.legs); n > 0 && p.legs[n-1].typ == legDoubleStar {
+		return nil, errInvalidPath
+	}
+	return p, nil
+}
+
+func parseKeyLeg(s string) (string, pathLeg, error) {
+	leg := pathLeg{typ: legKey}
+	switch {
+	case len(s) == 0:
+		return s, leg, errInvalidPath
+	case s[0] == '*':
+		leg.wildcard = true
+		return s[1:], leg, nil
+	case s[0] == '"':
+		end := 1
+		for ; end < len(s) && s[end] != '"'; end++ {
+			if s[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(s) {
+			return s, leg, errInvalidPath
+		}
+		if err := json.Unmarshal([]byte(s[:end+1]), &leg.key); err != nil {
+			return s, leg, errInvalidPath
+		}
+		return s[end+1:], leg, nil
+	}
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return r == '.' || r == '[' || r == '*' || unicode.IsSpace(r)
+	})
+	if end < 0 {
+		end = len(s)
+	}
+	if end == 0 {
+		return s, leg, errInvalidPath
+	}
+	leg.key = s[:end]
+	return s[end:], leg, nil
+}
+
+func parseIndexLeg(s string) (string, pathLeg, error) {
+	leg := pathLeg{typ: legIndex}
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return s, leg, errInvalidPath
+	}
+	idx := strings.TrimSpace(s[:end])
+	s = s[end+1:]
+	if idx == "*" {
+		leg.wildcard = true
+		return s, leg, nil
+	}
+	if strings.HasPrefix(idx, "last") {
+		leg.fromLast = true
+		idx = strings.TrimSpace(idx[4:])
+		if len(idx) == 0 {
+			return s, leg, nil
+		}
+		if idx[0] != '-' {
+			return s, leg, errInvalidPath
+		}
+		idx = strings.TrimSpace(idx[1:])
+	}
+	n, err := strconv.ParseUint(idx, 10, 31)
+	if err != nil {
+		return s, leg, errInvalidPath
+	}
+	leg.index = int(n)
+	return s, leg, nil
+}
+
+// Wildcard returns true if the path may match more than one value
+func (p *Path) Wildcard() bool {
+	return p.wildcard
+}
+
+func (p *Path) String() string {
+	var b strings.Builder
+	b.WriteByte('$')
+	for _, leg := range p.legs {
+		switch leg.typ {
+		case legKey:
+			if leg.wildcard {
+				b.WriteString(".*")
+			} else {
+				b.WriteByte('.')
+				b.WriteString(strconv.Quote(leg.key))
+			}
+		case legIndex:
+			switch {
+			case leg.wildcard:
+				b.WriteString("[*]")
+			case leg.fromLast && leg.index == 0:
+				b.WriteString("[last]")
+			case leg.fromLast:
+				b.WriteString("[last-" + strconv.Itoa(leg.index) + "]")
+			default:
+				b.WriteString("[" + strconv.Itoa(leg.index) + "]")
+			}
+		case legDoubleStar:
+			b.WriteString("**")
+		}
+	}
+	return b.String()
+}
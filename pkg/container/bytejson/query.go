@@ -0,0 +1,167 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+)
+
+// Find returns all the values matched by the path in document order
+func (bj ByteJson) Find(p *Path) []ByteJson {
+	return bj.find(p.legs, nil)
+}
+
+func (bj ByteJson) find(legs []pathLeg, rs []ByteJson) []ByteJson {
+	if len(legs) == 0 {
+		return append(rs, bj)
+	}
+	leg, rest := legs[0], legs[1:]
+	switch leg.typ {
+	case legKey:
+		if bj.Type != TpCodeObject {
+			return rs
+		}
+		if !leg.wildcard {
+			if v, ok := bj.ObjectGet(leg.key); ok {
+				rs = v.find(rest, rs)
+			}
+			return rs
+		}
+		for i, n := 0, bj.Len(); i < n; i++ {
+			rs = bj.ObjectValue(i).find(rest, rs)
+		}
+	case legIndex:
+		if bj.Type != TpCodeArray {
+			// a value which is not an array is treated as an array of
+			// the value itself
+			if leg.wildcard || leg.index == 0 {
+				rs = bj.find(rest, rs)
+			}
+			return rs
+		}
+		n := bj.Len()
+		if leg.wildcard {
+			for i := 0; i < n; i++ {
+				rs = bj.ArrayElem(i).find(rest, rs)
+			}
+			return rs
+		}
+		i := leg.index
+		if leg.fromLast {
+			i = n - 1 - leg.index
+		}
+		if i >= 0 && i < n {
+			rs = bj.ArrayElem(i).find(rest, rs)
+		}
+	case legDoubleStar:
+		rs = bj.find(rest, rs)
+		switch bj.Type {
+		case TpCodeArray:
+			for i, n := 0, bj.Len(); i < n; i++ {
+				rs = bj.ArrayElem(i).find(legs, rs)
+			}
+		case TpCodeObject:
+			for i, n := 0, bj.Len(); i < n; i++ {
+				rs = bj.ObjectValue(i).find(legs, rs)
+			}
+		}
+	}
+	return rs
+}
+
+// Extract returns the value matched by the paths as JSON_EXTRACT does, the
+// values are wrapped in an array unless there is only one path without
+// wildcards. ok is false if nothing is matched.
+func (bj ByteJson) Extract(ps []*Path) (ByteJson, bool) {
+	var rs []ByteJson
+	for _, p := range ps {
+		rs = bj.find(p.legs, rs)
+	}
+	if len(rs) == 0 {
+		return ByteJson{}, false
+	}
+	if len(ps) == 1 && !ps[0].wildcard {
+		return rs[0], true
+	}
+	return NewArray(rs), true
+}
+
+// Contains returns true if candidate is contained in bj as JSON_CONTAINS
+// does: a scalar contains an equal scalar, an array contains a value if
+// one of its elements contains the value or each element of the value if
+// the value is an array, an object contains an object if it has each key of
+// the object and the value of the key contains the value of the object.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		for i, n := 0, candidate.Len(); i < n; i++ {
+			v, ok := bj.ObjectGet(string(candidate.ObjectKey(i)))
+			if !ok || !v.Contains(candidate.ObjectValue(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			for i, n := 0, candidate.Len(); i < n; i++ {
+				if !bj.Contains(candidate.ArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i, n := 0, bj.Len(); i < n; i++ {
+			if bj.ArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return bj.scalarEqual(candidate)
+}
+
+func (bj ByteJson) scalarEqual(o ByteJson) bool {
+	if bj.isNumber() && o.isNumber() {
+		switch {
+		case bj.Type == TpCodeInt64 && o.Type == TpCodeInt64:
+			return bj.GetInt64() == o.GetInt64()
+		case bj.Type == TpCodeUint64 && o.Type == TpCodeUint64:
+			return bj.GetUint64() == o.GetUint64()
+		case bj.Type == TpCodeInt64 && o.Type == TpCodeUint64:
+			return bj.GetInt64() >= 0 && uint64(bj.GetInt64()) == o.GetUint64()
+		case bj.Type == TpCodeUint64 && o.Type == TpCodeInt64:
+			return o.GetInt64() >= 0 && uint64(o.GetInt64()) == bj.GetUint64()
+		}
+		return bj.toFloat64() == o.toFloat64()
+	}
+	return bj.Type == o.Type && bytes.Equal(bj.Data, o.Data)
+}
+
+func (bj ByteJson) isNumber() bool {
+	return bj.Type == TpCodeInt64 || bj.Type == TpCodeUint64 || bj.Type == TpCodeFloat64
+}
+
+func (bj ByteJson) toFloat64() float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	}
+	return bj.GetFloat64()
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// TpCode is the type of a json value
+type TpCode byte
+
+const (
+	TpCodeObject  TpCode = 0x01
+	TpCodeArray   TpCode = 0x03
+	TpCodeLiteral TpCode = 0x04
+	TpCodeInt64   TpCode = 0x09
+	TpCodeUint64  TpCode = 0x0a
+	TpCodeFloat64 TpCode = 0x0b
+	TpCodeString  TpCode = 0x0c
+)
+
+// literals of the type TpCodeLiteral
+const (
+	LiteralNull  byte = 0x00
+	LiteralTrue  byte = 0x01
+	LiteralFalse byte = 0x02
+)
+
+const (
+	headerSize     = 8 // count and size of an array or object
+	keyEntrySize   = 6 // offset and length of a key
+	valueEntrySize = 5 // type and offset of a value
+)
+
+// ByteJson is a json value in the binary format, it is stored as the type
+// code followed by Data.
+//
+// Data of a literal is one byte, of a number is 8 bytes in little endian and
+// of a string is its length as uvarint followed by the bytes. Data of an
+// array is
+//
+//	count(uint32) size(uint32) value-entry* value*
+//
+// and of an object is
+//
+//	count(uint32) size(uint32) key-entry* value-entry* key* value*
+//
+// where a key entry is the offset(uint32) and length(uint16) of a key and a
+// value entry is the type(byte) and offset(uint32) of a value, both offsets
+// are relative to the start of Data. The keys of an object are sorted by
+// length and then by bytes, so a key is found by binary search.
+type ByteJson struct {
+	Type TpCode
+	Data []byte
+}
+
+var (
+	errInvalidJson     = errors.New(errno.DataException, "Invalid JSON text")
+	errInvalidJsonData = errors.New(errno.DataException, "Invalid JSON binary data")
+	errInvalidPath     = errors.New(errno.DataException, "Invalid JSON path expression")
+)
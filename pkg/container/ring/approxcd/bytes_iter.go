@@ -52,7 +52,7 @@ func (b *fixedBytes) NextBytes() (data []byte, hasNext bool) {
 	if b.i >= b.dataLen {
 		return nil, false
 	}
-	if nulls.Contains(b.nsp, uint64(b.i)) {
+	if nulls.Contains(b.nsp, uint64(b.i/b.stride)) {
 		data, hasNext = nil, true
 	} else {
 		data, hasNext = b.data[b.i:b.i+b.stride], true
@@ -64,7 +64,7 @@ func (b *fixedBytes) NextBytes() (data []byte, hasNext bool) {
 func (b *fixedBytes) Foreach(f func([]byte)) {
 	if nulls.Any(b.nsp) {
 		for ; b.i < b.dataLen; b.i += b.stride {
-			if !nulls.Contains(b.nsp, uint64(b.i)) {
+			if !nulls.Contains(b.nsp, uint64(b.i/b.stride)) {
 				f(b.data[b.i : b.i+b.stride])
 			}
 		}
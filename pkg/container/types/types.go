@@ -158,6 +158,8 @@ func (t T) ToType() Type {
 		typ.Size = 24
 	case T_varchar:
 		typ.Size = 24
+	case T_json:
+		typ.Size = 24
 	case T_sel:
 		typ.Size = 8
 	case T_decimal64:
@@ -245,6 +247,8 @@ func (t T) OidString() string {
 		return "T_char"
 	case T_varchar:
 		return "T_varchar"
+	case T_json:
+		return "T_json"
 	case T_date:
 		return "T_date"
 	case T_datetime:
@@ -288,6 +292,8 @@ func (t T) GoType() string {
 		return "string"
 	case T_varchar:
 		return "string"
+	case T_json:
+		return "json"
 	case T_date:
 		return "date"
 	case T_datetime:
@@ -338,6 +344,8 @@ func (t T) TypeLen() int {
 		return 24
 	case T_varchar:
 		return 24
+	case T_json:
+		return 24
 	case T_sel:
 		return 8
 	case T_decimal64:
@@ -366,6 +374,8 @@ func (t T) FixedLength() int {
 		return -24
 	case T_varchar:
 		return -24
+	case T_json:
+		return -24
 	case T_sel:
 		return -8
 	}
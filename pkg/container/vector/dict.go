@@ -0,0 +1,56 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vector
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// Dict is the dictionary of a char/varchar vector read from dictionary
+// encoded column data, the value of row i is Values.Get(int64(Codes[i])).
+type Dict struct {
+	Values *types.Bytes
+	Codes  []uint32
+	// col and offsets identify the column data the dictionary belongs to
+	col     *types.Bytes
+	offsets []uint32
+}
+
+// SetDict attaches the dictionary to the vector, the column data of the
+// vector must be the data decoded from the dictionary.
+func SetDict(v *Vector, values *types.Bytes, codes []uint32) {
+	col := v.Col.(*types.Bytes)
+	v.Dict = &Dict{
+		Values:  values,
+		Codes:   codes,
+		col:     col,
+		offsets: col.Offsets,
+	}
+}
+
+// GetDict returns the dictionary of the vector, it returns nil if the vector
+// has no dictionary or its column data has been changed since SetDict.
+func GetDict(v *Vector) *Dict {
+	d := v.Dict
+	if d == nil {
+		return nil
+	}
+	col, ok := v.Col.(*types.Bytes)
+	if !ok || col != d.col || len(col.Offsets) != len(d.Codes) || len(col.Offsets) != len(d.offsets) {
+		return nil
+	}
+	if len(col.Offsets) > 0 && &col.Offsets[0] != &d.offsets[0] {
+		return nil
+	}
+	return d
+}
@@ -59,6 +59,7 @@ type Vector struct {
 	Typ  types.Type
 	Col  interface{}  // column data, encoded Data
 	Nsp  *nulls.Nulls // nulls list
+	Dict *Dict        // dictionary of the column data, see GetDict
 }
 
 // emptyInterface is the header for an interface{} value.
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"unsafe"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -272,7 +273,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeTimestampSlice(v.Data)[:0]
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		data, err := mheap.Alloc(m, int64(rows*len(ws.Data)/len(ws.Offsets)))
 		if err != nil {
@@ -3166,7 +3167,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar:
 		col := v.Col.(*types.Bytes)
 		if len(col.Offsets) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -3175,6 +3176,22 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%s\n", col.Get(0))
 			}
 		}
+	case types.T_json:
+		col := v.Col.(*types.Bytes)
+		rs := make([]string, len(col.Offsets))
+		for i := range rs {
+			if nulls.Contains(v.Nsp, uint64(i)) {
+				rs[i] = "null"
+			} else if bj, err := bytejson.Unmarshal(col.Get(int64(i))); err != nil {
+				rs[i] = err.Error()
+			} else {
+				rs[i] = bj.String()
+			}
+		}
+		if len(rs) == 1 {
+			return rs[0]
+		}
+		return fmt.Sprintf("%v-%s", rs, v.Nsp)
 	case types.T_decimal64:
 		col := v.Col.([]types.Decimal64)
 		if len(col) == 1 {
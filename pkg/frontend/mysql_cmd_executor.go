@@ -15,11 +15,14 @@
 package frontend
 
 import (
+	"encoding/binary"
 	"fmt"
+	"go/constant"
 	"os"
 	"runtime/pprof"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -27,17 +30,23 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
+	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/analyze"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -107,6 +116,7 @@ type outputQueue struct {
 	length  uint64
 	ep      *tree.ExportParam
 	lineStr []byte
+	binary  bool
 
 	getEmptyRowTime time.Duration
 	flushTime       time.Duration
@@ -168,6 +178,11 @@ func (o *outputQueue) flush() error {
 			logutil.Errorf("export to csv file error %v \n", err)
 			return err
 		}
+	} else if o.binary {
+		if err := o.proto.SendResultSetBinaryBatchRow(o.mrs, o.rowIdx); err != nil {
+			logutil.Errorf("flush error %v \n", err)
+			return err
+		}
 	} else {
 		//send group of row
 		if err := o.proto.SendResultSetTextBatchRowSpeedup(o.mrs, o.rowIdx); err != nil {
@@ -227,6 +242,8 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 
 	oq := NewOuputQueue(proto, mrs, uint64(countOfResultSet), ses.ep)
 	oq.reset()
+	//the result set of COM_STMT_EXECUTE is in the binary protocol
+	oq.binary = uint8(ses.Cmd) == COM_STMT_EXECUTE
 
 	row2colTime := time.Duration(0)
 
@@ -409,6 +426,17 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs.Get(int64(rowIndex))
 					}
 				}
+			case types.T_json:
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.(*types.Bytes)
+					bj, err := bytejson.Unmarshal(vs.Get(int64(rowIndex)))
+					if err != nil {
+						return err
+					}
+					row[i] = bj.String()
+				}
 			case types.T_date:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Date)
@@ -528,7 +556,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 func (mce *MysqlCmdExecutor) handleChangeDB(db string) error {
 	ses := mce.GetSession()
 	//TODO: check meta data
-	if _, err := ses.Pu.StorageEngine.Database(db); err != nil {
+	if _, err := mce.storageEngine().Database(db); err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, db)
 	}
@@ -724,7 +752,7 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		loadDb = ses.protocol.GetDatabaseName()
 	}
 
-	dbHandler, err := ses.Pu.StorageEngine.Database(loadDb)
+	dbHandler, err := mce.storageEngine().Database(loadDb)
 	if err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, loadDb)
@@ -840,10 +868,14 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
 	proto := mce.GetSession().protocol
 
+	if err = mce.handleSetAutocommit(sv); err != nil {
+		return err
+	}
+
 	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -852,52 +884,185 @@ func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
 }
 
 /*
-handle show variables
+Processes returns the connections of the server for information_schema.processlist
 */
-func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
-	var err error = nil
+func (mce *MysqlCmdExecutor) Processes() []infoschema.Process {
+	if mce.routineMgr == nil {
+		return nil
+	}
+	return mce.routineMgr.processes()
+}
+
+/*
+Variables returns the variables of the session for information_schema.session_variables.
+The variables except autocommit can not be changed now, they are the same as those answered by SELECT @@xxx.
+*/
+func (mce *MysqlCmdExecutor) Variables() []infoschema.Variable {
+	autocommit := "ON"
+	if ses := mce.GetSession(); ses != nil && !ses.autocommit {
+		autocommit = "OFF"
+	}
+	return []infoschema.Variable{
+		{Name: "autocommit", Value: autocommit},
+		{Name: "max_allowed_packet", Value: "16777216"},
+		{Name: "transaction_isolation", Value: "REPEATABLE-READ"},
+		{Name: "tx_isolation", Value: "REPEATABLE-READ"},
+		{Name: "version_comment", Value: "MatrixOne"},
+	}
+}
+
+//User returns the user of the session for information_schema
+func (mce *MysqlCmdExecutor) User() string {
+	if ses := mce.GetSession(); ses != nil && ses.protocol != nil {
+		return ses.protocol.GetUserName()
+	}
+	return ""
+}
+
+/*
+Privileges returns the privileges of the user of the session for information_schema.
+The built-in users hold all the privileges. Nothing is visible if the privileges
+can not be read.
+*/
+func (mce *MysqlCmdExecutor) Privileges() infoschema.Privileges {
 	ses := mce.GetSession()
-	proto := mce.GetSession().protocol
+	if ses == nil || ses.protocol == nil || ses.Pu == nil {
+		return nil
+	}
+	user, host := ses.protocol.GetUserName(), ses.protocol.GetUserHost()
+	if isBuiltinUser(ses.Pu.SV, user) || ses.Pu.ClusterCatalog == nil {
+		return nil
+	}
+	p, err := ses.Pu.ClusterCatalog.GetPrivileges(user, host)
+	if err != nil {
+		logutil.Errorf("get the privileges of %s failed. error:%v", accountName(user, host), err)
+		return &catalog.Privileges{}
+	}
+	return p
+}
 
-	col1 := new(MysqlColumn)
-	col1.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
-	col1.SetName("VARIABLE_NAME")
+//the storage engine with the information_schema of the session,
+//the operations are in the transaction of the session if there is one
+func (mce *MysqlCmdExecutor) storageEngine() engine.Engine {
+	return infoschema.New(&sessionEngine{ses: mce.GetSession()}, compile.Address, mce)
+}
 
-	col2 := new(MysqlColumn)
-	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
-	col2.SetName("VARIABLE_VALUE")
+/*
+handle "ANALYZE TABLE tbl [(col, ...)]"
+the statistics of the columns are collected by scanning the table and
+persisted by the storage engine for the optimizer.
+*/
+func (mce *MysqlCmdExecutor) handleAnalyzeStmt(epoch uint64, stmt *tree.AnalyzeStmt) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
 
-	ses.Mrs.AddColumn(col1)
-	ses.Mrs.AddColumn(col2)
+	dbName := string(stmt.Table.SchemaName)
+	if len(dbName) == 0 {
+		dbName = proto.GetDatabaseName()
+	}
+	tblName := string(stmt.Table.ObjectName)
+	db, err := mce.storageEngine().Database(dbName)
+	if err != nil {
+		return NewMysqlError(ER_BAD_DB_ERROR, dbName)
+	}
+	r, err := db.Relation(tblName)
+	if err != nil {
+		return NewMysqlError(ER_NO_SUCH_TABLE, dbName, tblName)
+	}
+	defer r.Close()
+
+	var attrs []string
+	if len(stmt.Cols) == 0 {
+		for _, def := range r.TableDefs() {
+			if v, ok := def.(*engine.AttributeDef); ok {
+				attrs = append(attrs, v.Attr.Name)
+			}
+		}
+	} else {
+		names := make(map[string]struct{})
+		for _, def := range r.TableDefs() {
+			if v, ok := def.(*engine.AttributeDef); ok {
+				names[v.Attr.Name] = struct{}{}
+			}
+		}
+		for _, col := range stmt.Cols {
+			if _, ok := names[string(col)]; !ok {
+				return NewMysqlError(ER_BAD_FIELD_ERROR, string(col), tblName)
+			}
+			attrs = append(attrs, string(col))
+		}
+	}
+
+	pc, err := mce.getPrivilegeChecker()
+	if err != nil {
+		return err
+	}
+	if pc != nil {
+		// analyze reads the columns and writes their statistics as MySQL does
+		for _, priv := range []struct {
+			typ  tree.PrivilegeType
+			name string
+		}{
+			{tree.PRIVILEGE_TYPE_STATIC_SELECT, "SELECT"},
+			{tree.PRIVILEGE_TYPE_STATIC_INSERT, "INSERT"},
+		} {
+			if pc.HasPrivilege(priv.typ, dbName, tblName, "") {
+				continue
+			}
+			for _, attr := range attrs {
+				if !pc.HasPrivilege(priv.typ, dbName, tblName, attr) {
+					return NewMysqlError(ER_TABLEACCESS_DENIED_ERROR, priv.name, proto.GetUserName(), proto.GetUserHost(), tblName)
+				}
+			}
+		}
+	}
+
+	msgType, msgText := "status", "OK"
+	if w, ok := r.(engine.StatisticsWriter); ok {
+		stats, err := analyze.Analyze(r, attrs, analyze.DefaultSampleSize, mheap.New(ses.GuestMmu))
+		if err != nil {
+			return err
+		}
+		if err = w.WriteStats(epoch, stats); err != nil {
+			return err
+		}
+	} else {
+		msgType, msgText = "note", "The storage engine for the table doesn't support analyze"
+	}
+
+	for _, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+	ses.Mrs.AddRow([]interface{}{dbName + "." + tblName, "analyze", msgType, msgText})
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
 	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
-
-	if err := proto.SendResponse(resp); err != nil {
+	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
-	return err
+	return nil
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
-	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.doComQuery(sql)
+/*
+handle "BACKUP TO 'target'"
+the storage engine copies its data into the target while it is running.
+*/
+func (mce *MysqlCmdExecutor) handleBackup(stmt *tree.Backup) error {
+	ses := mce.GetSession()
+	if err := mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_DYNAMIC_BACKUP_ADMIN, tree.PRIVILEGE_TYPE_STATIC_SUPER); err != nil {
+		return err
+	}
+	b, ok := ses.Pu.StorageEngine.(engine.Backuper)
+	if !ok {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "BACKUP with the storage engine")
+	}
+	if err := b.Backup(stmt.Target); err != nil {
+		return err
+	}
+	return mce.sendOkResponse()
 }
 
 func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
@@ -1056,6 +1221,11 @@ func buildMoExplainQuery(attrs []*plan.Attribute, buffer *explain.ExplainDataBuf
 
 type ComputationWrapperImpl struct {
 	exec *compile.Exec
+
+	//the prepared statement whose plan is cached after building,
+	//and the plan version before the plan is built
+	prepareStmt *PrepareStmt
+	planVersion uint64
 }
 
 func NewComputationWrapperImpl(e *compile.Exec) *ComputationWrapperImpl {
@@ -1094,7 +1264,26 @@ func (cw *ComputationWrapperImpl) GetAffectedRows() uint64 {
 
 func (cw *ComputationWrapperImpl) Compile(u interface{},
 	fill func(interface{}, *batch.Batch) error) error {
-	return cw.exec.Compile(u, fill)
+	if err := cw.exec.Compile(u, fill); err != nil {
+		return err
+	}
+	cw.cachePlan()
+	return nil
+}
+
+//BuildPlan builds the plan of the statement without compiling it
+func (cw *ComputationWrapperImpl) BuildPlan() error {
+	if err := cw.exec.BuildPlan(); err != nil {
+		return err
+	}
+	cw.cachePlan()
+	return nil
+}
+
+func (cw *ComputationWrapperImpl) cachePlan() {
+	if cw.prepareStmt != nil {
+		cw.prepareStmt.cachePlan(cw.exec.Database(), cw.planVersion, cw.exec.Plan())
+	}
 }
 
 func (cw *ComputationWrapperImpl) Run(ts uint64) error {
@@ -1118,39 +1307,152 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 	return cw, err
 }
 
-//execute query
-func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
-	ses := mce.GetSession()
-	proto := ses.GetMysqlProtocol()
-	pdHook := ses.GetEpochgc()
-	statementCount := uint64(1)
+/*
+GetComputationWrapperOfPrepareStmt gets the exec of the prepared statement from the computation engine.
+The statement has been parsed at preparing. The cached plan is executed with the values
+bound to its parameters if it is still valid, otherwise the plan is built with the values
+of the parameters.
+*/
+var GetComputationWrapperOfPrepareStmt = func(db, user string, stmt *PrepareStmt, values []interface{}, eng engine.Engine, proc *process.Process) (ComputationWrapper, error) {
+	return getComputationWrapperOfPrepareStmt(db, user, stmt, values, eng, proc)
+}
 
-	//pin the epoch with 1
-	epoch, _ := pdHook.IncQueryCountAtCurrentEpoch(statementCount)
-	defer func() {
-		pdHook.DecQueryCountAtEpoch(epoch, statementCount)
-	}()
+func getComputationWrapperOfPrepareStmt(db, user string, stmt *PrepareStmt, values []interface{}, eng engine.Engine, proc *process.Process) (*ComputationWrapperImpl, error) {
+	comp := compile.New(db, stmt.Sql, user, eng, proc)
+	if pn := stmt.getPlan(db); pn != nil {
+		ok, err := stmt.bindPlan(pn, values)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return NewComputationWrapperImpl(comp.BuildPlan(stmt.Stmt, pn)), nil
+		}
+	}
+	ast, err := stmt.bind(values)
+	if err != nil {
+		return nil, err
+	}
+	cw := NewComputationWrapperImpl(comp.BuildStatement(ast))
+	cw.prepareStmt = stmt
+	cw.planVersion = atomic.LoadUint64(&planVersion)
+	return cw, nil
+}
 
+//make the process for executing statements
+func (mce *MysqlCmdExecutor) newProcess() *process.Process {
+	ses := mce.GetSession()
 	proc := process.New(mheap.New(ses.GuestMmu))
 	proc.Id = mce.getNextProcessId()
 	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Lim.MaxRecursionDepth = ses.Pu.SV.GetProcessLimitationMaxRecursionDepth()
+	return proc
+}
+
+//execute query
+func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
 
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
 		proto.GetUserName(),
-		ses.Pu.StorageEngine,
-		proc)
+		mce.storageEngine(),
+		mce.newProcess())
 	if err != nil {
 		return NewMysqlError(ER_PARSE_ERROR, err,
 			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
 	}
 
+	return mce.executeComputations(cws)
+}
+
+//execute the prepared statement with the values of the parameters
+func (mce *MysqlCmdExecutor) doComStmtExecute(stmt *PrepareStmt, values []interface{}) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	cw, err := GetComputationWrapperOfPrepareStmt(proto.GetDatabaseName(),
+		proto.GetUserName(),
+		stmt,
+		values,
+		mce.storageEngine(),
+		mce.newProcess())
+	if err != nil {
+		return NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+
+	return mce.executeComputations([]ComputationWrapper{cw})
+}
+
+/*
+resolveColumnsOfPrepareStmt builds the plan of the prepared query to get the columns of its result set.
+The parameters are bound to NULL since their values are unknown at preparing.
+The plan of the statement without parameters is cached for the executions.
+*/
+func (mce *MysqlCmdExecutor) resolveColumnsOfPrepareStmt(stmt *PrepareStmt) (cols []interface{}, err error) {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	if _, ok := stmt.Stmt.(*tree.Select); !ok || proto.GetDatabaseName() == "" {
+		return nil, nil
+	}
+
+	if err = ses.beginStmt(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = ses.endStmt(err)
+	}()
+
+	cw, err := getComputationWrapperOfPrepareStmt(proto.GetDatabaseName(),
+		proto.GetUserName(),
+		stmt,
+		make([]interface{}, len(stmt.Params)),
+		mce.storageEngine(),
+		mce.newProcess())
+	if err != nil {
+		return nil, err
+	}
+	pc, err := mce.getPrivilegeChecker()
+	if err != nil {
+		return nil, err
+	}
+	if pc != nil {
+		cw.exec.SetPrivilegeChecker(pc)
+	}
+	if err = cw.BuildPlan(); err != nil {
+		return nil, err
+	}
+	return cw.GetColumns()
+}
+
+//execute the statements and send the results to the client
+func (mce *MysqlCmdExecutor) executeComputations(cws []ComputationWrapper) (retErr error) {
+	var err error
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	pdHook := ses.GetEpochgc()
+	statementCount := uint64(1)
+
+	//pin the epoch with 1
+	epoch, _ := pdHook.IncQueryCountAtCurrentEpoch(statementCount)
+	defer func() {
+		pdHook.DecQueryCountAtEpoch(epoch, statementCount)
+	}()
+
 	defer func() {
 		ses.Mrs = nil
 	}()
 
+	//the transaction of the failed statement is rolled back
+	defer func() {
+		if retErr != nil {
+			retErr = ses.endStmt(retErr)
+		}
+	}()
+
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
@@ -1210,13 +1512,25 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		}
 
+		switch stmt.(type) {
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			if err = mce.handleTxnStmt(stmt); err != nil {
+				return err
+			}
+
+			//next statement
+			continue
+		}
+
 		//check database
 		if proto.GetDatabaseName() == "" {
 			//if none database has been selected, database operations must be failed.
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
-				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar:
+				*tree.ShowStatus, *tree.ShowVariables, *tree.ShowProcessList, *tree.DropDatabase, *tree.Load,
+				*tree.Use, *tree.SetVar,
+				*tree.CreateUser, *tree.DropUser, *tree.AlterUser, *tree.SetPassword,
+				*tree.CreateRole, *tree.DropRole, *tree.Grant, *tree.Revoke, *tree.Backup:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
 					return NewMysqlError(ER_NO_DB_ERROR)
@@ -1230,6 +1544,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		}
 
+		switch stmt.(type) {
+		case *tree.Use, *tree.SetVar:
+		default:
+			if err = ses.beginStmt(); err != nil {
+				return err
+			}
+		}
+
 		var selfHandle = false
 
 		switch st := stmt.(type) {
@@ -1246,7 +1568,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		case *tree.DropDatabase:
 			// if the droped database is the same as the one in use, database must be reseted to empty.
 			if string(st.Name) == proto.GetDatabaseName() {
-				proto.SetUserName("")
+				proto.SetDatabaseName("")
 			}
 		case *tree.Load:
 			selfHandle = true
@@ -1260,34 +1582,87 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err != nil {
 				return err
 			}
-		case *tree.ShowVariables:
-			selfHandle = true
-			err = mce.handleShowVariables(st)
-			if err != nil {
-				return err
-			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
-			if err = mce.handleAnalyzeStmt(st); err != nil {
+			if err = mce.handleAnalyzeStmt(epoch, st); err != nil {
 				return err
 			}
 		case *tree.ExplainStmt:
+			// explain analyze is executed by the computation layer
+			if rewrite.IsExplainAnalyze(st) {
+				break
+			}
 			selfHandle = true
 			if err = mce.handleExplainStmt(st); err != nil {
 				return err
 			}
-		case *tree.ExplainAnalyze:
+		case *tree.CreateUser:
+			selfHandle = true
+			if err = mce.handleCreateUser(st); err != nil {
+				return err
+			}
+		case *tree.DropUser:
+			selfHandle = true
+			if err = mce.handleDropUser(st); err != nil {
+				return err
+			}
+		case *tree.AlterUser:
+			selfHandle = true
+			if err = mce.handleAlterUser(st); err != nil {
+				return err
+			}
+		case *tree.SetPassword:
 			selfHandle = true
-			return errors.New(errno.FeatureNotSupported, "not support explain analyze statment now")
+			if err = mce.handleSetPassword(st); err != nil {
+				return err
+			}
+		case *tree.CreateRole:
+			selfHandle = true
+			if err = mce.handleCreateRole(st); err != nil {
+				return err
+			}
+		case *tree.DropRole:
+			selfHandle = true
+			if err = mce.handleDropRole(st); err != nil {
+				return err
+			}
+		case *tree.Grant:
+			selfHandle = true
+			if err = mce.handleGrant(st); err != nil {
+				return err
+			}
+		case *tree.Revoke:
+			selfHandle = true
+			if err = mce.handleRevoke(st); err != nil {
+				return err
+			}
+		case *tree.Backup:
+			selfHandle = true
+			if err = mce.handleBackup(st); err != nil {
+				return err
+			}
 		}
 
 		if selfHandle {
+			invalidatePlans(stmt)
+			if err = ses.endStmt(nil); err != nil {
+				return err
+			}
 			continue
 		}
 		if err = cw.SetDatabaseName(proto.GetDatabaseName()); err != nil {
 			return err
 		}
 
+		//the privileges of the user are checked while building the plan
+		pc, er := mce.getPrivilegeChecker()
+		if er != nil {
+			return er
+		}
+		if cwi, ok := cw.(*ComputationWrapperImpl); ok && pc != nil {
+			cwi.exec.SetPrivilegeChecker(pc)
+		}
+
 		cmpBegin := time.Now()
 		if err = cw.Compile(ses, getDataFromPipeline); err != nil {
 			return err
@@ -1371,6 +1746,10 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
 			}
+			if err = ses.endStmt(nil); err != nil {
+				return err
+			}
+
 			/*
 				Step 3: Say goodbye
 				mysql COM_QUERY response: End after the data row has been sent.
@@ -1384,7 +1763,6 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
 			*tree.CreateIndex, *tree.DropIndex,
 			*tree.Insert, *tree.Update,
-			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
 			*tree.Load,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
@@ -1403,6 +1781,8 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
 			}
 
+			invalidatePlans(stmt)
+
 			//record ddl drop xxx after the success
 			switch stmt.(type) {
 			case *tree.DropTable, *tree.DropDatabase,
@@ -1411,6 +1791,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				pdHook.IncDDLCountAtEpoch(epoch, 1)
 			}
 
+			//the changes are committed before the client is answered
+			if err = ses.endStmt(nil); err != nil {
+				return err
+			}
+
 			/*
 				Step 2: Echo client
 			*/
@@ -1430,17 +1815,145 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				logutil.Infof("time of SendResponse %s", time.Since(echoTime).String())
 			}
 		}
+
+		if err = ses.endStmt(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//read the statement id from the data of COM_STMT_XXX
+func readStmtId(data []byte) (uint32, int, error) {
+	if len(data) < 4 {
+		return 0, 0, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	return binary.LittleEndian.Uint32(data), 4, nil
+}
+
+//handle COM_STMT_PREPARE
+//the statement is parsed once and cached in the session
+func (mce *MysqlCmdExecutor) handleStmtPrepare(sql string) error {
+	ses := mce.GetSession()
+	stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, sql)
+	if err != nil {
+		return NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	prepareStmt := ses.AddPrepareStmt(sql, stmt, params)
+	//the statement fails at executing if its plan can not be built,
+	//the columns are sent in the response of COM_STMT_EXECUTE then.
+	if prepareStmt.Columns, err = mce.resolveColumnsOfPrepareStmt(prepareStmt); err != nil {
+		logutil.Infof("resolve the columns of the prepared statement failed. error: %v", err)
+	}
+	return ses.GetMysqlProtocol().SendPrepareResponse(prepareStmt)
+}
+
+//handle COM_STMT_EXECUTE
+//the parameters are bound to the placeholders of a copy of the cached statement
+func (mce *MysqlCmdExecutor) handleStmtExecute(data []byte) error {
+	ses := mce.GetSession()
+	stmtId, pos, err := readStmtId(data)
+	if err != nil {
+		return err
+	}
+	stmt, err := ses.GetPrepareStmt(stmtId, "mysqld_stmt_execute")
+	if err != nil {
+		return err
+	}
+	//the long data is only for the next execution
+	defer stmt.ResetLongData()
+
+	values, err := ses.GetMysqlProtocol().ParseExecuteData(stmt, data, pos)
+	if err != nil {
+		return NewMysqlError(ER_MALFORMED_PACKET, err)
+	}
+	return mce.doComStmtExecute(stmt, values)
+}
+
+//handle COM_STMT_SEND_LONG_DATA
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(data []byte) error {
+	ses := mce.GetSession()
+	stmtId, pos, err := readStmtId(data)
+	if err != nil {
+		return err
+	}
+	stmt, err := ses.GetPrepareStmt(stmtId, "mysqld_stmt_send_long_data")
+	if err != nil {
+		return err
+	}
+	if len(data) < pos+2 {
+		return NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	paramIdx := binary.LittleEndian.Uint16(data[pos:])
+	if int(paramIdx) >= len(stmt.Params) {
+		return NewMysqlError(ER_WRONG_ARGUMENTS, "mysqld_stmt_send_long_data")
 	}
+	stmt.AppendLongData(paramIdx, data[pos+2:])
+	return nil
+}
+
+//handle COM_STMT_RESET
+func (mce *MysqlCmdExecutor) handleStmtReset(data []byte) error {
+	ses := mce.GetSession()
+	stmtId, _, err := readStmtId(data)
+	if err != nil {
+		return err
+	}
+	stmt, err := ses.GetPrepareStmt(stmtId, "mysqld_stmt_reset")
+	if err != nil {
+		return err
+	}
+	stmt.ResetLongData()
+	return nil
+}
 
+//handle COM_STMT_CLOSE
+func (mce *MysqlCmdExecutor) handleStmtClose(data []byte) error {
+	stmtId, _, err := readStmtId(data)
+	if err != nil {
+		return err
+	}
+	mce.GetSession().RemovePrepareStmt(stmtId)
 	return nil
 }
 
+/*
+makeParamExpr converts the value of the parameter into the literal
+in the same form as the parser makes.
+*/
+func makeParamExpr(value interface{}) tree.Expr {
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			abs := uint64(-(v + 1)) + 1
+			str := strconv.FormatUint(abs, 10)
+			return tree.NewUnaryExpr(tree.UNARY_MINUS, tree.NewNumVal(constant.MakeUint64(abs), str, false))
+		}
+		return tree.NewNumVal(constant.MakeUint64(uint64(v)), strconv.FormatInt(v, 10), false)
+	case uint64:
+		return tree.NewNumVal(constant.MakeUint64(v), strconv.FormatUint(v, 10), false)
+	case float64:
+		if v < 0 {
+			str := strconv.FormatFloat(-v, 'f', -1, 64)
+			return tree.NewUnaryExpr(tree.UNARY_MINUS, tree.NewNumValWithResFoalt(constant.MakeFloat64(-v), str, false, -v))
+		}
+		return tree.NewNumValWithResFoalt(constant.MakeFloat64(v), strconv.FormatFloat(v, 'f', -1, 64), false, v)
+	case string:
+		return tree.NewNumVal(constant.MakeString(v), v, false)
+	default:
+		return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
+	}
+}
+
 // ExecRequest the server execute the commands from the client following the mysql's routine
 func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 	var resp *Response = nil
 	logutil.Infof("cmd %v", req.GetCmd())
 
 	ses := mce.GetSession()
+	ses.Cmd = req.GetCmd()
 	if ses.Pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() {
 		pdHook := ses.GetEpochgc()
 		if !pdHook.CanAcceptSomething() {
@@ -1523,6 +2036,44 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 		resp = NewGeneralOkResponse(COM_PING)
 
 		return resp, nil
+	case COM_STMT_PREPARE:
+		var sql = string(req.GetData().([]byte))
+		mce.addSqlCount(1)
+		logutil.Infof("prepare:%s", SubStringFromBegin(sql, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+		err := mce.handleStmtPrepare(sql)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_PREPARE, err)
+		}
+		return resp, nil
+	case COM_STMT_EXECUTE:
+		mce.addSqlCount(1)
+		err := mce.handleStmtExecute(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
+		}
+		return resp, nil
+	case COM_STMT_SEND_LONG_DATA:
+		//no response for COM_STMT_SEND_LONG_DATA, the error is reported by COM_STMT_EXECUTE
+		err := mce.handleStmtSendLongData(req.GetData().([]byte))
+		if err != nil {
+			logutil.Errorf("send long data failed. error:%v", err)
+		}
+		return nil, nil
+	case COM_STMT_RESET:
+		err := mce.handleStmtReset(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_RESET, err)
+		} else {
+			resp = NewGeneralOkResponse(COM_STMT_RESET)
+		}
+		return resp, nil
+	case COM_STMT_CLOSE:
+		//no response for COM_STMT_CLOSE
+		err := mce.handleStmtClose(req.GetData().([]byte))
+		if err != nil {
+			logutil.Errorf("close statement failed. error:%v", err)
+		}
+		return nil, nil
 	default:
 		err := fmt.Errorf("unsupported command. 0x%x \n", req.GetCmd())
 		resp = NewGeneralErrorResponse(uint8(req.GetCmd()), err)
@@ -1578,6 +2129,8 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_json:
+		col.SetColumnType(defines.MYSQL_TYPE_JSON)
 	case types.T_date:
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
 	case types.T_datetime:
@@ -2,6 +2,7 @@ package frontend
 
 import (
 	"fmt"
+	"sync/atomic"
 	"testing"
 
 	"github.com/fagongzi/goetty/buf"
@@ -15,9 +16,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
@@ -482,10 +488,14 @@ func Test_mce(t *testing.T) {
 			data: []byte("test anywhere"),
 		}
 
+		proto.SetUserName("root")
 		mce.ses.Pu.SV.SetRejectWhenHeartbeatFromPDLeaderIsTimeout(true)
 		resp, err := mce.ExecRequest(req)
 		convey.So(err, convey.ShouldBeNil)
 		convey.So(resp, convey.ShouldBeNil)
+		//the database in use is dropped
+		convey.So(proto.GetDatabaseName(), convey.ShouldEqual, "")
+		convey.So(proto.GetUserName(), convey.ShouldEqual, "root")
 
 		req = &Request{
 			cmd:  int(COM_QUERY),
@@ -956,11 +966,55 @@ func Test_handleShowVariables(t *testing.T) {
 		}
 
 		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
-		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto}
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, Pu: pu}
 		mce := &MysqlCmdExecutor{}
 		mce.PrepareSessionBeforeExecRequest(ses)
 
-		convey.So(mce.handleShowVariables(nil), convey.ShouldBeNil)
+		// show variables reads the variables of the session from information_schema
+		showVariables := func(sql string) [][2]string {
+			var rows [][2]string
+
+			proc := process.New(mheap.New(guest.New(1<<20, host.New(1<<20))))
+			es, err := compile.New("test", sql, "", mce.storageEngine(), proc).Build()
+			convey.So(err, convey.ShouldBeNil)
+			err = es[0].Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+				if bat == nil || len(bat.Zs) == 0 {
+					return nil
+				}
+				n := len(bat.Zs)
+				if bat.Sels != nil {
+					n = len(bat.Sels)
+				}
+				names, values := make([]string, n), make([]string, n)
+				if err := bat.Vecs[0].GetColumnData(bat.Sels, bat.Zs, names); err != nil {
+					return err
+				}
+				if err := bat.Vecs[1].GetColumnData(bat.Sels, bat.Zs, values); err != nil {
+					return err
+				}
+				for i := range names {
+					rows = append(rows, [2]string{names[i], values[i]})
+				}
+				return nil
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(es[0].Run(0), convey.ShouldBeNil)
+			return rows
+		}
+		convey.So(showVariables("show variables like 'tx%'"), convey.ShouldResemble, [][2]string{{"tx_isolation", "REPEATABLE-READ"}})
+		convey.So(showVariables("show variables where Variable_name = 'autocommit'"), convey.ShouldResemble, [][2]string{{"autocommit", "OFF"}})
+		ses.autocommit = true
+		convey.So(showVariables("show variables like 'autocommit'"), convey.ShouldResemble, [][2]string{{"autocommit", "ON"}})
+	})
+}
+
+func Test_infoschemaSession(t *testing.T) {
+	convey.Convey("information_schema of the session succ", t, func() {
+		mce := &MysqlCmdExecutor{}
+		convey.So(mce.Processes(), convey.ShouldBeNil)
+		convey.So(mce.Variables(), convey.ShouldNotBeEmpty)
+		convey.So(commandName(COM_QUERY), convey.ShouldEqual, "Query")
+		convey.So(commandName(COM_SLEEP), convey.ShouldEqual, "Sleep")
 	})
 }
 
@@ -983,3 +1037,223 @@ func Test_GetComputationWrapper(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func Test_mce_prepareStmt(t *testing.T) {
+	convey.Convey("prepare and execute statement succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Database(gomock.Any()).Return(nil, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		var executed []string
+		stubs := gostub.Stub(&GetComputationWrapperOfPrepareStmt, func(db, user string, stmt *PrepareStmt, values []interface{}, eng engine.Engine, proc *process.Process) (ComputationWrapper, error) {
+			ast, err := stmt.bind(values)
+			if err != nil {
+				return nil, err
+			}
+			executed = append(executed, tree.String(ast, dialect.MYSQL))
+			select_1 := mock_frontend.NewMockComputationWrapper(ctrl)
+			select_1.EXPECT().GetAst().Return(ast).AnyTimes()
+			select_1.EXPECT().SetDatabaseName(gomock.Any()).Return(nil).AnyTimes()
+			select_1.EXPECT().Compile(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			select_1.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
+
+			cola := &MysqlColumn{}
+			cola.SetName("a")
+			cola.SetColumnType(defines.MYSQL_TYPE_LONG)
+			select_1.EXPECT().GetColumns().Return([]interface{}{cola}, nil).AnyTimes()
+			return select_1, nil
+		})
+		defer stubs.Reset()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		proto.SetDatabaseName("T")
+
+		epochgc := getPCI()
+
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+
+		ses := NewSession(proto, epochgc, guestMmu, pu.Mempool, pu)
+
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		req := &Request{
+			cmd:  int(COM_STMT_PREPARE),
+			data: []byte("select a from A where b = ? and c = ?"),
+		}
+		resp, err := mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+
+		ps, err := ses.GetPrepareStmt(1, "test")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(ps.Params), convey.ShouldEqual, 2)
+
+		//statement id, flags, iteration-count, null-bitmap, new-params-bound-flag, types, values
+		data := []byte{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1,
+			defines.MYSQL_TYPE_LONG, 0, defines.MYSQL_TYPE_VAR_STRING, 0,
+			0xfe, 0xff, 0xff, 0xff, 2, 'x', 'y'}
+		req = &Request{
+			cmd:  int(COM_STMT_EXECUTE),
+			data: data,
+		}
+		resp, err = mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+
+		//the long data is used by the next execution only
+		req = &Request{
+			cmd:  int(COM_STMT_SEND_LONG_DATA),
+			data: []byte{1, 0, 0, 0, 1, 0, 'z'},
+		}
+		resp, err = mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+
+		req = &Request{
+			cmd:  int(COM_STMT_EXECUTE),
+			data: []byte{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 3, 0, 0, 0},
+		}
+		resp, err = mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(ps.LongData, convey.ShouldBeNil)
+
+		convey.So(executed, convey.ShouldResemble, []string{
+			"select a from A where b = -2 and c = xy",
+			"select a from A where b = 3 and c = z",
+		})
+		//the values are bound to the copies of the statement
+		convey.So(tree.String(ps.Stmt, dialect.MYSQL), convey.ShouldEqual, "select a from A where b = ? and c = ?")
+
+		req = &Request{
+			cmd:  int(COM_STMT_RESET),
+			data: []byte{1, 0, 0, 0},
+		}
+		resp, err = mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+
+		req = &Request{
+			cmd:  int(COM_STMT_CLOSE),
+			data: []byte{1, 0, 0, 0},
+		}
+		resp, err = mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+
+		req = &Request{
+			cmd:  int(COM_STMT_EXECUTE),
+			data: []byte{1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0},
+		}
+		resp, err = mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		req = &Request{
+			cmd:  int(COM_STMT_PREPARE),
+			data: []byte("select from where ?"),
+		}
+		resp, err = mce.ExecRequest(req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+	})
+}
+
+func Test_prepareStmtPlan(t *testing.T) {
+	convey.Convey("cache the plan of the prepared statement", t, func() {
+		stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, "select a from t")
+		convey.So(err, convey.ShouldBeNil)
+		ses := &Session{}
+		ps := ses.AddPrepareStmt("select a from t", stmt, params)
+
+		pn := &plan.Query{Scope: &plan.Scope{}}
+		ps.cachePlan("db", atomic.LoadUint64(&planVersion), pn)
+		convey.So(ps.getPlan("db"), convey.ShouldEqual, pn)
+		convey.So(ps.getPlan("db2"), convey.ShouldBeNil)
+
+		//the plan is stale after the schema is changed
+		invalidatePlans(&tree.Select{})
+		convey.So(ps.getPlan("db"), convey.ShouldEqual, pn)
+		invalidatePlans(&tree.DropTable{})
+		convey.So(ps.getPlan("db"), convey.ShouldBeNil)
+
+		//the plan built before the schema is changed is not cached
+		version := atomic.LoadUint64(&planVersion)
+		invalidatePlans(&tree.CreateIndex{})
+		ps.cachePlan("db", version, pn)
+		convey.So(ps.getPlan("db"), convey.ShouldBeNil)
+	})
+
+	convey.Convey("bind the parameters to a copy of the statement", t, func() {
+		sql := "select a from t where b = ? limit ?"
+		stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, sql)
+		convey.So(err, convey.ShouldBeNil)
+		ses := &Session{}
+		ps := ses.AddPrepareStmt(sql, stmt, params)
+
+		ast1, err := ps.bind([]interface{}{int64(1), uint64(2)})
+		convey.So(err, convey.ShouldBeNil)
+		ast2, err := ps.bind([]interface{}{"x", nil})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tree.String(ast1, dialect.MYSQL), convey.ShouldEqual, "select a from t where b = 1 limit 2")
+		convey.So(tree.String(ast2, dialect.MYSQL), convey.ShouldEqual, "select a from t where b = x limit NULL")
+		convey.So(tree.String(ps.Stmt, dialect.MYSQL), convey.ShouldEqual, "select a from t where b = ? limit ?")
+
+		//the plan of the statement with parameters is cached too
+		pn := &plan.Query{Scope: &plan.Scope{}}
+		ps.cachePlan("db", atomic.LoadUint64(&planVersion), pn)
+		convey.So(ps.getPlan("db"), convey.ShouldEqual, pn)
+	})
+
+	convey.Convey("execute the cached plan with the values bound to its parameters", t, func() {
+		compile.InitAddress("127.0.0.1")
+		eng := memEngine.NewTestEngine()
+		sql := "select orderId from R where uid = ? and price > ?"
+		stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, sql)
+		convey.So(err, convey.ShouldBeNil)
+		ses := &Session{}
+		ps := ses.AddPrepareStmt(sql, stmt, params)
+
+		execute := func(values ...interface{}) (plan.Plan, int64) {
+			proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+			cw, err := getComputationWrapperOfPrepareStmt("test", "", ps, values, eng, proc)
+			convey.So(err, convey.ShouldBeNil)
+			var rows int64
+			err = cw.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+				for _, z := range bat.Zs {
+					rows += z
+				}
+				return nil
+			})
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cw.Run(0), convey.ShouldBeNil)
+			return cw.exec.Plan(), rows
+		}
+		pn, rows := execute(int64(1), int64(0))
+		convey.So(rows, convey.ShouldEqual, 5)
+		convey.So(ps.getPlan("test"), convey.ShouldEqual, pn)
+		pn2, rows := execute(int64(2), int64(10))
+		convey.So(pn2, convey.ShouldEqual, pn)
+		convey.So(rows, convey.ShouldEqual, 2)
+		pn2, rows = execute(int64(3), int64(-1))
+		convey.So(pn2, convey.ShouldEqual, pn)
+		convey.So(rows, convey.ShouldEqual, 5)
+
+		//the plan is built again for the values of other types
+		pn2, rows = execute(int64(1), 1.5)
+		convey.So(pn2, convey.ShouldNotEqual, pn)
+		convey.So(rows, convey.ShouldEqual, 4)
+	})
+}
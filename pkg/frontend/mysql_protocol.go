@@ -17,14 +17,17 @@ package frontend
 import (
 	"bytes"
 	"crypto/sha1"
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"time"
 	"unicode"
 
 	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -124,6 +127,14 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
+	//the header of the AuthMoreData packet
+	AuthMoreDataHeader byte = 0x01
+
+	//the caching_sha2_password authenticated the client with the scramble
+	CachingSha2PasswordFastAuthSuccess byte = 0x03
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -135,6 +146,10 @@ const (
 
 	// DefaultMySQLState is the default state of the mySQL
 	DefaultMySQLState string = "HY000"
+
+	// SSLRequestPacketLength is the length of the payload of the SSL request packet.
+	//capabilities(4) + max-packet size(4) + character set(1) + reserved(23)
+	SSLRequestPacketLength int = 32
 )
 
 type MysqlProtocol interface {
@@ -144,6 +159,15 @@ type MysqlProtocol interface {
 
 	SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error
 
+	//SendResultSetBinaryBatchRow the server send group row of the result set in the binary protocol
+	SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error
+
+	//SendPrepareResponse the server send the response of COM_STMT_PREPARE
+	SendPrepareResponse(stmt *PrepareStmt) error
+
+	//ParseExecuteData the server decodes the parameters from COM_STMT_EXECUTE
+	ParseExecuteData(stmt *PrepareStmt, data []byte, pos int) ([]interface{}, error)
+
 	//SendColumnDefinitionPacket the server send the column definition to the client
 	SendColumnDefinitionPacket(column Column, cmd int) error
 
@@ -264,6 +288,9 @@ type MysqlProtocolImpl struct {
 	//the user of the client
 	username string
 
+	//the host name of the account which the client logged in as, like '%' or 'localhost'
+	userHost string
+
 	//the default database for the client
 	database string
 
@@ -279,6 +306,15 @@ type MysqlProtocolImpl struct {
 	rowHandler
 
 	SV *config.SystemVariables
+
+	//the tls config of the server. nil means TLS is disabled.
+	tlsConfig *tls.Config
+
+	//whether the connection has been upgraded to TLS
+	tlsEstablished bool
+
+	//the catalog keeps the users except the built-in ones. nil means only the built-in users can connect.
+	catalog *catalog.Catalog
 }
 
 func (mp *MysqlProtocolImpl) GetDatabaseName() string {
@@ -297,6 +333,14 @@ func (mp *MysqlProtocolImpl) SetUserName(s string) {
 	mp.username = s
 }
 
+func (mp *MysqlProtocolImpl) GetUserHost() string {
+	return mp.userHost
+}
+
+func (mp *MysqlProtocolImpl) SetUserHost(s string) {
+	mp.userHost = s
+}
+
 func (mp *MysqlProtocolImpl) GetStats() string {
 	return fmt.Sprintf("flushCount %d %s",
 		mp.flushCount,
@@ -607,34 +651,134 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 	return bytes.Equal(hash1, auth)
 }
 
-//the server authenticate that the client can connect and use the database
-func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
-	var psw []byte
-	if mp.username == mp.SV.GetDumpuser() { //the user dump for test
-		psw = []byte(mp.SV.GetDumppassword())
+//the server authenticate that the client can connect and use the database.
+//the authPlugin is the authentication method used by the client.
+//the built-in users are configured in the system variables, the other users are kept in the catalog.
+func (mp *MysqlProtocolImpl) authenticateUser(authPlugin string, authResponse []byte) error {
+	var err error
+	if psw, ok := builtinUserPassword(mp.SV, mp.username); ok {
+		if authPlugin != AuthNativePassword {
+			if authResponse, err = mp.negotiateAuthenticationMethod(AuthNativePassword); err != nil {
+				return err
+			}
+		}
+		//the client sends nothing for the empty password
+		if len(psw) == 0 && len(authResponse) == 0 || mp.checkPassword([]byte(psw), mp.salt, authResponse) {
+			logutil.Infof("check password succeeded\n")
+			return nil
+		}
+		return fmt.Errorf("check password failed\n")
+	}
+
+	if mp.catalog == nil {
+		return fmt.Errorf("user %s does not exist", mp.username)
+	}
+	//the user logs in as the account of the name whose host name matches the client most specifically
+	host, _ := mp.Peer()
+	user, err := mp.catalog.MatchUser(mp.username, host)
+	if err == catalog.ErrUserNotExists {
+		return fmt.Errorf("host %s is not allowed to connect as user %s", host, mp.username)
 	}
+	if err != nil {
+		return err
+	}
+	mp.userHost = user.Host
 
-	//TO Check password
-	if mp.checkPassword(psw, mp.salt, authResponse) {
-		logutil.Infof("check password succeeded\n")
-	} else {
-		return fmt.Errorf("check password failed\n")
+	//the client is asked to authenticate with the method of the user
+	if authPlugin != user.AuthPlugin {
+		if authResponse, err = mp.negotiateAuthenticationMethod(user.AuthPlugin); err != nil {
+			return err
+		}
 	}
-	return nil
+
+	switch user.AuthPlugin {
+	case AuthNativePassword:
+		if checkNativePasswordHash(user.AuthString, mp.salt, authResponse) {
+			return nil
+		}
+	case AuthCachingSha2Password:
+		if checkCachingSha2PasswordHash(user.AuthString, mp.salt, authResponse) {
+			//the fast authentication succeeded, the OK packet follows it
+			return mp.writePackets([]byte{AuthMoreDataHeader, CachingSha2PasswordFastAuthSuccess})
+		}
+	default:
+		return fmt.Errorf("unsupported authentication method %s", user.AuthPlugin)
+	}
+	return fmt.Errorf("check password failed\n")
 }
 
 func (mp *MysqlProtocolImpl) setSequenceID(value uint8) {
 	mp.sequenceId = value
 }
 
+//the capabilities that the server advertises in the handshake
+func (mp *MysqlProtocolImpl) serverCapability() uint32 {
+	if mp.tlsConfig != nil {
+		return DefaultCapability | CLIENT_SSL
+	}
+	return DefaultCapability
+}
+
+//the client sends the SSL request packet instead of the handshake response
+//to upgrade the connection to TLS.
+//the SSL request packet is the header of the handshake response41.
+func (mp *MysqlProtocolImpl) isSSLRequest(payload []byte) bool {
+	if len(payload) != SSLRequestPacketLength {
+		return false
+	}
+	capabilities, _, ok := mp.io.ReadUint32(payload, 0)
+	return ok && capabilities&CLIENT_PROTOCOL_41 != 0 && capabilities&CLIENT_SSL != 0
+}
+
+//the server upgrades the connection to TLS after receiving the SSL request packet.
+//then the client sends the handshake response over TLS.
+func (mp *MysqlProtocolImpl) handleSSLRequest() error {
+	if mp.tlsConfig == nil {
+		return fmt.Errorf("the server does not support TLS")
+	}
+	if mp.tlsEstablished {
+		return fmt.Errorf("the connection has been upgraded to TLS")
+	}
+
+	conn, err := mp.tcpConn.RawConn()
+	if err != nil {
+		return err
+	}
+	upgradable, ok := conn.(*tlsUpgradableConn)
+	if !ok {
+		return fmt.Errorf("the connection can not be upgraded to TLS")
+	}
+
+	//the beginning of the TLS handshake may have been read into the buffer with the SSL request
+	var buffered []byte
+	if inBuf := mp.tcpConn.InBuf(); inBuf.Readable() > 0 {
+		if _, buffered, err = inBuf.ReadAll(); err != nil {
+			return err
+		}
+	}
+
+	if err = upgradable.upgrade(mp.tlsConfig, buffered); err != nil {
+		return fmt.Errorf("TLS handshake failed. error:%v", err)
+	}
+	mp.tlsEstablished = true
+	logutil.Infof("connection %d upgraded to TLS", mp.connectionID)
+	return nil
+}
+
 func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 	if len(payload) < 2 {
 		return fmt.Errorf("received a broken response packet")
 	}
 
+	//the connection without TLS is rejected
+	if mp.SV.GetRequireSecureTransport() && !mp.tlsEstablished {
+		fail := errorMsgRefer[ER_SECURE_TRANSPORT_REQUIRED]
+		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], fail.errorMsgOrFormat)
+		return fmt.Errorf("the connection without TLS is rejected")
+	}
+
 	var authResponse []byte
+	var authPlugin = AuthNativePassword
 	if capabilities, _, ok := mp.io.ReadUint16(payload, 0); !ok {
 		return fmt.Errorf("read capabilities from response packet failed")
 	} else if uint32(capabilities)&CLIENT_PROTOCOL_41 != 0 {
@@ -646,6 +790,9 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp41.authResponse
+		if len(resp41.clientPluginName) != 0 {
+			authPlugin = resp41.clientPluginName
+		}
 		mp.capability = DefaultCapability & resp41.capabilities
 
 		if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
@@ -678,7 +825,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		mp.database = resp320.database
 	}
 
-	if err := mp.authenticateUser(authResponse); err != nil {
+	if err := mp.authenticateUser(authPlugin, authResponse); err != nil {
 		fail := errorMsgRefer[ER_ACCESS_DENIED_ERROR]
 		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], "Access denied for user")
 		return err
@@ -711,8 +858,10 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	//int<1> filler 0
 	pos = mp.io.WriteUint8(data, pos, 0)
 
+	capability := mp.serverCapability()
+
 	//int<2>              capabilities flags (lower 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16(DefaultCapability&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16(capability&0xFFFF))
 
 	//int<1>              character set
 	pos = mp.io.WriteUint8(data, pos, utf8mb4BinCollationID)
@@ -721,7 +870,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((capability>>16)&0xFFFF))
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
@@ -848,9 +997,9 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(data []byte) (bool, resp
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		if info.clientPluginName != AuthNativePassword && info.clientPluginName != AuthCachingSha2Password {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(); err != nil {
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(AuthNativePassword); err != nil {
 				return false, info, fmt.Errorf("negotiate authentication method failed. error:%v", err)
 			}
 			info.clientPluginName = AuthNativePassword
@@ -992,9 +1141,9 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 //the server can send AuthSwitchRequest to ask client to use designated authentication method,
 //if both server and client support CLIENT_PLUGIN_AUTH capability.
 //return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod() ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
@@ -1302,7 +1451,7 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 					data = mp.appendStringLenEncOfInt64(data, value)
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_JSON:
 			if value, err2 := mrs.GetString(r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -1392,6 +1541,443 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	return err
 }
 
+//the server convert every row of the result set into the binary format of the mysql protocol
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/binary-protocol-resultset-row.html
+func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResultSet, r uint64) ([]byte, error) {
+	columnCount := mrs.GetColumnCount()
+
+	//NULL-bitmap, length: (column_count + 7 + 2) / 8
+	//the first two bits are reserved
+	nullBitmap := make([]byte, (columnCount+7+2)/8)
+	for i := uint64(0); i < columnCount; i++ {
+		if isNil, err := mrs.ColumnIsNull(r, i); err != nil {
+			return nil, err
+		} else if isNil {
+			nullBitmap[(i+2)/8] |= 1 << ((i + 2) % 8)
+		}
+	}
+
+	//packet header [00]
+	data = mp.appendUint8(data, defines.OKHeader)
+	data = mp.append(data, nullBitmap...)
+
+	var buf [8]byte
+	for i := uint64(0); i < columnCount; i++ {
+		if nullBitmap[(i+2)/8]&(1<<((i+2)%8)) != 0 {
+			continue
+		}
+
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+
+		switch mysqlColumn.ColumnType() {
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_JSON:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
+		case defines.MYSQL_TYPE_TINY:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint8(data, uint8(value))
+			}
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				mp.io.WriteUint16(buf[:], 0, uint16(value))
+				data = mp.append(data, buf[:2]...)
+			}
+		case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				mp.io.WriteUint32(buf[:], 0, uint32(value))
+				data = mp.append(data, buf[:4]...)
+			}
+		case defines.MYSQL_TYPE_LONGLONG:
+			if uint32(mysqlColumn.Flag())&defines.UNSIGNED_FLAG != 0 {
+				if value, err2 := mrs.GetUint64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					mp.io.WriteUint64(buf[:], 0, value)
+				}
+			} else {
+				if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					mp.io.WriteUint64(buf[:], 0, uint64(value))
+				}
+			}
+			data = mp.append(data, buf[:8]...)
+		case defines.MYSQL_TYPE_FLOAT:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				mp.io.WriteUint32(buf[:], 0, math.Float32bits(float32(value)))
+				data = mp.append(data, buf[:4]...)
+			}
+		case defines.MYSQL_TYPE_DOUBLE:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				mp.io.WriteUint64(buf[:], 0, math.Float64bits(value))
+				data = mp.append(data, buf[:8]...)
+			}
+		case defines.MYSQL_TYPE_DATE:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendDatetimeBinary(data, value.(types.Date).ToTime(), false)
+			}
+		case defines.MYSQL_TYPE_DATETIME:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendDatetimeBinary(data, value.(types.Datetime), true)
+			}
+		case defines.MYSQL_TYPE_TIMESTAMP:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				dt, err3 := types.ParseDatetime(value)
+				if err3 != nil {
+					return nil, err3
+				}
+				data = mp.appendDatetimeBinary(data, dt, true)
+			}
+		case defines.MYSQL_TYPE_TIME:
+			return nil, fmt.Errorf("unsupported MYSQL_TYPE_TIME")
+		default:
+			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
+		}
+	}
+	return data, nil
+}
+
+//append the date or the datetime in the binary protocol
+//length(1) year(2) month(1) day(1) [hour(1) minute(1) second(1) [micro_second(4)]]
+func (mp *MysqlProtocolImpl) appendDatetimeBinary(data []byte, dt types.Datetime, withClock bool) []byte {
+	var buf [12]byte
+	year, month, day, _ := dt.ToDate().Calendar(true)
+	pos := 1
+	pos = mp.io.WriteUint16(buf[:], pos, uint16(year))
+	pos = mp.io.WriteUint8(buf[:], pos, month)
+	pos = mp.io.WriteUint8(buf[:], pos, day)
+	if withClock {
+		hour, minute, sec := dt.Clock()
+		microSec := uint32(int64(dt) & 0xfffff)
+		pos = mp.io.WriteUint8(buf[:], pos, uint8(hour))
+		pos = mp.io.WriteUint8(buf[:], pos, uint8(minute))
+		pos = mp.io.WriteUint8(buf[:], pos, uint8(sec))
+		if microSec != 0 {
+			pos = mp.io.WriteUint32(buf[:], pos, microSec)
+		}
+	}
+	buf[0] = uint8(pos - 1)
+	return mp.append(data, buf[:pos]...)
+}
+
+//the server send group row of the result set in the binary protocol
+//thread safe
+func (mp *MysqlProtocolImpl) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+	var err error = nil
+
+	for i := uint64(0); i < cnt; i++ {
+		err = mp.openRow(nil)
+		if err != nil {
+			return err
+		}
+
+		_, err = mp.makeResultSetBinaryRow(nil, mrs, i)
+		if err != nil {
+			//ERR_Packet in case of error
+			err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
+			if err1 != nil {
+				return err1
+			}
+			return err
+		}
+
+		err = mp.closeRow(nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+//make the COM_STMT_PREPARE_OK packet
+func (mp *MysqlProtocolImpl) makePrepareOkPayload(stmt *PrepareStmt) []byte {
+	data := make([]byte, HeaderOffset+12)
+	pos := HeaderOffset
+	//int<1> status [00] OK
+	pos = mp.io.WriteUint8(data, pos, defines.OKHeader)
+	//int<4> statement_id
+	pos = mp.io.WriteUint32(data, pos, stmt.Id)
+	//int<2> num_columns
+	pos = mp.io.WriteUint16(data, pos, uint16(len(stmt.Columns)))
+	//int<2> num_params
+	pos = mp.io.WriteUint16(data, pos, uint16(len(stmt.Params)))
+	//int<1> reserved_1 [00] filler
+	pos = mp.io.WriteUint8(data, pos, 0)
+	//int<2> warning_count
+	pos = mp.io.WriteUint16(data, pos, 0)
+	return data[:pos]
+}
+
+//the server send the response of COM_STMT_PREPARE
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-prepare-response.html
+func (mp *MysqlProtocolImpl) SendPrepareResponse(stmt *PrepareStmt) error {
+	err := mp.writePackets(mp.makePrepareOkPayload(stmt))
+	if err != nil {
+		return err
+	}
+
+	//num_params * Protocol::ColumnDefinition packets
+	if len(stmt.Params) > 0 {
+		for range stmt.Params {
+			col := new(MysqlColumn)
+			col.SetName("?")
+			col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+			err = mp.SendColumnDefinitionPacket(col, int(COM_STMT_PREPARE))
+			if err != nil {
+				return err
+			}
+		}
+		if err = mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+
+	//num_columns * Protocol::ColumnDefinition packets
+	if len(stmt.Columns) > 0 {
+		for _, c := range stmt.Columns {
+			err = mp.SendColumnDefinitionPacket(c.(Column), int(COM_STMT_PREPARE))
+			if err != nil {
+				return err
+			}
+		}
+		if err = mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//the server decodes the parameters of COM_STMT_EXECUTE.
+//the pos is the position after the statement id.
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+//return the values of the parameters: nil, int64, uint64, float64 or string
+func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte, pos int) ([]interface{}, error) {
+	var ok bool
+	//int<1> flags
+	//the cursor is not supported. the result set is always sent at once.
+	if _, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+		return nil, fmt.Errorf("get flags failed")
+	}
+
+	//int<4> iteration-count, always 1
+	if _, pos, ok = mp.io.ReadUint32(data, pos); !ok {
+		return nil, fmt.Errorf("get iteration-count failed")
+	}
+
+	numParams := len(stmt.Params)
+	if numParams == 0 {
+		return nil, nil
+	}
+
+	//NULL-bitmap, length: (num-params+7)/8
+	var nullBitmap []byte
+	if nullBitmap, pos, ok = mp.readCountOfBytes(data, pos, (numParams+7)/8); !ok {
+		return nil, fmt.Errorf("get null-bitmap failed")
+	}
+
+	//int<1> new-params-bound-flag
+	var newParamsBound uint8
+	if newParamsBound, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+		return nil, fmt.Errorf("get new-params-bound-flag failed")
+	}
+
+	if newParamsBound == 1 {
+		//type of each parameter, length: num-params * 2
+		var paramTypes []byte
+		if paramTypes, pos, ok = mp.readCountOfBytes(data, pos, numParams*2); !ok {
+			return nil, fmt.Errorf("get types of parameters failed")
+		}
+		stmt.ParamTypes = append(stmt.ParamTypes[:0], paramTypes...)
+	}
+
+	if len(stmt.ParamTypes) != numParams*2 {
+		return nil, fmt.Errorf("the types of parameters have not been bound")
+	}
+
+	values := make([]interface{}, numParams)
+	for i := 0; i < numParams; i++ {
+		if nullBitmap[i/8]&(1<<(i%8)) != 0 {
+			continue
+		}
+
+		if longData, ok := stmt.LongData[uint16(i)]; ok {
+			values[i] = string(longData)
+			continue
+		}
+
+		var err error
+		typ := stmt.ParamTypes[i*2]
+		unsigned := stmt.ParamTypes[i*2+1]&0x80 != 0
+		if values[i], pos, err = mp.readBinaryValue(data, pos, typ, unsigned); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+//read a value in the binary protocol from the buffer at the position
+//return the value ; position + the count of bytes of the value
+func (mp *MysqlProtocolImpl) readBinaryValue(data []byte, pos int, typ uint8, unsigned bool) (interface{}, int, error) {
+	var ok bool
+	switch typ {
+	case defines.MYSQL_TYPE_NULL:
+		return nil, pos, nil
+	case defines.MYSQL_TYPE_TINY:
+		var v uint8
+		if v, pos, ok = mp.io.ReadUint8(data, pos); ok {
+			if unsigned {
+				return uint64(v), pos, nil
+			}
+			return int64(int8(v)), pos, nil
+		}
+	case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+		var v uint16
+		if v, pos, ok = mp.io.ReadUint16(data, pos); ok {
+			if unsigned {
+				return uint64(v), pos, nil
+			}
+			return int64(int16(v)), pos, nil
+		}
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		var v uint32
+		if v, pos, ok = mp.io.ReadUint32(data, pos); ok {
+			if unsigned {
+				return uint64(v), pos, nil
+			}
+			return int64(int32(v)), pos, nil
+		}
+	case defines.MYSQL_TYPE_LONGLONG:
+		var v uint64
+		if v, pos, ok = mp.io.ReadUint64(data, pos); ok {
+			if unsigned {
+				return v, pos, nil
+			}
+			return int64(v), pos, nil
+		}
+	case defines.MYSQL_TYPE_FLOAT:
+		var v uint32
+		if v, pos, ok = mp.io.ReadUint32(data, pos); ok {
+			return float64(math.Float32frombits(v)), pos, nil
+		}
+	case defines.MYSQL_TYPE_DOUBLE:
+		var v uint64
+		if v, pos, ok = mp.io.ReadUint64(data, pos); ok {
+			return math.Float64frombits(v), pos, nil
+		}
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL,
+		defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+		defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB, defines.MYSQL_TYPE_BLOB,
+		defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET, defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_BIT:
+		var v string
+		if v, pos, ok = mp.readStringLenEnc(data, pos); ok {
+			return v, pos, nil
+		}
+	case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return mp.readDatetimeBinary(data, pos)
+	case defines.MYSQL_TYPE_TIME:
+		return mp.readTimeBinary(data, pos)
+	default:
+		return nil, pos, fmt.Errorf("unsupported parameter type %d", typ)
+	}
+	return nil, pos, fmt.Errorf("get the value of parameter type %d failed", typ)
+}
+
+//read the date or the datetime in the binary protocol
+//return the string like 'yyyy-mm-dd hh:mm:ss.msec'
+func (mp *MysqlProtocolImpl) readDatetimeBinary(data []byte, pos int) (interface{}, int, error) {
+	var ok bool
+	var length uint8
+	var year uint16
+	var month, day, hour, minute, sec uint8
+	var microSec uint32
+	if length, pos, ok = mp.io.ReadUint8(data, pos); !ok || pos+int(length) > len(data) {
+		return nil, pos, fmt.Errorf("get the datetime value failed")
+	}
+	if length >= 4 {
+		year, pos, _ = mp.io.ReadUint16(data, pos)
+		month, pos, _ = mp.io.ReadUint8(data, pos)
+		day, pos, _ = mp.io.ReadUint8(data, pos)
+	}
+	if length >= 7 {
+		hour, pos, _ = mp.io.ReadUint8(data, pos)
+		minute, pos, _ = mp.io.ReadUint8(data, pos)
+		sec, pos, _ = mp.io.ReadUint8(data, pos)
+	}
+	if length >= 11 {
+		microSec, pos, _ = mp.io.ReadUint32(data, pos)
+	}
+	if length < 7 {
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day), pos, nil
+	}
+	if microSec != 0 {
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", year, month, day, hour, minute, sec, microSec), pos, nil
+	}
+	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, minute, sec), pos, nil
+}
+
+//read the time in the binary protocol
+//return the string like '[-]hh:mm:ss.msec'
+func (mp *MysqlProtocolImpl) readTimeBinary(data []byte, pos int) (interface{}, int, error) {
+	var ok bool
+	var length, isNegative, hour, minute, sec uint8
+	var days, microSec uint32
+	if length, pos, ok = mp.io.ReadUint8(data, pos); !ok || pos+int(length) > len(data) {
+		return nil, pos, fmt.Errorf("get the time value failed")
+	}
+	if length >= 8 {
+		isNegative, pos, _ = mp.io.ReadUint8(data, pos)
+		days, pos, _ = mp.io.ReadUint32(data, pos)
+		hour, pos, _ = mp.io.ReadUint8(data, pos)
+		minute, pos, _ = mp.io.ReadUint8(data, pos)
+		sec, pos, _ = mp.io.ReadUint8(data, pos)
+	}
+	if length >= 12 {
+		microSec, pos, _ = mp.io.ReadUint32(data, pos)
+	}
+	sign := ""
+	if isNegative == 1 {
+		sign = "-"
+	}
+	hours := days*24 + uint32(hour)
+	if microSec != 0 {
+		return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, hours, minute, sec, microSec), pos, nil
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hours, minute, sec), pos, nil
+}
+
 //open a new row of the resultset
 func (mp *MysqlProtocolImpl) openRow(_ []byte) error {
 	if mp.enableLog {
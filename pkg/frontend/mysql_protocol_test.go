@@ -31,6 +31,8 @@ import (
 	fuzz "github.com/google/gofuzz"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/stretchr/testify/require"
 
 	"database/sql"
@@ -2053,3 +2055,128 @@ func Test_handleHandshake_Recover(t *testing.T) {
 		}
 	})
 }
+
+func appendUint16(data []byte, v uint16) []byte {
+	var b [2]byte
+	binary.LittleEndian.PutUint16(b[:], v)
+	return append(data, b[:]...)
+}
+
+func appendUint64(data []byte, v uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return append(data, b[:]...)
+}
+
+func Test_binaryProtocol(t *testing.T) {
+	cvey.Convey("send result set binary batch row succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		res := make8ColumnsResultSet()
+
+		err = proto.SendResultSetBinaryBatchRow(res, uint64(len(res.Data)))
+		cvey.So(err, cvey.ShouldBeNil)
+	})
+
+	cvey.Convey("send prepare response succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, "select a from t where b = ? and c = ?")
+		cvey.So(err, cvey.ShouldBeNil)
+
+		ses := &Session{}
+		ps := ses.AddPrepareStmt("", stmt, params)
+		err = proto.SendPrepareResponse(ps)
+		cvey.So(err, cvey.ShouldBeNil)
+
+		//the columns of the result set follow the parameters
+		cola := &MysqlColumn{}
+		cola.SetName("a")
+		cola.SetColumnType(defines.MYSQL_TYPE_LONG)
+		ps.Columns = []interface{}{cola}
+		payload := proto.makePrepareOkPayload(ps)
+		cvey.So(binary.LittleEndian.Uint16(payload[HeaderOffset+5:]), cvey.ShouldEqual, 1)
+		cvey.So(binary.LittleEndian.Uint16(payload[HeaderOffset+7:]), cvey.ShouldEqual, 2)
+		err = proto.SendPrepareResponse(ps)
+		cvey.So(err, cvey.ShouldBeNil)
+	})
+
+	cvey.Convey("parse execute data succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, "insert into t values (?, ?, ?, ?, ?)")
+		cvey.So(err, cvey.ShouldBeNil)
+
+		ses := &Session{}
+		ps := ses.AddPrepareStmt("", stmt, params)
+
+		var data []byte
+		//flags, iteration-count
+		data = append(data, 0, 1, 0, 0, 0)
+		//null-bitmap: the second parameter is null
+		data = append(data, 0x02)
+		//new-params-bound-flag and the types
+		data = append(data, 1,
+			defines.MYSQL_TYPE_LONGLONG, 0,
+			defines.MYSQL_TYPE_LONG, 0,
+			defines.MYSQL_TYPE_VAR_STRING, 0,
+			defines.MYSQL_TYPE_DOUBLE, 0,
+			defines.MYSQL_TYPE_SHORT, 0x80)
+		//the values
+		data = appendUint64(data, uint64(math.MaxUint64-4))
+		data = append(data, 3, 'a', 'b', 'c')
+		data = appendUint64(data, math.Float64bits(1.5))
+		data = appendUint16(data, 65535)
+
+		values, err := proto.ParseExecuteData(ps, data, 0)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(values, cvey.ShouldResemble, []interface{}{int64(-5), nil, "abc", float64(1.5), uint64(65535)})
+
+		//the types are remembered and the long data takes the place of the value
+		ps.AppendLongData(2, []byte("long "))
+		ps.AppendLongData(2, []byte("data"))
+		data = append([]byte{0, 1, 0, 0, 0, 0x02, 0}, data[17:25]...)
+		data = appendUint64(data, math.Float64bits(2.5))
+		data = appendUint16(data, 1)
+		values, err = proto.ParseExecuteData(ps, data, 0)
+		cvey.So(err, cvey.ShouldBeNil)
+		cvey.So(values, cvey.ShouldResemble, []interface{}{int64(-5), nil, "long data", float64(2.5), uint64(1)})
+
+		//the data is incomplete
+		_, err = proto.ParseExecuteData(ps, data[:len(data)-1], 0)
+		cvey.So(err, cvey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,617 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+//builtinUserPassword returns the password of the built-in user.
+//the built-in users are the root and the dump user configured in the system variables,
+//they hold all the privileges.
+func builtinUserPassword(sv *config.SystemVariables, name string) (string, bool) {
+	switch {
+	case len(name) == 0:
+		return "", false
+	case name == sv.GetRootname():
+		return sv.GetRootpassword(), true
+	case name == sv.GetDumpuser():
+		return sv.GetDumppassword(), true
+	}
+	return "", false
+}
+
+func isBuiltinUser(sv *config.SystemVariables, name string) bool {
+	_, ok := builtinUserPassword(sv, name)
+	return ok
+}
+
+//hashPassword hashes the password with the authentication method.
+//mysql_native_password keeps SHA1(SHA1(password)),
+//caching_sha2_password keeps SHA256(SHA256(password)) which is enough for the fast authentication.
+//the empty password is kept as the empty hash.
+func hashPassword(authPlugin, password string) ([]byte, error) {
+	if len(password) == 0 {
+		return nil, nil
+	}
+	switch authPlugin {
+	case AuthNativePassword:
+		hash1 := sha1.Sum([]byte(password))
+		hash2 := sha1.Sum(hash1[:])
+		return hash2[:], nil
+	case AuthCachingSha2Password:
+		hash1 := sha256.Sum256([]byte(password))
+		hash2 := sha256.Sum256(hash1[:])
+		return hash2[:], nil
+	}
+	return nil, NewMysqlError(ER_PLUGIN_IS_NOT_LOADED, authPlugin)
+}
+
+//checkNativePasswordHash checks the response of mysql_native_password with the hashed password.
+//auth = SHA1(password) XOR SHA1(salt + SHA1(SHA1(password)))
+func checkNativePasswordHash(hash, salt, auth []byte) bool {
+	if len(hash) == 0 {
+		return len(auth) == 0
+	}
+	if len(auth) != sha1.Size {
+		return false
+	}
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(hash)
+	hash1 := sha.Sum(nil)
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+	hash2 := sha1.Sum(hash1)
+	return bytes.Equal(hash2[:], hash)
+}
+
+//checkCachingSha2PasswordHash checks the scramble of caching_sha2_password with the hashed password.
+//auth = SHA256(password) XOR SHA256(SHA256(SHA256(password)) + salt)
+func checkCachingSha2PasswordHash(hash, salt, auth []byte) bool {
+	if len(hash) == 0 {
+		return len(auth) == 0
+	}
+	if len(auth) != sha256.Size {
+		return false
+	}
+	//some clients scramble with the terminating zero of the salt in the AuthSwitchRequest
+	for _, s := range [][]byte{salt, append(append([]byte{}, salt...), 0)} {
+		sha := sha256.New()
+		sha.Write(hash)
+		sha.Write(s)
+		hash1 := sha.Sum(nil)
+		for i := range hash1 {
+			hash1[i] ^= auth[i]
+		}
+		hash2 := sha256.Sum256(hash1)
+		if bytes.Equal(hash2[:], hash) {
+			return true
+		}
+	}
+	return false
+}
+
+//makeAuthString returns the authentication method and the hashed password of the user.
+//the default authentication method is mysql_native_password.
+func makeAuthString(u *tree.User) (string, []byte, error) {
+	authPlugin := u.AuthPlugin
+	if len(authPlugin) == 0 {
+		authPlugin = AuthNativePassword
+	}
+	if u.ByAuth || len(u.HashString) == 0 {
+		hash, err := hashPassword(authPlugin, u.AuthString)
+		return authPlugin, hash, err
+	}
+
+	//the hashed password of mysql_native_password is in the form of '*' + HEX(SHA1(SHA1(password)))
+	if authPlugin != AuthNativePassword {
+		return "", nil, NewMysqlError(ER_PLUGIN_IS_NOT_LOADED, authPlugin)
+	}
+	if len(u.HashString) != 2*sha1.Size+1 || u.HashString[0] != '*' {
+		return "", nil, NewMysqlError(ER_PASSWORD_FORMAT)
+	}
+	hash, err := hex.DecodeString(u.HashString[1:])
+	if err != nil {
+		return "", nil, NewMysqlError(ER_PASSWORD_FORMAT)
+	}
+	return authPlugin, hash, nil
+}
+
+func accountName(name, host string) string {
+	return fmt.Sprintf("'%s'@'%s'", name, host)
+}
+
+//isCurrentAccount checks whether the account is the one which the user of the session logged in as.
+//the host names are case-insensitive and the empty one is '%'.
+func isCurrentAccount(proto Protocol, name, host string) bool {
+	current := proto.GetUserHost()
+	if len(current) == 0 {
+		current = "%"
+	}
+	if len(host) == 0 {
+		host = "%"
+	}
+	return name == proto.GetUserName() && strings.EqualFold(host, current)
+}
+
+//getCatalog returns the catalog which keeps the accounts.
+func (mce *MysqlCmdExecutor) getCatalog() (*catalog.Catalog, error) {
+	c := mce.GetSession().Pu.ClusterCatalog
+	if c == nil {
+		return nil, errors.New(errno.FeatureNotSupported, "the accounts are not supported without the catalog")
+	}
+	return c, nil
+}
+
+//getPrivilegeChecker returns the privileges of the user of the session.
+//the built-in users are not checked and nil is returned for them.
+func (mce *MysqlCmdExecutor) getPrivilegeChecker() (plan.PrivilegeChecker, error) {
+	ses := mce.GetSession()
+	user := ses.protocol.GetUserName()
+	if isBuiltinUser(ses.Pu.SV, user) || ses.Pu.ClusterCatalog == nil {
+		return nil, nil
+	}
+	p, err := ses.Pu.ClusterCatalog.GetPrivileges(user, ses.protocol.GetUserHost())
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+//checkGlobalPrivilege checks that the user holds one of the global privileges at least.
+func (mce *MysqlCmdExecutor) checkGlobalPrivilege(privs ...tree.PrivilegeType) error {
+	pc, err := mce.getPrivilegeChecker()
+	if err != nil || pc == nil {
+		return err
+	}
+	var names []string
+	for i := range privs {
+		if pc.HasPrivilege(privs[i], "", "", "") {
+			return nil
+		}
+		names = append(names, strings.ToUpper(privs[i].ToString()))
+	}
+	return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, strings.Join(names, ", "))
+}
+
+//checkGrantPrivilege checks that the user can grant or revoke the privileges.
+//the user must hold the privileges and the GRANT OPTION on the object.
+func (mce *MysqlCmdExecutor) checkGrantPrivilege(grants []catalog.GrantInfo) error {
+	pc, err := mce.getPrivilegeChecker()
+	if err != nil || pc == nil {
+		return err
+	}
+	for _, g := range grants {
+		privs := append([]tree.PrivilegeType{tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION}, g.Privileges...)
+		for i := range privs {
+			column := g.Column
+			if privs[i] == tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION {
+				column = ""
+			}
+			if !pc.HasPrivilege(privs[i], g.Database, g.Table, column) {
+				return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, strings.ToUpper(privs[i].ToString()))
+			}
+		}
+	}
+	return nil
+}
+
+//makeGrants converts the privileges on the level into the grants of the catalog.
+//the privileges on the columns are split into a grant for every column.
+func (mce *MysqlCmdExecutor) makeGrants(objType tree.ObjectType, level *tree.PrivilegeLevel, privs []*tree.Privilege, grantOption bool) ([]catalog.GrantInfo, error) {
+	if objType != tree.OBJECT_TYPE_NONE && objType != tree.OBJECT_TYPE_TABLE {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("privileges on %s are not supported", objType.ToString()))
+	}
+
+	var db, table string
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		db, table = level.DbName, level.TabName
+		if len(db) == 0 {
+			db = mce.GetSession().protocol.GetDatabaseName()
+			if len(db) == 0 {
+				return nil, NewMysqlError(ER_NO_DB_ERROR)
+			}
+		}
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, "the privilege level is not supported")
+	}
+
+	obj := catalog.GrantInfo{Database: db, Table: table}
+	var grants []catalog.GrantInfo
+	for _, p := range privs {
+		if len(p.ColumnList) == 0 {
+			obj.Privileges = append(obj.Privileges, p.Type)
+			continue
+		}
+		for _, col := range p.ColumnList {
+			grants = append(grants, catalog.GrantInfo{
+				Database:   db,
+				Table:      table,
+				Column:     col.Parts[0],
+				Privileges: []tree.PrivilegeType{p.Type},
+			})
+		}
+	}
+	if grantOption {
+		obj.Privileges = append(obj.Privileges, tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION)
+	}
+	if len(obj.Privileges) != 0 {
+		grants = append(grants, obj)
+	}
+	for i := range grants {
+		if err := grants[i].Normalize(); err != nil {
+			return nil, NewMysqlError(ER_ILLEGAL_GRANT_FOR_TABLE)
+		}
+	}
+	return grants, nil
+}
+
+//sendOkResponse tells the client that the account statement succeeded.
+func (mce *MysqlCmdExecutor) sendOkResponse() error {
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err := mce.GetSession().protocol.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle create user
+*/
+func (mce *MysqlCmdExecutor) handleCreateUser(st *tree.CreateUser) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+	if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	if err = mce.checkRolesExist(c, st.Roles); err != nil {
+		return err
+	}
+	sv := mce.GetSession().Pu.SV
+	for _, u := range st.Users {
+		if isBuiltinUser(sv, u.Username) {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE USER", accountName(u.Username, u.Hostname))
+		}
+		authPlugin, authString, err := makeAuthString(u)
+		if err != nil {
+			return err
+		}
+		user := catalog.UserInfo{
+			Name:       u.Username,
+			Host:       u.Hostname,
+			AuthPlugin: authPlugin,
+			AuthString: authString,
+		}
+		for _, r := range st.Roles {
+			user.Roles = append(user.Roles, catalog.Account{Name: r.UserName, Host: r.HostName})
+		}
+		err = c.CreateUser(user)
+		if (err == catalog.ErrUserExists || err == catalog.ErrRoleExists) && st.IfNotExists {
+			continue
+		}
+		if err == catalog.ErrUserExists || err == catalog.ErrRoleExists {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE USER", accountName(u.Username, u.Hostname))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+//checkRolesExist checks that the roles have been created.
+func (mce *MysqlCmdExecutor) checkRolesExist(c *catalog.Catalog, roles []*tree.Role) error {
+	if len(roles) == 0 {
+		return nil
+	}
+	users, err := c.ListUsers()
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		found := false
+		for i := range users {
+			if users[i].IsRole && users[i].Name == r.UserName && strings.EqualFold(users[i].Host, r.HostName) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return NewMysqlError(ER_UNKNOWN_AUTHID, r.UserName, r.HostName)
+		}
+	}
+	return nil
+}
+
+/*
+handle drop user
+*/
+func (mce *MysqlCmdExecutor) handleDropUser(st *tree.DropUser) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+	if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	for _, u := range st.Users {
+		err = c.DropUser(u.Username, u.Hostname, false)
+		if err == catalog.ErrUserNotExists && st.IfExists {
+			continue
+		}
+		if err == catalog.ErrUserNotExists {
+			return NewMysqlError(ER_CANNOT_USER, "DROP USER", accountName(u.Username, u.Hostname))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+/*
+handle alter user.
+only the password of the users can be changed.
+*/
+func (mce *MysqlCmdExecutor) handleAlterUser(st *tree.AlterUser) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+	proto := mce.GetSession().protocol
+	users := st.Users
+	if st.IsUserFunc {
+		users = []*tree.User{st.UserFunc}
+		users[0].Username, users[0].Hostname = proto.GetUserName(), proto.GetUserHost()
+	}
+	for _, u := range users {
+		//the users can change their own passwords
+		if !isCurrentAccount(proto, u.Username, u.Hostname) {
+			if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+				return err
+			}
+		}
+		if !u.ByAuth && len(u.HashString) == 0 {
+			continue
+		}
+		authPlugin, authString, err := makeAuthString(u)
+		if err != nil {
+			return err
+		}
+		err = c.SetPassword(u.Username, u.Hostname, authPlugin, authString)
+		if err == catalog.ErrUserNotExists && st.IfExists {
+			continue
+		}
+		if err == catalog.ErrUserNotExists {
+			return NewMysqlError(ER_CANNOT_USER, "ALTER USER", accountName(u.Username, u.Hostname))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+/*
+handle set password.
+the authentication method of the user is kept.
+*/
+func (mce *MysqlCmdExecutor) handleSetPassword(st *tree.SetPassword) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+	proto := mce.GetSession().protocol
+	name, host := proto.GetUserName(), proto.GetUserHost()
+	if st.User != nil && !isCurrentAccount(proto, st.User.Username, st.User.Hostname) {
+		if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+			return err
+		}
+		name, host = st.User.Username, st.User.Hostname
+	}
+	user, err := c.GetUser(name, host)
+	if err == catalog.ErrUserNotExists {
+		return NewMysqlError(ER_PASSWORD_NO_MATCH)
+	}
+	if err != nil {
+		return err
+	}
+	authString, err := hashPassword(user.AuthPlugin, st.Password)
+	if err != nil {
+		return err
+	}
+	if err = c.SetPassword(name, host, user.AuthPlugin, authString); err != nil {
+		return err
+	}
+	return mce.sendOkResponse()
+}
+
+/*
+handle create role
+*/
+func (mce *MysqlCmdExecutor) handleCreateRole(st *tree.CreateRole) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+	if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE, tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	sv := mce.GetSession().Pu.SV
+	for _, r := range st.Roles {
+		if isBuiltinUser(sv, r.UserName) {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE ROLE", accountName(r.UserName, r.HostName))
+		}
+		err = c.CreateUser(catalog.UserInfo{Name: r.UserName, Host: r.HostName, IsRole: true})
+		if (err == catalog.ErrUserExists || err == catalog.ErrRoleExists) && st.IfNotExists {
+			continue
+		}
+		if err == catalog.ErrUserExists || err == catalog.ErrRoleExists {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE ROLE", accountName(r.UserName, r.HostName))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+/*
+handle drop role
+*/
+func (mce *MysqlCmdExecutor) handleDropRole(st *tree.DropRole) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+	if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE, tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	for _, r := range st.Roles {
+		err = c.DropUser(r.UserName, r.HostName, true)
+		if err == catalog.ErrRoleNotExists && st.IfExists {
+			continue
+		}
+		if err == catalog.ErrRoleNotExists {
+			return NewMysqlError(ER_CANNOT_USER, "DROP ROLE", accountName(r.UserName, r.HostName))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+//convertGrantError converts the error of the catalog during GRANT or REVOKE into the mysql error.
+func convertGrantError(err error, grantee *tree.User, roles []*tree.Role) error {
+	role := &tree.Role{HostName: "%"}
+	if len(roles) != 0 {
+		role = roles[0]
+	}
+	switch err {
+	case catalog.ErrUserNotExists:
+		return NewMysqlError(ER_PASSWORD_NO_MATCH)
+	case catalog.ErrRoleNotExists:
+		return NewMysqlError(ER_UNKNOWN_AUTHID, role.UserName, role.HostName)
+	case catalog.ErrCircularRole:
+		return NewMysqlError(ER_ROLE_GRANTED_TO_ITSELF, accountName(grantee.Username, grantee.Hostname), accountName(role.UserName, role.HostName))
+	case catalog.ErrIllegalGrant:
+		return NewMysqlError(ER_ILLEGAL_GRANT_FOR_TABLE)
+	case catalog.ErrGrantNotExists:
+		return NewMysqlError(ER_NONEXISTING_GRANT, grantee.Username, grantee.Hostname)
+	}
+	return err
+}
+
+/*
+handle grant
+*/
+func (mce *MysqlCmdExecutor) handleGrant(st *tree.Grant) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+	if st.IsProxy {
+		return errors.New(errno.FeatureNotSupported, "grant proxy is not supported")
+	}
+
+	if st.IsGrantRole {
+		if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+			return err
+		}
+		var roles []catalog.Account
+		for _, r := range st.RolesInGrantRole {
+			roles = append(roles, catalog.Account{Name: r.UserName, Host: r.HostName})
+		}
+		for _, u := range st.Users {
+			if err = c.GrantRoles(u.Username, u.Hostname, roles); err != nil {
+				return convertGrantError(err, u, st.RolesInGrantRole)
+			}
+		}
+		return mce.sendOkResponse()
+	}
+
+	grants, err := mce.makeGrants(st.ObjType, st.Level, st.Privileges, st.GrantOption)
+	if err != nil {
+		return err
+	}
+	if err = mce.checkGrantPrivilege(grants); err != nil {
+		return err
+	}
+	for _, u := range st.Users {
+		if err = c.GrantPrivileges(u.Username, u.Hostname, grants); err != nil {
+			return convertGrantError(err, u, nil)
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+/*
+handle revoke
+*/
+func (mce *MysqlCmdExecutor) handleRevoke(st *tree.Revoke) error {
+	c, err := mce.getCatalog()
+	if err != nil {
+		return err
+	}
+
+	if st.IsRevokeRole {
+		if err = mce.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+			return err
+		}
+		var roles []catalog.Account
+		for _, r := range st.RolesInRevokeRole {
+			roles = append(roles, catalog.Account{Name: r.UserName, Host: r.HostName})
+		}
+		for _, u := range st.Users {
+			if err = c.RevokeRoles(u.Username, u.Hostname, roles); err != nil {
+				return convertGrantError(err, u, st.RolesInRevokeRole)
+			}
+		}
+		return mce.sendOkResponse()
+	}
+
+	grants, err := mce.makeGrants(st.ObjType, st.Level, st.Privileges, false)
+	if err != nil {
+		return err
+	}
+	if err = mce.checkGrantPrivilege(grants); err != nil {
+		return err
+	}
+	for _, u := range st.Users {
+		if err = c.RevokePrivileges(u.Username, u.Hostname, grants); err != nil {
+			return convertGrantError(err, u, nil)
+		}
+	}
+	return mce.sendOkResponse()
+}
@@ -0,0 +1,369 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/driver"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+)
+
+//memDriver keeps the keys of the catalog in memory.
+type memDriver struct {
+	driver.CubeDriver
+	kv map[string][]byte
+}
+
+func (d *memDriver) Set(k, v []byte) error {
+	d.kv[string(k)] = append([]byte{}, v...)
+	return nil
+}
+
+func (d *memDriver) Get(k []byte) ([]byte, error) {
+	return d.kv[string(k)], nil
+}
+
+func (d *memDriver) Delete(k []byte) error {
+	delete(d.kv, string(k))
+	return nil
+}
+
+func (d *memDriver) PrefixScan(prefix []byte, limit uint64) ([][]byte, error) {
+	var keys []string
+	for k := range d.kv {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var values [][]byte
+	for _, k := range keys {
+		values = append(values, []byte(k), d.kv[k])
+	}
+	return values, nil
+}
+
+//scrambleNativePassword computes the auth response of mysql_native_password like the client.
+func scrambleNativePassword(password string, salt []byte) []byte {
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+//scrambleCachingSha2Password computes the auth response of caching_sha2_password like the client.
+func scrambleCachingSha2Password(password string, salt []byte) []byte {
+	hash1 := sha256.Sum256([]byte(password))
+	hash2 := sha256.Sum256(hash1[:])
+	hash3 := sha256.Sum256(append(hash2[:], salt...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+func Test_checkPasswordHash(t *testing.T) {
+	convey.Convey("check the password with the hash", t, func() {
+		salt := []byte("0123456789abcdefghij")
+
+		hash, err := hashPassword(AuthNativePassword, "pwd")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(checkNativePasswordHash(hash, salt, scrambleNativePassword("pwd", salt)), convey.ShouldBeTrue)
+		convey.So(checkNativePasswordHash(hash, salt, scrambleNativePassword("pwd2", salt)), convey.ShouldBeFalse)
+		convey.So(checkNativePasswordHash(hash, salt, nil), convey.ShouldBeFalse)
+
+		hash, err = hashPassword(AuthCachingSha2Password, "pwd")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(checkCachingSha2PasswordHash(hash, salt, scrambleCachingSha2Password("pwd", salt)), convey.ShouldBeTrue)
+		convey.So(checkCachingSha2PasswordHash(hash, salt, scrambleCachingSha2Password("pwd", append(salt, 0))), convey.ShouldBeTrue)
+		convey.So(checkCachingSha2PasswordHash(hash, salt, scrambleCachingSha2Password("pwd2", salt)), convey.ShouldBeFalse)
+		convey.So(checkCachingSha2PasswordHash(hash, salt, scrambleNativePassword("pwd", salt)), convey.ShouldBeFalse)
+
+		//the empty password
+		hash, err = hashPassword(AuthNativePassword, "")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(checkNativePasswordHash(hash, salt, nil), convey.ShouldBeTrue)
+		convey.So(checkCachingSha2PasswordHash(hash, salt, nil), convey.ShouldBeTrue)
+		convey.So(checkNativePasswordHash(hash, salt, scrambleNativePassword("pwd", salt)), convey.ShouldBeFalse)
+
+		_, err = hashPassword("sha256_password", "pwd")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("make the auth string", t, func() {
+		authPlugin, hash, err := makeAuthString(&tree.User{AuthString: "pwd", ByAuth: true})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(authPlugin, convey.ShouldEqual, AuthNativePassword)
+		expected, _ := hashPassword(AuthNativePassword, "pwd")
+		convey.So(hash, convey.ShouldResemble, expected)
+
+		authPlugin, hash, err = makeAuthString(&tree.User{AuthPlugin: AuthNativePassword, HashString: "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19"})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(authPlugin, convey.ShouldEqual, AuthNativePassword)
+		expected, _ = hashPassword(AuthNativePassword, "password")
+		convey.So(hash, convey.ShouldResemble, expected)
+
+		_, _, err = makeAuthString(&tree.User{AuthPlugin: AuthNativePassword, HashString: "*2470"})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, _, err = makeAuthString(&tree.User{AuthPlugin: AuthCachingSha2Password, HashString: "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19"})
+		convey.So(err, convey.ShouldNotBeNil)
+		_, _, err = makeAuthString(&tree.User{AuthPlugin: "unknown", AuthString: "pwd", ByAuth: true})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_handleAccountStatements(t *testing.T) {
+	convey.Convey("account statements", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		ses := NewSession(proto, getPCI(), guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu), pu.Mempool, pu)
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		exec := func(sql string) error {
+			stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			switch st := stmt.(type) {
+			case *tree.CreateUser:
+				return mce.handleCreateUser(st)
+			case *tree.DropUser:
+				return mce.handleDropUser(st)
+			case *tree.AlterUser:
+				return mce.handleAlterUser(st)
+			case *tree.SetPassword:
+				return mce.handleSetPassword(st)
+			case *tree.CreateRole:
+				return mce.handleCreateRole(st)
+			case *tree.DropRole:
+				return mce.handleDropRole(st)
+			case *tree.Grant:
+				return mce.handleGrant(st)
+			case *tree.Revoke:
+				return mce.handleRevoke(st)
+			}
+			return nil
+		}
+
+		//the accounts need the catalog
+		proto.SetUserName(pu.SV.GetDumpuser())
+		convey.So(exec("create user u1 identified by '1'"), convey.ShouldNotBeNil)
+
+		c := &catalog.Catalog{Driver: &memDriver{kv: make(map[string][]byte)}}
+		pu.ClusterCatalog = c
+
+		//the built-in user holds all the privileges
+		pc, err := mce.getPrivilegeChecker()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pc, convey.ShouldBeNil)
+
+		convey.So(exec("create user u1 identified by '1'"), convey.ShouldBeNil)
+		convey.So(exec("create user u1 identified by '1'"), convey.ShouldNotBeNil)
+		convey.So(exec("create user if not exists u1 identified by '1'"), convey.ShouldBeNil)
+		convey.So(exec("create user u2 identified with caching_sha2_password by '2'"), convey.ShouldBeNil)
+		convey.So(exec("create user dump identified by '1'"), convey.ShouldNotBeNil)
+		convey.So(exec("create role r1, r2"), convey.ShouldBeNil)
+		convey.So(exec("create role u1"), convey.ShouldNotBeNil)
+
+		user, err := c.GetUser("u2", "%")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(user.AuthPlugin, convey.ShouldEqual, AuthCachingSha2Password)
+
+		//grant the privileges and the roles
+		convey.So(exec("grant select on db1.* to r1"), convey.ShouldBeNil)
+		convey.So(exec("grant r1 to r2"), convey.ShouldBeNil)
+		convey.So(exec("grant r2 to r1"), convey.ShouldNotBeNil)
+		convey.So(exec("grant r2 to u1"), convey.ShouldBeNil)
+		convey.So(exec("grant r3 to u1"), convey.ShouldNotBeNil)
+		convey.So(exec("grant select on db1.* to u3"), convey.ShouldNotBeNil)
+		convey.So(exec("grant select(a), insert on db1.t1 to u1 with grant option"), convey.ShouldBeNil)
+		convey.So(exec("grant select on t1 to u1"), convey.ShouldNotBeNil)
+		convey.So(exec("grant create user on db1.* to u1"), convey.ShouldNotBeNil)
+
+		proto.SetDatabaseName("db2")
+		convey.So(exec("grant update on t1 to u2"), convey.ShouldBeNil)
+
+		//the user without the privileges
+		proto.SetUserName("u1")
+		pc, err = mce.getPrivilegeChecker()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t2", ""), convey.ShouldBeTrue)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_INSERT, "db1", "t1", ""), convey.ShouldBeTrue)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_UPDATE, "db2", "t1", ""), convey.ShouldBeFalse)
+
+		convey.So(exec("create user u3"), convey.ShouldNotBeNil)
+		convey.So(exec("drop user u2"), convey.ShouldNotBeNil)
+		convey.So(exec("create role r3"), convey.ShouldNotBeNil)
+		convey.So(exec("set password for u2 = '3'"), convey.ShouldNotBeNil)
+		convey.So(exec("grant insert on db1.t1 to u2"), convey.ShouldBeNil)
+		convey.So(exec("grant delete on db1.t1 to u2"), convey.ShouldNotBeNil)
+		convey.So(exec("grant select on db1.t2 to u2"), convey.ShouldNotBeNil)
+
+		//the users can change their own passwords
+		convey.So(exec("set password = '3'"), convey.ShouldBeNil)
+		user, err = c.GetUser("u1", "%")
+		convey.So(err, convey.ShouldBeNil)
+		expected, _ := hashPassword(AuthNativePassword, "3")
+		convey.So(user.AuthString, convey.ShouldResemble, expected)
+		convey.So(exec("alter user u1 identified by '4'"), convey.ShouldBeNil)
+
+		//revoke
+		proto.SetUserName(pu.SV.GetDumpuser())
+		convey.So(exec("revoke r2 from u1"), convey.ShouldBeNil)
+		convey.So(exec("revoke insert on db1.t1 from u1"), convey.ShouldBeNil)
+		convey.So(exec("revoke insert on db1.t2 from u1"), convey.ShouldNotBeNil)
+		pc, err = c.GetPrivileges("u1", "%")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t2", ""), convey.ShouldBeFalse)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_INSERT, "db1", "t1", ""), convey.ShouldBeFalse)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t1", "a"), convey.ShouldBeTrue)
+
+		//the accounts with the same name and different hosts are different accounts
+		convey.So(exec("create user 'u1'@'localhost' identified by '5'"), convey.ShouldBeNil)
+		convey.So(exec("grant insert on db1.* to 'u1'@'localhost' with grant option"), convey.ShouldBeNil)
+		convey.So(exec("revoke select on db2.* from 'u1'@'localhost'"), convey.ShouldNotBeNil)
+		proto.SetUserName("u1")
+		proto.SetUserHost("localhost")
+		pc, err = mce.getPrivilegeChecker()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_INSERT, "db1", "t2", ""), convey.ShouldBeTrue)
+		convey.So(pc.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t1", "a"), convey.ShouldBeFalse)
+		convey.So(exec("set password = '6'"), convey.ShouldBeNil)
+		convey.So(exec("set password for u1 = '6'"), convey.ShouldNotBeNil)
+		user, err = c.GetUser("u1", "localhost")
+		convey.So(err, convey.ShouldBeNil)
+		expected, _ = hashPassword(AuthNativePassword, "6")
+		convey.So(user.AuthString, convey.ShouldResemble, expected)
+		user, err = c.GetUser("u1", "%")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(user.AuthString, convey.ShouldNotResemble, expected)
+		proto.SetUserName(pu.SV.GetDumpuser())
+		proto.SetUserHost("")
+		convey.So(exec("drop user 'u1'@'localhost'"), convey.ShouldBeNil)
+		convey.So(exec("drop user 'u1'@'localhost'"), convey.ShouldNotBeNil)
+
+		//drop
+		convey.So(exec("drop role r1"), convey.ShouldBeNil)
+		convey.So(exec("drop role r1"), convey.ShouldNotBeNil)
+		convey.So(exec("drop role if exists r1"), convey.ShouldBeNil)
+		convey.So(exec("drop user u1, u2"), convey.ShouldBeNil)
+		convey.So(exec("drop user u1"), convey.ShouldNotBeNil)
+		convey.So(exec("drop user if exists u1"), convey.ShouldBeNil)
+	})
+}
+
+func TestMOServer_Authentication(t *testing.T) {
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+
+	c := &catalog.Catalog{Driver: &memDriver{kv: make(map[string][]byte)}}
+	for _, u := range []struct{ name, authPlugin, password string }{
+		{"u1", AuthNativePassword, "1"},
+		{"u2", AuthCachingSha2Password, "2"},
+		{"u3", AuthNativePassword, ""},
+	} {
+		hash, err := hashPassword(u.authPlugin, u.password)
+		require.NoError(t, err)
+		require.NoError(t, c.CreateUser(catalog.UserInfo{Name: u.name, Host: "%", AuthPlugin: u.authPlugin, AuthString: hash}))
+	}
+	require.NoError(t, c.CreateUser(catalog.UserInfo{Name: "r1", Host: "%", IsRole: true}))
+	//the accounts which can connect from the given hosts only
+	//the client logs in as the account of h6 whose host name matches it most specifically
+	for _, u := range []struct{ name, host, password string }{
+		{"h1", "localhost", "h"},
+		{"h2", "127.0.0._", "h"},
+		{"h3", "127.0.0.0/255.0.0.0", "h"},
+		{"h4", "10.%", "h"},
+		{"h5", "192.168.0.0/255.255.0.0", "h"},
+		{"h6", "%", "a"},
+		{"h6", "127.0.%", "b"},
+		{"h6", "127.0.0.1", "c"},
+		{"h6", "10.0.0.1", "d"},
+	} {
+		hash, err := hashPassword(AuthNativePassword, u.password)
+		require.NoError(t, err)
+		require.NoError(t, c.CreateUser(catalog.UserInfo{Name: u.name, Host: u.host, AuthPlugin: AuthNativePassword, AuthString: hash}))
+	}
+
+	pu := config.NewParameterUnit(sv, host.New(sv.GetHostMmuLimitation()), mempool.New(), nil, nil, c)
+	ppu := NewPDCallbackParameterUnit(int(sv.GetPeriodOfEpochTimer()), int(sv.GetPeriodOfPersistence()), int(sv.GetPeriodOfDDLDeleteTimer()), int(sv.GetTimeoutOfHeartbeat()), sv.GetEnableEpochLogging(), math.MaxInt64)
+	pci := NewPDCallbackImpl(ppu)
+
+	port := 6012
+	mo := NewMOServer(fmt.Sprintf("127.0.0.1:%d", port), pu, pci)
+	require.NoError(t, mo.Start())
+	defer func() {
+		require.NoError(t, mo.Stop())
+	}()
+
+	ping := func(user, password string) error {
+		dsn := fmt.Sprintf("%s:%s@tcp(127.0.0.1:%d)/?readTimeout=10s&timeout=10s&writeTimeout=10s", user, password, port)
+		db, err := sql.Open("mysql", dsn)
+		require.NoError(t, err)
+		defer close_db(t, db)
+		return db.Ping()
+	}
+
+	require.NoError(t, ping("dump", "111"))
+	require.NoError(t, ping("root", ""))
+	require.NoError(t, ping("u1", "1"))
+	require.NoError(t, ping("u2", "2"))
+	require.NoError(t, ping("u3", ""))
+	require.NoError(t, ping("h1", "h"))
+	require.NoError(t, ping("h2", "h"))
+	require.NoError(t, ping("h3", "h"))
+
+	require.Error(t, ping("dump", "1"))
+	require.Error(t, ping("u1", "2"))
+	require.Error(t, ping("u2", "1"))
+	require.Error(t, ping("u3", "3"))
+	require.Error(t, ping("r1", ""))
+	require.Error(t, ping("u4", ""))
+	require.Error(t, ping("h4", "h"))
+	require.Error(t, ping("h5", "h"))
+	require.NoError(t, ping("h6", "c"))
+	require.Error(t, ping("h6", "a"))
+	require.Error(t, ping("h6", "b"))
+	require.Error(t, ping("h6", "d"))
+}
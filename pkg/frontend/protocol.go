@@ -157,6 +157,11 @@ type Protocol interface {
 
 	SetUserName(string)
 
+	// GetUserHost gets the host name of the account which the user logged in as
+	GetUserHost() string
+
+	SetUserHost(string)
+
 	// Quit
 	Quit()
 }
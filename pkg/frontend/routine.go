@@ -17,6 +17,7 @@ package frontend
 import (
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"sync"
@@ -46,6 +47,11 @@ type Routine struct {
 	onceCloseNotifyChan sync.Once
 
 	routineMgr *RoutineManager
+
+	//the request being handled, which is shown in the processlist
+	reqLock  sync.Mutex
+	req      *Request
+	reqBegin time.Time
 }
 
 func (routine *Routine) GetClientProtocol() Protocol {
@@ -68,6 +74,38 @@ func (routine *Routine) GetRoutineMgr() *RoutineManager {
 	return routine.routineMgr
 }
 
+func (routine *Routine) setRequest(req *Request, begin time.Time) {
+	routine.reqLock.Lock()
+	defer routine.reqLock.Unlock()
+	routine.req = req
+	routine.reqBegin = begin
+}
+
+/*
+getProcess returns the connection and the request being handled by the routine.
+*/
+func (routine *Routine) getProcess() infoschema.Process {
+	routine.reqLock.Lock()
+	defer routine.reqLock.Unlock()
+	host, _ := routine.protocol.Peer()
+	p := infoschema.Process{
+		Id:      uint64(routine.getConnID()),
+		User:    routine.protocol.GetUserName(),
+		Host:    host,
+		Db:      routine.protocol.GetDatabaseName(),
+		Command: "Sleep",
+		Time:    int64(time.Since(routine.reqBegin) / time.Second),
+	}
+	if routine.req != nil {
+		p.Command = commandName(uint8(routine.req.GetCmd()))
+		p.State = "executing"
+		if data, ok := routine.req.GetData().([]byte); ok && routine.req.GetCmd() == int(COM_QUERY) {
+			p.Info = string(data)
+		}
+	}
+	return p
+}
+
 /*
 After the handshake with the client is done, the routine goes into processing loop.
 */
@@ -75,7 +113,18 @@ func (routine *Routine) Loop() {
 	var req *Request = nil
 	var err error
 	var resp *Response
+	//the session lives as long as the connection.
+	//the statements prepared by the client are kept in it.
+	var ses *Session
 	defer routine.Quit()
+	//the transaction not committed is rolled back when the connection is closed
+	defer func() {
+		if ses != nil {
+			if err := ses.RollbackTxn(); err != nil {
+				logutil.Errorf("routine rollback the transaction failed. error:%v", err)
+			}
+		}
+	}()
 	for {
 		quit := false
 		select {
@@ -94,13 +143,19 @@ func (routine *Routine) Loop() {
 		mgr := routine.GetRoutineMgr()
 
 		routine.protocol.(*MysqlProtocolImpl).sequenceId = req.seq
-		ses := NewSession(routine.protocol, mgr.getEpochgc(), routine.guestMmu, routine.mempool, mgr.getParameterUnit())
+		if ses == nil {
+			ses = NewSession(routine.protocol, mgr.getEpochgc(), routine.guestMmu, routine.mempool, mgr.getParameterUnit())
+		} else {
+			ses.ResetForRequest()
+		}
 
 		routine.executor.PrepareSessionBeforeExecRequest(ses)
 
+		routine.setRequest(req, reqBegin)
 		if resp, err = routine.executor.ExecRequest(req); err != nil {
 			logutil.Errorf("routine execute request failed. error:%v \n", err)
 		}
+		routine.setRequest(nil, time.Now())
 
 		if resp != nil {
 			if err = routine.protocol.SendResponse(resp); err != nil {
@@ -139,8 +194,36 @@ func (routine *Routine) notifyClose() {
 	}
 }
 
+/*
+commandName returns the name of the command shown in the processlist.
+*/
+func commandName(cmd uint8) string {
+	switch cmd {
+	case COM_QUIT:
+		return "Quit"
+	case COM_INIT_DB:
+		return "Init DB"
+	case COM_QUERY:
+		return "Query"
+	case COM_FIELD_LIST:
+		return "Field List"
+	case COM_PING:
+		return "Ping"
+	case COM_STMT_PREPARE:
+		return "Prepare"
+	case COM_STMT_EXECUTE:
+		return "Execute"
+	case COM_STMT_CLOSE:
+		return "Close stmt"
+	case COM_STMT_RESET:
+		return "Reset stmt"
+	}
+	return "Sleep"
+}
+
 func NewRoutine(protocol MysqlProtocol, executor CmdExecutor, pu *config.ParameterUnit) *Routine {
 	ri := &Routine{
+		reqBegin:    time.Now(),
 		protocol:    protocol,
 		executor:    executor,
 		requestChan: make(chan *Request, 1),
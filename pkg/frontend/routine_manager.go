@@ -15,12 +15,15 @@
 package frontend
 
 import (
+	"crypto/tls"
 	"errors"
+	"sort"
 	"sync"
 
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
 )
 
 type RoutineManager struct {
@@ -31,6 +34,9 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//the tls config for the connections. nil means TLS is disabled.
+	tlsConfig *tls.Config
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
@@ -43,6 +49,8 @@ func (rm *RoutineManager) getParameterUnit() *config.ParameterUnit {
 
 func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	pro := NewMysqlClientProtocol(nextConnectionID(), rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()), rm.pu.SV)
+	pro.tlsConfig = rm.tlsConfig
+	pro.catalog = rm.pu.ClusterCatalog
 	exe := NewMysqlCmdExecutor()
 	exe.SetRoutineManager(rm)
 
@@ -98,6 +106,20 @@ func (rm *RoutineManager) killStatement(id uint64) error {
 	return nil
 }
 
+/*
+processes returns the connections of the routines ordered by the connection id.
+*/
+func (rm *RoutineManager) processes() []infoschema.Process {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+	ps := make([]infoschema.Process, 0, len(rm.clients))
+	for _, rt := range rm.clients {
+		ps = append(ps, rt.getProcess())
+	}
+	sort.Slice(ps, func(i, j int) bool { return ps[i].Id < ps[j].Id })
+	return ps
+}
+
 func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
 	if rm.pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() {
 		if !rm.pdHook.CanAcceptSomething() {
@@ -151,6 +173,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 			logutil.Infof("RP[%v] Payload80[%v]",rs.RemoteAddr(),di)
 		*/
 
+		//the handshake response will come after upgrading to TLS
+		if protocol.isSSLRequest(payload) {
+			return protocol.handleSSLRequest()
+		}
+
 		err := protocol.handleHandshake(payload)
 		if err != nil {
 			return err
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"net"
 	"sync/atomic"
 
 	"github.com/fagongzi/goetty"
@@ -60,13 +61,36 @@ func nextConnectionID() uint32 {
 func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl) *MOServer {
 	encoder, decoder := NewSqlCodec()
 	rm := NewRoutineManager(pu, pdHook)
-	// TODO asyncFlushBatch
-	app, err := goetty.NewTCPApplication(addr, rm.Handler,
+
+	tlsConfig, err := loadTLSConfig(pu.SV)
+	if err != nil {
+		logutil.Panicf("load tls config failed with %+v", err)
+	}
+	if tlsConfig == nil && pu.SV.GetRequireSecureTransport() {
+		logutil.Panicf("start server failed with %s", errorMsgRefer[ER_NO_SECURE_TRANSPORTS_CONFIGURED].errorMsgOrFormat)
+	}
+	rm.tlsConfig = tlsConfig
+
+	appOpts := []goetty.AppOption{
 		goetty.WithAppSessionOptions(
 			goetty.WithCodec(encoder, decoder),
 			goetty.WithLogger(logutil.GetGlobalLogger()),
 			goetty.WithBufSize(1024*1024, 1024*1024)),
-		goetty.WithAppSessionAware(rm))
+		goetty.WithAppSessionAware(rm),
+	}
+
+	// TODO asyncFlushBatch
+	var app goetty.NetApplication
+	if tlsConfig != nil {
+		//the connections need to be upgraded to TLS in the handshake
+		var listener net.Listener
+		listener, err = net.Listen("tcp4", addr)
+		if err == nil {
+			app, err = goetty.NewApplication(newTLSListener(listener), rm.Handler, appOpts...)
+		}
+	} else {
+		app, err = goetty.NewTCPApplication(addr, rm.Handler, appOpts...)
+	}
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
 	}
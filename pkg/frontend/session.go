@@ -15,8 +15,15 @@
 package frontend
 
 import (
+	"strconv"
+	"sync/atomic"
+
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -42,6 +49,143 @@ type Session struct {
 	ep *tree.ExportParam
 
 	closeRef *CloseExportData
+
+	//the statements prepared by COM_STMT_PREPARE
+	prepareStmts map[uint32]*PrepareStmt
+
+	//the id of the last prepared statement
+	lastStmtId uint32
+
+	//the transaction of the session, it is nil when the session is not in a transaction
+	txn engine.Txn
+
+	//the transaction is started for a single statement
+	stmtTxn bool
+
+	//the statements are committed one by one when it is true
+	autocommit bool
+}
+
+//PrepareStmt is the statement prepared by COM_STMT_PREPARE.
+//It keeps the statement parsed at preparing. The plan of a statement
+//is built once and reused by the repeated executions, the values of
+//every execution are bound to the parameters of the plan. The statement
+//is copied to bind the values only when the plan is built again.
+type PrepareStmt struct {
+	Id uint32
+
+	Sql string
+
+	Stmt tree.Statement
+
+	//the '?' placeholders in the statement
+	Params []*tree.ParamExpr
+
+	//the columns of the result set, which are sent in the response of COM_STMT_PREPARE.
+	//it is empty if the statement produces no result set or the columns are unknown at preparing.
+	Columns []interface{}
+
+	//the types of the parameters from the last COM_STMT_EXECUTE.
+	//two bytes for a parameter: the type and the unsigned flag.
+	ParamTypes []byte
+
+	//the data from COM_STMT_SEND_LONG_DATA, indexed by the parameter
+	LongData map[uint16][]byte
+
+	//the plan cached for the executions
+	plan *preparedPlan
+}
+
+//preparedPlan is the plan built in the database with the plan version
+type preparedPlan struct {
+	db      string
+	version uint64
+	pn      plan.Plan
+}
+
+//planVersion is increased when the schemas, the privileges or the statistics are changed.
+//The plans cached before are not reused anymore.
+var planVersion uint64
+
+//invalidatePlans makes the cached plans stale if the statement changes what the plans depend on
+func invalidatePlans(stmt tree.Statement) {
+	switch stmt.(type) {
+	case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
+		*tree.CreateIndex, *tree.DropIndex,
+		*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
+		*tree.CreateRole, *tree.DropRole,
+		*tree.Grant, *tree.Revoke,
+		*tree.SetDefaultRole, *tree.SetRole,
+		*tree.AnalyzeStmt:
+		atomic.AddUint64(&planVersion, 1)
+	}
+}
+
+//getPlan gets the cached plan if it is built in the database and still valid
+func (ps *PrepareStmt) getPlan(db string) plan.Plan {
+	if ps.plan == nil || ps.plan.db != db || ps.plan.version != atomic.LoadUint64(&planVersion) {
+		return nil
+	}
+	return ps.plan.pn
+}
+
+//cachePlan caches the plan built in the database.
+//The version is the plan version before the plan is built.
+func (ps *PrepareStmt) cachePlan(db string, version uint64, pn plan.Plan) {
+	if !plan.Reusable(pn) {
+		return
+	}
+	ps.plan = &preparedPlan{
+		db:      db,
+		version: version,
+		pn:      pn,
+	}
+}
+
+//bindPlan binds the values to the parameters of the cached plan.
+//It returns false if the plan has to be built for the values.
+func (ps *PrepareStmt) bindPlan(pn plan.Plan, values []interface{}) (bool, error) {
+	if len(ps.Params) == 0 {
+		return true, nil
+	}
+	qry, ok := pn.(*plan.Query)
+	if !ok {
+		return false, nil
+	}
+	exprs := make([]tree.Expr, len(values))
+	for i, value := range values {
+		exprs[i] = makeParamExpr(value)
+	}
+	return qry.Bind(exprs)
+}
+
+//bind makes a copy of the statement for an execution, in which the placeholders are
+//bound to the values. The statement parsed at preparing is never changed.
+func (ps *PrepareStmt) bind(values []interface{}) (tree.Statement, error) {
+	if len(ps.Params) == 0 {
+		return ps.Stmt, nil
+	}
+	stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, ps.Sql)
+	if err != nil {
+		return nil, err
+	}
+	for _, param := range params {
+		param.Expr = makeParamExpr(values[param.Offset-1])
+	}
+	return stmt, nil
+}
+
+//ResetLongData clears the data from COM_STMT_SEND_LONG_DATA
+func (ps *PrepareStmt) ResetLongData() {
+	ps.LongData = nil
+}
+
+//AppendLongData appends the data of the parameter from COM_STMT_SEND_LONG_DATA
+func (ps *PrepareStmt) AppendLongData(paramIdx uint16, data []byte) {
+	if ps.LongData == nil {
+		ps.LongData = make(map[uint16][]byte)
+	}
+	ps.LongData[paramIdx] = append(ps.LongData[paramIdx], data...)
 }
 
 func NewSession(proto Protocol, pdHook *PDCallbackImpl,
@@ -57,9 +201,53 @@ func NewSession(proto Protocol, pdHook *PDCallbackImpl,
 			Fields:  &tree.Fields{},
 			Lines:   &tree.Lines{},
 		},
+		autocommit: true,
+	}
+}
+
+//ResetForRequest clears the states of the last request
+//before the session handles the next one
+func (ses *Session) ResetForRequest() {
+	ses.Cmd = 0
+	ses.Mrs = nil
+	ses.ep = &tree.ExportParam{
+		Outfile: false,
+		Fields:  &tree.Fields{},
+		Lines:   &tree.Lines{},
 	}
+	ses.closeRef = nil
 }
 
 func (ses *Session) GetEpochgc() *PDCallbackImpl {
 	return ses.pdHook
 }
+
+//AddPrepareStmt caches the prepared statement and assigns an id to it
+func (ses *Session) AddPrepareStmt(sql string, stmt tree.Statement, params []*tree.ParamExpr) *PrepareStmt {
+	if ses.prepareStmts == nil {
+		ses.prepareStmts = make(map[uint32]*PrepareStmt)
+	}
+	ses.lastStmtId++
+	ps := &PrepareStmt{
+		Id:     ses.lastStmtId,
+		Sql:    sql,
+		Stmt:   stmt,
+		Params: params,
+	}
+	ses.prepareStmts[ps.Id] = ps
+	return ps
+}
+
+//GetPrepareStmt gets the prepared statement with the id.
+//The cmdName is the command which refers to the statement.
+func (ses *Session) GetPrepareStmt(id uint32, cmdName string) (*PrepareStmt, error) {
+	if ps, ok := ses.prepareStmts[id]; ok {
+		return ps, nil
+	}
+	idStr := strconv.FormatUint(uint64(id), 10)
+	return nil, NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(idStr), idStr, cmdName)
+}
+
+func (ses *Session) RemovePrepareStmt(id uint32) {
+	delete(ses.prepareStmts, id)
+}
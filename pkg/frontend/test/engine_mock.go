@@ -65,6 +65,20 @@ func (mr *MockStatisticsMockRecorder) Size(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockStatistics)(nil).Size), arg0)
 }
 
+// Stats mocks base method.
+func (m *MockStatistics) Stats(arg0 string) *engine.ColumnStatistics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", arg0)
+	ret0, _ := ret[0].(*engine.ColumnStatistics)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockStatisticsMockRecorder) Stats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockStatistics)(nil).Stats), arg0)
+}
+
 // MockTableDef is a mock of TableDef interface.
 type MockTableDef struct {
 	ctrl     *gomock.Controller
@@ -265,6 +279,20 @@ func (mr *MockRelationMockRecorder) Size(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockRelation)(nil).Size), arg0)
 }
 
+// Stats mocks base method.
+func (m *MockRelation) Stats(arg0 string) *engine.ColumnStatistics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", arg0)
+	ret0, _ := ret[0].(*engine.ColumnStatistics)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockRelationMockRecorder) Stats(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockRelation)(nil).Stats), arg0)
+}
+
 // TableDefs mocks base method.
 func (m *MockRelation) TableDefs() []engine.TableDef {
 	m.ctrl.T.Helper()
@@ -0,0 +1,133 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+/*
+loadTLSConfig makes the tls config of the server with the certificate, the key and the CA
+in the system variables.
+It returns nil when the certificate is not configured, that means TLS is disabled.
+*/
+func loadTLSConfig(sv *config.SystemVariables) (*tls.Config, error) {
+	certFile, keyFile, caFile := sv.GetTlsCertFile(), sv.GetTlsKeyFile(), sv.GetTlsCaFile()
+	if len(certFile) == 0 {
+		if len(keyFile) != 0 || len(caFile) != 0 {
+			return nil, fmt.Errorf("the tls key or CA is configured without the certificate")
+		}
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load the tls certificate and key failed. error:%v", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	//the certificates of the clients are verified with the CA
+	if len(caFile) != 0 {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read the tls CA failed. error:%v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("there is no valid certificate in the tls CA %s", caFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
+/*
+tlsListener accepts the connections which can be upgraded to TLS.
+The client asks for TLS with the SSL request packet in the middle of the handshake,
+so the connection starts with plain tcp and switches to TLS later.
+*/
+type tlsListener struct {
+	net.Listener
+}
+
+func newTLSListener(l net.Listener) net.Listener {
+	return &tlsListener{Listener: l}
+}
+
+func (l *tlsListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &tlsUpgradableConn{Conn: conn}, nil
+}
+
+/*
+tlsUpgradableConn is the connection from the tlsListener.
+All the operations go to the plain connection before the upgrading
+and go to the TLS connection after it.
+*/
+type tlsUpgradableConn struct {
+	net.Conn
+}
+
+/*
+upgrade runs the TLS handshake as the server on the connection.
+The buffered are the bytes which have been read from the connection
+but not been handled, they are the beginning of the TLS handshake of the client.
+*/
+func (c *tlsUpgradableConn) upgrade(tlsConfig *tls.Config, buffered []byte) error {
+	if _, ok := c.Conn.(*tls.Conn); ok {
+		return fmt.Errorf("the connection has been upgraded to TLS")
+	}
+
+	var raw net.Conn = c.Conn
+	if len(buffered) != 0 {
+		raw = &bufferedConn{
+			Conn:   c.Conn,
+			reader: io.MultiReader(bytes.NewReader(buffered), c.Conn),
+		}
+	}
+
+	tlsConn := tls.Server(raw, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+	c.Conn = tlsConn
+	return nil
+}
+
+//bufferedConn reads the buffered bytes before the data from the connection
+type bufferedConn struct {
+	net.Conn
+	reader io.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,190 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+)
+
+//makeSelfSignedCert generates a self-signed certificate for 127.0.0.1
+//and writes the certificate and the key into the dir in PEM format.
+func makeSelfSignedCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "matrixone test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "server-cert.pem")
+	keyFile := filepath.Join(dir, "server-key.pem")
+	err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	require.NoError(t, err)
+	err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	require.NoError(t, err)
+	return certFile, keyFile
+}
+
+func Test_loadTLSConfig(t *testing.T) {
+	convey.Convey("load tls config", t, func() {
+		dir := t.TempDir()
+		certFile, keyFile := makeSelfSignedCert(t, dir)
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+
+		//TLS is disabled
+		tlsConfig, err := loadTLSConfig(sv)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tlsConfig, convey.ShouldBeNil)
+
+		convey.So(sv.SetTlsKeyFile(keyFile), convey.ShouldBeNil)
+		_, err = loadTLSConfig(sv)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		convey.So(sv.SetTlsCertFile(certFile), convey.ShouldBeNil)
+		tlsConfig, err = loadTLSConfig(sv)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tlsConfig, convey.ShouldNotBeNil)
+		convey.So(len(tlsConfig.Certificates), convey.ShouldEqual, 1)
+		convey.So(tlsConfig.ClientCAs, convey.ShouldBeNil)
+
+		//the certificates of the clients are verified
+		convey.So(sv.SetTlsCaFile(certFile), convey.ShouldBeNil)
+		tlsConfig, err = loadTLSConfig(sv)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tlsConfig.ClientCAs, convey.ShouldNotBeNil)
+		convey.So(tlsConfig.ClientAuth, convey.ShouldEqual, tls.VerifyClientCertIfGiven)
+
+		convey.So(sv.SetTlsCaFile(keyFile), convey.ShouldBeNil)
+		_, err = loadTLSConfig(sv)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		convey.So(sv.SetTlsCaFile(""), convey.ShouldBeNil)
+		convey.So(sv.SetTlsKeyFile(filepath.Join(dir, "nonexistent.pem")), convey.ShouldBeNil)
+		_, err = loadTLSConfig(sv)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_isSSLRequest(t *testing.T) {
+	convey.Convey("ssl request packet", t, func() {
+		mp := &MysqlProtocolImpl{}
+		mp.io = NewIOPackage(true)
+
+		payload := make([]byte, SSLRequestPacketLength)
+		mp.io.WriteUint32(payload, 0, CLIENT_PROTOCOL_41|CLIENT_SSL)
+		convey.So(mp.isSSLRequest(payload), convey.ShouldBeTrue)
+
+		mp.io.WriteUint32(payload, 0, CLIENT_PROTOCOL_41)
+		convey.So(mp.isSSLRequest(payload), convey.ShouldBeFalse)
+
+		mp.io.WriteUint32(payload, 0, CLIENT_PROTOCOL_41|CLIENT_SSL)
+		convey.So(mp.isSSLRequest(append(payload, 'a', 0)), convey.ShouldBeFalse)
+
+		//the server without TLS does not advertise CLIENT_SSL
+		convey.So(mp.serverCapability()&CLIENT_SSL, convey.ShouldEqual, 0)
+		convey.So(mp.handleSSLRequest(), convey.ShouldNotBeNil)
+
+		mp.tlsConfig = &tls.Config{}
+		convey.So(mp.serverCapability()&CLIENT_SSL, convey.ShouldNotEqual, 0)
+	})
+}
+
+func TestMOServer_TLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := makeSelfSignedCert(t, dir)
+
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	require.NoError(t, sv.SetTlsCertFile(certFile))
+	require.NoError(t, sv.SetTlsKeyFile(keyFile))
+
+	pu := config.NewParameterUnit(sv, host.New(sv.GetHostMmuLimitation()), mempool.New(), nil, nil, nil)
+	ppu := NewPDCallbackParameterUnit(int(sv.GetPeriodOfEpochTimer()), int(sv.GetPeriodOfPersistence()), int(sv.GetPeriodOfDDLDeleteTimer()), int(sv.GetTimeoutOfHeartbeat()), sv.GetEnableEpochLogging(), math.MaxInt64)
+	pci := NewPDCallbackImpl(ppu)
+
+	port := 6011
+	mo := NewMOServer(fmt.Sprintf("127.0.0.1:%d", port), pu, pci)
+	require.NoError(t, mo.Start())
+	defer func() {
+		require.NoError(t, mo.Stop())
+	}()
+
+	ca, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(ca))
+	err = mysql.RegisterTLSConfig("mo-tls-test", &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"})
+	require.NoError(t, err)
+	defer mysql.DeregisterTLSConfig("mo-tls-test")
+
+	ping := func(tlsName string) error {
+		dsn := fmt.Sprintf("dump:111@tcp(127.0.0.1:%d)/?tls=%s&readTimeout=10s&timeout=10s&writeTimeout=10s", port, tlsName)
+		db, err := sql.Open("mysql", dsn)
+		require.NoError(t, err)
+		defer close_db(t, db)
+		return db.Ping()
+	}
+
+	//both the plain and the TLS connections are accepted
+	require.NoError(t, ping("false"))
+	require.NoError(t, ping("mo-tls-test"))
+
+	//only the TLS connections are accepted
+	require.NoError(t, sv.SetRequireSecureTransport(true))
+	err = ping("false")
+	require.Error(t, err)
+	myErr, ok := err.(*mysql.MySQLError)
+	require.True(t, ok)
+	require.Equal(t, ER_SECURE_TRANSPORT_REQUIRED, myErr.Number)
+	require.NoError(t, ping("mo-tls-test"))
+}
@@ -0,0 +1,255 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var (
+	_ engine.Engine = (*sessionEngine)(nil)
+)
+
+/*
+sessionEngine forwards the operations to the transaction of the session.
+The storage engine is used directly when the session is not in a transaction.
+*/
+type sessionEngine struct {
+	ses *Session
+}
+
+func (e *sessionEngine) engine() engine.Engine {
+	if e.ses.txn != nil {
+		return e.ses.txn
+	}
+	return e.ses.Pu.StorageEngine
+}
+
+func (e *sessionEngine) Delete(epoch uint64, name string) error {
+	return e.engine().Delete(epoch, name)
+}
+
+func (e *sessionEngine) Create(epoch uint64, name string, typ int) error {
+	return e.engine().Create(epoch, name, typ)
+}
+
+func (e *sessionEngine) Databases() []string {
+	return e.engine().Databases()
+}
+
+func (e *sessionEngine) Database(name string) (engine.Database, error) {
+	return e.engine().Database(name)
+}
+
+func (e *sessionEngine) Node(ip string) *engine.NodeInfo {
+	return e.engine().Node(ip)
+}
+
+//txnEngine returns the storage engine if it supports transactions
+func (ses *Session) txnEngine() engine.TxnEngine {
+	te, _ := ses.Pu.StorageEngine.(engine.TxnEngine)
+	return te
+}
+
+//InTxn returns true if the session is in a transaction which lasts until
+//COMMIT or ROLLBACK
+func (ses *Session) InTxn() bool {
+	return ses.txn != nil && !ses.stmtTxn
+}
+
+func (ses *Session) startTxn(stmtTxn bool) error {
+	te := ses.txnEngine()
+	if te == nil {
+		return nil
+	}
+	txn, err := te.StartTxn()
+	if err != nil {
+		return err
+	}
+	ses.txn, ses.stmtTxn = txn, stmtTxn
+	return nil
+}
+
+//BeginTxn starts a transaction, the active one is committed before
+func (ses *Session) BeginTxn() error {
+	if err := ses.CommitTxn(); err != nil {
+		return err
+	}
+	return ses.startTxn(false)
+}
+
+//CommitTxn commits the transaction of the session if there is one
+func (ses *Session) CommitTxn() error {
+	if ses.txn == nil {
+		return nil
+	}
+	txn := ses.txn
+	ses.txn, ses.stmtTxn = nil, false
+	return txnError(txn.Commit())
+}
+
+//RollbackTxn rolls back the transaction of the session if there is one
+func (ses *Session) RollbackTxn() error {
+	if ses.txn == nil {
+		return nil
+	}
+	txn := ses.txn
+	ses.txn, ses.stmtTxn = nil, false
+	return txnError(txn.Rollback())
+}
+
+//SetAutocommit changes the autocommit of the session,
+//the active transaction is committed when it is turned on.
+func (ses *Session) SetAutocommit(on bool) error {
+	ses.autocommit = on
+	if on {
+		return ses.CommitTxn()
+	}
+	return nil
+}
+
+/*
+beginStmt starts a transaction for the statement if the session is not in one.
+The transaction is committed after the statement when autocommit is on,
+otherwise it lasts until COMMIT or ROLLBACK.
+*/
+func (ses *Session) beginStmt() error {
+	if ses.txn != nil {
+		return nil
+	}
+	return ses.startTxn(ses.autocommit)
+}
+
+/*
+endStmt commits the transaction of the statement, or rolls it back if the statement fails.
+The changes of a failed statement can not be rolled back alone, so the transaction lasting
+until COMMIT or ROLLBACK is rolled back as a whole and the client is told about it.
+*/
+func (ses *Session) endStmt(err error) error {
+	if ses.txn == nil {
+		return txnError(err)
+	}
+	if err == nil {
+		if ses.stmtTxn {
+			return ses.CommitTxn()
+		}
+		return nil
+	}
+	inTxn := ses.InTxn()
+	if er := ses.RollbackTxn(); er != nil {
+		logutil.Errorf("rollback the transaction failed. error:%v", er)
+	}
+	if inTxn && !errors.Is(err, engine.ErrTxnConflict) {
+		return rolledBackError(err)
+	}
+	return txnError(err)
+}
+
+//rolledBackError tells the client that the transaction was rolled back by the failed statement
+func rolledBackError(err error) error {
+	const format = "%s, the transaction is rolled back"
+	if myerr, ok := err.(*MysqlError); ok {
+		return &MysqlError{
+			ErrorCode: myerr.ErrorCode,
+			SqlState:  myerr.SqlState,
+			Format:    format,
+			Args:      []interface{}{myerr.Error()},
+		}
+	}
+	return fmt.Errorf(format, err)
+}
+
+//txnError converts the conflict of the transactions to the mysql error
+func txnError(err error) error {
+	if errors.Is(err, engine.ErrTxnConflict) {
+		return NewMysqlError(ER_LOCK_DEADLOCK)
+	}
+	return err
+}
+
+//handleTxnStmt executes BEGIN, COMMIT or ROLLBACK
+func (mce *MysqlCmdExecutor) handleTxnStmt(stmt tree.Statement) error {
+	var err error
+	ses := mce.GetSession()
+	switch stmt.(type) {
+	case *tree.BeginTransaction:
+		err = ses.BeginTxn()
+	case *tree.CommitTransaction:
+		err = ses.CommitTxn()
+	case *tree.RollbackTransaction:
+		err = ses.RollbackTxn()
+	}
+	if err != nil {
+		return err
+	}
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	return ses.protocol.SendResponse(resp)
+}
+
+/*
+handleSetAutocommit changes the autocommit of the session if it is set by the statement.
+The value can be 0/1, ON/OFF or TRUE/FALSE.
+*/
+func (mce *MysqlCmdExecutor) handleSetAutocommit(sv *tree.SetVar) error {
+	if sv == nil {
+		return nil
+	}
+	for _, assign := range sv.Assignments {
+		if !assign.System || assign.Global || strings.ToLower(assign.Name) != "autocommit" {
+			continue
+		}
+		on, ok := autocommitValue(assign.Value)
+		if !ok {
+			value := tree.String(assign.Value, dialect.MYSQL)
+			return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, assign.Name, value)
+		}
+		if err := mce.GetSession().SetAutocommit(on); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func autocommitValue(expr tree.Expr) (bool, bool) {
+	nv, ok := expr.(*tree.NumVal)
+	if !ok || nv.Value == nil {
+		return false, false
+	}
+	switch nv.Value.Kind() {
+	case constant.Bool:
+		return constant.BoolVal(nv.Value), true
+	case constant.Int:
+		v, ok := constant.Int64Val(nv.Value)
+		if ok && (v == 0 || v == 1) {
+			return v == 1, true
+		}
+	case constant.String:
+		switch strings.ToLower(constant.StringVal(nv.Value)) {
+		case "on", "1":
+			return true, true
+		case "off", "0":
+			return false, true
+		}
+	}
+	return false, false
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"go/constant"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+type testTxn struct {
+	engine.Engine
+	commitErr  error
+	committed  bool
+	rollbacked bool
+}
+
+func (txn *testTxn) Commit() error {
+	txn.committed = true
+	return txn.commitErr
+}
+
+func (txn *testTxn) Rollback() error {
+	txn.rollbacked = true
+	return nil
+}
+
+type testTxnEngine struct {
+	engine.Engine
+	txns []*testTxn
+}
+
+func (e *testTxnEngine) StartTxn() (engine.Txn, error) {
+	txn := &testTxn{}
+	e.txns = append(e.txns, txn)
+	return txn, nil
+}
+
+func Test_sessionTxn(t *testing.T) {
+	newSession := func() (*Session, *testTxnEngine) {
+		eng := &testTxnEngine{}
+		return &Session{Pu: &config.ParameterUnit{StorageEngine: eng}, autocommit: true}, eng
+	}
+
+	convey.Convey("the statement is committed when autocommit is on", t, func() {
+		ses, eng := newSession()
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		convey.So(ses.InTxn(), convey.ShouldBeFalse)
+		convey.So(ses.endStmt(nil), convey.ShouldBeNil)
+		convey.So(ses.txn, convey.ShouldBeNil)
+		convey.So(eng.txns[0].committed, convey.ShouldBeTrue)
+
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		convey.So(ses.endStmt(errors.New("failed")), convey.ShouldNotBeNil)
+		convey.So(ses.txn, convey.ShouldBeNil)
+		convey.So(eng.txns[1].rollbacked, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("the transaction lasts until commit", t, func() {
+		ses, eng := newSession()
+		convey.So(ses.BeginTxn(), convey.ShouldBeNil)
+		convey.So(ses.InTxn(), convey.ShouldBeTrue)
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		convey.So(ses.endStmt(nil), convey.ShouldBeNil)
+		convey.So(ses.InTxn(), convey.ShouldBeTrue)
+		convey.So(len(eng.txns), convey.ShouldEqual, 1)
+
+		convey.So(ses.CommitTxn(), convey.ShouldBeNil)
+		convey.So(ses.InTxn(), convey.ShouldBeFalse)
+		convey.So(eng.txns[0].committed, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("the transaction is rolled back by the failed statement", t, func() {
+		ses, eng := newSession()
+		convey.So(ses.BeginTxn(), convey.ShouldBeNil)
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		convey.So(ses.endStmt(nil), convey.ShouldBeNil)
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		err := ses.endStmt(NewMysqlError(ER_DUP_ENTRY, "1", "PRIMARY"))
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_DUP_ENTRY)
+		convey.So(err.Error(), convey.ShouldEndWith, "the transaction is rolled back")
+		convey.So(ses.InTxn(), convey.ShouldBeFalse)
+		convey.So(eng.txns[0].rollbacked, convey.ShouldBeTrue)
+		convey.So(eng.txns[0].committed, convey.ShouldBeFalse)
+
+		convey.So(ses.BeginTxn(), convey.ShouldBeNil)
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		err = ses.endStmt(errors.New("failed"))
+		convey.So(err.Error(), convey.ShouldEqual, "failed, the transaction is rolled back")
+		convey.So(ses.InTxn(), convey.ShouldBeFalse)
+		convey.So(eng.txns[1].rollbacked, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("the transaction is started by the statement when autocommit is off", t, func() {
+		ses, eng := newSession()
+		convey.So(ses.SetAutocommit(false), convey.ShouldBeNil)
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		convey.So(ses.endStmt(nil), convey.ShouldBeNil)
+		convey.So(ses.InTxn(), convey.ShouldBeTrue)
+		convey.So(ses.SetAutocommit(true), convey.ShouldBeNil)
+		convey.So(ses.InTxn(), convey.ShouldBeFalse)
+		convey.So(eng.txns[0].committed, convey.ShouldBeTrue)
+	})
+
+	convey.Convey("the conflict is reported as the deadlock", t, func() {
+		ses, eng := newSession()
+		convey.So(ses.BeginTxn(), convey.ShouldBeNil)
+		convey.So(ses.beginStmt(), convey.ShouldBeNil)
+		err := ses.endStmt(engine.ErrTxnConflict)
+		convey.So(err, convey.ShouldResemble, NewMysqlError(ER_LOCK_DEADLOCK))
+		convey.So(ses.InTxn(), convey.ShouldBeFalse)
+		convey.So(eng.txns[0].rollbacked, convey.ShouldBeTrue)
+
+		convey.So(ses.BeginTxn(), convey.ShouldBeNil)
+		eng.txns[1].commitErr = engine.ErrTxnConflict
+		convey.So(ses.CommitTxn(), convey.ShouldResemble, NewMysqlError(ER_LOCK_DEADLOCK))
+		convey.So(ses.InTxn(), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("the value of autocommit", t, func() {
+		kases := []struct {
+			value constant.Value
+			on    bool
+			ok    bool
+		}{
+			{constant.MakeInt64(1), true, true},
+			{constant.MakeInt64(0), false, true},
+			{constant.MakeInt64(2), false, false},
+			{constant.MakeBool(true), true, true},
+			{constant.MakeString("ON"), true, true},
+			{constant.MakeString("off"), false, true},
+			{constant.MakeString("yes"), false, false},
+		}
+		for _, kase := range kases {
+			on, ok := autocommitValue(tree.NewNumVal(kase.value, "", false))
+			convey.So(ok, convey.ShouldEqual, kase.ok)
+			convey.So(on, convey.ShouldEqual, kase.on)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import "math"
+
+// parameters of the BM25 ranking function
+const (
+	K1 = 1.2
+	B  = 0.75
+)
+
+// Stats is the corpus statistics used by BM25, the stats of the indexes
+// of the segments are merged to score the documents of the whole table.
+type Stats struct {
+	Docs     uint64
+	Tokens   uint64
+	DocFreqs map[string]uint64
+}
+
+func NewStats() *Stats {
+	return &Stats{
+		DocFreqs: make(map[string]uint64),
+	}
+}
+
+func (s *Stats) Merge(o *Stats) {
+	s.Docs += o.Docs
+	s.Tokens += o.Tokens
+	for key, df := range o.DocFreqs {
+		s.DocFreqs[key] += df
+	}
+}
+
+func (s *Stats) IDF(key string) float64 {
+	df := float64(s.DocFreqs[key])
+	return math.Log(1 + (float64(s.Docs)-df+0.5)/(df+0.5))
+}
+
+// Score returns the BM25 score of a clause occurring tf times in a
+// document of length tokens
+func (s *Stats) Score(key string, tf, length uint32) float64 {
+	if tf == 0 {
+		return 0
+	}
+	avg := 1.0
+	if s.Docs > 0 && s.Tokens > 0 {
+		avg = float64(s.Tokens) / float64(s.Docs)
+	}
+	f := float64(tf)
+	return s.IDF(key) * f * (K1 + 1) / (f + K1*(1-B+B*float64(length)/avg))
+}
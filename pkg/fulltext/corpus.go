@@ -0,0 +1,289 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+var ErrInvalidSearch = errors.New("fulltext: invalid search")
+
+// Corpus is the indexes of the parts of a table, e.g. its segments or its
+// blocks. A query is resolved against the whole corpus so that the rows of
+// any part are scored by the statistics of the table.
+type Corpus []*Index
+
+// Stats returns the statistics of the corpus for the clauses of q
+func (c Corpus) Stats(q *Query) *Stats {
+	s := NewStats()
+	for _, idx := range c {
+		s.Merge(idx.Stats(q))
+	}
+	return s
+}
+
+// Expand returns q with the terms of the most relevant document of the
+// corpus added as optional clauses, which is the blind query expansion.
+func (c Corpus) Expand(q *Query, stats *Stats) *Query {
+	var best *Index
+	var bestDoc int
+	var bestScore float64
+	for _, idx := range c {
+		if doc, score := idx.best(q, stats); doc >= 0 && (best == nil || score > bestScore) {
+			best, bestDoc, bestScore = idx, doc, score
+		}
+	}
+	expanded := &Query{Mode: q.Mode, Clauses: append([]*Clause{}, q.Clauses...)}
+	if best == nil {
+		return expanded
+	}
+	for _, term := range best.terms(uint32(bestDoc)) {
+		expanded.add(&Clause{Op: Optional, Terms: []string{term}})
+	}
+	return expanded
+}
+
+// Search resolves q against the corpus, q is expanded in the query
+// expansion mode.
+func (c Corpus) Search(q *Query) *Search {
+	if q.Mode == QueryExpansion {
+		q = c.Expand(q, c.Stats(q))
+	}
+	return &Search{Query: q, Stats: c.Stats(q)}
+}
+
+// Search is a query resolved against a whole table, any part of the table
+// is scored by it. The query of a table without full-text indexes is not
+// resolved, Stats is nil and each part is scored by its own statistics.
+// Scores is set if the documents of the table are matched from the postings
+// of its indexes, it is the relevance of the matched documents keyed by
+// DocKey of their texts and the other documents have a zero relevance.
+type Search struct {
+	Query  *Query
+	Stats  *Stats
+	Scores map[string]float64
+}
+
+// DocKey returns the key of the document made of texts. The relevance of a
+// document only depends on its texts, the documents with the same texts
+// have the same relevance.
+func DocKey(texts ...[]byte) string {
+	var buf bytes.Buffer
+	for _, text := range texts {
+		buf.Write(encoding.EncodeUint32(uint32(len(text))))
+		buf.Write(text)
+	}
+	return buf.String()
+}
+
+// Match scores the documents of idx in the postings of the query and keeps
+// their relevance in Scores, texts returns the texts of a document of idx.
+// The other documents of idx are not read.
+func (s *Search) Match(idx *Index, texts func(doc uint32) ([][]byte, error)) error {
+	if s.Scores == nil {
+		s.Scores = make(map[string]float64)
+	}
+	for doc, score := range idx.Search(s.Query, s.Stats) {
+		if score == 0 {
+			continue
+		}
+		ts, err := texts(uint32(doc))
+		if err != nil {
+			return err
+		}
+		s.Scores[DocKey(ts...)] = score
+	}
+	return nil
+}
+
+// NewIndex returns the index of the documents to score by the search, only
+// the postings of the terms of the query are kept unless the query is still
+// to be expanded by the documents.
+func (s *Search) NewIndex() *Index {
+	if s.Stats == nil && s.Query.Mode == QueryExpansion {
+		return NewIndex()
+	}
+	return NewQueryIndex(s.Query)
+}
+
+// Score returns the relevance of each document of idx to the search
+func (s *Search) Score(idx *Index) []float64 {
+	if s.Stats == nil {
+		return Corpus{idx}.Search(s.Query).Score(idx)
+	}
+	return idx.Search(s.Query, s.Stats)
+}
+
+func (s *Search) Marshal() []byte {
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeUint32(uint32(s.Query.Mode)))
+	buf.Write(encoding.EncodeUint32(uint32(len(s.Query.Clauses))))
+	for _, c := range s.Query.Clauses {
+		buf.Write(encoding.EncodeUint32(uint32(c.Op)))
+		if c.Prefix {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(c.Terms))))
+		for _, term := range c.Terms {
+			writeString(&buf, term)
+		}
+	}
+	if s.Stats == nil {
+		buf.WriteByte(0)
+		return buf.Bytes()
+	}
+	buf.WriteByte(1)
+	buf.Write(encoding.EncodeUint64(s.Stats.Docs))
+	buf.Write(encoding.EncodeUint64(s.Stats.Tokens))
+	keys := make([]string, 0, len(s.Stats.DocFreqs))
+	for key := range s.Stats.DocFreqs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	buf.Write(encoding.EncodeUint32(uint32(len(keys))))
+	for _, key := range keys {
+		writeString(&buf, key)
+		buf.Write(encoding.EncodeUint64(s.Stats.DocFreqs[key]))
+	}
+	if s.Scores == nil {
+		buf.WriteByte(0)
+		return buf.Bytes()
+	}
+	buf.WriteByte(1)
+	docs := make([]string, 0, len(s.Scores))
+	for doc := range s.Scores {
+		docs = append(docs, doc)
+	}
+	sort.Strings(docs)
+	buf.Write(encoding.EncodeUint32(uint32(len(docs))))
+	for _, doc := range docs {
+		buf.Write(encoding.EncodeUint32(uint32(len(doc))))
+		buf.WriteString(doc)
+		buf.Write(encoding.EncodeFloat64(s.Scores[doc]))
+	}
+	return buf.Bytes()
+}
+
+func (s *Search) Unmarshal(data []byte) error {
+	d := &decoder{data: data}
+	s.Query = &Query{Mode: Mode(d.uint32())}
+	s.Query.Clauses = make([]*Clause, d.count())
+	for i := range s.Query.Clauses {
+		c := &Clause{Op: Operator(d.uint32())}
+		if buf := d.next(1); buf != nil {
+			c.Prefix = buf[0] == 1
+		}
+		c.Terms = make([]string, d.count())
+		for j := range c.Terms {
+			c.Terms[j] = d.string()
+		}
+		s.Query.Clauses[i] = c
+		if d.err != nil {
+			return d.err
+		}
+	}
+	s.Stats = nil
+	if buf := d.next(1); buf == nil || buf[0] == 0 {
+		if d.err == nil && len(d.data) != 0 {
+			return ErrInvalidSearch
+		}
+		return d.err
+	}
+	s.Stats = NewStats()
+	s.Stats.Docs = d.uint64()
+	s.Stats.Tokens = d.uint64()
+	for n := d.count(); n > 0 && d.err == nil; n-- {
+		key := d.string()
+		s.Stats.DocFreqs[key] = d.uint64()
+	}
+	s.Scores = nil
+	if buf := d.next(1); buf == nil || buf[0] == 0 {
+		if d.err == nil && len(d.data) != 0 {
+			return ErrInvalidSearch
+		}
+		return d.err
+	}
+	s.Scores = make(map[string]float64)
+	for n := d.count(); n > 0 && d.err == nil; n-- {
+		doc := string(d.next(int(d.uint32())))
+		if buf := d.next(8); buf != nil {
+			s.Scores[doc] = encoding.DecodeFloat64(buf)
+		}
+	}
+	if d.err == nil && len(d.data) != 0 {
+		return ErrInvalidSearch
+	}
+	return d.err
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	buf.Write(encoding.EncodeUint16(uint16(len(s))))
+	buf.WriteString(s)
+}
+
+// decoder reads the fields of a search, the first error is kept and the
+// reads after it return zero values.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil || len(d.data) < n {
+		d.err = ErrInvalidSearch
+		return nil
+	}
+	buf := d.data[:n]
+	d.data = d.data[n:]
+	return buf
+}
+
+// count reads the number of the elements which follow, each of them takes
+// at least one byte.
+func (d *decoder) count() int {
+	n := int(d.uint32())
+	if n > len(d.data) {
+		d.err = ErrInvalidSearch
+		return 0
+	}
+	return n
+}
+
+func (d *decoder) uint32() uint32 {
+	if buf := d.next(4); buf != nil {
+		return encoding.DecodeUint32(buf)
+	}
+	return 0
+}
+
+func (d *decoder) uint64() uint64 {
+	if buf := d.next(8); buf != nil {
+		return encoding.DecodeUint64(buf)
+	}
+	return 0
+}
+
+func (d *decoder) string() string {
+	if buf := d.next(2); buf != nil {
+		return string(d.next(int(encoding.DecodeUint16(buf))))
+	}
+	return ""
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"hello", "world", "42"}, Terms("Hello, WORLD! 42"))
+	require.Equal(t, []string{"全文", "文检", "检索", "mysql"}, Terms("全文检索MySQL"))
+	require.Equal(t, []string{"数", "据"}, Terms("数 据"))
+	tokens := Tokenize([]byte("a 全文检索 b"))
+	require.Equal(t, []Token{{"a", 0}, {"全文", 1}, {"文检", 2}, {"检索", 3}, {"b", 4}}, tokens)
+}
+
+func TestParseQuery(t *testing.T) {
+	require.Equal(t, "hello world", ParseQuery("Hello, world hello", NaturalLanguage).String())
+	require.Equal(t, "+mysql -oracle data* \"full text\" +\"全文 文检\"",
+		ParseQuery(`+MySQL -oracle data* "full text" +全文检`, Boolean).String())
+	require.Equal(t, "hello", ParseQuery(`+ "hello`, Boolean).String())
+}
+
+func newIndex(docs ...string) *Index {
+	idx := NewIndex()
+	for _, doc := range docs {
+		idx.Add([]byte(doc))
+	}
+	return idx
+}
+
+func TestSearch(t *testing.T) {
+	idx := newIndex(
+		"MySQL is a database management system",
+		"full text search in MySQL",
+		"a database with full text search and text ranking",
+		"Oracle database",
+		"数据库全文检索",
+	)
+	search := func(s string, mode Mode) []float64 {
+		q := ParseQuery(s, mode)
+		return idx.Search(q, idx.Stats(q))
+	}
+
+	scores := search("text search", NaturalLanguage)
+	require.Equal(t, 0.0, scores[0])
+	require.True(t, scores[1] > 0 && scores[2] > 0)
+	require.Equal(t, []float64{0, 0}, scores[3:])
+
+	scores = search("+database -oracle", Boolean)
+	require.True(t, scores[0] > 0 && scores[2] > 0)
+	require.Equal(t, []float64{0, 0, 0}, []float64{scores[1], scores[3], scores[4]})
+
+	scores = search(`"text search"`, Boolean)
+	require.True(t, scores[1] > 0 && scores[2] > 0)
+	require.Equal(t, 0.0, scores[0])
+	scores = search(`"search text"`, Boolean)
+	require.Equal(t, make([]float64, 5), scores)
+
+	scores = search("manage* or*", Boolean)
+	require.True(t, scores[0] > 0 && scores[3] > 0)
+	require.Equal(t, 0.0, scores[1])
+
+	scores = search("全文", NaturalLanguage)
+	require.True(t, scores[4] > 0)
+	require.Equal(t, make([]float64, 4), scores[:4])
+
+	scores = search("-oracle", Boolean)
+	require.Equal(t, make([]float64, 5), scores)
+
+	// a phrase never matches across the texts of a document
+	multi := NewIndex()
+	multi.Add([]byte("full"), []byte("text"))
+	q := ParseQuery(`"full text"`, Boolean)
+	require.Equal(t, []float64{0}, multi.Search(q, multi.Stats(q)))
+}
+
+func TestBM25(t *testing.T) {
+	idx := newIndex("apple", "apple apple banana", "banana cherry", "cherry")
+	q := ParseQuery("apple", NaturalLanguage)
+	scores := idx.Search(q, idx.Stats(q))
+	require.True(t, scores[0] > scores[1])
+
+	// a term rare in the corpus is more relevant
+	q = ParseQuery("apple banana", NaturalLanguage)
+	stats := idx.Stats(q)
+	require.True(t, stats.IDF("apple") == stats.IDF("banana"))
+	other := newIndex("banana", "banana split", "banana bread")
+	stats.Merge(other.Stats(q))
+	require.Equal(t, uint64(7), stats.Docs)
+	require.Equal(t, uint64(5), stats.DocFreqs["banana"])
+	require.True(t, stats.IDF("apple") > stats.IDF("banana"))
+
+	q = ParseQuery("cherry", QueryExpansion)
+	expanded := idx.Expand(q, idx.Stats(q))
+	require.Equal(t, "cherry", expanded.String())
+	q = ParseQuery("banana", QueryExpansion)
+	expanded = idx.Expand(q, idx.Stats(q))
+	scores = idx.Search(expanded, idx.Stats(expanded))
+	require.True(t, scores[3] > 0)
+}
+
+func TestMarshal(t *testing.T) {
+	idx := newIndex("full text search", "", "数据库全文检索", "text text")
+	data := idx.Marshal()
+	other := new(Index)
+	require.NoError(t, other.Unmarshal(data))
+	require.Equal(t, idx, other)
+	require.Equal(t, ErrInvalidIndex, other.Unmarshal(data[:len(data)-1]))
+}
+
+func TestCorpus(t *testing.T) {
+	docs := []string{"apple", "apple apple banana", "banana cherry", "cherry"}
+	whole := newIndex(docs...)
+	corpus := Corpus{newIndex(docs[:2]...), newIndex(docs[2:]...)}
+	q := ParseQuery("apple cherry", NaturalLanguage)
+	require.Equal(t, whole.Stats(q), corpus.Stats(q))
+
+	// the parts are scored by the statistics of the whole corpus
+	s := corpus.Search(q)
+	require.Equal(t, whole.Search(q, whole.Stats(q)), append(s.Score(corpus[0]), s.Score(corpus[1])...))
+
+	// the best document of any part expands the query
+	s = corpus.Search(ParseQuery("banana", QueryExpansion))
+	require.Equal(t, "banana cherry", s.Query.String())
+	require.Equal(t, uint64(2), s.Stats.DocFreqs["cherry"])
+
+	// the deleted documents are not in the statistics
+	corpus[0].Delete(0)
+	require.Equal(t, 1, corpus[0].Docs())
+	s = corpus.Search(q)
+	require.Equal(t, uint64(3), s.Stats.Docs)
+	require.Equal(t, uint64(6), s.Stats.Tokens)
+	require.Equal(t, uint64(1), s.Stats.DocFreqs["apple"])
+	require.Equal(t, 0.0, s.Score(corpus[0])[0])
+}
+
+func TestMarshalSearch(t *testing.T) {
+	s := Corpus{newIndex("full text search", "数据库全文检索")}.Search(ParseQuery(`+"full text" data* -数据`, Boolean))
+	data := s.Marshal()
+	other := new(Search)
+	require.NoError(t, other.Unmarshal(data))
+	require.Equal(t, s, other)
+	require.Equal(t, ErrInvalidSearch, other.Unmarshal(data[:len(data)-1]))
+	require.Equal(t, ErrInvalidSearch, other.Unmarshal(append(data, 0)))
+
+	// the documents matched from the postings are marshaled with the search
+	idx := newIndex("full text search", "data of full text")
+	s = Corpus{idx}.Search(ParseQuery("text", NaturalLanguage))
+	require.NoError(t, s.Match(idx, func(doc uint32) ([][]byte, error) {
+		return [][]byte{[]byte([]string{"full text search", "data of full text"}[doc])}, nil
+	}))
+	require.Equal(t, 2, len(s.Scores))
+	data = s.Marshal()
+	other = new(Search)
+	require.NoError(t, other.Unmarshal(data))
+	require.Equal(t, s, other)
+	require.Equal(t, ErrInvalidSearch, other.Unmarshal(data[:len(data)-1]))
+
+	// a search without statistics is scored by the statistics of each part
+	s = &Search{Query: ParseQuery("full text", NaturalLanguage)}
+	data = s.Marshal()
+	other = new(Search)
+	require.NoError(t, other.Unmarshal(data))
+	require.Equal(t, s, other)
+	require.Equal(t, ErrInvalidSearch, other.Unmarshal(append(data, 0)))
+}
+
+func TestQueryIndex(t *testing.T) {
+	docs := []string{"full text search", "数据库全文检索", "text ranking of a database", "oracle"}
+	whole := newIndex(docs...)
+	for _, str := range []string{"text", "+data* -oracle", `"full text" search`} {
+		q := ParseQuery(str, Boolean)
+		s := &Search{Query: q}
+		idx := s.NewIndex()
+		for _, doc := range docs {
+			idx.Add([]byte(doc))
+		}
+		require.Equal(t, whole.Lengths, idx.Lengths)
+		require.True(t, len(idx.Postings) < len(whole.Postings))
+		require.Equal(t, whole.Search(q, whole.Stats(q)), s.Score(idx))
+	}
+}
@@ -0,0 +1,378 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+var ErrInvalidIndex = errors.New("fulltext: invalid index")
+
+// Posting is the positions of a term in a document
+type Posting struct {
+	Doc       uint32
+	Positions []uint32
+}
+
+// Index is an inverted index of documents numbered from 0 in the order
+// they are added, e.g. the rows of a segment. Postings of a term are in
+// ascending order of documents.
+type Index struct {
+	Lengths  []uint32
+	Postings map[string][]Posting
+
+	deleted map[uint32]struct{} // documents removed by Delete
+	query   *Query              // only the postings of the terms of query are kept if set
+}
+
+func NewIndex() *Index {
+	return &Index{
+		Postings: make(map[string][]Posting),
+	}
+}
+
+// NewQueryIndex returns an index which only keeps the postings of the terms
+// of q, the lengths of the documents still count all their terms. It scores
+// the documents by q without indexing the terms which q never looks up.
+func NewQueryIndex(q *Query) *Index {
+	idx := NewIndex()
+	idx.query = q
+	return idx
+}
+
+func (idx *Index) Docs() int {
+	return len(idx.Lengths) - len(idx.deleted)
+}
+
+// Delete removes the documents, e.g. the deleted rows of a segment, the
+// other documents keep their numbers. The deletes are not marshaled.
+func (idx *Index) Delete(docs ...uint32) {
+	if len(docs) == 0 {
+		return
+	}
+	if idx.deleted == nil {
+		idx.deleted = make(map[uint32]struct{})
+	}
+	for _, doc := range docs {
+		idx.deleted[doc] = struct{}{}
+	}
+	for term, ps := range idx.Postings {
+		live := ps[:0]
+		for _, p := range ps {
+			if _, ok := idx.deleted[p.Doc]; !ok {
+				live = append(live, p)
+			}
+		}
+		if len(live) == 0 {
+			delete(idx.Postings, term)
+		} else {
+			idx.Postings[term] = live
+		}
+	}
+}
+
+// Add adds a document made of texts and returns its number, the texts
+// are tokenized as if they were concatenated.
+func (idx *Index) Add(texts ...[]byte) uint32 {
+	doc := uint32(len(idx.Lengths))
+	var tokens []Token
+	for _, text := range texts {
+		pos := uint32(0)
+		if len(tokens) > 0 {
+			// never match a phrase across texts
+			pos = tokens[len(tokens)-1].Pos + 2
+		}
+		tokens = tokenize(text, pos, tokens)
+	}
+	idx.Lengths = append(idx.Lengths, uint32(len(tokens)))
+	for _, tok := range tokens {
+		if idx.query != nil && !idx.query.lookup(tok.Term) {
+			continue
+		}
+		ps := idx.Postings[tok.Term]
+		if n := len(ps); n > 0 && ps[n-1].Doc == doc {
+			ps[n-1].Positions = append(ps[n-1].Positions, tok.Pos)
+			continue
+		}
+		idx.Postings[tok.Term] = append(ps, Posting{Doc: doc, Positions: []uint32{tok.Pos}})
+	}
+	return doc
+}
+
+// AddVectors adds a document for each row of the vectors of char or
+// varchar, the document of a row is made of its values which are not null.
+func (idx *Index) AddVectors(vecs []*vector.Vector) {
+	if len(vecs) == 0 {
+		return
+	}
+	texts := make([][]byte, 0, len(vecs))
+	for row, rows := 0, vector.Length(vecs[0]); row < rows; row++ {
+		texts = texts[:0]
+		for _, vec := range vecs {
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				continue
+			}
+			texts = append(texts, vec.Col.(*types.Bytes).Get(int64(row)))
+		}
+		idx.Add(texts...)
+	}
+}
+
+// freqs returns the term frequencies of the clause in the documents
+func (idx *Index) freqs(c *Clause) map[uint32]uint32 {
+	tfs := make(map[uint32]uint32)
+	if len(c.Terms) == 1 {
+		for _, p := range idx.postings(c.Terms[0], c.Prefix) {
+			tfs[p.Doc] += uint32(len(p.Positions))
+		}
+		return tfs
+	}
+	// a phrase, collect the positions of each term per document and
+	// count the positions followed by the rest of the terms in order
+	positions := make([]map[uint32]map[uint32]struct{}, len(c.Terms))
+	for i, term := range c.Terms {
+		positions[i] = make(map[uint32]map[uint32]struct{})
+		for _, p := range idx.postings(term, c.Prefix && i == len(c.Terms)-1) {
+			m, ok := positions[i][p.Doc]
+			if !ok {
+				m = make(map[uint32]struct{})
+				positions[i][p.Doc] = m
+			}
+			for _, pos := range p.Positions {
+				m[pos] = struct{}{}
+			}
+		}
+	}
+	for doc, starts := range positions[0] {
+		for start := range starts {
+			matched := true
+			for i := 1; i < len(c.Terms); i++ {
+				if _, ok := positions[i][doc][start+uint32(i)]; !ok {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				tfs[doc]++
+			}
+		}
+	}
+	return tfs
+}
+
+func (idx *Index) postings(term string, prefix bool) []Posting {
+	if !prefix {
+		return idx.Postings[term]
+	}
+	var ps []Posting
+	for t, tps := range idx.Postings {
+		if strings.HasPrefix(t, term) {
+			ps = append(ps, tps...)
+		}
+	}
+	return ps
+}
+
+// Stats returns the statistics of the index for the clauses of q
+func (idx *Index) Stats(q *Query) *Stats {
+	s := NewStats()
+	s.Docs = uint64(idx.Docs())
+	for doc, l := range idx.Lengths {
+		if _, ok := idx.deleted[uint32(doc)]; !ok {
+			s.Tokens += uint64(l)
+		}
+	}
+	for _, c := range q.Clauses {
+		s.DocFreqs[c.Key()] = uint64(len(idx.freqs(c)))
+	}
+	return s
+}
+
+// candidate is a document in the postings of the clauses of a query
+type candidate struct {
+	score    float64
+	musts    int
+	matched  bool // matched by a clause which is not excluding
+	excluded bool
+}
+
+// Search returns the relevance of each document to q, stats is the
+// statistics of the whole corpus which the index is a part of. Only the
+// documents in the postings of the clauses are scored, the others have a
+// zero relevance.
+func (idx *Index) Search(q *Query, stats *Stats) []float64 {
+	nmust := 0
+	cands := make(map[uint32]*candidate)
+	for _, c := range q.Clauses {
+		if c.Op == Must {
+			nmust++
+		}
+		key := c.Key()
+		for doc, tf := range idx.freqs(c) {
+			cand, ok := cands[doc]
+			if !ok {
+				cand = new(candidate)
+				cands[doc] = cand
+			}
+			switch c.Op {
+			case MustNot:
+				cand.excluded = true
+				continue
+			case Must:
+				cand.musts++
+			}
+			cand.matched = true
+			cand.score += stats.Score(key, tf, idx.Lengths[doc])
+		}
+	}
+	scores := make([]float64, len(idx.Lengths))
+	for doc, cand := range cands {
+		if cand.matched && !cand.excluded && cand.musts == nmust {
+			scores[doc] = cand.score
+		}
+	}
+	return scores
+}
+
+// Expand returns q with the terms of the most relevant document added as
+// optional clauses, which is the blind query expansion.
+func (idx *Index) Expand(q *Query, stats *Stats) *Query {
+	return Corpus{idx}.Expand(q, stats)
+}
+
+// best returns the most relevant document to q and its relevance, the
+// document is -1 if none is matched.
+func (idx *Index) best(q *Query, stats *Stats) (int, float64) {
+	scores := idx.Search(q, stats)
+	best := -1
+	for doc, score := range scores {
+		if score > 0 && (best < 0 || score > scores[best]) {
+			best = doc
+		}
+	}
+	if best < 0 {
+		return -1, 0
+	}
+	return best, scores[best]
+}
+
+// terms returns the terms of the document in order
+func (idx *Index) terms(doc uint32) []string {
+	var terms []string
+	for term, ps := range idx.Postings {
+		i := sort.Search(len(ps), func(i int) bool { return ps[i].Doc >= doc })
+		if i < len(ps) && ps[i].Doc == doc {
+			terms = append(terms, term)
+		}
+	}
+	sort.Strings(terms)
+	return terms
+}
+
+func (idx *Index) Marshal() []byte {
+	var buf bytes.Buffer
+	buf.Write(encoding.EncodeUint32(uint32(len(idx.Lengths))))
+	buf.Write(encoding.EncodeUint32Slice(idx.Lengths))
+	terms := make([]string, 0, len(idx.Postings))
+	for term := range idx.Postings {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+	buf.Write(encoding.EncodeUint32(uint32(len(terms))))
+	for _, term := range terms {
+		buf.Write(encoding.EncodeUint16(uint16(len(term))))
+		buf.WriteString(term)
+		ps := idx.Postings[term]
+		buf.Write(encoding.EncodeUint32(uint32(len(ps))))
+		for _, p := range ps {
+			buf.Write(encoding.EncodeUint32(p.Doc))
+			buf.Write(encoding.EncodeUint32(uint32(len(p.Positions))))
+			buf.Write(encoding.EncodeUint32Slice(p.Positions))
+		}
+	}
+	return buf.Bytes()
+}
+
+func (idx *Index) Unmarshal(data []byte) error {
+	next := func(n int) ([]byte, error) {
+		if len(data) < n {
+			return nil, ErrInvalidIndex
+		}
+		buf := data[:n]
+		data = data[n:]
+		return buf, nil
+	}
+	nextUint32 := func() (uint32, error) {
+		buf, err := next(4)
+		if err != nil {
+			return 0, err
+		}
+		return encoding.DecodeUint32(buf), nil
+	}
+	docs, err := nextUint32()
+	if err != nil {
+		return err
+	}
+	buf, err := next(int(docs) * 4)
+	if err != nil {
+		return err
+	}
+	idx.Lengths = append([]uint32{}, encoding.DecodeUint32Slice(buf)...)
+	terms, err := nextUint32()
+	if err != nil {
+		return err
+	}
+	idx.Postings = make(map[string][]Posting, terms)
+	for i := uint32(0); i < terms; i++ {
+		if buf, err = next(2); err != nil {
+			return err
+		}
+		if buf, err = next(int(encoding.DecodeUint16(buf))); err != nil {
+			return err
+		}
+		term := string(buf)
+		n, err := nextUint32()
+		if err != nil {
+			return err
+		}
+		ps := make([]Posting, n)
+		for j := range ps {
+			if ps[j].Doc, err = nextUint32(); err != nil {
+				return err
+			}
+			cnt, err := nextUint32()
+			if err != nil {
+				return err
+			}
+			if buf, err = next(int(cnt) * 4); err != nil {
+				return err
+			}
+			ps[j].Positions = append([]uint32{}, encoding.DecodeUint32Slice(buf)...)
+		}
+		idx.Postings[term] = ps
+	}
+	if len(data) != 0 {
+		return ErrInvalidIndex
+	}
+	return nil
+}
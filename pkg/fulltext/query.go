@@ -0,0 +1,163 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+)
+
+type Mode int
+
+const (
+	NaturalLanguage Mode = iota
+	Boolean
+	QueryExpansion
+)
+
+type Operator int
+
+const (
+	Optional Operator = iota
+	Must
+	MustNot
+)
+
+// Clause matches the documents containing Terms in sequence, a clause of
+// several terms is a phrase. The last term matches every term with it as
+// a prefix if Prefix is set.
+type Clause struct {
+	Op     Operator
+	Terms  []string
+	Prefix bool
+}
+
+// Key identifies the clause in the statistics
+func (c *Clause) Key() string {
+	key := strings.Join(c.Terms, " ")
+	if len(c.Terms) > 1 {
+		key = "\"" + key + "\""
+	}
+	if c.Prefix {
+		key += "*"
+	}
+	return key
+}
+
+type Query struct {
+	Mode    Mode
+	Clauses []*Clause
+}
+
+// ParseQuery parses the search string of MATCH ... AGAINST. In the natural
+// language mode every term is an optional clause. In the boolean mode a
+// word or a quoted phrase may be led by + (must be present) or - (must be
+// absent) and a word may end with * to match it as a prefix, the other
+// boolean operators are ignored.
+func ParseQuery(s string, mode Mode) *Query {
+	q := &Query{Mode: mode}
+	if mode != Boolean {
+		for _, term := range Terms(s) {
+			q.add(&Clause{Op: Optional, Terms: []string{term}})
+		}
+		return q
+	}
+	for i := 0; i < len(s); {
+		op := Optional
+		switch s[i] {
+		case '+':
+			op = Must
+			i++
+		case '-':
+			op = MustNot
+			i++
+		}
+		if i < len(s) && s[i] == '"' {
+			end := strings.IndexByte(s[i+1:], '"')
+			if end < 0 {
+				end = len(s) - i - 1
+			}
+			if terms := Terms(s[i+1 : i+1+end]); len(terms) > 0 {
+				q.add(&Clause{Op: op, Terms: terms})
+			}
+			i += end + 2
+			continue
+		}
+		end := strings.IndexAny(s[i:], " \t\r\n\"")
+		if end < 0 {
+			end = len(s) - i
+		}
+		word := s[i : i+end]
+		i += end
+		if end == 0 {
+			i++
+			continue
+		}
+		prefix := strings.HasSuffix(word, "*")
+		terms := Terms(word)
+		switch {
+		case len(terms) == 0:
+		case len(terms) == 1 || op == Optional:
+			// a word split into several terms, e.g. CJK text, is a
+			// phrase only if it is required or excluded
+			if len(terms) > 1 {
+				for _, term := range terms[:len(terms)-1] {
+					q.add(&Clause{Op: op, Terms: []string{term}})
+				}
+			}
+			q.add(&Clause{Op: op, Terms: terms[len(terms)-1:], Prefix: prefix})
+		default:
+			q.add(&Clause{Op: op, Terms: terms, Prefix: prefix})
+		}
+	}
+	return q
+}
+
+// lookup returns true if a clause of q looks up the postings of the term
+func (q *Query) lookup(term string) bool {
+	for _, c := range q.Clauses {
+		for i, t := range c.Terms {
+			if t == term || (c.Prefix && i == len(c.Terms)-1 && strings.HasPrefix(term, t)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (q *Query) add(c *Clause) {
+	for _, d := range q.Clauses {
+		if d.Op == c.Op && d.Key() == c.Key() {
+			return
+		}
+	}
+	q.Clauses = append(q.Clauses, c)
+}
+
+func (q *Query) String() string {
+	var b strings.Builder
+	for i, c := range q.Clauses {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		switch c.Op {
+		case Must:
+			b.WriteByte('+')
+		case MustNot:
+			b.WriteByte('-')
+		}
+		b.WriteString(c.Key())
+	}
+	return b.String()
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Token is a term of a text and its position in the text
+type Token struct {
+	Term string
+	Pos  uint32
+}
+
+// isCJK reports whether r is a character of the languages written
+// without spaces between words
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// Tokenize splits text into terms. A run of letters and digits is a word
+// and is lower cased, a run of CJK characters is split into overlapping
+// bigrams, a single CJK character is a term itself.
+func Tokenize(text []byte) []Token {
+	return tokenize(text, 0, nil)
+}
+
+func tokenize(text []byte, pos uint32, tokens []Token) []Token {
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRune(text[i:])
+		switch {
+		case isCJK(r):
+			var runes []rune
+			for i < len(text) {
+				if r, size = utf8.DecodeRune(text[i:]); !isCJK(r) {
+					break
+				}
+				runes = append(runes, r)
+				i += size
+			}
+			if len(runes) == 1 {
+				tokens = append(tokens, Token{Term: string(runes), Pos: pos})
+				pos++
+				continue
+			}
+			for j := 0; j+1 < len(runes); j++ {
+				tokens = append(tokens, Token{Term: string(runes[j : j+2]), Pos: pos})
+				pos++
+			}
+		case isWord(r):
+			start := i
+			for i < len(text) {
+				if r, size = utf8.DecodeRune(text[i:]); !isWord(r) || isCJK(r) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, Token{Term: strings.ToLower(string(text[start:i])), Pos: pos})
+			pos++
+		default:
+			i += size
+		}
+	}
+	return tokens
+}
+
+// Terms returns the terms of text in order
+func Terms(text string) []string {
+	tokens := Tokenize([]byte(text))
+	terms := make([]string, len(tokens))
+	for i, tok := range tokens {
+		terms[i] = tok.Term
+	}
+	return terms
+}
@@ -0,0 +1,216 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewMerger creates a merger of the groups sent by n producers, the groups are
+// partitioned by the attributes, all the attributes of a batch are used if attrs is empty.
+func NewMerger(attrs []string, n int) *Merger {
+	return &Merger{
+		attrs: attrs,
+		low:   make([]int, n),
+		first: make([]*batch.Batch, n),
+		sent:  make([]bool, n),
+	}
+}
+
+// Receiver returns the producer to receive the next batch from, which is the one
+// holding back the next partition, it returns -1 if all the producers are done.
+func (m *Merger) Receiver() int {
+	i := -1
+	for j, low := range m.low {
+		if low < Partitions && (i < 0 || low < m.low[i]) {
+			i = j
+		}
+	}
+	return i
+}
+
+// Add adds the groups sent by the i-th producer. Apart from its first batch, the
+// producer will not send the groups of a partition before the least partition of
+// the batch any more.
+func (m *Merger) Add(i int, bat *batch.Batch, mp *mheap.Mheap) error {
+	m.count++
+	if !m.sent[i] {
+		// the first batch is split when a partition is evaluated,
+		// it is the result if it is the only batch sent
+		m.sent[i] = true
+		m.first[i] = bat
+		return nil
+	}
+	low, err := m.split(bat, mp)
+	if err != nil {
+		return err
+	}
+	if low > m.low[i] {
+		m.low[i] = low
+	}
+	return nil
+}
+
+// Done marks that the i-th producer has sent all its groups.
+func (m *Merger) Done(i int) {
+	m.low[i] = Partitions
+}
+
+// Next returns the groups of the next partition which no producer will send any more,
+// it returns false if the groups of the next partition are not complete yet.
+// The partitions without groups are skipped.
+func (m *Merger) Next(mp *mheap.Mheap) ([]*batch.Batch, bool, error) {
+	low := Partitions
+	for _, l := range m.low {
+		if l < low {
+			low = l
+		}
+	}
+	if low == 0 {
+		return nil, false, nil
+	}
+	for i, bat := range m.first {
+		if bat == nil {
+			continue
+		}
+		m.first[i] = nil
+		if low == Partitions && m.count == 1 {
+			m.next = Partitions
+			return []*batch.Batch{bat}, true, nil
+		}
+		if _, err := m.split(bat, mp); err != nil {
+			return nil, false, err
+		}
+	}
+	for m.next < low {
+		bats := m.parts[m.next]
+		m.parts[m.next] = nil
+		m.next++
+		if len(bats) > 0 {
+			return bats, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// split splits the groups into their partitions, and returns the least partition.
+func (m *Merger) split(bat *batch.Batch, mp *mheap.Mheap) (int, error) {
+	bats, err := Split(bat, m.attrs, mp)
+	if err != nil {
+		return 0, err
+	}
+	low := -1
+	for p, b := range bats {
+		if b == nil {
+			continue
+		}
+		if low < 0 {
+			low = p
+		}
+		m.parts[p] = append(m.parts[p], b)
+	}
+	return low, nil
+}
+
+// Clean frees the groups which have not been returned by Next.
+func (m *Merger) Clean(mp *mheap.Mheap) {
+	for i, bat := range m.first {
+		if bat != nil {
+			batch.Clean(bat, mp)
+		}
+		m.first[i] = nil
+	}
+	for p, bats := range m.parts {
+		for _, bat := range bats {
+			batch.Clean(bat, mp)
+		}
+		m.parts[p] = nil
+	}
+}
+
+// Split splits the groups of the batch into the level 0 partitions of the
+// attributes, the i-th batch returned is nil if no group belongs to partition i.
+// The batch is returned as it is if all its groups belong to one partition,
+// otherwise it is freed.
+func Split(bat *batch.Batch, attrs []string, mp *mheap.Mheap) ([]*batch.Batch, error) {
+	if len(attrs) == 0 {
+		attrs = bat.Attrs
+	}
+	vecs := keys(bat, attrs)
+	sels := make([][]int64, Partitions)
+	for i, h := range Hash(vecs, len(bat.Zs)) {
+		p := h % Partitions
+		sels[p] = append(sels[p], int64(i))
+	}
+	bats := make([]*batch.Batch, Partitions)
+	for p, sel := range sels {
+		if len(sel) == len(bat.Zs) {
+			bats[p] = bat
+			return bats, nil
+		}
+	}
+	for p, sel := range sels {
+		if len(sel) == 0 {
+			continue
+		}
+		b, err := shrink(bat, sel, mp)
+		if err != nil {
+			for _, b := range bats {
+				if b != nil {
+					batch.Clean(b, mp)
+				}
+			}
+			return nil, err
+		}
+		bats[p] = b
+	}
+	batch.Clean(bat, mp)
+	return bats, nil
+}
+
+// shrink returns a new batch of the groups of the batch chosen by sels.
+func shrink(bat *batch.Batch, sels []int64, mp *mheap.Mheap) (*batch.Batch, error) {
+	b := batch.New(true, bat.Attrs)
+	b.As = bat.As
+	b.Refs = bat.Refs
+	b.Zs = make([]int64, len(sels))
+	for i, sel := range sels {
+		b.Zs[i] = bat.Zs[sel]
+	}
+	for j, vec := range bat.Vecs {
+		b.Vecs[j] = vector.New(vec.Typ)
+		b.Vecs[j].Ref = vec.Ref
+		for _, sel := range sels {
+			if err := vector.UnionOne(b.Vecs[j], vec, sel, mp); err != nil {
+				batch.Clean(b, mp)
+				return nil, err
+			}
+		}
+	}
+	for _, r := range bat.Rs {
+		nr := r.Dup()
+		b.Rs = append(b.Rs, nr)
+		if err := nr.Grows(len(sels), mp); err != nil {
+			batch.Clean(b, mp)
+			return nil, err
+		}
+		for i, sel := range sels {
+			nr.Add(r, int64(i), sel)
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,370 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"sort"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Exceed returns true if an operator which holds size bytes should spill its data to disk.
+// The memory threshold of the process is used as the limit, and spilling is disabled
+// if the process has no threshold.
+func Exceed(proc *process.Process, size int64) bool {
+	limit := proc.Lim.Size
+	if proc.Mp != nil && proc.Mp.Gm != nil && proc.Mp.Gm.Limit > 0 && (limit <= 0 || proc.Mp.Gm.Limit < limit) {
+		limit = proc.Mp.Gm.Limit
+	}
+	return limit > 0 && size > limit
+}
+
+// Size returns the approximate memory size of the batch.
+func Size(bat *batch.Batch) int64 {
+	var size int64
+
+	for _, vec := range bat.Vecs {
+		size += VectorSize(vec)
+	}
+	for _, r := range bat.Rs {
+		size += int64(r.Size())
+	}
+	return size + int64(len(bat.Zs)*8)
+}
+
+// VectorSize returns the approximate memory size of the vector.
+func VectorSize(vec *vector.Vector) int64 {
+	switch vec.Typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		vs := vec.Col.(*types.Bytes)
+		return int64(len(vs.Data) + len(vs.Offsets)*8)
+	case types.T_tuple:
+		return int64(len(vec.Data))
+	}
+	if n := int64(vector.Length(vec) * vec.Typ.Oid.TypeLen()); n > int64(len(vec.Data)) {
+		return n
+	}
+	return int64(len(vec.Data))
+}
+
+// New creates a spill file.
+func New() (*File, error) {
+	f, err := os.CreateTemp(Dir, "mo-spill-*")
+	if err != nil {
+		return nil, err
+	}
+	return &File{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Rows returns the number of rows written to the file.
+func (f *File) Rows() int64 {
+	return f.rows
+}
+
+// Write appends the rows of the batch to the file,
+// a batch with more than BatchRows rows is split into several batches.
+func (f *File) Write(bat *batch.Batch, mp *mheap.Mheap) error {
+	n := len(bat.Zs)
+	if n <= BatchRows {
+		return f.write(bat)
+	}
+	flags := make([]uint8, BatchRows)
+	for i := range flags {
+		flags[i]++
+	}
+	for i := 0; i < n; i += BatchRows {
+		cnt := n - i
+		if cnt > BatchRows {
+			cnt = BatchRows
+		}
+		b := batch.New(true, bat.Attrs)
+		for j, vec := range bat.Vecs {
+			b.Vecs[j] = vector.New(vec.Typ)
+			if err := vector.UnionBatch(b.Vecs[j], vec, int64(i), cnt, flags[:cnt], mp); err != nil {
+				batch.Clean(b, mp)
+				return err
+			}
+			b.Vecs[j].Ref = vec.Ref
+		}
+		b.Zs = bat.Zs[i : i+cnt]
+		err := f.write(b)
+		batch.Clean(b, mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read returns the next batch of the file, it returns nil if all the batches have been read.
+// The vectors of the batch are read-only.
+func (f *File) Read() (*batch.Batch, error) {
+	if f.r == nil {
+		if err := f.Rewind(); err != nil {
+			return nil, err
+		}
+	}
+	var head [8]byte
+	if _, err := io.ReadFull(f.r, head[:]); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	data := make([]byte, encoding.DecodeUint64(head[:]))
+	if _, err := io.ReadFull(f.r, data); err != nil {
+		return nil, err
+	}
+	return decodeBatch(data)
+}
+
+// Rewind makes the next Read start from the first batch of the file.
+func (f *File) Rewind() error {
+	if err := f.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	f.r = bufio.NewReader(f.f)
+	return nil
+}
+
+// Close closes and removes the file.
+func (f *File) Close() error {
+	err := f.f.Close()
+	if rerr := os.Remove(f.f.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+// Scatter writes each row of the batch to the file chosen by the hash of the attributes.
+// The level-th digit of the hash in base len(fs) chooses the file, so that the rows of
+// a file of one level can be scattered again by the next level.
+func Scatter(bat *batch.Batch, attrs []string, fs []*File, level int, mp *mheap.Mheap) error {
+	n := len(bat.Zs)
+	vecs := keys(bat, attrs)
+	div := uint64(1)
+	for i := 0; i < level; i++ {
+		div *= uint64(len(fs))
+	}
+	hs := Hash(vecs, n)
+	sels := make([][]int64, len(fs))
+	for i, h := range hs {
+		p := (h / div) % uint64(len(fs))
+		sels[p] = append(sels[p], int64(i))
+	}
+	for p, sel := range sels {
+		if len(sel) == 0 {
+			continue
+		}
+		b := batch.New(true, bat.Attrs)
+		b.Zs = make([]int64, len(sel))
+		for j, vec := range bat.Vecs {
+			b.Vecs[j] = vector.New(vec.Typ)
+			for k, s := range sel {
+				if err := vector.UnionOne(b.Vecs[j], vec, s, mp); err != nil {
+					batch.Clean(b, mp)
+					return err
+				}
+				b.Zs[k] = bat.Zs[s]
+			}
+			b.Vecs[j].Ref = vec.Ref
+		}
+		err := fs[p].Write(b, mp)
+		batch.Clean(b, mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keys returns the vectors of the attributes in the order of their names, so that
+// the operators which list the attributes in different orders hash a row to the same
+// partition.
+func keys(bat *batch.Batch, attrs []string) []*vector.Vector {
+	attrs = append([]string{}, attrs...)
+	sort.Strings(attrs)
+	vecs := make([]*vector.Vector, len(attrs))
+	for i, attr := range attrs {
+		vecs[i] = batch.GetVector(bat, attr)
+	}
+	return vecs
+}
+
+// Hash returns the hash of the values of each row of the vectors,
+// the rows with equal values have the same hash.
+func Hash(vecs []*vector.Vector, n int) []uint64 {
+	hs := make([]uint64, n)
+	for i := range hs {
+		hs[i] = offset64
+	}
+	for _, vec := range vecs {
+		switch vec.Typ.Oid {
+		case types.T_int8:
+			hashFixed(hs, vec.Col.([]int8), vec.Nsp)
+		case types.T_int16:
+			hashFixed(hs, vec.Col.([]int16), vec.Nsp)
+		case types.T_int32:
+			hashFixed(hs, vec.Col.([]int32), vec.Nsp)
+		case types.T_int64:
+			hashFixed(hs, vec.Col.([]int64), vec.Nsp)
+		case types.T_uint8:
+			hashFixed(hs, vec.Col.([]uint8), vec.Nsp)
+		case types.T_uint16:
+			hashFixed(hs, vec.Col.([]uint16), vec.Nsp)
+		case types.T_uint32:
+			hashFixed(hs, vec.Col.([]uint32), vec.Nsp)
+		case types.T_uint64:
+			hashFixed(hs, vec.Col.([]uint64), vec.Nsp)
+		case types.T_float32:
+			hashFixed(hs, vec.Col.([]float32), vec.Nsp)
+		case types.T_float64:
+			hashFixed(hs, vec.Col.([]float64), vec.Nsp)
+		case types.T_date:
+			hashFixed(hs, vec.Col.([]types.Date), vec.Nsp)
+		case types.T_datetime:
+			hashFixed(hs, vec.Col.([]types.Datetime), vec.Nsp)
+		case types.T_timestamp:
+			hashFixed(hs, vec.Col.([]types.Timestamp), vec.Nsp)
+		case types.T_decimal64:
+			hashFixed(hs, vec.Col.([]types.Decimal64), vec.Nsp)
+		case types.T_decimal128:
+			hashFixed(hs, vec.Col.([]types.Decimal128), vec.Nsp)
+		case types.T_char, types.T_varchar, types.T_json:
+			vs := vec.Col.(*types.Bytes)
+			for i := range hs {
+				if nulls.Contains(vec.Nsp, uint64(i)) {
+					hs[i] = hashBytes(hs[i], nullBytes)
+				} else {
+					hs[i] = hashBytes(hashBytes(hs[i], notNullBytes), vs.Get(int64(i)))
+				}
+			}
+		}
+	}
+	return hs
+}
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+var (
+	nullBytes    = []byte{1}
+	notNullBytes = []byte{0}
+)
+
+// hashBytes is the FNV-1a hash.
+func hashBytes(h uint64, data []byte) uint64 {
+	for _, c := range data {
+		h ^= uint64(c)
+		h *= prime64
+	}
+	return h
+}
+
+func hashFixed[T any](hs []uint64, vs []T, nsp *nulls.Nulls) {
+	var v T
+
+	size := int(unsafe.Sizeof(v))
+	for i := range hs {
+		if nulls.Contains(nsp, uint64(i)) {
+			hs[i] = hashBytes(hs[i], nullBytes)
+		} else {
+			hs[i] = hashBytes(hashBytes(hs[i], notNullBytes), unsafe.Slice((*byte)(unsafe.Pointer(&vs[i])), size))
+		}
+	}
+}
+
+func (f *File) write(bat *batch.Batch) error {
+	var buf bytes.Buffer
+
+	data, err := encoding.Encode(bat.Attrs)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoding.EncodeUint32(uint32(len(data))))
+	buf.Write(data)
+	buf.Write(encoding.EncodeUint32(uint32(len(bat.Vecs))))
+	for _, vec := range bat.Vecs {
+		data, err := vec.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint64(vec.Ref))
+		buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		buf.Write(data)
+	}
+	buf.Write(encoding.EncodeUint32(uint32(len(bat.Zs))))
+	if len(bat.Zs) > 0 {
+		buf.Write(encoding.EncodeInt64Slice(bat.Zs))
+	}
+	if _, err := f.w.Write(encoding.EncodeUint64(uint64(buf.Len()))); err != nil {
+		return err
+	}
+	if _, err := f.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	f.rows += int64(len(bat.Zs))
+	return nil
+}
+
+func decodeBatch(data []byte) (*batch.Batch, error) {
+	var attrs []string
+
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if err := encoding.Decode(data[:n], &attrs); err != nil {
+		return nil, err
+	}
+	data = data[n:]
+	bat := batch.New(true, attrs)
+	n = encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	bat.Vecs = make([]*vector.Vector, n)
+	for i := range bat.Vecs {
+		ref := encoding.DecodeUint64(data[:8])
+		size := encoding.DecodeUint32(data[8:12])
+		data = data[12:]
+		vec := vector.New(encoding.DecodeType(data[:encoding.TypeSize]))
+		if err := vec.Read(data[:size]); err != nil {
+			return nil, err
+		}
+		vec.Ref = ref
+		bat.Vecs[i] = vec
+		data = data[size:]
+	}
+	n = encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		bat.Zs = make([]int64, n)
+		copy(bat.Zs, encoding.DecodeInt64Slice(data[:n*8]))
+	}
+	return bat, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	rows := BatchRows + 10
+	bat := newBatch(t, rows)
+
+	f, err := New()
+	require.NoError(t, err)
+	require.NoError(t, f.Write(bat, proc.Mp))
+	require.Equal(t, int64(rows), f.Rows())
+
+	var is []int64
+	var ss [][]byte
+	for {
+		b, err := f.Read()
+		require.NoError(t, err)
+		if b == nil {
+			break
+		}
+		require.Equal(t, []string{"i", "s"}, b.Attrs)
+		require.LessOrEqual(t, len(b.Zs), BatchRows)
+		for j, v := range b.Vecs[0].Col.([]int64) {
+			require.Equal(t, nulls.Contains(b.Vecs[0].Nsp, uint64(j)), v%3 == 0)
+			is = append(is, v)
+			ss = append(ss, b.Vecs[1].Col.(*types.Bytes).Get(int64(j)))
+		}
+	}
+	require.Equal(t, bat.Vecs[0].Col.([]int64), is)
+	for i, s := range ss {
+		require.Equal(t, bat.Vecs[1].Col.(*types.Bytes).Get(int64(i)), s)
+	}
+
+	// read again from the beginning
+	require.NoError(t, f.Rewind())
+	b, err := f.Read()
+	require.NoError(t, err)
+	require.Equal(t, BatchRows, len(b.Zs))
+
+	name := f.f.Name()
+	require.NoError(t, f.Close())
+	_, err = os.Stat(name)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestScatter(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	bat := newBatch(t, 100)
+	hs := Hash([]*vector.Vector{bat.Vecs[1]}, 100)
+
+	for level := 0; level < 2; level++ {
+		fs := make([]*File, 4)
+		for i := range fs {
+			f, err := New()
+			require.NoError(t, err)
+			defer f.Close()
+			fs[i] = f
+		}
+		require.NoError(t, Scatter(bat, []string{"s"}, fs, level, proc.Mp))
+
+		// the rows with the same value are in the same partition,
+		// which is chosen by the level-th digit of the hash
+		parts := make(map[string]int)
+		total := int64(0)
+		for i, f := range fs {
+			total += f.Rows()
+			for {
+				b, err := f.Read()
+				require.NoError(t, err)
+				if b == nil {
+					break
+				}
+				vs := b.Vecs[1].Col.(*types.Bytes)
+				for j := range b.Zs {
+					s := string(vs.Get(int64(j)))
+					if p, ok := parts[s]; ok {
+						require.Equal(t, p, i)
+					}
+					parts[s] = i
+				}
+			}
+		}
+		require.Equal(t, int64(100), total)
+		require.Equal(t, 10, len(parts))
+		for i := 0; i < 10; i++ {
+			require.Equal(t, int((hs[i]>>(2*level))%4), parts[fmt.Sprintf("str%d", i)])
+		}
+	}
+}
+
+func TestExceed(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	require.False(t, Exceed(proc, 1<<20))
+	require.True(t, Exceed(proc, 1<<31))
+	proc.Lim.Size = 1 << 10
+	require.True(t, Exceed(proc, 1<<20))
+}
+
+func newBatch(t *testing.T, rows int) *batch.Batch {
+	bat := batch.New(true, []string{"i", "s"})
+	is := make([]int64, rows)
+	ss := make([][]byte, rows)
+	for i := range is {
+		is[i] = int64(i)
+		ss[i] = []byte(fmt.Sprintf("str%d", i%10))
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], is))
+	for i := 0; i < rows; i += 3 {
+		nulls.Add(bat.Vecs[0].Nsp, uint64(i))
+	}
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(bat.Vecs[1], ss))
+	bat.InitZsOne(rows)
+	return bat
+}
+
+func TestMerger(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+
+	// the only batch sent is the result as it is
+	m := NewMerger([]string{"s"}, 1)
+	bat := newGroups(t, proc, 10)
+	require.NoError(t, m.Add(0, bat, proc.Mp))
+	_, ok, err := m.Next(proc.Mp)
+	require.NoError(t, err)
+	require.False(t, ok)
+	m.Done(0)
+	bats, ok, err := m.Next(proc.Mp)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []*batch.Batch{bat}, bats)
+	_, ok, err = m.Next(proc.Mp)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the first producer sends the groups held in memory and then the groups of
+	// its spilled partitions in order, the second one sends all its groups at once
+	m = NewMerger([]string{"s"}, 2)
+	require.NoError(t, m.Add(0, newGroups(t, proc, 10), proc.Mp))
+	require.NoError(t, m.Add(1, newGroups(t, proc, 10), proc.Mp))
+	parts, err := Split(newGroups(t, proc, 10), []string{"s"}, proc.Mp)
+	require.NoError(t, err)
+	last := -1
+	for p, b := range parts {
+		if b != nil {
+			last = p
+		}
+	}
+	require.Greater(t, last, 0)
+	require.NoError(t, m.Add(0, parts[last], proc.Mp))
+	// all the partitions are held back by the second producer
+	_, ok, err = m.Next(proc.Mp)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, 1, m.Receiver())
+	m.Done(1)
+
+	seen := make(map[string]int64)
+	next := func(p int) int {
+		bats, ok, err := m.Next(proc.Mp)
+		require.NoError(t, err)
+		if !ok {
+			return -1
+		}
+		q := int(Hash([]*vector.Vector{bats[0].Vecs[1]}, 1)[0] % Partitions)
+		require.Greater(t, q, p)
+		for _, b := range bats {
+			hs := Hash([]*vector.Vector{b.Vecs[1]}, len(b.Zs))
+			vs := b.Vecs[1].Col.(*types.Bytes)
+			cs := b.Rs[0].Eval(b.Zs).Col.([]int64)
+			for i, h := range hs {
+				require.Equal(t, uint64(q), h%Partitions)
+				seen[string(vs.Get(int64(i)))] += cs[i]
+			}
+			batch.Clean(b, proc.Mp)
+		}
+		return q
+	}
+	// the partitions before the last one are complete
+	p := -1
+	for q := next(p); q >= 0; q = next(p) {
+		require.Less(t, q, last)
+		p = q
+	}
+	require.Equal(t, 0, m.Receiver())
+	m.Done(0)
+	require.Equal(t, -1, m.Receiver())
+	require.Equal(t, last, next(p))
+	require.Equal(t, -1, next(last))
+	require.Equal(t, 10, len(seen))
+	for k, c := range seen {
+		if int(Hash([]*vector.Vector{vectorOf(t, k)}, 1)[0]%Partitions) == last {
+			require.Equal(t, int64(3), c)
+		} else {
+			require.Equal(t, int64(2), c)
+		}
+	}
+	m.Clean(proc.Mp)
+}
+
+func vectorOf(t *testing.T, s string) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(vec, [][]byte{[]byte(s)}))
+	return vec
+}
+
+// newGroups returns the groups of the first rows of newBatch, each group is counted once.
+func newGroups(t *testing.T, proc *process.Process, rows int) *batch.Batch {
+	bat := newBatch(t, rows)
+	r := count.NewCount(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, r.Grows(rows, proc.Mp))
+	for i := 0; i < rows; i++ {
+		r.Fill(int64(i), int64(i), 1, bat.Vecs[1])
+	}
+	bat.Rs = []ring.Ring{r}
+	bat.As = []string{"cnt"}
+	bat.Refs = []uint64{1}
+	return bat
+}
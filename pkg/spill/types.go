@@ -0,0 +1,68 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+)
+
+const (
+	// BatchRows is the max rows of a batch written to or read from the spill file.
+	BatchRows = 8192
+	// Partitions is the number of hash partitions used by the partitioned hash aggregation.
+	Partitions = 16
+	// Levels is the max depth of the recursive hash partitions, the digits of
+	// the 64-bit hash in base Partitions are used up by then.
+	Levels = 16
+)
+
+// Dir is the directory of the spill files, the default directory for temporary files is used if Dir is empty.
+var Dir = ""
+
+// File is a temporary file which stores a sequence of batches,
+// the batches are read back in the order they were written.
+// The file is removed when it is closed.
+type File struct {
+	// rows is the number of rows written to the file.
+	rows int64
+	f    *os.File
+	w    *bufio.Writer
+	r    *bufio.Reader
+}
+
+// Merger merges the groups which several producers send partition by partition.
+// Apart from its first batch, which holds the groups aggregated in memory before
+// it spilled, a producer sends its groups in the ascending order of their level 0
+// partitions. So the groups of a partition are complete once every producer has
+// sent the groups of a later partition, and a partition is evaluated before the
+// next one is loaded.
+type Merger struct {
+	attrs []string
+	// low[i] is the least partition the i-th producer may still send
+	low []int
+	// sent[i] is true if the i-th producer has sent its first batch
+	sent []bool
+	// first[i] is the first batch of the i-th producer which is not split yet
+	first []*batch.Batch
+	// count is the number of batches received
+	count int
+	// next is the next partition to evaluate
+	next int
+	// parts[i] are the groups of the i-th partition received
+	parts [Partitions][]*batch.Batch
+}
@@ -0,0 +1,327 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+const (
+	// DefaultSampleSize is the default number of rows sampled to build the
+	// histogram of a column.
+	DefaultSampleSize = 10000
+	// histogramBuckets is the max number of buckets of a histogram.
+	histogramBuckets = 32
+)
+
+// collector collects the statistics of a column.
+type collector struct {
+	rows    int64
+	nulls   int64
+	min     interface{}
+	max     interface{}
+	seen    int64         // number of non-null values offered to the sample
+	sample  []interface{} // reservoir sample of the non-null values
+	size    int
+	rnd     *rand.Rand
+	ndv     *approxcd.ApproxCountDistinctRing // nil if the type is not supported
+	ordered bool                              // the values can be ordered, min, max and histogram are collected
+}
+
+// Analyze scans the relation and returns the statistics of the attributes.
+// The number of rows, number of nulls, min, max and number of distinct values
+// are computed over all the rows, the histograms are built from a sample of at
+// most sampleSize rows, all the rows are used if sampleSize is not positive.
+func Analyze(r engine.Relation, attrs []string, sampleSize int, m *mheap.Mheap) (map[string]*engine.ColumnStatistics, error) {
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	defs := make(map[string]engine.Attribute)
+	for _, def := range r.TableDefs() {
+		if v, ok := def.(*engine.AttributeDef); ok {
+			defs[v.Attr.Name] = v.Attr
+		}
+	}
+	cs := make([]*collector, len(attrs))
+	for i, attr := range attrs {
+		c, err := newCollector(defs[attr].Type, sampleSize, m)
+		if err != nil {
+			return nil, err
+		}
+		cs[i] = c
+	}
+	defer func() {
+		for _, c := range cs {
+			if c.ndv != nil {
+				c.ndv.Free(m)
+			}
+		}
+	}()
+	refCnts := make([]uint64, len(attrs))
+	for i := range refCnts {
+		refCnts[i] = 1
+	}
+	for _, rd := range r.NewReader(1, nil, nil) {
+		for {
+			bat, err := rd.Read(refCnts, attrs)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			for i, c := range cs {
+				c.fill(bat.Vecs[i], bat.Zs)
+			}
+		}
+	}
+	mp := make(map[string]*engine.ColumnStatistics)
+	for i, attr := range attrs {
+		st, err := cs[i].eval(m)
+		if err != nil {
+			return nil, err
+		}
+		mp[attr] = st
+	}
+	return mp, nil
+}
+
+func newCollector(typ types.Type, sampleSize int, m *mheap.Mheap) (*collector, error) {
+	c := &collector{
+		size: sampleSize,
+		rnd:  rand.New(rand.NewSource(1)),
+	}
+	switch typ.Oid {
+	case types.T_decimal64, types.T_decimal128:
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+		types.T_char, types.T_varchar:
+		c.ordered = true
+	case types.T_timestamp:
+		c.ordered = true
+		return c, nil
+	default:
+		return c, nil
+	}
+	c.ndv = approxcd.NewApproxCountDistinct(typ)
+	if err := c.ndv.Grow(m); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *collector) fill(vec *vector.Vector, zs []int64) {
+	for i, z := range zs {
+		c.rows += z
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			c.nulls += z
+			continue
+		}
+		if !c.ordered {
+			continue
+		}
+		v := getValue(vec, i)
+		if c.min == nil || compare(v, c.min) < 0 {
+			c.min = v
+		}
+		if c.max == nil || compare(v, c.max) > 0 {
+			c.max = v
+		}
+		for j := int64(0); j < z; j++ {
+			c.offer(v)
+		}
+	}
+	if c.ndv != nil {
+		c.ndv.BulkFill(0, zs, vec)
+	}
+}
+
+// offer adds the value to the reservoir sample.
+func (c *collector) offer(v interface{}) {
+	c.seen++
+	if c.size <= 0 || len(c.sample) < c.size {
+		c.sample = append(c.sample, v)
+		return
+	}
+	if j := c.rnd.Int63n(c.seen); j < int64(c.size) {
+		c.sample[j] = v
+	}
+}
+
+func (c *collector) eval(m *mheap.Mheap) (*engine.ColumnStatistics, error) {
+	st := &engine.ColumnStatistics{
+		Rows:      c.rows,
+		NullCount: c.nulls,
+		Min:       c.min,
+		Max:       c.max,
+	}
+	if c.ndv != nil {
+		vec := c.ndv.Eval(nil)
+		st.NDV = int64(vec.Col.([]uint64)[0])
+		mheap.Free(m, vec.Data)
+		c.ndv = nil
+	} else if c.ordered {
+		st.NDV = distinctCount(c.sortedSample())
+	}
+	if st.NDV > c.rows-c.nulls {
+		st.NDV = c.rows - c.nulls
+	}
+	if c.ordered {
+		st.Histogram = c.histogram(st.NDV)
+	}
+	return st, nil
+}
+
+func (c *collector) sortedSample() []interface{} {
+	sort.Slice(c.sample, func(i, j int) bool {
+		return compare(c.sample[i], c.sample[j]) < 0
+	})
+	return c.sample
+}
+
+// histogram builds the equi-depth histogram of the sample, the equal values
+// are kept in the same bucket, the counts are scaled to the number of the
+// non-null rows and the numbers of distinct values to ndv.
+func (c *collector) histogram(ndv int64) []engine.Bucket {
+	vs := c.sortedSample()
+	if len(vs) == 0 {
+		return nil
+	}
+	scale := float64(c.rows-c.nulls) / float64(len(vs))
+	ndvScale := float64(ndv) / float64(distinctCount(vs))
+	depth := (len(vs) + histogramBuckets - 1) / histogramBuckets
+	var bkts []engine.Bucket
+	for i := 0; i < len(vs); {
+		j := i + depth
+		if j > len(vs) {
+			j = len(vs)
+		}
+		for j < len(vs) && compare(vs[j], vs[j-1]) == 0 {
+			j++
+		}
+		cnt := int64(float64(j-i)*scale + 0.5)
+		dist := int64(float64(distinctCount(vs[i:j]))*ndvScale + 0.5)
+		if dist < 1 {
+			dist = 1
+		}
+		if dist > cnt {
+			dist = cnt
+		}
+		bkts = append(bkts, engine.Bucket{Upper: vs[j-1], Count: cnt, NDV: dist})
+		i = j
+	}
+	return bkts
+}
+
+// distinctCount returns the number of distinct values of the sorted values.
+func distinctCount(vs []interface{}) int64 {
+	var n int64
+	for i := range vs {
+		if i == 0 || compare(vs[i], vs[i-1]) != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// getValue returns the i-th value of the vector, the bytes are copied.
+func getValue(vec *vector.Vector, i int) interface{} {
+	switch col := vec.Col.(type) {
+	case []int8:
+		return col[i]
+	case []int16:
+		return col[i]
+	case []int32:
+		return col[i]
+	case []int64:
+		return col[i]
+	case []uint8:
+		return col[i]
+	case []uint16:
+		return col[i]
+	case []uint32:
+		return col[i]
+	case []uint64:
+		return col[i]
+	case []float32:
+		return col[i]
+	case []float64:
+		return col[i]
+	case []types.Date:
+		return col[i]
+	case []types.Datetime:
+		return col[i]
+	case []types.Timestamp:
+		return col[i]
+	case *types.Bytes:
+		return append([]byte{}, col.Get(int64(i))...)
+	}
+	return nil
+}
+
+// compare compares two values of the same type.
+func compare(x, y interface{}) int {
+	switch xv := x.(type) {
+	case int8:
+		return compareOrdered(xv < y.(int8), xv > y.(int8))
+	case int16:
+		return compareOrdered(xv < y.(int16), xv > y.(int16))
+	case int32:
+		return compareOrdered(xv < y.(int32), xv > y.(int32))
+	case int64:
+		return compareOrdered(xv < y.(int64), xv > y.(int64))
+	case uint8:
+		return compareOrdered(xv < y.(uint8), xv > y.(uint8))
+	case uint16:
+		return compareOrdered(xv < y.(uint16), xv > y.(uint16))
+	case uint32:
+		return compareOrdered(xv < y.(uint32), xv > y.(uint32))
+	case uint64:
+		return compareOrdered(xv < y.(uint64), xv > y.(uint64))
+	case float32:
+		return compareOrdered(xv < y.(float32), xv > y.(float32))
+	case float64:
+		return compareOrdered(xv < y.(float64), xv > y.(float64))
+	case types.Date:
+		return compareOrdered(xv < y.(types.Date), xv > y.(types.Date))
+	case types.Datetime:
+		return compareOrdered(xv < y.(types.Datetime), xv > y.(types.Datetime))
+	case types.Timestamp:
+		return compareOrdered(xv < y.(types.Timestamp), xv > y.(types.Timestamp))
+	case []byte:
+		return bytes.Compare(xv, y.([]byte))
+	}
+	return 0
+}
+
+func compareOrdered(lt, gt bool) int {
+	switch {
+	case lt:
+		return -1
+	case gt:
+		return 1
+	}
+	return 0
+}
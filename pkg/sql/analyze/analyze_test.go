@@ -0,0 +1,83 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	r, err := db.Relation("R")
+	require.NoError(t, err)
+	defer r.Close()
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+
+	for _, sampleSize := range []int{DefaultSampleSize, 5} {
+		stats, err := Analyze(r, []string{"orderId", "uid"}, sampleSize, m)
+		require.NoError(t, err)
+
+		uid := stats["uid"]
+		require.Equal(t, int64(20), uid.Rows)
+		require.Equal(t, int64(0), uid.NullCount)
+		require.Equal(t, int64(4), uid.NDV)
+		require.Equal(t, uint32(0), uid.Min)
+		require.Equal(t, uint32(3), uid.Max)
+		var cnt int64
+		for i, bkt := range uid.Histogram {
+			if i > 0 {
+				require.Equal(t, 1, compare(bkt.Upper, uid.Histogram[i-1].Upper))
+			}
+			require.True(t, bkt.NDV >= 1 && bkt.NDV <= bkt.Count)
+			cnt += bkt.Count
+		}
+		require.InDelta(t, 20, cnt, float64(len(uid.Histogram)))
+
+		orderId := stats["orderId"]
+		require.Equal(t, int64(20), orderId.NDV)
+		require.Equal(t, []byte("0"), orderId.Min)
+		require.Equal(t, []byte("9"), orderId.Max)
+	}
+}
+
+func TestWriteStats(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	r, err := db.Relation("R")
+	require.NoError(t, err)
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	stats, err := Analyze(r, []string{"uid", "price"}, DefaultSampleSize, m)
+	require.NoError(t, err)
+	require.NoError(t, r.(engine.StatisticsWriter).WriteStats(0, stats))
+	r.Close()
+
+	// the statistics are persisted with the table
+	r, err = db.Relation("R")
+	require.NoError(t, err)
+	defer r.Close()
+	require.Equal(t, stats["uid"], r.Stats("uid"))
+	require.Equal(t, stats["price"], r.Stats("price"))
+	require.Nil(t, r.Stats("orderId"))
+}
@@ -16,9 +16,14 @@ package deleteTag
 
 import (
 	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
-
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -37,20 +42,90 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if bat == nil || len(bat.Zs) == 0 {
 		return false, nil
 	}
+	defer func() {
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = &batch.Batch{}
+	}()
 
-	for i := range bat.Zs {
-		bat.Zs[i] = -1
-	}
-	if err := p.Relation.Write(p.Ts, bat); err != nil {
-		return false, err
+	affectedRows := uint64(0)
+	for _, tbl := range p.Tables {
+		rbat, _, err := tbl.Rows(bat, proc)
+		if err != nil {
+			return false, err
+		}
+		n := len(rbat.Zs)
+		for i := range rbat.Zs {
+			rbat.Zs[i] = -1
+		}
+		if n > 0 {
+			err = tbl.Relation.Write(p.Ts, rbat)
+		}
+		batch.Clean(rbat, proc.Mp)
+		if err != nil {
+			return false, err
+		}
+		affectedRows += uint64(n)
 	}
 
-	affectedRows := uint64(vector.Length(bat.Vecs[0]))
-	batch.Clean(bat, proc.Mp)
-	proc.Reg.InputBatch = &batch.Batch{}
-
 	p.M.Lock()
 	p.AffectedRows += affectedRows
 	p.M.Unlock()
 	return false, nil
 }
+
+// Rows returns a copy of the rows of the table in the batch, the attributes of
+// the copy are the column names of the table. If Dedup is set, the rows which
+// have been returned before are removed and sels are the selected rows of bat.
+func (tbl *Table) Rows(bat *batch.Batch, proc *process.Process) (*batch.Batch, []int64, error) {
+	var sels []int64
+
+	vecs := make([]*vector.Vector, len(tbl.Attrs))
+	for i, attr := range tbl.Attrs {
+		vecs[i] = batch.GetVector(bat, attr)
+	}
+	n := len(bat.Zs)
+	if tbl.Dedup {
+		if tbl.keys == nil {
+			tbl.keys = make(map[string]struct{})
+		}
+		sels = make([]int64, 0, n)
+		for i := 0; i < n; i++ {
+			key := rowKey(vecs, i)
+			if _, ok := tbl.keys[key]; !ok {
+				tbl.keys[key] = struct{}{}
+				sels = append(sels, int64(i))
+			}
+		}
+		n = len(sels)
+	}
+	rbat := &batch.Batch{Attrs: tbl.Cols, Zs: make([]int64, n)}
+	for _, vec := range vecs {
+		vec, err := vector.Dup(vec, proc.Mp)
+		if err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return nil, nil, err
+		}
+		if sels != nil {
+			vector.Shrink(vec, sels)
+		}
+		rbat.Vecs = append(rbat.Vecs, vec)
+	}
+	return rbat, sels, nil
+}
+
+func rowKey(vecs []*vector.Vector, row int) string {
+	var buf strings.Builder
+
+	for _, vec := range vecs {
+		switch {
+		case nulls.Contains(vec.Nsp, uint64(row)):
+			buf.WriteString("null")
+		case vec.Typ.Oid == types.T_char || vec.Typ.Oid == types.T_varchar || vec.Typ.Oid == types.T_json:
+			buf.WriteString(strconv.Quote(string(vec.Col.(*types.Bytes).Get(int64(row)))))
+		default:
+			buf.WriteString(fmt.Sprintf("%v", reflect.ValueOf(vec.Col).Index(row).Interface()))
+		}
+		buf.WriteByte(',')
+	}
+	return buf.String()
+}
@@ -15,13 +15,27 @@
 package deleteTag
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
+// Table is a table whose rows are deleted by the statement
+type Table struct {
+	Relation engine.Relation
+	// Attrs are the attributes of the input batch which hold the columns of the table
+	Attrs []string
+	// Cols are the column names of the table, one for each attribute of Attrs
+	Cols []string
+	// Dedup is set if the table is joined with other tables,
+	// then a row of the table may occur more than once
+	Dedup bool
+	keys  map[string]struct{}
+}
+
 type Argument struct {
 	Ts           uint64
-	Relation     engine.Relation
+	Tables       []*Table
 	M            sync.Mutex
 	AffectedRows uint64
 }
@@ -163,6 +163,7 @@ func initOperatorFunctions() {
 	// others
 	initCast()
 	initLike()
+	initNotLike()
 }
 
 func initReturnTypeFromBinary() {
@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// initNotLike builds NOT LIKE from LIKE, the rows selected are the rows
+// which are neither selected by LIKE nor null.
+func initNotLike() {
+	for _, op := range BinOps[Like] {
+		fn := op.Fn
+		BinOps[NotLike] = append(BinOps[NotLike], &BinOp{
+			LeftType:   op.LeftType,
+			RightType:  op.RightType,
+			ReturnType: op.ReturnType,
+			Fn: func(lv *vector.Vector, rv *vector.Vector, proc *process.Process, lc bool, rc bool) (*vector.Vector, error) {
+				n := 1
+				switch {
+				case !lc:
+					n = len(lv.Col.(*types.Bytes).Lengths)
+				case !rc:
+					n = len(rv.Col.(*types.Bytes).Lengths)
+				}
+				vec, err := fn(lv, rv, proc, lc, rc)
+				if err != nil {
+					return nil, err
+				}
+				sels := vec.Col.([]int64)
+				rs := make([]int64, 0, n-len(sels))
+				for i, j := 0, 0; i < n; i++ {
+					if j < len(sels) && sels[j] == int64(i) {
+						j++
+						continue
+					}
+					if nulls.Contains(vec.Nsp, uint64(i)) {
+						continue
+					}
+					rs = append(rs, int64(i))
+				}
+				vector.SetCol(vec, append(sels[:0], rs...))
+				return vec, nil
+			},
+		})
+	}
+}
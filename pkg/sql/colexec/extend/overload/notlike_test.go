@@ -0,0 +1,51 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotLike(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	typ := types.Type{Oid: types.T_varchar, Size: 24}
+
+	lv := vector.New(typ)
+	require.NoError(t, vector.Append(lv, [][]byte{[]byte("special requests"), []byte("x"), []byte(""), []byte("special y requests")}))
+	rv := vector.New(typ)
+	require.NoError(t, vector.Append(rv, [][]byte{[]byte("%special%requests%")}))
+	vec, err := BinaryEval(NotLike, types.T_varchar, types.T_varchar, false, true, lv, rv, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, vec.Col.([]int64))
+
+	// the null rows are selected by neither LIKE nor NOT LIKE
+	lv = vector.New(typ)
+	require.NoError(t, vector.Append(lv, [][]byte{[]byte("a"), []byte(""), []byte("b")}))
+	nulls.Add(lv.Nsp, 1)
+	rv = vector.New(typ)
+	require.NoError(t, vector.Append(rv, [][]byte{[]byte("b%")}))
+	vec, err = BinaryEval(NotLike, types.T_varchar, types.T_varchar, false, true, lv, rv, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{0}, vec.Col.([]int64))
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsontable
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("json_table(%s) -> %v", n.E, n.Attrs))
+}
+
+func Prepare(_ *process.Process, _ interface{}) error {
+	return nil
+}
+
+// Call replaces each row of the batch by the rows of the json table of its
+// document, a row whose document is null or has no rows is removed.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	bat := proc.Reg.InputBatch
+	if bat == nil || len(bat.Zs) == 0 {
+		return false, nil
+	}
+	n := arg.(*Argument)
+	rbat, err := expand(bat, n, proc)
+	batch.Clean(bat, proc.Mp)
+	if err != nil {
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, err
+	}
+	proc.Reg.InputBatch = rbat
+	return false, nil
+}
+
+func expand(bat *batch.Batch, n *Argument, proc *process.Process) (*batch.Batch, error) {
+	if err := batch.Shuffle(bat, proc.Mp); err != nil {
+		return nil, err
+	}
+	vec, typ, err := n.E.Eval(bat, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, v := range bat.Vecs {
+			if v == vec {
+				return
+			}
+		}
+		vector.Clean(vec, proc.Mp)
+	}()
+	docs := make([]bytejson.ByteJson, 0, len(bat.Zs))
+	rows := make([]int64, 0, len(bat.Zs)) // the row of each document
+	xs := vec.Col.(*types.Bytes)
+	for i := range bat.Zs {
+		row := int64(i)
+		if n.E.IsConstant() {
+			row = 0
+		}
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			continue
+		}
+		var doc bytejson.ByteJson
+		if typ == types.T_json {
+			doc, err = bytejson.Unmarshal(xs.Get(row))
+		} else {
+			doc, err = bytejson.ParseFromByteSlice(xs.Get(row))
+		}
+		if err != nil {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text in argument 1 to function json_table: %v", err))
+		}
+		docs = append(docs, doc)
+		rows = append(rows, int64(i))
+	}
+	jbat, sels, err := n.Rows(docs)
+	if err != nil {
+		return nil, err
+	}
+	for i, sel := range sels {
+		sels[i] = rows[sel]
+	}
+	rbat := batch.New(true, n.Attrs)
+	rbat.Zs = make([]int64, len(sels))
+	for i, sel := range sels {
+		rbat.Zs[i] = bat.Zs[sel]
+	}
+	for i, attr := range n.Attrs {
+		if v := batch.GetVector(jbat, attr); v != nil {
+			rbat.Vecs[i] = v
+		} else {
+			w := batch.GetVector(bat, attr)
+			rbat.Vecs[i] = vector.New(w.Typ)
+			for _, sel := range sels {
+				if err := vector.UnionOne(rbat.Vecs[i], w, sel, proc.Mp); err != nil {
+					rbat.Vecs = rbat.Vecs[:i+1]
+					batch.Clean(rbat, proc.Mp)
+					return nil, err
+				}
+			}
+		}
+		rbat.Vecs[i].Ref = n.Refs[i]
+	}
+	return rbat, nil
+}
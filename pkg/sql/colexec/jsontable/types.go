@@ -0,0 +1,32 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsontable
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+)
+
+// Argument expands each row of the batch into the rows of the json table of
+// its json document.
+type Argument struct {
+	E     extend.Extend // json document of each row
+	Attrs []string      // attributes of the result, those of the batch are followed by those of the json table
+	Refs  []uint64      // reference counts of the attributes of the result
+	// Rows returns the rows of the json table for the documents and the
+	// index of the document of each row.
+	Rows func([]bytejson.ByteJson) (*batch.Batch, []int64, error)
+}
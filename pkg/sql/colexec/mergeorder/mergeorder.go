@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -49,6 +50,8 @@ func Prepare(_ *process.Process, arg interface{}) error {
 		argument.ctr.ds[i] = s.Type == order.Descending
 	}
 	argument.ctr.bat = nil
+	argument.ctr.runs = nil
+	argument.ctr.srcs = nil
 	return nil
 }
 
@@ -72,11 +75,37 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				}
 				err := mergeSort(proc.Mp, argument, bat)
 				if err != nil {
+					argument.ctr.clean(proc)
 					return false, err
 				}
+				if spill.Exceed(proc, spill.Size(argument.ctr.bat)) {
+					if err := argument.ctr.spill(proc); err != nil {
+						argument.ctr.clean(proc)
+						return false, err
+					}
+				}
 				i--
 			}
 			argument.ctr.state = end
+			if len(argument.ctr.runs) > 0 {
+				if err := argument.ctr.prepareMerge(proc); err != nil {
+					argument.ctr.clean(proc)
+					return false, err
+				}
+				argument.ctr.state = merge
+			}
+		case merge:
+			bat, err := argument.ctr.mergeRuns(proc)
+			if err != nil {
+				argument.ctr.clean(proc)
+				return false, err
+			}
+			if bat != nil {
+				proc.Reg.InputBatch = bat
+				return false, nil
+			}
+			argument.ctr.clean(proc)
+			argument.ctr.state = end
 		case end:
 			proc.Reg.InputBatch = argument.ctr.bat
 			argument.ctr.bat = nil
@@ -176,3 +205,122 @@ func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
 	arg.ctr.bat = result
 	return nil
 }
+
+// spill writes the current result to disk as a sorted run.
+func (ctr *container) spill(proc *process.Process) error {
+	f, err := spill.New()
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, f)
+	if err := f.Write(ctr.bat, proc.Mp); err != nil {
+		return err
+	}
+	batch.Clean(ctr.bat, proc.Mp)
+	ctr.bat = nil
+	return nil
+}
+
+// prepareMerge reads the first batch of each run, the rows which are still in memory are the last run.
+func (ctr *container) prepareMerge(proc *process.Process) error {
+	for _, f := range ctr.runs {
+		bat, err := f.Read()
+		if err != nil {
+			return err
+		}
+		if bat != nil {
+			ctr.srcs = append(ctr.srcs, &source{f: f, bat: bat})
+		}
+	}
+	if ctr.bat != nil {
+		ctr.srcs = append(ctr.srcs, &source{bat: ctr.bat})
+		ctr.bat = nil
+	}
+	if len(ctr.srcs) > 0 && ctr.cmps[0] == nil {
+		for k := range ctr.cmps {
+			ctr.cmps[k] = compare.New(batch.GetVector(ctr.srcs[0].bat, ctr.attrs[k]).Typ.Oid, ctr.ds[k])
+		}
+	}
+	for _, src := range ctr.srcs {
+		batch.Reorder(src.bat, ctr.attrs)
+	}
+	return nil
+}
+
+// mergeRuns returns the next batch of at most spill.BatchRows rows merged from the runs,
+// it returns nil if all the rows have been returned.
+func (ctr *container) mergeRuns(proc *process.Process) (*batch.Batch, error) {
+	if len(ctr.srcs) == 0 {
+		return nil, nil
+	}
+	bat := batch.New(true, append([]string{}, ctr.srcs[0].bat.Attrs...))
+	for i, vec := range ctr.srcs[0].bat.Vecs {
+		bat.Vecs[i] = vector.New(vec.Typ)
+	}
+	for len(bat.Zs) < spill.BatchRows && len(ctr.srcs) > 0 {
+		k := 0
+		for i := 1; i < len(ctr.srcs); i++ {
+			if ctr.less(ctr.srcs[i], ctr.srcs[k]) {
+				k = i
+			}
+		}
+		src := ctr.srcs[k]
+		for i, vec := range bat.Vecs {
+			if err := vector.UnionOne(vec, src.bat.Vecs[i], src.row, proc.Mp); err != nil {
+				batch.Clean(bat, proc.Mp)
+				return nil, err
+			}
+		}
+		bat.Zs = append(bat.Zs, src.bat.Zs[src.row])
+		if src.row++; src.row < int64(len(src.bat.Zs)) {
+			continue
+		}
+		// the current batch of the source has been merged
+		batch.Clean(src.bat, proc.Mp)
+		src.bat, src.row = nil, 0
+		if src.f != nil {
+			b, err := src.f.Read()
+			if err != nil {
+				batch.Clean(bat, proc.Mp)
+				return nil, err
+			}
+			if b != nil {
+				batch.Reorder(b, ctr.attrs)
+				src.bat = b
+				continue
+			}
+		}
+		ctr.srcs = append(ctr.srcs[:k], ctr.srcs[k+1:]...)
+	}
+	return bat, nil
+}
+
+// less returns true if the current row of source a is ordered before the current row of source b.
+func (ctr *container) less(a, b *source) bool {
+	for k, cmp := range ctr.cmps {
+		cmp.Set(0, a.bat.Vecs[k])
+		cmp.Set(1, b.bat.Vecs[k])
+		if r := cmp.Compare(0, 1, a.row, b.row); r != 0 {
+			return r < 0
+		}
+	}
+	return false
+}
+
+// clean frees the rows in memory and removes the spilled runs.
+func (ctr *container) clean(proc *process.Process) {
+	for _, src := range ctr.srcs {
+		if src.bat != nil {
+			batch.Clean(src.bat, proc.Mp)
+		}
+	}
+	ctr.srcs = nil
+	for _, f := range ctr.runs {
+		f.Close()
+	}
+	ctr.runs = nil
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+}
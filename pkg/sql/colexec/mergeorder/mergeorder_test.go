@@ -0,0 +1,81 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergeorder
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/spill"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeOrderSpill(t *testing.T) {
+	const batches, rows = 3, 5000
+
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	proc.Lim.Size = 1 // every sorted run is spilled
+	arg := &Argument{Fields: []order.Field{{Attr: "a", Type: order.Descending}}}
+	require.NoError(t, Prepare(proc, arg))
+
+	reg := &process.WaitRegister{Ctx: context.TODO(), Ch: make(chan *batch.Batch, batches+1)}
+	proc.Reg.MergeReceivers = []*process.WaitRegister{reg}
+	var expected []int64
+	for i := 0; i < batches; i++ {
+		vs := make([]int64, rows)
+		for j := range vs {
+			vs[j] = rand.Int63n(1000)
+		}
+		sort.Slice(vs, func(i, j int) bool { return vs[i] > vs[j] })
+		expected = append(expected, vs...)
+		reg.Ch <- newBatch(t, vs)
+	}
+	reg.Ch <- nil
+	sort.Slice(expected, func(i, j int) bool { return expected[i] > expected[j] })
+
+	var result []int64
+	for {
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		if bat := proc.Reg.InputBatch; bat != nil {
+			require.LessOrEqual(t, len(bat.Zs), spill.BatchRows)
+			result = append(result, batch.GetVector(bat, "a").Col.([]int64)...)
+			batch.Clean(bat, proc.Mp)
+		}
+		if end {
+			break
+		}
+	}
+	require.Equal(t, expected, result)
+	require.Equal(t, 0, len(arg.ctr.runs))
+}
+
+func newBatch(t *testing.T, vs []int64) *batch.Batch {
+	bat := batch.New(true, []string{"a"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], vs))
+	bat.InitZsOne(len(vs))
+	return bat
+}
@@ -17,19 +17,22 @@ package mergeorder
 import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 )
 
 // state values
 const (
 	running = iota
+	merge
 	end
 )
 
 type container struct {
 	// state signs the statement of mergeOrder operator
 	//	1. if state is running, operator still range the mergeReceivers to do merge-sort.
-	//	2. if state is end, operator has done and should push data to next operator.
+	//	2. if state is merge, the sorted runs have been spilled to disk and operator merges them batch by batch.
+	//	3. if state is end, operator has done and should push data to next operator.
 	state uint8
 
 	attrs []string // sorted list of attributes
@@ -39,6 +42,18 @@ type container struct {
 
 	// bat store the result of merge-order
 	bat *batch.Batch
+
+	// runs are the sorted runs spilled to disk when bat exceeds the memory threshold
+	runs []*spill.File
+	// srcs are the runs and the last in-memory result being merged
+	srcs []*source
+}
+
+// source is a sorted sequence of rows being merged
+type source struct {
+	f   *spill.File // f is nil if the rows are in memory
+	bat *batch.Batch
+	row int64
 }
 
 type Argument struct {
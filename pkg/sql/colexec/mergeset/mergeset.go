@@ -0,0 +1,320 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergeset
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dedup"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var opName = [...]string{
+	Union:     "union",
+	Intersect: "intersect",
+	Except:    "except",
+}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString(opName[n.Type])
+	if n.All {
+		buf.WriteString(" all")
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = &container{
+		state:         build,
+		keys:          make([][]byte, dedup.UnitLimit),
+		values:        make([]uint64, dedup.UnitLimit),
+		strHashStates: make([][3]uint64, dedup.UnitLimit),
+		strHashMap:    &hashtable.StringHashMap{},
+		flags:         make([]uint8, dedup.UnitLimit),
+		zFlags:        make([]uint8, dedup.UnitLimit),
+	}
+	n.ctr.strHashMap.Init()
+	if n.Type == Union {
+		n.ctr.state = probe
+	}
+	return nil
+}
+
+// Call reads all the rows of the right input into the hash table first for
+// intersect and except, and then filters the rows of the left input.
+// The rows of both inputs are filtered for union, and they are passed
+// through for union all. A batch of the result is sent for each batch of
+// the inputs which has rows in the result.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case build:
+			bat := <-proc.Reg.MergeReceivers[1].Ch
+			if bat == nil {
+				ctr.rightRows = ctr.rows
+				ctr.state = probe
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			err := ctr.build(bat, proc)
+			batch.Clean(bat, proc.Mp)
+			if err != nil {
+				return false, err
+			}
+		case probe:
+			bat := <-proc.Reg.MergeReceivers[ctr.i].Ch
+			if bat == nil {
+				if n.Type == Union && ctr.i == 0 {
+					ctr.i++
+					continue
+				}
+				ctr.state = end
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if n.Type == Union && n.All {
+				proc.Reg.InputBatch = bat
+				return false, nil
+			}
+			rbat, err := ctr.probe(n, bat, proc)
+			batch.Clean(bat, proc.Mp)
+			if err != nil {
+				return false, err
+			}
+			if rbat == nil {
+				continue
+			}
+			proc.Reg.InputBatch = rbat
+			return false, nil
+		case end:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *container) build(bat *batch.Batch, proc *process.Process) error {
+	if err := batch.Shuffle(bat, proc.Mp); err != nil {
+		return err
+	}
+	count := int64(len(bat.Zs))
+	for i := int64(0); i < count; i += dedup.UnitLimit {
+		n := count - i
+		if n > dedup.UnitLimit {
+			n = dedup.UnitLimit
+		}
+		ctr.insert(bat, i, n)
+		for k, v := range ctr.values[:n] {
+			ctr.counts[v-1] += bat.Zs[i+int64(k)]
+		}
+	}
+	return nil
+}
+
+// probe returns the rows of the batch of an input which are in the result,
+// it returns nil if there is none of them.
+func (ctr *container) probe(n *Argument, bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	if err := batch.Shuffle(bat, proc.Mp); err != nil {
+		return nil, err
+	}
+	rbat := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	count := int64(len(bat.Zs))
+	for i := int64(0); i < count; i += dedup.UnitLimit {
+		m := count - i
+		if m > dedup.UnitLimit {
+			m = dedup.UnitLimit
+		}
+		cnt := 0
+		copy(ctr.flags[:m], ctr.zFlags[:m])
+		rows := ctr.rows
+		ctr.insert(bat, i, m)
+		for k, v := range ctr.values[:m] {
+			isNew := v > rows
+			if isNew {
+				rows++
+			}
+			if z := n.duplicates(ctr, v, isNew, bat.Zs[i+int64(k)]); z > 0 {
+				ctr.flags[k] = 1
+				rbat.Zs = append(rbat.Zs, z)
+				cnt++
+			}
+		}
+		if cnt > 0 {
+			for j, vec := range rbat.Vecs {
+				if err := vector.UnionBatch(vec, bat.Vecs[j], i, cnt, ctr.flags[:m], proc.Mp); err != nil {
+					batch.Clean(rbat, proc.Mp)
+					return nil, err
+				}
+			}
+		}
+	}
+	if len(rbat.Zs) == 0 {
+		batch.Clean(rbat, proc.Mp)
+		return nil, nil
+	}
+	return rbat, nil
+}
+
+// duplicates returns the number of the duplicates of a row of the left input
+// in the result, v is the row's group in the hash table, z is the number of
+// the duplicates of the row in the input.
+func (n *Argument) duplicates(ctr *container, v uint64, isNew bool, z int64) int64 {
+	switch n.Type {
+	case Union:
+		if isNew {
+			return 1
+		}
+	case Intersect:
+		if v > ctr.rightRows {
+			return 0
+		}
+		if m := min(z, ctr.counts[v-1]); m > 0 {
+			if !n.All {
+				ctr.counts[v-1] = 0
+				return 1
+			}
+			ctr.counts[v-1] -= m
+			return m
+		}
+	case Except:
+		if v > ctr.rightRows {
+			if n.All {
+				return z
+			}
+			if isNew {
+				return 1
+			}
+			return 0
+		}
+		if n.All {
+			m := min(z, ctr.counts[v-1])
+			ctr.counts[v-1] -= m
+			return z - m
+		}
+	}
+	return 0
+}
+
+// insert inserts n rows of the batch starting from the row start into
+// the hash table, and the groups of the rows are stored in values.
+func (ctr *container) insert(bat *batch.Batch, start, n int64) {
+	for _, vec := range bat.Vecs {
+		fillKeys(ctr.keys[:n], vec, start)
+	}
+	for k := int64(0); k < n; k++ {
+		if l := len(ctr.keys[k]); l < 16 {
+			ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+		}
+	}
+	ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
+	for k, v := range ctr.values[:n] {
+		ctr.keys[k] = ctr.keys[k][:0]
+		if v > ctr.rows {
+			ctr.rows++
+			ctr.counts = append(ctr.counts, 0)
+		}
+	}
+}
+
+// fillKeys appends the values of the vector starting from the row start to
+// the keys, each value is prefixed by a flag indicating whether it is null
+// and the length of a string is encoded before it.
+func fillKeys(keys [][]byte, vec *vector.Vector, start int64) {
+	if vs, ok := vec.Col.(*types.Bytes); ok {
+		for k := range keys {
+			row := start + int64(k)
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				keys[k] = append(keys[k], 1)
+				continue
+			}
+			v := vs.Get(row)
+			keys[k] = append(keys[k], 0)
+			keys[k] = append(keys[k], encoding.EncodeUint32(uint32(len(v)))...)
+			keys[k] = append(keys[k], v...)
+		}
+		return
+	}
+	data, size := fixedData(vec)
+	for k := range keys {
+		row := start + int64(k)
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			keys[k] = append(keys[k], 1)
+			continue
+		}
+		keys[k] = append(keys[k], 0)
+		keys[k] = append(keys[k], data[row*size:(row+1)*size]...)
+	}
+}
+
+// fixedData returns the values of a vector of fixed length type as bytes
+// and the size of each value.
+func fixedData(vec *vector.Vector) ([]byte, int64) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return encoding.EncodeInt8Slice(vs), 1
+	case []int16:
+		return encoding.EncodeInt16Slice(vs), 2
+	case []int32:
+		return encoding.EncodeInt32Slice(vs), 4
+	case []int64:
+		return encoding.EncodeInt64Slice(vs), 8
+	case []uint8:
+		return encoding.EncodeUint8Slice(vs), 1
+	case []uint16:
+		return encoding.EncodeUint16Slice(vs), 2
+	case []uint32:
+		return encoding.EncodeUint32Slice(vs), 4
+	case []uint64:
+		return encoding.EncodeUint64Slice(vs), 8
+	case []float32:
+		return encoding.EncodeFloat32Slice(vs), 4
+	case []float64:
+		return encoding.EncodeFloat64Slice(vs), 8
+	case []types.Date:
+		return encoding.EncodeDateSlice(vs), 4
+	case []types.Datetime:
+		return encoding.EncodeDatetimeSlice(vs), 8
+	case []types.Timestamp:
+		return encoding.EncodeTimestampSlice(vs), 8
+	case []types.Decimal64:
+		return encoding.EncodeDecimal64Slice(vs), 8
+	case []types.Decimal128:
+		return encoding.EncodeDecimal128Slice(vs), 16
+	}
+	panic("unsupported type " + vec.Typ.String())
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
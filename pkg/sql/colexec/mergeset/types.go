@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergeset
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+)
+
+// set operations
+const (
+	Union = iota
+	Intersect
+	Except
+)
+
+const (
+	build = iota
+	probe
+	end
+)
+
+type container struct {
+	state int
+	i     int // index of the receiver being probed
+
+	rows      uint64  // number of the distinct rows in the hash table
+	rightRows uint64  // number of the distinct rows of the right input
+	counts    []int64 // number of the rows of the right input which are not matched yet
+
+	keys          [][]byte
+	values        []uint64
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	flags  []uint8 // flags of the rows in the result
+	zFlags []uint8
+}
+
+// Argument combines the rows of the two merge receivers, the first one
+// is the left input and the second one is the right input.
+type Argument struct {
+	Type int  // Union, Intersect or Except
+	All  bool // keep the duplicate rows
+	ctr  *container
+}
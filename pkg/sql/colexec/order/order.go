@@ -60,6 +60,9 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	return n.ctr.process(bat, proc)
 }
 
+// process sorts the rows of a batch on its own, nothing is kept across the
+// batches so that the operator never spills to disk. The sorted batches are
+// merged by mergeorder, which spills the sorted runs over the memory threshold.
 func (ctr *Container) process(bat *batch.Batch, proc *process.Process) (bool, error) {
 	ovec := batch.GetVector(bat, ctr.attrs[0])
 	n := len(bat.Zs)
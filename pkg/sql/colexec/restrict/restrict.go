@@ -19,8 +19,11 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -112,6 +115,17 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		}
 		return false, nil
 	}
+	if sels, ok := evalDict(bat, n.E); ok {
+		if len(sels) == 0 {
+			bat.Zs = bat.Zs[:0]
+			proc.Reg.InputBatch = bat
+			return false, nil
+		}
+		batch.Reduce(bat, n.E.Attributes(), proc.Mp)
+		batch.Shrink(bat, sels)
+		proc.Reg.InputBatch = bat
+		return false, nil
+	}
 	vec, _, err := n.E.Eval(bat, proc)
 	if err != nil {
 		batch.Clean(bat, proc.Mp)
@@ -130,3 +144,81 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	proc.Reg.InputBatch = bat
 	return false, nil
 }
+
+// evalDict evaluates the comparison between a char/varchar attribute and
+// a constant on the dictionary codes of the attribute, the constant is
+// compared with each value of the dictionary only once. ok is false if e is
+// not such a comparison or the attribute is not dictionary encoded.
+func evalDict(bat *batch.Batch, e extend.Extend) ([]int64, bool) {
+	be, ok := e.(*extend.BinaryExtend)
+	if !ok {
+		return nil, false
+	}
+	op := be.Op
+	attr, aok := be.Left.(*extend.Attribute)
+	val, vok := be.Right.(*extend.ValueExtend)
+	if !aok || !vok {
+		if attr, aok = be.Right.(*extend.Attribute); !aok {
+			return nil, false
+		}
+		if val, vok = be.Left.(*extend.ValueExtend); !vok {
+			return nil, false
+		}
+		switch op {
+		case overload.LT:
+			op = overload.GT
+		case overload.LE:
+			op = overload.GE
+		case overload.GT:
+			op = overload.LT
+		case overload.GE:
+			op = overload.LE
+		}
+	}
+	switch op {
+	case overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE:
+	default:
+		return nil, false
+	}
+	if oid := val.V.Typ.Oid; oid != types.T_char && oid != types.T_varchar {
+		return nil, false
+	}
+	if nulls.Any(val.V.Nsp) {
+		return nil, false
+	}
+	vec := batch.GetVector(bat, attr.Name)
+	if vec == nil {
+		return nil, false
+	}
+	d := vector.GetDict(vec)
+	if d == nil {
+		return nil, false
+	}
+	v := val.V.Col.(*types.Bytes).Get(0)
+	matched := make([]bool, len(d.Values.Offsets))
+	for i := range matched {
+		r := bytes.Compare(d.Values.Get(int64(i)), v)
+		switch op {
+		case overload.EQ:
+			matched[i] = r == 0
+		case overload.NE:
+			matched[i] = r != 0
+		case overload.LT:
+			matched[i] = r < 0
+		case overload.LE:
+			matched[i] = r <= 0
+		case overload.GT:
+			matched[i] = r > 0
+		case overload.GE:
+			matched[i] = r >= 0
+		}
+	}
+	hasNull := nulls.Any(vec.Nsp)
+	sels := make([]int64, 0, len(d.Codes))
+	for i, c := range d.Codes {
+		if matched[c] && !(hasNull && nulls.Contains(vec.Nsp, uint64(i))) {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels, true
+}
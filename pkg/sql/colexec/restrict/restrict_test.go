@@ -0,0 +1,97 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrict
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/colenc"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestrictDict(t *testing.T) {
+	const rows = 100
+
+	for _, op := range []int{overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE} {
+		for _, reversed := range []bool{false, true} {
+			attr := &extend.Attribute{Name: "s", Type: types.T_varchar}
+			value := &extend.ValueExtend{V: vector.New(types.Type{Oid: types.T_varchar, Size: 24})}
+			require.NoError(t, vector.Append(value.V, [][]byte{[]byte("v3")}))
+			e := &extend.BinaryExtend{Op: op, Left: attr, Right: value}
+			if reversed {
+				e.Left, e.Right = value, attr
+			}
+
+			var results [][]int64
+			for _, dict := range []bool{true, false} {
+				proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+				bat := newBatch(t, rows, dict)
+				require.Equal(t, dict, vector.GetDict(bat.Vecs[1]) != nil)
+				if dict {
+					_, ok := evalDict(bat, e)
+					require.True(t, ok)
+				}
+				arg := &Argument{E: e}
+				require.NoError(t, Prepare(proc, arg))
+				proc.Reg.InputBatch = bat
+				_, err := Call(proc, arg)
+				require.NoError(t, err)
+				results = append(results, proc.Reg.InputBatch.Vecs[0].Col.([]int64))
+			}
+			require.Equal(t, results[1], results[0], "%s %v", overload.OpName[op], reversed)
+		}
+	}
+}
+
+// newBatch returns a batch of an int64 column i and a varchar column s,
+// the column s is read from the dictionary encoded data if dict is true.
+func newBatch(t *testing.T, rows int, dict bool) *batch.Batch {
+	bat := batch.New(true, []string{"i", "s"})
+	is := make([]int64, rows)
+	ss := make([][]byte, rows)
+	for i := range is {
+		is[i] = int64(i)
+		ss[i] = []byte(fmt.Sprintf("v%d", i%7))
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], is))
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(vec, ss))
+	for i := 0; i < rows; i += 10 {
+		nulls.Add(vec.Nsp, uint64(i))
+	}
+	if dict {
+		data, err := colenc.Encode(vec)
+		require.NoError(t, err)
+		require.Equal(t, colenc.Dict, colenc.Encoding(data))
+		vec = vector.New(vec.Typ)
+		require.NoError(t, colenc.Read(vec, data))
+	}
+	bat.Vecs[1] = vec
+	bat.Vecs[0].Ref, bat.Vecs[1].Ref = 1, 2
+	bat.InitZsOne(rows)
+	return bat
+}
@@ -15,17 +15,23 @@
 package updateTag
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
-	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deleteTag"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 )
 
+// Table is a table whose rows are updated by the statement,
+// the old rows are deleted and the new rows are written.
+type Table struct {
+	deleteTag.Table
+	UpdateList  []extend.UpdateExtend
+	UpdateAttrs []string
+}
+
 type Argument struct {
 	Ts           uint64
-	Relation     engine.Relation
+	Tables       []*Table
 	M            sync.Mutex
 	AffectedRows uint64
-	UpdateList   []extend.UpdateExtend
-	UpdateAttrs  []string
-	OtherAttrs   []string
 }
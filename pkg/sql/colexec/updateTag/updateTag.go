@@ -39,52 +39,75 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if bat == nil || len(bat.Zs) == 0 {
 		return false, nil
 	}
-
-	affectedRows := uint64(vector.Length(bat.Vecs[0]))
+	defer func() {
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = &batch.Batch{}
+	}()
 
 	// the reference of vector add 1
 	for i := range bat.Vecs {
 		bat.Vecs[i].Ref++
 	}
 
-	// update calculate
-	updateBatch := &batch.Batch{Attrs: append(p.UpdateAttrs, p.OtherAttrs...)}
-	for _, etd := range p.UpdateList {
-		vec, _, err := etd.Eval(bat, proc)
+	affectedRows := uint64(0)
+	for _, tbl := range p.Tables {
+		rows, err := update(tbl, bat, p.Ts, proc)
 		if err != nil {
-			batch.Clean(updateBatch, proc.Mp)
-			proc.Reg.InputBatch = &batch.Batch{}
 			return false, err
 		}
-		vec, err = vector.Dup(vec, proc.Mp)
-		if err != nil {
-			batch.Clean(updateBatch, proc.Mp)
-			proc.Reg.InputBatch = &batch.Batch{}
-			return false, err
+		affectedRows += rows
+	}
+
+	p.M.Lock()
+	p.AffectedRows += affectedRows
+	p.M.Unlock()
+	return false, nil
+}
+
+// update deletes the old rows of the table and writes the new rows in one batch
+func update(tbl *Table, bat *batch.Batch, ts uint64, proc *process.Process) (uint64, error) {
+	oldBatch, sels, err := tbl.Rows(bat, proc)
+	if err != nil {
+		return 0, err
+	}
+	defer batch.Clean(oldBatch, proc.Mp)
+	affectedRows := uint64(len(oldBatch.Zs))
+	if affectedRows == 0 {
+		return 0, nil
+	}
+
+	// update calculate
+	updateBatch := &batch.Batch{Attrs: tbl.Cols}
+	defer batch.Clean(updateBatch, proc.Mp)
+	for i, col := range tbl.Cols {
+		var vec *vector.Vector
+
+		j := indexOf(col, tbl.UpdateAttrs)
+		if j < 0 {
+			if vec, err = vector.Dup(oldBatch.Vecs[i], proc.Mp); err != nil {
+				return 0, err
+			}
+			updateBatch.Vecs = append(updateBatch.Vecs, vec)
+			continue
 		}
-		err = constantPadding(vec, affectedRows)
-		if err != nil {
-			batch.Clean(updateBatch, proc.Mp)
-			proc.Reg.InputBatch = &batch.Batch{}
-			return false, err
+		if vec, _, err = tbl.UpdateList[j].Eval(bat, proc); err != nil {
+			return 0, err
 		}
-		updateBatch.Vecs = append(updateBatch.Vecs, vec)
-	}
-	for _, attr := range p.OtherAttrs {
-		vec := batch.GetVector(bat, attr)
-		// newVec := &vector.Vector{Typ: vec.Typ, Col: vec.Col, Nsp: vec.Nsp}
-		vec, err := vector.Dup(vec, proc.Mp)
-		if err != nil {
-			batch.Clean(updateBatch, proc.Mp)
-			proc.Reg.InputBatch = &batch.Batch{}
-			return false, err
+		if vec, err = vector.Dup(vec, proc.Mp); err != nil {
+			return 0, err
 		}
 		updateBatch.Vecs = append(updateBatch.Vecs, vec)
+		if err = constantPadding(vec, uint64(len(bat.Zs))); err != nil {
+			return 0, err
+		}
+		if sels != nil {
+			vector.Shrink(vec, sels)
+		}
 	}
 
 	// delete tag
-	for i := range bat.Zs {
-		bat.Zs[i] = -1
+	for i := range oldBatch.Zs {
+		oldBatch.Zs[i] = -1
 	}
 
 	// update tag
@@ -93,28 +116,24 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		updateBatch.Zs[i] = 1
 	}
 
-	unionBat, err := bat.Append(proc.Mp, updateBatch)
+	unionBat, err := oldBatch.Append(proc.Mp, updateBatch)
 	if err != nil {
-		batch.Clean(unionBat, proc.Mp)
-		batch.Clean(updateBatch, proc.Mp)
-		proc.Reg.InputBatch = &batch.Batch{}
-		return false, err
+		return 0, err
 	}
 	// write batch to the storage
-	if err := p.Relation.Write(p.Ts, unionBat); err != nil {
-		batch.Clean(unionBat, proc.Mp)
-		batch.Clean(updateBatch, proc.Mp)
-		proc.Reg.InputBatch = &batch.Batch{}
-		return false, err
+	if err := tbl.Relation.Write(ts, unionBat); err != nil {
+		return 0, err
 	}
-	batch.Clean(unionBat, proc.Mp)
-	batch.Clean(updateBatch, proc.Mp)
-	proc.Reg.InputBatch = &batch.Batch{}
+	return affectedRows, nil
+}
 
-	p.M.Lock()
-	p.AffectedRows += affectedRows
-	p.M.Unlock()
-	return false, nil
+func indexOf(attr string, attrs []string) int {
+	for i := range attrs {
+		if attrs[i] == attr {
+			return i
+		}
+	}
+	return -1
 }
 
 func constantPadding(vec *vector.Vector, count uint64) error {
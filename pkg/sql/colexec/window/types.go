@@ -0,0 +1,183 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+const (
+	running = iota
+	end
+)
+
+const (
+	RowNumber = iota
+	Rank
+	DenseRank
+	PercentRank
+	CumeDist
+	Ntile
+	Lag
+	Lead
+	FirstValue
+	LastValue
+	NthValue
+	// Aggregate evaluates the aggregate function of the transformer over the frame.
+	Aggregate
+)
+
+var FunctionNames = [...]string{
+	RowNumber:   "row_number",
+	Rank:        "rank",
+	DenseRank:   "dense_rank",
+	PercentRank: "percent_rank",
+	CumeDist:    "cume_dist",
+	Ntile:       "ntile",
+	Lag:         "lag",
+	Lead:        "lead",
+	FirstValue:  "first_value",
+	LastValue:   "last_value",
+	NthValue:    "nth_value",
+	Aggregate:   "aggregate",
+}
+
+// FrameUnit is the unit of the frame.
+type FrameUnit int8
+
+const (
+	// Rows means the bounds of the frame are offsets of rows.
+	Rows FrameUnit = iota
+	// Range means the bounds of the frame are offsets of the value of the order attribute.
+	Range
+)
+
+// BoundType is the type of the frame bound.
+type BoundType int8
+
+const (
+	UnboundedPreceding BoundType = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+var boundNames = [...]string{
+	UnboundedPreceding: "UNBOUNDED PRECEDING",
+	Preceding:          "PRECEDING",
+	CurrentRow:         "CURRENT ROW",
+	Following:          "FOLLOWING",
+	UnboundedFollowing: "UNBOUNDED FOLLOWING",
+}
+
+type Bound struct {
+	Type BoundType
+	// Offset is the number of rows for Rows, or the difference of values for Range.
+	Offset float64
+}
+
+// Frame is the subset of the partition used by the function for each row.
+type Frame struct {
+	Unit  FrameUnit
+	Start Bound
+	End   Bound
+}
+
+type Function struct {
+	// Op is the window function, like RowNumber.
+	Op int
+	// Agg is the transformer op of the aggregate function, used only by Aggregate.
+	Agg int
+	// Attr is the argument of the function.
+	Attr string
+	// Default is the attribute whose value is used when the row of lag or lead is out of the partition,
+	// the value is Value or null if Default is empty.
+	Default string
+	// Value is the constant used as the default of lag and lead, nil for null.
+	Value *vector.Vector
+	// N is the offset of lag and lead, the number of buckets of ntile,
+	// or the position in the frame of nth_value.
+	N int64
+	// Alias is the name of the result attribute.
+	Alias string
+	// Frame is used by first_value, last_value, nth_value and the aggregate functions.
+	// The frame is RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW if Frame is nil and the rows are ordered,
+	// otherwise the frame is the whole partition.
+	Frame *Frame
+}
+
+type container struct {
+	// state signs the statement of window operator
+	//	1. if state is running, operator still range the mergeReceivers to collect the rows.
+	//	2. if state is end, operator has done and should push data to next operator.
+	state uint8
+
+	attrs []string // partition attributes followed by the order attributes
+	ds    []bool   // Directions, ds[i] == true: the attrs[i] are in descending order
+
+	// bat store all the rows of the window
+	bat *batch.Batch
+}
+
+type Argument struct {
+	// Partitions are the attributes of PARTITION BY.
+	Partitions []string
+	// Fields store the order information of ORDER BY.
+	Fields []order.Field
+	// Functions are the window functions evaluated over the partitions.
+	Functions []Function
+	// ctr stores the attributes needn't do Serialization work
+	ctr container
+}
+
+func (b Bound) String() string {
+	switch b.Type {
+	case Preceding, Following:
+		return fmt.Sprintf("%v %s", b.Offset, boundNames[b.Type])
+	}
+	return boundNames[b.Type]
+}
+
+func (f *Frame) String() string {
+	unit := "ROWS"
+	if f.Unit == Range {
+		unit = "RANGE"
+	}
+	return fmt.Sprintf("%s BETWEEN %s AND %s", unit, f.Start, f.End)
+}
+
+func (f Function) String() string {
+	var s string
+	switch f.Op {
+	case Aggregate:
+		s = fmt.Sprintf("%s(%s)", transformer.TransformerNames[f.Agg], f.Attr)
+	case Lag, Lead, NthValue:
+		s = fmt.Sprintf("%s(%s, %v)", FunctionNames[f.Op], f.Attr, f.N)
+	case Ntile:
+		s = fmt.Sprintf("%s(%v)", FunctionNames[f.Op], f.N)
+	default:
+		s = fmt.Sprintf("%s(%s)", FunctionNames[f.Op], f.Attr)
+	}
+	if f.Frame != nil {
+		s += " " + f.Frame.String()
+	}
+	return s + " -> " + f.Alias
+}
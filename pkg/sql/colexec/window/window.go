@@ -0,0 +1,685 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/partition"
+	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	argument := arg.(*Argument)
+	buf.WriteString("window(")
+	if len(argument.Partitions) > 0 {
+		buf.WriteString(fmt.Sprintf("partition by %v ", argument.Partitions))
+	}
+	if len(argument.Fields) > 0 {
+		buf.WriteString("order by [")
+		for i, f := range argument.Fields {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(f.String())
+		}
+		buf.WriteString("] ")
+	}
+	buf.WriteString("{")
+	for i, f := range argument.Functions {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f.String())
+	}
+	buf.WriteString("})")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	argument := arg.(*Argument)
+
+	argument.ctr.state = running
+	argument.ctr.attrs = make([]string, 0, len(argument.Partitions)+len(argument.Fields))
+	argument.ctr.ds = make([]bool, 0, len(argument.Partitions)+len(argument.Fields))
+	for _, attr := range argument.Partitions {
+		argument.ctr.attrs = append(argument.ctr.attrs, attr)
+		argument.ctr.ds = append(argument.ctr.ds, false)
+	}
+	for _, f := range argument.Fields {
+		argument.ctr.attrs = append(argument.ctr.attrs, f.Attr)
+		argument.ctr.ds = append(argument.ctr.ds, f.Type == order.Descending)
+	}
+	argument.ctr.bat = nil
+	return nil
+}
+
+// Call collects all the rows from the mergeReceivers,
+// then sorts them by the partition and order attributes and evaluates the window functions.
+// The results of the functions are appended to the batch as the attributes named by the aliases.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	argument := arg.(*Argument)
+	ctr := &argument.ctr
+
+	for {
+		switch ctr.state {
+		case running:
+			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
+				reg := proc.Reg.MergeReceivers[i]
+				bat := <-reg.Ch
+				if bat == nil {
+					proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+					i--
+					continue
+				}
+				if len(bat.Zs) == 0 {
+					i--
+					continue
+				}
+				if err := ctr.collect(bat, proc); err != nil {
+					ctr.clean(proc)
+					return false, err
+				}
+				i--
+			}
+			ctr.state = end
+		case end:
+			if ctr.bat != nil {
+				if err := ctr.eval(argument, proc); err != nil {
+					ctr.clean(proc)
+					return false, err
+				}
+			}
+			proc.Reg.InputBatch = ctr.bat
+			ctr.bat = nil
+			return true, nil
+		}
+	}
+}
+
+// collect appends the rows of the batch to the window,
+// the duplicated rows are expanded because every row has its own result.
+func (ctr *container) collect(bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	if ctr.bat == nil {
+		ctr.bat = batch.New(false, append([]string{}, bat.Attrs...))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+	} else {
+		batch.Reorder(bat, ctr.bat.Attrs)
+	}
+
+	duplicated := false
+	for _, z := range bat.Zs {
+		if z != 1 {
+			duplicated = true
+			break
+		}
+	}
+	if !duplicated {
+		flags := make([]uint8, len(bat.Zs))
+		for i := range flags {
+			flags[i]++
+		}
+		for i, vec := range ctr.bat.Vecs {
+			if err := vector.UnionBatch(vec, bat.Vecs[i], 0, len(bat.Zs), flags, proc.Mp); err != nil {
+				return err
+			}
+		}
+		ctr.bat.Zs = append(ctr.bat.Zs, bat.Zs...)
+		return nil
+	}
+	for i, z := range bat.Zs {
+		for ; z > 0; z-- {
+			for j, vec := range ctr.bat.Vecs {
+				if err := vector.UnionOne(vec, bat.Vecs[j], int64(i), proc.Mp); err != nil {
+					return err
+				}
+			}
+			ctr.bat.Zs = append(ctr.bat.Zs, 1)
+		}
+	}
+	return nil
+}
+
+func (ctr *container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+}
+
+// sort orders the rows by the partition attributes and then the order attributes,
+// it returns the first rows of the partitions and the first rows of the peers.
+// The peers are the rows of a partition which have the same values of the order attributes.
+func (ctr *container) sort(np int, proc *process.Process) ([]int64, []int64, error) {
+	bat := ctr.bat
+	if len(ctr.attrs) == 0 {
+		return []int64{0}, []int64{0}, nil
+	}
+	data, err := mheap.Alloc(proc.Mp, int64(len(bat.Zs)*8))
+	if err != nil {
+		return nil, nil, err
+	}
+	sels := encoding.DecodeInt64Slice(data)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+
+	var parts []int64
+	ps := make([]int64, 0, 16)
+	ds := make([]bool, len(sels))
+	ovec := batch.GetVector(bat, ctr.attrs[0])
+	sortNulls(ctr.ds[0], sels, ovec)
+	for i := 1; i < len(ctr.attrs); i++ {
+		ps = partitionNulls(sels, ds, ps, ovec)
+		if i == np {
+			parts = append(parts, ps...)
+		}
+		vec := batch.GetVector(bat, ctr.attrs[i])
+		for j := range ps {
+			if j == len(ps)-1 {
+				sortNulls(ctr.ds[i], sels[ps[j]:], vec)
+			} else {
+				sortNulls(ctr.ds[i], sels[ps[j]:ps[j+1]], vec)
+			}
+		}
+		ovec = vec
+	}
+	ps = partitionNulls(sels, ds, ps, ovec)
+	switch np {
+	case 0:
+		parts = []int64{0}
+	case len(ctr.attrs):
+		parts = append(parts, ps...)
+	}
+
+	bat.Sels = sels
+	bat.SelsData = data
+	if err := batch.Shuffle(bat, proc.Mp); err != nil {
+		return nil, nil, err
+	}
+	return parts, ps, nil
+}
+
+// sortNulls sorts the rows by the values of the vector, the nulls are
+// placed before the values in ascending order and after them in descending
+// order, as mysql does.
+func sortNulls(desc bool, sels []int64, vec *vector.Vector) {
+	sort.Sort(desc, sels, vec)
+	if !nulls.Any(vec.Nsp) {
+		return
+	}
+	vs := make([]int64, 0, len(sels))
+	ns := make([]int64, 0, len(sels))
+	for _, sel := range sels {
+		if nulls.Contains(vec.Nsp, uint64(sel)) {
+			ns = append(ns, sel)
+		} else {
+			vs = append(vs, sel)
+		}
+	}
+	if desc {
+		copy(sels[copy(sels, vs):], ns)
+	} else {
+		copy(sels[copy(sels, ns):], vs)
+	}
+}
+
+// partitionNulls returns the first rows of the groups of the rows with the
+// same values of the vector, all the nulls are in the same group, while
+// partition.Partition compares the values under the nulls.
+func partitionNulls(sels []int64, diffs []bool, ps []int64, vec *vector.Vector) []int64 {
+	if !nulls.Any(vec.Nsp) {
+		return partition.Partition(sels, diffs, ps, vec)
+	}
+	prev := make([]bool, len(sels))
+	copy(prev, diffs)
+	partition.Partition(sels, diffs, ps, vec)
+	ps = ps[:0]
+	for i, sel := range sels {
+		if i > 0 && !prev[i] && nulls.Contains(vec.Nsp, uint64(sel)) && nulls.Contains(vec.Nsp, uint64(sels[i-1])) {
+			diffs[i] = false
+		}
+		if diffs[i] {
+			ps = append(ps, int64(i))
+		}
+	}
+	return ps
+}
+
+// spans returns the first row and the end of the span which each row belongs to.
+func spans(starts []int64, n int64) ([]int64, []int64) {
+	ss, es := make([]int64, n), make([]int64, n)
+	for k, s := range starts {
+		e := n
+		if k+1 < len(starts) {
+			e = starts[k+1]
+		}
+		for i := s; i < e; i++ {
+			ss[i], es[i] = s, e
+		}
+	}
+	return ss, es
+}
+
+func (ctr *container) eval(arg *Argument, proc *process.Process) error {
+	bat := ctr.bat
+	n := int64(len(bat.Zs))
+	parts, peers, err := ctr.sort(len(arg.Partitions), proc)
+	if err != nil {
+		return err
+	}
+	ps, pe := spans(parts, n)
+	gs, ge := spans(peers, n)
+
+	for _, f := range arg.Functions {
+		var vec *vector.Vector
+		switch f.Op {
+		case RowNumber, Rank, DenseRank, Ntile:
+			var vs []int64
+			if vec, vs, err = newInt64Vector(n, proc); err != nil {
+				return err
+			}
+			var r int64
+			for i := int64(0); i < n; i++ {
+				switch f.Op {
+				case RowNumber:
+					vs[i] = i - ps[i] + 1
+				case Rank:
+					vs[i] = gs[i] - ps[i] + 1
+				case DenseRank:
+					if i == ps[i] {
+						r = 0
+					}
+					if i == gs[i] {
+						r++
+					}
+					vs[i] = r
+				case Ntile:
+					if f.N <= 0 {
+						vector.Clean(vec, proc.Mp)
+						return errors.New(errno.WindowingError, "the argument of ntile must be positive")
+					}
+					vs[i] = ntile(i-ps[i], pe[i]-ps[i], f.N)
+				}
+			}
+		case PercentRank, CumeDist:
+			var vs []float64
+			if vec, vs, err = newFloat64Vector(n, proc); err != nil {
+				return err
+			}
+			for i := int64(0); i < n; i++ {
+				rows := float64(pe[i] - ps[i])
+				switch {
+				case f.Op == CumeDist:
+					vs[i] = float64(ge[i]-ps[i]) / rows
+				case rows > 1:
+					vs[i] = float64(gs[i]-ps[i]) / (rows - 1)
+				default:
+					vs[i] = 0
+				}
+			}
+		case Lag, Lead:
+			if f.N < 0 {
+				return errors.New(errno.WindowingError, fmt.Sprintf("the offset of %s must not be negative", FunctionNames[f.Op]))
+			}
+			sels := make([]int64, n)
+			for i := int64(0); i < n; i++ {
+				j := i - f.N
+				if f.Op == Lead {
+					j = i + f.N
+				}
+				if j < ps[i] || j >= pe[i] {
+					j = -1
+				}
+				sels[i] = j
+			}
+			var dflt *vector.Vector
+			if len(f.Default) > 0 {
+				dflt = batch.GetVector(bat, f.Default)
+			}
+			if vec, err = pick(batch.GetVector(bat, f.Attr), dflt, f.Value, sels, proc); err != nil {
+				return err
+			}
+		case FirstValue, LastValue, NthValue:
+			if f.Op == NthValue && f.N <= 0 {
+				return errors.New(errno.WindowingError, "the position of nth_value must be positive")
+			}
+			fs, fe, err := ctr.frames(arg, f.Frame, ps, pe, gs, ge)
+			if err != nil {
+				return err
+			}
+			sels := make([]int64, n)
+			for i := int64(0); i < n; i++ {
+				j := fs[i]
+				switch f.Op {
+				case LastValue:
+					j = fe[i] - 1
+				case NthValue:
+					j = fs[i] + f.N - 1
+				}
+				if j < fs[i] || j >= fe[i] {
+					j = -1
+				}
+				sels[i] = j
+			}
+			if vec, err = pick(batch.GetVector(bat, f.Attr), nil, nil, sels, proc); err != nil {
+				return err
+			}
+		case Aggregate:
+			fs, fe, err := ctr.frames(arg, f.Frame, ps, pe, gs, ge)
+			if err != nil {
+				return err
+			}
+			if vec, err = aggregate(f.Agg, batch.GetVector(bat, f.Attr), fs, fe, proc); err != nil {
+				return err
+			}
+		default:
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function %v is not supported", f.Op))
+		}
+		bat.Attrs = append(bat.Attrs, f.Alias)
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	return nil
+}
+
+// ntile returns the bucket of the i-th row of the partition with n rows,
+// the first n % buckets buckets have one more row than the others.
+func ntile(i, n, buckets int64) int64 {
+	size, rem := n/buckets, n%buckets
+	if i < rem*(size+1) {
+		return i/(size+1) + 1
+	}
+	return (i-rem*(size+1))/size + rem + 1
+}
+
+// frames returns the first row and the end of the frame of each row.
+func (ctr *container) frames(arg *Argument, f *Frame, ps, pe, gs, ge []int64) ([]int64, []int64, error) {
+	if f == nil {
+		// RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW
+		if len(arg.Fields) > 0 {
+			return ps, ge, nil
+		}
+		return ps, pe, nil
+	}
+	if f.Start.Type == UnboundedFollowing || f.End.Type == UnboundedPreceding {
+		return nil, nil, errors.New(errno.WindowingError, fmt.Sprintf("invalid frame %s", f))
+	}
+
+	n := int64(len(ps))
+	fs, fe := make([]int64, n), make([]int64, n)
+	if f.Unit == Rows {
+		for i := int64(0); i < n; i++ {
+			fs[i] = rowBound(f.Start, i, ps[i], pe[i])
+			fe[i] = rowBound(f.End, i, ps[i], pe[i]) + 1
+			if f.End.Type == UnboundedFollowing {
+				fe[i] = pe[i]
+			}
+			fs[i], fe[i] = clamp(fs[i], ps[i], pe[i]), clamp(fe[i], ps[i], pe[i])
+			if fe[i] < fs[i] {
+				fe[i] = fs[i]
+			}
+		}
+		return fs, fe, nil
+	}
+
+	// the offsets of RANGE are the differences of the value of the only order attribute
+	var vs []float64
+	var vec *vector.Vector
+	dir := 1.0
+	if isOffset(f.Start) || isOffset(f.End) {
+		if len(arg.Fields) != 1 {
+			return nil, nil, errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+		}
+		vec = batch.GetVector(ctr.bat, arg.Fields[0].Attr)
+		values, err := toFloat64(vec)
+		if err != nil {
+			return nil, nil, err
+		}
+		vs = values
+		if arg.Fields[0].Type == order.Descending {
+			dir = -1
+		}
+	}
+	// the nulls of a partition are peers at its beginning or its end, the
+	// offsets of the other rows are scanned in the values [ns, ne) only
+	var sp, ep, ns, ne int64
+	for i := int64(0); i < n; i++ {
+		if i == ps[i] {
+			ns, ne = ps[i], pe[i]
+			if vec != nil {
+				for ns < ne && nulls.Contains(vec.Nsp, uint64(ns)) {
+					ns++
+				}
+				for ne > ns && nulls.Contains(vec.Nsp, uint64(ne-1)) {
+					ne--
+				}
+			}
+			sp, ep = ns, ns
+		}
+		// the frame of a null is its peers for an offset bound
+		isNull := vec != nil && nulls.Contains(vec.Nsp, uint64(i))
+		switch b := f.Start; {
+		case b.Type == UnboundedPreceding:
+			fs[i] = ps[i]
+		case b.Type == CurrentRow || isNull:
+			fs[i] = gs[i]
+		default:
+			off := offset(b)
+			for sp < ne && dir*(vs[sp]-vs[i]) < off {
+				sp++
+			}
+			fs[i] = sp
+		}
+		switch b := f.End; {
+		case b.Type == UnboundedFollowing:
+			fe[i] = pe[i]
+		case b.Type == CurrentRow || isNull:
+			fe[i] = ge[i]
+		default:
+			off := offset(b)
+			for ep < ne && dir*(vs[ep]-vs[i]) <= off {
+				ep++
+			}
+			fe[i] = ep
+		}
+		if fe[i] < fs[i] {
+			fe[i] = fs[i]
+		}
+	}
+	return fs, fe, nil
+}
+
+func isOffset(b Bound) bool {
+	return b.Type == Preceding || b.Type == Following
+}
+
+// offset returns the signed offset of the bound, the offset of PRECEDING is negative.
+func offset(b Bound) float64 {
+	if b.Type == Preceding {
+		return -b.Offset
+	}
+	return b.Offset
+}
+
+// rowBound returns the row of the bound of ROWS.
+func rowBound(b Bound, i, ps, pe int64) int64 {
+	switch b.Type {
+	case UnboundedPreceding:
+		return ps
+	case Preceding:
+		return i - int64(b.Offset)
+	case Following:
+		return i + int64(b.Offset)
+	case UnboundedFollowing:
+		return pe
+	}
+	return i
+}
+
+func clamp(v, min, max int64) int64 {
+	switch {
+	case v < min:
+		return min
+	case v > max:
+		return max
+	}
+	return v
+}
+
+func toFloat64(vec *vector.Vector) ([]float64, error) {
+	var vs []float64
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		for _, v := range vec.Col.([]int8) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_int16:
+		for _, v := range vec.Col.([]int16) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_int32:
+		for _, v := range vec.Col.([]int32) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_int64:
+		for _, v := range vec.Col.([]int64) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_uint8:
+		for _, v := range vec.Col.([]uint8) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_uint16:
+		for _, v := range vec.Col.([]uint16) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_uint32:
+		for _, v := range vec.Col.([]uint32) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_uint64:
+		for _, v := range vec.Col.([]uint64) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_float32:
+		for _, v := range vec.Col.([]float32) {
+			vs = append(vs, float64(v))
+		}
+	case types.T_float64:
+		vs = vec.Col.([]float64)
+	case types.T_date:
+		for _, v := range vec.Col.([]types.Date) {
+			vs = append(vs, float64(v))
+		}
+	default:
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("RANGE with offset is not supported for type %s", vec.Typ))
+	}
+	return vs, nil
+}
+
+// pick returns the vector made of the rows of the vector, the row is taken
+// from the default vector, is the constant value or is null if the sel is -1.
+func pick(vec, dflt, value *vector.Vector, sels []int64, proc *process.Process) (*vector.Vector, error) {
+	rvec := vector.New(vec.Typ)
+	for i, sel := range sels {
+		var err error
+		switch {
+		case sel >= 0:
+			err = vector.UnionOne(rvec, vec, sel, proc.Mp)
+		case dflt != nil:
+			err = vector.UnionOne(rvec, dflt, int64(i), proc.Mp)
+		case value != nil:
+			err = vector.UnionOne(rvec, value, 0, proc.Mp)
+		default:
+			if err = vector.UnionOne(rvec, vec, 0, proc.Mp); err == nil {
+				nulls.Add(rvec.Nsp, uint64(i))
+			}
+		}
+		if err != nil {
+			vector.Clean(rvec, proc.Mp)
+			return nil, err
+		}
+	}
+	return rvec, nil
+}
+
+// aggregate evaluates the aggregate function over the frame of each row.
+// The frame reuses the result of the previous frame if they have the same first row.
+func aggregate(op int, vec *vector.Vector, fs, fe []int64, proc *process.Process) (*vector.Vector, error) {
+	r, err := transformer.New(op, vec.Typ)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("aggregate %v is not supported by window", op))
+	}
+	n := len(fs)
+	if err := r.Grows(n, proc.Mp); err != nil {
+		r.Free(proc.Mp)
+		return nil, err
+	}
+	zs := make([]int64, n)
+	for i := 0; i < n; i++ {
+		start := fs[i]
+		if i > 0 && fs[i] == fs[i-1] && fe[i] >= fe[i-1] {
+			r.Add(r, int64(i), int64(i-1))
+			zs[i] = zs[i-1]
+			start = fe[i-1]
+		}
+		for j := start; j < fe[i]; j++ {
+			r.Fill(int64(i), j, 1, vec)
+			zs[i]++
+		}
+	}
+	return r.Eval(zs), nil
+}
+
+func newInt64Vector(n int64, proc *process.Process) (*vector.Vector, []int64, error) {
+	data, err := mheap.Alloc(proc.Mp, n*8)
+	if err != nil {
+		return nil, nil, err
+	}
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vs := encoding.DecodeInt64Slice(data)
+	vec.Col = vs
+	vec.Data = data
+	return vec, vs, nil
+}
+
+func newFloat64Vector(n int64, proc *process.Process) (*vector.Vector, []float64, error) {
+	data, err := mheap.Alloc(proc.Mp, n*8)
+	if err != nil {
+		return nil, nil, err
+	}
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	vs := encoding.DecodeFloat64Slice(data)
+	vec.Col = vs
+	vec.Data = data
+	return vec, vs, nil
+}
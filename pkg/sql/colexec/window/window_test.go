@@ -0,0 +1,113 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindow(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	arg := &Argument{
+		Partitions: []string{"p"},
+		Fields:     []order.Field{{Attr: "v", Type: order.Ascending}},
+		Functions: []Function{
+			{Op: RowNumber, Alias: "row_number"},
+			{Op: Rank, Alias: "rank"},
+			{Op: DenseRank, Alias: "dense_rank"},
+			{Op: Ntile, N: 2, Alias: "ntile"},
+			{Op: Lag, Attr: "v", N: 1, Alias: "lag"},
+			{Op: Aggregate, Agg: transformer.Sum, Attr: "v", Alias: "sum"},
+			{Op: Aggregate, Agg: transformer.Sum, Attr: "v", Alias: "moving_sum", Frame: &Frame{
+				Unit:  Rows,
+				Start: Bound{Type: Preceding, Offset: 1},
+				End:   Bound{Type: CurrentRow},
+			}},
+			{Op: LastValue, Attr: "v", Alias: "last_value", Frame: &Frame{
+				Unit:  Range,
+				Start: Bound{Type: CurrentRow},
+				End:   Bound{Type: Following, Offset: 1},
+			}},
+		},
+	}
+	buf := new(bytes.Buffer)
+	String(arg, buf)
+	require.NoError(t, Prepare(proc, arg))
+
+	reg := &process.WaitRegister{Ctx: context.TODO(), Ch: make(chan *batch.Batch, 2)}
+	proc.Reg.MergeReceivers = []*process.WaitRegister{reg}
+	reg.Ch <- newBatch(t, proc, []int64{1, 2, 1, 2, 1}, []int64{3, 1, 1, 2, 3})
+	reg.Ch <- nil
+
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+	bat := proc.Reg.InputBatch
+	defer batch.Clean(bat, proc.Mp)
+
+	// partition 1: v = 1, 3, 3; partition 2: v = 1, 2
+	require.Equal(t, []int64{1, 1, 1, 2, 2}, batch.GetVector(bat, "p").Col)
+	require.Equal(t, []int64{1, 3, 3, 1, 2}, batch.GetVector(bat, "v").Col)
+	require.Equal(t, []int64{1, 2, 3, 1, 2}, batch.GetVector(bat, "row_number").Col)
+	require.Equal(t, []int64{1, 2, 2, 1, 2}, batch.GetVector(bat, "rank").Col)
+	require.Equal(t, []int64{1, 2, 2, 1, 2}, batch.GetVector(bat, "dense_rank").Col)
+	require.Equal(t, []int64{1, 1, 2, 1, 2}, batch.GetVector(bat, "ntile").Col)
+	require.Equal(t, []int64{1, 7, 7, 1, 3}, batch.GetVector(bat, "sum").Col)
+	require.Equal(t, []int64{1, 4, 6, 1, 3}, batch.GetVector(bat, "moving_sum").Col)
+	require.Equal(t, []int64{1, 3, 3, 2, 2}, batch.GetVector(bat, "last_value").Col)
+
+	lag := batch.GetVector(bat, "lag")
+	require.True(t, nulls.Contains(lag.Nsp, 0))
+	require.True(t, nulls.Contains(lag.Nsp, 3))
+	require.Equal(t, int64(1), lag.Col.([]int64)[1])
+	require.Equal(t, int64(3), lag.Col.([]int64)[2])
+	require.Equal(t, int64(1), lag.Col.([]int64)[4])
+}
+
+func TestNtile(t *testing.T) {
+	var buckets []int64
+	for i := int64(0); i < 7; i++ {
+		buckets = append(buckets, ntile(i, 7, 3))
+	}
+	require.Equal(t, []int64{1, 1, 1, 2, 2, 3, 3}, buckets)
+}
+
+func newBatch(t *testing.T, proc *process.Process, ps, vs []int64) *batch.Batch {
+	bat := batch.New(true, []string{"p", "v"})
+	for i, col := range [][]int64{ps, vs} {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		require.NoError(t, vector.Append(vec, col))
+		bat.Vecs[i] = vec
+	}
+	bat.Zs = make([]int64, len(ps))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
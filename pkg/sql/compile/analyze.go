@@ -0,0 +1,95 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+)
+
+// analyzeScope makes all instructions of the scope and its pre-scopes record
+// their runtime statistics.
+func analyzeScope(s *Scope) {
+	vm.NewAnalyze(s.Instructions)
+	for _, ps := range s.PreScopes {
+		analyzeScope(ps)
+	}
+}
+
+// Analyzes returns the statistics of the instructions of the scope and its
+// pre-scopes in pre-order, an element is nil if the instruction has none.
+func (s *Scope) Analyzes() []*vm.Analyze {
+	as := make([]*vm.Analyze, 0, len(s.Instructions))
+	for _, in := range s.Instructions {
+		as = append(as, in.Analyze)
+	}
+	for _, ps := range s.PreScopes {
+		as = append(as, ps.Analyzes()...)
+	}
+	return as
+}
+
+// snapshotScope copies the structure of the scope before it runs, since the
+// scope is rewritten while running in parallel, the copies of the instructions
+// share the statistics with the instructions executed.
+func snapshotScope(s *Scope) *Scope {
+	rs := &Scope{
+		Magic:      s.Magic,
+		DataSource: s.DataSource,
+		NodeInfo:   s.NodeInfo,
+	}
+	rs.Instructions = append(rs.Instructions, s.Instructions...)
+	for _, ps := range s.PreScopes {
+		rs.PreScopes = append(rs.PreScopes, snapshotScope(ps))
+	}
+	return rs
+}
+
+// explainScope writes the instructions of the scope into buffer from the last
+// one, each instruction is a child of the next one and the pre-scopes are the
+// children of the first instruction.
+func explainScope(s *Scope, buffer *explain.ExplainDataBuffer, level int) {
+	if s.Magic == Remote {
+		buffer.PushNewLine(fmt.Sprintf("Remote Scope on %s", s.NodeInfo.Addr), true, level)
+		level++
+	}
+	for i := len(s.Instructions) - 1; i >= 0; i-- {
+		var buf bytes.Buffer
+
+		in := s.Instructions[i]
+		vm.String(vm.Instructions{in}, &buf)
+		buffer.PushNewLine(buf.String(), true, level)
+		if in.Analyze != nil {
+			buffer.PushNewLine(formatAnalyze(in.Analyze.Load()), false, level)
+		}
+		level++
+	}
+	if s.DataSource != nil && len(s.DataSource.RelationName) > 0 {
+		buffer.PushNewLine(fmt.Sprintf("Table Scan on %s.%s", s.DataSource.SchemaName, s.DataSource.RelationName), true, level)
+	}
+	for _, ps := range s.PreScopes {
+		explainScope(ps, buffer, level)
+	}
+}
+
+func formatAnalyze(a vm.Analyze) string {
+	return fmt.Sprintf("Analyze: rows in=%d out=%d, batches in=%d out=%d, time=%v, cpu=%v, peak memory=%dB",
+		a.InputRows, a.OutputRows, a.InputBatches, a.OutputBatches,
+		time.Duration(a.WallTime), time.Duration(a.CPUTime), a.PeakMemory)
+}
@@ -0,0 +1,222 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+)
+
+// newApplyReader returns the reader of the apply table of the plan scope ps.
+func (e *Exec) newApplyReader(op *plan.Apply, ps *plan.Scope, mp *mheap.Mheap) *cteReader {
+	return &cteReader{
+		mp: mp,
+		get: func() (*batch.Batch, error) {
+			return e.evalApply(op, ps)
+		},
+	}
+}
+
+// evalApply computes the rows of the apply table, the subquery is evaluated
+// for each value of the outer attributes without null, a value is dropped if
+// the subquery is empty for it.
+func (e *Exec) evalApply(op *plan.Apply, ps *plan.Scope) (*batch.Batch, error) {
+	mp := mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu))
+	if op.Outer == nil {
+		return e.evalScalar(op, ps, mp)
+	}
+	keys, err := e.runPlanScope(op.Outer, mp)
+	if err != nil {
+		return nil, err
+	}
+	rbat := batch.New(true, op.Attrs)
+	for i, attr := range op.Attrs {
+		rbat.Vecs[i] = vector.New(ps.Result.AttrsMap[attr].Type)
+	}
+	vals := make([]interface{}, len(keys.Vecs))
+	for i, z := range keys.Zs {
+		row := int64(i)
+		if z == 0 || hasNull(keys.Vecs, row) { // the null never equals to an outer attribute
+			continue
+		}
+		for j, vec := range keys.Vecs {
+			vals[j] = getValue(vec, row)
+		}
+		e.mu.Lock()
+		s, err := op.Build(vals)
+		e.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		bat, err := e.runPlanScope(s, mp)
+		if err != nil {
+			return nil, err
+		}
+		sel, n := int64(-1), int64(0)
+		for k, z := range bat.Zs {
+			if z > 0 {
+				sel = int64(k)
+				n += z
+			}
+		}
+		if n > 1 {
+			batch.Clean(bat, mp)
+			return nil, errors.New(errno.CardinalityViolation, "Subquery returns more than 1 row")
+		}
+		if n == 1 {
+			for j, vec := range keys.Vecs {
+				if err := vector.UnionOne(rbat.Vecs[j], vec, row, mp); err != nil {
+					batch.Clean(bat, mp)
+					return nil, err
+				}
+			}
+			if err := vector.UnionOne(rbat.Vecs[len(keys.Vecs)], bat.Vecs[0], sel, mp); err != nil {
+				batch.Clean(bat, mp)
+				return nil, err
+			}
+			rbat.Zs = append(rbat.Zs, 1)
+		}
+		batch.Clean(bat, mp)
+	}
+	batch.Clean(keys, mp)
+	return rbat, nil
+}
+
+// evalScalar computes the row of the apply table of an uncorrelated scalar
+// subquery, the result is null if the subquery is empty.
+func (e *Exec) evalScalar(op *plan.Apply, ps *plan.Scope, mp *mheap.Mheap) (*batch.Batch, error) {
+	bat, err := e.runPlanScope(op.Inner, mp)
+	if err != nil {
+		return nil, err
+	}
+	defer batch.Clean(bat, mp)
+	sel, n := int64(-1), int64(0)
+	for k, z := range bat.Zs {
+		if z > 0 {
+			sel = int64(k)
+			n += z
+		}
+	}
+	if n > 1 {
+		return nil, errors.New(errno.CardinalityViolation, "Subquery returns more than 1 row")
+	}
+	rbat := batch.New(true, op.Attrs)
+	rbat.Vecs[0] = vector.New(ps.Result.AttrsMap[op.Attrs[0]].Type)
+	if n == 1 {
+		err = vector.UnionOne(rbat.Vecs[0], bat.Vecs[0], sel, mp)
+	} else {
+		err = appendNull(rbat.Vecs[0])
+	}
+	if err != nil {
+		return nil, err
+	}
+	rbat.Zs = append(rbat.Zs, 1)
+	return rbat, nil
+}
+
+// appendNull appends a null to vec.
+func appendNull(vec *vector.Vector) error {
+	var arg interface{}
+
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		arg = make([]int8, 1)
+	case types.T_int16:
+		arg = make([]int16, 1)
+	case types.T_int32:
+		arg = make([]int32, 1)
+	case types.T_int64:
+		arg = make([]int64, 1)
+	case types.T_uint8:
+		arg = make([]uint8, 1)
+	case types.T_uint16:
+		arg = make([]uint16, 1)
+	case types.T_uint32:
+		arg = make([]uint32, 1)
+	case types.T_uint64:
+		arg = make([]uint64, 1)
+	case types.T_float32:
+		arg = make([]float32, 1)
+	case types.T_float64:
+		arg = make([]float64, 1)
+	case types.T_date:
+		arg = make([]types.Date, 1)
+	case types.T_datetime:
+		arg = make([]types.Datetime, 1)
+	case types.T_timestamp:
+		arg = make([]types.Timestamp, 1)
+	case types.T_decimal64:
+		arg = make([]types.Decimal64, 1)
+	case types.T_decimal128:
+		arg = make([]types.Decimal128, 1)
+	case types.T_char, types.T_varchar, types.T_json:
+		arg = make([][]byte, 1)
+	}
+	if err := vector.Append(vec, arg); err != nil {
+		return err
+	}
+	nulls.Add(vec.Nsp, uint64(vector.Length(vec)-1))
+	return nil
+}
+
+func hasNull(vecs []*vector.Vector, row int64) bool {
+	for _, vec := range vecs {
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			return true
+		}
+	}
+	return false
+}
+
+// getValue returns the value of the row of vec as the value of an outer
+// attribute of plan.Apply.
+func getValue(vec *vector.Vector, row int64) interface{} {
+	switch col := vec.Col.(type) {
+	case []int8:
+		return int64(col[row])
+	case []int16:
+		return int64(col[row])
+	case []int32:
+		return int64(col[row])
+	case []int64:
+		return col[row]
+	case []uint8:
+		return uint64(col[row])
+	case []uint16:
+		return uint64(col[row])
+	case []uint32:
+		return uint64(col[row])
+	case []uint64:
+		return col[row]
+	case []float32:
+		return float64(col[row])
+	case []float64:
+		return col[row]
+	case []types.Date:
+		return col[row].String()
+	case []types.Datetime:
+		return col[row].String()
+	case *types.Bytes:
+		return string(col.Get(row))
+	}
+	return nil
+}
@@ -18,8 +18,11 @@ import (
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/binary" // default import
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/multi"  // default import
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/unary"  // default import
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -33,7 +36,7 @@ func InitAddress(addr string) {
 func New(db string, sql string, uid string,
 	e engine.Engine, proc *process.Process) *compile {
 	return &compile{
-		e:    e,
+		e:    infoschema.New(e, Address, nil),
 		db:   db,
 		uid:  uid,
 		sql:  sql,
@@ -56,3 +59,23 @@ func (c *compile) Build() ([]*Exec, error) {
 	}
 	return es, nil
 }
+
+// BuildStatement generates the query execution of a statement which has been parsed.
+func (c *compile) BuildStatement(stmt tree.Statement) *Exec {
+	return &Exec{
+		c:    c,
+		stmt: stmt,
+	}
+}
+
+// BuildPlan generates the query execution of a statement whose plan has been built,
+// the plan is compiled without being built again. Only the plans which are reusable
+// (see plan.Reusable) can be executed more than once.
+func (c *compile) BuildPlan(stmt tree.Statement, pn plan.Plan) *Exec {
+	e := &Exec{
+		c:    c,
+		stmt: stmt,
+	}
+	e.setPlan(pn)
+	return e
+}
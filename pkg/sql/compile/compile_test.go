@@ -17,14 +17,31 @@ package compile
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
+	tpeEngine "github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"log"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 var querys = []string{
@@ -64,6 +81,20 @@ var querys = []string{
 	"select userID,MAX(score) from t1 where userID not between 2 and 3 group by userID order by userID desc;",
 	"select sum(score) as sum from t1 where spID=6 group by score order by sum desc;",
 	"select userID,MAX(score) max_score from t1 where userID <2 || userID > 3 group by userID order by max_score;",
+	"CREATE TABLE articles(id int, title varchar(100), body varchar(1000), FULLTEXT ft(title, body));",
+	"CREATE FULLTEXT INDEX ft_body on articles(body);",
+	"INSERT INTO articles values(1, 'MySQL Tutorial', 'DBMS stands for DataBase'), (2, 'Optimizing MySQL', 'In this tutorial we show'), (3, 'MySQL vs. YourSQL', 'In the following database comparison');",
+	"SELECT id FROM articles WHERE MATCH(title, body) AGAINST('database');",
+	"SELECT id, MATCH(title, body) AGAINST('database tutorial') as score FROM articles;",
+	"SELECT id FROM articles WHERE MATCH(title, body) AGAINST('+MySQL -YourSQL' IN BOOLEAN MODE) and id > 1;",
+	"SELECT id FROM articles ORDER BY MATCH(title, body) AGAINST('tutorial' WITH QUERY EXPANSION) desc;",
+	"CREATE TABLE docs(id int, doc json);",
+	"INSERT INTO docs values(1, '{\"name\": \"a\", \"tags\": [1, 2]}'), (2, '[1, {\"name\": \"b\"}]'), (3, null);",
+	"SELECT id, doc->'$.name', doc->>'$.name', json_extract(doc, '$.tags[0]', '$[1].name') FROM docs;",
+	"SELECT id FROM docs WHERE json_contains(doc, '2', '$.tags');",
+	"SELECT json_array(id, doc), json_object('id', id), json_unquote(json_extract(doc, '$.name')) FROM docs;",
+	"SELECT cast(doc as char), cast(cast(doc as char) as json) FROM docs;",
+	"SELECT * FROM json_table('[{\"a\": 1, \"b\": \"x\"}, {\"a\": 2}]', '$[*]' COLUMNS(id FOR ORDINALITY, a int PATH '$.a', b varchar(10) PATH '$.b' DEFAULT 'y' ON EMPTY)) as jt WHERE a > 0;",
 }
 
 func TestCompile(t *testing.T) {
@@ -77,6 +108,465 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+// outerJoinQuerys are the queries of TestOuterJoin and the rows of them
+var outerJoinQuerys = []struct {
+	sql  string
+	rows []string
+}{
+	{"select c_custkey, o_orderkey from customer left join orders on c_custkey = o_custkey", []string{"1 10", "1 11", "2 12", "3 null", "4 null"}},
+	{"select c_custkey, o_orderkey from customer left outer join orders on c_custkey = o_custkey and o_comment not like '%special%requests%'", []string{"1 10", "2 12", "3 null", "4 null"}},
+	{"select c_custkey, o_orderkey from customer left join orders on c_custkey = o_custkey where o_orderkey > 10", []string{"1 11", "2 12"}},
+	{"select c_custkey, o_orderkey from customer right join orders on c_custkey = o_custkey", []string{"1 10", "1 11", "2 12", "null 13"}},
+	{"select c_custkey, o_orderkey, o_custkey from customer full join orders on c_custkey = o_custkey", []string{"1 10 1", "1 11 1", "2 12 2", "3 null null", "4 null null", "null 13 5"}},
+	{"select c_custkey, n_nationkey from customer left join nation on c_custkey = n_nationkey", []string{"1 null", "2 null", "3 null", "4 null"}},
+	{"select c_custkey, n_nationkey from customer full join nation on c_custkey = n_nationkey", []string{"1 null", "2 null", "3 null", "4 null"}},
+	{"select c_custkey, count(o_orderkey) from customer left join orders on c_custkey = o_custkey group by c_custkey", []string{"1 2", "2 1", "3 0", "4 0"}},
+	// TPC-H Q13
+	{"select c_count, count(*) as custdist from (select c_custkey, count(o_orderkey) from customer left outer join orders on c_custkey = o_custkey and o_comment not like '%special%requests%' group by c_custkey) as c_orders (c_custkey, c_count) group by c_count order by custdist desc, c_count desc", []string{"1 2", "0 2"}},
+	// the attributes are qualified by the tables of the join
+	{"select r1.x, r2.z from r1 left join r2 on r1.x = r2.x", []string{"1 10", "2 20", "3 null"}},
+	{"select r1.x, r2.x, r2.z from r1 left join r2 on r1.x = r2.x", []string{"1 1 10", "2 2 20", "3 null null"}},
+	{"select r1.y, r2.x, r2.z from r1 right join r2 on r1.x = r2.x", []string{"1 1 10", "2 2 20", "null 4 40"}},
+	{"select r1.x, r2.x from r1 full join r2 on r1.x = r2.x", []string{"1 1", "2 2", "3 null", "null 4"}},
+	{"select a.y, b.z from r1 a left join r2 b on a.x = b.x and b.z > 10", []string{"1 null", "2 20", "3 null"}},
+	// the conditions checked by the join keep the rows padded with nulls
+	{"select r1.x, r2.z from r1 left join r2 on r1.x = r2.x and r1.y > 1", []string{"1 null", "2 20", "3 null"}},
+	{"select r1.x, r2.x from r1 left join r2 on r1.x < r2.x", []string{"1 2", "1 4", "2 4", "3 4"}},
+	{"select r1.x, r2.x from r1 right join r2 on r1.x = r2.x and r1.y > 1", []string{"null 1", "2 2", "null 4"}},
+	{"select r1.x, r2.x from r1 full join r2 on r1.x = r2.x and r2.z > 10", []string{"1 null", "2 2", "3 null", "null 1", "null 4"}},
+	{"select r1.x, r2.x from r1 full join r2 on r1.x > r2.x", []string{"1 null", "2 1", "3 1", "3 2", "null 4"}},
+	{"select c_custkey, o_orderkey from customer full join orders on c_custkey = o_custkey and o_orderkey > 10", []string{"1 11", "2 12", "3 null", "4 null", "null 10", "null 13"}},
+}
+
+func TestOuterJoin(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table customer (c_custkey int, c_name varchar(25))",
+		"create table orders (o_orderkey bigint, o_custkey int, o_comment varchar(79))",
+		"create table nation (n_nationkey int, n_name varchar(25))",
+		"create table r1 (x bigint, y bigint)",
+		"create table r2 (x bigint, z bigint)",
+		"insert into customer values (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd')",
+		"insert into orders values (10, 1, 'x'), (11, 1, 'special requests'), (12, 2, 'y'), (13, 5, 'z')",
+		"insert into r1 values (1, 1), (2, 2), (3, 3)",
+		"insert into r2 values (1, 10), (2, 20), (4, 40)",
+	} {
+		processQuery(query, e, proc)
+	}
+	for _, q := range outerJoinQuerys {
+		checkRows(t, e, proc, q.sql, q.rows)
+	}
+}
+
+// crossJoinQuerys are the queries of TestCrossJoin and the rows of them
+var crossJoinQuerys = []struct {
+	sql  string
+	rows []string
+}{
+	{"select * from r, s", []string{"1 1 1 1", "1 1 2 2", "1 1 3 4", "2 2 1 1", "2 2 2 2", "2 2 3 4", "3 3 1 1", "3 3 2 2", "3 3 3 4"}},
+	{"select * from r cross join s", []string{"1 1 1 1", "1 1 2 2", "1 1 3 4", "2 2 1 1", "2 2 2 2", "2 2 3 4", "3 3 1 1", "3 3 2 2", "3 3 3 4"}},
+	{"select a, c from r, s where a > 2", []string{"3 1", "3 2", "3 4"}},
+	{"select * from r, s, t where r.b = s.b", []string{"1 1 1 1 1 1", "1 1 1 1 2 2", "1 1 1 1 4 5", "2 2 2 2 1 1", "2 2 2 2 2 2", "2 2 2 2 4 5", "3 3 3 4 1 1", "3 3 3 4 2 2", "3 3 3 4 4 5"}},
+	{"select a, sum(c) from r, s group by a", []string{"1 7", "2 7", "3 7"}},
+	// cyclic join graph
+	{"select * from r, s, t where r.b = s.b and s.c = t.c and t.a = r.a", []string{"1 1 1 1 1 1", "2 2 2 2 2 2"}},
+	{"select * from r join s on r.b = s.b join t on s.c = t.c and t.a = r.a", []string{"1 1 1 1 1 1", "2 2 2 2 2 2"}},
+	// TPC-H Q5 like, the nation key of the customer closes a cycle
+	{"select n_name, count(*) from customer, orders, supplier, nation where c_custkey = o_custkey and o_suppkey = s_suppkey and c_nationkey = s_nationkey and s_nationkey = n_nationkey group by n_name", []string{"a 2", "b 1"}},
+}
+
+// crossJoinAggs are the aggregations of TestCrossJoin and the results of them
+var crossJoinAggs = []struct {
+	sql   string
+	value int64
+}{
+	{"select count(*) from r, s", 9},
+	{"select sum(c) from r, s", 21},
+	{"select count(*) from r, s where a = 1", 3},
+	{"select count(*) from r, s, t where r.b = s.b", 9},
+	{"select count(*) from r, s, t where r.b = s.b and s.c = t.c and t.a = r.a", 2},
+	{"select sum(s.c) from r, s, t where r.b = s.b and s.c = t.c and t.a = r.a", 3},
+	{"select count(*) from customer, orders, supplier where c_custkey = o_custkey and o_suppkey = s_suppkey and c_nationkey = s_nationkey", 3},
+	// the attributes of the aggregations are ambiguous in the cross product
+	{"select count(*) from r, t", 9},
+	{"select count(r.a) from r, s, t", 27},
+	{"select sum(t.a) from r, t where r.a > 1", 16},
+	// a table joined with itself
+	{"select count(*) from r a, r b", 9},
+	{"select count(*) from r a, r b where a.a = b.b", 3},
+	{"select sum(b.a) from r a, r b", 18},
+	{"select count(*) from r a, r b, s", 27},
+}
+
+func TestCrossJoin(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table r (a bigint, b bigint)",
+		"create table s (b bigint, c bigint)",
+		"create table t (c bigint, a bigint)",
+		"insert into r values (1, 1), (2, 2), (3, 3)",
+		"insert into s values (1, 1), (2, 2), (3, 4)",
+		"insert into t values (1, 1), (2, 2), (4, 5)",
+		"create table customer (c_custkey int, c_nationkey int)",
+		"create table orders (o_orderkey int, o_custkey int, o_suppkey int)",
+		"create table supplier (s_suppkey int, s_nationkey int)",
+		"create table nation (n_nationkey int, n_name varchar(25))",
+		"insert into customer values (1, 1), (2, 1), (3, 2)",
+		"insert into orders values (10, 1, 1), (11, 2, 1), (12, 2, 2), (13, 3, 2), (14, 3, 1)",
+		"insert into supplier values (1, 1), (2, 2)",
+		"insert into nation values (1, 'a'), (2, 'b')",
+	} {
+		processQuery(query, e, proc)
+	}
+	for _, q := range crossJoinQuerys {
+		checkRows(t, e, proc, q.sql, q.rows)
+	}
+	for _, q := range crossJoinAggs {
+		checkValue(t, e, proc, q.sql, q.value)
+	}
+}
+
+// subqueryQuerys are the queries of TestSubquery and the rows of them
+var subqueryQuerys = []struct {
+	sql  string
+	rows []string
+}{
+	{"select c_custkey from customer where c_custkey in (select o_custkey from orders)", []string{"1", "2", "3"}},
+	{"select c_custkey from customer where c_custkey not in (select o_custkey from orders)", []string{"4"}},
+	{"select c_custkey from customer where exists (select * from orders where o_custkey = c_custkey)", []string{"1", "2", "3"}},
+	{"select c_custkey from customer where not exists (select * from orders where o_custkey = c_custkey)", []string{"4"}},
+	{"select c_custkey from customer where exists (select o_orderkey from orders where o_custkey = c_custkey and o_totalprice > 60)", []string{"1", "3"}},
+	{"select c_custkey from customer where exists (select * from orders where o_totalprice > 80)", []string{"1", "2", "3", "4"}},
+	{"select c_custkey from customer where exists (select * from orders where o_totalprice > 100)", nil},
+	{"select c_custkey from customer where c_acctbal > (select avg(c_acctbal) from customer)", []string{"3", "4"}},
+	{"select c_custkey, (select max(o_totalprice) from orders) from customer", []string{"1 90", "2 90", "3 90", "4 90"}},
+	{"select c_custkey, (select sum(o_totalprice) from orders where o_custkey = c_custkey) from customer", []string{"1 120", "2 30", "3 90", "4 null"}},
+	{"select o_orderkey from orders where o_totalprice >= (select max(o_totalprice) from orders o2 where o2.o_custkey = orders.o_custkey)", []string{"11", "12", "13"}},
+	{"select c_custkey, o_orderkey from customer, orders where c_custkey = o_custkey and o_orderkey in (select l_orderkey from lineitem where l_quantity > 8)", []string{"2 12", "3 13"}},
+	// the correlated condition which is not an equality is applied to each candidate
+	{"select o_orderkey from orders where exists (select * from lineitem where l_orderkey = o_orderkey and l_quantity > o_totalprice / 10)", []string{"10", "12", "13"}},
+	// TPC-H Q18 like
+	{"select o_orderkey from orders where o_orderkey in (select l_orderkey from lineitem group by l_orderkey having sum(l_quantity) > 12)", []string{"12"}},
+	// NOT IN is null if either side of it is null and the subquery is not empty
+	{"select a from na where a not in (select c from nc)", []string{"2"}},
+	{"select a from na where a not in (select b from nb)", nil},
+	{"select a from na where a not in (select c from nc where c > 5)", []string{"1", "2", "null"}},
+	{"select a from na where a not in (select b from nb where b = a)", []string{"2", "null"}},
+	{"select a from na where a + 1 not in (select c + 1 from nc)", []string{"2"}},
+	// the correlated scalar subqueries which are not aggregations are evaluated for each value
+	{"select x from r1 where y = (select z from r2 where r2.x = r1.x)", []string{"1", "4"}},
+	{"select a.x, (select z from r2 b where b.x = a.x) from r1 a", []string{"1 10", "2 20", "3 null", "4 40", "null null"}},
+	{"select x, (select max(z) from r2 where r2.x < r1.x) from r1", []string{"1 null", "2 10", "3 20", "4 20", "null null"}},
+	{"select x, (select z from r2 where r2.x = r1.x group by z) from r1", []string{"1 10", "2 20", "3 null", "4 40", "null null"}},
+	{"select c_custkey from customer where c_custkey = (select o_custkey from orders where o_custkey = c_custkey and o_totalprice > 60)", []string{"1", "3"}},
+	// the uncorrelated scalar subquery is null if it is empty
+	{"select x, (select z from r2 where x = 1) from r1", []string{"1 10", "2 10", "3 10", "4 10", "null 10"}},
+	{"select x, (select z from r2 where x = 3) from r1", []string{"1 null", "2 null", "3 null", "4 null", "null null"}},
+	{"select x from r1 where y = (select z from r2 where x = 4)", []string{"4"}},
+	{"select x from r1 where y = (select z from r2 where x = 3)", nil},
+}
+
+// subqueryAggs are the aggregations of TestSubquery and the results of them
+var subqueryAggs = []struct {
+	sql   string
+	value int64
+}{
+	{"select count(*) from orders where o_totalprice > (select avg(o_totalprice) from orders)", 2},
+	{"select sum(o_totalprice) from orders where o_custkey in (select c_custkey from customer where c_acctbal < 250)", 150},
+}
+
+func TestSubquery(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table customer (c_custkey int, c_name varchar(25), c_acctbal bigint)",
+		"create table orders (o_orderkey int, o_custkey int, o_totalprice bigint)",
+		"create table lineitem (l_orderkey int, l_suppkey int, l_quantity bigint, l_commitdate int, l_receiptdate int)",
+		"insert into customer values (1, 'a', 100), (2, 'b', 200), (3, 'c', 300), (4, 'd', 400)",
+		"insert into orders values (10, 1, 50), (11, 1, 70), (12, 2, 30), (13, 3, 90)",
+		"insert into lineitem values (10, 1, 5, 1, 2), (10, 2, 6, 2, 1), (11, 1, 7, 1, 1), (12, 3, 8, 1, 2), (12, 3, 9, 2, 3), (13, 2, 10, 3, 1)",
+		"create table na (a int)",
+		"create table nb (b int)",
+		"create table nc (c int)",
+		"insert into na values (1), (2), (null)",
+		"insert into nb values (1), (null)",
+		"insert into nc values (1)",
+		"create table r1 (x int, y int)",
+		"create table r2 (x int, z int)",
+		"insert into r1 values (1, 10), (2, 30), (3, 30), (4, 40), (null, 50)",
+		"insert into r2 values (1, 10), (2, 20), (4, 40), (5, null)",
+	} {
+		processQuery(query, e, proc)
+	}
+	for _, q := range subqueryQuerys {
+		checkRows(t, e, proc, q.sql, q.rows)
+	}
+	for _, q := range subqueryAggs {
+		checkValue(t, e, proc, q.sql, q.value)
+	}
+	// a scalar subquery returns at most one row for each value
+	for _, sql := range []string{
+		"select x, (select z from r2 where r2.x > r1.x) from r1",
+		"select x, (select z from r2) from r1",
+		"select x from r1 where y = (select z from r2)",
+	} {
+		c := New("test", sql, "", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err, sql)
+		for _, e := range es {
+			require.NoError(t, e.Compile(nil, func(_ interface{}, _ *batch.Batch) error { return nil }), sql)
+			require.EqualError(t, e.Run(0), errors.New(errno.CardinalityViolation, "Subquery returns more than 1 row").Error(), sql)
+		}
+	}
+}
+
+// tpchQuerys are the queries of pkg/sql/plan2/tpch checked by TestTPCH and
+// the rows of them on its data
+var tpchQuerys = []struct {
+	query int
+	rows  []string
+}{
+	{11, []string{"2 200", "1 10", "3 0.5"}},
+	{13, []string{"1 3", "0 2", "2 1"}},
+	{21, []string{"Supplier#1 2", "Supplier#2 1"}},
+	{22, []string{"10 1 400"}},
+}
+
+// TestTPCH runs the TPC-H queries of pkg/sql/plan2/tpch on the tables of
+// its ddl with a few rows.
+func TestTPCH(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	ddl, err := os.ReadFile("../plan2/tpch/ddl.sql")
+	require.NoError(t, err)
+	// the names of the ddl are in upper case and those of the queries are not
+	for _, query := range strings.Split(strings.ToLower(string(ddl)), ";") {
+		if strings.TrimSpace(query) != "" {
+			processQuery(query, e, proc)
+		}
+	}
+	for _, query := range []string{
+		"insert into nation values (1, 'BRAZIL', 0, ''), (2, 'JAPAN', 0, '')",
+		"insert into supplier values (1, 'Supplier#1', '', 1, '', 0, ''), (2, 'Supplier#2', '', 1, '', 0, ''), (3, 'Supplier#3', '', 2, '', 0, '')",
+		"insert into partsupp values (1, 3, 10, 1, ''), (2, 3, 100, 2, ''), (3, 3, 1, 0.5, ''), (4, 3, 0, 3, ''), (1, 1, 1000, 1, '')",
+		"insert into customer values (1, 'a', '', 1, '13-1', 100, '', ''), (2, 'b', '', 1, '10-2', 200, '', ''), (3, 'c', '', 1, '11-3', 300, '', ''), " +
+			"(4, 'd', '', 1, '10-4', 400, '', ''), (5, 'e', '', 1, '99-5', 500, '', ''), (6, 'f', '', 1, '26-6', -10, '', '')",
+		"insert into orders values (10, 1, 'F', 0, '1995-01-01', '', '', 0, ''), (11, 1, 'F', 0, '1995-01-01', '', '', 0, 'special pending accounts'), " +
+			"(12, 2, 'O', 0, '1995-01-01', '', '', 0, ''), (13, 3, 'F', 0, '1995-01-01', '', '', 0, ''), (14, 3, 'F', 0, '1995-01-01', '', '', 0, ''), " +
+			"(15, 5, 'F', 0, '1995-01-01', '', '', 0, '')",
+		"insert into lineitem values " +
+			"(10, 1, 1, 1, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-20', '', '', ''), " +
+			"(10, 1, 2, 2, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-05', '', '', ''), " +
+			"(11, 1, 2, 1, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-20', '', '', ''), " +
+			"(11, 1, 1, 2, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-05', '', '', ''), " +
+			"(12, 1, 2, 1, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-20', '', '', ''), " +
+			"(12, 1, 1, 2, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-05', '', '', ''), " +
+			"(13, 1, 1, 1, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-20', '', '', ''), " +
+			"(13, 1, 2, 2, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-20', '', '', ''), " +
+			"(14, 1, 2, 1, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-20', '', '', ''), " +
+			"(15, 1, 1, 1, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-20', '', '', ''), " +
+			"(15, 1, 3, 2, 1, 0, 0, 0, '', '', '1995-01-01', '1995-01-10', '1995-01-05', '', '', '')",
+	} {
+		processQuery(query, e, proc)
+	}
+	for _, q := range tpchQuerys {
+		sql, err := os.ReadFile(fmt.Sprintf("../plan2/tpch/q%d.sql", q.query))
+		require.NoError(t, err)
+		require.Equal(t, q.rows, runQuery(t, e, proc, string(sql)), "q%d", q.query)
+	}
+}
+
+func TestMatchAgainst(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	articles := [][2]string{
+		{"MySQL Tutorial", "DBMS stands for DataBase"},
+		{"Optimizing MySQL", "In this tutorial we show"},
+		{"MySQL vs. YourSQL", "In the following database comparison"},
+		{"Oracle", "A database system"},
+	}
+	processQuery("create table articles (id int, title varchar(100), body varchar(1000), FULLTEXT ft(title, body))", e, proc)
+	idx := fulltext.NewIndex()
+	for i, a := range articles {
+		// each insert is read in a batch of its own
+		processQuery(fmt.Sprintf("insert into articles values (%d, '%s', '%s')", i+1, a[0], a[1]), e, proc)
+		idx.Add([]byte(a[0]), []byte(a[1]))
+	}
+	// the rows are scored by the statistics of the whole table
+	score := func(s string, mode fulltext.Mode, id int) string {
+		return fmt.Sprint(fulltext.Corpus{idx}.Search(fulltext.ParseQuery(s, mode)).Score(idx)[id-1])
+	}
+	checkRows(t, e, proc, "select id, match(title, body) against('database') from articles where id < 3", []string{
+		"1 " + score("database", fulltext.NaturalLanguage, 1),
+		"2 0",
+	})
+	checkRows(t, e, proc, "select id from articles where match(title, body) against('+MySQL -YourSQL' in boolean mode)", []string{"1", "2"})
+	checkRows(t, e, proc, "select a.id, match(a.title, a.body) against('tutorial' with query expansion) from articles a where a.id = 3", []string{
+		"3 " + score("tutorial", fulltext.QueryExpansion, 3),
+	})
+	// the columns are not of the same table
+	es, err := New("test", "select a.id from articles a, articles b where match(a.title, b.body) against('database')", "", e, proc).Build()
+	require.NoError(t, err)
+	require.Error(t, es[0].Compile(nil, func(_ interface{}, _ *batch.Batch) error { return nil }))
+}
+
+func TestExplainAnalyze(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table orders (o_orderkey int, o_custkey int, o_totalprice bigint)",
+		"create table customer (c_custkey int, c_name varchar(25))",
+		"insert into orders values (10, 1, 50), (11, 1, 70), (12, 2, 30), (13, 3, 90)",
+		"insert into customer values (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd')",
+	} {
+		processQuery(query, e, proc)
+	}
+	for sql, rows := range map[string]string{
+		"explain analyze select o_orderkey from orders where o_totalprice > 40":                           "rows in=3 out=0,",
+		"explain analyze select o_custkey, sum(o_totalprice) from orders group by o_custkey":              "rows in=3 out=0,",
+		"explain analyze select o_orderkey, c_name from orders join customer on o_custkey = c_custkey":    "rows in=4 out=0,",
+		"explain analyze select o_orderkey from orders order by o_totalprice desc limit 2":                "rows in=2 out=0,",
+		"explain analyze select count(*) from orders where o_custkey in (select c_custkey from customer)": "rows in=1 out=0,",
+	} {
+		var lines []string
+
+		c := New("test", sql, "", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err, sql)
+		for _, e := range es {
+			err := e.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+				vs := bat.Vecs[0].Col.(*types.Bytes)
+				for i := range vs.Offsets {
+					lines = append(lines, string(vs.Get(int64(i))))
+				}
+				return nil
+			})
+			require.NoError(t, err, sql)
+			require.Equal(t, "QUERY PLAN", e.Columns()[0].Name, sql)
+			require.NoError(t, e.Run(0), sql)
+		}
+		require.True(t, len(lines) > 2, sql)
+		require.Equal(t, "sql output", lines[0], sql)
+		// the output instruction receives the result of the query
+		require.Contains(t, lines[1], rows, sql)
+	}
+	c := New("test", "explain analyze insert into customer values (5, 'e')", "", e, proc)
+	es, err := c.Build()
+	require.NoError(t, err)
+	require.Error(t, es[0].Compile(nil, sqlOutput))
+}
+
+func TestBuildPlan(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	processQuery("create table r (a int, b int)", e, proc)
+	processQuery("insert into r values (1, 10), (2, 20)", e, proc)
+
+	sql := "select a, b from r where b > 5"
+	stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+	require.NoError(t, err)
+	pe := New("test", sql, "", e, proc).BuildStatement(stmt)
+	require.NoError(t, pe.BuildPlan())
+	require.True(t, plan.Reusable(pe.Plan()))
+	require.Equal(t, []string{"a", "b"}, []string{pe.Columns()[0].Name, pe.Columns()[1].Name})
+
+	// the plan is executed again with the rows inserted after it is built
+	for _, rows := range []int{2, 3} {
+		var n int
+
+		ex := New("test", sql, "", e, proc).BuildPlan(stmt, pe.Plan())
+		require.NoError(t, ex.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				n += len(bat.Zs)
+			}
+			return nil
+		}))
+		require.NoError(t, ex.Run(0))
+		require.Equal(t, rows, n)
+		processQuery("insert into r values (3, 30)", e, proc)
+	}
+
+	// the rows of a recursive cte are kept in its plan
+	sql = "with recursive c (n) as (select a from r union all select n + 1 from c where n < 3) select n from c"
+	stmt, err = parsers.ParseOne(dialect.MYSQL, sql)
+	require.NoError(t, err)
+	pe = New("test", sql, "", e, proc).BuildStatement(stmt)
+	require.NoError(t, pe.BuildPlan())
+	require.False(t, plan.Reusable(pe.Plan()))
+}
+
+// modifyQuerys are the statements of TestModify, the affected rows of them
+// and the sum of a * 100 + b for t1 and a * 100 + c for t2 after the statement.
+var modifyQuerys = []struct {
+	sql          string
+	affectedRows uint64
+	sum1, sum2   int64
+}{
+	{"update t1 set b = b + 10 where a between 2 and 3", 2, 1030, 805},
+	{"update t1 set b = 0 where a > 3 or b < 2", 2, 1025, 805},
+	{"update t1, t2 set t1.b = t1.b + 1 where t1.a < t2.a", 4, 1029, 805},
+	{"update t1 join t2 on t1.a = t2.a set t1.b = t2.c where t2.c > 0", 1, 1021, 805},
+	{"update t1 as x, t2 set x.b = 7, t2.c = x.a where x.a = t2.a and x.a = 2", 2, 1023, 802},
+	{"delete t1 from t1 join t2 on t1.a = t2.a", 2, 715, 802},
+	{"delete from t1 using t1, t2 where t1.a + 1 = t2.a", 1, 314, 802},
+	{"delete t1, t2 from t1, t2 where t2.a < 3", 3, 0, 500},
+}
+
+func TestModify(t *testing.T) {
+	InitAddress("localhost:20000")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e, err := tpeEngine.NewTpeEngine(&tpeEngine.TpeConfig{
+		KvType:                    tuplecodec.KV_MEMORY,
+		SerialType:                tuplecodec.ST_JSON,
+		ValueLayoutSerializerType: "default",
+		KVLimit:                   10000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, e.Create(0, "test", 0))
+	for _, query := range []string{
+		"create table t1 (a int primary key, b int)",
+		"create table t2 (a int primary key, c int)",
+		"insert into t1 values (1, 1), (2, 2), (3, 3), (4, 4)",
+		"insert into t2 values (1, 0), (2, 5), (5, 0)",
+	} {
+		processQuery(query, e, proc)
+	}
+	sum := func(sql string) int64 {
+		var v int64
+
+		c := New("test", sql, "", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err, sql)
+		require.NoError(t, es[0].Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if len(bat.Zs) > 0 && !nulls.Contains(bat.Vecs[0].Nsp, 0) {
+				v += bat.Vecs[0].Col.([]int64)[0]
+			}
+			return nil
+		}), sql)
+		require.NoError(t, es[0].Run(0), sql)
+		return v
+	}
+	for _, q := range modifyQuerys {
+		c := New("test", q.sql, "", e, proc)
+		es, err := c.Build()
+		require.NoError(t, err, q.sql)
+		require.NoError(t, es[0].Compile(nil, sqlOutput), q.sql)
+		require.NoError(t, es[0].Run(0), q.sql)
+		require.Equal(t, q.affectedRows, es[0].GetAffectedRows(), q.sql)
+		require.Equal(t, q.sum1, sum("select sum(a * 100 + b) from t1"), q.sql)
+		require.Equal(t, q.sum2, sum("select sum(a * 100 + c) from t2"), q.sql)
+	}
+}
+
 func sqlOutput(_ interface{}, bat *batch.Batch) error {
 	fmt.Printf("%v\n", bat.Zs)
 	fmt.Printf("%v\n", bat)
@@ -104,3 +594,197 @@ func processQuery(query string, e engine.Engine, proc *process.Process) {
 		}
 	}
 }
+
+// TestJoinWithNullKeys checks that the rows whose join keys are null are
+// skipped without dropping the other rows of the same unit.
+func TestJoinWithNullKeys(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table r (ra int, rb int)",
+		"create table s (sa int, sc int)",
+		"insert into r values (1, 1), (null, 2), (2, 3), (null, 4)",
+		"insert into s values (1, 10), (null, 20), (2, 30)",
+	} {
+		processQuery(query, e, proc)
+	}
+	var rows int64
+
+	sql := "select rb, sc from r join s on ra = sa"
+	c := New("test", sql, "", e, proc)
+	es, err := c.Build()
+	require.NoError(t, err)
+	for _, e := range es {
+		err := e.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			for _, z := range bat.Zs {
+				rows += z
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, e.Run(0))
+	}
+	require.Equal(t, int64(2), rows)
+}
+
+// TestJoinAggregationMultiplicity checks that the aggregations of the views
+// count every row of the other tables joined with them.
+func TestJoinAggregationMultiplicity(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table r (ra int, rb int)",
+		"create table s (sa int, sc int)",
+		"create table u (ua int, ud int)",
+		"insert into r values (1, 1), (1, 2), (2, 3), (1, 4)",
+		"insert into s values (1, 10), (1, 20), (2, 30)",
+		"insert into u values (1, 100), (2, 200), (2, 300)",
+	} {
+		processQuery(query, e, proc)
+	}
+	var sums []int64
+
+	sql := "select sum(sc), sum(ud) from r join s on ra = sa join u on ra = ua"
+	c := New("test", sql, "", e, proc)
+	es, err := c.Build()
+	require.NoError(t, err)
+	for _, e := range es {
+		err := e.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			for _, vec := range bat.Vecs {
+				sums = append(sums, vec.Col.([]int64)...)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, e.Run(0))
+	}
+	require.Equal(t, []int64{150, 1100}, sums)
+}
+
+// runQuery runs the query and returns the rows of its result, the values of a
+// row are separated by spaces and a null is written as null.
+func runQuery(t *testing.T, e engine.Engine, proc *process.Process, sql string) []string {
+	var rows []string
+
+	c := New("test", sql, "", e, proc)
+	es, err := c.Build()
+	require.NoError(t, err, sql)
+	for _, e := range es {
+		err := e.Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat == nil {
+				return nil
+			}
+			for i, z := range bat.Zs {
+				row := int64(i)
+				if len(bat.Sels) > 0 {
+					row = bat.Sels[i]
+				}
+				vals := make([]string, len(bat.Vecs))
+				for j, vec := range bat.Vecs {
+					vals[j] = formatValue(vec, row)
+				}
+				for ; z > 0; z-- {
+					rows = append(rows, strings.Join(vals, " "))
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err, sql)
+		require.NoError(t, e.Run(0), sql)
+	}
+	return rows
+}
+
+func formatValue(vec *vector.Vector, row int64) string {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return "null"
+	}
+	if col, ok := vec.Col.(*types.Bytes); ok {
+		return string(col.Get(row))
+	}
+	return fmt.Sprint(reflect.ValueOf(vec.Col).Index(int(row)).Interface())
+}
+
+// checkRows checks the rows of the result of the query regardless of their order
+func checkRows(t *testing.T, e engine.Engine, proc *process.Process, sql string, rows []string) {
+	require.ElementsMatch(t, rows, runQuery(t, e, proc, sql), sql)
+}
+
+// checkValue checks the result of the query is the single value
+func checkValue(t *testing.T, e engine.Engine, proc *process.Process, sql string, value interface{}) {
+	require.Equal(t, []string{fmt.Sprint(value)}, runQuery(t, e, proc, sql), sql)
+}
+
+// TestLateralJSONTable checks the json_table whose document is a column of
+// a table before it in the FROM list is expanded row by row.
+func TestLateralJSONTable(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table events (id int, payload varchar(100))",
+		"create table docs (id int, doc json)",
+		"create table users (uid int, name varchar(10))",
+		`insert into events values (1, '[{"a": 1}, {"a": 2}]'), (2, '[{"a": 3}]'), (3, '[]'), (4, null)`,
+		`insert into docs values (1, '{"id": 10, "tags": ["x", "y"]}'), (2, '{"id": 20, "tags": []}')`,
+		"insert into users values (1, 'a'), (2, 'b')",
+	} {
+		processQuery(query, e, proc)
+	}
+	for _, q := range []struct {
+		sql  string
+		rows []string
+	}{
+		{`select id, jt.n, jt.a from events, json_table(events.payload, '$[*]' columns(n for ordinality, a int path '$.a')) as jt`, []string{"1 1 1", "1 2 2", "2 1 3"}},
+		{`select id, a from events, json_table(payload, '$[*]' columns(a int path '$.a')) as jt where a > 1`, []string{"1 2", "2 3"}},
+		{`select id, count(*) from events, json_table(payload, '$[*]' columns(a int path '$.a')) as jt group by id`, []string{"1 2", "2 1"}},
+		{`select docs.id, jt.id from docs, json_table(doc, '$.tags[*]' columns(id varchar(10) path '$')) as jt`, []string{"1 x", "1 y"}},
+		{`select name, a from users, events, json_table(events.payload, '$[*]' columns(a int path '$.a')) as jt where uid = id`, []string{"a 1", "a 2", "b 3"}},
+	} {
+		checkRows(t, e, proc, q.sql, q.rows)
+	}
+}
+
+// TestWindow checks the window functions of the select expressions, the
+// nulls of the order attribute are peers before the values in ascending
+// order and after them in descending order.
+func TestWindow(t *testing.T) {
+	InitAddress("127.0.0.1")
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	for _, query := range []string{
+		"create table w (g int, o int, v int)",
+		"insert into w values (1, 1, 10), (1, 2, 20), (1, 2, 30), (1, null, 40), (2, 1, 5), (2, 3, 15), (2, null, 25), (2, null, 35)",
+	} {
+		processQuery(query, e, proc)
+	}
+	for _, q := range []struct {
+		sql  string
+		rows []string
+	}{
+		{"select g, v, row_number() over (partition by g order by v) as rn from w", []string{"1 10 1", "1 20 2", "1 30 3", "1 40 4", "2 5 1", "2 15 2", "2 25 3", "2 35 4"}},
+		{"select g, o, rank() over (partition by g order by o), dense_rank() over (partition by g order by o) from w", []string{"1 null 1 1", "1 1 2 2", "1 2 3 3", "1 2 3 3", "2 null 1 1", "2 null 1 1", "2 1 3 2", "2 3 4 3"}},
+		{"select g, v, sum(v) over (partition by g order by o range between 1 preceding and current row) as s from w", []string{"1 40 40", "1 10 10", "1 20 60", "1 30 60", "2 25 60", "2 35 60", "2 5 5", "2 15 15"}},
+		{"select o, count(*) over (order by o desc) from w where g = 2", []string{"3 1", "1 2", "null 4", "null 4"}},
+		{"select v, lag(v, 1, 0) over (order by v), lead(v) over (order by v) from w where g = 2", []string{"5 0 15", "15 5 25", "25 15 35", "35 25 null"}},
+		{"select v, sum(v) over (order by v rows between 1 preceding and 1 following) from w where g = 1", []string{"10 30", "20 60", "30 90", "40 70"}},
+		{"select v, first_value(v) over (partition by g order by v desc), count(v) over (partition by g) from w where v > 10", []string{"20 40 3", "30 40 3", "40 40 3", "15 35 3", "25 35 3", "35 35 3"}},
+		{"select v, row_number() over (order by v desc) as rn from w order by rn limit 2", []string{"40 1", "35 2"}},
+		{"select g, sum(v), rank() over (order by sum(v) desc) as r from w group by g", []string{"1 100 1", "2 80 2"}},
+		{"select a.v, rank() over (order by b.o desc) from w a join w b on a.v = b.v where a.g = 1", []string{"20 1", "30 1", "10 3", "40 4"}},
+		{"select distinct g, count(*) over (partition by g) from w", []string{"1 4", "2 4"}},
+	} {
+		checkRows(t, e, proc, q.sql, q.rows)
+	}
+	for _, sql := range []string{
+		"select v from w where row_number() over () > 1",
+		"select row_number() over () + 1 from w",
+		"select sum(v) over (order by v rows between 1.5 preceding and current row) from w",
+	} {
+		es, err := New("test", sql, "", e, proc).Build()
+		require.NoError(t, err, sql)
+		require.Error(t, es[0].Compile(nil, sqlOutput), sql)
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+)
+
+// defaultMaxRecursionDepth is the max number of iterations of a recursive
+// cte if the limitation of the process is not set.
+const defaultMaxRecursionDepth = 1000
+
+// cteResult is the rows of a common table expression, which are computed
+// when the cte is read first and shared by all its references.
+type cteResult struct {
+	sync.Mutex
+	done bool
+	err  error
+	bat  *batch.Batch
+	// working is the rows produced by the last iteration of a recursive cte.
+	working *batch.Batch
+}
+
+// cteReader is the reader of a table of a common table expression, each
+// reader gets a copy of the rows because the operators free the vectors
+// they consume.
+type cteReader struct {
+	mp  *mheap.Mheap
+	get func() (*batch.Batch, error)
+}
+
+func (r *cteReader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if r.get == nil {
+		return nil, nil
+	}
+	src, err := r.get()
+	r.get = nil
+	if err != nil {
+		return nil, err
+	}
+	if src == nil || len(src.Zs) == 0 {
+		return nil, nil
+	}
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		vec, err := vector.Dup(batch.GetVector(src, attr), r.mp)
+		if err != nil {
+			batch.Clean(bat, r.mp)
+			return nil, err
+		}
+		vec.Nsp = new(nulls.Nulls)
+		nulls.Set(vec.Nsp, batch.GetVector(src, attr).Nsp)
+		vec.Ref = cs[i]
+		bat.Vecs[i] = vec
+	}
+	bat.Zs = append([]int64{}, src.Zs...)
+	return bat, nil
+}
+
+// newCTEReader returns the reader of the table of the cte read by op.
+func (e *Exec) newCTEReader(op *plan.CTEScan, mp *mheap.Mheap) *cteReader {
+	e.mu.Lock()
+	if e.ctes == nil {
+		e.ctes = make(map[*plan.CTE]*cteResult)
+	}
+	res, ok := e.ctes[op.CTE]
+	if !ok {
+		res = new(cteResult)
+		e.ctes[op.CTE] = res
+	}
+	e.mu.Unlock()
+	r := &cteReader{mp: mp}
+	switch op.Table {
+	case plan.CTEWorking:
+		r.get = func() (*batch.Batch, error) {
+			return res.working, nil
+		}
+	case plan.CTEIntermediate:
+		r.get = func() (*batch.Batch, error) {
+			return res.bat, nil
+		}
+	default:
+		r.get = func() (*batch.Batch, error) {
+			res.Lock()
+			defer res.Unlock()
+			if !res.done {
+				res.err = e.evalCTE(op.CTE, res)
+				res.done = true
+			}
+			return res.bat, res.err
+		}
+	}
+	return r
+}
+
+// evalCTE computes the rows of the cte, the recursive part of a recursive
+// cte is evaluated with the rows produced by the last iteration until no
+// rows are produced.
+func (e *Exec) evalCTE(cte *plan.CTE, res *cteResult) error {
+	mp := mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu))
+	bat, err := e.runPlanScope(cte.Scope, mp)
+	if err != nil {
+		return err
+	}
+	if cte.Recursive == nil {
+		res.bat = bat
+		return nil
+	}
+	res.bat = newBatch(cte.Scope)
+	if _, err = res.bat.Append(mp, bat); err != nil {
+		return err
+	}
+	depth := e.c.proc.Lim.MaxRecursionDepth
+	if depth <= 0 {
+		depth = defaultMaxRecursionDepth
+	}
+	ps := cte.Recursive
+	for i := int64(0); len(bat.Zs) > 0; i++ {
+		if i >= depth {
+			return errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("Recursive query aborted after %v iterations. Try increasing processLimitationMaxRecursionDepth to a larger value.", depth))
+		}
+		if i > 0 {
+			e.mu.Lock()
+			ps, err = cte.Rebuild()
+			e.mu.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+		res.working = bat
+		if bat, err = e.runPlanScope(ps, mp); err != nil {
+			return err
+		}
+		if _, err = res.bat.Append(mp, bat); err != nil {
+			return err
+		}
+		batch.Clean(res.working, mp)
+	}
+	res.working = nil
+	return nil
+}
+
+// runPlanScope runs the query of the plan scope and returns all its rows
+// in one batch allocated by mp.
+func (e *Exec) runPlanScope(ps *plan.Scope, mp *mheap.Mheap) (*batch.Batch, error) {
+	rbat := newBatch(ps)
+	s, err := e.compilePlanScope(ps)
+	if err != nil || s == nil {
+		return rbat, err
+	}
+	s.Instructions = append(s.Instructions, vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Attrs: ps.Result.Attrs,
+			Func: func(_ interface{}, bat *batch.Batch) error {
+				_, err := rbat.Append(mp, bat)
+				return err
+			},
+		},
+	})
+	switch s.Magic {
+	case Normal:
+		err = s.Run(e.c.e)
+	case Merge:
+		err = s.MergeRun(e.c.e)
+	case Remote:
+		err = s.RemoteRun(e.c.e)
+	case Parallel:
+		err = s.ParallelRun(e.c.e)
+	}
+	if err != nil {
+		batch.Clean(rbat, mp)
+		return nil, err
+	}
+	return rbat, nil
+}
+
+// newBatch returns an empty batch of the attributes of the plan scope.
+func newBatch(ps *plan.Scope) *batch.Batch {
+	bat := batch.New(true, ps.Result.Attrs)
+	for i, attr := range ps.Result.Attrs {
+		bat.Vecs[i] = vector.New(ps.Result.AttrsMap[attr].Type)
+	}
+	return bat
+}
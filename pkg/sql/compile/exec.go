@@ -38,6 +38,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+// SetPrivilegeChecker sets the checker of the privileges of the user who initiated the sql.
+func (e *Exec) SetPrivilegeChecker(pc plan.PrivilegeChecker) {
+	e.pc = pc
+}
+
 // Compile is the entrance of the compute-layer, it compiles AST tree to scope list.
 // A scope is an execution unit.
 func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (err error) {
@@ -47,31 +52,18 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 		}
 	}()
 
-	// do semantic analysis and build plan for sql
-	// do ast rewrite
-	e.stmt = rewrite.AstRewrite(e.stmt)
-
-	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).BuildStatement(e.stmt)
-	if err != nil {
-		return err
-	}
-	{
-		attrs := pn.ResultColumns()
-		cols := make([]*Col, len(attrs))
-		for i, attr := range attrs {
-			cols[i] = &Col{
-				Name: attr.Name,
-				Typ:  attr.Type.Oid,
-			}
+	// the plan has been built if the exec is made from a cached plan
+	if e.pn == nil {
+		if err = e.buildPlan(); err != nil {
+			return err
 		}
-		e.resultCols = cols
 	}
 	e.u = u
 	e.e = e.c.e
 	e.fill = fill
 
 	// build scope for a single sql
-	s, err := e.compileScope(pn)
+	s, err := e.compileScope(e.pn)
 	if err != nil {
 		return err
 	}
@@ -79,6 +71,50 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 	return nil
 }
 
+// BuildPlan builds the plan of the statement and resolves its result columns
+// without compiling the plan to scopes.
+func (e *Exec) BuildPlan() (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = moerr.NewPanicError(e)
+		}
+	}()
+	return e.buildPlan()
+}
+
+func (e *Exec) buildPlan() error {
+	// do semantic analysis and build plan for sql
+	// do ast rewrite
+	e.stmt = rewrite.AstRewrite(e.stmt)
+
+	b := plan.New(e.c.db, e.c.sql, e.c.e)
+	b.SetPrivilegeChecker(e.pc)
+	pn, err := b.BuildStatement(e.stmt)
+	if err != nil {
+		return err
+	}
+	e.setPlan(pn)
+	return nil
+}
+
+func (e *Exec) setPlan(pn plan.Plan) {
+	attrs := pn.ResultColumns()
+	cols := make([]*Col, len(attrs))
+	for i, attr := range attrs {
+		cols[i] = &Col{
+			Name: attr.Name,
+			Typ:  attr.Type.Oid,
+		}
+	}
+	e.resultCols = cols
+	e.pn = pn
+}
+
+// Plan returns the plan built for the statement, it is nil before the plan is built.
+func (e *Exec) Plan() plan.Plan {
+	return e.pn
+}
+
 // Run is an important function of the compute-layer, it executes a single sql according to its scope
 func (e *Exec) Run(ts uint64) (err error) {
 	defer func() {
@@ -119,12 +155,6 @@ func (e *Exec) Run(ts uint64) (err error) {
 		return e.scope.DropTable(ts)
 	case DropIndex:
 		return e.scope.DropIndex(ts)
-	case ShowDatabases:
-		return e.scope.ShowDatabases(e.u, e.fill)
-	case ShowTables:
-		return e.scope.ShowTables(e.u, e.fill)
-	case ShowColumns:
-		return e.scope.ShowColumns(e.u, e.fill)
 	case ShowCreateTable:
 		return e.scope.ShowCreateTable(e.u, e.fill)
 	case ShowCreateDatabase:
@@ -143,6 +173,8 @@ func (e *Exec) Run(ts uint64) (err error) {
 		}
 		e.setAffectedRows(affectedRows)
 		return nil
+	case ExplainAnalyze:
+		return e.scope.ExplainAnalyze(e.c.e, e.u, e.fill)
 	}
 	return nil
 }
@@ -197,24 +229,6 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
-	case *plan.ShowDatabases:
-		return &Scope{
-			Magic: ShowDatabases,
-			Plan:  pn,
-			Proc:  e.c.proc,
-		}, nil
-	case *plan.ShowTables:
-		return &Scope{
-			Magic: ShowTables,
-			Plan:  pn,
-			Proc:  e.c.proc,
-		}, nil
-	case *plan.ShowColumns:
-		return &Scope{
-			Magic: ShowColumns,
-			Plan:  pn,
-			Proc:  e.c.proc,
-		}, nil
 	case *plan.ShowCreateTable:
 		return &Scope{
 			Magic: ShowCreateTable,
@@ -228,9 +242,11 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Proc:  e.c.proc,
 		}, nil
 	case *plan.Delete:
-		return e.compileDelete(qry.Qry)
+		return e.compileDelete(qry)
 	case *plan.Update:
 		return e.compileUpdate(qry)
+	case *plan.ExplainAnalyze:
+		return e.compileExplainAnalyze(qry)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", pn))
 }
@@ -244,6 +260,11 @@ func (e *Exec) SetSchema(db string) error {
 	return nil
 }
 
+// Database returns the name of the database in which the statement is executed.
+func (e *Exec) Database() string {
+	return e.c.db
+}
+
 func (e *Exec) Columns() []*Col {
 	return e.resultCols
 }
@@ -286,67 +307,120 @@ func (e *Exec) compileQuery(qry *plan.Query) (*Scope, error) {
 	return s, nil
 }
 
-func (e *Exec) compileDelete(qry *plan.Query) (*Scope, error) {
-	if e.checkPlanScope(qry.Scope) != BQ {
-		return nil, errors.New(errno.FeatureNotSupported, "Only single table delete is supported")
-	}
-	rel := e.getRelationFromPlanScope(qry.Scope)
-	if rel == nil {
-		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "cannot find table for delete")
+// compileExplainAnalyze compiles the query of EXPLAIN ANALYZE whose result is
+// discarded, all its instructions record the runtime statistics.
+func (e *Exec) compileExplainAnalyze(pn *plan.ExplainAnalyze) (*Scope, error) {
+	qry := pn.Plan.(*plan.Query)
+	rs := &Scope{
+		Magic: ExplainAnalyze,
+		Plan:  pn,
+		Proc:  e.c.proc,
 	}
 	s, err := e.compilePlanScope(qry.Scope)
 	if err != nil {
 		return nil, err
 	}
+	if s == nil {
+		return rs, nil
+	}
+	cols := qry.ResultColumns()
+	attrs := make([]string, len(cols))
+	for i, col := range cols {
+		attrs[i] = col.Name
+	}
+	s.Instructions = append(s.Instructions, vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Attrs: attrs,
+			Func: func(_ interface{}, _ *batch.Batch) error {
+				return nil
+			},
+		},
+	})
+	analyzeScope(s)
+	rs.PreScopes = []*Scope{s}
+	return rs, nil
+}
+
+func (e *Exec) compileDelete(pn *plan.Delete) (*Scope, error) {
+	s, err := e.compilePlanScope(pn.Qry.Scope)
+	if err != nil {
+		return nil, err
+	}
 	if s == nil {
 		return s, nil
 	}
+	arg := &deleteTag.Argument{}
+	for _, tbl := range pn.Tables {
+		rel, err := e.getModifiedRelation(tbl)
+		if err != nil {
+			for _, t := range arg.Tables {
+				t.Relation.Close()
+			}
+			return nil, err
+		}
+		arg.Tables = append(arg.Tables, &deleteTag.Table{
+			Relation: rel,
+			Attrs:    tbl.Attrs,
+			Cols:     tbl.Cols,
+			Dedup:    tbl.Dedup,
+		})
+	}
 	s.Magic = Delete
 	s.Instructions = append(s.Instructions, vm.Instruction{
-		Op: vm.DeleteTag,
-		Arg: &deleteTag.Argument{
-			Relation:     rel,
-			AffectedRows: 0,
-		},
+		Op:  vm.DeleteTag,
+		Arg: arg,
 	})
 	e.scope = s
 	return s, nil
 }
 
-func (e *Exec) compileUpdate(qry *plan.Update) (*Scope, error) {
-	if e.checkPlanScope(qry.Qry.Scope) != BQ {
-		return nil, errors.New(errno.FeatureNotSupported, "Only single table update is supported")
-	}
-	rel := e.getRelationFromPlanScope(qry.Qry.Scope)
-	if rel == nil {
-		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "cannot find table for update")
-	}
-	s, err := e.compilePlanScope(qry.Qry.Scope)
+func (e *Exec) compileUpdate(pn *plan.Update) (*Scope, error) {
+	s, err := e.compilePlanScope(pn.Qry.Scope)
 	if err != nil {
 		return nil, err
 	}
 	if s == nil {
 		return s, nil
 	}
-	attrs := make([]string, len(e.resultCols))
-	for i, col := range e.resultCols {
-		attrs[i] = col.Name
+	arg := &updateTag.Argument{}
+	for _, tbl := range pn.Tables {
+		rel, err := e.getModifiedRelation(tbl)
+		if err != nil {
+			for _, t := range arg.Tables {
+				t.Relation.Close()
+			}
+			return nil, err
+		}
+		arg.Tables = append(arg.Tables, &updateTag.Table{
+			Table: deleteTag.Table{
+				Relation: rel,
+				Attrs:    tbl.Attrs,
+				Cols:     tbl.Cols,
+				Dedup:    tbl.Dedup,
+			},
+			UpdateList:  tbl.UpdateList,
+			UpdateAttrs: tbl.UpdateAttrs,
+		})
 	}
 	s.Magic = Update
 	s.Instructions = append(s.Instructions, vm.Instruction{
-		Op: vm.UpdateTag,
-		Arg: &updateTag.Argument{
-			Relation:     rel,
-			AffectedRows: 0,
-			UpdateList:   qry.UpdateList,
-			UpdateAttrs:  qry.UpdateAttrs,
-			OtherAttrs:   qry.OtherAttrs,
-		},
+		Op:  vm.UpdateTag,
+		Arg: arg,
 	})
 	e.scope = s
 	return s, nil
 }
 
+// getModifiedRelation opens the relation of the table modified by update or delete
+func (e *Exec) getModifiedRelation(tbl *plan.ModifiedTable) (engine.Relation, error) {
+	db, err := e.e.Database(tbl.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return db.Relation(tbl.Name)
+}
+
 func (e *Exec) compilePlanScope(s *plan.Scope) (*Scope, error) {
 	switch e.checkPlanScope(s) {
 	case BQ:
@@ -421,6 +495,8 @@ func (e *Exec) checkPlanScope(s *plan.Scope) int {
 		return typ
 	case *plan.Order:
 		return e.checkPlanScope(s.Children[0])
+	case *plan.Window:
+		return e.checkPlanScope(s.Children[0])
 	case *plan.Dedup:
 		return e.checkPlanScope(s.Children[0])
 	case *plan.Limit:
@@ -460,44 +536,6 @@ func (e *Exec) checkPlanScope(s *plan.Scope) int {
 	return BQ
 }
 
-func (e *Exec) getRelationFromPlanScope(s *plan.Scope) engine.Relation {
-	switch op := s.Op.(type) {
-	case *plan.Join:
-		return nil
-	case *plan.Order:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.Dedup:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.Limit:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.Offset:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.Restrict:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.Projection:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.Relation:
-		db, err := e.e.Database(op.Schema)
-		if err != nil {
-			return nil
-		}
-		rel, err := db.Relation(op.Name)
-		if err != nil {
-			return nil
-		}
-		return rel
-	case *plan.DerivedRelation:
-		return nil
-	case *plan.Untransform:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.Rename:
-		return e.getRelationFromPlanScope(s.Children[0])
-	case *plan.ResultProjection:
-		return e.getRelationFromPlanScope(s.Children[0])
-	}
-	return nil
-}
-
 // compileQ builds the scope which sql is a query for single table and without any aggregate functions
 // In this function, we push down an operator like order, deduplicate, transform, limit or top.
 // And do merge work for them at the top scope
@@ -525,7 +563,9 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 		if len(ss) == 0 {
 			return nil, nil
 		}
-		if len(ss) == 1 && ss[0].Magic == Merge {
+		// the batches streamed by a set operation are sorted one by one
+		// and merged as those of the parallel scopes
+		if len(ss) == 1 && ss[0].Magic == Merge && !isSetOperation(ss[0]) {
 			ss[0].Instructions = append(ss[0].Instructions, vm.Instruction{
 				Op:  vm.Order,
 				Arg: constructOrder(op),
@@ -569,6 +609,15 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			})
 		}
 		return []*Scope{rs}, nil
+	case *plan.Window:
+		ss, err := e.compileQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(ss) == 0 {
+			return nil, nil
+		}
+		return []*Scope{e.compileWindow(op, ss)}, nil
 	case *plan.Dedup:
 		ss, err := e.compileQ(ps.Children[0])
 		if err != nil {
@@ -630,7 +679,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			return nil, nil
 		}
 		if len(ss) == 1 && ss[0].Magic == Merge {
-			if n := len(ss[0].Instructions); n >= 2 && ss[0].Instructions[n-2].Op == vm.MergeOrder {
+			// the rows skipped by an offset after the order are not in the top
+			if n := len(ss[0].Instructions); n >= 2 && ss[0].Instructions[n-2].Op == vm.MergeOrder && ss[0].Instructions[n-1].Op != vm.Offset {
 				pss := ss[0].PreScopes
 				for i := range pss {
 					m := len(pss[i].Instructions) - 2
@@ -797,6 +847,91 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			})
 		}
 		return ss, nil
+	case *plan.JSONTable:
+		if op.E != nil { // the rows of the json table over the rows of the child
+			child, err := e.compilePlanScope(ps.Children[0])
+			if err != nil {
+				return nil, err
+			}
+			rs := &Scope{Magic: Merge}
+			rs.PreScopes = []*Scope{child}
+			rs.Instructions = append(rs.Instructions, vm.Instruction{
+				Op:  vm.Merge,
+				Arg: &merge.Argument{},
+			})
+			rs.Instructions = append(rs.Instructions, vm.Instruction{
+				Op:  vm.JSONTable,
+				Arg: constructJSONTable(op, ps),
+			})
+			ctx, cancel := context.WithCancel(context.Background())
+			rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+			rs.Proc.Cancel = cancel
+			rs.Proc.Id = e.c.proc.Id
+			rs.Proc.Lim = e.c.proc.Lim
+			rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
+			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+				Ctx: ctx,
+				Ch:  make(chan *batch.Batch, 1),
+			}
+			child.Instructions = append(child.Instructions, vm.Instruction{
+				Op: vm.Connector,
+				Arg: &connector.Argument{
+					Mmu: rs.Proc.Mp.Gm,
+					Reg: rs.Proc.Reg.MergeReceivers[0],
+				},
+			})
+			return []*Scope{rs}, nil
+		}
+		src := &Source{
+			RefCounts:  make([]uint64, len(ps.Result.Attrs)),
+			Attributes: ps.Result.Attrs,
+			R:          &jsonTableReader{bat: op.Bat},
+		}
+		for i, attr := range ps.Result.Attrs {
+			src.RefCounts[i] = uint64(ps.Result.AttrsMap[attr].Ref)
+		}
+		s := &Scope{
+			DataSource: src,
+			Magic:      Normal,
+		}
+		s.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		s.Proc.Id = e.c.proc.Id
+		s.Proc.Lim = e.c.proc.Lim
+		return []*Scope{s}, nil
+	case *plan.CTEScan:
+		src := &Source{
+			RefCounts:  make([]uint64, len(ps.Result.Attrs)),
+			Attributes: ps.Result.Attrs,
+		}
+		for i, attr := range ps.Result.Attrs {
+			src.RefCounts[i] = uint64(ps.Result.AttrsMap[attr].Ref)
+		}
+		s := &Scope{
+			DataSource: src,
+			Magic:      Normal,
+		}
+		s.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		s.Proc.Id = e.c.proc.Id
+		s.Proc.Lim = e.c.proc.Lim
+		src.R = e.newCTEReader(op, s.Proc.Mp)
+		return []*Scope{s}, nil
+	case *plan.Apply:
+		src := &Source{
+			RefCounts:  make([]uint64, len(ps.Result.Attrs)),
+			Attributes: ps.Result.Attrs,
+		}
+		for i, attr := range ps.Result.Attrs {
+			src.RefCounts[i] = uint64(ps.Result.AttrsMap[attr].Ref)
+		}
+		s := &Scope{
+			DataSource: src,
+			Magic:      Normal,
+		}
+		s.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		s.Proc.Id = e.c.proc.Id
+		s.Proc.Lim = e.c.proc.Lim
+		src.R = e.newApplyReader(op, ps, s.Proc.Mp)
+		return []*Scope{s}, nil
 	case *plan.DerivedRelation:
 		child, err := e.compilePlanScope(ps.Children[0])
 		if err != nil {
@@ -830,6 +965,41 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			},
 		})
 		return []*Scope{rs}, nil
+	case *plan.SetOperation:
+		rs := &Scope{Magic: Merge}
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.MergeSet,
+			Arg: constructMergeSet(op),
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc.Cancel = cancel
+		rs.Proc.Id = e.c.proc.Id
+		rs.Proc.Lim = e.c.proc.Lim
+		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ps.Children))
+		for i := range ps.Children {
+			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+				Ctx: ctx,
+				Ch:  make(chan *batch.Batch, 1),
+			}
+			child, err := e.compilePlanScope(ps.Children[i])
+			if err != nil {
+				return nil, err
+			}
+			if child == nil { // the input is empty
+				rs.Proc.Reg.MergeReceivers[i].Ch <- nil
+				continue
+			}
+			child.Instructions = append(child.Instructions, vm.Instruction{
+				Op: vm.Connector,
+				Arg: &connector.Argument{
+					Mmu: rs.Proc.Mp.Gm,
+					Reg: rs.Proc.Reg.MergeReceivers[i],
+				},
+			})
+			rs.PreScopes = append(rs.PreScopes, child)
+		}
+		return []*Scope{rs}, nil
 	case *plan.Rename:
 		ss, err := e.compileQ(ps.Children[0])
 		if err != nil {
@@ -895,6 +1065,44 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 	return nil, nil
 }
 
+// compileWindow builds the scope which evaluates the window functions over
+// all the rows of the scopes, the window operator merges their rows itself.
+// isSetOperation returns true if the scope s ends with a set operation,
+// which sends a batch for each batch of its inputs.
+func isSetOperation(s *Scope) bool {
+	n := len(s.Instructions)
+	return n > 0 && s.Instructions[n-1].Op == vm.MergeSet
+}
+
+func (e *Exec) compileWindow(op *plan.Window, ss []*Scope) *Scope {
+	rs := &Scope{Magic: Merge}
+	rs.PreScopes = ss
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Window,
+		Arg: constructWindow(op),
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = e.c.proc.Id
+	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	for i := range ss {
+		rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return rs
+}
+
 // compileAQ builds the scope for aggregation query
 func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 	switch op := ps.Op.(type) {
@@ -911,6 +1119,15 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructOrder(op),
 		})
 		return s, nil
+	case *plan.Window:
+		s, err := e.compileAQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, nil
+		}
+		return e.compileWindow(op, []*Scope{s}), nil
 	case *plan.Dedup:
 		s, err := e.compileAQ(ps.Children[0])
 		if err != nil {
@@ -1144,34 +1361,28 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 	switch op := ps.Op.(type) {
 	case *plan.Join:
-		var children []*Scope
+		var ss []*Scope
+		var err error
 
 		switch op.Type {
-		case plan.INNER:
-		case plan.NATURAL:
-		default:
-			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Unsupport join type '%v'", op.Type))
-		}
-		for i := 1; i < len(ps.Children); i++ {
-			s, err := e.compilePlanScope(ps.Children[i])
-			if err != nil {
+		case plan.INNER, plan.NATURAL, plan.CROSS, plan.LEFT, plan.SEMI, plan.ANTI:
+			if ss, err = e.compileCQJoin(op, ps.Children); err != nil {
 				return nil, err
 			}
-			if s == nil {
-				return nil, nil
+		case plan.FULL:
+			// the rows of the view without a match are collected by all
+			// scopes of the fact, which are one per node
+			if ss, err = e.compileCQJoin(op, ps.Children); err != nil {
+				return nil, err
 			}
-			children = append(children, s)
-		}
-		ss, err := e.compileFact(ps.Children[0])
-		if err != nil {
-			return nil, err
+			if len(ss) > 1 {
+				return nil, errors.New(errno.FeatureNotSupported, "full join on a distributed relation")
+			}
+		default:
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Unsupport join type '%v'", op.Type))
 		}
-		for i := range ss {
-			ss[i].PreScopes = append(ss[i].PreScopes, children...)
-			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-				Op:  vm.Join,
-				Arg: constructJoin(op),
-			})
+		if ss == nil {
+			return nil, nil
 		}
 		rs := &Scope{Magic: Merge}
 		rs.PreScopes = ss
@@ -1242,6 +1453,15 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			},
 		})
 		return rs, nil
+	case *plan.Window:
+		s, err := e.compileCQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, nil
+		}
+		return e.compileWindow(op, []*Scope{s}), nil
 	case *plan.Dedup:
 		s, err := e.compileCQ(ps.Children[0])
 		if err != nil {
@@ -1415,6 +1635,35 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 	return nil, nil
 }
 
+// compileCQJoin builds the scopes of the fact of a conjunctive query, which
+// probe the views built from the other children.
+func (e *Exec) compileCQJoin(op *plan.Join, children []*plan.Scope) ([]*Scope, error) {
+	var views []*Scope
+
+	for i := 1; i < len(children); i++ {
+		s, err := e.compilePlanScope(children[i])
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, nil
+		}
+		views = append(views, s)
+	}
+	ss, err := e.compileFact(children[0])
+	if err != nil {
+		return nil, err
+	}
+	for i := range ss {
+		ss[i].PreScopes = append(ss[i].PreScopes, views...)
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op:  vm.Join,
+			Arg: constructJoin(op, children),
+		})
+	}
+	return ss, nil
+}
+
 // compileCAQ builds the scope for conjunctive aggregation query.
 func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 	switch op := ps.Op.(type) {
@@ -1431,6 +1680,15 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructOrder(op),
 		})
 		return s, nil
+	case *plan.Window:
+		s, err := e.compileCAQ(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		if s == nil {
+			return nil, nil
+		}
+		return e.compileWindow(op, []*Scope{s}), nil
 	case *plan.Dedup:
 		s, err := e.compileCAQ(ps.Children[0])
 		if err != nil {
@@ -1565,6 +1823,19 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 func (e *Exec) compileJoin(ps *plan.Scope) ([]*Scope, error) {
 	var children []*Scope
 
+	if op, ok := ps.Op.(*plan.Restrict); ok { // the conditions out of the spanning tree of a cyclic join
+		ss, err := e.compileJoin(ps.Children[0])
+		if err != nil {
+			return nil, err
+		}
+		for i := range ss {
+			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+				Op:  vm.Restrict,
+				Arg: constructRestrict(op),
+			})
+		}
+		return ss, nil
+	}
 	op, ok := ps.Op.(*plan.Join)
 	if !ok {
 		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Unsupport join plan '%T'", ps))
@@ -1572,6 +1843,7 @@ func (e *Exec) compileJoin(ps *plan.Scope) ([]*Scope, error) {
 	switch op.Type {
 	case plan.INNER:
 	case plan.NATURAL:
+	case plan.CROSS:
 	default:
 		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Unsupport join type '%v'", op.Type))
 	}
@@ -17,13 +17,16 @@ package compile
 import (
 	"strconv"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dedup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/jsontable"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergededup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
@@ -31,6 +34,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
@@ -42,7 +46,8 @@ import (
 
 func dupInstruction(in vm.Instruction) vm.Instruction {
 	rin := vm.Instruction{
-		Op: in.Op,
+		Op:      in.Op,
+		Analyze: in.Analyze,
 	}
 	switch arg := in.Arg.(type) {
 	case *top.Argument:
@@ -77,9 +82,17 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 		}
 	case *join.Argument:
 		rin.Arg = &join.Argument{
-			Vars:   arg.Vars,
-			Bats:   arg.Bats,
-			Result: arg.Result,
+			Type:       arg.Type,
+			Vars:       arg.Vars,
+			Bats:       arg.Bats,
+			Result:     arg.Result,
+			Attrs:      arg.Attrs,
+			Types:      arg.Types,
+			Cond:       arg.Cond,
+			NotIn:      arg.NotIn,
+			ProbeAttrs: arg.ProbeAttrs,
+			ProbeTypes: arg.ProbeTypes,
+			Unmatched:  arg.Unmatched,
 		}
 	case *times.Argument:
 		rin.Arg = &times.Argument{
@@ -97,6 +110,13 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Data:  arg.Data,
 			Func:  arg.Func,
 		}
+	case *jsontable.Argument:
+		rin.Arg = &jsontable.Argument{
+			E:     arg.E,
+			Attrs: arg.Attrs,
+			Refs:  arg.Refs,
+			Rows:  arg.Rows,
+		}
 	}
 	return rin
 }
@@ -109,6 +129,19 @@ func constructMergeDedup() *mergededup.Argument {
 	return &mergededup.Argument{}
 }
 
+var setOperations = map[int]int{
+	plan.UNION:     mergeset.Union,
+	plan.INTERSECT: mergeset.Intersect,
+	plan.EXCEPT:    mergeset.Except,
+}
+
+func constructMergeSet(op *plan.SetOperation) *mergeset.Argument {
+	return &mergeset.Argument{
+		Type: setOperations[op.Type],
+		All:  op.All,
+	}
+}
+
 func constructLimit(op *plan.Limit) *limit.Argument {
 	return &limit.Argument{
 		Limit: uint64(op.Limit),
@@ -156,6 +189,31 @@ func constructMergeOrder(op *plan.Order) *mergeorder.Argument {
 
 }
 
+func constructWindow(op *plan.Window) *window.Argument {
+	arg := &window.Argument{
+		Partitions: op.Partitions,
+		Fields:     make([]order.Field, len(op.Fs)),
+		Functions:  make([]window.Function, len(op.Functions)),
+	}
+	for i, f := range op.Fs {
+		arg.Fields[i].Attr = f.Attr
+		arg.Fields[i].Type = order.Direction(f.Type)
+	}
+	for i, f := range op.Functions {
+		arg.Functions[i] = window.Function{
+			Op:      f.Op,
+			Agg:     f.Agg,
+			Attr:    f.Attr,
+			Default: f.Default,
+			Value:   f.Value,
+			N:       f.N,
+			Alias:   f.Alias,
+			Frame:   f.Frame,
+		}
+	}
+	return arg
+}
+
 func constructTop(op interface{}, limit int64) *top.Argument {
 	fs := op.(*order.Argument).Fs
 	arg := &top.Argument{
@@ -182,6 +240,22 @@ func constructMergeTop(op interface{}, limit int64) *mergetop.Argument {
 	return arg
 }
 
+// constructJSONTable returns the argument which expands the rows of the
+// child of the json table over a table, s is the scope of the json table.
+func constructJSONTable(op *plan.JSONTable, s *plan.Scope) *jsontable.Argument {
+	arg := &jsontable.Argument{
+		E:    op.E,
+		Rows: op.Rows,
+	}
+	for _, attr := range s.Result.Attrs {
+		if ref := s.Result.AttrsMap[attr].Ref; ref > 0 {
+			arg.Attrs = append(arg.Attrs, attr)
+			arg.Refs = append(arg.Refs, uint64(ref))
+		}
+	}
+	return arg
+}
+
 func constructRestrict(op *plan.Restrict) *restrict.Argument {
 	return &restrict.Argument{
 		E: op.E,
@@ -431,8 +505,20 @@ func constructCAQTransformFromDerived(op *plan.DerivedRelation) *transform.Argum
 	return arg
 }
 
-func constructJoin(op *plan.Join) *join.Argument {
+func constructJoin(op *plan.Join, ss []*plan.Scope) *join.Argument {
 	arg := new(join.Argument)
+	switch op.Type {
+	case plan.LEFT:
+		arg.Type = join.Left
+	case plan.SEMI:
+		arg.Type = join.Semi
+	case plan.ANTI:
+		arg.Type = join.Anti
+	case plan.FULL:
+		arg.Type = join.Full
+	default:
+		arg.Type = join.Inner
+	}
 	arg.Vars = make([][]string, len(op.Vars)-1)
 	for i := 1; i < len(op.Vars); i++ {
 		arg.Vars[i-1] = make([]string, len(op.Vars[i]))
@@ -440,6 +526,25 @@ func constructJoin(op *plan.Join) *join.Argument {
 			arg.Vars[i-1][j] = strconv.Itoa(op.Vars[i][j])
 		}
 	}
+	if arg.Type == join.Left || arg.Type == join.Anti || arg.Type == join.Full {
+		arg.Attrs = make([][]string, len(ss)-1)
+		arg.Types = make([][]types.Type, len(ss)-1)
+		for i := 1; i < len(ss); i++ {
+			for _, attr := range ss[i].Result.Attrs {
+				arg.Attrs[i-1] = append(arg.Attrs[i-1], attr)
+				arg.Types[i-1] = append(arg.Types[i-1], ss[i].Result.AttrsMap[attr].Type)
+			}
+		}
+	}
+	if arg.Type == join.Full {
+		for _, attr := range ss[0].Result.Attrs {
+			arg.ProbeAttrs = append(arg.ProbeAttrs, attr)
+			arg.ProbeTypes = append(arg.ProbeTypes, ss[0].Result.AttrsMap[attr].Type)
+		}
+		arg.Unmatched = new(join.Unmatched)
+	}
+	arg.Cond = op.Cond
+	arg.NotIn = op.NotIn
 	arg.Result = append(arg.Result, op.Result...)
 	return arg
 }
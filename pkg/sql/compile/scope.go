@@ -21,7 +21,6 @@ import (
 	"math"
 	"net"
 	"runtime"
-	"strings"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
@@ -42,7 +41,6 @@ import (
 
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
-	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
@@ -55,7 +53,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
-	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -64,10 +61,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
-const (
-	nullString = "NULL"
-)
-
 // CreateDatabase do create database work according to create database plan.
 func (s *Scope) CreateDatabase(ts uint64) error {
 	p, _ := s.Plan.(*plan.CreateDatabase)
@@ -159,84 +152,6 @@ func (s *Scope) DropIndex(ts uint64) error {
 	return nil
 }
 
-// ShowDatabases fill batch with all database names
-func (s *Scope) ShowDatabases(u interface{}, fill func(interface{}, *batch.Batch) error) error {
-	p, _ := s.Plan.(*plan.ShowDatabases)
-	attrs := p.ResultColumns()
-	bat := batch.New(true, []string{attrs[0].Name})
-	// Column 1
-	{
-		rs := p.E.Databases()
-		vs := make([][]byte, len(rs))
-
-		// like
-		count := 0
-		if p.Like == nil {
-			for _, r := range rs {
-				vs[count] = []byte(r)
-				count++
-			}
-		} else {
-			tempSlice := make([]int64, 1)
-			for _, r := range rs {
-				str := []byte(r)
-				if k, _ := like.BtConstAndConst(str, p.Like, tempSlice); k != nil {
-					vs[count] = str
-					count++
-				}
-			}
-		}
-		vs = vs[:count]
-
-		vec := vector.New(attrs[0].Type)
-		if err := vector.Append(vec, vs); err != nil {
-			return err
-		}
-		bat.Vecs[0] = vec
-		bat.InitZsOne(count)
-	}
-	return fill(u, bat)
-}
-
-// ShowTables fill batch with all table names in a database
-func (s *Scope) ShowTables(u interface{}, fill func(interface{}, *batch.Batch) error) error {
-	p, _ := s.Plan.(*plan.ShowTables)
-	attrs := p.ResultColumns()
-	bat := batch.New(true, []string{attrs[0].Name})
-	// Column 1
-	{
-		rs := p.Db.Relations()
-		vs := make([][]byte, len(rs))
-
-		// like
-		count := 0
-		if p.Like == nil {
-			for _, r := range rs {
-				vs[count] = []byte(r)
-				count++
-			}
-		} else {
-			tempSlice := make([]int64, 1)
-			for _, r := range rs {
-				str := []byte(r)
-				if k, _ := like.BtConstAndConst(str, p.Like, tempSlice); k != nil {
-					vs[count] = str
-					count++
-				}
-			}
-		}
-		vs = vs[:count]
-
-		vec := vector.New(attrs[0].Type)
-		if err := vector.Append(vec, vs); err != nil {
-			return err
-		}
-		bat.Vecs[0] = vec
-		bat.InitZsOne(count)
-	}
-	return fill(u, bat)
-}
-
 // ExplainQuery fill batch with all query plan tree
 func (s *Scope) ExplainQuery(u interface{}, fill func(interface{}, *batch.Batch) error) error {
 	p, _ := s.Plan.(*plan.ExplainQuery)
@@ -267,98 +182,41 @@ func (s *Scope) ExplainQuery(u interface{}, fill func(interface{}, *batch.Batch)
 	return fill(u, bat)
 }
 
-type columnInfo struct {
-	name string
-	typ  types.Type
-	dft  string // default value
-	pri  bool   // primary key
-}
-
-// ShowColumns fill batch with column information of a table
-func (s *Scope) ShowColumns(u interface{}, fill func(interface{}, *batch.Batch) error) error {
-	p, _ := s.Plan.(*plan.ShowColumns)
-	results := p.ResultColumns() // field, type, null, key, default, extra
-	defs := p.Relation.TableDefs()
-	attrs := make([]columnInfo, len(defs))
-
-	names := make([]string, 0)
-	for _, resultColumn := range results {
-		names = append(names, resultColumn.Name)
-	}
+// ExplainAnalyze runs the query of the scope and fills the batch with its
+// scopes annotated with the runtime statistics of each instruction.
+func (s *Scope) ExplainAnalyze(e engine.Engine, u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	attrs := s.Plan.ResultColumns()
+	buffer := explain.NewExplainDataBuffer()
+	if len(s.PreScopes) > 0 {
+		var err error
 
-	count := 0
-	tmpSlice := make([]int64, 1)
-	for _, def := range defs {
-		switch tableOption := def.(type) {
-		case *engine.AttributeDef:
-			if p.Like != nil { // deal with like rule
-				if k, _ := like.BtConstAndConst([]byte(tableOption.Attr.Name), p.Like, tmpSlice); k == nil {
-					continue
-				}
-			}
-			attrs[count] = columnInfo{
-				name: tableOption.Attr.Name,
-				typ:  tableOption.Attr.Type,
-				pri:  tableOption.Attr.Primary,
-			}
-			if tableOption.Attr.HasDefaultExpr() {
-				if tableOption.Attr.Default.IsNull {
-					attrs[count].dft = nullString
-				} else {
-					switch tableOption.Attr.Type.Oid {
-					case types.T_date, types.T_datetime:
-						attrs[count].dft = fmt.Sprintf("%s", tableOption.Attr.Default.Value)
-					default:
-						attrs[count].dft = fmt.Sprintf("%v", tableOption.Attr.Default.Value)
-					}
-				}
-			}
-			count++
+		rs := snapshotScope(s.PreScopes[0])
+		switch s.PreScopes[0].Magic {
+		case Normal:
+			err = s.PreScopes[0].Run(e)
+		case Merge:
+			err = s.PreScopes[0].MergeRun(e)
+		case Remote:
+			err = s.PreScopes[0].RemoteRun(e)
+		case Parallel:
+			err = s.PreScopes[0].ParallelRun(e)
+		}
+		if err != nil {
+			return err
 		}
+		explainScope(rs, buffer, 0)
 	}
-	attrs = attrs[:count]
-
-	bat := batch.New(true, names)
-	for i := range bat.Vecs {
-		bat.Vecs[i] = vector.New(results[i].Type)
+	bat := batch.New(true, []string{attrs[0].Name})
+	vs := make([][]byte, len(buffer.Lines))
+	for i, line := range buffer.Lines {
+		vs[i] = []byte(line)
 	}
-
-	nameVector := make([][]byte, len(attrs))
-	typeVector := make([][]byte, len(attrs))
-	defaultValueVector := make([][]byte, len(attrs))
-	keyVector := make([][]byte, len(attrs))
-	emptyVector := make([][]byte, len(attrs))
-
-	for i, attr := range attrs {
-		var typ, pri string
-
-		if attr.typ.Width > 0 {
-			typ = fmt.Sprintf("%s(%v)", strings.ToLower(attr.typ.String()), attr.typ.Width)
-		} else {
-			typ = strings.ToLower(attr.typ.String())
-		}
-
-		if attr.pri {
-			pri = "PRI"
-		} else {
-			pri = ""
-		}
-
-		nameVector[i] = []byte(attr.name)
-		typeVector[i] = []byte(typ)
-		defaultValueVector[i] = []byte(attr.dft)
-		keyVector[i] = []byte(pri)
-		emptyVector[i] = []byte("")
+	vec := vector.New(attrs[0].Type)
+	if err := vector.Append(vec, vs); err != nil {
+		return err
 	}
-
-	vector.Append(bat.Vecs[0], nameVector)         // field
-	vector.Append(bat.Vecs[1], typeVector)         // type
-	vector.Append(bat.Vecs[2], emptyVector)        // null todo: not implement
-	vector.Append(bat.Vecs[3], keyVector)          // key
-	vector.Append(bat.Vecs[4], defaultValueVector) // default
-	vector.Append(bat.Vecs[5], emptyVector)        // extra todo: not implement
-
-	bat.InitZsOne(count)
+	bat.Vecs[0] = vec
+	bat.InitZsOne(len(vs))
 	return fill(u, bat)
 }
 
@@ -472,24 +330,32 @@ func (s *Scope) Insert(ts uint64) (uint64, error) {
 	return uint64(vector.Length(p.Bat.Vecs[0])), p.Relation.Write(ts, p.Bat)
 }
 
-// Delete will delete rows from a single of table
+// Delete will delete rows from the tables
 func (s *Scope) Delete(ts uint64, e engine.Engine) (uint64, error) {
 	s.Magic = Merge
 	arg := s.Instructions[len(s.Instructions)-1].Arg.(*deleteTag.Argument)
 	arg.Ts = ts
-	defer arg.Relation.Close()
+	defer func() {
+		for _, tbl := range arg.Tables {
+			tbl.Relation.Close()
+		}
+	}()
 	if err := s.MergeRun(e); err != nil {
 		return 0, err
 	}
 	return arg.AffectedRows, nil
 }
 
-// Update will update rows from a single of table
+// Update will update rows of the tables
 func (s *Scope) Update(ts uint64, e engine.Engine) (uint64, error) {
 	s.Magic = Merge
 	arg := s.Instructions[len(s.Instructions)-1].Arg.(*updateTag.Argument)
 	arg.Ts = ts
-	defer arg.Relation.Close()
+	defer func() {
+		for _, tbl := range arg.Tables {
+			tbl.Relation.Close()
+		}
+	}()
 	if err := s.MergeRun(e); err != nil {
 		return 0, err
 	}
@@ -572,6 +438,7 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 	if Address == s.NodeInfo.Addr {
 		return s.ParallelRun(e)
 	}
+	as := s.Analyzes()
 	ps := Transfer(s)
 	err := protocol.EncodeScope(ps, &buf)
 	if err != nil {
@@ -618,6 +485,17 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 			case <-arg.Reg.Ctx.Done():
 			case arg.Reg.Ch <- nil:
 			}
+			if len(msg.Data) > 0 { // runtime statistics of the remote scope
+				rs, err := protocol.DecodeAnalyzes(msg.Data)
+				if err != nil {
+					return err
+				}
+				for i := range rs {
+					if i < len(as) && as[i] != nil {
+						as[i].Add(rs[i])
+					}
+				}
+			}
 			break
 		}
 		bat, _, err := protocol.DecodeBatchWithProcess(val.(*message.Message).Data, s.Proc)
@@ -750,6 +628,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 							Fs:    arg.Fs,
 							Limit: arg.Limit,
 						},
+						Analyze: in.Analyze,
 					})
 				}
 				for len(ss) > 3 {
@@ -771,6 +650,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 						Arg: &order.Argument{
 							Fs: arg.Fs,
 						},
+						Analyze: in.Analyze,
 					})
 				}
 				for len(ss) > 3 {
@@ -785,8 +665,9 @@ func (s *Scope) RunQ(e engine.Engine) error {
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op:  vm.Dedup,
-						Arg: &dedup.Argument{},
+						Op:      vm.Dedup,
+						Arg:     &dedup.Argument{},
+						Analyze: in.Analyze,
 					})
 				}
 				for len(ss) > 3 {
@@ -808,6 +689,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 						Arg: &limit.Argument{
 							Limit: arg.Limit,
 						},
+						Analyze: in.Analyze,
 					})
 				}
 				for len(ss) > 3 {
@@ -964,47 +846,27 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				})
 			}
 		}
+		errChan := make(chan error, len(s.PreScopes))
 		for i := range s.PreScopes {
 			switch s.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(s.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(s.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(s.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(s.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(s.Proc.Reg.MergeReceivers); i++ {
 			reg := s.Proc.Reg.MergeReceivers[i]
@@ -1030,9 +892,37 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				}
 			}
 			if bats[i] == nil {
-				flg = true
+				if op.Type == join.Left || op.Type == join.Anti || op.Type == join.Full {
+					bats[i] = newEmptyView(op.Attrs[i], op.Types[i])
+				} else {
+					flg = true
+				}
+			}
+		}
+		// check sub-goroutine's error
+		for i := 0; i < len(s.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil && err == nil {
+				err = rerr
 			}
 		}
+		if err != nil {
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], s.Proc.Mp)
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
@@ -1074,6 +964,9 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 		defer rel.Close()
 		rds = rel.NewReader(mcpu, getConditionFromInstructions(s.Instructions), s.NodeInfo.Data)
 	}
+	if op.Unmatched != nil {
+		op.Unmatched.SetCopies(mcpu)
+	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
 		ss[i] = &Scope{
@@ -1184,47 +1077,27 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 				})
 			}
 		}
+		errChan := make(chan error, len(rs.PreScopes))
 		for i := range rs.PreScopes {
 			switch rs.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(rs.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(rs.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(rs.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(rs.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(rs.Proc.Reg.MergeReceivers); i++ {
 			reg := rs.Proc.Reg.MergeReceivers[i]
@@ -1250,9 +1123,37 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 				}
 			}
 			if bats[i] == nil {
-				flg = true
+				if op.Type == join.Left || op.Type == join.Anti || op.Type == join.Full {
+					bats[i] = newEmptyView(op.Attrs[i], op.Types[i])
+				} else {
+					flg = true
+				}
+			}
+		}
+		// check sub-goroutine's error
+		for i := 0; i < len(rs.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil && err == nil {
+				err = rerr
 			}
 		}
+		if err != nil {
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], rs.Proc.Mp)
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
@@ -1327,47 +1228,27 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 
 			}
 		}
+		errChan := make(chan error, len(s.PreScopes))
 		for i := range s.PreScopes {
 			switch s.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(s.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(s.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(s.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(s.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(s.Proc.Reg.MergeReceivers); i++ {
 			reg := s.Proc.Reg.MergeReceivers[i]
@@ -1396,6 +1277,30 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 				flg = true
 			}
 		}
+		// check sub-goroutine's error
+		for i := 0; i < len(s.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil && err == nil {
+				err = rerr
+			}
+		}
+		if err != nil {
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], s.Proc.Mp)
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
@@ -1566,47 +1471,27 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 				})
 			}
 		}
+		errChan := make(chan error, len(rs.PreScopes))
 		for i := range rs.PreScopes {
 			switch rs.PreScopes[i].Magic {
 			case Normal:
 				go func(s *Scope) {
-					if rerr := s.Run(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.Run(e)
 				}(rs.PreScopes[i])
 			case Merge:
 				go func(s *Scope) {
-					if rerr := s.MergeRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.MergeRun(e)
 				}(rs.PreScopes[i])
 			case Remote:
 				go func(s *Scope) {
-					if rerr := s.RemoteRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.RemoteRun(e)
 				}(rs.PreScopes[i])
 			case Parallel:
 				go func(s *Scope) {
-					if rerr := s.ParallelRun(e); rerr != nil {
-						err = rerr
-					}
+					errChan <- s.ParallelRun(e)
 				}(rs.PreScopes[i])
 			}
 		}
-		if err != nil {
-			for i, in := range s.Instructions {
-				if in.Op == vm.Connector {
-					arg := s.Instructions[i].Arg.(*connector.Argument)
-					select {
-					case <-arg.Reg.Ctx.Done():
-					case arg.Reg.Ch <- nil:
-					}
-					break
-				}
-			}
-			return err
-		}
 		flg := false // check for empty tables
 		for i := 0; i < len(rs.Proc.Reg.MergeReceivers); i++ {
 			reg := rs.Proc.Reg.MergeReceivers[i]
@@ -1635,6 +1520,30 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 				flg = true
 			}
 		}
+		// check sub-goroutine's error
+		for i := 0; i < len(rs.PreScopes); i++ {
+			if rerr := <-errChan; rerr != nil && err == nil {
+				err = rerr
+			}
+		}
+		if err != nil {
+			for i, in := range s.Instructions {
+				if in.Op == vm.Connector {
+					arg := s.Instructions[i].Arg.(*connector.Argument)
+					select {
+					case <-arg.Reg.Ctx.Done():
+					case arg.Reg.Ch <- nil:
+					}
+					break
+				}
+			}
+			for i := range bats {
+				if bats[i] != nil {
+					batch.Clean(bats[i], rs.Proc.Mp)
+				}
+			}
+			return err
+		}
 		if flg {
 			for i, in := range s.Instructions {
 				if in.Op == vm.Connector {
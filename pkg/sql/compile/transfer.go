@@ -95,7 +95,14 @@ func UntransferIns(ins, pins vm.Instructions) {
 		case vm.Join:
 			a := ins[i].Arg.(*join.Argument)
 			pa := in.Arg.(*join.Argument)
+			a.Type = pa.Type
 			a.Vars = pa.Vars
+			a.Attrs = pa.Attrs
+			a.Types = pa.Types
+			a.Cond = pa.Cond
+			a.NotIn = pa.NotIn
+			a.ProbeAttrs = pa.ProbeAttrs
+			a.ProbeTypes = pa.ProbeTypes
 		case vm.Times:
 			a := ins[i].Arg.(*times.Argument)
 			pa := in.Arg.(*times.Argument)
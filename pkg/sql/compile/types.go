@@ -15,6 +15,8 @@
 package compile
 
 import (
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -37,13 +39,11 @@ const (
 	DropDatabase
 	DropTable
 	DropIndex
-	ShowDatabases
-	ShowTables
-	ShowColumns
 	ShowCreateTable
 	ShowCreateDatabase
 	Delete
 	Update
+	ExplainAnalyze
 )
 
 // type of query
@@ -109,10 +109,17 @@ type Exec struct {
 	e engine.Engine
 	//stmt ast of a single sql
 	stmt tree.Statement
-	u    interface{}
+	//pn is the plan of the statement, it is set by Compile or taken from a cached plan.
+	pn plan.Plan
+	u  interface{}
+	//pc checks the privileges of the user, nil means the privileges are not checked.
+	pc plan.PrivilegeChecker
 	//fill is a result writer runs a callback function.
 	//fill will be called when result data is ready.
 	fill func(interface{}, *batch.Batch) error
+	//ctes stores the rows of the common table expressions read by the query.
+	ctes map[*plan.CTE]*cteResult
+	mu   sync.Mutex
 }
 
 // compile contains all the information needed for compilation.
@@ -128,3 +135,22 @@ type compile struct {
 	// proc stores the execution context.
 	proc *process.Process
 }
+
+// jsonTableReader is the reader of the rows of a JSON_TABLE computed by the plan
+type jsonTableReader struct {
+	bat *batch.Batch
+}
+
+func (r *jsonTableReader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if r.bat == nil || len(r.bat.Zs) == 0 {
+		return nil, nil
+	}
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		bat.Vecs[i] = batch.GetVector(r.bat, attr)
+		bat.Vecs[i].Ref = cs[i]
+	}
+	bat.Zs = r.bat.Zs
+	r.bat = nil
+	return bat, nil
+}
@@ -40,6 +40,17 @@ func init() {
 	}
 }
 
+// newEmptyView returns a batch without rows, which is the view of an outer
+// join whose build side is empty.
+func newEmptyView(attrs []string, ts []types.Type) *batch.Batch {
+	bat := batch.New(true, attrs)
+	for i := range attrs {
+		bat.Vecs[i] = vector.New(ts[i])
+		bat.Vecs[i].Ref = 1
+	}
+	return bat
+}
+
 func constructViews(bats []*batch.Batch, vars [][]string) {
 	for i := range vars {
 		constructView(bats[i], vars[i])
@@ -51,6 +62,10 @@ func constructViews(bats []*batch.Batch, vars [][]string) {
 func constructView(bat *batch.Batch, vars []string) {
 	var rows uint64
 
+	if len(vars) == 0 {
+		constructProductView(bat)
+		return
+	}
 	batch.Reorder(bat, vars)
 	if len(vars) == 1 {
 		constructViewWithOneVar(bat, vars[0])
@@ -313,6 +328,20 @@ func constructView(bat *batch.Batch, vars []string) {
 	bat.Ht = ht
 }
 
+// constructProductView is used to create the view of a cross product, all
+// rows of the view belong to a single group which matches every probed row.
+func constructProductView(bat *batch.Batch) {
+	ht := &join.HashTable{}
+	if n := len(bat.Zs); n > 0 {
+		sels := make([]int64, n)
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+		ht.Sels = append(ht.Sels, sels)
+	}
+	bat.Ht = ht
+}
+
 func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 	var rows uint64
 
@@ -361,7 +390,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -424,7 +453,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -487,7 +516,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -550,7 +579,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -613,7 +642,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -676,7 +705,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -739,7 +768,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -802,7 +831,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -865,7 +894,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -928,7 +957,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -991,7 +1020,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -1054,7 +1083,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = uint64(vs[int(i)+k])
 					}
@@ -1122,7 +1151,7 @@ func constructViewWithOneVar(bat *batch.Batch, fvar string) {
 					copy(zValues[:n], OneInt64s[:n])
 					for k := 0; k < n; k++ {
 						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							zValues[i] = 0
+							zValues[k] = 0
 						}
 						keys[k] = append(keys[k], vs.Get(int64(i+k))...)
 					}
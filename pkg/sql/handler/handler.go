@@ -47,11 +47,23 @@ func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) err
 			Data: conn,
 			Func: writeBack,
 		},
+		Analyze: s.Instructions[len(s.Instructions)-1].Analyze,
 	}
+	as := s.Analyzes() // the scope is rewritten while running
 	if err := s.ParallelRun(hp.engine); err != nil {
 		conn.WriteAndFlush(&message.Message{Code: []byte(err.Error())})
 	}
-	return conn.WriteAndFlush(&message.Message{Sid: 1})
+	// the runtime statistics are sent back with the end message
+	var buf bytes.Buffer
+	for _, a := range as {
+		if a != nil {
+			if err := protocol.EncodeAnalyzes(as, &buf); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return conn.WriteAndFlush(&message.Message{Sid: 1, Data: buf.Bytes()})
 }
 
 func writeBack(u interface{}, bat *batch.Batch) error {
@@ -0,0 +1,93 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// New returns the engine e with the information_schema database, addr is the
+// address of the node reading information_schema, ses provides the connections
+// and the variables and can be nil. The session of e is replaced by ses if e
+// already has information_schema.
+func New(e engine.Engine, addr string, ses Session) engine.Engine {
+	if ie, ok := e.(*infoEngine); ok {
+		if ses == nil {
+			return ie
+		}
+		e = ie.Engine
+	}
+	return &infoEngine{Engine: e, addr: addr, ses: ses}
+}
+
+func (e *infoEngine) Create(epoch uint64, name string, typ int) error {
+	if IsName(name) {
+		return errors.New(errno.DuplicateDatabase, fmt.Sprintf("database '%s' already exists", Name))
+	}
+	return e.Engine.Create(epoch, name, typ)
+}
+
+func (e *infoEngine) Delete(epoch uint64, name string) error {
+	if IsName(name) {
+		return accessDenied()
+	}
+	return e.Engine.Delete(epoch, name)
+}
+
+func (e *infoEngine) Databases() []string {
+	return append(e.Engine.Databases(), Name)
+}
+
+func (e *infoEngine) Database(name string) (engine.Database, error) {
+	if IsName(name) {
+		return &database{e: e}, nil
+	}
+	return e.Engine.Database(name)
+}
+
+func (db *database) Relations() []string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (db *database) Relation(name string) (engine.Relation, error) {
+	tbl, ok := tables[strings.ToUpper(name)]
+	if !ok {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%s.%s' doesn't exist", Name, name))
+	}
+	return &relation{e: db.e, tbl: tbl}, nil
+}
+
+func (db *database) Delete(_ uint64, _ string) error {
+	return accessDenied()
+}
+
+func (db *database) Create(_ uint64, _ string, _ []engine.TableDef) error {
+	return accessDenied()
+}
+
+func accessDenied() error {
+	return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("access denied to database '%s'", Name))
+}
@@ -0,0 +1,123 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (r *relation) Close() {}
+
+func (r *relation) ID() string {
+	return r.tbl.name
+}
+
+// Rows returns 0 because the rows are only known when the table is read.
+func (r *relation) Rows() int64 {
+	return 0
+}
+
+func (r *relation) Size(_ string) int64 {
+	return 0
+}
+
+func (r *relation) Stats(_ string) *engine.ColumnStatistics {
+	return nil
+}
+
+// Nodes returns the node reading the relation, the rows are never sent to other nodes.
+func (r *relation) Nodes() engine.Nodes {
+	return engine.Nodes{{Id: "0", Addr: r.e.addr}}
+}
+
+func (r *relation) TableDefs() []engine.TableDef {
+	defs := make([]engine.TableDef, len(r.tbl.attrs))
+	for i, attr := range r.tbl.attrs {
+		defs[i] = &engine.AttributeDef{Attr: attr}
+	}
+	return defs
+}
+
+func (r *relation) GetPriKeyOrHideKey() ([]engine.Attribute, bool) {
+	return nil, false
+}
+
+func (r *relation) Write(_ uint64, _ *batch.Batch) error {
+	return accessDenied()
+}
+
+func (r *relation) AddTableDef(_ uint64, _ engine.TableDef) error {
+	return accessDenied()
+}
+
+func (r *relation) DelTableDef(_ uint64, _ engine.TableDef) error {
+	return accessDenied()
+}
+
+// NewReader generates the rows of the table, all the rows are read by the first reader.
+func (r *relation) NewReader(n int, _ extend.Extend, _ []byte) []engine.Reader {
+	rs := make([]engine.Reader, n)
+	for i := range rs {
+		rs[i] = &reader{}
+	}
+	if n > 0 {
+		rs[0] = &reader{bat: r.tbl.batch(r.e)}
+	}
+	return rs
+}
+
+func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if r.bat == nil || len(r.bat.Zs) == 0 {
+		return nil, nil
+	}
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		bat.Vecs[i] = batch.GetVector(r.bat, attr)
+		bat.Vecs[i].Ref = cs[i]
+	}
+	bat.Zs = r.bat.Zs
+	r.bat = nil
+	return bat, nil
+}
+
+// batch returns the rows of the table
+func (tbl *table) batch(e *infoEngine) *batch.Batch {
+	rows := tbl.rows(e)
+	bat := batch.New(true, make([]string, len(tbl.attrs)))
+	for i, attr := range tbl.attrs {
+		bat.Attrs[i] = attr.Name
+		bat.Vecs[i] = vector.New(attr.Type)
+		switch attr.Type.Oid {
+		case types.T_int64:
+			vs := make([]int64, len(rows))
+			for j, row := range rows {
+				vs[j] = row[i].(int64)
+			}
+			vector.Append(bat.Vecs[i], vs)
+		default:
+			vs := make([][]byte, len(rows))
+			for j, row := range rows {
+				vs[j] = []byte(row[i].(string))
+			}
+			vector.Append(bat.Vecs[i], vs)
+		}
+	}
+	bat.InitZsOne(len(rows))
+	return bat
+}
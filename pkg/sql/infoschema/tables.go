@@ -0,0 +1,277 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	catalogName   = "def"
+	charsetName   = "utf8mb4"
+	collationName = "utf8mb4_bin"
+)
+
+var tables = map[string]*table{
+	Schemata: {
+		name:  Schemata,
+		attrs: attributes("CATALOG_NAME", "SCHEMA_NAME", "DEFAULT_CHARACTER_SET_NAME", "DEFAULT_COLLATION_NAME"),
+		rows:  schemataRows,
+	},
+	Tables: {
+		name:  Tables,
+		attrs: attributes("TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "TABLE_TYPE", "TABLE_ROWS:bigint", "TABLE_COMMENT"),
+		rows:  tablesRows,
+	},
+	Columns: {
+		name: Columns,
+		attrs: attributes("TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "COLUMN_NAME", "ORDINAL_POSITION:bigint",
+			"COLUMN_DEFAULT", "IS_NULLABLE", "DATA_TYPE", "COLUMN_TYPE", "COLUMN_KEY", "EXTRA", "COLUMN_COMMENT"),
+		rows: columnsRows,
+	},
+	Statistics: {
+		name: Statistics,
+		attrs: attributes("TABLE_CATALOG", "TABLE_SCHEMA", "TABLE_NAME", "NON_UNIQUE:bigint", "INDEX_SCHEMA",
+			"INDEX_NAME", "SEQ_IN_INDEX:bigint", "COLUMN_NAME", "INDEX_TYPE", "COMMENT"),
+		rows: statisticsRows,
+	},
+	ProcessList: {
+		name:  ProcessList,
+		attrs: attributes("ID:bigint", "USER", "HOST", "DB", "COMMAND", "TIME:bigint", "STATE", "INFO"),
+		rows:  processListRows,
+	},
+	SessionVariables: {
+		name:  SessionVariables,
+		attrs: attributes("VARIABLE_NAME", "VARIABLE_VALUE"),
+		rows:  sessionVariablesRows,
+	},
+}
+
+// attributes returns the attributes of names, the type of a name
+// is bigint if it ends with ":bigint" and is varchar otherwise.
+func attributes(names ...string) []engine.Attribute {
+	attrs := make([]engine.Attribute, len(names))
+	for i, name := range names {
+		attrs[i] = engine.Attribute{Name: name, Type: varcharType}
+		if strings.HasSuffix(name, ":bigint") {
+			attrs[i] = engine.Attribute{Name: strings.TrimSuffix(name, ":bigint"), Type: bigintType}
+		}
+	}
+	return attrs
+}
+
+func schemataRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	visible := e.visible()
+	for _, name := range e.Databases() {
+		if !visible(name, "", "") {
+			continue
+		}
+		rows = append(rows, []interface{}{catalogName, name, charsetName, collationName})
+	}
+	return rows
+}
+
+func tablesRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	e.walkRelations(e.visible(), func(schema, name string, rel engine.Relation) {
+		typ, comment := "BASE TABLE", ""
+		if IsName(schema) {
+			typ = "SYSTEM VIEW"
+		}
+		for _, def := range rel.TableDefs() {
+			if c, ok := def.(*engine.CommentDef); ok {
+				comment = c.Comment
+			}
+		}
+		rows = append(rows, []interface{}{catalogName, schema, name, typ, rel.Rows(), comment})
+	})
+	return rows
+}
+
+func columnsRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	visible := e.visible()
+	e.walkRelations(visible, func(schema, name string, rel engine.Relation) {
+		defs := rel.TableDefs()
+		keys := primaryKeys(defs)
+		pos := int64(0)
+		for _, def := range defs {
+			attr, ok := def.(*engine.AttributeDef)
+			if !ok {
+				continue
+			}
+			pos++
+			if !visible(schema, name, attr.Attr.Name) {
+				continue
+			}
+			nullable, key := "YES", ""
+			if !attr.Attr.Nullability {
+				nullable = "NO"
+			}
+			if _, ok := keys[attr.Attr.Name]; ok {
+				nullable, key = "NO", "PRI"
+			}
+			rows = append(rows, []interface{}{catalogName, schema, name, attr.Attr.Name, pos, defaultString(attr.Attr),
+				nullable, strings.ToLower(attr.Attr.Type.String()), columnType(attr.Attr.Type), key, "", ""})
+		}
+	})
+	return rows
+}
+
+func statisticsRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	e.walkRelations(e.visible(), func(schema, name string, rel engine.Relation) {
+		defs := rel.TableDefs()
+		var pks []string
+		for _, def := range defs {
+			switch d := def.(type) {
+			case *engine.PrimaryIndexDef:
+				pks = d.Names
+			case *engine.AttributeDef:
+				if d.Attr.Primary {
+					pks = append(pks, d.Attr.Name)
+				}
+			}
+		}
+		for i, pk := range pks {
+			rows = append(rows, []interface{}{catalogName, schema, name, int64(0), schema, "PRIMARY", int64(i + 1), pk, "BTREE", ""})
+		}
+		for _, def := range defs {
+			if d, ok := def.(*engine.IndexTableDef); ok {
+				for i, col := range d.ColNames {
+					rows = append(rows, []interface{}{catalogName, schema, name, int64(1), schema, d.Name, int64(i + 1), col, d.Typ.ToString(), ""})
+				}
+			}
+		}
+	})
+	return rows
+}
+
+func processListRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	if e.ses == nil {
+		return nil
+	}
+	// the processes of the other users are listed with the PROCESS privilege
+	priv := e.ses.Privileges()
+	all := priv == nil || priv.HasPrivilege(tree.PRIVILEGE_TYPE_STATIC_PROCESS, "", "", "")
+	user := e.ses.User()
+	for _, p := range e.ses.Processes() {
+		if !all && p.User != user {
+			continue
+		}
+		rows = append(rows, []interface{}{int64(p.Id), p.User, p.Host, p.Db, p.Command, p.Time, p.State, p.Info})
+	}
+	return rows
+}
+
+func sessionVariablesRows(e *infoEngine) [][]interface{} {
+	var rows [][]interface{}
+
+	if e.ses == nil {
+		return nil
+	}
+	for _, v := range e.ses.Variables() {
+		rows = append(rows, []interface{}{v.Name, v.Value})
+	}
+	return rows
+}
+
+// visible returns the function checking whether the object is visible to the user
+// of the session, information_schema is visible to everyone. The empty table means
+// the database itself and the empty column means the table itself.
+func (e *infoEngine) visible() func(string, string, string) bool {
+	var priv Privileges
+	if e.ses != nil {
+		priv = e.ses.Privileges()
+	}
+	return func(db, table, column string) bool {
+		return priv == nil || IsName(db) || priv.HasAnyPrivilege(db, table, column)
+	}
+}
+
+// walkRelations calls fn with every relation of the databases visible to the user.
+func (e *infoEngine) walkRelations(visible func(string, string, string) bool, fn func(string, string, engine.Relation)) {
+	for _, schema := range e.Databases() {
+		if !visible(schema, "", "") {
+			continue
+		}
+		db, err := e.Database(schema)
+		if err != nil {
+			continue
+		}
+		for _, name := range db.Relations() {
+			if !visible(schema, name, "") {
+				continue
+			}
+			rel, err := db.Relation(name)
+			if err != nil {
+				continue
+			}
+			fn(schema, name, rel)
+			rel.Close()
+		}
+	}
+}
+
+// primaryKeys returns the columns of the primary key.
+func primaryKeys(defs []engine.TableDef) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, def := range defs {
+		switch d := def.(type) {
+		case *engine.PrimaryIndexDef:
+			for _, name := range d.Names {
+				keys[name] = struct{}{}
+			}
+		case *engine.AttributeDef:
+			if d.Attr.Primary {
+				keys[d.Attr.Name] = struct{}{}
+			}
+		}
+	}
+	return keys
+}
+
+func defaultString(attr engine.Attribute) string {
+	if !attr.HasDefaultExpr() {
+		return ""
+	}
+	if attr.Default.IsNull {
+		return "NULL"
+	}
+	switch attr.Type.Oid {
+	case types.T_date, types.T_datetime:
+		return fmt.Sprintf("%s", attr.Default.Value)
+	}
+	return fmt.Sprintf("%v", attr.Default.Value)
+}
+
+func columnType(typ types.Type) string {
+	if typ.Width > 0 {
+		return fmt.Sprintf("%s(%v)", strings.ToLower(typ.String()), typ.Width)
+	}
+	return strings.ToLower(typ.String())
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/stretchr/testify/require"
+)
+
+type testSession struct {
+	user  string
+	priv  Privileges
+	procs []Process
+}
+
+func (s *testSession) Processes() []Process   { return s.procs }
+func (s *testSession) Variables() []Variable  { return nil }
+func (s *testSession) User() string           { return s.user }
+func (s *testSession) Privileges() Privileges { return s.priv }
+
+// testPrivileges grants every privilege on the objects, the process
+// privilege is granted if process is true
+type testPrivileges struct {
+	objects [][3]string
+	process bool
+}
+
+func (p *testPrivileges) HasPrivilege(priv tree.PrivilegeType, db, table, column string) bool {
+	return p.process && priv == tree.PRIVILEGE_TYPE_STATIC_PROCESS && db == ""
+}
+
+func (p *testPrivileges) HasAnyPrivilege(db, table, column string) bool {
+	for _, o := range p.objects {
+		if o[0] == db && (table == "" || o[1] == "" || o[1] == table) &&
+			(column == "" || o[2] == "" || o[2] == column) {
+			return true
+		}
+	}
+	return false
+}
+
+// column returns the values of the column of the rows
+func column(rows [][]interface{}, idx int) []interface{} {
+	vs := make([]interface{}, len(rows))
+	for i, row := range rows {
+		vs[i] = row[idx]
+	}
+	return vs
+}
+
+func TestVisibleRows(t *testing.T) {
+	ses := &testSession{
+		user: "u1",
+		priv: &testPrivileges{objects: [][3]string{{"test", "R", "uid"}}},
+		procs: []Process{
+			{Id: 1, User: "u1"},
+			{Id: 2, User: "u2"},
+		},
+	}
+	e := New(memEngine.NewTestEngine(), "", ses).(*infoEngine)
+
+	// the objects without any privilege are hidden except information_schema
+	require.Equal(t, []interface{}{"test", Name}, column(schemataRows(e), 1))
+	var tbls []interface{}
+	for _, row := range tablesRows(e) {
+		if row[1] == "test" {
+			tbls = append(tbls, row[2])
+		}
+	}
+	require.Equal(t, []interface{}{"R"}, tbls)
+	var cols []interface{}
+	for _, row := range columnsRows(e) {
+		if row[1] == "test" {
+			cols = append(cols, row[3], row[4], row[6])
+		}
+	}
+	require.Equal(t, []interface{}{"uid", int64(2), "NO"}, cols)
+
+	// the processes of the other users are listed with the PROCESS privilege
+	require.Equal(t, []interface{}{int64(1)}, column(processListRows(e), 0))
+	ses.priv.(*testPrivileges).process = true
+	require.Equal(t, []interface{}{int64(1), int64(2)}, column(processListRows(e), 0))
+
+	// everything is visible to the user holding all the privileges
+	ses.priv = nil
+	require.Equal(t, 2, len(processListRows(e)))
+	tbls = nil
+	for _, row := range tablesRows(e) {
+		if row[1] == "test" {
+			tbls = append(tbls, row[2])
+		}
+	}
+	require.Contains(t, tbls, "R")
+	require.Contains(t, tbls, "S")
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package infoschema
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Name is the name of the read-only database describing the other databases.
+const Name = "information_schema"
+
+// tables of information_schema
+const (
+	Schemata         = "SCHEMATA"
+	Tables           = "TABLES"
+	Columns          = "COLUMNS"
+	Statistics       = "STATISTICS"
+	ProcessList      = "PROCESSLIST"
+	SessionVariables = "SESSION_VARIABLES"
+)
+
+// Process is a connection of the server which is listed by PROCESSLIST.
+type Process struct {
+	Id      uint64
+	User    string
+	Host    string
+	Db      string
+	Command string
+	Time    int64 // seconds since the command started
+	State   string
+	Info    string // the statement being executed
+}
+
+// Variable is a system variable of the session.
+type Variable struct {
+	Name  string
+	Value string
+}
+
+// Session provides the information kept by the frontend, which are
+// the connections of the server, the variables of the session and
+// the privileges of its user.
+type Session interface {
+	Processes() []Process
+	Variables() []Variable
+	// User returns the user of the session
+	User() string
+	// Privileges returns the privileges of the user, nil means the user
+	// holds all the privileges.
+	Privileges() Privileges
+}
+
+// Privileges decides the rows visible to the user, the objects on which
+// the user holds no privilege are hidden as mysql does.
+type Privileges interface {
+	// HasPrivilege checks whether the privilege is granted on the object.
+	// The empty table means the database itself and the empty column means the table itself.
+	HasPrivilege(priv tree.PrivilegeType, db, table, column string) bool
+	// HasAnyPrivilege checks whether any privilege is granted on the object or on an object in it.
+	HasAnyPrivilege(db, table, column string) bool
+}
+
+// IsName returns true if name is the name of information_schema,
+// the name is case-insensitive.
+func IsName(name string) bool {
+	return strings.EqualFold(name, Name)
+}
+
+type infoEngine struct {
+	engine.Engine
+	addr string // address of the node which reads information_schema
+	ses  Session
+}
+
+type database struct {
+	e *infoEngine
+}
+
+type relation struct {
+	e   *infoEngine
+	tbl *table
+}
+
+type reader struct {
+	bat *batch.Batch
+}
+
+// table is a table of information_schema, the rows of the table are
+// generated every time the table is read.
+type table struct {
+	name  string
+	attrs []engine.Attribute
+	rows  func(*infoEngine) [][]interface{}
+}
+
+var (
+	varcharType = types.Type{Oid: types.T_varchar, Size: 24, Width: 1024}
+	bigintType  = types.Type{Oid: types.T_int64, Size: 8, Width: 64}
+)
@@ -33,6 +33,19 @@ func Parse(sql string) ([]tree.Statement, error) {
 	return lexer.stmts, nil
 }
 
+// ParseOneWithParams parses a single statement which may contain '?' placeholders.
+// The placeholders are returned in the order they appear in the statement.
+func ParseOneWithParams(sql string) (tree.Statement, []*tree.ParamExpr, error) {
+	lexer := NewLexer(dialect.MYSQL, sql)
+	if yyParse(lexer) != 0 {
+		return nil, nil, lexer.scanner.LastError
+	}
+	if len(lexer.stmts) != 1 {
+		return nil, nil, errors.New("syntax error, or too many sql to parse")
+	}
+	return lexer.stmts[0], lexer.params, nil
+}
+
 func ParseOne(sql string) (tree.Statement, error) {
 	lexer := NewLexer(dialect.MYSQL, sql)
 	if yyParse(lexer) != 0 {
@@ -47,6 +60,7 @@ func ParseOne(sql string) (tree.Statement, error) {
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
+	params  []*tree.ParamExpr
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -84,6 +98,14 @@ func (l *Lexer) AppendStmt(stmt tree.Statement) {
 	l.stmts = append(l.stmts, stmt)
 }
 
+// paramExpr makes the placeholder for the VALUE_ARG token like ':v1'
+func (l *Lexer) paramExpr(str string) *tree.ParamExpr {
+	offset, _ := strconv.Atoi(str[2:])
+	p := tree.NewParamExpr(offset)
+	l.params = append(l.params, p)
+	return p
+}
+
 func (l *Lexer) toInt(lval *yySymType, str string) int {
 	ival, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
@@ -22,425 +22,440 @@ import (
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const DISTINCTROW = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const VERBOSE = 57649
-const CONNECTION = 57650
-const LOAD = 57651
-const INFILE = 57652
-const TERMINATED = 57653
-const OPTIONALLY = 57654
-const ENCLOSED = 57655
-const ESCAPED = 57656
-const STARTING = 57657
-const LINES = 57658
-const DATABASES = 57659
-const TABLES = 57660
-const EXTENDED = 57661
-const FULL = 57662
-const PROCESSLIST = 57663
-const FIELDS = 57664
-const COLUMNS = 57665
-const OPEN = 57666
-const ERRORS = 57667
-const WARNINGS = 57668
-const INDEXES = 57669
-const NAMES = 57670
-const GLOBAL = 57671
-const SESSION = 57672
-const ISOLATION = 57673
-const LEVEL = 57674
-const READ = 57675
-const WRITE = 57676
-const ONLY = 57677
-const REPEATABLE = 57678
-const COMMITTED = 57679
-const UNCOMMITTED = 57680
-const SERIALIZABLE = 57681
-const LOCAL = 57682
-const EXCEPT = 57683
-const CURRENT_TIMESTAMP = 57684
-const DATABASE = 57685
-const CURRENT_TIME = 57686
-const LOCALTIME = 57687
-const LOCALTIMESTAMP = 57688
-const UTC_DATE = 57689
-const UTC_TIME = 57690
-const UTC_TIMESTAMP = 57691
-const REPLACE = 57692
-const CONVERT = 57693
-const SEPARATOR = 57694
-const CURRENT_DATE = 57695
-const CURRENT_USER = 57696
-const CURRENT_ROLE = 57697
-const SECOND_MICROSECOND = 57698
-const MINUTE_MICROSECOND = 57699
-const MINUTE_SECOND = 57700
-const HOUR_MICROSECOND = 57701
-const HOUR_SECOND = 57702
-const HOUR_MINUTE = 57703
-const DAY_MICROSECOND = 57704
-const DAY_SECOND = 57705
-const DAY_MINUTE = 57706
-const DAY_HOUR = 57707
-const YEAR_MONTH = 57708
-const SQL_TSI_HOUR = 57709
-const SQL_TSI_DAY = 57710
-const SQL_TSI_WEEK = 57711
-const SQL_TSI_MONTH = 57712
-const SQL_TSI_QUARTER = 57713
-const SQL_TSI_YEAR = 57714
-const SQL_TSI_SECOND = 57715
-const SQL_TSI_MINUTE = 57716
-const RECURSIVE = 57717
-const MATCH = 57718
-const AGAINST = 57719
-const BOOLEAN = 57720
-const LANGUAGE = 57721
-const WITH = 57722
-const QUERY = 57723
-const EXPANSION = 57724
-const ADDDATE = 57725
-const BIT_AND = 57726
-const BIT_OR = 57727
-const BIT_XOR = 57728
-const CAST = 57729
-const COUNT = 57730
-const APPROX_COUNT_DISTINCT = 57731
-const APPROX_PERCENTILE = 57732
-const CURDATE = 57733
-const CURTIME = 57734
-const DATE_ADD = 57735
-const DATE_SUB = 57736
-const EXTRACT = 57737
-const GROUP_CONCAT = 57738
-const MAX = 57739
-const MID = 57740
-const MIN = 57741
-const NOW = 57742
-const POSITION = 57743
-const SESSION_USER = 57744
-const STD = 57745
-const STDDEV = 57746
-const STDDEV_POP = 57747
-const STDDEV_SAMP = 57748
-const SUBDATE = 57749
-const SUBSTR = 57750
-const SUBSTRING = 57751
-const SUM = 57752
-const SYSDATE = 57753
-const SYSTEM_USER = 57754
-const TRANSLATE = 57755
-const TRIM = 57756
-const VARIANCE = 57757
-const VAR_POP = 57758
-const VAR_SAMP = 57759
-const AVG = 57760
-const ROW = 57761
-const OUTFILE = 57762
-const HEADER = 57763
-const MAX_FILE_SIZE = 57764
-const FORCE_QUOTE = 57765
-const UNUSED = 57766
+const EXCEPT = 57348
+const INTERSECT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const DISTINCTROW = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const KEYS = 57376
+const VALUES = 57377
+const LAST_INSERT_ID = 57378
+const NEXT = 57379
+const VALUE = 57380
+const SHARE = 57381
+const MODE = 57382
+const SQL_NO_CACHE = 57383
+const SQL_CACHE = 57384
+const JOIN = 57385
+const STRAIGHT_JOIN = 57386
+const LEFT = 57387
+const RIGHT = 57388
+const FULL = 57389
+const INNER = 57390
+const OUTER = 57391
+const CROSS = 57392
+const NATURAL = 57393
+const USE = 57394
+const FORCE = 57395
+const ON = 57396
+const USING = 57397
+const SUBQUERY_AS_EXPR = 57398
+const ID = 57399
+const AT_ID = 57400
+const AT_AT_ID = 57401
+const STRING = 57402
+const VALUE_ARG = 57403
+const LIST_ARG = 57404
+const COMMENT = 57405
+const COMMENT_KEYWORD = 57406
+const INTEGRAL = 57407
+const HEX = 57408
+const HEXNUM = 57409
+const BIT_LITERAL = 57410
+const FLOAT = 57411
+const NULL = 57412
+const TRUE = 57413
+const FALSE = 57414
+const EMPTY_FROM_CLAUSE = 57415
+const LOWER_THAN_CHARSET = 57416
+const CHARSET = 57417
+const UNIQUE = 57418
+const KEY = 57419
+const OR = 57420
+const XOR = 57421
+const AND = 57422
+const NOT = 57423
+const BETWEEN = 57424
+const CASE = 57425
+const WHEN = 57426
+const THEN = 57427
+const ELSE = 57428
+const END = 57429
+const LE = 57430
+const GE = 57431
+const NE = 57432
+const NULL_SAFE_EQUAL = 57433
+const IS = 57434
+const LIKE = 57435
+const REGEXP = 57436
+const IN = 57437
+const ASSIGNMENT = 57438
+const SHIFT_LEFT = 57439
+const SHIFT_RIGHT = 57440
+const DIV = 57441
+const MOD = 57442
+const UNARY = 57443
+const COLLATE = 57444
+const BINARY = 57445
+const UNDERSCORE_BINARY = 57446
+const INTERVAL = 57447
+const BEGIN = 57448
+const START = 57449
+const TRANSACTION = 57450
+const COMMIT = 57451
+const ROLLBACK = 57452
+const WORK = 57453
+const CONSISTENT = 57454
+const SNAPSHOT = 57455
+const CHAIN = 57456
+const NO = 57457
+const RELEASE = 57458
+const BIT = 57459
+const TINYINT = 57460
+const SMALLINT = 57461
+const MEDIUMINT = 57462
+const INT = 57463
+const INTEGER = 57464
+const BIGINT = 57465
+const INTNUM = 57466
+const REAL = 57467
+const DOUBLE = 57468
+const FLOAT_TYPE = 57469
+const DECIMAL = 57470
+const NUMERIC = 57471
+const TIME = 57472
+const TIMESTAMP = 57473
+const DATETIME = 57474
+const YEAR = 57475
+const CHAR = 57476
+const VARCHAR = 57477
+const BOOL = 57478
+const CHARACTER = 57479
+const VARBINARY = 57480
+const NCHAR = 57481
+const TEXT = 57482
+const TINYTEXT = 57483
+const MEDIUMTEXT = 57484
+const LONGTEXT = 57485
+const BLOB = 57486
+const TINYBLOB = 57487
+const MEDIUMBLOB = 57488
+const LONGBLOB = 57489
+const JSON = 57490
+const ENUM = 57491
+const GEOMETRY = 57492
+const POINT = 57493
+const LINESTRING = 57494
+const POLYGON = 57495
+const GEOMETRYCOLLECTION = 57496
+const MULTIPOINT = 57497
+const MULTILINESTRING = 57498
+const MULTIPOLYGON = 57499
+const INT1 = 57500
+const INT2 = 57501
+const INT3 = 57502
+const INT4 = 57503
+const INT8 = 57504
+const CREATE = 57505
+const ALTER = 57506
+const DROP = 57507
+const RENAME = 57508
+const ANALYZE = 57509
+const ADD = 57510
+const BACKUP = 57511
+const SCHEMA = 57512
+const TABLE = 57513
+const INDEX = 57514
+const VIEW = 57515
+const TO = 57516
+const IGNORE = 57517
+const IF = 57518
+const PRIMARY = 57519
+const COLUMN = 57520
+const CONSTRAINT = 57521
+const SPATIAL = 57522
+const FULLTEXT = 57523
+const FOREIGN = 57524
+const KEY_BLOCK_SIZE = 57525
+const SHOW = 57526
+const DESCRIBE = 57527
+const EXPLAIN = 57528
+const DATE = 57529
+const ESCAPE = 57530
+const REPAIR = 57531
+const OPTIMIZE = 57532
+const TRUNCATE = 57533
+const MAXVALUE = 57534
+const PARTITION = 57535
+const REORGANIZE = 57536
+const LESS = 57537
+const THAN = 57538
+const PROCEDURE = 57539
+const TRIGGER = 57540
+const STATUS = 57541
+const VARIABLES = 57542
+const ROLE = 57543
+const PROXY = 57544
+const AVG_ROW_LENGTH = 57545
+const STORAGE = 57546
+const DISK = 57547
+const MEMORY = 57548
+const CHECKSUM = 57549
+const COMPRESSION = 57550
+const DATA = 57551
+const DIRECTORY = 57552
+const DELAY_KEY_WRITE = 57553
+const ENCRYPTION = 57554
+const ENGINE = 57555
+const MAX_ROWS = 57556
+const MIN_ROWS = 57557
+const PACK_KEYS = 57558
+const ROW_FORMAT = 57559
+const STATS_AUTO_RECALC = 57560
+const STATS_PERSISTENT = 57561
+const STATS_SAMPLE_PAGES = 57562
+const DYNAMIC = 57563
+const COMPRESSED = 57564
+const REDUNDANT = 57565
+const COMPACT = 57566
+const FIXED = 57567
+const COLUMN_FORMAT = 57568
+const AUTO_RANDOM = 57569
+const RESTRICT = 57570
+const CASCADE = 57571
+const ACTION = 57572
+const PARTIAL = 57573
+const SIMPLE = 57574
+const CHECK = 57575
+const ENFORCED = 57576
+const RANGE = 57577
+const LIST = 57578
+const ALGORITHM = 57579
+const LINEAR = 57580
+const PARTITIONS = 57581
+const SUBPARTITION = 57582
+const SUBPARTITIONS = 57583
+const TYPE = 57584
+const PROPERTIES = 57585
+const PARSER = 57586
+const VISIBLE = 57587
+const INVISIBLE = 57588
+const BTREE = 57589
+const HASH = 57590
+const RTREE = 57591
+const BSI = 57592
+const ZONEMAP = 57593
+const EXPIRE = 57594
+const ACCOUNT = 57595
+const UNLOCK = 57596
+const DAY = 57597
+const NEVER = 57598
+const SECOND = 57599
+const ASCII = 57600
+const COALESCE = 57601
+const COLLATION = 57602
+const HOUR = 57603
+const MICROSECOND = 57604
+const MINUTE = 57605
+const MONTH = 57606
+const QUARTER = 57607
+const REPEAT = 57608
+const REVERSE = 57609
+const ROW_COUNT = 57610
+const WEEK = 57611
+const REVOKE = 57612
+const FUNCTION = 57613
+const PRIVILEGES = 57614
+const TABLESPACE = 57615
+const EXECUTE = 57616
+const SUPER = 57617
+const GRANT = 57618
+const OPTION = 57619
+const REFERENCES = 57620
+const REPLICATION = 57621
+const SLAVE = 57622
+const CLIENT = 57623
+const USAGE = 57624
+const RELOAD = 57625
+const FILE = 57626
+const TEMPORARY = 57627
+const ROUTINE = 57628
+const EVENT = 57629
+const SHUTDOWN = 57630
+const NULLX = 57631
+const AUTO_INCREMENT = 57632
+const APPROXNUM = 57633
+const SIGNED = 57634
+const UNSIGNED = 57635
+const ZEROFILL = 57636
+const USER = 57637
+const IDENTIFIED = 57638
+const CIPHER = 57639
+const ISSUER = 57640
+const X509 = 57641
+const SUBJECT = 57642
+const SAN = 57643
+const REQUIRE = 57644
+const SSL = 57645
+const NONE = 57646
+const PASSWORD = 57647
+const MAX_QUERIES_PER_HOUR = 57648
+const MAX_UPDATES_PER_HOUR = 57649
+const MAX_CONNECTIONS_PER_HOUR = 57650
+const MAX_USER_CONNECTIONS = 57651
+const FORMAT = 57652
+const VERBOSE = 57653
+const CONNECTION = 57654
+const LOAD = 57655
+const INFILE = 57656
+const TERMINATED = 57657
+const OPTIONALLY = 57658
+const ENCLOSED = 57659
+const ESCAPED = 57660
+const STARTING = 57661
+const LINES = 57662
+const DATABASES = 57663
+const TABLES = 57664
+const EXTENDED = 57665
+const PROCESSLIST = 57666
+const FIELDS = 57667
+const COLUMNS = 57668
+const OPEN = 57669
+const ERRORS = 57670
+const WARNINGS = 57671
+const INDEXES = 57672
+const NAMES = 57673
+const GLOBAL = 57674
+const SESSION = 57675
+const ISOLATION = 57676
+const LEVEL = 57677
+const READ = 57678
+const WRITE = 57679
+const ONLY = 57680
+const REPEATABLE = 57681
+const COMMITTED = 57682
+const UNCOMMITTED = 57683
+const SERIALIZABLE = 57684
+const LOCAL = 57685
+const CURRENT_TIMESTAMP = 57686
+const DATABASE = 57687
+const CURRENT_TIME = 57688
+const LOCALTIME = 57689
+const LOCALTIMESTAMP = 57690
+const UTC_DATE = 57691
+const UTC_TIME = 57692
+const UTC_TIMESTAMP = 57693
+const REPLACE = 57694
+const CONVERT = 57695
+const SEPARATOR = 57696
+const CURRENT_DATE = 57697
+const CURRENT_USER = 57698
+const CURRENT_ROLE = 57699
+const SECOND_MICROSECOND = 57700
+const MINUTE_MICROSECOND = 57701
+const MINUTE_SECOND = 57702
+const HOUR_MICROSECOND = 57703
+const HOUR_SECOND = 57704
+const HOUR_MINUTE = 57705
+const DAY_MICROSECOND = 57706
+const DAY_SECOND = 57707
+const DAY_MINUTE = 57708
+const DAY_HOUR = 57709
+const YEAR_MONTH = 57710
+const SQL_TSI_HOUR = 57711
+const SQL_TSI_DAY = 57712
+const SQL_TSI_WEEK = 57713
+const SQL_TSI_MONTH = 57714
+const SQL_TSI_QUARTER = 57715
+const SQL_TSI_YEAR = 57716
+const SQL_TSI_SECOND = 57717
+const SQL_TSI_MINUTE = 57718
+const RECURSIVE = 57719
+const MATCH = 57720
+const AGAINST = 57721
+const BOOLEAN = 57722
+const LANGUAGE = 57723
+const WITH = 57724
+const QUERY = 57725
+const EXPANSION = 57726
+const JSON_EXTRACT_OP = 57727
+const JSON_UNQUOTE_EXTRACT_OP = 57728
+const JSON_TABLE = 57729
+const PATH = 57730
+const ORDINALITY = 57731
+const EMPTY = 57732
+const ERROR = 57733
+const OVER = 57734
+const ROWS = 57735
+const PRECEDING = 57736
+const FOLLOWING = 57737
+const UNBOUNDED = 57738
+const CURRENT = 57739
+const ADDDATE = 57740
+const BIT_AND = 57741
+const BIT_OR = 57742
+const BIT_XOR = 57743
+const CAST = 57744
+const COUNT = 57745
+const APPROX_COUNT_DISTINCT = 57746
+const APPROX_PERCENTILE = 57747
+const CURDATE = 57748
+const CURTIME = 57749
+const DATE_ADD = 57750
+const DATE_SUB = 57751
+const EXTRACT = 57752
+const GROUP_CONCAT = 57753
+const MAX = 57754
+const MID = 57755
+const MIN = 57756
+const NOW = 57757
+const POSITION = 57758
+const SESSION_USER = 57759
+const STD = 57760
+const STDDEV = 57761
+const STDDEV_POP = 57762
+const STDDEV_SAMP = 57763
+const SUBDATE = 57764
+const SUBSTR = 57765
+const SUBSTRING = 57766
+const SUM = 57767
+const SYSDATE = 57768
+const SYSTEM_USER = 57769
+const TRANSLATE = 57770
+const TRIM = 57771
+const VARIANCE = 57772
+const VAR_POP = 57773
+const VAR_SAMP = 57774
+const AVG = 57775
+const ROW = 57776
+const OUTFILE = 57777
+const HEADER = 57778
+const MAX_FILE_SIZE = 57779
+const FORCE_QUOTE = 57780
+const UNUSED = 57781
 
 var yyToknames = [...]string{
 	"$end",
@@ -448,6 +463,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -487,6 +504,7 @@ var yyToknames = [...]string{
 	"STRAIGHT_JOIN",
 	"LEFT",
 	"RIGHT",
+	"FULL",
 	"INNER",
 	"OUTER",
 	"CROSS",
@@ -625,6 +643,7 @@ var yyToknames = [...]string{
 	"RENAME",
 	"ANALYZE",
 	"ADD",
+	"BACKUP",
 	"SCHEMA",
 	"TABLE",
 	"INDEX",
@@ -779,7 +798,6 @@ var yyToknames = [...]string{
 	"DATABASES",
 	"TABLES",
 	"EXTENDED",
-	"FULL",
 	"PROCESSLIST",
 	"FIELDS",
 	"COLUMNS",
@@ -800,7 +818,6 @@ var yyToknames = [...]string{
 	"UNCOMMITTED",
 	"SERIALIZABLE",
 	"LOCAL",
-	"EXCEPT",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -842,6 +859,19 @@ var yyToknames = [...]string{
 	"WITH",
 	"QUERY",
 	"EXPANSION",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"JSON_TABLE",
+	"PATH",
+	"ORDINALITY",
+	"EMPTY",
+	"ERROR",
+	"OVER",
+	"ROWS",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNBOUNDED",
+	"CURRENT",
 	"ADDDATE",
 	"BIT_AND",
 	"BIT_OR",
@@ -894,1843 +924,1869 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6272
+//line mysql_sql.y:6629
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 353,
-	-2, 334,
-	-1, 56,
-	185, 490,
-	-2, 526,
-	-1, 65,
-	212, 243,
-	213, 243,
-	-2, 263,
-	-1, 312,
-	58, 1282,
-	443, 1282,
-	-2, 92,
-	-1, 331,
-	58, 653,
-	443, 653,
-	-2, 488,
-	-1, 332,
-	58, 481,
-	443, 481,
-	-2, 489,
-	-1, 338,
-	17, 354,
-	-2, 317,
-	-1, 560,
-	17, 354,
-	-2, 317,
-	-1, 593,
-	54, 779,
-	-2, 1323,
-	-1, 594,
-	54, 780,
-	-2, 1324,
-	-1, 595,
-	54, 781,
-	-2, 1325,
-	-1, 597,
-	54, 788,
-	-2, 1328,
-	-1, 598,
-	54, 787,
-	-2, 1329,
-	-1, 604,
-	54, 862,
-	-2, 1227,
-	-1, 605,
-	54, 873,
-	-2, 1287,
-	-1, 606,
-	54, 875,
-	-2, 1297,
-	-1, 607,
-	54, 863,
-	-2, 1302,
-	-1, 757,
-	1, 516,
-	56, 516,
-	442, 516,
-	-2, 523,
-	-1, 875,
-	17, 353,
-	-2, 711,
-	-1, 921,
-	119, 1001,
-	-2, 999,
-	-1, 923,
-	119, 435,
-	-2, 996,
-	-1, 924,
-	119, 436,
-	-2, 997,
-	-1, 1115,
-	1, 517,
-	56, 517,
-	442, 517,
-	-2, 523,
-	-1, 1543,
-	75, 523,
-	115, 523,
-	148, 523,
-	151, 523,
-	-2, 563,
-	-1, 1545,
-	246, 678,
-	-2, 659,
-	-1, 1653,
-	75, 523,
-	115, 523,
-	148, 523,
-	151, 523,
-	-2, 564,
-	-1, 1681,
-	246, 678,
-	-2, 660,
-	-1, 2066,
-	55, 538,
-	56, 538,
-	-2, 523,
-	-1, 2070,
-	55, 538,
-	56, 538,
-	-2, 523,
-	-1, 2082,
-	55, 542,
-	56, 542,
-	-2, 523,
-	-1, 2085,
-	55, 543,
-	56, 543,
-	-2, 523,
+	-1, 54,
+	19, 358,
+	-2, 339,
+	-1, 58,
+	189, 516,
+	-2, 552,
+	-1, 67,
+	216, 246,
+	217, 246,
+	-2, 266,
+	-1, 327,
+	61, 1346,
+	458, 1346,
+	-2, 93,
+	-1, 346,
+	61, 679,
+	458, 679,
+	-2, 514,
+	-1, 347,
+	61, 507,
+	458, 507,
+	-2, 515,
+	-1, 353,
+	19, 359,
+	-2, 322,
+	-1, 440,
+	55, 641,
+	58, 641,
+	-2, 451,
+	-1, 589,
+	19, 359,
+	-2, 322,
+	-1, 622,
+	57, 830,
+	-2, 1387,
+	-1, 623,
+	57, 831,
+	-2, 1388,
+	-1, 624,
+	57, 832,
+	-2, 1389,
+	-1, 626,
+	57, 839,
+	-2, 1392,
+	-1, 627,
+	57, 838,
+	-2, 1393,
+	-1, 633,
+	57, 913,
+	-2, 1285,
+	-1, 634,
+	57, 924,
+	-2, 1351,
+	-1, 635,
+	57, 926,
+	-2, 1361,
+	-1, 636,
+	57, 914,
+	-2, 1366,
+	-1, 947,
+	1, 542,
+	59, 542,
+	457, 542,
+	-2, 549,
+	-1, 1066,
+	19, 358,
+	-2, 738,
+	-1, 1116,
+	122, 1053,
+	-2, 1051,
+	-1, 1118,
+	122, 461,
+	-2, 1048,
+	-1, 1119,
+	122, 462,
+	-2, 1049,
+	-1, 1168,
+	1, 543,
+	59, 543,
+	457, 543,
+	-2, 549,
+	-1, 1608,
+	78, 549,
+	118, 549,
+	151, 549,
+	154, 549,
+	-2, 589,
+	-1, 1610,
+	250, 705,
+	-2, 685,
+	-1, 1727,
+	78, 549,
+	118, 549,
+	151, 549,
+	154, 549,
+	-2, 590,
+	-1, 1755,
+	250, 705,
+	-2, 686,
+	-1, 2197,
+	58, 564,
+	59, 564,
+	-2, 549,
+	-1, 2201,
+	58, 564,
+	59, 564,
+	-2, 549,
+	-1, 2213,
+	58, 568,
+	59, 568,
+	-2, 549,
+	-1, 2216,
+	58, 569,
+	59, 569,
+	-2, 549,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16993
+const yyLast = 17185
 
 var yyAct = [...]int{
-	749, 1168, 2072, 2070, 2069, 2077, 2043, 610, 2017, 1650,
-	738, 1914, 628, 1988, 2032, 1693, 1972, 1887, 1973, 1646,
-	547, 1864, 1823, 513, 83, 1648, 1526, 288, 810, 1105,
-	1875, 453, 1816, 299, 1649, 545, 1538, 86, 1330, 1443,
-	83, 301, 1682, 1793, 388, 1608, 1716, 1715, 1609, 333,
-	333, 1611, 500, 1439, 1412, 794, 581, 82, 1616, 608,
-	1620, 1444, 1448, 1459, 1590, 1477, 1305, 1421, 1108, 903,
-	1476, 1366, 389, 690, 294, 609, 517, 817, 410, 918,
-	921, 912, 83, 555, 3, 904, 619, 1169, 1246, 913,
-	1433, 292, 19, 1299, 1232, 732, 291, 12, 289, 6,
-	51, 735, 290, 5, 787, 762, 1657, 1116, 751, 733,
-	707, 1170, 339, 1183, 1167, 419, 574, 791, 338, 637,
-	52, 763, 764, 1087, 281, 491, 1078, 571, 284, 430,
-	812, 455, 409, 847, 381, 538, 724, 556, 441, 305,
-	1094, 470, 304, 79, 1735, 1642, 52, 1525, 746, 906,
-	308, 308, 407, 78, 1906, 23, 39, 24, 1090, 78,
-	1284, 303, 524, 340, 416, 78, 1413, 76, 78, 295,
-	1300, 19, 1931, 64, 1291, 400, 12, 71, 6, 781,
-	520, 78, 5, 490, 78, 395, 23, 39, 24, 405,
-	404, 397, 687, 399, 401, 684, 40, 350, 1294, 52,
-	766, 74, 78, 368, 23, 39, 24, 74, 1389, 525,
-	1960, 335, 1958, 74, 776, 777, 686, 512, 358, 403,
-	511, 514, 515, 514, 515, 1976, 1977, 741, 485, 74,
-	1992, 481, 74, 1814, 1416, 1895, 396, 1817, 1818, 1819,
-	1820, 1898, 1417, 382, 1418, 522, 1738, 1527, 745, 433,
-	74, 1422, 1423, 1424, 1425, 1271, 424, 1308, 1306, 1303,
-	1307, 1309, 788, 1302, 1301, 1460, 1463, 67, 68, 1090,
-	69, 70, 1308, 1306, 1092, 1307, 1309, 369, 1792, 1702,
-	1701, 83, 423, 472, 483, 484, 1905, 1522, 1698, 471,
-	1639, 422, 482, 725, 83, 1805, 1603, 1426, 1876, 1877,
-	1878, 1880, 1879, 1955, 352, 1602, 1599, 1962, 1799, 2062,
-	476, 2078, 1999, 402, 349, 348, 1957, 1462, 1975, 727,
-	457, 1916, 2006, 1939, 56, 66, 75, 437, 38, 1311,
-	1312, 1313, 1314, 1912, 1913, 344, 1916, 458, 477, 1787,
-	433, 2053, 1778, 1478, 65, 63, 62, 1889, 1908, 1909,
-	1756, 1755, 337, 1292, 1922, 421, 521, 1964, 1965, 534,
-	510, 509, 2073, 479, 2079, 406, 1489, 1486, 1487, 1488,
-	2044, 1483, 1744, 1482, 1481, 1479, 418, 1367, 333, 480,
-	501, 523, 1893, 467, 389, 389, 389, 1288, 400, 503,
-	1139, 435, 434, 726, 1600, 1098, 1452, 502, 392, 504,
-	1523, 462, 505, 293, 373, 52, 52, 401, 1328, 410,
-	474, 779, 577, 1135, 426, 427, 1618, 1617, 528, 353,
-	463, 689, 475, 478, 550, 1137, 1136, 1480, 780, 343,
-	48, 1134, 473, 526, 527, 1782, 49, 704, 1750, 423,
-	83, 83, 83, 83, 778, 370, 860, 2035, 708, 371,
-	2057, 721, 2021, 375, 374, 459, 460, 461, 548, 1419,
-	496, 1340, 1282, 1281, 1270, 1264, 1129, 333, 333, 423,
-	333, 394, 1103, 50, 1319, 457, 506, 493, 739, 308,
-	1072, 351, 435, 434, 829, 692, 722, 1907, 333, 333,
-	552, 1963, 458, 699, 700, 514, 515, 514, 515, 576,
-	1413, 333, 428, 333, 1453, 757, 436, 83, 495, 685,
-	1204, 559, 561, 1888, 549, 487, 558, 397, 560, 420,
-	789, 771, 544, 333, 756, 1093, 469, 1308, 1306, 1110,
-	1307, 1309, 1484, 1485, 801, 333, 389, 52, 333, 533,
-	759, 1285, 1598, 769, 77, 1601, 2036, 1780, 52, 1407,
-	77, 1779, 1405, 802, 518, 1089, 77, 695, 758, 77,
-	537, 570, 396, 2039, 557, 333, 333, 809, 83, 308,
-	410, 740, 77, 818, 772, 77, 703, 827, 516, 2030,
-	519, 392, 748, 743, 702, 752, 760, 761, 720, 813,
-	1849, 767, 507, 77, 768, 1505, 753, 1434, 744, 1406,
-	1926, 811, 1449, 1452, 308, 1088, 814, 737, 728, 709,
-	710, 711, 712, 1172, 1171, 877, 1266, 1141, 773, 747,
-	1076, 1317, 425, 742, 1783, 1784, 795, 541, 542, 543,
-	536, 1200, 795, 1197, 1247, 765, 308, 1199, 1196, 1198,
-	1202, 1203, 539, 804, 1247, 1201, 1372, 790, 564, 565,
-	566, 567, 568, 540, 394, 1377, 754, 1319, 2033, 2034,
-	807, 1164, 800, 824, 755, 1789, 830, 308, 1239, 1788,
-	786, 1594, 1165, 365, 825, 826, 824, 797, 798, 799,
-	508, 803, 1237, 1238, 1236, 1589, 805, 910, 910, 915,
-	878, 879, 880, 881, 72, 1969, 785, 826, 824, 806,
-	1177, 876, 815, 372, 1773, 1341, 818, 882, 2068, 884,
-	886, 1453, 2049, 923, 400, 887, 1446, 825, 826, 824,
-	1447, 1450, 825, 826, 824, 901, 1180, 808, 1860, 1858,
-	924, 1318, 551, 875, 854, 1182, 1185, 1186, 1187, 1188,
-	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1207, 1208, 1209,
-	1210, 1211, 1212, 1205, 1206, 459, 460, 461, 1540, 83,
-	459, 460, 461, 548, 1859, 1857, 288, 1826, 398, 825,
-	826, 824, 1451, 1131, 376, 893, 909, 1507, 2000, 917,
-	1074, 2052, 333, 1073, 813, 1375, 1996, 400, 1374, 825,
-	826, 824, 1119, 1944, 1891, 1850, 1852, 1853, 1854, 1851,
-	1856, 814, 333, 916, 1846, 2050, 401, 1890, 1866, 397,
-	1844, 825, 826, 824, 1541, 546, 52, 1647, 362, 549,
-	1843, 577, 2051, 83, 922, 1070, 363, 1071, 1842, 1161,
-	1162, 1839, 1833, 1120, 1121, 1122, 1855, 1830, 1083, 1123,
-	1845, 1829, 1132, 459, 460, 461, 548, 1178, 1179, 1086,
-	859, 858, 868, 869, 861, 862, 863, 864, 865, 866,
-	867, 860, 1796, 1736, 1117, 1730, 1097, 1729, 1728, 901,
-	1125, 1727, 1127, 868, 869, 861, 862, 863, 864, 865,
-	866, 867, 860, 308, 1347, 795, 795, 795, 1724, 1166,
-	1126, 765, 1128, 1154, 1124, 1534, 1254, 1157, 1533, 1532,
-	1531, 1401, 549, 1146, 693, 459, 460, 461, 576, 1993,
-	1968, 1865, 1158, 1159, 1160, 1142, 1143, 1144, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1147, 1175, 1148, 1241, 1242, 1566, 1954, 1155, 1933, 825,
-	826, 824, 1920, 1138, 1919, 1847, 1840, 2083, 1240, 1632,
-	1836, 1835, 1834, 1173, 1174, 1794, 1176, 1234, 1775, 1256,
-	1737, 1331, 1213, 1214, 1215, 1216, 1645, 1217, 1218, 1219,
-	1685, 1643, 1542, 1248, 1431, 360, 1251, 361, 368, 2027,
-	1430, 1429, 359, 357, 356, 364, 1631, 366, 367, 858,
-	868, 869, 861, 862, 863, 864, 865, 866, 867, 860,
-	1269, 1249, 1428, 1250, 1252, 1688, 1100, 1099, 825, 826,
-	824, 1683, 897, 1255, 1258, 1257, 896, 1696, 1697, 895,
-	694, 1554, 1684, 2082, 859, 858, 868, 869, 861, 862,
-	863, 864, 865, 866, 867, 860, 1573, 1577, 1579, 1581,
-	1583, 1584, 1586, 2060, 1489, 1486, 1487, 1488, 1812, 1568,
-	1569, 1570, 1571, 1552, 1553, 1574, 1689, 1555, 1941, 1556,
-	1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1572,
-	825, 826, 824, 1272, 1106, 1107, 423, 1576, 1578, 1580,
-	1582, 1585, 1940, 1380, 1927, 708, 1343, 1379, 1343, 2087,
-	1873, 333, 2038, 871, 333, 874, 1807, 423, 342, 333,
-	2081, 2080, 1096, 2063, 1297, 1567, 1287, 1806, 341, 872,
-	873, 870, 1633, 859, 858, 868, 869, 861, 862, 863,
-	864, 865, 866, 867, 860, 2059, 2058, 825, 826, 824,
-	1325, 1695, 1630, 1445, 863, 864, 865, 866, 867, 860,
-	333, 861, 862, 863, 864, 865, 866, 867, 860, 563,
-	83, 83, 1096, 2047, 1336, 1096, 2046, 1629, 1691, 1102,
-	2020, 2019, 1316, 1607, 1276, 1740, 1983, 1277, 1740, 1978,
-	1279, 1543, 833, 834, 835, 836, 837, 838, 1348, 831,
-	1690, 1692, 1514, 1333, 1334, 1804, 1275, 1274, 1626, 1295,
-	1296, 1465, 752, 397, 1289, 1286, 1101, 1321, 1150, 1966,
-	1283, 1464, 1322, 1383, 1323, 1513, 1381, 825, 826, 824,
-	825, 826, 824, 1298, 1952, 1951, 1504, 1740, 1937, 825,
-	826, 824, 1117, 1315, 1740, 1936, 1326, 825, 826, 824,
-	1740, 1935, 1698, 1498, 1324, 1329, 1335, 1361, 825, 826,
-	824, 1740, 1934, 19, 1686, 1378, 1332, 1376, 12, 1352,
-	6, 1497, 1925, 1924, 5, 825, 826, 824, 1344, 1349,
-	1496, 1345, 1346, 910, 1342, 1393, 910, 1871, 1872, 1396,
-	1495, 52, 1327, 825, 826, 824, 1871, 1870, 1253, 818,
-	723, 333, 825, 826, 824, 333, 333, 1811, 1810, 333,
-	562, 1399, 825, 826, 824, 1494, 1809, 1808, 1364, 1365,
-	1575, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1400, 1740,
-	1739, 83, 1343, 1363, 2025, 1493, 1388, 825, 826, 824,
-	1259, 423, 1395, 1234, 1153, 1517, 1343, 1499, 1343, 1490,
-	1442, 1362, 1369, 1392, 1371, 1373, 400, 825, 826, 824,
-	83, 1470, 1343, 1351, 1432, 1385, 1384, 1394, 795, 1544,
-	1397, 1398, 1391, 1390, 795, 875, 1403, 691, 1402, 859,
-	858, 868, 869, 861, 862, 863, 864, 865, 866, 867,
-	860, 1427, 1343, 1350, 1153, 1273, 1404, 1268, 1267, 2029,
-	1492, 52, 822, 1475, 1411, 466, 1408, 1410, 1262, 1261,
-	1153, 1152, 1096, 1095, 2023, 1474, 1454, 1455, 2007, 1473,
-	1075, 1456, 825, 826, 824, 825, 826, 824, 1435, 1436,
-	333, 1509, 697, 696, 1469, 1243, 1511, 825, 826, 824,
-	1470, 825, 826, 824, 486, 1503, 820, 1090, 465, 467,
-	464, 1472, 1515, 1339, 465, 1500, 467, 825, 826, 824,
-	1265, 1491, 1512, 1502, 1244, 1508, 1588, 1150, 1104, 569,
-	78, 535, 2004, 2002, 691, 1943, 1539, 1885, 1869, 1516,
-	1506, 438, 1867, 1862, 1821, 1510, 1802, 1801, 1800, 1537,
-	1606, 1797, 443, 446, 447, 448, 444, 1786, 445, 449,
-	1521, 1771, 1610, 443, 446, 447, 448, 444, 1530, 445,
-	449, 1712, 1709, 1535, 1708, 1612, 1985, 1621, 74, 1624,
-	1595, 1592, 1536, 1235, 1320, 1587, 1278, 1260, 1551, 1151,
-	1591, 1518, 1591, 1140, 1593, 1133, 572, 902, 900, 899,
-	333, 333, 1597, 898, 83, 894, 848, 1613, 1614, 1615,
-	1596, 891, 889, 888, 885, 74, 857, 423, 1501, 856,
-	855, 853, 852, 851, 1619, 423, 1654, 850, 1622, 849,
-	1625, 846, 845, 844, 1442, 1605, 843, 1640, 1627, 859,
-	858, 868, 869, 861, 862, 863, 864, 865, 866, 867,
-	860, 1635, 842, 841, 840, 839, 1638, 705, 1634, 688,
-	443, 446, 447, 448, 444, 1699, 445, 449, 468, 1798,
-	1717, 1719, 1113, 1717, 1717, 1628, 1703, 2012, 1679, 2010,
-	1706, 1707, 1705, 795, 1704, 1079, 1080, 1974, 1310, 1149,
-	1082, 488, 302, 717, 1710, 715, 1713, 1714, 718, 1382,
-	716, 1636, 1637, 859, 858, 868, 869, 861, 862, 863,
-	864, 865, 866, 867, 860, 1085, 719, 1718, 447, 448,
-	1720, 1721, 1084, 1726, 714, 713, 2067, 1722, 1263, 553,
-	554, 1118, 1106, 1107, 1519, 1414, 1746, 492, 1111, 775,
-	816, 1520, 334, 1368, 1733, 859, 858, 868, 869, 861,
-	862, 863, 864, 865, 866, 867, 860, 412, 414, 415,
-	1069, 451, 494, 1723, 859, 858, 868, 869, 861, 862,
-	863, 864, 865, 866, 867, 860, 1741, 1172, 1171, 83,
-	498, 499, 1749, 2024, 1948, 1946, 1900, 1899, 1897, 1827,
-	1539, 1822, 1644, 1604, 1529, 1528, 1468, 342, 497, 341,
-	1731, 1699, 1467, 1719, 1772, 691, 1776, 341, 1338, 2014,
-	2013, 2013, 1790, 1353, 1280, 280, 2014, 450, 354, 1742,
-	1, 423, 701, 432, 698, 1774, 1747, 1748, 1828, 1751,
-	1752, 1753, 1754, 1795, 431, 1757, 1758, 1759, 1760, 1761,
-	1762, 1763, 1764, 1765, 1766, 1767, 1768, 1769, 1770, 1803,
-	1861, 1825, 429, 73, 1245, 1824, 1184, 638, 905, 911,
-	457, 1863, 1984, 2016, 1942, 1987, 627, 611, 1892, 1415,
-	1813, 1841, 1894, 1815, 1293, 1732, 1290, 458, 423, 489,
-	1386, 423, 423, 423, 859, 858, 868, 869, 861, 862,
-	863, 864, 865, 866, 867, 860, 1387, 650, 640, 890,
-	641, 683, 1902, 1874, 413, 639, 1882, 1883, 1884, 1725,
-	1881, 1461, 347, 411, 355, 1791, 1524, 1700, 1623, 1711,
-	1831, 1832, 1903, 1181, 2076, 2066, 1837, 1838, 1896, 2042,
-	2022, 1915, 2061, 1956, 2005, 1998, 1910, 1911, 1743, 306,
-	782, 529, 379, 83, 1886, 386, 706, 1917, 1918, 1420,
-	423, 1304, 1109, 1091, 734, 307, 1904, 1868, 345, 1112,
-	346, 1115, 1114, 832, 1233, 892, 423, 883, 579, 1370,
-	618, 1923, 612, 1458, 1457, 1932, 811, 1694, 770, 26,
-	452, 823, 919, 85, 1130, 920, 1901, 1734, 1989, 626,
-	625, 1938, 624, 623, 442, 440, 439, 1947, 298, 1949,
-	1950, 1945, 297, 1337, 1466, 819, 821, 1971, 1970, 1929,
-	1930, 1641, 1785, 1848, 1781, 1777, 1959, 1961, 1921, 1653,
-	1652, 1680, 1681, 1687, 1991, 1550, 1546, 1967, 1548, 1549,
-	1547, 1545, 1440, 1995, 1441, 1928, 1990, 1979, 1980, 1981,
-	1982, 1438, 1437, 1081, 1077, 907, 914, 417, 1994, 750,
-	80, 296, 1156, 573, 11, 18, 1997, 17, 16, 47,
-	46, 45, 44, 15, 8, 43, 42, 2008, 41, 14,
-	2011, 2009, 13, 2018, 37, 36, 1953, 35, 34, 2015,
-	33, 32, 31, 423, 30, 423, 29, 28, 27, 9,
-	55, 54, 739, 2026, 739, 2028, 53, 20, 2001, 21,
-	2003, 1991, 2041, 22, 61, 60, 59, 58, 57, 2037,
-	423, 25, 10, 1990, 2040, 7, 2045, 4, 2, 739,
-	2048, 0, 0, 0, 0, 0, 2018, 2054, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2064, 0,
-	0, 0, 0, 0, 0, 0, 2065, 2031, 0, 0,
-	0, 0, 0, 2075, 0, 2074, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2086, 2085, 2084, 2075, 0,
-	0, 0, 1037, 1023, 0, 985, 1039, 957, 973, 1047,
-	975, 976, 1010, 935, 994, 210, 971, 927, 960, 961,
-	929, 968, 930, 958, 987, 154, 956, 1026, 997, 179,
-	1045, 181, 0, 0, 239, 194, 0, 2056, 990, 1028,
-	992, 1015, 984, 1011, 943, 1004, 1040, 972, 1008, 1041,
-	0, 0, 0, 0, 459, 460, 461, 0, 0, 0,
-	0, 137, 0, 0, 0, 0, 0, 1007, 1033, 970,
-	0, 0, 944, 1038, 991, 1009, 0, 928, 1005, 0,
-	933, 936, 1046, 1031, 965, 966, 0, 0, 0, 0,
-	0, 0, 0, 988, 993, 1012, 981, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 962, 0, 1001, 0,
-	0, 0, 938, 934, 0, 986, 0, 128, 244, 258,
-	138, 235, 271, 142, 242, 134, 209, 231, 130, 256,
-	241, 191, 173, 174, 129, 0, 226, 152, 165, 149,
-	207, 1035, 1036, 148, 274, 937, 266, 132, 133, 265,
-	206, 253, 257, 192, 186, 131, 255, 190, 185, 177,
-	156, 169, 219, 184, 220, 170, 196, 195, 197, 1057,
-	1058, 1059, 1060, 1061, 942, 0, 963, 1013, 0, 926,
-	1022, 1029, 983, 268, 1032, 980, 979, 1064, 0, 1063,
-	243, 1065, 1066, 178, 1027, 959, 969, 964, 967, 229,
-	212, 1034, 1000, 217, 227, 182, 254, 221, 259, 245,
-	267, 1016, 222, 124, 246, 151, 193, 135, 136, 147,
-	153, 155, 157, 158, 202, 203, 215, 234, 247, 248,
-	249, 150, 143, 228, 144, 167, 145, 125, 236, 146,
-	126, 216, 252, 1062, 164, 224, 189, 127, 188, 218,
-	251, 250, 275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 925, 263, 0, 208, 1024, 931, 941,
-	939, 977, 1002, 1003, 204, 279, 1018, 1021, 1019, 1048,
-	232, 0, 0, 0, 0, 0, 172, 214, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	932, 0, 240, 261, 273, 264, 978, 950, 989, 272,
-	953, 951, 1017, 952, 1006, 1050, 198, 199, 200, 201,
-	974, 0, 141, 998, 982, 1051, 1052, 1053, 1054, 1055,
-	1056, 955, 1030, 160, 166, 0, 168, 140, 213, 163,
-	270, 175, 205, 171, 237, 176, 183, 225, 269, 211,
-	230, 139, 260, 238, 187, 162, 949, 954, 948, 995,
-	996, 1042, 1043, 1044, 1014, 940, 1025, 945, 947, 946,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1020,
-	999, 123, 0, 180, 1049, 223, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 78, 0, 646,
-	0, 0, 0, 1067, 1068, 276, 277, 278, 262, 210,
-	0, 0, 0, 0, 0, 620, 0, 0, 0, 154,
-	0, 0, 0, 179, 0, 181, 0, 0, 239, 194,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 613, 0, 0, 580, 652,
-	651, 629, 0, 0, 0, 137, 630, 0, 635, 0,
-	631, 634, 632, 633, 0, 0, 654, 0, 0, 0,
-	0, 0, 578, 617, 0, 621, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 614, 615, 0, 0,
-	0, 0, 647, 0, 616, 0, 0, 649, 0, 636,
-	0, 128, 244, 258, 138, 235, 271, 142, 242, 134,
-	209, 231, 130, 256, 241, 191, 173, 174, 129, 0,
-	226, 152, 165, 149, 207, 644, 645, 148, 606, 642,
-	266, 132, 133, 265, 206, 253, 257, 192, 186, 131,
-	255, 190, 185, 177, 156, 169, 219, 184, 220, 170,
-	196, 195, 197, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 0,
-	660, 0, 0, 0, 243, 0, 0, 178, 0, 0,
-	0, 643, 0, 229, 212, 671, 0, 217, 227, 182,
-	254, 221, 259, 245, 267, 0, 222, 124, 246, 151,
-	193, 135, 136, 147, 153, 155, 157, 158, 202, 203,
-	215, 234, 247, 248, 249, 150, 143, 228, 144, 167,
-	145, 125, 236, 146, 126, 216, 252, 0, 164, 224,
-	189, 127, 188, 218, 251, 250, 275, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 263, 658,
-	208, 670, 653, 655, 656, 659, 663, 664, 604, 607,
-	665, 667, 669, 672, 232, 0, 0, 0, 0, 0,
-	172, 214, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 240, 261, 273, 605,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 648,
-	198, 199, 200, 201, 661, 0, 141, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 160, 166, 0,
-	168, 140, 213, 163, 270, 175, 205, 171, 237, 176,
-	183, 225, 269, 211, 230, 139, 260, 238, 187, 162,
-	678, 657, 677, 679, 680, 676, 681, 682, 666, 622,
-	0, 674, 673, 675, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 180, 77, 223,
-	159, 87, 582, 583, 584, 585, 586, 587, 588, 95,
-	589, 97, 98, 590, 100, 591, 102, 592, 104, 105,
-	106, 593, 594, 595, 596, 111, 597, 598, 599, 600,
-	116, 117, 118, 119, 601, 602, 603, 646, 0, 276,
-	277, 278, 262, 0, 0, 0, 0, 210, 0, 0,
-	0, 0, 0, 620, 0, 0, 0, 154, 796, 0,
-	0, 179, 0, 181, 0, 0, 239, 194, 0, 0,
-	0, 0, 662, 668, 0, 0, 0, 0, 0, 0,
-	792, 0, 0, 613, 0, 0, 580, 652, 651, 629,
-	0, 0, 0, 137, 630, 0, 635, 0, 631, 634,
-	632, 633, 0, 0, 654, 0, 0, 0, 0, 0,
-	578, 617, 0, 621, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 614, 615, 0, 0, 0, 0,
-	647, 0, 616, 0, 0, 793, 0, 636, 0, 128,
-	244, 258, 138, 235, 271, 142, 242, 134, 209, 231,
-	130, 256, 241, 191, 173, 174, 129, 0, 226, 152,
-	165, 149, 207, 644, 645, 148, 606, 642, 266, 132,
-	133, 265, 206, 253, 257, 192, 186, 131, 255, 190,
-	185, 177, 156, 169, 219, 184, 220, 170, 196, 195,
-	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 0, 660, 0,
-	0, 0, 243, 0, 0, 178, 0, 0, 0, 643,
-	0, 229, 212, 671, 0, 217, 227, 182, 254, 221,
-	259, 245, 267, 0, 222, 124, 246, 151, 193, 135,
-	136, 147, 153, 155, 157, 158, 202, 203, 215, 234,
-	247, 248, 249, 150, 143, 228, 144, 167, 145, 125,
-	236, 146, 126, 216, 252, 0, 164, 224, 189, 127,
-	188, 218, 251, 250, 275, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 0, 263, 658, 208, 670,
-	653, 655, 656, 659, 663, 664, 604, 607, 665, 667,
-	669, 672, 232, 0, 0, 0, 0, 0, 172, 214,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 261, 273, 605, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 648, 198, 199,
-	200, 201, 661, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 160, 166, 0, 168, 140,
-	213, 163, 270, 175, 205, 171, 237, 176, 183, 225,
-	269, 211, 230, 139, 260, 238, 187, 162, 678, 657,
-	677, 679, 680, 676, 681, 682, 666, 622, 0, 674,
-	673, 675, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 180, 0, 223, 159, 87,
-	582, 583, 584, 585, 586, 587, 588, 95, 589, 97,
-	98, 590, 100, 591, 102, 592, 104, 105, 106, 593,
-	594, 595, 596, 111, 597, 598, 599, 600, 116, 117,
-	118, 119, 601, 602, 603, 646, 0, 276, 277, 278,
-	262, 0, 0, 0, 0, 210, 0, 0, 0, 0,
-	0, 620, 0, 0, 0, 154, 2055, 0, 0, 179,
-	0, 181, 0, 0, 239, 194, 0, 0, 0, 0,
-	662, 668, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 613, 0, 0, 580, 652, 651, 629, 0, 0,
-	0, 137, 630, 0, 635, 0, 631, 634, 632, 633,
-	0, 0, 654, 0, 0, 0, 0, 0, 578, 617,
-	0, 621, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 614, 615, 0, 0, 0, 0, 647, 0,
-	616, 0, 0, 649, 0, 636, 0, 128, 244, 258,
-	138, 235, 271, 142, 242, 134, 209, 231, 130, 256,
-	241, 191, 173, 174, 129, 0, 226, 152, 165, 149,
-	207, 644, 645, 148, 606, 642, 266, 132, 133, 265,
-	206, 253, 257, 192, 186, 131, 255, 190, 185, 177,
-	156, 169, 219, 184, 220, 170, 196, 195, 197, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 0, 660, 0, 0, 0,
-	243, 0, 0, 178, 0, 0, 0, 643, 0, 229,
-	212, 671, 0, 217, 227, 182, 254, 221, 259, 245,
-	267, 0, 222, 124, 246, 151, 193, 135, 136, 147,
-	153, 155, 157, 158, 202, 203, 215, 234, 247, 248,
-	249, 150, 143, 228, 144, 167, 145, 125, 236, 146,
-	126, 216, 252, 0, 164, 224, 189, 127, 188, 218,
-	251, 250, 275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 0, 263, 658, 208, 670, 653, 655,
-	656, 659, 663, 664, 604, 607, 665, 667, 669, 672,
-	232, 0, 0, 0, 0, 0, 172, 214, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 240, 261, 273, 605, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 648, 198, 199, 200, 201,
-	661, 0, 141, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 160, 166, 0, 168, 140, 213, 163,
-	270, 175, 205, 171, 237, 176, 183, 225, 269, 211,
-	230, 139, 260, 238, 187, 162, 678, 657, 677, 679,
-	680, 676, 681, 682, 666, 622, 0, 674, 673, 675,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 123, 0, 180, 0, 223, 159, 87, 582, 583,
-	584, 585, 586, 587, 588, 95, 589, 97, 98, 590,
-	100, 591, 102, 592, 104, 105, 106, 593, 594, 595,
-	596, 111, 597, 598, 599, 600, 116, 117, 118, 119,
-	601, 602, 603, 646, 0, 276, 277, 278, 262, 0,
-	0, 0, 0, 210, 0, 0, 0, 0, 0, 620,
-	0, 0, 0, 154, 796, 0, 0, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 662, 668,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 613,
-	0, 0, 580, 652, 651, 629, 0, 0, 0, 137,
-	630, 0, 635, 0, 631, 634, 632, 633, 0, 0,
-	654, 0, 0, 0, 0, 0, 578, 617, 0, 621,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	614, 615, 0, 0, 0, 0, 647, 0, 616, 0,
-	0, 649, 0, 636, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 644,
-	645, 148, 606, 642, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 0, 660, 0, 0, 0, 243, 0,
-	0, 178, 0, 0, 0, 643, 0, 229, 212, 671,
-	0, 217, 227, 182, 254, 221, 259, 245, 267, 0,
-	222, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 0, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 0, 263, 658, 208, 670, 653, 655, 656, 659,
-	663, 664, 604, 607, 665, 667, 669, 672, 232, 0,
-	0, 0, 0, 0, 172, 214, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 261, 273, 605, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 648, 198, 199, 200, 201, 661, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 0, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 678, 657, 677, 679, 680, 676,
-	681, 682, 666, 622, 0, 674, 673, 675, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 87, 582, 583, 584, 585,
-	586, 587, 588, 95, 589, 97, 98, 590, 100, 591,
-	102, 592, 104, 105, 106, 593, 594, 595, 596, 111,
-	597, 598, 599, 600, 116, 117, 118, 119, 601, 602,
-	603, 646, 0, 276, 277, 278, 262, 0, 0, 0,
-	0, 210, 0, 0, 0, 0, 0, 620, 0, 0,
-	0, 154, 0, 0, 0, 179, 0, 181, 0, 0,
-	239, 194, 0, 0, 0, 0, 662, 668, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 613, 0, 0,
-	580, 652, 651, 629, 0, 0, 0, 137, 630, 0,
-	635, 0, 631, 634, 632, 633, 0, 0, 654, 0,
-	0, 0, 0, 0, 578, 617, 0, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 615,
-	575, 0, 0, 0, 647, 0, 616, 0, 0, 649,
-	0, 636, 0, 128, 244, 258, 138, 235, 271, 142,
-	242, 134, 209, 231, 130, 256, 241, 191, 173, 174,
-	129, 0, 226, 152, 165, 149, 207, 644, 645, 148,
-	606, 642, 266, 132, 133, 265, 206, 253, 257, 192,
-	186, 131, 255, 190, 185, 177, 156, 169, 219, 184,
-	220, 170, 196, 195, 197, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 0, 660, 0, 0, 0, 243, 0, 0, 178,
-	0, 0, 0, 643, 0, 229, 212, 671, 0, 217,
-	227, 182, 254, 221, 259, 245, 267, 0, 222, 124,
-	246, 151, 193, 135, 136, 147, 153, 155, 157, 158,
-	202, 203, 215, 234, 247, 248, 249, 150, 143, 228,
-	144, 167, 145, 125, 236, 146, 126, 216, 252, 0,
-	164, 224, 189, 127, 188, 218, 251, 250, 275, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
-	263, 658, 208, 670, 653, 655, 656, 659, 663, 664,
-	604, 607, 665, 667, 669, 672, 232, 0, 0, 0,
-	0, 0, 172, 214, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 261,
-	273, 605, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 648, 198, 199, 200, 201, 661, 0, 141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-	166, 0, 168, 140, 213, 163, 270, 175, 205, 171,
-	237, 176, 183, 225, 269, 211, 230, 139, 260, 238,
-	187, 162, 678, 657, 677, 679, 680, 676, 681, 682,
-	666, 622, 0, 674, 673, 675, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 180,
-	0, 223, 159, 87, 582, 583, 584, 585, 586, 587,
-	588, 95, 589, 97, 98, 590, 100, 591, 102, 592,
-	104, 105, 106, 593, 594, 595, 596, 111, 597, 598,
-	599, 600, 116, 117, 118, 119, 601, 602, 603, 646,
-	0, 276, 277, 278, 262, 0, 0, 0, 0, 210,
-	0, 0, 0, 0, 0, 620, 0, 0, 0, 154,
-	0, 0, 0, 179, 0, 181, 0, 0, 239, 194,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 613, 0, 0, 580, 652,
-	651, 629, 0, 0, 0, 137, 630, 0, 635, 0,
-	631, 634, 632, 633, 0, 0, 654, 0, 0, 0,
-	0, 0, 578, 617, 0, 621, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 614, 615, 0, 0,
-	0, 0, 647, 0, 616, 0, 0, 649, 0, 636,
-	0, 128, 244, 258, 138, 235, 271, 142, 242, 134,
-	209, 231, 130, 256, 241, 191, 173, 174, 129, 0,
-	226, 152, 165, 149, 207, 644, 645, 148, 606, 642,
-	266, 132, 133, 265, 206, 253, 257, 192, 186, 131,
-	255, 190, 185, 177, 156, 169, 219, 184, 220, 170,
-	196, 195, 197, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 0,
-	660, 0, 0, 0, 243, 0, 0, 178, 0, 0,
-	0, 643, 0, 229, 212, 671, 0, 217, 227, 182,
-	254, 221, 259, 245, 267, 0, 222, 124, 246, 151,
-	193, 135, 136, 147, 153, 155, 157, 158, 202, 203,
-	215, 234, 247, 248, 249, 150, 143, 228, 144, 167,
-	145, 125, 236, 146, 126, 216, 252, 0, 164, 224,
-	189, 127, 188, 218, 251, 250, 275, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 263, 658,
-	208, 670, 653, 655, 656, 659, 663, 664, 604, 607,
-	665, 667, 669, 672, 232, 0, 0, 0, 0, 0,
-	172, 214, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 240, 261, 273, 605,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 648,
-	198, 199, 200, 201, 661, 0, 141, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 160, 166, 0,
-	168, 140, 213, 163, 270, 175, 205, 171, 237, 176,
-	183, 225, 269, 211, 230, 139, 260, 238, 187, 162,
-	678, 657, 677, 679, 680, 676, 681, 682, 666, 622,
-	0, 674, 673, 675, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 180, 0, 223,
-	159, 87, 582, 583, 584, 585, 586, 587, 588, 95,
-	589, 97, 98, 590, 100, 591, 102, 592, 104, 105,
-	106, 593, 594, 595, 596, 111, 597, 598, 599, 600,
-	116, 117, 118, 119, 601, 602, 603, 646, 0, 276,
-	277, 278, 262, 0, 0, 0, 0, 210, 0, 0,
-	0, 0, 0, 620, 0, 0, 0, 154, 0, 0,
-	0, 179, 0, 181, 0, 0, 239, 194, 0, 0,
-	0, 0, 662, 668, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 613, 0, 0, 580, 652, 651, 629,
-	0, 0, 0, 137, 630, 0, 635, 0, 631, 634,
-	632, 633, 0, 0, 654, 0, 0, 0, 0, 0,
-	0, 617, 0, 621, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 614, 615, 0, 0, 0, 0,
-	647, 0, 616, 0, 0, 649, 0, 636, 0, 128,
-	244, 258, 138, 235, 271, 142, 242, 134, 209, 231,
-	130, 256, 241, 191, 173, 174, 129, 0, 226, 152,
-	165, 149, 207, 644, 645, 148, 606, 642, 266, 132,
-	133, 265, 206, 253, 257, 192, 186, 131, 255, 190,
-	185, 177, 156, 169, 219, 184, 220, 170, 196, 195,
-	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 0, 660, 0,
-	0, 0, 243, 0, 0, 178, 0, 0, 0, 643,
-	0, 229, 212, 671, 0, 217, 227, 182, 254, 221,
-	259, 245, 267, 0, 222, 124, 246, 151, 193, 135,
-	136, 147, 153, 155, 157, 158, 202, 203, 215, 234,
-	247, 248, 249, 150, 143, 228, 144, 167, 145, 125,
-	236, 146, 126, 216, 252, 0, 164, 224, 189, 127,
-	188, 218, 251, 250, 275, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 0, 263, 658, 208, 670,
-	653, 655, 656, 659, 663, 664, 604, 607, 665, 667,
-	669, 672, 232, 0, 0, 0, 0, 0, 172, 214,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 261, 273, 605, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 648, 198, 199,
-	200, 201, 661, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 160, 166, 0, 168, 140,
-	213, 163, 270, 175, 205, 171, 237, 176, 183, 225,
-	269, 211, 230, 139, 260, 238, 187, 162, 678, 657,
-	677, 679, 680, 676, 681, 682, 666, 622, 0, 674,
-	673, 675, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 180, 0, 223, 159, 87,
-	582, 583, 584, 585, 586, 587, 588, 95, 589, 97,
-	98, 590, 100, 591, 102, 592, 104, 105, 106, 593,
-	594, 595, 596, 111, 597, 598, 599, 600, 116, 117,
-	118, 119, 601, 602, 603, 0, 0, 276, 277, 278,
-	262, 318, 0, 317, 321, 313, 0, 0, 0, 0,
-	0, 0, 0, 210, 0, 309, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 328, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 332, 0, 0, 0, 137,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 318, 0, 317, 321, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 309, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
-	0, 0, 0, 0, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 0,
-	0, 148, 274, 0, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 311, 310, 314, 0, 0, 0, 0, 0,
-	316, 268, 0, 0, 0, 0, 0, 0, 243, 0,
-	0, 178, 320, 0, 0, 0, 0, 229, 212, 0,
-	0, 217, 227, 182, 254, 221, 312, 245, 267, 0,
-	336, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 0, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 311, 310, 314, 0, 0,
-	161, 0, 263, 316, 208, 0, 0, 0, 0, 0,
-	0, 0, 204, 279, 0, 320, 0, 0, 232, 0,
-	0, 0, 315, 319, 322, 214, 323, 324, 0, 729,
-	325, 326, 327, 0, 0, 329, 330, 0, 0, 0,
-	240, 261, 273, 264, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 198, 199, 200, 201, 0, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 0, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 319, 730, 0, 323,
-	731, 0, 0, 325, 326, 327, 0, 0, 329, 330,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 0, 0, 276, 277, 278, 262, 318, 0, 317,
-	321, 313, 0, 0, 0, 0, 0, 0, 0, 210,
-	0, 309, 0, 0, 0, 0, 0, 0, 0, 154,
-	0, 0, 328, 179, 0, 181, 0, 0, 239, 194,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 0,
-	0, 332, 0, 0, 0, 137, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 128, 244, 258, 138, 235, 271, 142, 242, 134,
-	209, 231, 130, 256, 241, 191, 173, 174, 129, 0,
-	226, 152, 165, 149, 207, 0, 0, 148, 274, 0,
-	266, 132, 133, 265, 206, 253, 257, 192, 186, 131,
-	255, 190, 185, 177, 156, 169, 219, 184, 220, 170,
-	196, 195, 197, 0, 0, 0, 0, 0, 311, 310,
-	314, 0, 0, 0, 0, 0, 316, 268, 0, 0,
-	0, 0, 0, 0, 243, 0, 0, 178, 320, 0,
-	0, 0, 0, 229, 212, 0, 0, 217, 227, 182,
-	254, 221, 312, 245, 267, 0, 222, 124, 246, 151,
-	193, 135, 136, 147, 153, 155, 157, 158, 202, 203,
-	215, 234, 247, 248, 249, 150, 143, 228, 144, 167,
-	145, 125, 236, 146, 126, 216, 252, 0, 164, 224,
-	189, 127, 188, 218, 251, 250, 275, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 263, 0,
-	208, 0, 0, 0, 0, 0, 0, 0, 204, 279,
-	0, 0, 0, 0, 232, 0, 0, 0, 315, 319,
-	322, 214, 323, 324, 0, 0, 325, 326, 327, 0,
-	0, 329, 330, 0, 0, 0, 240, 261, 273, 264,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 0,
-	198, 199, 200, 201, 0, 0, 141, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 160, 166, 0,
-	168, 140, 213, 163, 270, 175, 205, 171, 237, 176,
-	183, 225, 269, 211, 230, 139, 260, 238, 187, 162,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 180, 0, 223,
-	159, 87, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 0, 0, 276,
-	277, 278, 262, 78, 0, 23, 39, 24, 0, 0,
-	0, 0, 0, 0, 0, 210, 282, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 0, 0, 0, 179,
-	0, 181, 0, 0, 239, 194, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 84, 0, 0, 0, 0, 0,
-	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 128, 244, 258,
-	138, 235, 271, 142, 242, 134, 209, 231, 130, 256,
-	241, 191, 173, 174, 129, 0, 226, 152, 165, 149,
-	207, 0, 0, 148, 274, 0, 266, 132, 133, 265,
-	206, 253, 257, 192, 186, 131, 255, 190, 185, 177,
-	156, 169, 219, 184, 220, 170, 196, 195, 197, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
-	0, 0, 0, 268, 0, 0, 0, 0, 0, 0,
-	243, 0, 0, 178, 0, 0, 0, 0, 0, 229,
-	212, 0, 0, 217, 227, 182, 254, 221, 259, 245,
-	267, 0, 222, 124, 246, 151, 193, 135, 136, 147,
-	153, 155, 157, 158, 202, 203, 215, 234, 247, 248,
-	249, 150, 143, 228, 144, 167, 145, 125, 236, 146,
-	126, 216, 252, 0, 164, 224, 189, 127, 188, 218,
-	251, 250, 275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 0, 263, 0, 208, 0, 0, 0,
-	0, 0, 0, 0, 204, 279, 0, 0, 0, 0,
-	232, 0, 0, 0, 0, 0, 172, 214, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 240, 261, 273, 264, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 0, 198, 199, 200, 201,
-	283, 285, 141, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 160, 166, 0, 168, 140, 213, 163,
-	270, 175, 205, 171, 237, 176, 183, 225, 269, 211,
-	230, 139, 260, 238, 187, 162, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 123, 0, 180, 77, 223, 159, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 210, 0, 276, 277, 278, 262, 0,
-	0, 0, 0, 154, 0, 0, 0, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 0, 0, 0, 0, 0, 0, 137,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1449, 1452, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 0,
-	0, 148, 274, 0, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1453, 268, 0, 0, 0, 1446, 0, 1445, 243, 1447,
-	1450, 178, 0, 0, 0, 0, 0, 229, 212, 0,
-	0, 217, 227, 182, 254, 221, 259, 245, 267, 0,
-	222, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 1451, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 0, 263, 0, 208, 0, 0, 0, 0, 0,
-	0, 0, 204, 279, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 0, 172, 214, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 261, 273, 264, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 198, 199, 200, 201, 0, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 0, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 87, 88, 89, 90, 91,
+	912, 1219, 2203, 2201, 2200, 2208, 2171, 639, 2143, 1724,
+	2023, 637, 929, 658, 2057, 2111, 2158, 1768, 2092, 1988,
+	2093, 1923, 1720, 1964, 542, 85, 85, 641, 300, 1591,
+	1806, 1722, 291, 314, 574, 1916, 478, 474, 1976, 1791,
+	1220, 1708, 85, 316, 1879, 1158, 1603, 576, 88, 1723,
+	1502, 348, 348, 1756, 1673, 405, 1388, 1535, 84, 292,
+	1610, 1790, 1674, 1498, 1676, 1472, 984, 1685, 529, 1681,
+	1518, 610, 1507, 1655, 406, 873, 1363, 1503, 1480, 926,
+	427, 1161, 1534, 1097, 85, 354, 1422, 923, 638, 1006,
+	584, 720, 1106, 1107, 546, 1098, 1432, 1299, 53, 648,
+	304, 20, 1283, 977, 1357, 1731, 941, 1169, 303, 13,
+	3, 668, 54, 301, 6, 893, 603, 436, 1492, 302,
+	5, 952, 1234, 924, 1218, 981, 953, 954, 307, 318,
+	323, 323, 293, 600, 1129, 1221, 516, 1001, 296, 54,
+	451, 480, 1036, 1141, 722, 426, 398, 915, 320, 414,
+	464, 585, 1148, 308, 495, 81, 319, 1820, 567, 1716,
+	412, 1590, 309, 937, 1901, 1100, 424, 355, 1902, 1903,
+	78, 1899, 1900, 2140, 2141, 417, 1699, 1078, 1077, 2060,
+	2194, 350, 20, 1910, 2000, 1064, 1065, 416, 418, 2015,
+	13, 2182, 433, 54, 2054, 6, 1912, 2164, 1997, 1144,
+	1343, 5, 2139, 1473, 353, 1358, 2052, 80, 659, 666,
+	1573, 553, 2040, 660, 1815, 665, 1350, 661, 664, 662,
+	663, 2058, 549, 515, 2080, 1631, 2078, 659, 666, 551,
+	1995, 1807, 660, 375, 665, 1462, 661, 664, 662, 663,
+	1353, 1905, 413, 1048, 1047, 1057, 1058, 1050, 1051, 1052,
+	1053, 1054, 1055, 1056, 1049, 956, 76, 554, 932, 422,
+	421, 399, 966, 967, 510, 80, 80, 24, 41, 25,
+	541, 506, 540, 543, 544, 2115, 80, 80, 24, 41,
+	25, 543, 544, 1917, 1918, 1919, 1920, 1914, 2004, 420,
+	718, 85, 1476, 715, 444, 1477, 2007, 1478, 1823, 1592,
+	936, 1328, 454, 443, 1450, 445, 85, 1481, 1482, 1483,
+	1484, 1522, 1619, 1519, 76, 717, 1366, 1364, 1144, 1365,
+	1367, 1146, 2014, 439, 440, 76, 76, 1638, 1642, 1644,
+	1646, 1648, 1649, 1651, 1698, 1548, 1545, 1546, 1547, 458,
+	1633, 1634, 1635, 1636, 1617, 1618, 1639, 386, 1620, 1878,
+	1621, 1622, 1623, 1624, 1625, 1626, 1627, 1628, 1629, 1630,
+	1637, 497, 482, 483, 978, 1521, 80, 1713, 1641, 1643,
+	1645, 1647, 1650, 1366, 1364, 1361, 1365, 1367, 501, 1360,
+	1359, 1777, 1776, 419, 2017, 2018, 508, 509, 1587, 1773,
+	507, 496, 1891, 1351, 1664, 348, 550, 1632, 417, 916,
+	1668, 406, 406, 406, 2082, 2075, 502, 2209, 1885, 54,
+	54, 418, 2192, 2077, 487, 76, 2122, 2025, 1667, 505,
+	1977, 1978, 1979, 1981, 1980, 918, 427, 488, 2129, 606,
+	531, 2048, 533, 423, 1990, 1873, 447, 448, 2021, 2022,
+	579, 2025, 85, 85, 438, 456, 455, 1369, 1370, 1371,
+	1372, 2181, 1841, 1840, 352, 2084, 2085, 2161, 890, 2031,
+	563, 444, 85, 85, 85, 85, 85, 521, 504, 605,
+	894, 2095, 2210, 1485, 323, 910, 877, 1863, 499, 539,
+	538, 2172, 2204, 1829, 1434, 723, 724, 435, 482, 483,
+	500, 503, 348, 348, 444, 348, 1423, 530, 535, 917,
+	498, 588, 590, 930, 552, 1949, 518, 2002, 1665, 543,
+	544, 543, 544, 348, 348, 532, 913, 911, 1867, 1347,
+	1192, 2016, 1152, 306, 562, 587, 520, 482, 483, 1588,
+	348, 409, 348, 492, 947, 716, 85, 534, 1906, 305,
+	1473, 1386, 454, 1683, 1682, 1511, 54, 1190, 1189, 1147,
+	961, 494, 348, 1188, 557, 969, 2059, 2162, 1808, 1809,
+	939, 54, 573, 942, 348, 406, 589, 348, 353, 949,
+	876, 1375, 323, 959, 931, 1911, 1996, 1808, 1809, 1163,
+	946, 1344, 992, 413, 586, 449, 555, 556, 881, 2083,
+	1640, 970, 599, 1187, 348, 348, 999, 85, 79, 427,
+	934, 1989, 1007, 948, 411, 968, 1016, 1377, 985, 387,
+	545, 323, 548, 388, 985, 895, 2187, 943, 2147, 962,
+	367, 1463, 957, 979, 909, 896, 897, 898, 899, 1000,
+	292, 1396, 1663, 950, 951, 1341, 958, 935, 353, 963,
+	1340, 928, 1327, 323, 1068, 1002, 1003, 919, 1019, 593,
+	594, 595, 596, 597, 1512, 938, 79, 79, 1320, 933,
+	1666, 945, 570, 571, 572, 1182, 512, 79, 79, 955,
+	2159, 2160, 2096, 2097, 323, 1631, 1140, 885, 886, 994,
+	1123, 997, 1376, 1067, 1865, 456, 455, 1018, 1864, 878,
+	980, 1075, 333, 975, 332, 336, 328, 409, 390, 990,
+	581, 457, 437, 1255, 1366, 1364, 324, 1365, 1367, 1868,
+	1869, 976, 1950, 1952, 1953, 1954, 1951, 343, 1104, 1104,
+	1109, 993, 991, 1049, 998, 1467, 995, 1465, 369, 987,
+	988, 989, 1069, 1070, 1071, 1072, 547, 1835, 366, 365,
+	2167, 417, 996, 566, 1004, 568, 2156, 392, 391, 1073,
+	1223, 1222, 536, 1493, 1066, 2035, 569, 79, 1322, 361,
+	889, 1194, 1619, 1143, 484, 485, 486, 577, 888, 1095,
+	411, 1127, 1043, 1377, 446, 1466, 1564, 1638, 1642, 1644,
+	1646, 1648, 1649, 1651, 1300, 1548, 1545, 1546, 1547, 944,
+	1633, 1634, 1635, 1636, 1617, 1618, 1639, 1300, 1620, 1428,
+	1621, 1622, 1623, 1624, 1625, 1626, 1627, 1628, 1629, 1630,
+	1637, 1103, 1993, 1142, 565, 1087, 417, 1013, 1641, 1643,
+	1645, 1647, 1650, 578, 74, 1251, 1536, 1248, 1875, 418,
+	1290, 1250, 1247, 1249, 1253, 1254, 1874, 1215, 1228, 1252,
+	1659, 537, 1654, 370, 1288, 1289, 1287, 1632, 1216, 1548,
+	1545, 1546, 1547, 360, 1541, 1858, 1540, 1539, 1537, 580,
+	1014, 1015, 1013, 2180, 326, 325, 329, 1397, 1566, 1014,
+	1015, 1013, 1544, 331, 1015, 1013, 85, 575, 1052, 1053,
+	1054, 1055, 1056, 1049, 389, 335, 2199, 2177, 484, 485,
+	486, 577, 1007, 1080, 484, 485, 486, 1605, 1081, 920,
+	415, 1231, 1721, 368, 1403, 2179, 484, 485, 486, 577,
+	1233, 1538, 2123, 1960, 1958, 1111, 1057, 1058, 1050, 1051,
+	1052, 1053, 1054, 1055, 1056, 1049, 1118, 1119, 1236, 1237,
+	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1258,
+	1259, 1260, 1261, 1262, 1263, 1256, 1257, 578, 2119, 85,
+	1959, 1957, 1113, 1606, 2064, 1992, 300, 393, 1956, 1110,
+	1014, 1015, 1013, 1184, 1991, 578, 1946, 1967, 1125, 1944,
+	1114, 1431, 348, 1943, 1430, 330, 334, 921, 1942, 338,
+	922, 1124, 1172, 340, 341, 342, 1939, 1933, 344, 345,
+	1930, 2089, 348, 1929, 54, 1955, 1882, 1014, 1015, 1013,
+	2166, 1002, 1003, 1945, 1112, 1821, 1805, 1804, 1803, 1802,
+	606, 1799, 85, 1014, 1015, 1013, 1542, 1543, 1212, 1213,
+	1117, 1121, 1599, 1116, 1122, 1173, 1174, 1175, 985, 985,
+	985, 2116, 1598, 1134, 1597, 1596, 1229, 1230, 1459, 879,
+	1640, 2106, 1508, 1511, 1138, 1185, 2088, 1176, 1965, 2074,
+	605, 323, 2061, 1170, 1209, 1210, 1211, 2042, 2029, 1271,
+	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
+	1282, 1199, 1095, 1226, 1292, 1293, 1178, 1151, 1180, 2028,
+	444, 1999, 1703, 1966, 1206, 1947, 955, 1181, 1179, 930,
+	1310, 1177, 1304, 1217, 1940, 1022, 1023, 1024, 1025, 1026,
+	1027, 1191, 1020, 1936, 1935, 1312, 1926, 1934, 1880, 1159,
+	1160, 1860, 1822, 1195, 1196, 1197, 1438, 1301, 1389, 1719,
+	1702, 1717, 1607, 1307, 1329, 1490, 1207, 444, 1014, 1015,
+	1013, 1200, 1907, 1201, 1489, 1488, 894, 484, 485, 486,
+	1208, 1338, 1014, 1015, 1013, 348, 1487, 1337, 348, 1295,
+	1291, 444, 1512, 348, 1014, 1015, 1013, 1505, 1294, 1285,
+	1346, 1506, 1509, 1014, 1015, 1013, 1224, 1225, 1333, 1227,
+	1154, 1334, 1156, 1153, 1336, 1264, 1265, 1266, 1267, 1091,
+	1268, 1269, 1270, 1383, 1014, 1015, 1013, 382, 1326, 1090,
+	1089, 880, 459, 348, 1399, 2218, 1354, 1355, 942, 1890,
+	385, 1325, 2213, 85, 1305, 2190, 1393, 1306, 1308, 2050,
+	1155, 1331, 1399, 1510, 2049, 1691, 353, 1311, 2036, 1313,
+	1994, 1014, 1015, 1013, 2212, 2211, 1374, 1345, 1974, 1404,
+	1908, 1577, 1314, 1014, 1015, 1013, 1391, 1014, 1015, 1013,
+	1348, 1893, 1332, 1050, 1051, 1052, 1053, 1054, 1055, 1056,
+	1049, 1892, 1379, 1014, 1015, 1013, 1704, 1441, 1695, 1563,
+	1399, 1440, 1400, 1557, 1356, 1401, 1402, 1694, 1342, 1556,
+	1150, 2193, 1555, 1380, 1170, 1381, 1373, 357, 359, 358,
+	1387, 1014, 1015, 1013, 1672, 1014, 1015, 1013, 1417, 356,
+	1384, 1014, 1015, 1013, 1014, 1015, 1013, 1608, 1390, 2189,
+	2188, 1420, 1421, 1382, 20, 1410, 1411, 1412, 1413, 1414,
+	1415, 1416, 13, 1578, 1392, 54, 1572, 6, 1569, 1104,
+	1524, 1454, 1104, 5, 1523, 1457, 1444, 1554, 1150, 2175,
+	1553, 592, 1442, 379, 1150, 2174, 1007, 1552, 1425, 1439,
+	348, 1429, 380, 1551, 348, 348, 2146, 2145, 348, 1014,
+	1015, 1013, 1014, 1015, 1013, 1445, 1437, 985, 1533, 1014,
+	1015, 1013, 1408, 985, 1405, 1014, 1015, 1013, 1398, 85,
+	1532, 1825, 2103, 1825, 2098, 723, 724, 1419, 1385, 444,
+	1014, 1015, 1013, 1449, 1531, 417, 874, 1285, 1501, 1456,
+	1418, 1309, 1014, 1015, 1013, 914, 1427, 1528, 1066, 591,
+	1435, 1315, 1491, 1453, 442, 2086, 1014, 1015, 1013, 1296,
+	875, 1446, 1452, 442, 1455, 2072, 2071, 1609, 1451, 1458,
+	1139, 874, 1460, 1468, 1470, 1461, 1011, 1464, 1144, 54,
+	461, 1014, 1015, 1013, 1579, 1471, 1759, 1825, 2046, 1486,
+	1530, 1825, 2045, 1014, 1015, 1013, 1825, 2044, 1825, 2043,
+	1550, 2034, 2033, 1972, 1973, 1576, 1513, 1514, 1972, 1971,
+	1897, 1896, 1895, 1894, 1528, 1126, 1568, 1825, 1824, 1565,
+	348, 1009, 1762, 1814, 1813, 461, 1575, 1515, 1757, 1574,
+	1205, 1581, 1399, 1558, 1771, 1772, 1399, 1549, 511, 1758,
+	492, 377, 490, 378, 1494, 1495, 1562, 376, 374, 373,
+	381, 491, 383, 384, 1321, 1653, 1559, 1150, 1436, 1399,
+	1407, 460, 1561, 1567, 1399, 1406, 1205, 1330, 489, 1570,
+	1324, 1323, 490, 1763, 1318, 1317, 441, 1602, 1297, 1671,
+	1205, 1204, 1150, 1149, 1580, 883, 882, 461, 442, 1157,
+	598, 80, 1604, 564, 2214, 1137, 492, 2155, 2149, 1583,
+	2130, 2127, 2125, 1586, 2063, 1986, 1970, 1968, 1595, 1616,
+	1962, 1921, 1600, 1888, 1887, 1886, 1883, 1670, 1872, 1856,
+	2183, 442, 1675, 1700, 1787, 1784, 1657, 1783, 1677, 1656,
+	1652, 1656, 1658, 1709, 1701, 348, 348, 1686, 1662, 85,
+	76, 1661, 1689, 1660, 1678, 1679, 1680, 1601, 1582, 1770,
+	1286, 1504, 444, 1378, 1335, 1316, 1303, 1693, 1302, 1203,
+	444, 1728, 1560, 1684, 1687, 1193, 1690, 1186, 601, 1501,
+	985, 1096, 1714, 1094, 1093, 1092, 1765, 1088, 1037, 1085,
+	1766, 1083, 1692, 1048, 1047, 1057, 1058, 1050, 1051, 1052,
+	1053, 1054, 1055, 1056, 1049, 1082, 1706, 1079, 1764, 1767,
+	1076, 76, 1712, 1046, 1045, 1792, 1794, 1044, 1792, 1792,
+	1042, 1774, 1041, 1040, 1710, 1711, 1039, 1038, 1035, 1034,
+	1778, 1033, 1032, 1753, 1781, 1782, 1031, 1030, 1029, 1028,
+	891, 1780, 1779, 719, 493, 476, 1130, 1131, 1785, 1884,
+	1788, 1789, 1166, 2135, 2133, 1793, 2105, 2094, 1368, 1443,
+	1773, 1798, 1202, 1133, 874, 513, 1751, 317, 385, 906,
+	1136, 1135, 1760, 1811, 908, 907, 470, 471, 472, 1795,
+	1796, 904, 901, 902, 900, 1810, 1801, 905, 1797, 903,
+	1831, 2104, 1171, 466, 469, 470, 471, 472, 467, 1818,
+	468, 473, 2053, 2198, 1812, 1048, 1047, 1057, 1058, 1050,
+	1051, 1052, 1053, 1054, 1055, 1056, 1049, 1319, 2202, 349,
+	2108, 582, 583, 1171, 1159, 1160, 1827, 1616, 1733, 1474,
+	1816, 1859, 517, 1584, 85, 1164, 965, 475, 1005, 1826,
+	1585, 466, 469, 470, 471, 472, 467, 1834, 468, 473,
+	429, 431, 432, 1223, 1222, 527, 528, 1120, 1794, 525,
+	526, 523, 524, 519, 2150, 2068, 1774, 1876, 2066, 1857,
+	1861, 1604, 357, 359, 358, 1709, 2009, 2137, 2008, 2006,
+	1927, 1922, 1718, 1669, 356, 356, 444, 1594, 1593, 1881,
+	1571, 1527, 522, 1928, 1526, 1810, 1395, 1889, 1898, 874,
+	2137, 2136, 971, 1409, 1339, 2136, 371, 1, 1832, 1833,
+	887, 1836, 1837, 1838, 1839, 1961, 1909, 1842, 1843, 1844,
+	1845, 1846, 1847, 1848, 1849, 1850, 1851, 1852, 1853, 1854,
+	1855, 453, 1925, 884, 452, 1924, 450, 75, 1298, 1235,
+	669, 1941, 1424, 1099, 444, 1870, 1105, 444, 444, 444,
+	1737, 1963, 482, 483, 2107, 2142, 2062, 2110, 657, 640,
+	2001, 1741, 1475, 1048, 1047, 1057, 1058, 1050, 1051, 1052,
+	1053, 1054, 1055, 1056, 1049, 1975, 1913, 2003, 1983, 1984,
+	1985, 1730, 2011, 1915, 1982, 1732, 1734, 1736, 2178, 1738,
+	1739, 1740, 1742, 1743, 1744, 1746, 1747, 1748, 1749, 1352,
+	1817, 1349, 514, 1447, 1448, 2012, 1998, 681, 671, 1084,
+	672, 2005, 714, 1931, 1932, 430, 670, 1800, 1520, 1937,
+	1938, 1752, 364, 85, 428, 372, 2026, 2027, 1877, 1589,
+	1775, 444, 2019, 1048, 1047, 1057, 1058, 1050, 1051, 1052,
+	1053, 1054, 1055, 1056, 1049, 1688, 1786, 444, 1232, 2153,
+	2207, 1750, 2197, 2170, 2148, 2032, 292, 2024, 2191, 2055,
+	2076, 2128, 2041, 2121, 2020, 1828, 321, 972, 1729, 2037,
+	558, 396, 1987, 403, 892, 1479, 1362, 1162, 2047, 1145,
+	925, 1810, 322, 1745, 2051, 2013, 1969, 2056, 1707, 2067,
+	1735, 2069, 2070, 2065, 1048, 1047, 1057, 1058, 1050, 1051,
+	1052, 1053, 1054, 1055, 1056, 1049, 2079, 2081, 2151, 1904,
+	362, 1165, 363, 1168, 1167, 1021, 1284, 1086, 2087, 1074,
+	608, 1426, 647, 1517, 2114, 2099, 2100, 2101, 2102, 1516,
+	1769, 960, 27, 2118, 477, 1012, 1115, 2113, 1048, 1047,
+	1057, 1058, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1049,
+	2124, 2117, 2126, 1048, 1047, 1057, 1058, 1050, 1051, 1052,
+	1053, 1054, 1055, 1056, 1049, 87, 1183, 721, 2010, 2131,
+	1819, 2112, 2134, 2132, 1697, 1696, 2144, 1433, 655, 654,
+	653, 2138, 652, 2120, 465, 463, 444, 462, 444, 312,
+	311, 1705, 1394, 2073, 1525, 930, 1008, 930, 2152, 1010,
+	2154, 2091, 2157, 2090, 2038, 2039, 1715, 2114, 2169, 1871,
+	1948, 1866, 1862, 2030, 2163, 2165, 444, 1727, 1726, 1754,
+	2113, 2173, 2168, 1755, 1761, 930, 1615, 1611, 2176, 1613,
+	1614, 1612, 1499, 1500, 2144, 2184, 1048, 1047, 1057, 1058,
+	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1049, 2195, 1497,
+	1496, 1132, 1128, 1101, 1108, 434, 2196, 940, 82, 310,
+	602, 12, 11, 19, 2206, 18, 2205, 17, 2186, 49,
+	48, 47, 46, 16, 8, 45, 2217, 2216, 2215, 2206,
+	841, 770, 789, 827, 44, 788, 843, 759, 776, 851,
+	778, 779, 814, 735, 798, 214, 774, 727, 762, 763,
+	729, 771, 730, 760, 791, 157, 758, 830, 801, 183,
+	849, 185, 43, 15, 249, 198, 14, 38, 794, 832,
+	796, 819, 170, 787, 815, 743, 808, 844, 775, 812,
+	845, 37, 36, 35, 34, 484, 485, 486, 33, 32,
+	31, 30, 140, 29, 28, 9, 57, 56, 811, 837,
+	773, 55, 21, 744, 842, 795, 813, 22, 728, 809,
+	23, 733, 736, 850, 835, 767, 768, 63, 62, 61,
+	60, 59, 26, 10, 792, 797, 816, 784, 7, 4,
+	2, 0, 0, 0, 0, 0, 0, 764, 0, 805,
+	0, 0, 0, 738, 734, 0, 790, 0, 131, 254,
+	268, 141, 245, 281, 145, 252, 137, 213, 241, 133,
+	266, 251, 195, 177, 178, 132, 0, 236, 155, 169,
+	152, 211, 839, 840, 151, 284, 737, 276, 135, 136,
+	275, 210, 263, 267, 196, 190, 134, 265, 194, 189,
+	181, 159, 173, 229, 188, 230, 174, 200, 199, 201,
+	861, 862, 863, 864, 865, 742, 0, 765, 817, 0,
+	726, 130, 826, 833, 786, 278, 836, 783, 782, 868,
+	0, 867, 253, 869, 870, 182, 831, 761, 772, 766,
+	769, 239, 216, 838, 804, 221, 237, 186, 264, 231,
+	269, 255, 277, 820, 232, 126, 256, 154, 197, 138,
+	139, 150, 156, 158, 160, 161, 206, 207, 219, 244,
+	257, 258, 259, 153, 146, 238, 147, 171, 148, 127,
+	246, 149, 128, 220, 262, 866, 168, 234, 193, 129,
+	192, 222, 261, 260, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 725, 273, 0, 212, 828,
+	731, 741, 739, 780, 806, 807, 208, 289, 822, 825,
+	823, 852, 242, 0, 0, 0, 0, 0, 176, 218,
+	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 732, 0, 250, 271, 283, 274, 781, 752,
+	793, 282, 755, 753, 821, 754, 810, 854, 202, 203,
+	204, 205, 777, 0, 144, 802, 785, 855, 856, 857,
+	858, 859, 860, 757, 834, 163, 0, 172, 143, 217,
+	165, 280, 179, 209, 175, 247, 180, 187, 235, 279,
+	215, 240, 142, 270, 248, 191, 749, 756, 748, 799,
+	800, 846, 847, 848, 818, 740, 829, 745, 747, 746,
+	1047, 1057, 1058, 1050, 1051, 1052, 1053, 1054, 1055, 1056,
+	1049, 0, 0, 0, 0, 0, 0, 0, 0, 824,
+	803, 125, 0, 184, 853, 233, 162, 1060, 0, 1063,
+	223, 224, 166, 167, 750, 751, 226, 227, 228, 225,
+	0, 0, 0, 1061, 1062, 1059, 0, 1048, 1047, 1057,
+	1058, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1049, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	80, 0, 677, 0, 0, 0, 871, 872, 286, 287,
+	288, 272, 214, 0, 0, 0, 0, 0, 649, 0,
+	0, 0, 157, 0, 0, 0, 183, 0, 185, 0,
+	0, 249, 198, 0, 0, 0, 0, 693, 699, 170,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 642,
+	0, 0, 609, 683, 682, 659, 666, 0, 0, 140,
+	660, 0, 665, 0, 661, 664, 662, 663, 0, 0,
+	685, 0, 0, 0, 0, 0, 607, 646, 0, 650,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	643, 644, 0, 0, 0, 0, 678, 0, 645, 0,
+	0, 680, 0, 667, 0, 131, 254, 268, 141, 245,
+	281, 145, 252, 137, 213, 241, 133, 266, 251, 195,
+	177, 178, 132, 0, 236, 155, 169, 152, 211, 675,
+	676, 151, 635, 673, 276, 135, 136, 275, 210, 263,
+	267, 196, 190, 134, 265, 194, 189, 181, 159, 173,
+	229, 188, 230, 174, 200, 199, 201, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 130, 0,
+	0, 0, 278, 0, 0, 691, 0, 0, 0, 253,
+	0, 0, 182, 0, 0, 0, 674, 0, 239, 216,
+	702, 0, 221, 237, 186, 264, 231, 269, 255, 277,
+	0, 232, 126, 256, 154, 197, 138, 139, 150, 156,
+	158, 160, 161, 206, 207, 219, 244, 257, 258, 259,
+	153, 146, 238, 147, 171, 148, 127, 246, 149, 128,
+	220, 262, 0, 168, 234, 193, 129, 192, 222, 261,
+	260, 285, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 273, 689, 212, 701, 684, 686, 687,
+	690, 694, 695, 633, 636, 696, 698, 700, 703, 242,
+	0, 0, 0, 0, 0, 176, 218, 0, 243, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 271, 283, 634, 0, 0, 0, 282, 0,
+	0, 0, 0, 0, 679, 202, 203, 204, 205, 692,
+	0, 144, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 0, 172, 143, 217, 165, 280, 179,
+	209, 175, 247, 180, 187, 235, 279, 215, 240, 142,
+	270, 248, 191, 709, 688, 708, 710, 711, 707, 712,
+	713, 697, 651, 0, 705, 704, 706, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 656, 125, 0,
+	184, 79, 233, 162, 0, 0, 0, 223, 224, 166,
+	167, 0, 0, 226, 227, 228, 225, 89, 611, 612,
+	613, 614, 615, 616, 617, 97, 618, 99, 100, 619,
+	102, 620, 104, 621, 106, 107, 108, 622, 623, 624,
+	625, 113, 626, 627, 628, 629, 118, 119, 120, 121,
+	630, 631, 632, 677, 0, 286, 287, 288, 272, 0,
+	0, 0, 0, 214, 0, 0, 0, 0, 0, 649,
+	0, 0, 0, 157, 986, 0, 0, 183, 0, 185,
+	0, 0, 249, 198, 0, 0, 0, 0, 693, 699,
+	170, 0, 0, 0, 0, 0, 0, 982, 0, 0,
+	642, 0, 0, 609, 683, 682, 659, 666, 0, 0,
+	140, 660, 0, 665, 0, 661, 664, 662, 663, 0,
+	0, 685, 0, 0, 0, 0, 0, 607, 646, 0,
+	650, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 643, 644, 0, 0, 0, 0, 678, 0, 645,
+	0, 0, 983, 0, 667, 0, 131, 254, 268, 141,
+	245, 281, 145, 252, 137, 213, 241, 133, 266, 251,
+	195, 177, 178, 132, 0, 236, 155, 169, 152, 211,
+	675, 676, 151, 635, 673, 276, 135, 136, 275, 210,
+	263, 267, 196, 190, 134, 265, 194, 189, 181, 159,
+	173, 229, 188, 230, 174, 200, 199, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 130,
+	0, 0, 0, 278, 0, 0, 691, 0, 0, 0,
+	253, 0, 0, 182, 0, 0, 0, 674, 0, 239,
+	216, 702, 0, 221, 237, 186, 264, 231, 269, 255,
+	277, 0, 232, 126, 256, 154, 197, 138, 139, 150,
+	156, 158, 160, 161, 206, 207, 219, 244, 257, 258,
+	259, 153, 146, 238, 147, 171, 148, 127, 246, 149,
+	128, 220, 262, 0, 168, 234, 193, 129, 192, 222,
+	261, 260, 285, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 273, 689, 212, 701, 684, 686,
+	687, 690, 694, 695, 633, 636, 696, 698, 700, 703,
+	242, 0, 0, 0, 0, 0, 176, 218, 0, 243,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 271, 283, 634, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 679, 202, 203, 204, 205,
+	692, 0, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 172, 143, 217, 165, 280,
+	179, 209, 175, 247, 180, 187, 235, 279, 215, 240,
+	142, 270, 248, 191, 709, 688, 708, 710, 711, 707,
+	712, 713, 697, 651, 0, 705, 704, 706, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 656, 125,
+	0, 184, 0, 233, 162, 0, 0, 0, 223, 224,
+	166, 167, 0, 0, 226, 227, 228, 225, 89, 611,
+	612, 613, 614, 615, 616, 617, 97, 618, 99, 100,
+	619, 102, 620, 104, 621, 106, 107, 108, 622, 623,
+	624, 625, 113, 626, 627, 628, 629, 118, 119, 120,
+	121, 630, 631, 632, 677, 0, 286, 287, 288, 272,
+	0, 0, 0, 0, 214, 0, 0, 0, 0, 0,
+	649, 0, 0, 0, 157, 2185, 0, 0, 183, 0,
+	185, 0, 0, 249, 198, 0, 0, 0, 0, 693,
+	699, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 642, 0, 0, 609, 683, 682, 659, 666, 0,
+	0, 140, 660, 0, 665, 0, 661, 664, 662, 663,
+	0, 0, 685, 0, 0, 0, 0, 0, 607, 646,
+	0, 650, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 643, 644, 0, 0, 0, 0, 678, 0,
+	645, 0, 0, 680, 0, 667, 0, 131, 254, 268,
+	141, 245, 281, 145, 252, 137, 213, 241, 133, 266,
+	251, 195, 177, 178, 132, 0, 236, 155, 169, 152,
+	211, 675, 676, 151, 635, 673, 276, 135, 136, 275,
+	210, 263, 267, 196, 190, 134, 265, 194, 189, 181,
+	159, 173, 229, 188, 230, 174, 200, 199, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 0, 0, 0, 278, 0, 0, 691, 0, 0,
+	0, 253, 0, 0, 182, 0, 0, 0, 674, 0,
+	239, 216, 702, 0, 221, 237, 186, 264, 231, 269,
+	255, 277, 0, 232, 126, 256, 154, 197, 138, 139,
+	150, 156, 158, 160, 161, 206, 207, 219, 244, 257,
+	258, 259, 153, 146, 238, 147, 171, 148, 127, 246,
+	149, 128, 220, 262, 0, 168, 234, 193, 129, 192,
+	222, 261, 260, 285, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 273, 689, 212, 701, 684,
+	686, 687, 690, 694, 695, 633, 636, 696, 698, 700,
+	703, 242, 0, 0, 0, 0, 0, 176, 218, 0,
+	243, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 271, 283, 634, 0, 0, 0,
+	282, 0, 0, 0, 0, 0, 679, 202, 203, 204,
+	205, 692, 0, 144, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 172, 143, 217, 165,
+	280, 179, 209, 175, 247, 180, 187, 235, 279, 215,
+	240, 142, 270, 248, 191, 709, 688, 708, 710, 711,
+	707, 712, 713, 697, 651, 0, 705, 704, 706, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 656,
+	125, 0, 184, 0, 233, 162, 0, 0, 0, 223,
+	224, 166, 167, 0, 0, 226, 227, 228, 225, 89,
+	611, 612, 613, 614, 615, 616, 617, 97, 618, 99,
+	100, 619, 102, 620, 104, 621, 106, 107, 108, 622,
+	623, 624, 625, 113, 626, 627, 628, 629, 118, 119,
+	120, 121, 630, 631, 632, 677, 0, 286, 287, 288,
+	272, 0, 0, 0, 0, 214, 0, 0, 0, 0,
+	0, 649, 0, 0, 0, 157, 986, 0, 0, 183,
+	0, 185, 0, 0, 249, 198, 0, 0, 0, 0,
+	693, 699, 170, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 642, 0, 0, 609, 683, 682, 659, 666,
+	0, 0, 140, 660, 0, 665, 0, 661, 664, 662,
+	663, 0, 0, 685, 0, 0, 0, 0, 0, 607,
+	646, 0, 650, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 643, 644, 0, 0, 0, 0, 678,
+	0, 645, 0, 0, 680, 0, 667, 0, 131, 254,
+	268, 141, 245, 281, 145, 252, 137, 213, 241, 133,
+	266, 251, 195, 177, 178, 132, 0, 236, 155, 169,
+	152, 211, 675, 676, 151, 635, 673, 276, 135, 136,
+	275, 210, 263, 267, 196, 190, 134, 265, 194, 189,
+	181, 159, 173, 229, 188, 230, 174, 200, 199, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 130, 0, 0, 0, 278, 0, 0, 691, 0,
+	0, 0, 253, 0, 0, 182, 0, 0, 0, 674,
+	0, 239, 216, 702, 0, 221, 237, 186, 264, 231,
+	269, 255, 277, 0, 232, 126, 256, 154, 197, 138,
+	139, 150, 156, 158, 160, 161, 206, 207, 219, 244,
+	257, 258, 259, 153, 146, 238, 147, 171, 148, 127,
+	246, 149, 128, 220, 262, 0, 168, 234, 193, 129,
+	192, 222, 261, 260, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 273, 689, 212, 701,
+	684, 686, 687, 690, 694, 695, 633, 636, 696, 698,
+	700, 703, 242, 0, 0, 0, 0, 0, 176, 218,
+	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 271, 283, 634, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 679, 202, 203,
+	204, 205, 692, 0, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 172, 143, 217,
+	165, 280, 179, 209, 175, 247, 180, 187, 235, 279,
+	215, 240, 142, 270, 248, 191, 709, 688, 708, 710,
+	711, 707, 712, 713, 697, 651, 0, 705, 704, 706,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	656, 125, 0, 184, 0, 233, 162, 0, 0, 0,
+	223, 224, 166, 167, 0, 0, 226, 227, 228, 225,
+	89, 611, 612, 613, 614, 615, 616, 617, 97, 618,
+	99, 100, 619, 102, 620, 104, 621, 106, 107, 108,
+	622, 623, 624, 625, 113, 626, 627, 628, 629, 118,
+	119, 120, 121, 630, 631, 632, 677, 0, 286, 287,
+	288, 272, 0, 0, 0, 0, 214, 0, 0, 0,
+	0, 0, 649, 0, 0, 0, 157, 0, 0, 0,
+	183, 0, 185, 0, 0, 249, 198, 0, 0, 0,
+	0, 693, 699, 170, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 642, 0, 0, 609, 683, 682, 659,
+	666, 0, 0, 140, 660, 0, 665, 0, 661, 664,
+	662, 663, 0, 0, 685, 0, 0, 0, 0, 0,
+	607, 646, 0, 650, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 643, 644, 604, 0, 0, 0,
+	678, 0, 645, 0, 0, 680, 0, 667, 0, 131,
+	254, 268, 141, 245, 281, 145, 252, 137, 213, 241,
+	133, 266, 251, 195, 177, 178, 132, 0, 236, 155,
+	169, 152, 211, 675, 676, 151, 635, 673, 276, 135,
+	136, 275, 210, 263, 267, 196, 190, 134, 265, 194,
+	189, 181, 159, 173, 229, 188, 230, 174, 200, 199,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 0, 0, 0, 278, 0, 0, 691,
+	0, 0, 0, 253, 0, 0, 182, 0, 0, 0,
+	674, 0, 239, 216, 702, 0, 221, 237, 186, 264,
+	231, 269, 255, 277, 0, 232, 126, 256, 154, 197,
+	138, 139, 150, 156, 158, 160, 161, 206, 207, 219,
+	244, 257, 258, 259, 153, 146, 238, 147, 171, 148,
+	127, 246, 149, 128, 220, 262, 0, 168, 234, 193,
+	129, 192, 222, 261, 260, 285, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 273, 689, 212,
+	701, 684, 686, 687, 690, 694, 695, 633, 636, 696,
+	698, 700, 703, 242, 0, 0, 0, 0, 0, 176,
+	218, 0, 243, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 271, 283, 634, 0,
+	0, 0, 282, 0, 0, 0, 0, 0, 679, 202,
+	203, 204, 205, 692, 0, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 172, 143,
+	217, 165, 280, 179, 209, 175, 247, 180, 187, 235,
+	279, 215, 240, 142, 270, 248, 191, 709, 688, 708,
+	710, 711, 707, 712, 713, 697, 651, 0, 705, 704,
+	706, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 656, 125, 0, 184, 0, 233, 162, 0, 0,
+	0, 223, 224, 166, 167, 0, 0, 226, 227, 228,
+	225, 89, 611, 612, 613, 614, 615, 616, 617, 97,
+	618, 99, 100, 619, 102, 620, 104, 621, 106, 107,
+	108, 622, 623, 624, 625, 113, 626, 627, 628, 629,
+	118, 119, 120, 121, 630, 631, 632, 677, 0, 286,
+	287, 288, 272, 0, 0, 0, 0, 214, 0, 0,
+	0, 0, 0, 649, 0, 0, 0, 157, 0, 0,
+	0, 183, 0, 185, 0, 0, 249, 198, 0, 0,
+	0, 0, 693, 699, 170, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 642, 0, 0, 609, 683, 682,
+	659, 666, 0, 0, 140, 660, 0, 665, 0, 661,
+	664, 662, 663, 0, 0, 685, 0, 0, 0, 0,
+	0, 607, 646, 0, 650, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 643, 644, 0, 0, 0,
+	0, 678, 0, 645, 0, 0, 680, 0, 667, 0,
+	131, 254, 268, 141, 245, 281, 145, 252, 137, 213,
+	241, 133, 266, 251, 195, 177, 178, 132, 0, 236,
+	155, 169, 152, 211, 675, 676, 151, 635, 673, 276,
+	135, 136, 275, 210, 263, 267, 196, 190, 134, 265,
+	194, 189, 181, 159, 173, 229, 188, 230, 174, 200,
+	199, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 130, 0, 0, 0, 278, 0, 0,
+	691, 0, 0, 0, 253, 0, 0, 182, 0, 0,
+	0, 674, 0, 239, 216, 702, 0, 221, 237, 186,
+	264, 231, 269, 255, 277, 0, 232, 126, 256, 154,
+	197, 138, 139, 150, 156, 158, 160, 161, 206, 207,
+	219, 244, 257, 258, 259, 153, 146, 238, 147, 171,
+	148, 127, 246, 149, 128, 220, 262, 0, 168, 234,
+	193, 129, 192, 222, 261, 260, 285, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 273, 689,
+	212, 701, 684, 686, 687, 690, 694, 695, 633, 636,
+	696, 698, 700, 703, 242, 0, 0, 0, 0, 0,
+	176, 218, 0, 243, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 271, 283, 634,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 679,
+	202, 203, 204, 205, 692, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 172,
+	143, 217, 165, 280, 179, 209, 175, 247, 180, 187,
+	235, 279, 215, 240, 142, 270, 248, 191, 709, 688,
+	708, 710, 711, 707, 712, 713, 697, 651, 0, 705,
+	704, 706, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 656, 125, 0, 184, 0, 233, 162, 0,
+	0, 0, 223, 224, 166, 167, 0, 0, 226, 227,
+	228, 225, 89, 611, 612, 613, 614, 615, 616, 617,
+	97, 618, 99, 100, 619, 102, 620, 104, 621, 106,
+	107, 108, 622, 623, 624, 625, 113, 626, 627, 628,
+	629, 118, 119, 120, 121, 630, 631, 632, 677, 0,
+	286, 287, 288, 272, 0, 0, 0, 0, 214, 0,
+	0, 0, 0, 0, 649, 0, 0, 0, 157, 0,
+	0, 0, 183, 0, 185, 0, 0, 249, 198, 0,
+	0, 0, 0, 693, 699, 170, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 642, 0, 0, 609, 683,
+	682, 659, 666, 0, 0, 140, 660, 0, 665, 0,
+	661, 664, 662, 663, 0, 0, 685, 0, 0, 0,
+	0, 0, 0, 646, 0, 650, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 643, 644, 0, 0,
+	0, 0, 678, 0, 645, 0, 0, 680, 0, 667,
+	0, 131, 254, 268, 141, 245, 281, 145, 252, 137,
+	213, 241, 133, 266, 251, 195, 177, 178, 132, 0,
+	236, 155, 169, 152, 211, 675, 676, 151, 635, 673,
+	276, 135, 136, 275, 210, 263, 267, 196, 190, 134,
+	265, 194, 189, 181, 159, 173, 229, 188, 230, 174,
+	200, 199, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 0, 0, 0, 278, 0,
+	0, 691, 0, 0, 0, 253, 0, 0, 182, 0,
+	0, 0, 674, 0, 239, 216, 702, 0, 221, 237,
+	186, 264, 231, 269, 255, 277, 0, 232, 126, 256,
+	154, 197, 138, 139, 150, 156, 158, 160, 161, 206,
+	207, 219, 244, 257, 258, 259, 153, 146, 238, 147,
+	171, 148, 127, 246, 149, 128, 220, 262, 0, 168,
+	234, 193, 129, 192, 222, 261, 260, 285, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 273,
+	689, 212, 701, 684, 686, 687, 690, 694, 695, 633,
+	636, 696, 698, 700, 703, 242, 0, 0, 0, 0,
+	0, 176, 218, 0, 243, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 271, 283,
+	634, 0, 0, 0, 282, 0, 0, 0, 0, 0,
+	679, 202, 203, 204, 205, 692, 0, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	172, 143, 217, 165, 280, 179, 209, 175, 247, 180,
+	187, 235, 279, 215, 240, 142, 270, 248, 191, 709,
+	688, 708, 710, 711, 707, 712, 713, 697, 651, 0,
+	705, 704, 706, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 656, 125, 0, 184, 0, 233, 162,
+	0, 0, 0, 223, 224, 166, 167, 0, 0, 226,
+	227, 228, 225, 89, 611, 612, 613, 614, 615, 616,
+	617, 97, 618, 99, 100, 619, 102, 620, 104, 621,
+	106, 107, 108, 622, 623, 624, 625, 113, 626, 627,
+	628, 629, 118, 119, 120, 121, 630, 631, 632, 0,
+	0, 286, 287, 288, 272, 333, 0, 332, 336, 328,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 324,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 0,
+	343, 183, 0, 185, 0, 0, 249, 198, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 346, 0, 0,
+	347, 0, 0, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 254, 268, 141, 245, 281, 145, 252, 137, 213,
+	241, 133, 266, 251, 195, 177, 178, 132, 0, 236,
+	155, 169, 152, 211, 0, 0, 151, 284, 0, 276,
+	135, 136, 275, 210, 263, 267, 196, 190, 134, 265,
+	194, 189, 181, 159, 173, 229, 188, 230, 174, 200,
+	199, 201, 0, 0, 0, 0, 0, 326, 325, 329,
+	0, 0, 0, 130, 0, 0, 331, 278, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 182, 335, 0,
+	0, 0, 0, 239, 216, 0, 0, 221, 237, 186,
+	264, 231, 327, 255, 277, 0, 351, 126, 256, 154,
+	197, 138, 139, 150, 156, 158, 160, 161, 206, 207,
+	219, 244, 257, 258, 259, 153, 146, 238, 147, 171,
+	148, 127, 246, 149, 128, 220, 262, 0, 168, 234,
+	193, 129, 192, 222, 261, 260, 285, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 273, 0,
+	212, 0, 0, 0, 0, 0, 0, 0, 208, 289,
+	0, 0, 0, 0, 242, 0, 0, 0, 330, 334,
+	337, 218, 338, 339, 0, 0, 340, 341, 342, 0,
+	0, 344, 345, 0, 0, 0, 250, 271, 283, 274,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	202, 203, 204, 205, 0, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 172,
+	143, 217, 165, 280, 179, 209, 175, 247, 180, 187,
+	235, 279, 215, 240, 142, 270, 248, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 184, 0, 233, 162, 0,
+	0, 0, 223, 224, 166, 167, 0, 0, 226, 227,
+	228, 225, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	286, 287, 288, 272, 333, 0, 332, 336, 328, 0,
+	0, 0, 0, 0, 0, 0, 214, 0, 324, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 0, 343,
+	183, 0, 185, 0, 0, 249, 198, 0, 0, 0,
+	0, 0, 0, 170, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 346, 0, 0, 347,
+	0, 0, 0, 140, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	254, 268, 141, 245, 281, 145, 252, 137, 213, 241,
+	133, 266, 251, 195, 177, 178, 132, 0, 236, 155,
+	169, 152, 211, 0, 0, 151, 284, 0, 276, 135,
+	136, 275, 210, 263, 267, 196, 190, 134, 265, 194,
+	189, 181, 159, 173, 229, 188, 230, 174, 200, 199,
+	201, 0, 0, 0, 0, 0, 326, 325, 329, 0,
+	0, 0, 130, 0, 0, 331, 278, 0, 0, 0,
+	0, 0, 0, 253, 0, 0, 182, 335, 0, 0,
+	0, 0, 239, 216, 0, 0, 221, 237, 186, 264,
+	231, 327, 255, 277, 0, 232, 126, 256, 154, 197,
+	138, 139, 150, 156, 158, 160, 161, 206, 207, 219,
+	244, 257, 258, 259, 153, 146, 238, 147, 171, 148,
+	127, 246, 149, 128, 220, 262, 0, 168, 234, 193,
+	129, 192, 222, 261, 260, 285, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 273, 0, 212,
+	0, 0, 0, 0, 0, 0, 0, 208, 289, 0,
+	0, 0, 0, 242, 0, 0, 0, 330, 334, 337,
+	218, 338, 339, 0, 0, 340, 341, 342, 0, 0,
+	344, 345, 0, 0, 0, 250, 271, 283, 274, 0,
+	0, 0, 282, 0, 0, 0, 0, 0, 0, 202,
+	203, 204, 205, 0, 0, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 172, 143,
+	217, 165, 280, 179, 209, 175, 247, 180, 187, 235,
+	279, 215, 240, 142, 270, 248, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 184, 0, 233, 162, 0, 0,
+	0, 223, 224, 166, 167, 0, 0, 226, 227, 228,
+	225, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 0, 0, 286,
+	287, 288, 272, 80, 0, 24, 41, 25, 0, 0,
+	0, 0, 0, 0, 0, 214, 294, 0, 0, 0,
+	0, 0, 0, 0, 0, 157, 0, 0, 0, 183,
+	0, 185, 0, 0, 249, 198, 0, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 299, 0, 0, 86, 0, 0, 0, 0,
+	0, 0, 140, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 254,
+	268, 141, 245, 281, 145, 252, 137, 213, 241, 133,
+	266, 251, 195, 177, 178, 132, 0, 236, 155, 169,
+	152, 211, 0, 0, 151, 284, 0, 276, 135, 136,
+	275, 210, 263, 267, 196, 190, 134, 265, 194, 189,
+	181, 159, 173, 229, 188, 230, 174, 200, 199, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 298,
+	0, 130, 0, 0, 0, 278, 0, 0, 0, 0,
+	0, 0, 253, 0, 0, 182, 0, 0, 0, 0,
+	0, 239, 216, 0, 0, 221, 237, 186, 264, 231,
+	269, 255, 277, 0, 232, 126, 256, 154, 197, 138,
+	139, 150, 156, 158, 160, 161, 206, 207, 219, 244,
+	257, 258, 259, 153, 146, 238, 147, 171, 148, 127,
+	246, 149, 128, 220, 262, 0, 168, 234, 193, 129,
+	192, 222, 261, 260, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 273, 0, 212, 0,
+	0, 0, 0, 0, 0, 0, 208, 289, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 176, 218,
+	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 271, 283, 274, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 0, 202, 203,
+	204, 205, 295, 297, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 172, 143, 217,
+	165, 280, 179, 209, 175, 247, 180, 187, 235, 279,
+	215, 240, 142, 270, 248, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 184, 79, 233, 162, 0, 0, 0,
+	223, 224, 166, 167, 0, 0, 226, 227, 228, 225,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 214, 0, 286, 287,
+	288, 272, 0, 0, 0, 0, 157, 0, 0, 0,
+	183, 0, 185, 0, 0, 249, 198, 0, 0, 0,
+	0, 0, 0, 170, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 86, 0, 0, 0,
+	0, 0, 0, 140, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1508, 1511, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	254, 268, 141, 245, 281, 145, 252, 137, 213, 241,
+	133, 266, 251, 195, 177, 178, 132, 0, 236, 155,
+	169, 152, 211, 0, 0, 151, 284, 0, 276, 135,
+	136, 275, 210, 263, 267, 196, 190, 134, 265, 194,
+	189, 181, 159, 173, 229, 188, 230, 174, 200, 199,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 0, 0, 1512, 278, 0, 0, 0,
+	1505, 0, 1504, 253, 1506, 1509, 182, 0, 0, 0,
+	0, 0, 239, 216, 0, 0, 221, 237, 186, 264,
+	231, 269, 255, 277, 0, 232, 126, 256, 154, 197,
+	138, 139, 150, 156, 158, 160, 161, 206, 207, 219,
+	244, 257, 258, 259, 153, 146, 238, 147, 171, 148,
+	127, 246, 149, 128, 220, 262, 1510, 168, 234, 193,
+	129, 192, 222, 261, 260, 285, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 273, 0, 212,
+	0, 0, 0, 0, 0, 0, 0, 208, 289, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 176,
+	218, 0, 243, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 271, 283, 274, 0,
+	0, 0, 282, 0, 0, 0, 0, 0, 0, 202,
+	203, 204, 205, 0, 0, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 172, 143,
+	217, 165, 280, 179, 209, 175, 247, 180, 187, 235,
+	279, 215, 240, 142, 270, 248, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 184, 0, 233, 162, 0, 0,
+	0, 223, 224, 166, 167, 0, 0, 226, 227, 228,
+	225, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 214, 0, 286,
+	287, 288, 272, 0, 0, 0, 0, 157, 395, 0,
+	0, 183, 0, 185, 0, 0, 249, 198, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 86, 407, 408,
+	0, 0, 0, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 409, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 254, 268, 141, 245, 281, 145, 252, 137, 213,
+	241, 133, 266, 251, 195, 177, 178, 132, 0, 236,
+	155, 169, 152, 211, 0, 0, 151, 284, 411, 276,
+	135, 410, 275, 210, 263, 267, 196, 190, 134, 265,
+	194, 189, 181, 159, 173, 229, 188, 230, 174, 200,
+	199, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 130, 0, 0, 0, 278, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 182, 0, 0,
+	0, 0, 0, 239, 216, 0, 0, 221, 237, 186,
+	264, 231, 269, 255, 277, 394, 232, 126, 256, 154,
+	197, 138, 139, 150, 156, 158, 160, 161, 206, 207,
+	219, 244, 257, 258, 259, 153, 146, 238, 147, 171,
+	148, 127, 246, 149, 128, 220, 262, 0, 168, 234,
+	193, 129, 192, 222, 261, 260, 285, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 273, 0,
+	212, 0, 0, 0, 0, 0, 0, 0, 208, 289,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	176, 218, 0, 243, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 271, 283, 274,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 397,
+	202, 203, 204, 205, 0, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 172,
+	143, 217, 165, 280, 179, 404, 400, 401, 180, 187,
+	235, 279, 215, 240, 142, 270, 248, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 184, 0, 233, 162, 0,
+	0, 0, 223, 224, 166, 167, 0, 0, 226, 227,
+	228, 225, 89, 90, 91, 92, 93, 94, 95, 96,
+	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 80, 0,
+	286, 287, 288, 272, 0, 0, 0, 0, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 0, 0, 183, 0, 185, 0, 0, 249,
+	198, 0, 0, 0, 0, 0, 0, 170, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 76, 0, 1102,
+	86, 0, 0, 0, 0, 0, 0, 140, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 254, 268, 141, 245, 281, 145,
+	252, 137, 213, 241, 133, 266, 251, 195, 177, 178,
+	132, 0, 236, 155, 169, 152, 211, 0, 0, 151,
+	284, 0, 276, 135, 136, 275, 210, 263, 267, 196,
+	190, 134, 265, 194, 189, 181, 159, 173, 229, 188,
+	230, 174, 200, 199, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 0, 0, 0,
+	278, 0, 0, 0, 0, 0, 0, 253, 0, 0,
+	182, 0, 0, 0, 0, 0, 239, 216, 0, 0,
+	221, 237, 186, 264, 231, 269, 255, 277, 0, 232,
+	126, 256, 154, 197, 138, 139, 150, 156, 158, 160,
+	161, 206, 207, 219, 244, 257, 258, 259, 153, 146,
+	238, 147, 171, 148, 127, 246, 149, 128, 220, 262,
+	0, 168, 234, 193, 129, 192, 222, 261, 260, 285,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 273, 0, 212, 0, 0, 0, 0, 0, 0,
+	0, 208, 289, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 176, 218, 0, 243, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	271, 283, 274, 0, 0, 0, 282, 0, 0, 0,
+	0, 0, 0, 202, 203, 204, 205, 0, 0, 144,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 0, 172, 143, 217, 165, 280, 179, 209, 175,
+	247, 180, 187, 235, 279, 215, 240, 142, 270, 248,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 184, 79,
+	233, 162, 0, 0, 0, 223, 224, 166, 167, 0,
+	0, 226, 227, 228, 225, 89, 90, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 0, 214, 286, 287, 288, 272, 1017, 0, 0,
+	0, 0, 157, 0, 0, 0, 183, 0, 185, 0,
+	0, 249, 198, 0, 0, 0, 0, 0, 0, 170,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 86, 0, 0, 0, 0, 0, 0, 140,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1014, 1015, 1013, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 254, 268, 141, 245,
+	281, 145, 252, 137, 213, 241, 133, 266, 251, 195,
+	177, 178, 132, 0, 236, 155, 169, 152, 211, 0,
+	0, 151, 284, 0, 276, 135, 136, 275, 210, 263,
+	267, 196, 190, 134, 265, 194, 189, 181, 159, 173,
+	229, 188, 230, 174, 200, 199, 201, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 130, 0,
+	0, 0, 278, 0, 0, 0, 0, 0, 0, 253,
+	0, 0, 182, 0, 0, 0, 0, 0, 239, 216,
+	0, 0, 221, 237, 186, 264, 231, 269, 255, 277,
+	0, 232, 126, 256, 154, 197, 138, 139, 150, 156,
+	158, 160, 161, 206, 207, 219, 244, 257, 258, 259,
+	153, 146, 238, 147, 171, 148, 127, 246, 149, 128,
+	220, 262, 0, 168, 234, 193, 129, 192, 222, 261,
+	260, 285, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 273, 0, 212, 0, 0, 0, 0,
+	0, 0, 0, 208, 289, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 176, 218, 0, 243, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 250, 271, 283, 274, 0, 0, 0, 282, 0,
+	0, 0, 0, 0, 0, 202, 203, 204, 205, 0,
+	0, 144, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 163, 0, 172, 143, 217, 165, 280, 179,
+	209, 175, 247, 180, 187, 235, 279, 215, 240, 142,
+	270, 248, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	184, 0, 233, 162, 0, 0, 0, 223, 224, 166,
+	167, 0, 0, 226, 227, 228, 225, 89, 90, 91,
 	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
 	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
 	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 210, 0, 276, 277, 278, 262, 0, 0, 0,
-	0, 154, 378, 0, 0, 179, 0, 181, 0, 0,
-	239, 194, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 390, 391, 0, 0, 0, 0, 137, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 392, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 128, 244, 258, 138, 235, 271, 142,
-	242, 134, 209, 231, 130, 256, 241, 191, 173, 174,
-	129, 0, 226, 152, 165, 149, 207, 0, 0, 148,
-	274, 394, 266, 132, 393, 265, 206, 253, 257, 192,
-	186, 131, 255, 190, 185, 177, 156, 169, 219, 184,
-	220, 170, 196, 195, 197, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 0, 0, 0, 0, 0, 243, 0, 0, 178,
-	0, 0, 0, 0, 0, 229, 212, 0, 0, 217,
-	227, 182, 254, 221, 259, 245, 267, 377, 222, 124,
-	246, 151, 193, 135, 136, 147, 153, 155, 157, 158,
-	202, 203, 215, 234, 247, 248, 249, 150, 143, 228,
-	144, 167, 145, 125, 236, 146, 126, 216, 252, 0,
-	164, 224, 189, 127, 188, 218, 251, 250, 275, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
-	263, 0, 208, 0, 0, 0, 0, 0, 0, 0,
-	204, 279, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 172, 214, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 261,
-	273, 264, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 380, 198, 199, 200, 201, 0, 0, 141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-	166, 0, 168, 140, 213, 163, 270, 175, 387, 383,
-	384, 176, 183, 225, 269, 211, 230, 139, 260, 238,
-	385, 162, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 180,
-	0, 223, 159, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 78,
-	0, 276, 277, 278, 262, 0, 0, 0, 0, 0,
-	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 154, 0, 0, 0, 179, 0, 181, 0, 0,
-	239, 194, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 74, 0, 908,
-	84, 0, 0, 0, 0, 0, 0, 137, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 128, 244, 258, 138, 235, 271, 142,
-	242, 134, 209, 231, 130, 256, 241, 191, 173, 174,
-	129, 0, 226, 152, 165, 149, 207, 0, 0, 148,
-	274, 0, 266, 132, 133, 265, 206, 253, 257, 192,
-	186, 131, 255, 190, 185, 177, 156, 169, 219, 184,
-	220, 170, 196, 195, 197, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 0, 0, 0, 0, 0, 243, 0, 0, 178,
-	0, 0, 0, 0, 0, 229, 212, 0, 0, 217,
-	227, 182, 254, 221, 259, 245, 267, 0, 222, 124,
-	246, 151, 193, 135, 136, 147, 153, 155, 157, 158,
-	202, 203, 215, 234, 247, 248, 249, 150, 143, 228,
-	144, 167, 145, 125, 236, 146, 126, 216, 252, 0,
-	164, 224, 189, 127, 188, 218, 251, 250, 275, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
-	263, 0, 208, 0, 0, 0, 0, 0, 0, 0,
-	204, 279, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 172, 214, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 261,
-	273, 264, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 198, 199, 200, 201, 0, 0, 141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-	166, 0, 168, 140, 213, 163, 270, 175, 205, 171,
-	237, 176, 183, 225, 269, 211, 230, 139, 260, 238,
-	187, 162, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 180,
-	77, 223, 159, 87, 88, 89, 90, 91, 92, 93,
+	122, 123, 124, 214, 0, 286, 287, 288, 272, 0,
+	0, 0, 0, 157, 0, 0, 0, 183, 0, 185,
+	0, 0, 249, 198, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 86, 407, 408, 0, 0, 0, 0,
+	140, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 254, 268, 141,
+	245, 281, 145, 252, 137, 213, 241, 133, 266, 251,
+	195, 177, 178, 132, 0, 236, 155, 169, 152, 211,
+	0, 0, 151, 284, 411, 276, 135, 410, 275, 210,
+	263, 267, 196, 190, 134, 265, 194, 189, 181, 159,
+	173, 229, 188, 230, 174, 200, 199, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 130,
+	0, 0, 0, 278, 0, 0, 0, 0, 0, 0,
+	253, 0, 0, 182, 0, 0, 0, 0, 0, 239,
+	216, 0, 0, 221, 237, 186, 264, 231, 269, 255,
+	277, 0, 232, 126, 256, 154, 197, 138, 139, 150,
+	156, 158, 160, 161, 206, 207, 219, 244, 257, 258,
+	259, 153, 146, 238, 147, 171, 148, 127, 246, 149,
+	128, 220, 262, 0, 168, 234, 193, 129, 192, 222,
+	261, 260, 285, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 273, 0, 212, 0, 0, 0,
+	0, 0, 0, 0, 208, 289, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 176, 218, 0, 243,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 271, 283, 274, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 0, 202, 203, 204, 205,
+	0, 0, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 172, 143, 217, 165, 280,
+	179, 404, 400, 401, 180, 187, 235, 279, 215, 240,
+	142, 270, 248, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 184, 0, 233, 162, 0, 0, 0, 223, 224,
+	166, 167, 0, 0, 226, 227, 228, 225, 89, 90,
+	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 0, 0, 286, 287, 288, 272,
+	214, 0, 559, 0, 0, 0, 0, 0, 0, 0,
+	157, 560, 0, 0, 183, 0, 185, 0, 0, 249,
+	198, 0, 0, 0, 0, 0, 0, 170, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	346, 0, 0, 347, 0, 0, 0, 140, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 254, 268, 141, 245, 281, 145,
+	252, 137, 213, 241, 133, 266, 251, 195, 177, 178,
+	132, 0, 236, 155, 169, 152, 211, 0, 0, 151,
+	284, 0, 276, 135, 136, 275, 210, 263, 267, 196,
+	190, 134, 265, 194, 189, 181, 159, 173, 229, 188,
+	230, 174, 200, 199, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 0, 0, 0,
+	278, 0, 0, 0, 0, 0, 0, 253, 0, 0,
+	182, 0, 0, 0, 0, 0, 239, 216, 0, 0,
+	221, 237, 186, 264, 231, 269, 255, 277, 0, 232,
+	126, 256, 154, 197, 138, 139, 150, 156, 158, 160,
+	161, 206, 207, 219, 244, 257, 258, 259, 153, 146,
+	238, 147, 171, 148, 127, 246, 149, 128, 220, 262,
+	0, 168, 234, 193, 129, 192, 222, 261, 260, 285,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 273, 0, 212, 0, 0, 0, 0, 0, 0,
+	0, 208, 289, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 176, 218, 0, 243, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	271, 283, 274, 0, 0, 0, 282, 0, 0, 0,
+	0, 561, 0, 202, 203, 204, 205, 0, 0, 144,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 0, 172, 143, 217, 165, 280, 179, 209, 175,
+	247, 180, 187, 235, 279, 215, 240, 142, 270, 248,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 184, 0,
+	233, 162, 0, 0, 0, 223, 224, 166, 167, 0,
+	0, 226, 227, 228, 225, 89, 90, 91, 92, 93,
 	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
 	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 0,
-	210, 276, 277, 278, 262, 828, 0, 0, 0, 0,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	825, 826, 824, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 390, 391,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 392, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 394, 266,
-	132, 393, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 387, 383, 384, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 385, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 0, 0, 286, 287, 288, 272, 214, 0, 974,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 0,
+	0, 183, 0, 185, 0, 0, 249, 198, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 346, 0, 0,
+	347, 0, 0, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 254, 268, 141, 245, 281, 145, 252, 137, 213,
+	241, 133, 266, 251, 195, 177, 178, 132, 0, 236,
+	155, 169, 152, 211, 0, 0, 151, 284, 0, 276,
+	135, 136, 275, 210, 263, 267, 196, 190, 134, 265,
+	194, 189, 181, 159, 173, 229, 188, 230, 174, 200,
+	199, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 130, 0, 0, 0, 278, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 182, 0, 0,
+	0, 0, 0, 239, 216, 0, 0, 221, 237, 186,
+	264, 231, 269, 255, 277, 0, 232, 126, 256, 154,
+	197, 138, 139, 150, 156, 158, 160, 161, 206, 207,
+	219, 244, 257, 258, 259, 153, 146, 238, 147, 171,
+	148, 127, 246, 149, 128, 220, 262, 0, 168, 234,
+	193, 129, 192, 222, 261, 260, 285, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 273, 0,
+	212, 0, 0, 0, 0, 0, 0, 0, 208, 289,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	176, 218, 0, 243, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 271, 283, 274,
+	0, 0, 0, 282, 0, 0, 0, 0, 973, 0,
+	202, 203, 204, 205, 0, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 172,
+	143, 217, 165, 280, 179, 209, 175, 247, 180, 187,
+	235, 279, 215, 240, 142, 270, 248, 191, 0, 0,
+	214, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 0, 0, 183, 0, 185, 0, 0, 249,
+	198, 0, 0, 0, 0, 0, 0, 170, 0, 0,
+	0, 0, 0, 125, 0, 184, 0, 233, 162, 2109,
+	86, 683, 223, 224, 166, 167, 0, 140, 226, 227,
+	228, 225, 89, 90, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 0, 0, 276, 277,
-	278, 262, 210, 0, 530, 0, 0, 0, 0, 0,
-	0, 0, 154, 531, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 331, 0, 0, 332, 0, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 532, 0, 198, 199, 200, 201, 0, 0, 141,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	286, 287, 288, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 254, 268, 141, 245, 281, 145,
+	252, 137, 213, 241, 133, 266, 251, 195, 177, 178,
+	132, 0, 236, 155, 169, 152, 211, 0, 0, 151,
+	284, 0, 276, 135, 136, 275, 210, 263, 267, 196,
+	190, 134, 265, 194, 189, 181, 159, 173, 229, 188,
+	230, 174, 200, 199, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 0, 0, 0,
+	278, 0, 0, 0, 0, 0, 0, 253, 0, 0,
+	182, 0, 0, 0, 0, 0, 239, 216, 0, 0,
+	221, 237, 186, 264, 231, 269, 255, 277, 0, 232,
+	126, 256, 154, 197, 138, 139, 150, 156, 158, 160,
+	161, 206, 207, 219, 244, 257, 258, 259, 153, 146,
+	238, 147, 171, 148, 127, 246, 149, 128, 220, 262,
+	0, 168, 234, 193, 129, 192, 222, 261, 260, 285,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 273, 0, 212, 0, 0, 0, 0, 0, 0,
+	0, 208, 289, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 176, 218, 0, 243, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	271, 283, 274, 0, 0, 0, 282, 0, 0, 0,
+	0, 0, 0, 202, 203, 204, 205, 0, 0, 144,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 0, 172, 143, 217, 165, 280, 179, 209, 175,
+	247, 180, 187, 235, 279, 215, 240, 142, 270, 248,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 184, 0,
+	233, 162, 0, 0, 0, 223, 224, 166, 167, 0,
+	0, 226, 227, 228, 225, 89, 90, 91, 92, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 214, 0, 286, 287, 288, 272, 0, 0, 0,
+	0, 157, 0, 0, 0, 183, 0, 185, 0, 0,
+	249, 198, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 0, 0, 927, 0, 0, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 254, 268, 141, 245, 281,
+	145, 252, 137, 213, 241, 133, 266, 251, 195, 177,
+	178, 132, 0, 236, 155, 169, 152, 211, 0, 0,
+	151, 284, 0, 276, 135, 136, 275, 210, 263, 267,
+	196, 190, 134, 265, 194, 189, 181, 159, 173, 229,
+	188, 230, 174, 200, 199, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 130, 0, 0,
+	0, 278, 0, 0, 0, 0, 0, 0, 253, 0,
+	0, 182, 0, 0, 0, 0, 0, 239, 216, 0,
+	0, 221, 237, 186, 264, 231, 269, 255, 277, 0,
+	232, 126, 256, 154, 197, 138, 139, 150, 156, 158,
+	160, 161, 206, 207, 219, 244, 257, 258, 259, 153,
+	146, 238, 147, 171, 148, 127, 246, 149, 128, 220,
+	262, 0, 168, 234, 193, 129, 192, 222, 261, 260,
+	285, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	164, 0, 273, 0, 212, 0, 0, 0, 0, 0,
+	0, 0, 208, 289, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 176, 218, 0, 243, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 271, 283, 274, 0, 0, 0, 282, 0, 0,
+	0, 0, 0, 1469, 202, 203, 204, 205, 0, 0,
+	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 172, 143, 217, 165, 280, 179, 209,
+	175, 247, 180, 187, 235, 279, 215, 240, 142, 270,
+	248, 191, 0, 214, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 0, 0, 183, 0, 185,
+	0, 0, 249, 198, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 0, 0, 0, 125, 0, 184,
+	315, 233, 162, 86, 0, 0, 223, 224, 166, 167,
+	140, 0, 226, 227, 228, 225, 89, 90, 91, 92,
 	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
 	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	0, 0, 276, 277, 278, 262, 210, 0, 784, 0,
-	0, 0, 0, 0, 0, 0, 154, 0, 0, 0,
-	179, 0, 181, 0, 0, 239, 194, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 0, 0, 332, 0,
-	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 128, 244,
-	258, 138, 235, 271, 142, 242, 134, 209, 231, 130,
-	256, 241, 191, 173, 174, 129, 0, 226, 152, 165,
-	149, 207, 0, 0, 148, 274, 0, 266, 132, 133,
-	265, 206, 253, 257, 192, 186, 131, 255, 190, 185,
-	177, 156, 169, 219, 184, 220, 170, 196, 195, 197,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 178, 0, 0, 0, 0, 0,
-	229, 212, 0, 0, 217, 227, 182, 254, 221, 259,
-	245, 267, 0, 222, 124, 246, 151, 193, 135, 136,
-	147, 153, 155, 157, 158, 202, 203, 215, 234, 247,
-	248, 249, 150, 143, 228, 144, 167, 145, 125, 236,
-	146, 126, 216, 252, 0, 164, 224, 189, 127, 188,
-	218, 251, 250, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 0, 263, 0, 208, 0, 0,
-	0, 0, 0, 0, 0, 204, 279, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 172, 214, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 240, 261, 273, 264, 0, 0, 0,
-	272, 0, 0, 0, 0, 783, 0, 198, 199, 200,
-	201, 0, 0, 141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 160, 166, 0, 168, 140, 213,
-	163, 270, 175, 205, 171, 237, 176, 183, 225, 269,
-	211, 230, 139, 260, 238, 187, 162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 180, 0, 223, 159, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 210, 0, 276, 277, 278, 262,
-	0, 0, 0, 0, 154, 0, 0, 0, 179, 0,
-	181, 0, 0, 239, 194, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1986, 84, 652, 0, 0, 0, 0, 0,
-	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 128, 244, 258, 138,
-	235, 271, 142, 242, 134, 209, 231, 130, 256, 241,
-	191, 173, 174, 129, 0, 226, 152, 165, 149, 207,
-	0, 0, 148, 274, 0, 266, 132, 133, 265, 206,
-	253, 257, 192, 186, 131, 255, 190, 185, 177, 156,
-	169, 219, 184, 220, 170, 196, 195, 197, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 0, 0, 0, 0, 0, 243,
-	0, 0, 178, 0, 0, 0, 0, 0, 229, 212,
-	0, 0, 217, 227, 182, 254, 221, 259, 245, 267,
-	0, 222, 124, 246, 151, 193, 135, 136, 147, 153,
-	155, 157, 158, 202, 203, 215, 234, 247, 248, 249,
-	150, 143, 228, 144, 167, 145, 125, 236, 146, 126,
-	216, 252, 0, 164, 224, 189, 127, 188, 218, 251,
-	250, 275, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 0, 263, 0, 208, 0, 0, 0, 0,
-	0, 0, 0, 204, 279, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 172, 214, 0, 233, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 240, 261, 273, 264, 0, 0, 0, 272, 0,
-	0, 0, 0, 0, 0, 198, 199, 200, 201, 0,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 160, 166, 0, 168, 140, 213, 163, 270,
-	175, 205, 171, 237, 176, 183, 225, 269, 211, 230,
-	139, 260, 238, 187, 162, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 180, 0, 223, 159, 87, 88, 89, 90,
+	123, 124, 0, 0, 286, 287, 288, 272, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 254, 268, 141,
+	245, 281, 145, 252, 137, 213, 241, 133, 266, 251,
+	195, 177, 178, 132, 0, 236, 155, 169, 152, 211,
+	0, 0, 151, 284, 0, 276, 135, 136, 275, 210,
+	263, 267, 196, 190, 134, 265, 194, 189, 181, 159,
+	173, 229, 188, 230, 174, 200, 199, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 130,
+	0, 0, 0, 278, 0, 0, 0, 0, 0, 0,
+	253, 0, 0, 182, 0, 0, 0, 0, 0, 239,
+	216, 0, 0, 221, 237, 186, 264, 231, 269, 255,
+	277, 0, 232, 126, 256, 154, 197, 138, 139, 150,
+	156, 158, 160, 161, 206, 207, 219, 244, 257, 258,
+	259, 153, 146, 238, 147, 171, 148, 127, 246, 149,
+	128, 220, 262, 0, 168, 234, 193, 129, 192, 222,
+	261, 260, 285, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 273, 0, 212, 0, 0, 0,
+	0, 0, 0, 0, 208, 289, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 176, 218, 0, 243,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 271, 283, 274, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 0, 202, 203, 204, 205,
+	0, 0, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 172, 143, 217, 165, 280,
+	179, 209, 175, 247, 180, 187, 235, 279, 215, 240,
+	142, 270, 248, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 184, 0, 233, 162, 0, 0, 313, 223, 224,
+	166, 167, 0, 0, 226, 227, 228, 225, 89, 90,
 	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 210, 0, 276, 277, 278, 262, 0, 0,
-	0, 0, 154, 0, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 736, 0, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 0, 1409, 198, 199, 200, 201, 0, 0, 141,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
+	121, 122, 123, 124, 214, 0, 286, 287, 288, 272,
+	0, 0, 0, 0, 157, 1198, 0, 0, 183, 0,
+	185, 0, 0, 249, 198, 0, 0, 0, 0, 0,
+	0, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 86, 0, 0, 927, 0, 0,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 254, 268,
+	141, 245, 281, 145, 252, 137, 213, 241, 133, 266,
+	251, 195, 177, 178, 132, 0, 236, 155, 169, 152,
+	211, 0, 0, 151, 284, 0, 276, 135, 136, 275,
+	210, 263, 267, 196, 190, 134, 265, 194, 189, 181,
+	159, 173, 229, 188, 230, 174, 200, 199, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 182, 0, 0, 0, 0, 0,
+	239, 216, 0, 0, 221, 237, 186, 264, 231, 269,
+	255, 277, 0, 232, 126, 256, 154, 197, 138, 139,
+	150, 156, 158, 160, 161, 206, 207, 219, 244, 257,
+	258, 259, 153, 146, 238, 147, 171, 148, 127, 246,
+	149, 128, 220, 262, 0, 168, 234, 193, 129, 192,
+	222, 261, 260, 285, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 273, 0, 212, 0, 0,
+	0, 0, 0, 0, 0, 208, 289, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 176, 218, 0,
+	243, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 271, 283, 274, 0, 0, 0,
+	282, 0, 0, 0, 0, 0, 0, 202, 203, 204,
+	205, 0, 0, 144, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 172, 143, 217, 165,
+	280, 179, 209, 175, 247, 180, 187, 235, 279, 215,
+	240, 142, 270, 248, 191, 0, 214, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 0, 0,
+	183, 0, 185, 0, 0, 249, 198, 0, 0, 0,
+	0, 0, 0, 170, 0, 0, 0, 0, 0, 0,
+	125, 0, 184, 0, 233, 162, 86, 683, 0, 223,
+	224, 166, 167, 140, 0, 226, 227, 228, 225, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 0, 0, 286, 287, 288,
+	272, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	254, 268, 141, 245, 281, 145, 252, 137, 213, 241,
+	133, 266, 251, 195, 177, 178, 132, 0, 236, 155,
+	169, 152, 211, 0, 0, 151, 284, 0, 276, 135,
+	136, 275, 210, 263, 267, 196, 190, 134, 265, 194,
+	189, 181, 159, 173, 229, 188, 230, 174, 200, 199,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 130, 0, 0, 0, 278, 0, 0, 0,
+	0, 0, 0, 253, 0, 0, 182, 0, 0, 0,
+	0, 0, 239, 216, 0, 0, 221, 237, 186, 264,
+	231, 269, 255, 277, 0, 232, 126, 256, 154, 197,
+	138, 139, 150, 156, 158, 160, 161, 206, 207, 219,
+	244, 257, 258, 259, 153, 146, 238, 147, 171, 148,
+	127, 246, 149, 128, 220, 262, 0, 168, 234, 193,
+	129, 192, 222, 261, 260, 285, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 0, 273, 0, 212,
+	0, 0, 0, 0, 0, 0, 0, 208, 289, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 176,
+	218, 0, 243, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 250, 271, 283, 274, 0,
+	0, 0, 282, 0, 0, 0, 0, 0, 0, 202,
+	203, 204, 205, 0, 0, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 163, 0, 172, 143,
+	217, 165, 280, 179, 209, 175, 247, 180, 187, 235,
+	279, 215, 240, 142, 270, 248, 191, 0, 214, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 157, 0,
+	0, 0, 183, 0, 185, 0, 0, 249, 198, 0,
+	0, 0, 0, 0, 0, 170, 0, 0, 0, 0,
+	0, 0, 125, 0, 184, 1725, 233, 162, 86, 0,
+	0, 223, 224, 166, 167, 140, 0, 226, 227, 228,
+	225, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 0, 0, 286,
+	287, 288, 272, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 254, 268, 141, 245, 281, 145, 252, 137,
+	213, 241, 133, 266, 251, 195, 177, 178, 132, 0,
+	236, 155, 169, 152, 211, 0, 0, 151, 284, 0,
+	276, 135, 136, 275, 210, 263, 267, 196, 190, 134,
+	265, 194, 189, 181, 159, 173, 229, 188, 230, 174,
+	200, 199, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 130, 0, 0, 0, 278, 0,
+	0, 0, 0, 0, 0, 253, 0, 0, 182, 0,
+	0, 0, 0, 0, 239, 216, 0, 0, 221, 237,
+	186, 264, 231, 269, 255, 277, 0, 232, 126, 256,
+	154, 197, 138, 139, 150, 156, 158, 160, 161, 206,
+	207, 219, 244, 257, 258, 259, 153, 146, 238, 147,
+	171, 148, 127, 246, 149, 128, 220, 262, 0, 168,
+	234, 193, 129, 192, 222, 261, 260, 285, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 273,
+	0, 212, 0, 0, 0, 0, 0, 0, 0, 208,
+	289, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 176, 218, 0, 243, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 250, 271, 283,
+	274, 0, 0, 0, 282, 0, 0, 0, 0, 0,
+	0, 202, 203, 204, 205, 0, 0, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 163, 0,
+	172, 143, 217, 165, 280, 179, 209, 175, 247, 180,
+	187, 235, 279, 215, 240, 142, 270, 248, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 184, 0, 233, 162,
+	0, 0, 0, 223, 224, 166, 167, 0, 0, 226,
+	227, 228, 225, 89, 90, 91, 92, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 214,
+	0, 286, 287, 288, 272, 0, 0, 0, 0, 157,
+	0, 0, 0, 183, 0, 185, 0, 0, 249, 198,
+	0, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
+	0, 0, 927, 0, 0, 0, 140, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 254, 268, 141, 245, 281, 145, 252,
+	137, 213, 241, 133, 266, 251, 195, 177, 178, 132,
+	0, 236, 155, 169, 152, 211, 0, 0, 151, 284,
+	0, 276, 135, 136, 275, 210, 263, 267, 196, 190,
+	134, 265, 194, 189, 181, 159, 173, 229, 188, 230,
+	174, 200, 199, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 130, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 182,
+	0, 0, 0, 0, 0, 239, 216, 0, 0, 221,
+	237, 186, 264, 231, 269, 255, 277, 0, 232, 126,
+	256, 154, 197, 138, 139, 150, 156, 158, 160, 161,
+	206, 207, 219, 244, 257, 258, 259, 153, 146, 238,
+	147, 171, 148, 127, 246, 149, 128, 220, 262, 0,
+	168, 234, 193, 129, 192, 222, 261, 260, 285, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 164, 0,
+	273, 0, 212, 0, 0, 0, 0, 0, 0, 0,
+	208, 289, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 176, 218, 0, 243, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 271,
+	283, 274, 0, 0, 0, 282, 0, 0, 0, 0,
+	0, 0, 202, 203, 204, 205, 0, 0, 144, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 172, 143, 217, 165, 280, 179, 209, 175, 247,
+	180, 187, 235, 279, 215, 240, 142, 270, 248, 191,
+	0, 214, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 0, 0, 183, 0, 185, 0, 0,
+	249, 198, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 0, 0, 0, 125, 0, 184, 0, 233,
+	162, 86, 0, 0, 223, 224, 166, 167, 140, 0,
+	226, 227, 228, 225, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	0, 1529, 286, 287, 288, 272, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 254, 268, 141, 245, 281,
+	145, 252, 137, 213, 241, 133, 266, 251, 195, 177,
+	178, 132, 0, 236, 155, 169, 152, 211, 0, 0,
+	151, 284, 0, 276, 135, 136, 275, 210, 263, 267,
+	196, 190, 134, 265, 194, 189, 181, 159, 173, 229,
+	188, 230, 174, 200, 199, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 130, 0, 0,
+	0, 278, 0, 0, 0, 0, 0, 0, 253, 0,
+	0, 182, 0, 0, 0, 0, 0, 239, 216, 0,
+	0, 221, 237, 186, 264, 231, 269, 255, 277, 0,
+	232, 126, 256, 154, 197, 138, 139, 150, 156, 158,
+	160, 161, 206, 207, 219, 244, 257, 258, 259, 153,
+	146, 238, 147, 171, 148, 127, 246, 149, 128, 220,
+	262, 0, 168, 234, 193, 129, 192, 222, 261, 260,
+	285, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	164, 0, 273, 0, 212, 0, 0, 0, 0, 0,
+	0, 0, 208, 289, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 176, 218, 0, 243, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	250, 271, 283, 274, 0, 0, 0, 282, 0, 0,
+	0, 0, 0, 0, 202, 203, 204, 205, 0, 0,
+	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 163, 0, 172, 143, 217, 165, 280, 179, 209,
+	175, 247, 180, 187, 235, 279, 215, 240, 142, 270,
+	248, 191, 0, 214, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 0, 0, 0, 183, 0, 185,
+	0, 0, 249, 198, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 0, 0, 0, 125, 0, 184,
+	0, 233, 162, 86, 0, 0, 223, 224, 166, 167,
+	140, 0, 226, 227, 228, 225, 89, 90, 91, 92,
 	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
 	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	210, 0, 276, 277, 278, 262, 0, 0, 0, 0,
-	154, 1145, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 736, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 652, 0,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 0, 266,
-	132, 133, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 205, 171, 237, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 187, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 210, 0, 276, 277,
-	278, 262, 0, 0, 0, 0, 154, 0, 0, 0,
-	179, 0, 181, 0, 0, 239, 194, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1651, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 128, 244,
-	258, 138, 235, 271, 142, 242, 134, 209, 231, 130,
-	256, 241, 191, 173, 174, 129, 0, 226, 152, 165,
-	149, 207, 0, 0, 148, 274, 0, 266, 132, 133,
-	265, 206, 253, 257, 192, 186, 131, 255, 190, 185,
-	177, 156, 169, 219, 184, 220, 170, 196, 195, 197,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 178, 0, 0, 0, 0, 0,
-	229, 212, 0, 0, 217, 227, 182, 254, 221, 259,
-	245, 267, 0, 222, 124, 246, 151, 193, 135, 136,
-	147, 153, 155, 157, 158, 202, 203, 215, 234, 247,
-	248, 249, 150, 143, 228, 144, 167, 145, 125, 236,
-	146, 126, 216, 252, 0, 164, 224, 189, 127, 188,
-	218, 251, 250, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 0, 263, 0, 208, 0, 0,
-	0, 0, 0, 0, 0, 204, 279, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 172, 214, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 240, 261, 273, 264, 0, 0, 0,
-	272, 0, 0, 0, 0, 0, 0, 198, 199, 200,
-	201, 0, 0, 141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 160, 166, 0, 168, 140, 213,
-	163, 270, 175, 205, 171, 237, 176, 183, 225, 269,
-	211, 230, 139, 260, 238, 187, 162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 180, 0, 223, 159, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 210, 0, 276, 277, 278, 262,
-	0, 0, 0, 0, 154, 0, 0, 0, 179, 0,
-	181, 0, 0, 239, 194, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 736, 0, 0, 0,
-	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 128, 244, 258, 138,
-	235, 271, 142, 242, 134, 209, 231, 130, 256, 241,
-	191, 173, 174, 129, 0, 226, 152, 165, 149, 207,
-	0, 0, 148, 274, 0, 266, 132, 133, 265, 206,
-	253, 257, 192, 186, 131, 255, 190, 185, 177, 156,
-	169, 219, 184, 220, 170, 196, 195, 197, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 0, 0, 0, 0, 0, 243,
-	0, 0, 178, 0, 0, 0, 0, 0, 229, 212,
-	0, 0, 217, 227, 182, 254, 221, 259, 245, 267,
-	0, 222, 124, 246, 151, 193, 135, 136, 147, 153,
-	155, 157, 158, 202, 203, 215, 234, 247, 248, 249,
-	150, 143, 228, 144, 167, 145, 125, 236, 146, 126,
-	216, 252, 0, 164, 224, 189, 127, 188, 218, 251,
-	250, 275, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 0, 263, 0, 208, 0, 0, 0, 0,
-	0, 0, 0, 204, 279, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 172, 214, 0, 233, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 240, 261, 273, 264, 0, 0, 0, 272, 0,
-	0, 0, 0, 0, 0, 198, 199, 200, 201, 0,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 160, 166, 0, 168, 140, 213, 163, 270,
-	175, 205, 171, 237, 176, 183, 225, 269, 211, 230,
-	139, 260, 238, 187, 162, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 180, 0, 223, 159, 87, 88, 89, 90,
+	123, 124, 0, 1214, 286, 287, 288, 272, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 254, 268, 141,
+	245, 281, 145, 252, 137, 213, 241, 133, 266, 251,
+	195, 177, 178, 132, 0, 236, 155, 169, 152, 211,
+	0, 0, 151, 284, 0, 276, 135, 136, 275, 210,
+	263, 267, 196, 190, 134, 265, 194, 189, 181, 159,
+	173, 229, 188, 230, 174, 200, 199, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 130,
+	0, 0, 0, 278, 0, 0, 0, 0, 0, 0,
+	253, 0, 0, 182, 0, 0, 0, 0, 0, 239,
+	216, 0, 0, 221, 237, 186, 264, 231, 269, 255,
+	277, 0, 232, 126, 256, 154, 197, 138, 139, 150,
+	156, 158, 160, 161, 206, 207, 219, 244, 257, 258,
+	259, 153, 146, 238, 147, 171, 148, 127, 246, 149,
+	128, 220, 262, 0, 168, 234, 193, 129, 192, 222,
+	261, 260, 285, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 164, 0, 273, 0, 212, 0, 0, 0,
+	0, 0, 0, 0, 208, 289, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 176, 218, 0, 243,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 250, 271, 283, 274, 0, 0, 0, 282,
+	0, 0, 0, 0, 0, 0, 202, 203, 204, 205,
+	0, 0, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 163, 0, 172, 143, 217, 165, 280,
+	179, 209, 175, 247, 180, 187, 235, 279, 215, 240,
+	142, 270, 248, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 184, 0, 233, 162, 0, 0, 0, 223, 224,
+	166, 167, 0, 0, 226, 227, 228, 225, 89, 90,
 	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 210, 0, 276, 277, 278, 262, 0, 0,
-	0, 0, 154, 0, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1471, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 0, 0, 198, 199, 200, 201, 0, 0, 141,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	210, 0, 276, 277, 278, 262, 0, 0, 0, 0,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 300, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 0, 266,
-	132, 133, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 205, 171, 237, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 187, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	121, 122, 123, 124, 214, 0, 286, 287, 288, 272,
+	0, 0, 0, 0, 157, 0, 0, 0, 183, 0,
+	185, 0, 0, 249, 198, 0, 0, 0, 0, 0,
+	0, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 346, 0, 0, 347, 0, 0,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 131, 254, 268,
+	141, 245, 281, 145, 252, 137, 213, 241, 133, 266,
+	251, 195, 177, 178, 132, 0, 236, 155, 169, 152,
+	211, 0, 0, 151, 284, 0, 276, 135, 136, 275,
+	210, 263, 267, 196, 190, 134, 265, 194, 189, 181,
+	159, 173, 229, 188, 230, 174, 200, 199, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	130, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 253, 0, 0, 182, 0, 0, 0, 0, 0,
+	239, 216, 0, 0, 221, 237, 186, 264, 231, 269,
+	255, 277, 0, 232, 126, 256, 154, 197, 138, 139,
+	150, 156, 158, 160, 161, 206, 207, 219, 244, 257,
+	258, 259, 153, 146, 238, 147, 171, 148, 127, 246,
+	149, 128, 220, 262, 0, 168, 234, 193, 129, 192,
+	222, 261, 260, 285, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 273, 0, 212, 0, 0,
+	0, 0, 0, 0, 0, 208, 289, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 176, 218, 0,
+	243, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 250, 271, 283, 274, 0, 0, 0,
+	282, 0, 0, 0, 0, 0, 0, 202, 203, 204,
+	205, 0, 0, 144, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 172, 143, 217, 165,
+	280, 179, 209, 175, 247, 180, 187, 235, 279, 215,
+	240, 142, 270, 248, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 184, 0, 233, 162, 0, 0, 0, 223,
+	224, 166, 167, 0, 0, 226, 227, 228, 225, 89,
+	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 214, 0, 286, 287, 288,
+	272, 0, 0, 0, 0, 157, 0, 0, 0, 183,
+	0, 185, 0, 0, 249, 198, 0, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 0, 0, 927, 0,
+	0, 0, 140, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 254,
+	268, 141, 245, 281, 145, 252, 137, 213, 241, 133,
+	266, 251, 195, 177, 178, 132, 0, 236, 155, 169,
+	152, 211, 0, 0, 151, 284, 0, 276, 135, 136,
+	275, 210, 263, 267, 196, 190, 134, 265, 194, 189,
+	181, 159, 173, 229, 188, 230, 174, 200, 199, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 130, 0, 0, 0, 278, 0, 0, 0, 0,
+	0, 0, 253, 0, 0, 182, 0, 0, 0, 0,
+	0, 239, 216, 0, 0, 221, 237, 186, 264, 231,
+	269, 255, 277, 0, 232, 126, 256, 154, 197, 138,
+	139, 150, 156, 158, 160, 161, 206, 207, 219, 244,
+	257, 258, 259, 153, 146, 238, 147, 171, 148, 127,
+	246, 149, 128, 220, 262, 0, 168, 234, 193, 129,
+	192, 222, 261, 260, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 273, 0, 212, 0,
+	0, 0, 0, 0, 0, 0, 208, 289, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 176, 218,
+	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 271, 283, 964, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 0, 202, 203,
+	204, 205, 0, 0, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 172, 143, 217,
+	165, 280, 179, 209, 175, 247, 180, 187, 235, 279,
+	215, 240, 142, 270, 248, 191, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 0,
+	0, 183, 0, 185, 0, 0, 249, 198, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 125, 0, 184, 0, 233, 162, 86, 0, 0,
+	223, 224, 166, 167, 140, 0, 226, 227, 228, 225,
+	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 0, 0, 286, 287,
+	288, 272, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 254, 268, 141, 245, 281, 145, 252, 137, 213,
+	241, 133, 266, 251, 195, 177, 178, 132, 0, 236,
+	155, 169, 152, 211, 0, 0, 151, 284, 0, 276,
+	135, 136, 275, 210, 263, 267, 196, 190, 134, 265,
+	194, 189, 181, 159, 173, 229, 188, 230, 174, 200,
+	199, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 130, 0, 0, 0, 278, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 182, 0, 0,
+	0, 0, 0, 239, 216, 0, 0, 221, 237, 186,
+	264, 231, 269, 255, 277, 0, 232, 126, 256, 154,
+	197, 138, 139, 150, 156, 158, 160, 161, 206, 207,
+	219, 244, 257, 258, 259, 153, 146, 238, 147, 171,
+	148, 127, 246, 149, 128, 220, 262, 0, 168, 234,
+	193, 129, 192, 222, 261, 260, 285, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 273, 0,
+	212, 0, 0, 0, 0, 0, 0, 0, 208, 289,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	176, 218, 0, 243, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 271, 283, 274,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	202, 203, 204, 205, 0, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 172,
+	143, 217, 165, 280, 179, 209, 175, 247, 180, 187,
+	235, 279, 215, 240, 142, 270, 248, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 425, 0, 125, 0, 184, 0, 233, 162, 0,
+	0, 0, 223, 224, 166, 167, 0, 0, 226, 227,
+	228, 225, 89, 90, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 210, 0, 276, 277,
-	278, 262, 0, 0, 0, 0, 154, 0, 0, 0,
-	179, 0, 181, 0, 0, 239, 194, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 0, 0, 332, 0,
-	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 128, 244,
-	258, 138, 235, 271, 142, 242, 134, 209, 231, 130,
-	256, 241, 191, 173, 174, 129, 0, 226, 152, 165,
-	149, 207, 0, 0, 148, 274, 0, 266, 132, 133,
-	265, 206, 253, 257, 192, 186, 131, 255, 190, 185,
-	177, 156, 169, 219, 184, 220, 170, 196, 195, 197,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 178, 0, 0, 0, 0, 0,
-	229, 212, 0, 0, 217, 227, 182, 254, 221, 259,
-	245, 267, 0, 222, 124, 246, 151, 193, 135, 136,
-	147, 153, 155, 157, 158, 202, 203, 215, 234, 247,
-	248, 249, 150, 143, 228, 144, 167, 145, 125, 236,
-	146, 126, 216, 252, 0, 164, 224, 189, 127, 188,
-	218, 251, 250, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 0, 263, 0, 208, 0, 0,
-	0, 0, 0, 0, 0, 204, 279, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 172, 214, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 240, 261, 273, 264, 0, 0, 0,
-	272, 0, 0, 0, 0, 0, 0, 198, 199, 200,
-	201, 0, 0, 141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 160, 166, 0, 168, 140, 213,
-	163, 270, 175, 205, 171, 237, 176, 183, 225, 269,
-	211, 230, 139, 260, 238, 187, 162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 180, 0, 223, 159, 87, 88,
+	117, 118, 119, 120, 121, 122, 123, 124, 290, 0,
+	286, 287, 288, 272, 0, 214, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 157, 0, 0, 0, 183,
+	0, 185, 0, 0, 249, 198, 0, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 86, 0, 0, 0, 0,
+	0, 0, 140, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 254,
+	268, 141, 245, 281, 145, 252, 137, 213, 241, 133,
+	266, 251, 195, 177, 178, 132, 0, 236, 155, 169,
+	152, 211, 0, 0, 151, 284, 0, 276, 135, 136,
+	275, 210, 263, 267, 196, 190, 134, 265, 194, 189,
+	181, 159, 173, 229, 188, 230, 174, 200, 199, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 130, 0, 0, 0, 278, 0, 0, 0, 0,
+	0, 0, 253, 0, 0, 182, 0, 0, 0, 0,
+	0, 239, 216, 0, 0, 221, 237, 186, 264, 231,
+	269, 255, 277, 0, 232, 126, 256, 154, 197, 138,
+	139, 150, 156, 158, 160, 161, 206, 207, 219, 244,
+	257, 258, 259, 153, 146, 238, 147, 171, 148, 127,
+	246, 149, 128, 220, 262, 0, 168, 234, 193, 129,
+	192, 222, 261, 260, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 273, 0, 212, 0,
+	0, 0, 0, 0, 0, 0, 208, 289, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 176, 218,
+	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 271, 283, 274, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 0, 202, 203,
+	204, 205, 0, 0, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 172, 143, 217,
+	165, 280, 179, 209, 175, 247, 180, 187, 235, 279,
+	215, 240, 142, 270, 248, 191, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 157, 0, 0,
+	0, 183, 0, 185, 0, 0, 249, 198, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 125, 0, 184, 0, 233, 162, 86, 0, 0,
+	223, 224, 166, 167, 140, 0, 226, 227, 228, 225,
 	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 210, 0, 276, 277, 278, 262,
-	0, 0, 0, 0, 154, 0, 0, 0, 179, 0,
-	181, 0, 0, 239, 194, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 736, 0, 0, 0,
-	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 128, 244, 258, 138,
-	235, 271, 142, 242, 134, 209, 231, 130, 256, 241,
-	191, 173, 174, 129, 0, 226, 152, 165, 149, 207,
-	0, 0, 148, 274, 0, 266, 132, 133, 265, 206,
-	253, 257, 192, 186, 131, 255, 190, 185, 177, 156,
-	169, 219, 184, 220, 170, 196, 195, 197, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 0, 0, 0, 0, 0, 243,
-	0, 0, 178, 0, 0, 0, 0, 0, 229, 212,
-	0, 0, 217, 227, 182, 254, 221, 259, 245, 267,
-	0, 222, 124, 246, 151, 193, 135, 136, 147, 153,
-	155, 157, 158, 202, 203, 215, 234, 247, 248, 249,
-	150, 143, 228, 144, 167, 145, 125, 236, 146, 126,
-	216, 252, 0, 164, 224, 189, 127, 188, 218, 251,
-	250, 275, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 0, 263, 0, 208, 0, 0, 0, 0,
-	0, 0, 0, 204, 279, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 172, 214, 0, 233, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 240, 261, 273, 774, 0, 0, 0, 272, 0,
-	0, 0, 0, 0, 0, 198, 199, 200, 201, 0,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 160, 166, 0, 168, 140, 213, 163, 270,
-	175, 205, 171, 237, 176, 183, 225, 269, 211, 230,
-	139, 260, 238, 187, 162, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 180, 0, 223, 159, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 210, 0, 276, 277, 278, 262, 0, 0,
-	0, 0, 154, 0, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 0, 0, 198, 199, 200, 201, 0, 0, 141,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 408, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	210, 0, 276, 277, 278, 262, 0, 0, 0, 81,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 0, 266,
-	132, 133, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 205, 171, 237, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 187, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
+	119, 120, 121, 122, 123, 124, 0, 0, 286, 287,
+	288, 272, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 254, 268, 141, 245, 281, 145, 252, 137, 213,
+	241, 133, 266, 251, 195, 177, 178, 132, 0, 236,
+	155, 169, 152, 211, 0, 0, 151, 284, 0, 276,
+	135, 136, 275, 210, 263, 267, 196, 190, 134, 265,
+	194, 189, 181, 159, 173, 229, 188, 230, 174, 200,
+	199, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 130, 0, 0, 0, 278, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 182, 0, 0,
+	0, 0, 0, 239, 216, 0, 0, 221, 237, 186,
+	264, 231, 269, 255, 277, 0, 232, 126, 256, 154,
+	197, 138, 139, 150, 156, 158, 160, 161, 206, 207,
+	219, 244, 257, 258, 259, 153, 146, 238, 147, 171,
+	148, 127, 246, 149, 128, 220, 262, 0, 168, 234,
+	193, 129, 192, 222, 261, 260, 285, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 164, 0, 273, 0,
+	212, 0, 0, 0, 0, 0, 0, 0, 208, 289,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	176, 218, 0, 243, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 250, 271, 283, 274,
+	0, 0, 0, 282, 0, 0, 0, 0, 0, 0,
+	202, 203, 204, 205, 0, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 172,
+	143, 217, 165, 280, 179, 209, 175, 247, 180, 187,
+	235, 279, 215, 240, 142, 270, 248, 191, 0, 214,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 157,
+	0, 0, 0, 183, 0, 185, 0, 0, 249, 198,
+	0, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	0, 0, 0, 125, 0, 184, 0, 233, 162, 86,
+	0, 0, 223, 224, 166, 167, 140, 0, 226, 227,
+	228, 225, 89, 90, 91, 92, 93, 94, 95, 96,
 	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 0, 210, 276, 277,
-	278, 262, 454, 0, 0, 0, 0, 154, 0, 0,
-	0, 179, 0, 181, 0, 0, 239, 194, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 459, 460, 461, 456,
-	0, 0, 0, 137, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
-	244, 258, 138, 235, 271, 142, 242, 134, 209, 231,
-	130, 256, 241, 191, 173, 174, 129, 0, 226, 152,
-	165, 149, 207, 0, 0, 148, 274, 0, 266, 132,
-	133, 265, 206, 253, 257, 192, 186, 131, 255, 190,
-	185, 177, 156, 169, 219, 184, 220, 170, 196, 195,
-	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 0, 0, 0,
-	0, 0, 243, 0, 0, 178, 0, 0, 0, 0,
-	0, 229, 212, 0, 0, 217, 227, 182, 254, 221,
-	259, 245, 267, 0, 222, 124, 246, 151, 193, 135,
-	136, 147, 153, 155, 157, 158, 202, 203, 215, 234,
-	247, 248, 249, 150, 143, 228, 144, 167, 145, 125,
-	236, 146, 126, 216, 252, 0, 164, 224, 189, 127,
-	188, 218, 251, 250, 275, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 0, 263, 0, 208, 0,
-	0, 0, 0, 0, 0, 0, 204, 279, 0, 0,
-	0, 0, 232, 0, 0, 0, 0, 0, 172, 214,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 261, 273, 264, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 0, 198, 199,
-	200, 201, 0, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 160, 166, 0, 168, 140,
-	213, 163, 270, 175, 205, 171, 237, 176, 183, 225,
-	269, 211, 230, 139, 260, 238, 187, 162, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 180, 0, 223, 159, 459,
-	460, 461, 456, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 276, 277, 278,
-	262, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 0, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 459, 460, 461, 0, 0, 0, 0, 137,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 0,
-	0, 148, 274, 0, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 0, 0, 0, 0, 0, 243, 0,
-	0, 178, 0, 0, 0, 0, 0, 229, 212, 0,
-	0, 217, 227, 182, 254, 221, 259, 245, 267, 0,
-	222, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 0, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 0, 0, 0, 0, 1677,
-	161, 0, 263, 0, 208, 0, 0, 0, 0, 0,
-	0, 0, 204, 279, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 1118, 172, 214, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 261, 273, 264, 0, 0, 0, 272, 2071, 1677,
-	0, 0, 0, 0, 198, 199, 200, 201, 1659, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 1118, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 0, 0, 0, 0, 0, 1745,
-	0, 0, 0, 0, 0, 0, 0, 0, 1659, 0,
-	0, 0, 1677, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 0, 1118, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1659, 0, 276, 277, 278, 262, 0, 0, 1663,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1667, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1656, 0, 0, 0, 1658, 1660, 1662, 0, 1664, 1665,
-	1666, 1668, 1669, 1670, 1672, 1673, 1674, 1675, 0, 1663,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1667, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1678, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1656, 0, 0, 0, 1658, 1660, 1662, 0, 1664, 1665,
-	1666, 1668, 1669, 1670, 1672, 1673, 1674, 1675, 0, 0,
-	1676, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1663, 0, 0, 0, 0, 1655, 0, 0,
-	1678, 0, 0, 1667, 0, 0, 0, 0, 0, 0,
-	0, 0, 1671, 0, 0, 0, 0, 0, 0, 1661,
-	0, 0, 0, 1656, 0, 0, 0, 1658, 1660, 1662,
-	1676, 1664, 1665, 1666, 1668, 1669, 1670, 1672, 1673, 1674,
-	1675, 0, 0, 0, 0, 0, 0, 1655, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1671, 1678, 0, 0, 0, 0, 0, 1661,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1676, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1655, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1671, 0, 0, 0, 0,
-	0, 0, 1661,
+	117, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	286, 287, 288, 272, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 254, 268, 141, 245, 281, 145, 252,
+	137, 213, 241, 133, 266, 251, 195, 177, 178, 132,
+	0, 236, 155, 169, 152, 211, 0, 0, 151, 284,
+	0, 276, 135, 136, 275, 210, 263, 267, 196, 190,
+	134, 265, 194, 189, 181, 159, 173, 229, 188, 230,
+	174, 200, 199, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 130, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 253, 0, 0, 182,
+	0, 0, 0, 0, 0, 239, 216, 0, 0, 221,
+	237, 186, 264, 231, 269, 255, 277, 0, 232, 126,
+	256, 154, 197, 138, 139, 150, 156, 158, 160, 161,
+	206, 207, 219, 244, 257, 258, 259, 153, 146, 238,
+	147, 171, 148, 127, 246, 149, 128, 220, 262, 0,
+	168, 234, 193, 129, 192, 222, 261, 260, 285, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 164, 0,
+	273, 0, 212, 0, 0, 0, 0, 0, 0, 0,
+	208, 289, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 176, 218, 0, 243, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 250, 271,
+	283, 274, 0, 0, 0, 282, 0, 0, 0, 0,
+	0, 0, 202, 203, 204, 205, 0, 0, 144, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 172, 143, 217, 165, 280, 179, 209, 175, 247,
+	180, 187, 235, 279, 215, 240, 142, 270, 248, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 184, 0, 233,
+	162, 0, 0, 0, 223, 224, 166, 167, 0, 0,
+	226, 227, 228, 225, 89, 90, 91, 92, 93, 94,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	214, 0, 286, 287, 288, 272, 0, 0, 0, 0,
+	157, 0, 0, 0, 183, 0, 185, 0, 0, 249,
+	198, 0, 0, 0, 0, 0, 0, 170, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	484, 485, 486, 481, 0, 0, 0, 140, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 254, 268, 141, 245, 281, 145,
+	252, 137, 213, 241, 133, 266, 251, 195, 177, 178,
+	132, 0, 236, 155, 169, 152, 211, 0, 0, 151,
+	284, 0, 276, 135, 136, 275, 210, 263, 267, 196,
+	190, 134, 265, 194, 189, 181, 159, 173, 229, 188,
+	230, 174, 200, 199, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 130, 0, 0, 0,
+	278, 0, 0, 0, 0, 0, 0, 253, 0, 0,
+	182, 0, 0, 0, 0, 0, 239, 216, 0, 0,
+	221, 237, 186, 264, 231, 269, 255, 277, 0, 232,
+	126, 256, 154, 197, 138, 139, 150, 156, 158, 160,
+	161, 206, 207, 219, 244, 257, 258, 259, 153, 146,
+	238, 147, 171, 148, 127, 246, 149, 128, 220, 262,
+	0, 168, 234, 193, 129, 192, 222, 261, 260, 285,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 164,
+	0, 273, 0, 212, 0, 0, 0, 0, 0, 0,
+	0, 208, 289, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 176, 218, 0, 243, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 250,
+	271, 283, 274, 0, 0, 0, 282, 0, 0, 0,
+	0, 0, 0, 202, 203, 204, 205, 0, 0, 144,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	163, 0, 172, 143, 217, 165, 280, 179, 209, 175,
+	247, 180, 187, 235, 279, 215, 240, 142, 270, 248,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 0, 0, 0,
+	479, 0, 0, 0, 0, 157, 0, 0, 0, 183,
+	0, 185, 0, 0, 249, 198, 125, 0, 184, 0,
+	233, 162, 0, 0, 0, 223, 224, 166, 167, 0,
+	0, 226, 227, 228, 225, 484, 485, 486, 481, 0,
+	0, 0, 140, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 287, 288, 272, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 254,
+	268, 141, 245, 281, 145, 252, 137, 213, 241, 133,
+	266, 251, 195, 177, 178, 132, 0, 236, 155, 169,
+	152, 211, 0, 0, 151, 284, 0, 276, 135, 136,
+	275, 210, 263, 267, 196, 190, 134, 265, 194, 189,
+	181, 159, 173, 229, 188, 230, 174, 200, 199, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 130, 0, 0, 0, 278, 0, 0, 0, 0,
+	0, 0, 253, 0, 0, 182, 0, 0, 0, 0,
+	0, 239, 216, 0, 0, 221, 237, 186, 264, 231,
+	269, 255, 277, 0, 232, 126, 256, 154, 197, 138,
+	139, 150, 156, 158, 160, 161, 206, 207, 219, 244,
+	257, 258, 259, 153, 146, 238, 147, 171, 148, 127,
+	246, 149, 128, 220, 262, 0, 168, 234, 193, 129,
+	192, 222, 261, 260, 285, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 164, 0, 273, 0, 212, 0,
+	0, 0, 0, 0, 0, 0, 208, 289, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 176, 218,
+	0, 243, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 250, 271, 283, 274, 0, 0,
+	0, 282, 0, 0, 0, 0, 0, 0, 202, 203,
+	204, 205, 0, 0, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 163, 0, 172, 143, 217,
+	165, 280, 179, 209, 175, 247, 180, 187, 235, 279,
+	215, 240, 142, 270, 248, 191, 0, 214, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 157, 0, 0,
+	0, 183, 0, 185, 0, 0, 249, 198, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 125, 0, 184, 0, 233, 162, 484, 485, 486,
+	223, 224, 166, 167, 140, 0, 226, 227, 228, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 287,
+	288, 272, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 254, 268, 141, 245, 281, 145, 252, 137, 213,
+	241, 133, 266, 251, 195, 177, 178, 132, 0, 236,
+	155, 169, 152, 211, 0, 0, 151, 284, 0, 276,
+	135, 136, 275, 210, 263, 267, 196, 190, 134, 265,
+	194, 189, 181, 159, 173, 229, 188, 230, 174, 200,
+	199, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 130, 0, 0, 0, 278, 0, 0,
+	0, 0, 0, 0, 253, 0, 0, 182, 0, 0,
+	0, 0, 0, 239, 216, 0, 0, 221, 237, 186,
+	264, 231, 269, 255, 277, 0, 232, 126, 256, 154,
+	197, 138, 139, 150, 156, 158, 160, 161, 206, 207,
+	219, 244, 257, 258, 259, 153, 146, 238, 147, 171,
+	148, 127, 246, 149, 128, 220, 262, 0, 168, 234,
+	193, 129, 192, 222, 261, 260, 285, 0, 0, 0,
+	0, 80, 1751, 24, 41, 25, 164, 0, 273, 0,
+	212, 0, 0, 0, 0, 0, 0, 0, 208, 289,
+	0, 66, 0, 0, 242, 73, 0, 0, 1171, 0,
+	176, 218, 0, 243, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 42, 250, 271, 283, 274,
+	76, 0, 0, 282, 0, 1830, 0, 0, 0, 0,
+	202, 203, 204, 205, 1733, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 163, 0, 172,
+	143, 217, 165, 280, 179, 209, 175, 247, 180, 187,
+	235, 279, 215, 240, 142, 270, 248, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1751, 0, 0, 0, 0, 0, 69, 70, 0, 71,
+	72, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 184, 1171, 233, 162, 0,
+	0, 0, 223, 224, 166, 167, 0, 0, 226, 227,
+	228, 225, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1733, 58, 68, 77, 0, 39, 0, 40,
+	0, 0, 0, 0, 0, 0, 1737, 0, 0, 0,
+	286, 287, 288, 272, 67, 65, 64, 1741, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1730, 0, 0,
+	0, 1732, 1734, 1736, 0, 1738, 1739, 1740, 1742, 1743,
+	1744, 1746, 1747, 1748, 1749, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1752, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	50, 0, 0, 0, 0, 0, 51, 1750, 0, 0,
+	0, 0, 0, 0, 1737, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1729, 1741, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1745,
+	0, 0, 0, 52, 0, 1730, 1735, 0, 0, 1732,
+	1734, 1736, 0, 1738, 1739, 1740, 1742, 1743, 1744, 1746,
+	1747, 1748, 1749, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1752, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 0, 1750, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1729, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1745, 0, 0,
+	0, 0, 0, 0, 1735,
 }
 
 var yyPact = [...]int{
-	147, -1000, -299, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	16743, -1000, -302, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 15017, 14675, -1000, 6645, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 351,
+	332, 10803, 15359, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	6196, 5747, 228, -1000, 1807, -1000, -1000, -1000, 541, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1153, 35, 481,
+	488, 615, 615, 7507, 1807, 1533, 199, 71, -1000, 14237,
+	1768, 16743, 277, 15359, -1000, 580, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 14772, 1724, -1000, 6387, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 219, 12682,
-	15190, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5951, 5515,
-	130, -1000, 1712, -1000, -1000, -1000, 121, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 641, -31, 320, 327, 324,
-	324, 7223, 1712, 1444, 153, 5, -1000, 14354, 1657, 147,
-	170, 15190, -1000, 400, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2748,72 +2804,51 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	10803, 1513, -1000, 15359, -24, 682, -1000, 268, 257, 358,
+	579, -1000, -1000, -1000, -1000, 15359, 1129, 1479, 1738, -1000,
+	-1000, -1000, 1752, 1628, 16145, 199, -1000, 1464, 1488, -1000,
+	-1000, 1627, -1000, 93, 102, 58, 188, -1000, -1000, 250,
+	-1000, -1000, -1000, -1000, -1000, 81, -1000, 96, -1000, 89,
+	-1000, -1000, -1000, -75, -1000, -1000, -1000, -1000, -1000, 1434,
+	475, 1651, -137, 1743, 1784, 1533, 1814, 1779, 1777, 1773,
+	304, 304, 326, 304, 349, -1000, -1000, -1000, -1000, -1000,
+	-1000, 739, 263, -1000, -1000, -69, 1661, 636, 1661, 34,
+	-1000, -1000, -1000, -1000, -1000, -1000, 311, -1000, -142, -1000,
+	455, -1000, 421, -1000, 9250, 242, 1485, 722, -1000, 653,
+	15359, 15359, 15359, 653, 846, 828, 578, -1000, -1000, -1000,
+	1729, 1730, 1784, 1533, -1000, 1807, 1807, 1340, 1272, 311,
+	311, 311, 311, 311, 1482, 15359, -1000, 1561, 4416, -1000,
+	-1000, -1000, -1000, -1000, 258, 1626, -1000, 2215, 1690, 1355,
+	16145, 10803, 15359, -1000, 567, 971, 1128, -1000, -1000, 268,
+	1477, -1000, 603, -1000, -1000, -1000, -1000, 15359, 1623, -1000,
+	15359, 10803, 10803, 10803, 10803, 10803, -1000, 1681, 1679, -1000,
+	1680, 1678, 1666, 1671, 15359, -1000, 4857, -1000, -1000, 15790,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1336, 1807, 211,
+	684, 12349, 13464, 15359, 12349, -1000, -1000, -1000, -1000, -1000,
+	-81, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 211, 12349, 12349, -31, -1000, -1000, -289, 1743, 4857,
+	-1000, -1000, 4857, -1000, -1000, -1000, -1000, -1000, -1000, 12349,
+	705, 13464, 1077, 15359, 304, 15359, -1000, -1000, 636, 636,
+	-1000, 739, 739, -1000, -1000, -84, 1825, 5298, -61, 15359,
+	304, 13895, 1750, -92, 476, 423, 460, -1000, -1000, 1836,
+	-1000, -1000, 1432, 9687, 8813, 301, 12349, 3093, -1000, -1000,
+	653, 653, 653, 3093, 604, -1000, -1000, -1000, -1000, -1000,
+	-1000, 15359, -1000, -1000, 1743, -1000, -1000, -1000, 1784, 1743,
+	1784, -1000, -1000, 12349, 13464, 15359, 15359, 16487, 15359, 1482,
+	1753, 15359, 1413, -1000, -1000, 8382, 565, 4857, 1003, 1622,
+	-1000, 1621, 1620, 1619, 1615, 1614, 1612, 1611, 1571, 1610,
+	1609, 1606, -1000, -1000, -1000, 1605, -1000, -1000, 1603, 1571,
+	1600, 1597, 1596, -1000, -1000, -1000, -1000, 2533, -1000, -217,
+	-1000, -1000, 2652, 5298, 5298, 5298, 5298, -1000, -1000, 1594,
+	4857, 1593, -231, -1000, -1000, -232, 1590, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 830, -1000, 1588,
+	1574, 1572, 1571, 1570, 1127, 1126, 1116, 1568, 1567, 1566,
+	5298, 1564, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	12682, 15190, -69, 533, -1000, 196, 178, 159, 387, -1000,
-	-1000, -1000, -1000, 15190, 1431, -1000, -1000, -1000, 1658, 15609,
-	153, -1000, 1379, 1374, -1000, -1000, 1534, -1000, 83, 4,
-	-16, 124, -1000, -1000, 149, -1000, -1000, -1000, -1000, -1000,
-	45, -1000, 2, -1000, -9, -1000, -1000, -1000, -107, -1000,
-	-1000, -1000, -1000, -1000, 1373, 328, 1560, -174, 1630, 1665,
-	1444, 1702, 1680, 191, 191, 204, 191, 218, -1000, -1000,
-	-1000, -1000, -1000, -1000, 581, 148, -1000, -1000, -118, -134,
-	457, -134, -4, -1000, -1000, -1000, -1000, -1000, -1000, 192,
-	-1000, -188, -1000, 305, -1000, 288, -1000, 8914, 145, 1396,
-	541, -1000, 553, 15190, 15190, 15190, 553, 786, 703, 371,
-	-1000, -1000, -1000, 1619, 1620, 1665, 1444, -1000, 1712, 1712,
-	1234, 1093, 192, 192, 192, 192, 192, 1394, 15190, -1000,
-	1462, 4223, -1000, -1000, -1000, -1000, -1000, 162, 1525, -1000,
-	15190, 1442, -1000, 366, 839, 960, -1000, -1000, 196, 1357,
-	-1000, 422, -1000, -1000, -1000, -1000, 15190, 1523, 15190, 12682,
-	12682, 12682, 12682, -1000, 1604, 1603, -1000, 1574, 1572, 1595,
-	15190, -1000, -1000, -1000, 15952, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1224, 1712, 109, 5598, 11846, 13518, 15190, 11846,
-	-1000, -1000, -1000, -1000, -1000, -108, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 109, 11846, 11846, -79,
-	-1000, -1000, -289, 1630, 4651, -1000, -1000, 4651, -1000, -1000,
-	11846, 575, 13518, 848, 15190, 191, 15190, -1000, -1000, 457,
-	457, -1000, 581, 581, -1000, -1000, -135, 1713, 5079, -116,
-	15190, 191, 13936, 1635, -137, 318, 282, 300, -1000, -1000,
-	-179, -1000, -1000, 1381, 9338, 8490, 202, 11846, 2939, -1000,
-	-1000, 553, 553, 553, 2939, 419, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15190, -1000, -1000, 1630, -1000, -1000, -1000, 1665,
-	1630, 1665, -1000, -1000, 11846, 13518, 15190, 15190, 16295, 15190,
-	1394, 1637, 15190, 1371, -1000, -1000, 8072, 365, 4651, 1083,
-	1521, -1000, 1520, 1519, 1518, 1502, 1499, 1498, 1497, 1472,
-	1495, 1493, 1489, -1000, -1000, -1000, 1488, -1000, -1000, 1487,
-	1472, 1486, 1485, 1482, -1000, -1000, -1000, -1000, 1012, -1000,
-	-1000, -1000, -1000, 2511, 5079, 5079, 5079, 5079, -1000, -1000,
-	1481, 4651, 1480, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 650, -1000, 1479, 1478,
-	1477, 1472, 1471, 959, 956, 952, 1469, 1465, 1464, 5079,
-	1463, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -286, -1000, 7950, 15359, 15359,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -287, -1000, 7653, 15190, 15190, -1000,
-	1704, 4651, 2087, -1000, 1661, -1000, 196, 68, -1000, -1000,
-	-1000, -1000, -1000, -1000, 361, 15190, 1345, -1000, 531, 1554,
-	1559, 1554, -1000, -1000, -1000, -1000, 1601, -1000, 1594, -1000,
-	-1000, 1462, -1000, -1000, 498, -1000, -1000, -1000, -1000, -1000,
-	2, -9, 1372, -1000, -35, 82, -1000, -1000, 1337, -1000,
-	-1000, -1000, 498, 1372, 208, 947, 946, -1000, 1141, 353,
-	1393, -1000, 1049, 214, 1634, 1381, 1540, 1622, 15190, 1713,
-	1713, 1713, 457, 16295, 581, 15190, 581, -1000, -1000, 581,
-	-1000, 347, 15190, 214, 1461, -1000, -1000, -1000, 304, 283,
-	296, 13518, 203, -1000, -1000, 1381, -1000, -1000, -1000, 1459,
-	528, -1000, -1000, 5079, -1000, 596, -1000, 2939, 2939, 2939,
-	-1000, 10592, -1000, -1000, 1630, -1000, 1630, 1372, 1381, 1558,
-	1392, -1000, -1000, -1000, -1000, -1000, 1455, 1335, -1000, 1713,
-	4223, -1000, 12682, -1000, 4651, 4651, 4651, -1000, 15190, 13100,
-	-1000, 591, 5079, -1000, -1000, -1000, -1000, -1000, -1000, 4651,
-	1677, 1677, 1677, 4651, 593, 4651, 4651, -1000, 670, 363,
-	1677, 1677, 1677, 1677, -1000, 1677, 1677, 1677, 5079, 5079,
-	5079, 5079, 5079, 5079, 5079, 5079, 5079, 5079, 5079, 5079,
-	1449, 585, 5079, 5079, 5079, 1093, 1359, 1389, -1000, -1000,
-	-1000, -1000, -1000, 549, 596, 4651, -1000, 363, 4651, 4651,
-	-1000, 1222, -1000, -1000, 4651, -1000, -1000, -1000, 4651, 5079,
-	4651, -1000, 1677, 1265, -1000, 1453, -1000, 1333, 1615, -1000,
-	346, 1385, -1000, 527, 1322, -1000, 1665, 596, -1000, 345,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2827,150 +2862,182 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1808, 4857, 10803, 1372, -1000, 2215, -1000,
+	1776, -1000, 268, 118, -1000, -1000, -1000, -1000, -1000, -1000,
+	558, 15359, 1407, -1000, 679, 1738, 1632, 1649, 1632, -1000,
+	-1000, -1000, -1000, 1668, -1000, 1667, -1000, 1502, -1000, -1000,
+	1561, 1362, 554, -1000, -1000, 703, -1000, -1000, -1000, -1000,
+	-1000, 96, 89, 1370, -1000, 8, 91, -1000, -1000, 1474,
+	-1000, -1000, -1000, 703, 1370, 331, 1110, 1107, -1000, 1152,
+	1481, -1000, 1082, 260, 1749, 1432, 1637, 1732, 15359, 1825,
+	1825, 1825, 636, 16487, 739, 15359, 739, -1000, -1000, 739,
+	-1000, 543, 15359, 260, 1560, -1000, -1000, -1000, 463, 420,
+	415, 13464, 329, -1000, -1000, 1432, -1000, -1000, -1000, 1558,
+	669, -1000, -1000, 5298, -1000, 788, -1000, 3093, 3093, 3093,
+	-1000, 11234, -1000, -1000, 1743, -1000, 1743, 1370, 1432, 1648,
+	1480, -1000, -1000, -1000, -1000, 1552, 1472, -1000, 1825, 4416,
+	-1000, 10803, -1000, 4857, 4857, 4857, -1000, 15359, 13033, -1000,
+	764, 5298, -1000, -1000, -1000, -1000, -1000, -1000, 4857, 1771,
+	1771, 1771, 4857, 728, 4857, 4857, -1000, 842, 553, 1771,
+	1771, 1771, 1771, -1000, 1771, 1771, 1771, 5298, 5298, 5298,
+	5298, 5298, 5298, 5298, 5298, 5298, 5298, 5298, 5298, 1543,
+	744, 5298, 5298, 5298, 1095, 1086, 1272, 1350, 1470, -1000,
+	-1000, -1000, -1000, -1000, 696, 788, 4857, 1551, 1549, 15359,
+	-1000, 553, 4857, 4857, -1000, 1332, -1000, -1000, 4857, -1000,
+	-1000, -1000, 4857, 5298, 4857, -1000, 1771, 1343, -1000, 1548,
+	-1000, 1466, 1722, -1000, 536, 1446, -1000, 666, 1462, -1000,
+	1784, 788, 1372, -1000, -1000, 520, -1000, -1000, -1000, -1000,
+	-28, -1000, -1000, 15359, 1458, 1808, 15359, 4857, -1000, -1000,
+	4857, 1547, -1000, 4857, -1000, -1000, -1000, -1000, -1000, 1084,
+	15359, 1831, 518, 513, 12349, -1000, 182, 12349, -1000, -1000,
+	15359, 328, 12349, 24, -102, 4857, 4857, 4857, -1000, -1000,
+	-1000, -194, -1000, 59, -1000, 1644, 183, -1000, 1732, -1000,
+	453, -1000, 1546, -1000, -1000, -1000, 1825, -1000, 636, -1000,
+	636, 739, 15359, -1000, -1000, -194, 1319, -1000, -1000, -1000,
+	408, 1432, 12349, 1055, 301, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 15359, 16743, -1000, 15359, 1821, -1000, 1417, -1000,
+	792, 734, -1000, 509, -1000, -1000, 794, -1000, 1309, 1154,
+	788, 4857, -1000, -1000, 4857, 4857, 879, 4857, 1305, 1456,
+	1451, -1000, 1303, -1000, 1830, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -70,
-	-1000, -1000, 15190, 1319, 1704, 15190, 4651, -1000, -1000, 4651,
-	1452, -1000, 4651, -1000, -1000, -1000, -1000, 1723, 344, 343,
-	11846, -1000, 144, 11846, -1000, -1000, 15190, 200, 11846, -14,
-	-141, 4651, 4651, 15190, 4651, -1000, -1000, -1000, -227, -1000,
-	-53, -1000, 1557, 69, -1000, 1622, -1000, 506, -1000, 1450,
-	-1000, -1000, -1000, 1713, -1000, 457, -1000, 457, 581, 15190,
-	-1000, -1000, -227, 1216, -1000, -1000, -1000, 278, 1381, 11846,
-	901, 202, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15190,
-	15190, 147, -1000, 15190, 1715, -1000, 1378, 1539, -1000, 618,
-	583, -1000, 342, -1000, -1000, 635, -1000, 1208, 1257, 596,
-	4651, -1000, -1000, 4651, 4651, 861, 4651, 1203, 1317, 1287,
-	-1000, 1193, -1000, 1722, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 4857, 4857, 4857, 4857, 4857, 4857,
+	4857, 810, 2485, -1000, 768, 768, 608, 608, 608, 608,
+	608, 1135, 1135, -1000, -1000, -1000, 2652, 1543, 5298, 5298,
+	5298, 291, 1974, 1799, -1000, -1000, -1000, 4857, 709, -1000,
+	4857, 916, 274, 274, 1449, -1000, 1297, 1103, 1280, -1000,
+	1202, 1273, 1641, 1267, 4857, -286, 3975, 269, 15359, -286,
+	15359, 15359, 3975, -1000, 15359, -1000, -1000, 2215, 970, -1000,
+	-1000, 1784, -1000, 788, 788, 15359, 788, -108, 499, 12349,
+	617, 665, -1000, 10461, 12349, -1000, -1000, 12349, 141, 1740,
+	-1000, -1000, -45, -37, 788, 788, -1000, -1000, -16, -1000,
+	-1000, -1000, 390, -1000, 1083, 1072, 1071, 1062, 15359, -1000,
+	-1000, -1000, -1000, -1000, 661, 661, 661, 1729, 7076, -1000,
+	1825, 1825, 636, -1000, 43, -2, -1000, 1370, 1265, -1000,
+	-1000, -1000, 1261, -1000, 1818, 1813, 12691, -1000, -1000, 4857,
+	1325, 1311, 1299, 707, 1428, -1000, -1000, -1000, -1000, 4857,
+	1284, 1278, 1271, 1268, 1213, 1210, 1204, 1424, -1000, 291,
+	1974, 1529, -1000, 5298, 5298, 1200, 685, -1000, 4857, 779,
+	707, 704, 1259, 1808, 1812, 1257, -186, -1000, 4857, -1000,
+	-1000, 704, -1000, 5298, -1000, 1172, -1000, 1254, 1376, -1000,
+	-286, -1000, -1000, 1343, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1422, 1541, 15359, 1370, -1000, -1000, -1000, -1000, 12349,
+	1755, 260, -1000, 95, 341, -292, -33, 1810, 1809, -16,
+	-1000, 967, 966, 964, 954, 2, -1000, -1000, -1000, -1000,
+	-1000, 1540, 704, -1000, 834, 1059, 1238, 1359, -1000, -1000,
+	-1000, 643, 963, -1000, 15359, 762, 465, 304, 465, 760,
+	1536, -1000, -1000, -1000, -1000, 1825, -1000, 43, -1000, 361,
+	387, 131, 1805, -1000, -1000, -1000, 4857, 4857, -1000, -1000,
+	788, -1000, -1000, -1000, 1225, -1000, 1515, 1521, -1000, 1515,
+	1515, 1515, 405, 405, -1000, 1530, 1530, 1535, 1530, -1000,
+	1156, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	5298, -1000, -1000, -1000, -1000, 788, 4857, 1208, 1199, -1000,
+	-76, 4857, -1000, 1527, 1061, 1197, 2072, -1000, -1000, 3975,
+	1343, -1000, 15359, -1000, 12349, 12349, -196, 73, 15359, -295,
+	1058, -1000, 1804, 1056, 839, -1000, -1000, -1000, -1000, -1000,
+	-1000, 11918, -1000, -1000, -1000, -1000, -1000, -1000, 16855, 7076,
+	1405, 72, -1000, -1000, -1000, 1515, -1000, 1521, 1515, 1515,
+	1515, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1520, 1518, -1000, 1515, 1517, 1515, 1515, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4651, 4651, 4651, 4651, 4651, 4651, 4651,
-	770, 887, -1000, 1027, 1027, 334, 334, 334, 334, 334,
-	1036, 1036, -1000, -1000, -1000, 2511, 1449, 5079, 5079, 5079,
-	176, 1703, 1583, -1000, 4651, 559, -1000, 4651, 733, -1000,
-	1191, 644, 1189, -1000, 1031, 1150, 1564, 1147, 4651, -287,
-	3795, 175, 15190, -287, 15190, 15190, 3795, -1000, 15190, -1000,
-	2087, 836, -1000, -1000, 1665, -1000, 596, 596, 15190, 596,
-	11846, 445, 492, -1000, 10174, 11846, -1000, -1000, 11846, 103,
-	1628, -1000, -1000, -99, -86, 596, 596, 340, -1000, -1000,
-	-68, -1000, -1000, -1000, 217, -1000, 942, 921, 920, 914,
-	15190, -1000, -1000, -1000, -1000, -1000, 508, 508, 508, 1619,
-	6805, -1000, 1713, 1713, 457, -1000, -1, -43, -1000, 1372,
-	1145, -1000, -1000, -1000, -1000, 1135, -1000, 1708, 1700, 12682,
-	12264, -1000, -1000, 4651, 1343, 1339, 1327, 227, 1273, -1000,
-	-1000, -1000, -1000, 4651, 1324, 1259, 1239, 1214, 1204, 1195,
-	1177, 1271, -1000, 176, 1703, 1458, -1000, 5079, 5079, 1160,
-	507, -1000, 4651, 691, 227, 398, -1000, 4651, -1000, -1000,
-	398, -1000, 5079, -1000, 1149, -1000, 1126, 1377, -1000, -287,
-	-1000, -1000, 1265, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1269, 1372, -1000, -1000, -1000, -1000, 11846,
-	1638, 214, -1000, -2, 216, -291, -81, 1699, 1698, 15190,
-	-68, -1000, 835, 834, 833, 830, -38, -1000, -1000, -1000,
-	-1000, -1000, 1448, 398, -1000, 698, 912, 1115, 1294, -1000,
-	-1000, -1000, 905, 526, -1000, 15190, 608, 319, 191, 319,
-	594, 1446, -1000, -1000, -1000, -1000, 1713, -1000, -1, -1000,
-	275, 276, 31, 1697, -1000, -1000, -1000, 4651, 4651, 1539,
-	-1000, -1000, 596, -1000, -1000, -1000, 1107, -1000, 1428, 1441,
-	-1000, 1428, 1428, 1428, 281, 281, 1443, 1443, 1445, 1443,
-	-1000, 1132, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5079, -1000, -1000, -1000, -1000, 596, 4651, 1101, 1076,
-	930, 1056, 1522, -1000, -1000, 3795, 1265, -1000, -1000, 11846,
-	11846, -231, 0, 15190, -294, 911, -1000, 1696, 906, 757,
-	-1000, -1000, -1000, -1000, -1000, -1000, 11428, -1000, -1000, -1000,
-	-1000, -1000, -1000, 16667, 6805, 941, -26, -1000, -1000, -1000,
-	1428, -1000, 1441, 1428, 1428, 1428, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1440, 1438, -1000, 1428, 1437,
-	1428, 1428, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15190,
-	15190, -1000, 15190, 15190, 191, 4651, -1000, -1000, -1000, -1000,
-	823, -1000, -1000, -1000, 901, 596, 1257, -1000, -1000, -1000,
-	806, -1000, 803, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 802, -1000, -1000, 800, -1000, -1000, -1000, 596, -1000,
-	-1000, -1000, 4651, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-116, -296, 798, -1000, 900, -84, -1000, -1000, 1254, -1000,
-	1428, 4651, 166, 16604, -1000, 508, 508, 323, 508, 508,
-	508, 508, 128, 127, 508, 508, 508, 508, 508, 508,
-	508, 508, 508, 508, 508, 508, 508, 508, 1427, -1000,
-	-1000, 941, -1000, -1000, 634, 5079, -1000, -1000, 898, 698,
-	313, 406, 1423, -1000, 93, 592, 588, -1000, 15190, -1000,
-	-29, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 895, 895,
-	-1000, -1000, 797, -1000, -1000, 1417, 1537, 53, 1414, -1000,
-	1413, 1412, 15190, 1129, 27, -1000, -1000, 1051, 1040, 1241,
-	1232, 992, -100, -91, -1000, 1410, -1000, -1000, 1695, -1000,
-	11428, 1627, 711, -1000, 1693, 16667, -1000, 776, 772, 508,
-	508, 767, 892, 891, 890, 508, 508, 766, 886, 15952,
-	763, 755, 745, 775, 885, 561, 771, 700, 699, 15190,
-	1409, 851, -1000, -1000, 1703, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 743, 1408, -1000, -1000,
-	1404, -1000, -1000, 1221, -1000, 1212, 1034, 11428, 38, 38,
-	11428, 11428, 11428, 1403, 266, -1000, -1000, -1000, -1000, 742,
-	-1000, 729, -1000, 194, -97, -91, -1000, 1692, -89, 1691,
-	1690, 15190, 757, 90, -1000, -1000, 1627, 85, -1000, -1000,
-	-1000, 398, 398, -1000, -1000, -1000, -1000, 884, 882, -1000,
+	-1000, -1000, -1000, -1000, 15359, 15359, -1000, 15359, 15359, 304,
+	4857, -1000, -1000, -1000, -1000, 943, -1000, -1000, -1000, 1055,
+	788, 1154, -1000, -1000, -1000, 941, -1000, 940, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 939, -1000, -1000, 938,
+	-1000, -1000, -1000, 788, -1000, -1000, -1000, 145, -1000, -1000,
+	1154, 5298, -1000, 4857, -1000, -1000, -1000, 1415, -1000, 193,
+	-1000, -1000, -1000, -1000, -61, -298, 937, -1000, 1049, -36,
+	-1000, -1000, 1409, -1000, 1515, 4857, 273, 16747, -1000, 661,
+	661, 619, 661, 661, 661, 661, 226, 225, 661, 661,
+	661, 661, 661, 661, 661, 661, 661, 661, 661, 661,
+	661, 661, 1512, -1000, -1000, 1405, -1000, -1000, 782, 5298,
+	-1000, -1000, 1048, 834, 446, 487, 661, 1511, -1000, 185,
+	756, 748, -1000, 15359, -1000, 38, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1045, 1045, -1000, -1000, 928, -1000, -1000,
+	1509, 1634, 149, 1508, -1000, 1507, 1506, 15359, 1140, 120,
+	-1000, -1000, 1192, 1182, 1404, 1402, -1000, 164, -240, -287,
+	-243, 139, 1073, 1171, 15359, -223, 170, -50, -49, -1000,
+	1504, -1000, -1000, 1803, -1000, 11918, 1737, 1047, -1000, 1802,
+	16855, -1000, 925, 922, 661, 661, 919, 1044, 1041, 1040,
+	661, 661, 918, 1031, 15790, 910, 905, 901, 935, 1022,
+	474, 927, 883, 882, 15359, 1503, 985, -1000, -1000, 1974,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1020, -1000, 899, 1500, -1000, -1000, 1499, -1000, -1000, 1400,
+	-1000, 1395, 1169, 11918, 156, 156, 11918, 11918, 11918, 1498,
+	350, -1000, -1000, -1000, -1000, 896, -1000, 887, 729, -1000,
+	-1000, -1000, -1000, -1000, 1161, 179, -202, -1000, 1752, -1000,
+	-1000, 1018, -221, 315, -48, -49, -1000, 1801, -38, 1800,
+	1798, 15359, 839, 122, -1000, -1000, 1737, 186, -1000, -1000,
+	-1000, 704, 704, -1000, -1000, -1000, -1000, 1016, 995, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 137, 15190, 1197, -1000, 511, 1028, 4651, -221, 11428,
-	-1000, 878, -1000, -1000, 1186, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1175, 1169, 1162, 11428, -1000, -1000, -1000, 76,
-	1026, 1002, 1401, 728, -81, 1689, -1000, 757, 1688, 757,
-	757, 1159, -1000, -1000, -1000, 508, 876, 46, -1000, -1000,
-	-1000, 64, 158, 156, -1000, 230, -1000, -1000, -1000, -1000,
-	-1000, -1000, 139, 1143, -1000, 851, 850, -1000, 639, 1556,
-	-1000, -19, 1113, -1000, -1000, -1000, -1000, -1000, 1110, -1000,
-	-1000, -1000, 1466, 9756, -103, -1000, 849, -1000, 757, -1000,
-	-1000, -1000, 15190, 721, -1000, 848, 59, 713, 5079, 1399,
-	5079, 1398, 72, 1344, -1000, -1000, -1000, -1000, -1000, 266,
-	-1000, -1000, 1548, 1546, 1720, -1000, -1000, -1000, -1000, 90,
-	90, 90, 90, -3, -1000, 15190, -1000, 1105, -1000, -1000,
-	-1000, 333, -1000, -1000, -1000, -1000, -1000, -1000, 1340, 1687,
-	-1000, 1258, 15190, 923, 15190, 1325, 490, 5079, -1000, -1000,
-	1727, -1000, 1721, 417, 417, -1000, 1037, -1000, 474, -1000,
-	11010, 15190, -1000, 164, 70, -1000, 1100, -1000, 1097, 15190,
-	647, 749, -1000, -1000, -1000, 752, 98, -1000, 15190, 3367,
-	-1000, 331, 1070, -1000, 986, 55, -1000, -1000, 1047, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 596, 15190, -1000, 164,
-	1613, -1000, 643, -1000, -1000, -1000, 16554, 154, -1000, -1000,
-	16554, 58, -1000, 155, -1000, -1000, 1045, -1000, 966, 893,
-	-1000, 58, 16667, 4651, -1000, 16667, 1033, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 238, 15359, 1393, -1000, 663, -1000, 1159, 4857, -183,
+	11918, -1000, 994, -1000, -1000, 1390, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1388, 1383, 1379, 11918, -1000, -1000, -1000,
+	180, 1155, 1150, 164, -1000, -192, 1702, -207, 15359, 148,
+	989, 1497, 886, -33, 1790, -1000, 839, 1787, 839, 839,
+	1357, -1000, -1000, -1000, 661, 986, 144, -1000, -1000, -1000,
+	157, 169, 167, -1000, 324, -1000, -1000, -1000, -1000, -1000,
+	-1000, 233, 1346, -1000, 985, 983, -1000, 932, 1643, -1000,
+	424, 1315, -1000, -1000, -1000, -1000, -1000, 1313, -1000, -1000,
+	-1000, -1000, 1691, -1000, -1000, -1000, -1000, 1642, -1000, -1000,
+	978, -1000, 1728, 10030, -62, -1000, 968, -1000, 839, -1000,
+	-1000, -1000, 15359, 880, -1000, 1077, 159, 844, 5298, 1495,
+	5298, 1494, 174, 1493, -1000, -1000, -1000, -1000, -1000, 350,
+	-1000, -1000, 1640, 1639, 1829, -1000, -1000, -1000, -1000, 122,
+	122, 122, 122, 94, -197, -234, -1000, -1000, 15359, -1000,
+	1288, -1000, -1000, -1000, 496, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1491, 1786, -1000, 1989, 15359, 1930, 15359, 1490, 654,
+	5298, -1000, -1000, 1806, -1000, 1833, 425, 425, -1000, -203,
+	148, -1000, 942, -1000, 648, -1000, 11576, 15359, -1000, 271,
+	162, -1000, 1276, -1000, 1270, 15359, 819, 1869, -1000, -1000,
+	-1000, 832, 204, -1000, -210, 1516, 15359, 3534, -1000, 494,
+	1241, -1000, 1145, 154, -1000, -1000, 1212, -1000, -1000, -1000,
+	-1000, -1000, -1000, -228, -1000, -1000, 788, 15359, -1000, 271,
+	1708, -1000, 818, -1000, -1000, -1000, -1000, 1701, 270, -1000,
+	-1000, 1701, 150, -1000, 259, -1000, -1000, 1166, -1000, 1142,
+	1487, -1000, 150, 16855, 4857, -1000, 16855, 1136, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 84, 2038, 2037, 102, 98, 2035, 2032, 2031, 2028,
-	2027, 2026, 2025, 2024, 2023, 2019, 2017, 2016, 2011, 2010,
-	2009, 2008, 2007, 2006, 2004, 2002, 2001, 2000, 1998, 1997,
-	1995, 1994, 96, 1992, 1989, 1988, 1986, 1985, 1984, 128,
-	1983, 1982, 1981, 1980, 1979, 1978, 1977, 1975, 1974, 125,
-	91, 100, 694, 119, 167, 1973, 116, 1972, 74, 169,
-	1971, 1970, 29, 108, 1969, 118, 112, 83, 137, 89,
-	77, 127, 1967, 1966, 1965, 126, 1964, 1963, 1962, 1961,
-	53, 1954, 61, 33, 28, 1952, 70, 1951, 1950, 1949,
-	1948, 1946, 65, 1945, 58, 42, 1943, 1942, 1941, 1940,
-	1939, 35, 1938, 36, 1935, 1934, 1933, 1932, 1931, 1930,
-	1929, 14, 16, 18, 1928, 1927, 15, 2, 1926, 1925,
-	73, 1924, 1923, 1922, 163, 1918, 1916, 1915, 138, 1914,
-	111, 1913, 1912, 1910, 1909, 9, 1908, 43, 1907, 1906,
-	1905, 44, 1904, 1903, 80, 37, 90, 79, 1902, 1901,
-	1900, 131, 20, 101, 0, 130, 31, 1899, 124, 122,
-	1898, 76, 218, 105, 38, 1897, 39, 63, 1894, 1893,
-	1892, 56, 59, 1890, 75, 1889, 87, 71, 1888, 94,
-	1887, 114, 1, 85, 1885, 133, 1884, 1883, 107, 1882,
-	1881, 52, 106, 1880, 1879, 1878, 25, 1877, 34, 22,
-	1876, 161, 139, 1875, 1874, 1873, 109, 95, 68, 1872,
-	1871, 66, 1869, 93, 67, 110, 1866, 703, 1865, 104,
-	54, 17, 1864, 134, 1862, 243, 135, 117, 1861, 1860,
-	142, 1612, 136, 1859, 123, 10, 1858, 1857, 11, 1855,
-	23, 1854, 1853, 1852, 1851, 6, 1850, 1849, 1845, 3,
-	5, 1844, 4, 86, 1843, 45, 51, 48, 1839, 60,
-	1838, 1837, 1836, 1835, 1834, 245, 1833, 1832, 1831, 1829,
-	1825, 1824, 1821, 69, 1820, 1819, 1818, 1817, 55, 1816,
-	1800, 1799, 1796, 1795, 32, 1794, 1793, 19, 1792, 26,
-	1790, 1789, 1788, 12, 1787, 1786, 13, 1785, 1784, 7,
-	8, 1783, 1782, 47, 46, 30, 64, 62, 1781, 21,
-	1779, 81, 1778, 1777, 113, 1776, 88, 1774, 1773, 132,
-	152, 1772, 129, 1754, 1744, 1743, 1742, 1740, 1738, 121,
-	1737,
-}
-
-//line mysql_sql.y:6272
+	0, 110, 2320, 2319, 119, 113, 2318, 2313, 2312, 2311,
+	2310, 2309, 2308, 2307, 2300, 2297, 2292, 2291, 2287, 2286,
+	2285, 2284, 2283, 2281, 2280, 2279, 2278, 2274, 2273, 2272,
+	2271, 2257, 108, 2256, 2253, 2252, 2224, 2215, 2214, 138,
+	2213, 2212, 2211, 2210, 2209, 2207, 2205, 2203, 2202, 2201,
+	136, 100, 98, 824, 111, 170, 2200, 116, 128, 153,
+	162, 2199, 2198, 45, 106, 2197, 149, 85, 90, 151,
+	93, 89, 133, 2195, 2194, 2193, 134, 2192, 2191, 2190,
+	2189, 63, 2173, 77, 33, 32, 2172, 82, 60, 2171,
+	2170, 2169, 2167, 57, 2166, 69, 53, 2164, 2163, 2159,
+	2158, 2157, 34, 2153, 46, 2152, 2151, 2150, 2149, 2146,
+	2145, 2144, 16, 18, 20, 2143, 2141, 17, 2, 2139,
+	2136, 75, 2134, 2132, 2130, 167, 2129, 2127, 2125, 150,
+	2124, 135, 2122, 2120, 2119, 2118, 96, 2117, 2115, 2114,
+	30, 9, 2111, 44, 2110, 2108, 2107, 55, 2106, 2105,
+	144, 48, 118, 91, 2076, 2075, 2074, 141, 47, 79,
+	0, 137, 36, 2072, 132, 127, 2071, 94, 233, 121,
+	56, 2070, 50, 70, 2069, 2063, 27, 71, 11, 2062,
+	88, 2061, 40, 86, 2060, 102, 2059, 124, 1, 95,
+	2057, 142, 2056, 2055, 107, 2054, 2053, 68, 105, 2052,
+	2051, 2050, 2049, 41, 2028, 14, 2027, 31, 2026, 49,
+	21, 2025, 129, 148, 2022, 2020, 2019, 123, 87, 81,
+	2017, 2016, 76, 2015, 104, 78, 115, 2014, 884, 2013,
+	103, 65, 19, 2012, 146, 2011, 261, 158, 125, 2010,
+	2007, 156, 1707, 147, 2006, 143, 12, 2005, 2004, 10,
+	2003, 24, 2001, 2000, 1998, 1997, 6, 1994, 1993, 1992,
+	3, 5, 1990, 4, 99, 1988, 54, 64, 62, 1986,
+	67, 1985, 1970, 1969, 1968, 1965, 229, 1964, 1962, 1958,
+	1957, 1956, 1955, 1952, 83, 1950, 1949, 1948, 1947, 66,
+	1944, 1943, 1942, 1941, 1940, 35, 1939, 1923, 22, 1917,
+	29, 1916, 1902, 1900, 13, 1899, 1898, 15, 1897, 1896,
+	7, 8, 1895, 1894, 61, 39, 38, 73, 72, 1891,
+	23, 1886, 92, 1883, 1880, 122, 1879, 97, 1878, 1877,
+	145, 166, 1876, 140, 1874, 1873, 1871, 1850, 1847, 1846,
+	126, 37,
+}
+
+//line mysql_sql.y:6629
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3119,6 +3186,21 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
+func (st *yySymType) frameUnitUnion() tree.FrameUnitType {
+	v, _ := st.union.(tree.FrameUnitType)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -3129,6 +3211,11 @@ func (st *yySymType) fullOptUnion() bool {
 	return v
 }
 
+func (st *yySymType) fullTextSearchTypeUnion() tree.FullTextSearchType {
+	v, _ := st.union.(tree.FullTextSearchType)
+	return v
+}
+
 func (st *yySymType) funcExprUnion() *tree.FuncExpr {
 	v, _ := st.union.(*tree.FuncExpr)
 	return v
@@ -3184,6 +3271,26 @@ func (st *yySymType) joinCondUnion() tree.JoinCond {
 	return v
 }
 
+func (st *yySymType) jsonOnResponseUnion() tree.JSONOnResponse {
+	v, _ := st.union.(tree.JSONOnResponse)
+	return v
+}
+
+func (st *yySymType) jsonOnResponsesUnion() [2]tree.JSONOnResponse {
+	v, _ := st.union.([2]tree.JSONOnResponse)
+	return v
+}
+
+func (st *yySymType) jsonTableColumnUnion() *tree.JSONTableColumn {
+	v, _ := st.union.(*tree.JSONTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableColumnsUnion() []*tree.JSONTableColumn {
+	v, _ := st.union.([]*tree.JSONTableColumn)
+	return v
+}
+
 func (st *yySymType) keyPartUnion() *tree.KeyPart {
 	v, _ := st.union.(*tree.KeyPart)
 	return v
@@ -3554,6 +3661,11 @@ func (st *yySymType) whereUnion() *tree.Where {
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) withClauseUnion() *tree.With {
 	v, _ := st.union.(*tree.With)
 	return v
@@ -3565,244 +3677,255 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 327, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 338, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 47, 302, 302, 301, 301, 300, 300, 299, 299,
-	299, 298, 298, 298, 297, 297, 296, 296, 294, 294,
-	295, 293, 292, 292, 290, 290, 288, 288, 289, 289,
-	283, 283, 286, 286, 284, 284, 284, 284, 287, 282,
-	282, 282, 281, 281, 46, 46, 46, 220, 220, 45,
-	45, 234, 234, 234, 234, 234, 232, 232, 232, 232,
-	231, 231, 230, 230, 235, 235, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 40,
-	40, 40, 40, 43, 44, 228, 228, 228, 228, 228,
-	229, 229, 229, 41, 42, 42, 219, 219, 224, 224,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 218, 218, 227, 227, 227, 226, 226, 225, 225,
-	34, 34, 34, 37, 36, 217, 217, 217, 217, 217,
-	217, 217, 217, 35, 35, 35, 35, 35, 35, 33,
-	33, 32, 216, 216, 215, 39, 39, 39, 39, 38,
-	38, 38, 38, 38, 38, 38, 38, 38, 157, 157,
-	157, 321, 321, 322, 323, 324, 324, 324, 48, 7,
-	31, 31, 265, 265, 168, 168, 169, 169, 167, 167,
-	167, 167, 167, 167, 268, 269, 164, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 30, 328, 328,
-	328, 28, 29, 264, 264, 264, 27, 26, 25, 24,
-	24, 23, 22, 22, 161, 161, 163, 163, 159, 329,
-	329, 240, 240, 162, 162, 21, 21, 160, 160, 142,
-	158, 158, 158, 6, 8, 8, 8, 8, 8, 13,
-	12, 11, 10, 9, 5, 4, 272, 272, 272, 272,
-	272, 272, 310, 310, 310, 311, 74, 74, 69, 69,
-	273, 273, 183, 312, 312, 280, 280, 279, 279, 278,
-	278, 72, 72, 73, 73, 61, 61, 49, 49, 285,
-	285, 285, 285, 291, 291, 262, 262, 108, 108, 138,
-	138, 139, 139, 50, 50, 51, 51, 51, 51, 51,
-	51, 318, 318, 320, 320, 319, 71, 71, 67, 67,
-	68, 68, 68, 66, 66, 65, 64, 64, 63, 62,
-	62, 62, 53, 53, 52, 52, 52, 52, 52, 124,
-	124, 124, 54, 266, 266, 266, 271, 271, 121, 121,
-	122, 122, 120, 120, 55, 55, 56, 56, 56, 56,
-	119, 119, 118, 57, 57, 58, 58, 60, 60, 60,
-	60, 129, 129, 128, 128, 128, 128, 77, 77, 127,
-	126, 126, 126, 76, 76, 75, 75, 70, 70, 59,
-	59, 125, 330, 330, 123, 150, 150, 150, 156, 156,
-	149, 149, 149, 155, 155, 151, 151, 152, 152, 152,
-	3, 3, 3, 16, 16, 16, 14, 213, 213, 212,
-	212, 214, 214, 214, 214, 208, 208, 209, 209, 209,
-	209, 210, 210, 210, 211, 211, 211, 211, 207, 207,
-	206, 204, 204, 204, 205, 205, 205, 205, 205, 205,
-	153, 153, 15, 201, 201, 202, 202, 202, 203, 203,
-	195, 195, 195, 195, 19, 199, 199, 200, 200, 200,
-	200, 200, 196, 196, 198, 198, 194, 194, 194, 194,
-	194, 18, 193, 193, 191, 191, 189, 189, 190, 190,
-	188, 188, 188, 192, 192, 17, 267, 267, 236, 236,
-	239, 239, 246, 246, 247, 247, 245, 245, 252, 252,
-	251, 251, 250, 250, 249, 249, 248, 248, 243, 243,
-	242, 242, 237, 237, 237, 237, 237, 238, 238, 241,
-	241, 244, 244, 99, 99, 100, 100, 100, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 308, 308, 309,
-	102, 102, 102, 106, 106, 106, 106, 106, 106, 101,
-	101, 101, 103, 103, 103, 84, 84, 83, 83, 78,
-	78, 79, 79, 80, 80, 81, 81, 82, 82, 82,
-	82, 82, 82, 222, 222, 306, 306, 307, 307, 303,
-	303, 303, 305, 305, 305, 305, 305, 304, 304, 85,
-	136, 136, 136, 154, 154, 154, 135, 135, 135, 98,
-	98, 97, 97, 95, 95, 95, 95, 95, 95, 95,
-	95, 95, 95, 95, 95, 95, 221, 221, 165, 165,
-	166, 166, 116, 114, 114, 115, 115, 115, 115, 112,
-	113, 111, 111, 111, 111, 111, 110, 110, 109, 109,
-	109, 197, 197, 107, 107, 105, 105, 105, 104, 104,
-	104, 253, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 174, 174, 174, 174, 174,
-	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
-	174, 174, 174, 174, 174, 175, 175, 180, 180, 317,
-	317, 316, 86, 86, 86, 86, 86, 86, 86, 86,
-	86, 94, 94, 94, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 277,
-	277, 277, 131, 131, 131, 131, 131, 313, 313, 314,
-	314, 314, 314, 314, 314, 314, 314, 314, 314, 314,
-	314, 315, 315, 315, 315, 315, 315, 315, 315, 315,
-	315, 315, 315, 315, 315, 315, 315, 315, 133, 133,
-	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
-	132, 132, 184, 184, 185, 185, 274, 274, 274, 274,
-	274, 274, 275, 275, 276, 276, 276, 276, 270, 270,
-	270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
-	270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
-	270, 270, 270, 270, 270, 270, 173, 173, 130, 130,
-	130, 186, 181, 181, 182, 182, 176, 176, 176, 176,
-	176, 178, 178, 178, 178, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 177, 177, 179, 179, 187, 187,
-	187, 187, 187, 187, 96, 96, 96, 96, 254, 170,
-	170, 170, 170, 170, 170, 170, 87, 87, 87, 87,
-	91, 91, 93, 93, 93, 93, 93, 93, 93, 93,
-	93, 93, 93, 93, 93, 93, 92, 92, 92, 92,
-	90, 90, 90, 90, 90, 88, 88, 88, 88, 88,
-	88, 88, 88, 88, 88, 88, 88, 88, 88, 88,
-	89, 137, 137, 255, 255, 258, 258, 256, 256, 257,
-	259, 259, 259, 260, 260, 260, 261, 261, 261, 263,
-	263, 141, 141, 141, 146, 146, 140, 140, 147, 147,
-	148, 148, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 325,
-	325, 325, 326, 326,
+	1, 1, 47, 313, 313, 312, 312, 311, 311, 310,
+	310, 310, 309, 309, 309, 308, 308, 307, 307, 305,
+	305, 306, 304, 303, 303, 301, 301, 299, 299, 300,
+	300, 294, 294, 297, 297, 295, 295, 295, 295, 298,
+	293, 293, 293, 292, 292, 46, 46, 46, 231, 231,
+	45, 45, 245, 245, 245, 245, 245, 243, 243, 243,
+	243, 242, 242, 241, 241, 246, 246, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	40, 40, 40, 40, 43, 44, 239, 239, 239, 239,
+	239, 240, 240, 240, 41, 42, 42, 230, 230, 235,
+	235, 234, 234, 234, 234, 234, 234, 234, 234, 234,
+	234, 234, 229, 229, 238, 238, 238, 237, 237, 236,
+	236, 34, 34, 34, 37, 36, 228, 228, 228, 228,
+	228, 228, 228, 228, 35, 35, 35, 35, 35, 35,
+	33, 33, 32, 227, 227, 226, 39, 39, 39, 39,
+	38, 38, 38, 38, 38, 38, 38, 38, 38, 163,
+	163, 163, 332, 332, 333, 334, 335, 335, 335, 48,
+	48, 49, 7, 31, 31, 276, 276, 174, 174, 175,
+	175, 173, 173, 173, 173, 173, 173, 279, 280, 170,
+	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
+	30, 339, 339, 339, 28, 29, 275, 275, 275, 27,
+	26, 25, 24, 24, 23, 22, 22, 167, 167, 169,
+	169, 165, 340, 340, 251, 251, 168, 168, 21, 21,
+	166, 166, 148, 164, 164, 164, 6, 8, 8, 8,
+	8, 8, 13, 12, 11, 10, 9, 5, 5, 5,
+	4, 283, 283, 283, 283, 283, 283, 321, 321, 321,
+	322, 75, 75, 70, 70, 284, 284, 189, 323, 323,
+	291, 291, 290, 290, 289, 289, 73, 73, 74, 74,
+	62, 62, 50, 50, 296, 296, 296, 296, 302, 302,
+	273, 273, 109, 109, 144, 144, 145, 145, 51, 51,
+	52, 52, 52, 52, 52, 52, 329, 329, 331, 331,
+	330, 72, 72, 68, 68, 69, 69, 69, 67, 67,
+	66, 65, 65, 64, 63, 63, 63, 54, 54, 53,
+	53, 53, 53, 53, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 55, 277, 277, 277, 282, 282, 122,
+	122, 123, 123, 121, 121, 56, 56, 57, 57, 57,
+	57, 120, 120, 119, 58, 58, 59, 59, 61, 61,
+	61, 61, 130, 130, 129, 129, 129, 129, 129, 129,
+	78, 78, 128, 127, 127, 127, 77, 77, 76, 76,
+	71, 71, 60, 60, 60, 204, 204, 203, 203, 203,
+	206, 206, 206, 206, 205, 205, 205, 126, 341, 341,
+	124, 156, 156, 156, 162, 162, 155, 155, 155, 161,
+	161, 157, 157, 158, 158, 158, 3, 3, 3, 16,
+	16, 16, 14, 224, 224, 223, 223, 225, 225, 225,
+	225, 219, 219, 220, 220, 220, 220, 221, 221, 221,
+	222, 222, 222, 222, 218, 218, 217, 215, 215, 215,
+	216, 216, 216, 216, 216, 216, 159, 159, 15, 212,
+	212, 213, 213, 213, 214, 214, 201, 201, 201, 201,
+	19, 210, 210, 211, 211, 211, 211, 211, 207, 207,
+	209, 209, 200, 200, 200, 200, 200, 18, 199, 199,
+	197, 197, 195, 195, 196, 196, 194, 194, 194, 198,
+	198, 17, 278, 278, 247, 247, 250, 250, 257, 257,
+	258, 258, 256, 256, 263, 263, 262, 262, 261, 261,
+	260, 260, 259, 259, 254, 254, 253, 253, 248, 248,
+	248, 248, 248, 249, 249, 252, 252, 255, 255, 100,
+	100, 101, 101, 101, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 319, 319, 320, 103, 103, 103, 107,
+	107, 107, 107, 107, 107, 102, 102, 102, 104, 104,
+	104, 85, 85, 84, 84, 79, 79, 80, 80, 81,
+	81, 82, 82, 83, 83, 83, 83, 83, 83, 233,
+	233, 317, 317, 318, 318, 314, 314, 314, 316, 316,
+	316, 316, 316, 315, 315, 86, 142, 142, 142, 160,
+	160, 160, 141, 141, 141, 99, 99, 98, 98, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 232, 232, 171, 171, 172, 172, 117,
+	115, 115, 116, 116, 116, 116, 113, 114, 112, 112,
+	112, 112, 112, 111, 111, 110, 110, 110, 208, 208,
+	108, 108, 106, 106, 106, 105, 105, 105, 264, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 180, 180, 180, 180, 180, 180, 180, 180,
+	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
+	180, 180, 180, 180, 180, 180, 180, 202, 202, 202,
+	202, 202, 181, 181, 186, 186, 328, 328, 327, 87,
+	87, 87, 87, 87, 87, 87, 87, 87, 87, 95,
+	95, 95, 135, 135, 135, 135, 135, 135, 135, 135,
+	135, 135, 135, 135, 135, 135, 135, 136, 137, 137,
+	138, 138, 138, 139, 139, 140, 140, 140, 140, 140,
+	288, 288, 288, 132, 132, 132, 132, 132, 324, 324,
+	325, 325, 325, 325, 325, 325, 325, 325, 325, 325,
+	325, 325, 326, 326, 326, 326, 326, 326, 326, 326,
+	326, 326, 326, 326, 326, 326, 326, 326, 326, 134,
+	134, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 190, 190, 191, 191, 285, 285, 285,
+	285, 285, 285, 286, 286, 287, 287, 287, 287, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
+	281, 281, 281, 281, 281, 281, 281, 179, 179, 131,
+	131, 131, 192, 187, 187, 188, 188, 182, 182, 182,
+	182, 182, 184, 184, 184, 184, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 183, 183, 185, 185, 193,
+	193, 193, 193, 193, 193, 97, 97, 97, 97, 265,
+	176, 176, 176, 176, 176, 176, 176, 176, 88, 88,
+	88, 88, 92, 92, 94, 94, 94, 94, 94, 94,
+	94, 94, 94, 94, 94, 94, 94, 94, 93, 93,
+	93, 93, 91, 91, 91, 91, 91, 89, 89, 89,
+	89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
+	89, 89, 90, 143, 143, 266, 266, 269, 269, 267,
+	267, 268, 270, 270, 270, 271, 271, 271, 272, 272,
+	272, 274, 274, 147, 147, 147, 152, 152, 146, 146,
+	153, 153, 154, 154, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 150, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 336, 336, 336, 337, 337,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
+	1, 0, 14, 0, 2, 1, 3, 3, 3, 1,
+	3, 5, 0, 2, 3, 1, 3, 1, 1, 1,
+	1, 1, 1, 0, 3, 0, 3, 0, 3, 0,
+	3, 0, 2, 1, 2, 3, 4, 3, 3, 1,
+	0, 1, 1, 0, 1, 9, 4, 7, 0, 3,
+	7, 4, 1, 3, 3, 3, 1, 0, 1, 1,
+	1, 1, 3, 1, 4, 1, 3, 1, 2, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 1, 2, 1, 2, 2, 1, 1, 1,
+	3, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 3, 6, 3, 1, 1, 1,
+	1, 1, 1, 1, 2, 4, 6, 1, 4, 1,
+	3, 3, 4, 4, 4, 3, 2, 4, 4, 2,
 	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
-	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
-	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
-	1, 1, 3, 2, 1, 1, 1, 1, 6, 1,
-	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
-	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 7, 8, 0, 2, 0, 2, 2, 1,
-	1, 1, 1, 0, 1, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 4,
-	4, 6, 4, 4, 6, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 7, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 4, 4, 4,
-	3, 2, 2, 2, 3, 2, 3, 0, 2, 1,
-	1, 2, 2, 0, 1, 2, 4, 1, 3, 1,
-	4, 3, 0, 1, 2, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 6, 0, 2, 1,
-	2, 2, 2, 2, 2, 0, 1, 2, 2, 2,
-	2, 1, 3, 2, 2, 2, 2, 2, 1, 3,
-	2, 1, 3, 2, 0, 3, 3, 5, 5, 4,
-	1, 1, 4, 1, 3, 1, 3, 2, 1, 1,
-	0, 1, 1, 1, 11, 0, 2, 3, 2, 3,
-	1, 1, 1, 3, 3, 4, 0, 2, 2, 2,
-	2, 5, 1, 1, 0, 3, 0, 1, 1, 2,
-	4, 4, 4, 0, 1, 10, 0, 1, 0, 6,
-	0, 4, 0, 3, 1, 3, 4, 5, 0, 3,
-	1, 3, 2, 3, 1, 2, 0, 6, 0, 2,
-	0, 2, 4, 5, 4, 5, 1, 6, 5, 0,
-	3, 0, 1, 0, 1, 1, 3, 2, 3, 3,
-	4, 4, 3, 3, 3, 3, 4, 4, 3, 3,
+	3, 1, 1, 1, 2, 2, 0, 4, 2, 4,
+	1, 5, 3, 2, 1, 2, 2, 4, 4, 5,
+	2, 1, 7, 1, 3, 3, 1, 1, 1, 1,
+	2, 3, 4, 7, 2, 3, 3, 4, 5, 1,
+	1, 1, 1, 3, 2, 1, 1, 1, 1, 3,
+	6, 3, 1, 7, 9, 0, 2, 0, 1, 1,
+	2, 2, 2, 1, 4, 2, 2, 3, 0, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	5, 1, 1, 1, 5, 5, 0, 1, 1, 2,
+	2, 3, 6, 7, 4, 7, 8, 0, 2, 0,
+	2, 2, 1, 1, 1, 1, 0, 1, 4, 5,
+	1, 3, 1, 1, 3, 5, 1, 1, 1, 1,
+	1, 1, 4, 4, 6, 4, 4, 6, 5, 6,
+	4, 2, 1, 5, 4, 4, 2, 0, 1, 3,
+	3, 1, 3, 1, 3, 1, 3, 4, 0, 1,
+	0, 1, 1, 3, 1, 1, 0, 4, 1, 3,
+	2, 1, 0, 8, 0, 4, 7, 4, 0, 2,
+	0, 2, 0, 2, 0, 4, 1, 3, 1, 1,
+	4, 3, 4, 5, 4, 5, 2, 3, 1, 3,
+	6, 0, 3, 0, 1, 2, 4, 4, 0, 1,
+	3, 1, 3, 2, 0, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 1, 2, 2, 1, 2, 2,
+	1, 2, 2, 7, 0, 1, 1, 1, 1, 0,
+	2, 0, 3, 0, 2, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 3, 1, 1, 4, 4,
+	4, 3, 2, 2, 2, 3, 2, 3, 2, 3,
+	0, 2, 1, 1, 2, 2, 0, 1, 2, 4,
+	1, 3, 1, 4, 12, 1, 3, 3, 5, 5,
+	0, 3, 3, 6, 1, 1, 2, 3, 0, 1,
+	2, 0, 1, 2, 1, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 6, 0, 2, 1, 2, 2, 2, 2,
+	2, 0, 1, 2, 2, 2, 2, 1, 3, 2,
+	2, 2, 2, 2, 1, 3, 2, 1, 3, 2,
+	0, 3, 3, 5, 5, 4, 1, 1, 4, 1,
+	3, 1, 3, 2, 1, 1, 0, 1, 1, 1,
+	11, 0, 2, 3, 2, 3, 1, 1, 1, 3,
+	3, 4, 0, 2, 2, 2, 2, 5, 1, 1,
+	0, 3, 0, 1, 1, 2, 4, 4, 4, 0,
+	1, 10, 0, 1, 0, 6, 0, 4, 0, 3,
+	1, 3, 4, 5, 0, 3, 1, 3, 2, 3,
+	1, 2, 0, 6, 0, 2, 0, 2, 4, 5,
+	4, 5, 1, 6, 5, 0, 3, 0, 1, 0,
+	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
+	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 5, 4, 1, 3, 3,
-	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 3, 0,
-	1, 1, 3, 1, 1, 2, 1, 7, 7, 7,
-	7, 8, 5, 0, 1, 0, 1, 1, 1, 1,
-	3, 3, 1, 1, 1, 1, 1, 0, 1, 3,
-	1, 3, 5, 1, 1, 1, 1, 3, 5, 0,
-	1, 1, 2, 1, 2, 2, 1, 1, 2, 2,
-	2, 2, 2, 1, 5, 6, 1, 2, 0, 1,
-	1, 2, 5, 0, 1, 1, 1, 2, 2, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 2, 2,
-	2, 0, 3, 0, 3, 1, 1, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 1, 1, 1, 1, 3, 5,
-	2, 2, 2, 2, 1, 1, 2, 5, 6, 6,
-	6, 1, 1, 1, 1, 0, 2, 0, 1, 1,
-	2, 4, 1, 2, 2, 1, 2, 2, 2, 2,
-	2, 0, 1, 1, 5, 4, 4, 5, 5, 5,
-	5, 4, 5, 5, 5, 5, 5, 5, 5, 1,
-	1, 1, 4, 4, 6, 8, 6, 1, 1, 1,
+	4, 5, 4, 1, 3, 3, 0, 2, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 3, 0, 1, 1, 3, 1,
+	1, 2, 1, 7, 7, 7, 7, 8, 5, 0,
+	1, 0, 1, 1, 1, 1, 3, 3, 1, 1,
+	1, 1, 1, 0, 1, 3, 1, 3, 5, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
+	2, 2, 1, 1, 2, 2, 2, 2, 3, 2,
+	1, 5, 6, 1, 2, 0, 1, 1, 2, 5,
+	0, 1, 1, 1, 2, 2, 3, 3, 1, 1,
+	2, 2, 2, 0, 1, 2, 2, 2, 0, 3,
+	0, 3, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 1, 1, 1, 1, 3, 5, 2, 2, 2,
+	2, 1, 1, 2, 5, 6, 6, 6, 1, 1,
+	1, 1, 5, 5, 9, 3, 3, 0, 4, 7,
+	3, 3, 0, 2, 0, 1, 1, 2, 4, 1,
+	2, 2, 1, 2, 2, 2, 2, 2, 1, 0,
+	1, 1, 5, 4, 4, 5, 5, 5, 5, 4,
+	5, 5, 5, 5, 5, 5, 5, 3, 0, 3,
+	0, 2, 5, 1, 1, 2, 2, 2, 2, 2,
+	1, 1, 1, 4, 4, 6, 8, 6, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	4, 2, 2, 4, 6, 2, 2, 2, 4, 6,
-	4, 2, 0, 1, 2, 3, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 4, 2, 2, 4, 6, 2, 2, 2, 4,
+	6, 4, 2, 0, 1, 2, 3, 1, 1, 1,
+	1, 1, 1, 0, 2, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 3, 0, 1,
-	1, 3, 0, 1, 1, 3, 3, 3, 3, 2,
-	1, 3, 4, 3, 1, 3, 4, 4, 5, 3,
-	4, 5, 6, 1, 0, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 3, 0,
+	1, 1, 3, 0, 1, 1, 3, 3, 3, 3,
+	2, 1, 3, 4, 3, 1, 3, 4, 4, 5,
+	3, 4, 5, 6, 1, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
+	2, 2, 1, 2, 2, 2, 2, 2, 2, 2,
 	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
-	1, 2, 2, 2, 2, 2, 2, 2, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
-	1, 1, 3, 0, 1, 0, 3, 0, 3, 3,
-	0, 3, 5, 0, 3, 5, 0, 1, 1, 0,
-	1, 1, 2, 2, 0, 1, 1, 1, 1, 1,
+	4, 4, 1, 1, 3, 0, 1, 0, 3, 0,
+	3, 3, 0, 3, 5, 0, 3, 5, 0, 1,
+	1, 0, 1, 1, 2, 2, 0, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3837,447 +3960,474 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -327, -2, -1, -3, -4, -5, -6, -38, -20,
-	-7, -48, -32, -33, -34, -40, -45, -46, -47, -50,
-	-16, -15, -14, 8, 10, -8, -157, -21, -22, -23,
-	-24, -25, -26, -27, -28, -29, -30, -31, 181, 9,
-	49, -35, -36, -37, -41, -42, -43, -44, 283, 289,
-	326, -51, -53, -17, -18, -19, 177, -9, -10, -11,
-	-12, -13, 199, 198, 26, 197, 178, 120, 121, 123,
-	124, 30, -52, -318, 54, 179, -54, 397, 6, 442,
-	-61, 27, -83, -154, 57, -143, -145, 400, 401, 402,
-	403, 404, 405, 406, 407, 408, 409, 410, 411, 412,
-	413, 414, 415, 416, 417, 418, 419, 420, 421, 422,
-	423, 424, 425, 426, 427, 428, 429, 430, 431, 432,
-	433, 434, 435, 394, 216, 240, 243, 250, 120, 137,
-	131, 158, 150, 151, 128, 220, 221, 64, 123, 354,
-	340, 325, 126, 235, 237, 239, 242, 222, 146, 142,
-	234, 218, 140, 223, 28, 224, 163, 225, 226, 399,
-	336, 265, 358, 342, 247, 141, 337, 238, 339, 164,
-	168, 346, 289, 135, 136, 344, 348, 162, 196, 32,
-	396, 34, 208, 349, 166, 161, 157, 357, 251, 249,
-	160, 134, 156, 219, 38, 170, 169, 171, 319, 320,
-	321, 322, 227, 228, 277, 345, 153, 143, 269, 129,
-	18, 352, 203, 341, 290, 229, 244, 206, 252, 165,
-	167, 210, 215, 398, 248, 350, 139, 207, 236, 202,
-	353, 130, 283, 292, 230, 124, 241, 347, 356, 37,
-	305, 133, 127, 193, 121, 212, 217, 231, 232, 233,
-	254, 253, 245, 154, 209, 159, 132, 155, 122, 211,
-	355, 306, 441, 267, 308, 152, 149, 213, 186, 351,
-	343, 125, 312, 307, 147, 255, 438, 439, 440, 278,
-	11, -158, 19, 323, -39, 324, 181, 54, -154, -5,
-	-4, -32, -50, 184, -58, -59, -60, -123, -125, -83,
-	54, -154, -231, -201, -230, -202, -233, -203, -153, 20,
-	178, 177, 211, 10, 179, 287, 185, 8, 6, 288,
-	197, 9, 289, 291, 292, 295, 296, 297, 31, 300,
-	301, 57, 60, -154, -231, -201, 215, 222, -65, -66,
-	-124, 15, 5, 308, 214, -195, -193, -267, 194, 193,
-	76, 360, 183, 298, -328, -264, 343, 342, -162, 341,
-	334, 336, 177, 185, 344, 32, 346, 347, 337, 308,
-	125, 122, -217, 80, 130, 129, -217, 214, 29, -224,
-	318, -223, -225, 346, 347, 357, -218, 345, -141, -154,
-	58, 59, 75, 151, 148, -66, -124, -65, -52, -53,
-	-51, -53, 308, 214, 185, 184, 360, -320, 392, -319,
-	-154, -266, 20, -271, 21, 22, -1, -72, 206, -83,
-	119, -58, -135, -154, 325, 89, -39, -39, 324, -321,
-	-322, -323, -325, 181, 324, 323, 119, -83, 30, -126,
-	-127, -128, -129, 41, 45, 47, 42, 43, 44, 48,
-	-330, 23, -150, -156, 23, -151, 60, -152, -145, 57,
-	58, 59, -51, -53, 51, 55, 11, 55, 54, 443,
-	58, 285, 299, 308, 286, 298, 186, 214, 299, 214,
-	334, 186, 290, 293, 294, 335, 51, 187, 51, -281,
-	357, -49, 27, -68, 17, -54, -53, 16, 20, 21,
-	-191, 189, -191, 185, -191, 184, -329, 11, 99, 213,
-	212, 338, 335, -240, 339, 340, -162, -161, 97, -162,
-	184, 360, -265, 189, 350, 397, 128, 129, 130, -228,
-	20, 29, 317, -201, 214, 55, 89, 19, -226, 89,
-	100, -225, -225, -225, -226, -101, 29, -152, 60, 116,
-	-101, 29, 119, 30, 30, -67, -68, -54, -53, -66,
-	-65, -66, 56, 56, -265, -265, -265, -265, -265, 55,
-	-320, -71, 54, -55, -56, 107, -176, -154, 81, -178,
-	57, -171, 401, 402, 403, 404, 405, 406, 407, 409,
-	412, 414, 416, 420, 421, 422, 423, 425, 426, 427,
-	428, 433, 434, 435, 277, 308, 147, 278, -172, -174,
-	-299, -294, -170, 54, 105, 106, 113, 82, -173, -253,
-	24, 84, 368, -131, -132, -133, -134, -295, -293, 60,
-	65, 69, 71, 72, 70, 67, 118, -53, -313, -270,
-	-276, -274, 148, 200, 144, 145, 8, 111, 318, 116,
-	-277, 59, 58, 271, 75, 272, 273, 360, 268, 274,
-	189, 323, 43, 275, 276, 279, 367, 280, 44, 281,
-	270, 204, 282, 371, 370, 372, 364, 361, 359, 362,
-	363, 365, 366, -272, 33, -50, 54, 30, 54, -154,
-	-120, 12, 119, 65, 60, -39, 56, 55, -324, 71,
-	72, -326, 162, 154, -154, 54, -216, -215, -135, -59,
-	-59, -59, -59, 41, 41, 41, 46, 41, 46, 41,
-	-128, -154, -156, 56, -232, 184, 284, 210, -230, 211,
-	289, 292, -207, -206, -204, -153, 60, -202, -235, -135,
-	-153, 335, -232, -207, -206, 327, 437, -49, -176, -154,
-	-64, -63, -176, -207, 81, -201, -152, -154, -191, -83,
-	-161, -161, -163, -329, -159, -329, 335, -120, -174, -240,
-	-160, -154, -191, -207, 308, 24, 351, 352, 126, 129,
-	128, 358, -229, 317, 20, -201, -223, -219, 60, 318,
-	-206, -227, 51, 116, -278, -176, 29, -226, -226, -226,
-	-227, 115, -154, -49, -67, -49, -68, -207, -201, -154,
-	-84, -83, -155, -152, -145, -319, 23, -70, -154, -119,
-	55, -118, 11, -149, 80, 78, 79, -154, 23, 119,
-	-176, 96, -187, 89, 90, 91, 92, 93, 94, 54,
-	54, 54, 54, 54, 54, 54, 54, -185, 54, 54,
-	54, 54, 54, 54, -185, 54, 54, 54, 102, 101,
-	112, 105, 106, 107, 108, 109, 110, 111, 103, 104,
-	99, 81, 97, 98, 83, -53, -176, -182, -174, -174,
-	-174, -174, -253, -180, -176, 54, 60, 65, 54, 54,
-	-275, 54, -184, -185, 54, 60, 60, 60, 54, 54,
-	54, -174, 54, -273, -183, -312, 436, -74, 56, -69,
-	-154, -310, -311, -69, -73, -154, -66, -176, -147, -148,
-	-140, -144, -151, -152, -145, 266, 182, 20, 80, 23,
-	25, 271, 303, 83, 116, 16, 84, 148, 115, 273,
-	368, 272, 177, 47, 75, 370, 372, 371, 361, 359,
-	310, 314, 316, 313, 360, 334, 29, 10, 26, 198,
-	21, 22, 109, 179, 200, 87, 88, 201, 24, 199,
-	72, 19, 50, 11, 323, 13, 14, 274, 309, 189,
-	188, 99, 327, 185, 45, 8, 118, 27, 96, 311,
-	41, 77, 43, 97, 17, 362, 363, 31, 326, 393,
-	205, 111, 275, 276, 48, 81, 317, 70, 51, 78,
-	15, 46, 98, 180, 367, 44, 214, 315, 279, 281,
-	392, 280, 183, 6, 270, 369, 30, 197, 42, 184,
-	335, 86, 187, 71, 204, 144, 145, 5, 76, 9,
-	49, 52, 364, 365, 366, 33, 85, 12, 282, 397,
-	318, 328, 329, 330, 331, 332, 333, 172, 173, 174,
-	175, 176, 246, 192, 190, 194, 195, 436, 437, 19,
-	-39, -322, 119, -70, -120, 55, 89, -76, -75, 51,
-	52, -77, 51, -75, 41, 41, -71, -234, 107, 57,
-	55, -205, 309, 443, 58, 56, 55, -234, 187, 60,
-	60, 55, 18, 119, 55, -62, 25, 26, -208, -209,
-	315, 24, -194, 52, -189, -190, -188, -192, 29, -83,
-	-120, -120, -120, -161, -155, -163, -158, -163, -159, 119,
-	-142, -154, -208, 54, 127, 130, 130, 129, -201, 187,
-	54, 89, -227, -227, -227, 29, -153, -49, -49, 51,
-	55, 54, 56, 55, -120, -56, -57, -58, -176, -176,
-	-176, -154, -154, 107, 70, 81, -171, -181, -182, -176,
-	-130, 21, 20, -130, -130, -176, -130, 107, -182, -182,
-	56, -254, 65, -314, -315, 373, 374, 375, 376, 377,
-	378, 379, 380, 381, 382, 383, 275, 270, 276, 274,
-	268, 282, 277, 278, 147, 390, 391, 384, 385, 386,
-	387, 388, 389, -130, -130, -130, -130, -130, -130, -130,
-	-172, -172, -172, -172, -172, -172, -172, -172, -172, -172,
-	-172, -172, -179, -186, -253, 54, 99, 97, 98, 83,
-	-174, -172, -172, 56, 55, -317, -316, 85, -176, -314,
-	-181, -176, -181, 56, -182, -181, -172, -181, -130, 55,
-	54, 56, 55, 33, 119, 55, 89, 56, 55, -67,
-	119, 325, -154, 56, -66, -215, -176, -176, 54, -176,
-	11, 119, 119, -206, 16, 397, -153, -135, 187, -207,
-	-282, 188, 367, -285, 339, -176, -176, -154, -63, -213,
-	397, 317, 316, 312, -210, -211, 311, 313, 310, 314,
-	51, 260, 261, 262, 263, -188, -141, 115, 225, 151,
-	54, -120, -161, -161, -163, -154, -213, 56, 130, -207,
-	-164, 60, -219, -83, -83, -1, -154, -122, 13, 55,
-	119, 70, 56, 55, -176, -176, -176, 23, -182, 56,
-	56, 56, 56, 11, -176, -176, -176, -176, -176, -176,
-	-176, -182, -179, -174, -172, -172, -177, 201, 80, -176,
-	-175, -316, 87, -176, 55, 52, 56, 11, 56, 56,
-	52, 56, 55, 56, -176, -183, -280, -279, -278, 33,
-	-50, -69, -273, -154, -311, -278, -154, -147, -144, -152,
-	-145, 65, -67, -70, -207, 107, 107, 57, -153, 318,
-	-153, -207, -220, 397, 27, -291, 333, 328, 330, 119,
-	-212, -214, 319, 320, 321, 322, 80, -211, 60, 60,
-	60, 60, -83, -146, 89, -146, -146, -78, -79, -80,
-	-85, -81, -135, -166, -82, 192, 190, 194, -307, 76,
-	195, 246, 77, 185, -120, -120, -161, -168, -169, -167,
-	266, -268, 318, 309, 56, 56, -121, 14, 16, -58,
-	-154, 107, -176, 56, 56, 56, -86, -92, 116, 148,
-	200, 147, 146, 144, 305, 306, 140, 141, 142, 139,
-	56, -176, 56, 56, 56, 56, 56, 56, 56, 56,
-	-177, 80, -174, -171, 56, 88, -176, 86, -86, -101,
-	-176, -101, -172, 56, 56, 55, -273, 56, -153, 16,
-	23, -208, 289, 184, -262, 438, -289, 328, 16, 16,
-	-214, 65, 65, 65, 65, -211, 54, -101, -103, -152,
-	60, 116, 60, 56, 55, -87, -91, -88, -90, -89,
-	-93, -92, 148, 149, 116, 152, 154, 155, 156, 157,
-	158, 159, 160, 161, 162, 163, 30, 200, 144, 145,
-	146, 147, 164, 131, 150, 395, 172, 132, 173, 133,
-	174, 134, 175, 135, 136, 176, 137, -82, -154, 77,
-	-306, -307, -191, -306, 77, 54, -120, -167, 267, 31,
-	118, 269, 29, 265, 16, -176, -182, 56, -255, -257,
-	54, -256, 54, -255, -255, -255, -94, 136, 135, -94,
-	-259, 54, -259, -260, 54, -259, 56, -171, -176, 56,
-	56, 56, 19, 56, 56, -278, -153, -153, -220, 290,
-	-83, -108, 439, 60, 16, 60, -287, 60, -196, -198,
-	-135, 54, -99, -100, -117, 303, 216, -192, 220, 64,
-	221, 325, 222, 185, 224, 225, 226, 196, 227, 228,
-	229, 318, 230, 231, 232, 233, 286, 5, 256, -80,
-	-98, -97, -95, 70, 81, 29, 303, -96, 64, 115,
-	239, 217, 240, -116, -165, 190, 76, 77, 291, -166,
-	-261, 306, 305, -255, -256, -257, -255, -255, 54, 54,
-	-255, -258, 54, -255, -255, -303, -304, -154, -304, -154,
-	-303, -303, -191, -176, 65, -269, -164, 65, 65, 65,
-	65, -176, -283, -240, -138, 440, 65, 60, 330, 56,
-	55, -255, -176, -236, 206, 55, -117, -146, -146, -141,
-	115, -146, -146, -146, -146, 223, 223, -146, -146, -146,
-	-146, -146, -146, -146, -146, -146, -146, -146, -146, -146,
-	-146, 54, -95, 70, -172, 60, -103, -104, 29, 238,
-	234, -105, 29, 218, 219, -107, 54, 246, 77, 77,
-	-83, -263, 307, -137, 60, -137, 65, 54, 52, 255,
-	54, 54, 54, -304, 56, 268, 56, 56, 56, 55,
-	56, 55, 56, -290, 333, -286, -284, 328, 329, 330,
-	331, 54, 16, -199, -198, -62, 56, 16, -117, 65,
-	65, -146, -146, 65, 60, 60, 60, -146, -146, 65,
-	60, -156, 65, 65, 65, 65, 29, 60, -106, 29,
-	234, 238, 235, 236, 237, 65, 29, 65, 29, 65,
-	29, -154, 54, -308, -309, 60, 65, 54, -197, 54,
-	56, 55, 56, 56, -196, -305, 260, 261, 262, 264,
-	263, -305, -196, -196, -196, 54, -222, -221, 247, 81,
-	65, 65, -292, 188, -288, 332, -284, 16, 330, 16,
-	16, -139, -154, -287, -200, 196, 64, 397, 258, 259,
-	-62, -237, 248, 249, -238, -244, 251, -101, -101, 60,
-	60, -102, 217, -84, 56, 55, 89, 56, -176, -110,
-	-109, 393, -196, 60, 56, 56, 56, 56, -196, 247,
-	56, 56, -298, 54, 65, -289, 16, -287, 16, -287,
-	-287, 56, 55, -146, 60, 257, -242, 252, 54, -240,
-	54, -240, 77, 261, 218, 219, 56, -309, 60, 56,
-	-114, -115, -112, -113, 51, 337, 244, 245, 56, -199,
-	-199, -199, -199, 56, -302, 30, 56, -297, -296, -136,
-	-293, -154, 333, 60, -287, -154, 65, -152, -239, 253,
-	65, -172, 54, -172, 54, -241, 250, 54, -221, -113,
-	51, -112, 51, 10, 9, -116, -301, -300, -299, 56,
-	55, 119, -246, 54, 16, 56, -235, 56, -235, 54,
-	89, -172, -111, 241, 242, 30, 129, -111, 55, 89,
-	-296, -154, -247, -245, 206, -238, 56, 56, -235, 65,
-	56, 70, 29, 243, -300, 29, -176, 119, 56, 55,
-	57, -243, 254, 56, -154, -245, -248, 33, 65, -252,
-	-249, 54, -117, 208, -252, -117, -251, -250, 253, 209,
-	56, 55, 57, 54, -250, -249, -182, 56,
+	-1000, -338, -2, -1, -3, -4, -5, -6, -38, -20,
+	-7, -48, -49, -32, -33, -34, -40, -45, -46, -47,
+	-51, -16, -15, -14, 10, 12, -8, -163, -21, -22,
+	-23, -24, -25, -26, -27, -28, -29, -30, -31, 184,
+	186, 11, 52, -35, -36, -37, -41, -42, -43, -44,
+	287, 293, 330, -52, -54, -17, -18, -19, 180, -9,
+	-10, -11, -12, -13, 203, 202, 28, 201, 181, 123,
+	124, 126, 127, 32, -53, -329, 57, 182, -55, 399,
+	8, 457, -62, 29, -84, -160, 60, -149, -151, 415,
+	416, 417, 418, 419, 420, 421, 422, 423, 424, 425,
+	426, 427, 428, 429, 430, 431, 432, 433, 434, 435,
+	436, 437, 438, 439, 440, 441, 442, 443, 444, 445,
+	446, 447, 448, 449, 450, 396, 220, 244, 247, 254,
+	186, 123, 140, 134, 161, 153, 154, 131, 224, 225,
+	67, 126, 357, 343, 329, 129, 239, 241, 243, 246,
+	226, 149, 145, 238, 222, 143, 227, 30, 228, 166,
+	229, 230, 401, 340, 269, 345, 407, 408, 251, 144,
+	47, 242, 342, 167, 171, 349, 293, 138, 139, 347,
+	351, 165, 200, 34, 398, 36, 212, 352, 169, 164,
+	160, 360, 255, 253, 163, 137, 159, 223, 40, 173,
+	172, 174, 323, 324, 325, 326, 231, 232, 281, 348,
+	156, 146, 273, 132, 20, 355, 207, 344, 294, 233,
+	248, 210, 256, 405, 406, 414, 411, 412, 413, 168,
+	170, 214, 219, 400, 252, 353, 142, 211, 240, 206,
+	356, 133, 287, 296, 234, 127, 245, 350, 359, 39,
+	309, 136, 130, 197, 124, 216, 221, 235, 236, 237,
+	258, 257, 249, 157, 213, 162, 135, 158, 125, 215,
+	358, 310, 456, 271, 312, 155, 152, 217, 190, 354,
+	346, 128, 316, 311, 150, 259, 453, 454, 455, 282,
+	13, -85, -84, -164, 21, 327, -39, 328, 184, 57,
+	-160, -5, -4, -32, -51, 188, 191, -58, -59, -60,
+	-61, -124, -126, 404, -84, 57, -160, -242, -212, -241,
+	-213, -244, -214, -159, 22, 181, 180, 215, 12, 182,
+	291, 189, 10, 8, 292, 201, 11, 293, 295, 296,
+	299, 300, 301, 33, 304, 305, 60, 63, -160, -242,
+	-212, 219, 226, -66, -67, -125, 17, 5, 7, 6,
+	312, 218, -201, -199, -278, 198, 197, 79, 362, 187,
+	302, -339, -275, 346, 345, -168, 344, 338, 340, 180,
+	189, 347, 34, 349, 350, 47, 312, 128, 125, -228,
+	83, 133, 132, -228, 218, 31, -235, 322, -234, -236,
+	349, 350, 360, -229, 348, -147, -160, 61, 62, 78,
+	154, 151, -67, -125, -66, -53, -54, -52, -54, 312,
+	218, 189, 188, 362, -331, 394, -330, -160, -277, 22,
+	-282, 23, 24, -1, -73, 210, -84, 122, -59, -85,
+	-84, 13, 58, -141, -160, 329, 92, -39, -39, 328,
+	-332, -333, -334, -336, 184, 328, 327, 122, -84, 63,
+	32, 58, -127, -128, -129, -130, 43, 48, 50, 44,
+	45, 46, 47, 51, -341, 25, 57, -156, -162, 25,
+	-157, 63, -158, -151, 60, 61, 62, -52, -54, 54,
+	58, 13, 58, 57, 458, 61, 289, 303, 312, 290,
+	302, 190, 218, 303, 218, 338, 190, 294, 297, 298,
+	339, 54, 191, 54, -292, 360, -50, 29, -69, 19,
+	-55, -54, 18, 22, 23, 22, 23, 22, 23, -197,
+	193, -197, 189, -197, 188, -340, 13, 102, 217, 216,
+	341, 339, -251, 342, 343, -168, -167, 100, -168, 188,
+	362, -276, 193, 353, 399, 131, 132, 133, -239, 22,
+	31, 321, -212, 218, 58, 92, 21, -237, 92, 103,
+	-236, -236, -236, -237, -102, 31, -158, 63, 119, -102,
+	31, 122, 32, 32, -68, -69, -55, -54, -67, -66,
+	-67, 59, 59, -276, -276, -276, -276, -276, 58, -331,
+	-72, 57, -56, -57, 110, -182, -160, 84, -184, 60,
+	-177, 416, 417, 418, 419, 420, 421, 422, 424, 427,
+	429, 431, 435, 436, 437, 438, 440, 441, 442, 443,
+	448, 449, 450, 281, 312, 150, 282, -178, -180, -310,
+	-305, -176, 57, 108, 109, 116, 85, -179, -264, 26,
+	87, 370, -132, -133, -134, -135, 395, -306, -304, 63,
+	68, 72, 74, 75, 73, 70, 64, 121, -54, -324,
+	-281, -287, -285, 151, 204, 147, 148, 10, 114, 322,
+	119, -288, 62, 61, 275, 78, 276, 277, 362, 272,
+	278, 193, 327, 45, 279, 280, 283, 369, 284, 46,
+	285, 274, 208, 286, 373, 372, 374, 366, 363, 361,
+	364, 365, 367, 368, -283, 35, -51, 57, 32, 57,
+	-153, -146, -150, -158, -151, 270, 185, 22, 83, 25,
+	27, 275, 307, 86, 119, 18, 87, 151, 118, 277,
+	370, 276, 180, 50, 78, 372, 374, 373, 363, 361,
+	409, 410, 314, 318, 320, 317, 362, 338, 31, 12,
+	28, 202, 23, 24, 112, 182, 204, 90, 91, 205,
+	6, 26, 203, 75, 21, 53, 13, 327, 15, 16,
+	278, 313, 193, 192, 102, 331, 189, 48, 10, 7,
+	121, 29, 99, 315, 43, 80, 45, 100, 19, 364,
+	365, 33, 330, 395, 209, 114, 279, 280, 51, 84,
+	321, 73, 54, 81, 17, 49, 101, 183, 369, 46,
+	218, 319, 283, 285, 394, 284, 187, 8, 274, 371,
+	32, 201, 44, 188, 339, 89, 191, 74, 208, 147,
+	148, 5, 79, 11, 52, 55, 366, 367, 368, 35,
+	88, 14, 286, 399, 322, 332, 333, 334, 335, 336,
+	337, 175, 176, 177, 178, 179, 250, 196, 194, 198,
+	199, 451, 452, -121, 14, 55, -58, -84, 122, 68,
+	63, -39, 59, 58, -335, 74, 75, -337, 165, 157,
+	-160, 57, -227, -226, -141, -59, -60, -60, -60, -60,
+	43, 43, 43, 49, 43, 49, 43, 49, 43, -129,
+	-160, -182, -160, -162, 59, -243, 188, 288, 214, -241,
+	215, 293, 296, -218, -217, -215, -159, 63, -213, -246,
+	-141, -159, 339, -243, -218, -217, 331, 452, -50, -182,
+	-65, -64, -182, -218, 84, -212, -158, -160, -197, -84,
+	-167, -167, -169, -340, -165, -340, 339, -121, -180, -251,
+	-166, -160, -197, -218, 312, 26, 354, 355, 129, 132,
+	131, 6, -240, 321, 22, -212, -234, -230, 63, 322,
+	-217, -238, 54, 119, -289, -182, 31, -237, -237, -237,
+	-238, 118, -160, -50, -68, -50, -69, -218, -212, -160,
+	-85, -161, -158, -151, -330, 25, -71, -160, -120, 58,
+	-119, 13, -155, 83, 81, 82, -160, 25, 122, -182,
+	99, -193, 92, 93, 94, 95, 96, 97, 57, 57,
+	57, 57, 57, 57, 57, 57, -191, 57, 57, 57,
+	57, 57, 57, -191, 57, 57, 57, 105, 104, 115,
+	108, 109, 110, 111, 112, 113, 114, 106, 107, 102,
+	84, 100, 101, 86, 402, 403, -54, -182, -188, -180,
+	-180, -180, -180, -264, -186, -182, 57, 409, 409, 57,
+	63, 68, 57, 57, -286, 57, -190, -191, 57, 63,
+	63, 63, 57, 57, 57, -180, 57, -284, -189, -323,
+	451, -75, 59, -70, -160, -321, -322, -70, -74, -160,
+	-67, -182, -58, -121, -153, -154, -150, -157, -158, -151,
+	21, -39, -333, 122, -71, -121, 58, 92, -77, -76,
+	54, 55, -78, 54, -76, 43, 43, 43, -72, 58,
+	122, -245, 110, 60, 58, -216, 313, 458, 61, 59,
+	58, -245, 191, 63, 63, 58, 20, 58, -63, 27,
+	28, -219, -220, 319, 26, -200, 55, -195, -196, -194,
+	-198, 31, -84, -121, -121, -121, -167, -161, -169, -164,
+	-169, -165, 122, -148, -160, -219, 57, 130, 133, 133,
+	132, -212, 191, 57, 92, -238, -238, -238, 31, -159,
+	-50, -50, 54, 57, 59, 58, -121, -57, -58, -182,
+	-182, -182, -160, -160, 110, 73, 84, -177, -187, -188,
+	-182, -131, 23, 22, -131, -131, -182, -131, 110, -188,
+	-188, 59, -265, 68, -325, -326, 375, 376, 377, 378,
+	379, 380, 381, 382, 383, 384, 385, 279, 274, 280,
+	278, 272, 286, 281, 282, 150, 392, 393, 386, 387,
+	388, 389, 390, 391, -131, -131, -131, -131, -131, -131,
+	-131, -178, -178, -178, -178, -178, -178, -178, -178, -178,
+	-178, -178, -178, -185, -192, -264, 57, 102, 100, 101,
+	86, -180, -178, -178, 63, 63, 59, 58, -328, -327,
+	88, -182, 57, 57, -246, -325, -187, -182, -187, 59,
+	-188, -187, -178, -187, -131, 58, 57, 59, 58, 35,
+	122, 58, 92, 59, 58, -68, -121, 122, 329, -160,
+	59, -67, -226, -182, -182, 57, -182, 63, -160, 13,
+	122, 122, -217, 18, 399, -159, -141, 191, -218, -293,
+	192, 369, -296, 342, -182, -182, -64, -224, 399, 321,
+	320, 316, -221, -222, 315, 317, 314, 318, 54, 264,
+	265, 266, 267, -194, -147, 118, 229, 154, 57, -121,
+	-167, -167, -169, -160, -224, 59, 133, -218, -170, 63,
+	-230, -84, -1, -160, -123, 15, 122, 73, 59, 58,
+	-182, -182, -182, 25, -188, 59, 59, 59, 59, 13,
+	-182, -182, -182, -182, -182, -182, -182, -188, -185, -180,
+	-178, -178, -183, 205, 83, -182, -181, -327, 90, -182,
+	58, 55, -136, -137, 210, -136, 59, 59, 13, 59,
+	59, 55, 59, 58, 59, -182, -189, -291, -290, -289,
+	35, -51, -70, -284, -160, -322, -289, -160, -153, 68,
+	-68, -71, 343, 122, -218, 110, 110, 60, -159, 322,
+	-159, -218, -231, 399, 29, -302, 337, 332, 334, -223,
+	-225, 323, 324, 325, 326, 83, -222, 63, 63, 63,
+	63, -84, -152, 92, -152, -152, -79, -80, -81, -86,
+	-82, -141, -172, -83, 196, 194, 198, -318, 79, 199,
+	250, 80, 189, -121, -121, -167, -174, -175, -173, 270,
+	-279, 322, 313, 59, 59, -122, 16, 18, -160, 110,
+	-182, 59, 59, 59, -87, -93, 119, 151, 204, 150,
+	149, 147, 309, 310, 165, 143, 144, 145, 142, 59,
+	-182, 59, 59, 59, 59, 59, 59, 59, 59, -183,
+	83, -180, -177, 59, 91, -182, 89, -87, -102, 59,
+	-67, 18, 59, 396, -182, -102, -178, 59, 59, 58,
+	-284, 59, 57, -159, 18, 25, -219, 293, 188, -273,
+	453, -300, 332, 18, 18, -225, 68, 68, 68, 68,
+	-222, 57, -102, -104, -158, 63, 119, 63, 59, 58,
+	-88, -92, -89, -91, -90, -94, -93, 151, 152, 119,
+	155, 157, 158, 159, 160, 161, 162, 163, 164, 165,
+	166, 32, 204, 147, 148, 149, 150, 167, 134, 153,
+	397, 175, 135, 176, 136, 177, 137, 178, 138, 139,
+	179, 140, -83, -160, 80, -317, -318, -197, -317, 80,
+	57, -121, -173, 271, 33, 121, 273, 31, 269, 18,
+	-182, -188, 59, -266, -268, 57, -267, 57, -266, -266,
+	-266, -95, 139, 138, -95, -270, 57, -270, -271, 57,
+	-270, 59, -177, -182, 59, 59, -138, -139, 410, 252,
+	-188, 57, 59, 21, 59, 59, -289, -204, -203, -160,
+	-159, -159, -231, 294, -84, -109, 454, 63, 18, 63,
+	-298, 63, -207, -209, -141, 57, -100, -101, -118, 307,
+	220, -198, 224, 67, 225, 329, 226, 189, 228, 229,
+	230, 200, 231, 232, 233, 322, 234, 235, 236, 237,
+	290, 5, 260, -81, -99, -98, -96, 73, 84, 31,
+	307, -97, 67, 118, 243, 221, 225, 244, -117, -171,
+	194, 79, 80, 295, -172, -272, 310, 309, -266, -267,
+	-268, -266, -266, 57, 57, -266, -269, 57, -266, -266,
+	-314, -315, -160, -315, -160, -314, -314, -197, -182, 68,
+	-280, -170, 68, 68, 68, 68, -140, 86, 413, 414,
+	-176, -178, -182, 59, 58, 21, -88, -294, -251, -144,
+	455, 68, 63, 334, 59, 58, -266, -182, -247, 210,
+	58, -118, -152, -152, -147, 118, -152, -152, -152, -152,
+	227, 227, -152, -152, -152, -152, -152, -152, -152, -152,
+	-152, -152, -152, -152, -152, -152, 57, -96, 73, -178,
+	63, -104, -105, 31, 242, 238, -106, 31, 222, 223,
+	-152, -108, 57, 250, 80, 80, -84, -274, 311, -143,
+	63, -143, 68, 57, 55, 259, 57, 57, 57, -315,
+	59, 272, 59, 59, 59, 58, 59, 58, -140, 411,
+	412, 451, 411, 412, -202, 102, 399, 59, 59, -203,
+	406, 405, 26, -301, 337, -297, -295, 332, 333, 334,
+	335, 57, 18, -210, -209, -63, 59, 18, -118, 68,
+	68, -152, -152, 68, 63, 63, 63, -152, -152, 68,
+	63, -162, 68, 68, 68, 68, 31, 63, -107, 31,
+	238, 242, 239, 240, 241, 68, 31, 68, 31, 68,
+	31, -160, 57, -319, -320, 63, 63, 68, 57, -208,
+	57, 59, 58, 59, 59, -207, -316, 264, 265, 266,
+	268, 267, -316, -207, -207, -207, 57, -233, -232, 251,
+	84, 68, 68, 83, 59, 51, 397, 400, -341, 63,
+	405, -303, 192, -299, 336, -295, 18, 334, 18, 18,
+	-145, -160, -298, -211, 200, 67, 399, 262, 263, -63,
+	-248, 252, 253, -249, -255, 255, -102, -102, 63, 63,
+	-103, 221, -85, 59, 58, 92, 59, -182, -111, -110,
+	395, -207, 63, 59, 59, 59, 59, -207, 251, 59,
+	59, -140, 398, 40, 401, -160, -206, -205, 73, 408,
+	31, 63, -309, 57, 68, -300, 18, -298, 18, -298,
+	-298, 59, 58, -152, 63, 261, -253, 256, 57, -251,
+	57, -251, 80, 265, 222, 223, 59, -320, 63, 59,
+	-115, -116, -113, -114, 54, 47, 248, 249, 59, -210,
+	-210, -210, -210, 59, 40, 54, 63, -313, 32, 59,
+	-308, -307, -142, -304, -160, 337, 63, -298, -160, 68,
+	-158, -250, 257, 68, -178, 57, -178, 57, -252, 254,
+	57, -232, -114, 54, -113, 54, 12, 11, -117, 399,
+	407, 408, -312, -311, -310, 59, 58, 122, -257, 57,
+	18, 59, -246, 59, -246, 57, 92, -178, -112, 245,
+	246, 32, 132, -112, 400, -205, 58, 92, -307, -160,
+	-258, -256, 210, -249, 59, 59, -246, 68, 59, 73,
+	31, 247, 401, 54, -311, 31, -182, 122, 59, 58,
+	60, -254, 258, 59, 408, -160, -256, -259, 35, 68,
+	-263, -260, 57, -118, 212, -263, -118, -262, -261, 257,
+	213, 59, 58, 60, 57, -261, -260, -188, 59,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	21, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	440, 441, 442, 0, 0, 273, 0, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 209, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 333, -2, 443, 444, 445, -2, 274, 275, 276,
-	277, 278, 198, 199, 200, -2, 0, 173, 0, 165,
-	165, 0, 353, 0, 0, 0, 364, 0, 373, 20,
-	311, 0, 316, 617, 653, 654, 655, 1303, 1304, 1305,
-	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
-	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
-	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 1337, 1338, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
-	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
-	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
-	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
-	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
-	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
-	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
-	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
-	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
-	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
-	0, 189, 0, 0, 193, 0, 0, 0, 270, 185,
-	186, 187, 188, 0, 0, 395, 396, 419, 422, 425,
-	0, 179, 0, 0, 80, 483, 82, 485, 0, 86,
-	88, 89, -2, 93, 94, 95, 96, 97, 98, 99,
-	0, 101, 1195, 103, 1256, 106, 107, 108, 0, 117,
-	118, -2, -2, 480, 0, 0, 1245, 62, -2, 0,
-	0, 0, 369, 514, 514, 0, 514, 0, 491, 492,
-	493, 512, 513, 527, 0, 0, 246, 247, 0, 263,
-	254, 263, 0, 238, 239, 240, 244, 245, 264, 212,
-	174, 175, 164, 0, 169, 0, 163, 0, 0, 133,
-	0, 138, 0, 1194, 1260, 1210, 0, 1228, 0, 158,
-	151, 152, 991, 1156, 0, 348, 0, 354, 353, 353,
-	0, 353, 212, 212, 212, 212, 212, 341, 0, 343,
-	346, 0, 374, 375, 376, 377, 3, 0, 0, 315,
-	0, 382, 190, 656, 0, 0, 194, 195, 0, 0,
-	201, 0, 204, 1339, 1340, 1341, 0, 0, 0, 0,
-	0, 0, 0, 410, 0, 0, 409, 0, 0, 0,
-	0, 423, 424, 426, 0, 428, 429, 435, 436, 437,
-	438, 439, 0, 353, 76, 0, 0, 0, 0, 0,
-	487, 87, 116, 90, 91, 0, 111, 113, 115, 114,
-	100, 112, 102, 104, 105, 110, 76, 0, 0, 0,
-	63, 336, 0, 317, 0, 366, 368, 0, 370, 371,
-	0, 0, 0, 0, 0, 514, 0, 259, 260, 254,
-	254, 248, 256, 0, 261, 262, 0, 382, 0, 0,
-	0, 514, 0, 0, 0, 0, 167, 0, 172, 123,
-	128, 126, 127, 129, 0, 0, 0, 0, 0, 156,
-	157, 0, 0, 0, 0, 145, 148, 609, 610, 611,
-	149, 150, 0, 992, 993, 317, 349, 365, 367, 348,
-	-2, 0, 362, 363, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 390, 384, 386, 430, 28, 0, 890,
-	653, 894, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1312,
-	1315, 1317, 1319, -2, -2, -2, 1326, -2, -2, 1330,
-	1331, 1336, 1337, 1338, -2, -2, -2, -2, 903, 724,
-	725, 726, 727, 0, 0, 0, 0, 0, 734, 735,
-	0, 747, 0, 741, 742, 743, 744, 38, 39, 919,
-	920, 921, 922, 923, 924, 925, 857, 711, 0, 0,
-	842, 832, 0, 852, 870, 871, 0, 0, 0, 0,
-	0, 40, 41, 848, 849, 850, 851, 853, 854, 855,
-	856, 858, 859, 860, 861, 864, 865, 866, 867, 868,
-	869, 872, 874, 844, 845, 846, 847, 836, 837, 838,
-	839, 840, 841, 285, 303, 287, 0, 292, 0, 618,
-	353, 0, 0, 191, 0, 196, 0, 0, 203, 205,
-	206, 207, 1342, 1343, 271, 0, 382, 182, 0, 413,
-	407, 0, 400, 411, 412, 403, 0, 405, 0, 401,
-	402, 346, 427, 421, 0, 77, 78, 79, 81, 92,
-	0, 0, 70, 468, 474, 471, 481, 484, 0, 84,
-	486, 109, 0, 65, 0, 0, 0, 337, 350, 28,
-	355, 356, 359, 455, 0, 482, 506, -2, 0, 382,
-	382, 382, 254, 0, 256, 0, 256, 251, 255, 0,
-	265, 267, 0, 455, 1287, 213, 176, 177, 0, 0,
-	171, 0, 0, 130, 131, 132, 139, 134, 136, 0,
-	0, 140, 153, 154, 155, 309, 310, 0, 0, 0,
-	144, 0, 159, 335, 317, 339, 317, 279, 280, 0,
-	282, 615, 283, 433, 434, 344, 0, 0, 417, 382,
-	0, 391, 0, 387, 0, 0, 0, 431, 0, 0,
-	889, 0, 0, 908, 909, 910, 911, 912, 913, 882,
-	878, 878, 878, 0, 878, 0, 0, 818, 0, 0,
-	878, 878, 878, 878, 819, 878, 878, 878, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, -2, 884, 0, 730, 731,
-	732, 733, 736, 0, 748, 0, 876, 0, 882, 882,
-	821, 0, 822, 833, 0, 825, 826, 827, 882, 0,
-	882, 831, 878, 286, 300, 0, 304, 0, 0, 296,
-	298, 291, 293, 0, 0, 313, 348, 383, 657, 0,
-	998, -2, 1000, -2, -2, 1002, 1003, 1004, 1005, 1006,
-	1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016,
-	1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026,
-	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036,
-	1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046,
-	1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056,
-	1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
-	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
-	1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
-	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
-	1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116,
-	1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
-	1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
-	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 0,
-	197, 202, 0, 0, 353, 0, 0, 397, 414, 0,
-	0, 398, 0, 399, 404, 406, 420, 0, 71, 75,
-	0, 470, 0, 0, 473, 83, 0, 0, 0, 59,
-	319, 0, 0, 0, 0, 358, 360, 361, 447, 456,
-	0, 515, 0, 0, 511, -2, 518, 0, 524, 0,
-	237, 241, 242, 382, 257, 254, 258, 254, 256, 0,
-	266, 269, 447, 0, 178, 166, 168, 0, 125, 0,
-	0, 0, 141, 142, 143, 146, 147, 338, 340, 0,
-	0, 20, 347, 0, 380, 385, 392, 393, 886, 887,
-	888, 432, 29, 388, 891, 0, 893, 0, 883, 884,
-	0, 879, 880, 0, 0, 0, 0, 0, 0, 0,
-	834, 0, 918, 0, 789, 790, 791, 792, 793, 794,
-	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
-	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
-	815, 816, 817, 0, 0, 0, 0, 0, 0, 0,
-	712, 713, 714, 715, 716, 717, 718, 719, 720, 721,
-	722, 723, 895, 906, 907, 0, 0, 0, 0, 0,
-	904, 899, 0, 728, 0, 745, 749, 0, 0, 877,
-	0, 884, 0, 843, 0, 0, 0, 0, 0, 303,
-	305, 0, 0, 303, 0, 0, 0, 312, 0, 284,
-	0, 0, 272, 208, 348, 183, 184, 415, 0, 408,
-	0, 0, 0, 469, 0, 0, 472, 85, 0, 67,
-	0, 60, 61, 323, 0, 351, 352, 29, 357, 446,
-	0, 457, 458, 459, 460, 461, 0, 0, 0, 0,
-	0, 507, 508, 509, 510, 519, 994, 994, 994, 0,
-	619, 249, 382, 382, 254, 268, 214, 0, 170, 124,
-	0, 226, 135, 281, 616, 0, 418, 378, 0, 0,
-	0, 892, 782, 0, 0, 0, 0, 0, 0, 771,
-	765, 766, 835, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 896, 904, 900, 0, 897, 0, 0, 885,
-	0, 750, 0, 0, 0, 0, 783, 0, 820, 823,
-	0, 828, 0, 830, 0, 301, 0, 306, 307, 303,
-	290, 297, 289, 299, 294, 295, 314, 658, 999, 996,
-	997, 192, 181, 0, 69, 72, 73, 74, 475, 0,
-	476, 455, 66, 0, 0, 325, 48, 0, 0, 0,
-	448, 449, 0, 0, 0, 0, 0, 463, 464, 465,
-	466, 467, 0, 0, 995, 0, 0, 0, 620, 621,
-	623, 624, 0, 0, 626, 680, 0, 635, 514, 635,
-	0, 0, 637, 638, 252, 250, 382, 210, 215, 216,
-	0, 220, 0, 0, 137, 345, 372, 0, 0, 394,
-	30, 389, 885, 767, 768, 769, 0, 752, 973, 977,
-	755, 973, 973, 973, 761, 761, 980, 980, 983, 980,
-	770, 0, 772, 773, 776, 774, 777, 778, 764, 881,
-	898, 0, 905, 901, 729, 737, 746, 0, 0, 0,
-	0, 0, 0, 775, 302, 0, 288, 416, 479, 0,
-	0, 67, 0, 0, 327, 0, 324, 0, 0, 0,
-	450, 451, 452, 453, 454, 462, 0, 520, 521, 612,
-	613, 614, 522, -2, 0, -2, 986, 927, 928, 929,
-	973, 931, 977, 0, 973, 973, 959, 960, 961, 962,
-	963, 964, 965, 966, 967, 0, 0, 950, 973, 975,
-	973, 973, 970, 932, 933, 934, 935, 936, 937, 938,
-	939, 940, 941, 942, 943, 944, 945, 625, 681, 647,
-	647, 636, 647, 647, 514, 0, 253, 217, 218, 219,
-	0, 222, 223, 225, 0, 379, 381, 738, 753, 974,
-	0, 754, 0, 756, 757, 758, 759, 762, 763, 760,
-	946, 0, 947, 948, 0, 949, 786, 902, 751, 739,
-	740, 784, 0, 824, 829, 308, 477, 478, 64, 68,
-	50, 329, 0, 326, 0, 320, 322, 58, 0, 502,
-	973, 0, 528, -2, 565, 994, 994, 0, 994, 994,
-	994, 994, 0, 0, 994, 994, 994, 994, 994, 994,
-	994, 994, 994, 994, 994, 994, 994, 994, 0, 622,
-	649, -2, 661, 663, 0, 0, 666, 667, 0, 0,
-	0, 0, 703, 673, 0, 0, 916, 917, 0, 679,
-	989, 987, 988, 930, 955, 956, 957, 958, 0, 0,
-	951, 952, 0, 953, 954, 0, 639, 648, 0, 648,
-	0, 0, 647, 0, 0, 224, 211, 0, 0, 0,
-	0, 0, 44, 0, 318, 0, 328, 49, 0, 495,
-	0, 359, 0, 525, 0, 523, 567, 0, 0, 994,
-	994, 0, 0, 0, 0, 994, 994, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 662, 664, 665, 668, 669, 670, 708, 709,
-	710, 671, 705, 706, 707, 672, 0, 0, 914, 915,
-	701, 926, 990, 0, 971, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 633, 221, 979, 978, 981, 0,
-	984, 0, 785, 42, 46, 51, 52, 0, 0, 0,
-	0, 0, 0, 494, 503, 504, 359, 561, 566, 568,
-	569, 0, 0, 572, 573, 574, 575, 0, 0, 578,
-	579, 580, 581, 582, 583, 584, 585, 586, 587, 603,
-	604, 605, 606, 607, 608, 588, 589, 590, 591, 592,
-	593, 600, 0, 0, 597, 0, 0, 0, 696, 0,
-	968, 0, 969, 976, 0, 640, 642, 643, 644, 645,
-	646, 641, 0, 0, 0, 0, 632, 634, 676, 0,
-	0, 0, 31, 0, 48, 0, 53, 0, 0, 0,
-	0, 0, 331, 321, 496, 994, 0, 0, 500, 501,
-	505, 550, 0, 0, 556, 0, 562, 570, 571, 576,
-	577, 594, 0, 0, 596, 0, 0, 704, 0, 683,
-	697, 0, 0, 972, 495, 495, 495, 495, 0, 677,
-	982, 985, 22, 0, 0, 45, 0, 54, 0, 56,
-	57, 330, 0, 0, 498, 0, 530, 0, 0, 0,
-	0, 0, 559, 0, 601, 602, 595, 598, 599, 674,
-	682, 684, 685, 686, 0, 698, 699, 700, 702, 627,
-	628, 629, 630, 0, 21, 0, 32, 0, 34, 36,
-	37, 650, 43, 47, 55, 332, 497, 499, 532, 0,
-	551, 0, 0, 0, 0, 0, 0, 0, 675, 687,
-	0, 688, 0, 0, 0, 631, 23, 24, 0, 33,
-	0, 0, 529, 0, 561, 552, 0, 554, 0, 0,
-	0, 0, 689, 691, 692, 0, 0, 690, 0, 0,
-	35, 651, 0, 534, 0, 548, 553, 555, 0, 560,
-	558, 693, 695, 694, 25, 26, 27, 0, 533, 0,
-	546, 531, 0, 557, 652, 535, -2, 0, 549, 536,
-	-2, 0, 544, 0, 537, 545, 0, 540, 0, 0,
-	539, 0, -2, 0, 541, -2, 0, 547,
+	20, 466, 467, 468, 0, 0, 276, 0, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 239, 212, 0,
+	0, 0, 181, 161, 162, 163, 120, 121, 122, 123,
+	0, 0, 0, 338, -2, 469, 470, 471, -2, 277,
+	278, 279, 280, 281, 199, 200, 201, -2, 0, 174,
+	0, 166, 166, 0, 358, 0, 0, 0, 369, 0,
+	384, 21, 316, 0, 321, 643, 679, 680, 681, 1367,
+	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377,
+	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387,
+	1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397,
+	1398, 1399, 1400, 1401, 1402, 1202, 1203, 1204, 1205, 1206,
+	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
+	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
+	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
+	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
+	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
+	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
+	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
+	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
+	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
+	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
+	1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346,
+	1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356,
+	1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366,
+	0, 0, 641, 190, 0, 0, 194, 0, 0, 0,
+	273, 186, 187, 188, 189, 0, 0, 0, 404, 406,
+	407, 432, 448, 0, 451, 0, 180, 0, 0, 81,
+	509, 83, 511, 0, 87, 89, 90, -2, 94, 95,
+	96, 97, 98, 99, 100, 0, 102, 1253, 104, 1320,
+	107, 108, 109, 0, 118, 119, -2, -2, 506, 0,
+	0, 1309, 63, -2, 0, 0, 0, 374, 377, 380,
+	540, 540, 0, 540, 0, 517, 518, 519, 538, 539,
+	553, 0, 0, 249, 250, 0, 266, 257, 266, 0,
+	241, 242, 243, 247, 248, 267, 215, 175, 176, 165,
+	0, 170, 0, 164, 0, 0, 134, 0, 139, 0,
+	1252, 1324, 1268, 0, 1286, 0, 159, 152, 153, 1043,
+	1213, 0, 353, 0, 359, 358, 358, 0, 358, 215,
+	215, 215, 215, 215, 346, 0, 348, 351, 0, 385,
+	386, 387, 388, 3, 0, 0, 320, 0, 393, 0,
+	-2, 0, 0, 191, 682, 0, 0, 195, 196, 0,
+	0, 202, 0, 205, 1403, 1404, 1405, 0, 209, 211,
+	0, 0, 0, 0, 0, 0, 423, 0, 0, 422,
+	0, 0, 0, 0, 0, 449, 0, 450, 452, 0,
+	454, 455, 461, 462, 463, 464, 465, 0, 358, 77,
+	0, 0, 0, 0, 0, 513, 88, 117, 91, 92,
+	0, 112, 114, 116, 115, 101, 113, 103, 105, 106,
+	111, 77, 0, 0, 0, 64, 341, 0, 322, 0,
+	371, 373, 0, 375, 376, 378, 379, 381, 382, 0,
+	0, 0, 0, 0, 540, 0, 262, 263, 257, 257,
+	251, 259, 0, 264, 265, 0, 393, 0, 0, 0,
+	540, 0, 0, 0, 0, 168, 0, 173, 124, 129,
+	127, 128, 130, 0, 0, 0, 0, 0, 157, 158,
+	0, 0, 0, 0, 146, 149, 635, 636, 637, 150,
+	151, 0, 1044, 1045, 322, 354, 370, 372, 353, -2,
+	0, 367, 368, 0, 0, 0, 0, 0, 0, 347,
+	0, 0, 401, 395, 397, 456, 29, 0, 941, 679,
+	945, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1376, 1379,
+	1381, 1383, -2, -2, -2, 1390, -2, -2, 1394, 1395,
+	1400, 1401, 1402, -2, -2, -2, -2, 954, 751, 752,
+	753, 754, 0, 0, 0, 0, 0, 761, 762, 0,
+	784, 0, 768, 769, 770, 771, 0, 39, 40, 970,
+	971, 972, 973, 974, 975, 976, 977, 908, 738, 0,
+	0, 893, 883, 0, 903, 921, 922, 0, 0, 0,
+	0, 0, 41, 42, 899, 900, 901, 902, 904, 905,
+	906, 907, 909, 910, 911, 912, 915, 916, 917, 918,
+	919, 920, 923, 925, 895, 896, 897, 898, 887, 888,
+	889, 890, 891, 892, 290, 308, 292, 0, 297, 0,
+	644, 1050, 1051, 1048, 1049, 1054, 1055, 1056, 1057, 1058,
+	1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068,
+	1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078,
+	1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
+	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098,
+	1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108,
+	1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
+	1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
+	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
+	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
+	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
+	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
+	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
+	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
+	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198,
+	1199, 1200, 1201, 358, 0, 0, 393, 642, 0, 192,
+	0, 197, 0, 0, 204, 206, 207, 208, 1406, 1407,
+	274, 0, 393, 183, 0, 405, 426, 420, 0, 411,
+	424, 425, 414, 0, 416, 0, 418, 0, 412, 413,
+	351, 0, 29, 453, 447, 0, 78, 79, 80, 82,
+	93, 0, 0, 71, 494, 500, 497, 507, 510, 0,
+	85, 512, 110, 0, 66, 0, 0, 0, 342, 355,
+	360, 361, 364, 481, 0, 508, 532, -2, 0, 393,
+	393, 393, 257, 0, 259, 0, 259, 254, 258, 0,
+	268, 270, 0, 481, 1351, 216, 177, 178, 0, 0,
+	172, 0, 0, 131, 132, 133, 140, 135, 137, 0,
+	0, 141, 154, 155, 156, 314, 315, 0, 0, 0,
+	145, 0, 160, 340, 322, 344, 322, 282, 283, 0,
+	285, 286, 459, 460, 349, 0, 0, 430, 393, 0,
+	402, 0, 398, 0, 0, 0, 457, 0, 0, 940,
+	0, 0, 959, 960, 961, 962, 963, 964, 933, 929,
+	929, 929, 0, 929, 0, 0, 869, 0, 0, 929,
+	929, 929, 929, 870, 929, 929, 929, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 935, 0, 757,
+	758, 759, 760, 763, 0, 785, 0, 0, 0, 0,
+	927, 0, 933, 933, 872, 0, 873, 884, 0, 876,
+	877, 878, 933, 0, 933, 882, 929, 291, 305, 0,
+	309, 0, 0, 301, 303, 296, 298, 0, 0, 318,
+	353, 394, 393, 288, 683, 0, -2, 1052, -2, -2,
+	0, 198, 203, 0, 0, 358, 0, 0, 408, 427,
+	0, 0, 409, 0, 410, 415, 417, 419, 433, 0,
+	0, 0, 72, 76, 0, 496, 0, 0, 499, 84,
+	0, 0, 0, 60, 324, 0, 0, 0, 363, 365,
+	366, 473, 482, 0, 541, 0, 0, 537, -2, 544,
+	0, 550, 0, 240, 244, 245, 393, 260, 257, 261,
+	257, 259, 0, 269, 272, 473, 0, 179, 167, 169,
+	0, 126, 0, 0, 0, 142, 143, 144, 147, 148,
+	343, 345, 0, 21, 352, 0, 391, 396, 403, 937,
+	938, 939, 458, 30, 399, 942, 0, 944, 0, 934,
+	935, 0, 930, 931, 0, 0, 0, 0, 0, 0,
+	0, 885, 0, 969, 0, 840, 841, 842, 843, 844,
+	845, 846, 847, 848, 849, 850, 851, 852, 853, 854,
+	855, 856, 857, 858, 859, 860, 861, 862, 863, 864,
+	865, 866, 867, 868, 0, 0, 0, 0, 0, 0,
+	0, 739, 740, 741, 742, 743, 744, 745, 746, 747,
+	748, 749, 750, 946, 957, 958, 0, 0, 0, 0,
+	0, 955, 950, 0, 775, 776, 755, 0, 782, 786,
+	0, 0, 818, 818, 0, 928, 0, 935, 0, 894,
+	0, 0, 0, 0, 0, 308, 310, 0, 0, 308,
+	0, 0, 0, 317, 0, 287, 289, 0, 0, 275,
+	210, 353, 184, 185, 428, 0, 421, 0, 30, 0,
+	0, 0, 495, 0, 0, 498, 86, 0, 68, 0,
+	61, 62, 328, 0, 356, 357, 362, 472, 0, 483,
+	484, 485, 486, 487, 0, 0, 0, 0, 0, 533,
+	534, 535, 536, 545, 1046, 1046, 1046, 0, 645, 252,
+	393, 393, 257, 271, 217, 0, 171, 125, 0, 229,
+	136, 284, 0, 431, 389, 0, 0, 943, 833, 0,
+	0, 0, 0, 0, 0, 809, 803, 804, 886, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 947, 955,
+	951, 0, 948, 0, 0, 936, 0, 787, 0, 0,
+	0, 0, 0, 358, 0, 0, 0, 834, 0, 871,
+	874, 0, 879, 0, 881, 0, 306, 0, 311, 312,
+	308, 295, 302, 294, 304, 299, 300, 319, 684, 193,
+	182, 0, 0, 0, 70, 73, 74, 75, 501, 0,
+	502, 481, 67, 0, 0, 330, 49, 0, 0, 474,
+	475, 0, 0, 0, 0, 0, 489, 490, 491, 492,
+	493, 0, 0, 1047, 0, 0, 0, 646, 647, 649,
+	650, 0, 0, 652, 707, 0, 661, 540, 661, 0,
+	0, 663, 664, 255, 253, 393, 213, 218, 219, 0,
+	223, 0, 0, 138, 350, 383, 0, 0, 31, 400,
+	936, 805, 806, 807, 0, 789, 1025, 1029, 792, 1025,
+	1025, 1025, 799, 799, 798, 1032, 1032, 1035, 1032, 808,
+	0, 810, 811, 814, 812, 815, 816, 802, 932, 949,
+	0, 956, 952, 756, 764, 783, 0, 0, 0, 772,
+	820, 0, 773, 0, 0, 0, 0, 813, 307, 0,
+	293, 429, 0, 505, 0, 0, 68, 0, 0, 332,
+	0, 329, 0, 0, 0, 476, 477, 478, 479, 480,
+	488, 0, 546, 547, 638, 639, 640, 548, -2, 0,
+	-2, 1038, 979, 980, 981, 1025, 983, 1029, 0, 1025,
+	1025, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
+	0, 0, 1002, 1025, 1027, 1025, 1025, 1022, 984, 985,
+	986, 987, 988, 989, 990, 991, 992, 993, 994, 995,
+	996, 997, 651, 708, 673, 673, 662, 673, 673, 540,
+	0, 256, 220, 221, 222, 0, 225, 226, 228, 0,
+	390, 392, 765, 790, 1026, 0, 791, 0, 793, 794,
+	795, 796, 800, 801, 797, 998, 0, 999, 1000, 0,
+	1001, 837, 953, 788, 766, 767, 817, 0, 823, 824,
+	819, 0, 835, 0, 875, 880, 313, 0, 435, 0,
+	503, 504, 65, 69, 51, 334, 0, 331, 0, 325,
+	327, 59, 0, 528, 1025, 0, 554, -2, 591, 1046,
+	1046, 0, 1046, 1046, 1046, 1046, 0, 0, 1046, 1046,
+	1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046, 1046,
+	1046, 1046, 0, 648, 675, -2, 687, 689, 0, 0,
+	692, 693, 0, 0, 0, 0, 1046, 730, 700, 0,
+	0, 967, 968, 0, 706, 1041, 1039, 1040, 982, 1007,
+	1008, 1009, 1010, 0, 0, 1003, 1004, 0, 1005, 1006,
+	0, 665, 674, 0, 674, 0, 0, 673, 0, 0,
+	227, 214, 0, 0, 0, 0, 821, 0, 0, 0,
+	0, 777, 0, 0, 0, 0, 0, 45, 0, 323,
+	0, 333, 50, 0, 521, 0, 364, 0, 551, 0,
+	549, 593, 0, 0, 1046, 1046, 0, 0, 0, 0,
+	1046, 1046, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 688, 690, 691,
+	694, 695, 696, 735, 736, 737, 697, 732, 733, 734,
+	0, 699, 0, 0, 965, 966, 728, 978, 1042, 0,
+	1023, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	659, 224, 1031, 1030, 1033, 0, 1036, 0, 0, 825,
+	826, 827, 828, 829, 0, 0, 0, 836, 448, 436,
+	437, 0, 0, 43, 47, 52, 53, 0, 0, 0,
+	0, 0, 0, 520, 529, 530, 364, 587, 592, 594,
+	595, 0, 0, 598, 599, 600, 601, 0, 0, 604,
+	605, 606, 607, 608, 609, 610, 611, 612, 613, 629,
+	630, 631, 632, 633, 634, 614, 615, 616, 617, 618,
+	619, 626, 0, 0, 623, 0, 698, 0, 0, 723,
+	0, 1020, 0, 1021, 1028, 0, 666, 668, 669, 670,
+	671, 672, 667, 0, 0, 0, 0, 658, 660, 703,
+	0, 0, 0, 0, 774, 0, 0, 0, 0, 440,
+	0, 32, 0, 49, 0, 54, 0, 0, 0, 0,
+	0, 336, 326, 522, 1046, 0, 0, 526, 527, 531,
+	576, 0, 0, 582, 0, 588, 596, 597, 602, 603,
+	620, 0, 0, 622, 0, 0, 731, 0, 710, 724,
+	0, 0, 1024, 521, 521, 521, 521, 0, 704, 1034,
+	1037, 822, 0, 780, 781, 434, 438, 0, 444, 445,
+	0, 439, 23, 0, 0, 46, 0, 55, 0, 57,
+	58, 335, 0, 0, 524, 0, 556, 0, 0, 0,
+	0, 0, 585, 0, 627, 628, 621, 624, 625, 701,
+	709, 711, 712, 713, 0, 725, 726, 727, 729, 653,
+	654, 655, 656, 0, 778, 0, 446, 22, 0, 33,
+	0, 35, 37, 38, 676, 44, 48, 56, 337, 523,
+	525, 558, 0, 577, 0, 0, 0, 0, 0, 0,
+	0, 702, 714, 0, 715, 0, 0, 0, 657, 0,
+	441, 442, 24, 25, 0, 34, 0, 0, 555, 0,
+	587, 578, 0, 580, 0, 0, 0, 0, 716, 718,
+	719, 0, 0, 717, 0, 0, 0, 0, 36, 677,
+	0, 560, 0, 574, 579, 581, 0, 586, 584, 720,
+	722, 721, 779, 0, 26, 27, 28, 0, 559, 0,
+	572, 557, 0, 583, 443, 678, 561, -2, 0, 575,
+	562, -2, 0, 570, 0, 563, 571, 0, 566, 0,
+	0, 565, 0, -2, 0, 567, -2, 0, 573,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 442,
-	90, 89, 91, 3, 443, 3, 3, 3, 3, 3,
+	3, 3, 3, 85, 3, 3, 3, 113, 105, 3,
+	57, 59, 110, 108, 58, 109, 122, 111, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 457,
+	93, 92, 94, 3, 458, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 115, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 104, 3, 116,
 }
 
 var yyTok2 = [...]int{
@@ -4286,12 +4436,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 56, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	86, 87, 88, 89, 90, 91, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 106, 107, 112, 114, 117,
+	118, 119, 120, 121, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4343,7 +4493,10 @@ var yyTok3 = [...]int{
 	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
 	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
-	57765, 440, 57766, 441, 0,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	57780, 455, 57781, 456, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4693,7 +4846,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:502
+//line mysql_sql.y:530
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4701,32 +4854,32 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:508
+//line mysql_sql.y:536
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:531
+//line mysql_sql.y:560
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 21:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:535
+//line mysql_sql.y:564
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 22:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:541
+//line mysql_sql.y:570
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -4741,42 +4894,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:556
+//line mysql_sql.y:585
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 24:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:560
+//line mysql_sql.y:589
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:566
+//line mysql_sql.y:595
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 26:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:570
+//line mysql_sql.y:599
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 27:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:576
+//line mysql_sql.y:605
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4784,10 +4937,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:583
+//line mysql_sql.y:612
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4795,58 +4948,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:592
+//line mysql_sql.y:621
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:596
+//line mysql_sql.y:625
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 31:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:600
+//line mysql_sql.y:629
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:605
+//line mysql_sql.y:634
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 33:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:609
+//line mysql_sql.y:638
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:613
+//line mysql_sql.y:642
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:619
+//line mysql_sql.y:648
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4856,10 +5009,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:628
+//line mysql_sql.y:657
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4869,42 +5022,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:639
+//line mysql_sql.y:668
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:672
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:649
+//line mysql_sql.y:678
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:653
+//line mysql_sql.y:682
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:659
+//line mysql_sql.y:688
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4927,10 +5080,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:683
+//line mysql_sql.y:712
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -4949,34 +5102,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:702
+//line mysql_sql.y:731
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:706
+//line mysql_sql.y:735
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:711
+//line mysql_sql.y:740
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:715
+//line mysql_sql.y:744
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -4984,42 +5137,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:752
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:727
+//line mysql_sql.y:756
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:732
+//line mysql_sql.y:761
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:736
+//line mysql_sql.y:765
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:770
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:774
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5042,36 +5195,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:769
+//line mysql_sql.y:798
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:773
+//line mysql_sql.y:802
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:779
+//line mysql_sql.y:808
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:785
+//line mysql_sql.y:814
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5090,10 +5243,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:803
+//line mysql_sql.y:832
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5111,10 +5264,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:820
+//line mysql_sql.y:849
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5132,50 +5285,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:843
+//line mysql_sql.y:872
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:847
+//line mysql_sql.y:876
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:851
+//line mysql_sql.y:880
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:856
+//line mysql_sql.y:885
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:860
+//line mysql_sql.y:889
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:866
+//line mysql_sql.y:895
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5186,10 +5339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:876
+//line mysql_sql.y:905
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5198,10 +5351,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:884
+//line mysql_sql.y:913
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5211,26 +5364,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:894
+//line mysql_sql.y:923
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:898
+//line mysql_sql.y:927
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:908
+//line mysql_sql.y:937
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5241,10 +5394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:918
+//line mysql_sql.y:947
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5253,30 +5406,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:928
+//line mysql_sql.y:957
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:934
+//line mysql_sql.y:963
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:940
+//line mysql_sql.y:969
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5284,10 +5437,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:947
+//line mysql_sql.y:976
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5296,10 +5449,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:955
+//line mysql_sql.y:984
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5307,58 +5460,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:963
+//line mysql_sql.y:992
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:967
+//line mysql_sql.y:996
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:971
+//line mysql_sql.y:1000
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:975
+//line mysql_sql.y:1004
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:981
+//line mysql_sql.y:1010
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:985
+//line mysql_sql.y:1014
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:991
+//line mysql_sql.y:1020
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5366,10 +5519,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:998
+//line mysql_sql.y:1027
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5377,418 +5530,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1007
+//line mysql_sql.y:1036
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1011
+//line mysql_sql.y:1040
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1046
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1050
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1054
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1058
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1062
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1066
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1070
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1074
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1078
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1082
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1086
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1090
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1094
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1098
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1102
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1106
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1110
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1114
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1118
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1122
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1126
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1130
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1134
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 110:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1138
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1142
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1146
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1121
+//line mysql_sql.y:1150
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1125
+//line mysql_sql.y:1154
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1129
+//line mysql_sql.y:1158
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1133
+//line mysql_sql.y:1162
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1137
+//line mysql_sql.y:1166
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1141
+//line mysql_sql.y:1170
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1145
+//line mysql_sql.y:1174
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1157
+//line mysql_sql.y:1186
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 125:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1163
+//line mysql_sql.y:1192
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1171
+//line mysql_sql.y:1200
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1175
+//line mysql_sql.y:1204
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1179
+//line mysql_sql.y:1208
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1183
+//line mysql_sql.y:1212
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1187
+//line mysql_sql.y:1216
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1193
+//line mysql_sql.y:1222
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1197
+//line mysql_sql.y:1226
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1201
+//line mysql_sql.y:1230
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1207
+//line mysql_sql.y:1236
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 135:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1213
+//line mysql_sql.y:1242
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 136:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1217
+//line mysql_sql.y:1246
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 138:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1224
+//line mysql_sql.y:1253
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1230
+//line mysql_sql.y:1259
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1234
+//line mysql_sql.y:1263
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1240
+//line mysql_sql.y:1269
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5797,10 +5950,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1248
+//line mysql_sql.y:1277
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5810,10 +5963,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1257
+//line mysql_sql.y:1286
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5822,10 +5975,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1265
+//line mysql_sql.y:1294
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5834,10 +5987,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1273
+//line mysql_sql.y:1302
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5861,10 +6014,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1296
+//line mysql_sql.y:1325
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5872,10 +6025,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1303
+//line mysql_sql.y:1332
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5883,10 +6036,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1310
+//line mysql_sql.y:1339
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5895,10 +6048,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1318
+//line mysql_sql.y:1347
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5906,10 +6059,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1354
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5917,10 +6070,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1332
+//line mysql_sql.y:1361
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5928,201 +6081,210 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1345
+//line mysql_sql.y:1374
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1349
+//line mysql_sql.y:1378
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1353
+//line mysql_sql.y:1382
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1359
+//line mysql_sql.y:1388
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1363
+//line mysql_sql.y:1392
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1399
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1381
+//line mysql_sql.y:1410
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1387
+//line mysql_sql.y:1416
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1421
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1396
+//line mysql_sql.y:1425
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1400
+//line mysql_sql.y:1429
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1404
+//line mysql_sql.y:1433
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1408
+//line mysql_sql.y:1437
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 171:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1412
+//line mysql_sql.y:1441
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 172:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1416
+//line mysql_sql.y:1445
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1420
+//line mysql_sql.y:1449
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1455
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1459
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1463
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1438
+//line mysql_sql.y:1467
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1443
+//line mysql_sql.y:1472
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 179:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1448
+//line mysql_sql.y:1477
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1483
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1458
+//line mysql_sql.y:1487
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 182:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1464
-		{
+//line mysql_sql.y:1493
+		{
+			// the table references of multiple table update are a cross join
+			tbl := yyDollar[2].tableExprsUnion()[0]
+			for _, t := range yyDollar[2].tableExprsUnion()[1:] {
+				tbl = &tree.JoinTableExpr{
+					Left:     tbl,
+					JoinType: tree.JOIN_TYPE_CROSS,
+					Right:    t,
+				}
+			}
 			yyLOCAL = &tree.Update{
-				Table:   yyDollar[2].tableExprUnion(),
+				Table:   tbl,
 				Exprs:   yyDollar[4].updateExprsUnion(),
 				Where:   yyDollar[5].whereUnion(),
 				OrderBy: yyDollar[6].orderByUnion(),
@@ -6130,84 +6292,84 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1477
+//line mysql_sql.y:1515
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1481
+//line mysql_sql.y:1519
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 185:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1487
+//line mysql_sql.y:1525
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1534
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1502
+//line mysql_sql.y:1540
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1545
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1512
+//line mysql_sql.y:1550
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 193:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1516
+//line mysql_sql.y:1554
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1520
+//line mysql_sql.y:1558
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1524
+//line mysql_sql.y:1562
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6217,10 +6379,10 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1533
+//line mysql_sql.y:1571
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6229,10 +6391,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 197:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1541
+//line mysql_sql.y:1579
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6243,76 +6405,92 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1551
+//line mysql_sql.y:1589
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1564
+//line mysql_sql.y:1602
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1568
+//line mysql_sql.y:1606
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1574
+//line mysql_sql.y:1612
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1580
+//line mysql_sql.y:1618
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 205:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1585
+//line mysql_sql.y:1623
 		{
 			yyVAL.str = "true"
 		}
-	case 206:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1586
+//line mysql_sql.y:1624
 		{
 			yyVAL.str = "false"
 		}
-	case 207:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1587
+//line mysql_sql.y:1625
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 208:
+	case 209:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1630
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 210:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1592
+//line mysql_sql.y:1634
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1640
+		{
+			yyLOCAL = tree.NewBackup(yyDollar[3].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 213:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1602
+//line mysql_sql.y:1650
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6324,10 +6502,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 214:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1661
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6340,118 +6518,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1626
+//line mysql_sql.y:1674
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1630
+//line mysql_sql.y:1678
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1635
+//line mysql_sql.y:1683
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1639
+//line mysql_sql.y:1687
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1645
+//line mysql_sql.y:1693
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1649
+//line mysql_sql.y:1697
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1655
+//line mysql_sql.y:1703
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1659
+//line mysql_sql.y:1707
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1663
+//line mysql_sql.y:1711
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 224:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1667
+//line mysql_sql.y:1715
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1671
+//line mysql_sql.y:1719
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1675
+//line mysql_sql.y:1723
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 227:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1681
+//line mysql_sql.y:1729
 		{
 			yyVAL.item = nil
 		}
-	case 225:
+	case 228:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1686
+//line mysql_sql.y:1734
 		{
 			yyVAL.item = nil
 		}
-	case 237:
+	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1707
+//line mysql_sql.y:1755
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6459,10 +6637,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 244:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1721
+//line mysql_sql.y:1769
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6471,10 +6649,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 245:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1779
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6483,58 +6661,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 246:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1740
+//line mysql_sql.y:1788
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1744
+//line mysql_sql.y:1792
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1748
+//line mysql_sql.y:1796
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1754
+//line mysql_sql.y:1802
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 250:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1760
+//line mysql_sql.y:1808
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1766
+//line mysql_sql.y:1814
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 252:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1772
+//line mysql_sql.y:1820
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6545,10 +6723,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 253:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1782
+//line mysql_sql.y:1830
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6559,18 +6737,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1794
+//line mysql_sql.y:1842
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 255:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1800
+//line mysql_sql.y:1848
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6583,10 +6761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 256:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1860
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6599,118 +6777,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 257:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1825
+//line mysql_sql.y:1873
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1829
+//line mysql_sql.y:1877
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 259:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1834
+//line mysql_sql.y:1882
 		{
 			yyVAL.str = ""
 		}
-	case 257:
+	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1838
+//line mysql_sql.y:1886
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 258:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1844
+//line mysql_sql.y:1892
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 266:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1857
+//line mysql_sql.y:1905
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1861
+//line mysql_sql.y:1909
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:1915
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 269:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1871
+//line mysql_sql.y:1919
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1877
+//line mysql_sql.y:1925
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1881
+//line mysql_sql.y:1929
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1890
+//line mysql_sql.y:1938
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1894
+//line mysql_sql.y:1942
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 275:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1898
+//line mysql_sql.y:1946
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1914
+//line mysql_sql.y:1962
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6718,10 +6896,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1923
+//line mysql_sql.y:1971
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6729,10 +6907,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 284:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1932
+//line mysql_sql.y:1980
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6741,26 +6919,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1942
+//line mysql_sql.y:1990
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1948
+//line mysql_sql.y:1996
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 287:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1954
+//line mysql_sql.y:2002
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6770,10 +6948,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 288:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2011
+		{
+			yyLOCAL = &tree.Delete{
+				Tables:    yyDollar[2].tableNamesUnion(),
+				TableRefs: yyDollar[4].tableExprsUnion(),
+				Where:     yyDollar[5].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 289:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2019
+		{
+			yyLOCAL = &tree.Delete{
+				Tables:    yyDollar[3].tableNamesUnion(),
+				TableRefs: yyDollar[5].tableExprsUnion(),
+				Where:     yyDollar[6].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1969
+//line mysql_sql.y:2031
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6781,10 +6983,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1978
+//line mysql_sql.y:2040
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6792,20 +6994,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1985
+//line mysql_sql.y:2047
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 293:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1991
+//line mysql_sql.y:2053
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6814,10 +7016,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1999
+//line mysql_sql.y:2061
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6825,10 +7027,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2006
+//line mysql_sql.y:2068
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6836,10 +7038,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 296:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2013
+//line mysql_sql.y:2075
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6858,34 +7060,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 297:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2032
+//line mysql_sql.y:2094
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2036
+//line mysql_sql.y:2098
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2040
+//line mysql_sql.y:2102
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2046
+//line mysql_sql.y:2108
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6893,155 +7095,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2055
+//line mysql_sql.y:2117
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2059
+//line mysql_sql.y:2121
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2065
+//line mysql_sql.y:2127
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 299:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2069
+//line mysql_sql.y:2131
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 300:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2075
+//line mysql_sql.y:2137
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2079
+//line mysql_sql.y:2141
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2085
+//line mysql_sql.y:2147
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 308:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2090
+//line mysql_sql.y:2152
 		{
 		}
-	case 305:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2094
+//line mysql_sql.y:2156
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2101
+//line mysql_sql.y:2163
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2167
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2112
+//line mysql_sql.y:2174
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 316:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2117
+//line mysql_sql.y:2179
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2121
+//line mysql_sql.y:2183
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2127
+//line mysql_sql.y:2189
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2131
+//line mysql_sql.y:2193
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2137
+//line mysql_sql.y:2199
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2141
+//line mysql_sql.y:2203
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2146
+//line mysql_sql.y:2208
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 323:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2150
+//line mysql_sql.y:2212
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -7054,10 +7256,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2163
+//line mysql_sql.y:2225
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7065,10 +7267,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 325:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2170
+//line mysql_sql.y:2232
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7076,10 +7278,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 326:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2177
+//line mysql_sql.y:2239
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7098,10 +7300,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2195
+//line mysql_sql.y:2257
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7120,38 +7322,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2214
+//line mysql_sql.y:2276
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2220
+//line mysql_sql.y:2282
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2227
+//line mysql_sql.y:2289
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2231
+//line mysql_sql.y:2293
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7164,115 +7366,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2244
+//line mysql_sql.y:2306
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2248
+//line mysql_sql.y:2310
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2253
+//line mysql_sql.y:2315
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 335:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2257
+//line mysql_sql.y:2319
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2326
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2269
+//line mysql_sql.y:2331
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2276
+//line mysql_sql.y:2338
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 340:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2282
+//line mysql_sql.y:2344
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 341:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2286
+//line mysql_sql.y:2348
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2290
+//line mysql_sql.y:2352
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 343:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2294
+//line mysql_sql.y:2356
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 344:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2298
+//line mysql_sql.y:2360
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 345:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2302
+//line mysql_sql.y:2364
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2308
+//line mysql_sql.y:2370
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7280,10 +7482,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 347:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2315
+//line mysql_sql.y:2377
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7291,26 +7493,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2324
+//line mysql_sql.y:2386
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2328
+//line mysql_sql.y:2390
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 350:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2334
+//line mysql_sql.y:2396
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7318,162 +7520,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2342
+//line mysql_sql.y:2404
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2346
+//line mysql_sql.y:2408
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 353:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2351
+//line mysql_sql.y:2413
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2355
+//line mysql_sql.y:2417
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2361
+//line mysql_sql.y:2423
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 356:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2365
+//line mysql_sql.y:2427
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 357:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2369
+//line mysql_sql.y:2431
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2374
+//line mysql_sql.y:2436
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2378
+//line mysql_sql.y:2440
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2384
+//line mysql_sql.y:2446
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2390
+//line mysql_sql.y:2452
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2394
+//line mysql_sql.y:2456
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2400
+//line mysql_sql.y:2462
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 364:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2405
+//line mysql_sql.y:2467
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2409
+//line mysql_sql.y:2471
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2413
+//line mysql_sql.y:2475
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2420
+//line mysql_sql.y:2482
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2424
+//line mysql_sql.y:2486
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2430
+//line mysql_sql.y:2492
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2434
+//line mysql_sql.y:2496
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7484,10 +7686,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2444
+//line mysql_sql.y:2506
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7498,10 +7700,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2454
+//line mysql_sql.y:2516
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7512,10 +7714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2464
+//line mysql_sql.y:2526
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7526,10 +7728,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2476
+//line mysql_sql.y:2538
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7538,10 +7740,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2484
+//line mysql_sql.y:2546
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7550,10 +7752,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2492
+//line mysql_sql.y:2554
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7562,10 +7764,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2562
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 378:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2570
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 379:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2578
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2586
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 381:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2594
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 382:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2602
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 383:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2502
+//line mysql_sql.y:2612
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7577,130 +7851,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2514
+//line mysql_sql.y:2624
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2518
+//line mysql_sql.y:2628
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2522
+//line mysql_sql.y:2632
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 389:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2531
+//line mysql_sql.y:2641
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2535
+//line mysql_sql.y:2645
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 391:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2540
+//line mysql_sql.y:2650
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2544
+//line mysql_sql.y:2654
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2549
+//line mysql_sql.y:2659
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2553
+//line mysql_sql.y:2663
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2559
+//line mysql_sql.y:2669
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2563
+//line mysql_sql.y:2673
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2569
+//line mysql_sql.y:2679
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 398:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2573
+//line mysql_sql.y:2683
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2577
+//line mysql_sql.y:2687
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 400:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2581
+//line mysql_sql.y:2691
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2587
+//line mysql_sql.y:2697
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7709,44 +7983,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2595
+//line mysql_sql.y:2705
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2601
+//line mysql_sql.y:2711
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2609
+//line mysql_sql.y:2719
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2613
+//line mysql_sql.y:2723
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 408:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2623
+//line mysql_sql.y:2733
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7756,10 +8030,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 409:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2742
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7769,10 +8043,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 410:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2752
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7782,10 +8056,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2761
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7794,15 +8068,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2661
+//line mysql_sql.y:2771
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 402:
+	case 413:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2665
+//line mysql_sql.y:2775
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7810,130 +8084,142 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 403:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2785
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 404:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2789
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 405:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2683
+//line mysql_sql.y:2793
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 406:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2687
+//line mysql_sql.y:2797
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 407:
+	case 418:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2801
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 419:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:2805
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 420:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2693
+//line mysql_sql.y:2811
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2697
+//line mysql_sql.y:2815
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2821
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 410:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2709
+//line mysql_sql.y:2827
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 411:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2713
+//line mysql_sql.y:2831
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 412:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2717
+//line mysql_sql.y:2835
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 413:
+	case 426:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2723
+//line mysql_sql.y:2841
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2727
+//line mysql_sql.y:2845
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2733
+//line mysql_sql.y:2851
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 429:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2737
+//line mysql_sql.y:2855
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2743
+//line mysql_sql.y:2861
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2747
+//line mysql_sql.y:2865
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2753
+//line mysql_sql.y:2871
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 433:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2757
+//line mysql_sql.y:2875
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -7944,28 +8230,152 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 434:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:2885
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: tree.NewJSONTable(yyDollar[3].exprUnion(), yyDollar[5].str, yyDollar[8].jsonTableColumnsUnion()),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[12].str),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 435:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.JSONTableColumn
+//line mysql_sql.y:2897
+		{
+			yyLOCAL = []*tree.JSONTableColumn{yyDollar[1].jsonTableColumnUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 436:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.JSONTableColumn
+//line mysql_sql.y:2901
+		{
+			yyLOCAL = append(yyDollar[1].jsonTableColumnsUnion(), yyDollar[3].jsonTableColumnUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 437:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JSONTableColumn
+//line mysql_sql.y:2907
+		{
+			yyLOCAL = &tree.JSONTableColumn{
+				Name: tree.Identifier(yyDollar[1].str),
+				Type: tree.JSON_TABLE_COLUMN_ORDINALITY,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 438:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JSONTableColumn
+//line mysql_sql.y:2914
+		{
+			yyLOCAL = &tree.JSONTableColumn{
+				Name:    tree.Identifier(yyDollar[1].str),
+				Type:    tree.JSON_TABLE_COLUMN_PATH,
+				ColType: yyDollar[2].columnTypeUnion(),
+				Path:    yyDollar[4].str,
+				OnEmpty: yyDollar[5].jsonOnResponsesUnion()[0],
+				OnError: yyDollar[5].jsonOnResponsesUnion()[1],
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 439:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JSONTableColumn
+//line mysql_sql.y:2925
+		{
+			yyLOCAL = &tree.JSONTableColumn{
+				Name:    tree.Identifier(yyDollar[1].str),
+				Type:    tree.JSON_TABLE_COLUMN_EXISTS,
+				ColType: yyDollar[2].columnTypeUnion(),
+				Path:    yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 440:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL [2]tree.JSONOnResponse
+//line mysql_sql.y:2935
+		{
+			yyLOCAL = [2]tree.JSONOnResponse{}
+		}
+		yyVAL.union = yyLOCAL
+	case 441:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL [2]tree.JSONOnResponse
+//line mysql_sql.y:2939
+		{
+			yyLOCAL = [2]tree.JSONOnResponse{yyDollar[1].jsonOnResponseUnion(), {}}
+		}
+		yyVAL.union = yyLOCAL
+	case 442:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL [2]tree.JSONOnResponse
+//line mysql_sql.y:2943
+		{
+			yyLOCAL = [2]tree.JSONOnResponse{{}, yyDollar[1].jsonOnResponseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 443:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL [2]tree.JSONOnResponse
+//line mysql_sql.y:2947
+		{
+			yyLOCAL = [2]tree.JSONOnResponse{yyDollar[1].jsonOnResponseUnion(), yyDollar[4].jsonOnResponseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.JSONOnResponse
+//line mysql_sql.y:2953
+		{
+			yyLOCAL = tree.JSONOnResponse{Type: tree.JSON_ON_RESPONSE_NULL}
+		}
+		yyVAL.union = yyLOCAL
+	case 445:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.JSONOnResponse
+//line mysql_sql.y:2957
+		{
+			yyLOCAL = tree.JSONOnResponse{Type: tree.JSON_ON_RESPONSE_ERROR}
+		}
+		yyVAL.union = yyLOCAL
+	case 446:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.JSONOnResponse
+//line mysql_sql.y:2961
+		{
+			yyLOCAL = tree.JSONOnResponse{Type: tree.JSON_ON_RESPONSE_DEFAULT, Default: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2770
+//line mysql_sql.y:2967
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 448:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2775
+//line mysql_sql.y:2972
 		{
 		}
-	case 423:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2776
+//line mysql_sql.y:2973
 		{
 		}
-	case 424:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2780
+//line mysql_sql.y:2977
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -7975,46 +8385,46 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2792
+//line mysql_sql.y:2990
 		{
 			yyVAL.str = ""
 		}
-	case 426:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2796
+//line mysql_sql.y:2994
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 427:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2800
+//line mysql_sql.y:2998
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 430:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2809
+//line mysql_sql.y:3007
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2813
+//line mysql_sql.y:3011
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2817
+//line mysql_sql.y:3015
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 446:
+	case 472:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2846
+//line mysql_sql.y:3044
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8024,189 +8434,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2856
+//line mysql_sql.y:3054
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2860
+//line mysql_sql.y:3058
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2866
+//line mysql_sql.y:3064
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2870
+//line mysql_sql.y:3068
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2876
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2880
+//line mysql_sql.y:3078
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2884
+//line mysql_sql.y:3082
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2888
+//line mysql_sql.y:3086
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2894
+//line mysql_sql.y:3092
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2901
+//line mysql_sql.y:3099
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2906
+//line mysql_sql.y:3104
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2911
+//line mysql_sql.y:3109
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2916
+//line mysql_sql.y:3114
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2922
+//line mysql_sql.y:3120
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2926
+//line mysql_sql.y:3124
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2930
+//line mysql_sql.y:3128
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2936
+//line mysql_sql.y:3134
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2940
+//line mysql_sql.y:3138
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2944
+//line mysql_sql.y:3142
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2948
+//line mysql_sql.y:3146
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2954
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2958
+//line mysql_sql.y:3156
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2964
+//line mysql_sql.y:3162
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8218,42 +8628,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2977
+//line mysql_sql.y:3175
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2981
+//line mysql_sql.y:3179
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2985
+//line mysql_sql.y:3183
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 500:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2990
+//line mysql_sql.y:3188
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2994
+//line mysql_sql.y:3192
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8261,20 +8671,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3001
+//line mysql_sql.y:3199
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3007
+//line mysql_sql.y:3205
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8283,10 +8693,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3015
+//line mysql_sql.y:3213
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8294,20 +8704,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3022
+//line mysql_sql.y:3220
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3034
+//line mysql_sql.y:3232
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8315,82 +8725,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3043
+//line mysql_sql.y:3241
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3047
+//line mysql_sql.y:3245
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3053
+//line mysql_sql.y:3251
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3057
+//line mysql_sql.y:3255
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3061
+//line mysql_sql.y:3259
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3070
+//line mysql_sql.y:3268
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3074
+//line mysql_sql.y:3272
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3078
+//line mysql_sql.y:3276
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3082
+//line mysql_sql.y:3280
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 520:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3088
+//line mysql_sql.y:3286
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8409,18 +8819,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3107
+//line mysql_sql.y:3305
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3111
+//line mysql_sql.y:3309
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8441,123 +8851,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3133
+//line mysql_sql.y:3331
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3137
+//line mysql_sql.y:3335
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3141
+//line mysql_sql.y:3339
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3145
+//line mysql_sql.y:3343
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3149
+//line mysql_sql.y:3347
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3155
+//line mysql_sql.y:3353
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3159
+//line mysql_sql.y:3357
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3165
+//line mysql_sql.y:3363
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3170
+//line mysql_sql.y:3368
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3175
+//line mysql_sql.y:3373
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3179
+//line mysql_sql.y:3377
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3183
+//line mysql_sql.y:3381
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3187
+//line mysql_sql.y:3385
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3191
+//line mysql_sql.y:3389
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3197
+//line mysql_sql.y:3395
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8566,98 +8976,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3211
+//line mysql_sql.y:3409
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3215
+//line mysql_sql.y:3413
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3220
+//line mysql_sql.y:3418
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3224
+//line mysql_sql.y:3422
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3230
+//line mysql_sql.y:3428
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3234
+//line mysql_sql.y:3432
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3240
+//line mysql_sql.y:3438
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3244
+//line mysql_sql.y:3442
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3248
+//line mysql_sql.y:3446
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 549:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3253
+//line mysql_sql.y:3451
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3257
+//line mysql_sql.y:3455
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 551:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3263
+//line mysql_sql.y:3461
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8669,34 +9079,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 552:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3275
+//line mysql_sql.y:3473
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3279
+//line mysql_sql.y:3477
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3284
+//line mysql_sql.y:3482
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 555:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3288
+//line mysql_sql.y:3486
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8706,18 +9116,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3298
+//line mysql_sql.y:3496
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3302
+//line mysql_sql.y:3500
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8726,42 +9136,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3311
+//line mysql_sql.y:3509
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3315
+//line mysql_sql.y:3513
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3321
+//line mysql_sql.y:3519
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3325
+//line mysql_sql.y:3523
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3331
+//line mysql_sql.y:3529
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8771,10 +9181,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 563:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3340
+//line mysql_sql.y:3538
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8784,42 +9194,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3350
+//line mysql_sql.y:3548
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3354
+//line mysql_sql.y:3552
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3360
+//line mysql_sql.y:3558
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3364
+//line mysql_sql.y:3562
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3370
+//line mysql_sql.y:3568
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8827,10 +9237,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3377
+//line mysql_sql.y:3575
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8838,50 +9248,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3386
+//line mysql_sql.y:3584
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3390
+//line mysql_sql.y:3588
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3395
+//line mysql_sql.y:3593
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 573:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3399
+//line mysql_sql.y:3597
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3404
+//line mysql_sql.y:3602
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3408
+//line mysql_sql.y:3606
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8891,18 +9301,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3418
+//line mysql_sql.y:3616
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3422
+//line mysql_sql.y:3620
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8912,10 +9322,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 578:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3433
+//line mysql_sql.y:3631
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8924,10 +9334,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 579:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3441
+//line mysql_sql.y:3639
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8936,10 +9346,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3449
+//line mysql_sql.y:3647
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8948,10 +9358,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 581:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3457
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8960,10 +9370,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 583:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3468
+//line mysql_sql.y:3666
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -8974,10 +9384,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 584:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3478
+//line mysql_sql.y:3676
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -8987,478 +9397,478 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3488
+//line mysql_sql.y:3686
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3492
+//line mysql_sql.y:3690
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3497
+//line mysql_sql.y:3695
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3501
+//line mysql_sql.y:3699
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3506
+//line mysql_sql.y:3704
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3510
+//line mysql_sql.y:3708
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3516
+//line mysql_sql.y:3714
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3520
+//line mysql_sql.y:3718
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3524
+//line mysql_sql.y:3722
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3530
+//line mysql_sql.y:3728
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3534
+//line mysql_sql.y:3732
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3538
+//line mysql_sql.y:3736
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3542
+//line mysql_sql.y:3740
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3546
+//line mysql_sql.y:3744
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3550
+//line mysql_sql.y:3748
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3554
+//line mysql_sql.y:3752
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3558
+//line mysql_sql.y:3756
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 602:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3562
+//line mysql_sql.y:3760
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3566
+//line mysql_sql.y:3764
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3570
+//line mysql_sql.y:3768
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3574
+//line mysql_sql.y:3772
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3578
+//line mysql_sql.y:3776
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3582
+//line mysql_sql.y:3780
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3586
+//line mysql_sql.y:3784
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3590
+//line mysql_sql.y:3788
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3594
+//line mysql_sql.y:3792
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3598
+//line mysql_sql.y:3796
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3602
+//line mysql_sql.y:3800
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3606
+//line mysql_sql.y:3804
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3610
+//line mysql_sql.y:3808
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3614
+//line mysql_sql.y:3812
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3618
+//line mysql_sql.y:3816
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3622
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3626
+//line mysql_sql.y:3824
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3630
+//line mysql_sql.y:3828
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 620:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3634
+//line mysql_sql.y:3832
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 621:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3638
+//line mysql_sql.y:3836
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3642
+//line mysql_sql.y:3840
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3649
+//line mysql_sql.y:3847
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3653
+//line mysql_sql.y:3851
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3659
+//line mysql_sql.y:3857
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3664
+//line mysql_sql.y:3862
 		{
 			yyVAL.str = ""
 		}
-	case 601:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3668
+//line mysql_sql.y:3866
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 602:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3672
+//line mysql_sql.y:3870
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 603:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3678
+//line mysql_sql.y:3876
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3682
+//line mysql_sql.y:3880
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3686
+//line mysql_sql.y:3884
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3690
+//line mysql_sql.y:3888
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3694
+//line mysql_sql.y:3892
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3698
+//line mysql_sql.y:3896
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3714
+//line mysql_sql.y:3912
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3718
+//line mysql_sql.y:3916
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3727
+//line mysql_sql.y:3925
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3732
+//line mysql_sql.y:3930
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 645:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3738
+//line mysql_sql.y:3936
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3745
+//line mysql_sql.y:3943
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3749
+//line mysql_sql.y:3947
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3755
+//line mysql_sql.y:3953
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3759
+//line mysql_sql.y:3957
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3765
+//line mysql_sql.y:3963
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9469,18 +9879,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3775
+//line mysql_sql.y:3973
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 653:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3781
+//line mysql_sql.y:3979
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9490,10 +9900,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 654:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3790
+//line mysql_sql.y:3988
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9503,10 +9913,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 655:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3799
+//line mysql_sql.y:3997
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9530,10 +9940,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 656:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3822
+//line mysql_sql.y:4020
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9543,10 +9953,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 657:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3831
+//line mysql_sql.y:4029
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9557,10 +9967,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 658:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3841
+//line mysql_sql.y:4039
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9568,298 +9978,306 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3849
+//line mysql_sql.y:4047
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3855
+//line mysql_sql.y:4053
 		{
 			yyVAL.str = ""
 		}
-	case 636:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3859
+//line mysql_sql.y:4057
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 639:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3869
+//line mysql_sql.y:4067
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3875
+//line mysql_sql.y:4073
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3881
+//line mysql_sql.y:4079
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 673:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3895
+//line mysql_sql.y:4093
 		{
 			yyVAL.str = ""
 		}
-	case 649:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3902
+//line mysql_sql.y:4100
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3908
+//line mysql_sql.y:4106
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3912
+//line mysql_sql.y:4110
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 678:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3916
+//line mysql_sql.y:4114
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3927
+//line mysql_sql.y:4125
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3931
+//line mysql_sql.y:4129
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 684:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3935
+//line mysql_sql.y:4133
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 685:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3940
+//line mysql_sql.y:4138
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3944
+//line mysql_sql.y:4142
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3950
+//line mysql_sql.y:4148
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3954
+//line mysql_sql.y:4152
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3960
+//line mysql_sql.y:4158
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3964
+//line mysql_sql.y:4162
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3968
+//line mysql_sql.y:4166
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3972
+//line mysql_sql.y:4170
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3976
+//line mysql_sql.y:4174
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3980
+//line mysql_sql.y:4178
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3984
+//line mysql_sql.y:4182
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3988
+//line mysql_sql.y:4186
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3992
+//line mysql_sql.y:4190
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 698:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3996
+//line mysql_sql.y:4194
 		{
-			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
+			yyLOCAL = tree.NewAttributeCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 699:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4000
+//line mysql_sql.y:4198
+		{
+			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
+		}
+		yyVAL.union = yyLOCAL
+	case 700:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4202
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 701:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4004
+//line mysql_sql.y:4206
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 702:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4008
+//line mysql_sql.y:4210
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4018
+//line mysql_sql.y:4220
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4022
+//line mysql_sql.y:4224
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4027
+//line mysql_sql.y:4229
 		{
 			yyVAL.str = ""
 		}
-	case 679:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4031
+//line mysql_sql.y:4233
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 680:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4037
+//line mysql_sql.y:4239
 		{
 			yyVAL.str = ""
 		}
-	case 681:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4041
+//line mysql_sql.y:4243
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 682:
+	case 709:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4047
+//line mysql_sql.y:4249
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9870,10 +10288,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 710:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4058
+//line mysql_sql.y:4260
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9881,10 +10299,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4068
+//line mysql_sql.y:4270
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9892,10 +10310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4075
+//line mysql_sql.y:4277
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9903,10 +10321,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4082
+//line mysql_sql.y:4284
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9914,10 +10332,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4089
+//line mysql_sql.y:4291
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -9925,339 +10343,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4098
+//line mysql_sql.y:4300
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4104
+//line mysql_sql.y:4306
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4110
+//line mysql_sql.y:4312
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4114
+//line mysql_sql.y:4316
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4118
+//line mysql_sql.y:4320
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4122
+//line mysql_sql.y:4324
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 722:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4126
+//line mysql_sql.y:4328
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4131
+//line mysql_sql.y:4333
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4138
+//line mysql_sql.y:4340
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4142
+//line mysql_sql.y:4344
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4146
+//line mysql_sql.y:4348
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 728:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4151
+//line mysql_sql.y:4353
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4155
+//line mysql_sql.y:4357
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 730:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4160
+//line mysql_sql.y:4362
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4164
+//line mysql_sql.y:4366
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4180
+//line mysql_sql.y:4382
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4186
+//line mysql_sql.y:4388
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4190
+//line mysql_sql.y:4392
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4194
+//line mysql_sql.y:4396
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4198
+//line mysql_sql.y:4400
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4202
+//line mysql_sql.y:4404
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4206
+//line mysql_sql.y:4408
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4210
+//line mysql_sql.y:4412
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4214
+//line mysql_sql.y:4416
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4218
+//line mysql_sql.y:4420
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4222
+//line mysql_sql.y:4424
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4226
+//line mysql_sql.y:4428
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4230
+//line mysql_sql.y:4432
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4234
+//line mysql_sql.y:4436
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 752:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4240
+//line mysql_sql.y:4442
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4244
+//line mysql_sql.y:4446
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4248
+//line mysql_sql.y:4450
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4252
+//line mysql_sql.y:4454
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 756:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4256
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4260
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4264
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4268
+//line mysql_sql.y:4470
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4272
+//line mysql_sql.y:4474
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4276
+//line mysql_sql.y:4478
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4280
+//line mysql_sql.y:4482
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 763:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4284
+//line mysql_sql.y:4486
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 764:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4289
+//line mysql_sql.y:4491
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10266,26 +10684,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 765:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4297
+//line mysql_sql.y:4499
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 766:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4301
+//line mysql_sql.y:4503
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 767:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4305
+//line mysql_sql.y:4507
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -10295,90 +10713,185 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4314
+//line mysql_sql.y:4516
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4318
+//line mysql_sql.y:4520
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4322
+//line mysql_sql.y:4524
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4326
+//line mysql_sql.y:4528
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 772:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4532
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[4].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4537
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[4].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4542
+		{
+			yyLOCAL = tree.NewFullTextMatchExpr(yyDollar[3].unresolveNamesUnion(), yyDollar[7].exprUnion(), yyDollar[8].fullTextSearchTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4546
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4554
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+			name = tree.SetUnresolvedName("json_unquote")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 777:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:4568
+		{
+			yyLOCAL = tree.FULLTEXT_DEFAULT
+		}
+		yyVAL.union = yyLOCAL
+	case 778:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:4572
+		{
+			yyLOCAL = tree.FULLTEXT_NL
+		}
+		yyVAL.union = yyLOCAL
+	case 779:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:4576
+		{
+			yyLOCAL = tree.FULLTEXT_NL_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 780:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:4580
+		{
+			yyLOCAL = tree.FULLTEXT_BOOLEAN
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:4584
+		{
+			yyLOCAL = tree.FULLTEXT_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4331
+//line mysql_sql.y:4589
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4335
+//line mysql_sql.y:4593
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 784:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4340
+//line mysql_sql.y:4598
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4344
+//line mysql_sql.y:4602
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4350
+//line mysql_sql.y:4608
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4354
+//line mysql_sql.y:4612
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 788:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4360
+//line mysql_sql.y:4618
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10386,10 +10899,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4370
+//line mysql_sql.y:4628
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10403,10 +10916,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4383
+//line mysql_sql.y:4641
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10420,10 +10933,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4396
+//line mysql_sql.y:4654
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10436,10 +10949,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4408
+//line mysql_sql.y:4666
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10454,10 +10967,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4422
+//line mysql_sql.y:4680
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10473,10 +10986,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4437
+//line mysql_sql.y:4695
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10492,10 +11005,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4452
+//line mysql_sql.y:4710
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10509,10 +11022,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4465
+//line mysql_sql.y:4723
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10527,15 +11040,31 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 798:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:4737
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.JsonFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_JSON),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 799:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4480
+//line mysql_sql.y:4750
 		{
 		}
-	case 764:
+	case 802:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4486
+//line mysql_sql.y:4756
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10545,10 +11074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 803:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4495
+//line mysql_sql.y:4765
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10557,10 +11086,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 804:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4503
+//line mysql_sql.y:4773
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10569,10 +11098,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4511
+//line mysql_sql.y:4781
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10582,10 +11111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 806:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4520
+//line mysql_sql.y:4790
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10595,10 +11124,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 807:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4529
+//line mysql_sql.y:4799
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10608,10 +11137,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 808:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4808
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10621,10 +11150,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 809:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4547
+//line mysql_sql.y:4817
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10634,10 +11163,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 810:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4556
+//line mysql_sql.y:4826
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10647,88 +11176,204 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 811:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4835
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4844
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4853
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 814:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4862
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 815:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4565
+//line mysql_sql.y:4871
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 816:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4880
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 817:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4891
+		{
+			yyLOCAL = &tree.WindowSpec{
+				PartitionBy: yyDollar[1].exprsUnion(),
+				OrderBy:     yyDollar[2].orderByUnion(),
+				Frame:       yyDollar[3].frameClauseUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 818:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4900
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 819:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4904
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 820:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4909
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 821:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4913
+		{
+			yyLOCAL = &tree.FrameClause{
+				Unit:  yyDollar[1].frameUnitUnion(),
+				Start: yyDollar[2].frameBoundUnion(),
+				End:   &tree.FrameBound{Type: tree.FRAME_BOUND_CURRENT_ROW},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 822:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4921
+		{
+			yyLOCAL = &tree.FrameClause{
+				Unit:  yyDollar[1].frameUnitUnion(),
+				Start: yyDollar[3].frameBoundUnion(),
+				End:   yyDollar[5].frameBoundUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 823:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameUnitType
+//line mysql_sql.y:4931
+		{
+			yyLOCAL = tree.FRAME_UNIT_ROWS
+		}
+		yyVAL.union = yyLOCAL
+	case 824:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameUnitType
+//line mysql_sql.y:4935
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = tree.FRAME_UNIT_RANGE
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+	case 825:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4941
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_PRECEDING}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+	case 826:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4945
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_FOLLOWING}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+	case 827:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4949
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_CURRENT_ROW}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+	case 828:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4953
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_PRECEDING, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+	case 829:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4957
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_FOLLOWING, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 833:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4626
+//line mysql_sql.y:4968
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10737,10 +11382,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 834:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4634
+//line mysql_sql.y:4976
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10749,10 +11394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 835:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4642
+//line mysql_sql.y:4984
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10761,10 +11406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 836:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4650
+//line mysql_sql.y:4992
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10773,10 +11418,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 837:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4658
+//line mysql_sql.y:5000
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -10786,16 +11431,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4673
+//line mysql_sql.y:5015
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 818:
+	case 869:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4709
+//line mysql_sql.y:5051
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10808,10 +11453,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4721
+//line mysql_sql.y:5063
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10824,10 +11469,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 871:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4735
+//line mysql_sql.y:5077
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10836,10 +11481,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4743
+//line mysql_sql.y:5085
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10847,10 +11492,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4750
+//line mysql_sql.y:5092
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10863,10 +11508,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 874:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4762
+//line mysql_sql.y:5104
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10875,10 +11520,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 875:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4770
+//line mysql_sql.y:5112
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10890,10 +11535,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4781
+//line mysql_sql.y:5123
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -10903,10 +11548,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 877:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4790
+//line mysql_sql.y:5132
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -10916,10 +11561,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4799
+//line mysql_sql.y:5141
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -10929,10 +11574,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 879:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4808
+//line mysql_sql.y:5150
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -10941,10 +11586,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 880:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4816
+//line mysql_sql.y:5158
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -10955,10 +11600,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 881:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4826
+//line mysql_sql.y:5168
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -10967,10 +11612,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 882:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4834
+//line mysql_sql.y:5176
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -10979,52 +11624,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 883:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4843
+//line mysql_sql.y:5185
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4847
+//line mysql_sql.y:5189
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4853
+//line mysql_sql.y:5195
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4857
+//line mysql_sql.y:5199
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 893:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4870
+//line mysql_sql.y:5212
 		{
 		}
-	case 843:
+	case 894:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4872
+//line mysql_sql.y:5214
 		{
 		}
-	case 876:
+	case 927:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4912
+//line mysql_sql.y:5254
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
@@ -11034,10 +11679,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 928:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4921
+//line mysql_sql.y:5263
 		{
 			name := tree.SetUnresolvedName("interval")
 			ival := util.GetUint64(yyDollar[2].item)
@@ -11050,314 +11695,314 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 929:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4934
+//line mysql_sql.y:5276
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 930:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4938
+//line mysql_sql.y:5280
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 931:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4942
+//line mysql_sql.y:5284
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 932:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4948
+//line mysql_sql.y:5290
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 933:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4953
+//line mysql_sql.y:5295
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4957
+//line mysql_sql.y:5299
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4963
+//line mysql_sql.y:5305
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4967
+//line mysql_sql.y:5309
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 937:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4974
+//line mysql_sql.y:5316
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 938:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4978
+//line mysql_sql.y:5320
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 939:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4982
+//line mysql_sql.y:5324
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 940:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4986
+//line mysql_sql.y:5328
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4990
+//line mysql_sql.y:5332
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 942:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4996
+//line mysql_sql.y:5338
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 943:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5000
+//line mysql_sql.y:5342
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5004
+//line mysql_sql.y:5346
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5011
+//line mysql_sql.y:5353
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 947:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5015
+//line mysql_sql.y:5357
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 948:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5019
+//line mysql_sql.y:5361
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 949:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5023
+//line mysql_sql.y:5365
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 950:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5027
+//line mysql_sql.y:5369
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 951:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5031
+//line mysql_sql.y:5373
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 952:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5035
+//line mysql_sql.y:5377
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 953:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5039
+//line mysql_sql.y:5381
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 955:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5045
+//line mysql_sql.y:5387
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5049
+//line mysql_sql.y:5391
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5055
+//line mysql_sql.y:5397
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5059
+//line mysql_sql.y:5401
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5066
+//line mysql_sql.y:5408
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5070
+//line mysql_sql.y:5412
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5074
+//line mysql_sql.y:5416
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5078
+//line mysql_sql.y:5420
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5082
+//line mysql_sql.y:5424
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5086
+//line mysql_sql.y:5428
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 965:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5093
+//line mysql_sql.y:5435
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 966:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5097
+//line mysql_sql.y:5439
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5101
+//line mysql_sql.y:5443
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5105
+//line mysql_sql.y:5447
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5111
+//line mysql_sql.y:5453
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -11371,96 +12016,104 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5126
+//line mysql_sql.y:5468
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 971:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5130
+//line mysql_sql.y:5472
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5135
+//line mysql_sql.y:5477
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5140
+//line mysql_sql.y:5482
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5144
+//line mysql_sql.y:5486
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5148
+//line mysql_sql.y:5490
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5152
+//line mysql_sql.y:5494
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 977:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5499
+		{
+			yyLOCAL = yylex.(*Lexer).paramExpr(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 978:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5162
+//line mysql_sql.y:5507
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 982:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5173
+//line mysql_sql.y:5518
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5178
+//line mysql_sql.y:5523
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5184
+//line mysql_sql.y:5529
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11473,10 +12126,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5196
+//line mysql_sql.y:5541
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11489,10 +12142,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5208
+//line mysql_sql.y:5553
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11505,10 +12158,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5220
+//line mysql_sql.y:5565
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11522,10 +12175,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5233
+//line mysql_sql.y:5578
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11539,10 +12192,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5246
+//line mysql_sql.y:5591
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11556,10 +12209,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5259
+//line mysql_sql.y:5604
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11573,10 +12226,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5272
+//line mysql_sql.y:5617
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11590,10 +12243,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5285
+//line mysql_sql.y:5630
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11607,10 +12260,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5298
+//line mysql_sql.y:5643
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11624,10 +12277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5311
+//line mysql_sql.y:5656
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11641,10 +12294,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5324
+//line mysql_sql.y:5669
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11658,10 +12311,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5337
+//line mysql_sql.y:5682
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11675,10 +12328,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5350
+//line mysql_sql.y:5695
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11692,10 +12345,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 998:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5365
+//line mysql_sql.y:5710
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11719,10 +12372,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 999:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5388
+//line mysql_sql.y:5733
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11759,10 +12412,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5425
+//line mysql_sql.y:5770
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11799,10 +12452,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1001:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5473
+//line mysql_sql.y:5818
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11818,10 +12471,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5490
+//line mysql_sql.y:5835
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11834,10 +12487,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1003:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5502
+//line mysql_sql.y:5847
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11853,10 +12506,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5517
+//line mysql_sql.y:5862
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -11877,10 +12530,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1005:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5537
+//line mysql_sql.y:5882
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11896,10 +12549,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1006:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5552
+//line mysql_sql.y:5897
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11914,10 +12567,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5568
+//line mysql_sql.y:5913
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11931,10 +12584,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5581
+//line mysql_sql.y:5926
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11948,10 +12601,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1009:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5594
+//line mysql_sql.y:5939
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11965,10 +12618,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1010:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5607
+//line mysql_sql.y:5952
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11982,10 +12635,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5620
+//line mysql_sql.y:5965
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11998,10 +12651,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5632
+//line mysql_sql.y:5977
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12014,10 +12667,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5644
+//line mysql_sql.y:5989
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12030,10 +12683,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5656
+//line mysql_sql.y:6001
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12046,10 +12699,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1015:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5668
+//line mysql_sql.y:6013
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12062,10 +12715,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5680
+//line mysql_sql.y:6025
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12078,10 +12731,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1017:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5692
+//line mysql_sql.y:6037
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12094,10 +12747,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5704
+//line mysql_sql.y:6049
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12110,10 +12763,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5716
+//line mysql_sql.y:6061
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12126,10 +12779,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1020:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5728
+//line mysql_sql.y:6073
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12143,10 +12796,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1021:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5741
+//line mysql_sql.y:6086
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12160,10 +12813,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5756
+//line mysql_sql.y:6101
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12176,75 +12829,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5779
+//line mysql_sql.y:6124
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1024:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5784
+//line mysql_sql.y:6129
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1025:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5790
+//line mysql_sql.y:6135
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1027:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5797
+//line mysql_sql.y:6142
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1028:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5801
+//line mysql_sql.y:6146
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1029:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5806
+//line mysql_sql.y:6151
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1030:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5810
+//line mysql_sql.y:6155
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1031:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5816
+//line mysql_sql.y:6161
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5822
+//line mysql_sql.y:6167
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12252,10 +12905,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1033:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5829
+//line mysql_sql.y:6174
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12263,10 +12916,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1034:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5836
+//line mysql_sql.y:6181
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12274,10 +12927,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1035:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5845
+//line mysql_sql.y:6190
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12285,10 +12938,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1036:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5852
+//line mysql_sql.y:6197
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12296,10 +12949,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1037:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5859
+//line mysql_sql.y:6204
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12307,52 +12960,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5868
+//line mysql_sql.y:6213
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5872
+//line mysql_sql.y:6217
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5876
+//line mysql_sql.y:6221
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1041:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5882
+//line mysql_sql.y:6227
 		{
 		}
-	case 990:
+	case 1042:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5884
+//line mysql_sql.y:6229
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1046:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5894
+//line mysql_sql.y:6239
 		{
 			yyVAL.str = ""
 		}
-	case 995:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5898
+//line mysql_sql.y:6243
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
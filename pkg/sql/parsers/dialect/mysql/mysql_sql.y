@@ -88,6 +88,11 @@ import (
     createOptions []tree.CreateOption
     indexType tree.IndexType
     indexCategory tree.IndexCategory
+    fullTextSearchType tree.FullTextSearchType
+    jsonTableColumn *tree.JSONTableColumn
+    jsonTableColumns []*tree.JSONTableColumn
+    jsonOnResponse tree.JSONOnResponse
+    jsonOnResponses [2]tree.JSONOnResponse
     keyParts []*tree.KeyPart
     keyPart *tree.KeyPart
     indexOption *tree.IndexOption
@@ -120,6 +125,10 @@ import (
 
     subquery *tree.Subquery
     funcExpr *tree.FuncExpr
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
+    frameUnit tree.FrameUnitType
 
     roles []*tree.Role
     role *tree.Role
@@ -165,13 +174,13 @@ import (
 }
 
 %token LEX_ERROR
-%left <str> UNION
+%left <str> UNION EXCEPT INTERSECT
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
-%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT FULL INNER OUTER CROSS NATURAL USE FORCE
 %left <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
@@ -216,6 +225,7 @@ import (
 
 // Create Table
 %token <str> CREATE ALTER DROP RENAME ANALYZE ADD
+%token <str> BACKUP
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
@@ -259,11 +269,11 @@ import (
 %token <str> LOAD INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES
 
 // Supported SHOW tokens
-%token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
+%token <str> DATABASES TABLES EXTENDED PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
-%token <str> LOCAL EXCEPT
+%token <str> LOCAL
 
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
@@ -285,6 +295,13 @@ import (
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
+// JSON
+%token <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
+%token <str> JSON_TABLE PATH ORDINALITY EMPTY ERROR
+
+// Window
+%token <str> OVER ROWS PRECEDING FOLLOWING UNBOUNDED CURRENT
+
 // Built-in function
 %token <str> ADDDATE BIT_AND BIT_OR BIT_XOR CAST COUNT APPROX_COUNT_DISTINCT
 %token <str> APPROX_PERCENTILE CURDATE CURTIME DATE_ADD DATE_SUB EXTRACT
@@ -314,6 +331,7 @@ import (
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
 %type <statement> analyze_stmt
+%type <statement> backup_stmt
 %type <exportParm> export_data_param_opt
 
 %type <select> select_stmt select_no_parens
@@ -362,6 +380,11 @@ import (
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
+%type <windowSpec> window_spec
+%type <exprs> window_partition_by_opt
+%type <frameClause> window_frame_opt
+%type <frameUnit> window_frame_unit
+%type <frameBound> window_frame_bound
 
 %type <unresolvedName> column_name column_name_unresolved
 %type <strs> enum_values force_quote_opt force_quote_list
@@ -396,6 +419,11 @@ import (
 %type <str> database_or_schema
 %type <indexType> using_opt
 %type <indexCategory> index_prefix
+%type <fullTextSearchType> fulltext_search_opt
+%type <jsonTableColumn> json_table_column
+%type <jsonTableColumns> json_table_column_list
+%type <jsonOnResponse> json_on_response
+%type <jsonOnResponses> json_on_opt
 %type <keyParts> index_column_list index_column_list_opt
 %type <keyPart> index_column
 %type <indexOption> index_option_list index_option
@@ -520,6 +548,7 @@ stmt:
 |   show_stmt
 |   alter_stmt
 |   analyze_stmt
+|   backup_stmt
 |   update_stmt
 |   use_stmt
 |   transaction_stmt
@@ -1460,10 +1489,19 @@ use_stmt:
     }
 
 update_stmt:
-    UPDATE table_reference SET update_list where_expression_opt order_by_opt limit_opt
-    {
+    UPDATE table_references SET update_list where_expression_opt order_by_opt limit_opt
+    {
+        // the table references of multiple table update are a cross join
+        tbl := $2[0]
+        for _, t := range $2[1:] {
+            tbl = &tree.JoinTableExpr{
+                Left: tbl,
+                JoinType: tree.JOIN_TYPE_CROSS,
+                Right: t,
+            }
+        }
         $$ = &tree.Update{
-            Table: $2,
+            Table: tbl,
             Exprs: $4,
             Where: $5,
             OrderBy: $6,
@@ -1588,11 +1626,21 @@ utility_option_arg:
 
 
 analyze_stmt:
-    ANALYZE TABLE table_name '(' column_list ')' 
+    ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
+|   ANALYZE TABLE table_name '(' column_list ')' 
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
 
+backup_stmt:
+    BACKUP TO STRING
+    {
+        $$ = tree.NewBackup($3)
+    }
+
 alter_stmt:
     alter_user_stmt
 // |    alter_ddl_stmt
@@ -1959,9 +2007,23 @@ delete_stmt:
             Limit: $6,
         }
     }
+|   DELETE table_name_list FROM table_references where_expression_opt
+    {
+        $$ = &tree.Delete{
+            Tables: $2,
+            TableRefs: $4,
+            Where: $5,
+        }
+    }
+|   DELETE FROM table_name_list USING table_references where_expression_opt
+    {
+        $$ = &tree.Delete{
+            Tables: $3,
+            TableRefs: $5,
+            Where: $6,
+        }
+    }
 //     DELETE comment_opt ignore_opt FROM table_name partition_clause_opt where_expression_opt order_by_opt limit_opt
-// |   DELETE comment_opt ignore_opt FROM table_name_list USING table_references where_expression_opt
-// |   DELETE comment_opt ignore_opt table_name_list from_or_using table_references where_expression_opt
 // |   DELETE comment_opt ignore_opt delete_table_list from_or_using table_references where_expression_opt
 
 insert_stmt:
@@ -2496,6 +2558,54 @@ union_op:
             Distinct: true,
         }
     }
+|   INTERSECT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   INTERSECT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   INTERSECT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: true,
+        }
+    }
+|   EXCEPT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   EXCEPT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   EXCEPT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: true,
+        }
+    }
 
 simple_select_clause:
     SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
@@ -2687,6 +2797,14 @@ outer_join:
     {
         $$ = tree.JOIN_TYPE_RIGHT
     }
+|   FULL JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+|   FULL OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
 
 on_expression_opt:
     %prec JOIN
@@ -2763,8 +2881,87 @@ table_factor:
             },
         }
     }
+|   JSON_TABLE '(' expression ',' STRING COLUMNS '(' json_table_column_list ')' ')' as_opt ident
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: tree.NewJSONTable($3, $5, $8),
+            As: tree.AliasClause{
+                Alias: tree.Identifier($12),
+            },
+        }
+    }
 // |   '(' table_references ')'
 
+json_table_column_list:
+    json_table_column
+    {
+        $$ = []*tree.JSONTableColumn{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JSONTableColumn{
+            Name: tree.Identifier($1),
+            Type: tree.JSON_TABLE_COLUMN_ORDINALITY,
+        }
+    }
+|   ident column_type PATH STRING json_on_opt
+    {
+        $$ = &tree.JSONTableColumn{
+            Name: tree.Identifier($1),
+            Type: tree.JSON_TABLE_COLUMN_PATH,
+            ColType: $2,
+            Path: $4,
+            OnEmpty: $5[0],
+            OnError: $5[1],
+        }
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JSONTableColumn{
+            Name: tree.Identifier($1),
+            Type: tree.JSON_TABLE_COLUMN_EXISTS,
+            ColType: $2,
+            Path: $5,
+        }
+    }
+
+json_on_opt:
+    {
+        $$ = [2]tree.JSONOnResponse{}
+    }
+|   json_on_response ON EMPTY
+    {
+        $$ = [2]tree.JSONOnResponse{$1, {}}
+    }
+|   json_on_response ON ERROR
+    {
+        $$ = [2]tree.JSONOnResponse{{}, $1}
+    }
+|   json_on_response ON EMPTY json_on_response ON ERROR
+    {
+        $$ = [2]tree.JSONOnResponse{$1, $4}
+    }
+
+json_on_response:
+    NULL
+    {
+        $$ = tree.JSONOnResponse{Type: tree.JSON_ON_RESPONSE_NULL}
+    }
+|   ERROR
+    {
+        $$ = tree.JSONOnResponse{Type: tree.JSON_ON_RESPONSE_ERROR}
+    }
+|   DEFAULT STRING
+    {
+        $$ = tree.JSONOnResponse{Type: tree.JSON_ON_RESPONSE_DEFAULT, Default: $2}
+    }
+
 derived_table:
     '(' select_no_parens ')'
     {
@@ -2789,6 +2986,7 @@ aliased_table_name:
 
 
 as_opt_id:
+    %prec JOIN
     {
         $$ = ""
     }
@@ -3728,7 +3926,7 @@ table_name:
         prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
         $$ = tree.NewTableName(tree.Identifier($1), prefix)
     }
-|   ident '.' ident
+|   ident '.' reserved_sql_id
     {
         prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1), ExplicitSchema: true}
         $$ = tree.NewTableName(tree.Identifier($3), prefix)
@@ -3992,6 +4190,10 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeStorage($2)
     }
+|   COMPRESSION equal_opt STRING
+    {
+        $$ = tree.NewAttributeCompression($3)
+    }
 |   AUTO_RANDOM field_length_opt
     {
         $$ = tree.NewAttributeAutoRandom(int($2))
@@ -4326,6 +4528,62 @@ simple_expr:
     {
         $$ = $1
     }
+|   function_call_generic OVER '(' window_spec ')'
+    {
+        $1.WindowSpec = $4
+        $$ = $1
+    }
+|   function_call_aggregate OVER '(' window_spec ')'
+    {
+        $1.WindowSpec = $4
+        $$ = $1
+    }
+|   MATCH '(' column_name_list ')' AGAINST '(' bit_expr fulltext_search_opt ')'
+    {
+        $$ = tree.NewFullTextMatchExpr($3, $7, $8)
+    }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+        name = tree.SetUnresolvedName("json_unquote")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{extract},
+        }
+    }
+
+fulltext_search_opt:
+    {
+        $$ = tree.FULLTEXT_DEFAULT
+    }
+|   IN NATURAL LANGUAGE MODE
+    {
+        $$ = tree.FULLTEXT_NL
+    }
+|   IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_NL_QUERY_EXPANSION
+    }
+|   IN BOOLEAN MODE
+    {
+        $$ = tree.FULLTEXT_BOOLEAN
+    }
+|   WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_QUERY_EXPANSION
+    }
 
 else_opt:
 	{
@@ -4475,6 +4733,18 @@ cast_type:
 	        },
         }
     }
+|   JSON
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.JsonFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:    uint32(defines.MYSQL_TYPE_JSON),
+	        },
+        }
+    }
 
 integer_opt:
     {}
@@ -4616,6 +4886,78 @@ function_call_aggregate:
         }
     }
 
+window_spec:
+    window_partition_by_opt order_by_opt window_frame_opt
+    {
+        $$ = &tree.WindowSpec{
+            PartitionBy: $1,
+            OrderBy: $2,
+            Frame: $3,
+        }
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+window_frame_opt:
+    {
+        $$ = nil
+    }
+|   window_frame_unit window_frame_bound
+    {
+        $$ = &tree.FrameClause{
+            Unit: $1,
+            Start: $2,
+            End: &tree.FrameBound{Type: tree.FRAME_BOUND_CURRENT_ROW},
+        }
+    }
+|   window_frame_unit BETWEEN window_frame_bound AND window_frame_bound
+    {
+        $$ = &tree.FrameClause{
+            Unit: $1,
+            Start: $3,
+            End: $5,
+        }
+    }
+
+window_frame_unit:
+    ROWS
+    {
+        $$ = tree.FRAME_UNIT_ROWS
+    }
+|   RANGE
+    {
+        $$ = tree.FRAME_UNIT_RANGE
+    }
+
+window_frame_bound:
+    UNBOUNDED PRECEDING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_PRECEDING}
+    }
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_FOLLOWING}
+    }
+|   CURRENT ROW
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_CURRENT_ROW}
+    }
+|   literal PRECEDING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_PRECEDING, Expr: $1}
+    }
+|   literal FOLLOWING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_FOLLOWING, Expr: $1}
+    }
+
 std_dev_pop:
     STD
 |   STDDEV
@@ -5153,9 +5495,12 @@ literal:
 		ival := util.GetUint64($1)
 		$$ = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 	}
+|   VALUE_ARG
+    {
+        $$ = yylex.(*Lexer).paramExpr($1)
+    }
 // |   HEX
 // |   BIT_LITERAL
-// |   VALUE_ARG
 
 column_type:
     numeric_type unsigned_opt zero_fill_opt
@@ -5937,6 +6282,8 @@ reserved_keyword:
 |   CURRENT_USER
 |   CURRENT_TIME
 |   CURRENT_TIMESTAMP
+|   OVER
+|   ROWS
 |   CIPHER
 |   SAN
 |   SSL
@@ -5955,6 +6302,7 @@ reserved_keyword:
 |   ELSE
 |   END
 |   ESCAPE
+|   EXCEPT
 |   EXISTS
 |   EXPLAIN
 |   FALSE
@@ -5973,6 +6321,7 @@ reserved_keyword:
 |   INDEX
 |   INNER
 |   INSERT
+|   INTERSECT
 |   INTERVAL
 |   INTO
 |   IS
@@ -6063,6 +6412,7 @@ non_reserved_keyword:
 |   AUTO_RANDOM
 |   ACTION
 |   ALGORITHM
+|   BACKUP
 |   BEGIN
 |   BIGINT
 |   BIT
@@ -6097,8 +6447,9 @@ non_reserved_keyword:
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
-|   EXCEPT
 |   ERRORS
+|   EMPTY
+|   ERROR
 |   ENFORCED
 |   FLOAT_TYPE
 |   FULL
@@ -6154,6 +6505,12 @@ non_reserved_keyword:
 |   PARTIAL
 |   PARTITION
 |   PARTITIONS
+|   PATH
+|   ORDINALITY
+|   CURRENT
+|   PRECEDING
+|   FOLLOWING
+|   UNBOUNDED
 |   POINT
 |   POLYGON
 |   PROCEDURE
@@ -15,6 +15,8 @@
 package mysql
 
 import (
+	"go/constant"
+	"strconv"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -57,12 +59,40 @@ var (
 		output string
 	}{{
 		input: "select Quarter from ontime limit 1",
+	}, {
+		input:  "select * from t where match(a, t.b) against('hello world')",
+		output: "select * from t where match(a, t.b) against(hello world)",
+	}, {
+		input:  "select a, match(a) against('+hello -world' in boolean mode) as score from t",
+		output: "select a, match(a) against(+hello -world in boolean mode) as score from t",
+	}, {
+		input:  "select * from t where match(a) against('hello' in natural language mode)",
+		output: "select * from t where match(a) against(hello in natural language mode)",
+	}, {
+		input:  "select * from t where match(a) against('hello' with query expansion)",
+		output: "select * from t where match(a) against(hello with query expansion)",
+	}, {
+		input: "create fulltext index idx1 on A (a, b)",
+	}, {
+		input:  "select a->'$.b', a->>'$.c[0]' from t where cast(b as json) is not null",
+		output: "select json_extract(a, $.b), json_unquote(json_extract(a, $.c[0])) from t where cast(b as json) is not null",
+	}, {
+		input:  "select * from json_table('[{\"a\": 1}]', '$[*]' columns (id for ordinality, a int path '$.a' default '0' on empty null on error, b json exists path '$.b')) as jt",
+		output: "select * from json_table([{\"a\": 1}], \"$[*]\" columns(id for ordinality, a int path \"$.a\" default \"0\" on empty null on error, b json exists path \"$.b\")) as jt",
+	}, {
+		input:  "select a, row_number() over (partition by b order by c desc), sum(c) over (order by a rows between 1 preceding and unbounded following) from t",
+		output: "select a, row_number() over (partition by b order by c desc), sum(c) over (order by a rows between 1 preceding and unbounded following) from t",
+	}, {
+		input:  "select lag(a, 2) over (), avg(a) over (order by b range 2.5 preceding) as w from t",
+		output: "select lag(a, 2) over (), avg(a) over (order by b range between 2.5 preceding and current row) as w from t",
 	}, {
 		input: "select month from ontime limit 1",
 	}, {
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input: "with recursive tw(n) as (select a from t2 union all select n + 1 from tw where n < 10) select * from tw",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
@@ -376,6 +406,9 @@ var (
 		input: "create table A (a int storage disk, b int references B(a asc, b desc) match full on delete cascade on update restrict)",
 	}, {
 		input: "create table A (a int storage disk, b int)",
+	}, {
+		input:  "create table A (a int compression 'zstd', b varchar(10) compression = 'snappy')",
+		output: "create table A (a int compression zstd, b varchar(10) compression snappy)",
 	}, {
 		input: "create table A (a int not null default 1 auto_increment unique primary key collate utf8_bin storage disk)",
 	}, {
@@ -605,6 +638,18 @@ var (
 		input: "delete from t where a > 1 order by b limit 1 offset 2",
 	}, {
 		input: "delete from t where a = 1",
+	}, {
+		input: "delete t1, t2 from t1 inner join t2 on t1.a = t2.a where t2.b > 1",
+	}, {
+		input:  "delete from t1 using t1, t2 where t1.a = t2.a",
+		output: "delete t1 from t1, t2 where t1.a = t2.a",
+	}, {
+		input: "update t1 inner join t2 on t1.a = t2.a set t1.b = t2.b where t2.c between 1 and 3",
+	}, {
+		input:  "update t1, t2 set t1.b = t2.b where t1.a = t2.a",
+		output: "update t1 cross join t2 set t1.b = t2.b where t1.a = t2.a",
+	}, {
+		input: "select TABLE_NAME from information_schema.tables where TABLE_SCHEMA = test",
 	}, {
 		input: "insert into u partition(p1, p2) (a, b, c, d) values (1, 2, 3, 4), (5, 6, 1, 0)",
 	}, {
@@ -662,6 +707,16 @@ var (
 		input: "select * from t union all select c from t1",
 	}, {
 		input: "select * from t union distinct select c from t1",
+	}, {
+		input: "select a from t intersect select c from t1",
+	}, {
+		input: "select a from t intersect all select c from t1",
+	}, {
+		input: "select a from t except select c from t1",
+	}, {
+		input: "select a from t except all select c from t1 union select d from t2",
+	}, {
+		input: "(select a from t) except distinct (select c from t1) order by a limit 1",
 	}, {
 		input: "select * from (select a from t) as t1",
 	}, {
@@ -691,6 +746,13 @@ var (
 	}, {
 		input:  "analyze table part (a,b )",
 		output: "analyze table part(a, b)",
+	}, {
+		input: "analyze table db.part",
+	}, {
+		input:  "BACKUP TO '/data/backup.tar.gz'",
+		output: "backup to '/data/backup.tar.gz'",
+	}, {
+		input: "select backup from t",
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test'",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -712,6 +774,10 @@ var (
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+	}, {
+		input: "select a from t where b = ? and c > ?",
+	}, {
+		input: "insert into t values (?, ?, ?)",
 	}}
 )
 
@@ -769,3 +835,24 @@ func TestMulti(t *testing.T) {
 		}
 	}
 }
+
+func TestParseOneWithParams(t *testing.T) {
+	sql := "update t set a = ? where b = ? and c in (?, 1)"
+	ast, params, err := ParseOneWithParams(sql)
+	if err != nil {
+		t.Fatalf("Parse(%q) err: %v", sql, err)
+	}
+	if len(params) != 3 {
+		t.Fatalf("expected 3 placeholders, got %d", len(params))
+	}
+	for i, p := range params {
+		if p.Offset != i+1 {
+			t.Errorf("expected offset %d, got %d", i+1, p.Offset)
+		}
+		p.Expr = tree.NewNumVal(constant.MakeInt64(int64(i)), strconv.Itoa(i), false)
+	}
+	expected := "update t set a = 0 where b = 1 and c in (2, 1)"
+	if out := tree.String(ast, dialect.MYSQL); out != expected {
+		t.Errorf("Binding failed. \nExpected/Got:\n%s\n%s", expected, out)
+	}
+}
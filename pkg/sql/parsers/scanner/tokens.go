@@ -95,6 +95,7 @@ func initTokens(dialectType dialect.DialectType) {
 		SYSDATE = MYSQL_SYSDATE
 		QUARTER = MYSQL_QUARTER
 		REPEAT = MYSQL_REPEAT
+		RECURSIVE = MYSQL_RECURSIVE
 		REVERSE = MYSQL_REVERSE
 		ROW_COUNT = MYSQL_ROW_COUNT
 		WEEK = MYSQL_WEEK
@@ -301,6 +302,7 @@ func initTokens(dialectType dialect.DialectType) {
 		COLLATE = MYSQL_COLLATE
 		BINARY = MYSQL_BINARY
 		UNDERSCORE_BINARY = MYSQL_UNDERSCORE_BINARY
+		INTERSECT = MYSQL_INTERSECT
 		INTERVAL = MYSQL_INTERVAL
 		BEGIN = MYSQL_BEGIN
 		START = MYSQL_START
@@ -354,6 +356,7 @@ func initTokens(dialectType dialect.DialectType) {
 		RENAME = MYSQL_RENAME
 		ANALYZE = MYSQL_ANALYZE
 		ADD = MYSQL_ADD
+		BACKUP = MYSQL_BACKUP
 		SCHEMA = MYSQL_SCHEMA
 		TABLE = MYSQL_TABLE
 		INDEX = MYSQL_INDEX
@@ -433,6 +436,19 @@ func initTokens(dialectType dialect.DialectType) {
 		WITH = MYSQL_WITH
 		QUERY = MYSQL_QUERY
 		EXPANSION = MYSQL_EXPANSION
+		JSON_EXTRACT_OP = MYSQL_JSON_EXTRACT_OP
+		JSON_UNQUOTE_EXTRACT_OP = MYSQL_JSON_UNQUOTE_EXTRACT_OP
+		JSON_TABLE = MYSQL_JSON_TABLE
+		PATH = MYSQL_PATH
+		ORDINALITY = MYSQL_ORDINALITY
+		EMPTY = MYSQL_EMPTY
+		ERROR = MYSQL_ERROR
+		OVER = MYSQL_OVER
+		ROWS = MYSQL_ROWS
+		PRECEDING = MYSQL_PRECEDING
+		FOLLOWING = MYSQL_FOLLOWING
+		UNBOUNDED = MYSQL_UNBOUNDED
+		CURRENT = MYSQL_CURRENT
 		UNUSED = MYSQL_UNUSED
 
 	case dialect.POSTGRESQL:
@@ -464,6 +480,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"avg_row_length":           AVG_ROW_LENGTH,
 		"avg":                      AVG,
 		"bsi":                      BSI,
+		"backup":                   BACKUP,
 		"before":                   UNUSED,
 		"begin":                    BEGIN,
 		"between":                  BETWEEN,
@@ -523,6 +540,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"current_timestamp":        CURRENT_TIMESTAMP,
 		"current_user":             CURRENT_USER,
 		"current_role":             CURRENT_ROLE,
+		"current":                  CURRENT,
 		"curtime":                  CURTIME,
 		"cursor":                   UNUSED,
 		"database":                 DATABASE,
@@ -551,6 +569,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"duplicate":                DUPLICATE,
 		"each":                     UNUSED,
 		"else":                     ELSE,
+		"empty":                    EMPTY,
 		"elseif":                   UNUSED,
 		"enclosed":                 ENCLOSED,
 		"encryption":               ENCRYPTION,
@@ -558,6 +577,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"end":                      END,
 		"enum":                     ENUM,
 		"enforced":                 ENFORCED,
+		"error":                    ERROR,
 		"escape":                   ESCAPE,
 		"escaped":                  ESCAPED,
 		"exists":                   EXISTS,
@@ -576,6 +596,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"float4":                   UNUSED,
 		"float8":                   UNUSED,
 		"for":                      FOR,
+		"following":                FOLLOWING,
 		"force":                    FORCE,
 		"foreign":                  FOREIGN,
 		"format":                   FORMAT,
@@ -616,6 +637,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"int4":                     INT4,
 		"int8":                     INT8,
 		"integer":                  INTEGER,
+		"intersect":                INTERSECT,
 		"interval":                 INTERVAL,
 		"into":                     INTO,
 		"invisible":                INVISIBLE,
@@ -626,6 +648,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"iterate":                  UNUSED,
 		"join":                     JOIN,
 		"json":                     JSON,
+		"json_table":               JSON_TABLE,
 		"key":                      KEY,
 		"keys":                     KEYS,
 		"key_block_size":           KEY_BLOCK_SIZE,
@@ -697,21 +720,25 @@ func initTokens(dialectType dialect.DialectType) {
 		"open":                     OPEN,
 		"or":                       OR,
 		"order":                    ORDER,
+		"ordinality":               ORDINALITY,
 		"out":                      UNUSED,
 		"outer":                    OUTER,
 		"outfile":                  OUTFILE,
+		"over":                     OVER,
 		"header":                   HEADER,
 		"max_file_size":            MAX_FILE_SIZE,
 		"force_quote":              FORCE_QUOTE,
 		"parser":                   PARSER,
 		"partition":                PARTITION,
 		"partitions":               PARTITIONS,
+		"path":                     PATH,
 		"partial":                  PARTIAL,
 		"password":                 PASSWORD,
 		"pack_keys":                PACK_KEYS,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
 		"precision":                UNUSED,
+		"preceding":                PRECEDING,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
 		"procedure":                PROCEDURE,
@@ -733,6 +760,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"reorganize":               REORGANIZE,
 		"repair":                   REPAIR,
 		"repeat":                   REPEAT,
+		"recursive":                RECURSIVE,
 		"repeatable":               REPEATABLE,
 		"replace":                  REPLACE,
 		"replication":              REPLICATION,
@@ -749,6 +777,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"role":                     ROLE,
 		"routine":                  ROUTINE,
 		"row":                      ROW,
+		"rows":                     ROWS,
 		"row_format":               ROW_FORMAT,
 		"row_count":                ROW_COUNT,
 		"rtree":                    RTREE,
@@ -812,6 +841,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"true":                     TRUE,
 		"truncate":                 TRUNCATE,
 		"uncommitted":              UNCOMMITTED,
+		"unbounded":                UNBOUNDED,
 		"undo":                     UNUSED,
 		"union":                    UNION,
 		"unique":                   UNIQUE,
@@ -959,6 +989,7 @@ var (
 	MINUTE                   int
 	QUARTER                  int
 	REPEAT                   int
+	RECURSIVE                int
 	REVERSE                  int
 	ROW_COUNT                int
 	WEEK                     int
@@ -1158,6 +1189,7 @@ var (
 	COLLATE                  int
 	BINARY                   int
 	UNDERSCORE_BINARY        int
+	INTERSECT                int
 	INTERVAL                 int
 	BEGIN                    int
 	START                    int
@@ -1211,6 +1243,7 @@ var (
 	RENAME                   int
 	ANALYZE                  int
 	ADD                      int
+	BACKUP                   int
 	SCHEMA                   int
 	TABLE                    int
 	INDEX                    int
@@ -1290,6 +1323,19 @@ var (
 	WITH                     int
 	QUERY                    int
 	EXPANSION                int
+	JSON_EXTRACT_OP          int
+	JSON_UNQUOTE_EXTRACT_OP  int
+	JSON_TABLE               int
+	PATH                     int
+	ORDINALITY               int
+	EMPTY                    int
+	ERROR                    int
+	OVER                     int
+	ROWS                     int
+	PRECEDING                int
+	FOLLOWING                int
+	UNBOUNDED                int
+	CURRENT                  int
 	CURRENT_USER             int
 	CURRENT_ROLE             int
 	MONTH                    int
@@ -44,3 +44,13 @@ func ParseOne(dialectType dialect.DialectType, sql string) (tree.Statement, erro
 		return nil, errors.New("type of dialect error")
 	}
 }
+
+// ParseOneWithParams parses a single statement with '?' placeholders for the prepared statement.
+func ParseOneWithParams(dialectType dialect.DialectType, sql string) (tree.Statement, []*tree.ParamExpr, error) {
+	switch dialectType {
+	case dialect.MYSQL:
+		return mysql.ParseOneWithParams(sql)
+	default:
+		return nil, nil, errors.New("type of dialect does not support the prepared statement")
+	}
+}
@@ -374,6 +374,24 @@ func NewAttributeStorage(s string) *AttributeStorage {
 	}
 }
 
+// AttributeCompression is the compression algorithm of the column data,
+// it takes precedence over the compression of the table.
+type AttributeCompression struct {
+	columnAttributeImpl
+	Compression string
+}
+
+func (node *AttributeCompression) Format(ctx *FmtCtx) {
+	ctx.WriteString("compression ")
+	ctx.WriteString(node.Compression)
+}
+
+func NewAttributeCompression(c string) *AttributeCompression {
+	return &AttributeCompression{
+		Compression: c,
+	}
+}
+
 type AttributeCheckConstraint struct {
 	columnAttributeImpl
 	Name     string
@@ -17,15 +17,27 @@ package tree
 //Delete statement
 type Delete struct {
 	statementImpl
-	Table   TableExpr
-	Where   *Where
-	OrderBy OrderBy
-	Limit   *Limit
+	Table TableExpr
+	// Tables are the tables whose rows are deleted and TableRefs are the
+	// tables joined to find the rows, both are only set for the multiple
+	// table syntax.
+	Tables    TableNames
+	TableRefs TableExprs
+	Where     *Where
+	OrderBy   OrderBy
+	Limit     *Limit
 }
 
 func (node *Delete) Format(ctx *FmtCtx) {
-	ctx.WriteString("delete from ")
-	node.Table.Format(ctx)
+	if len(node.Tables) > 0 {
+		ctx.WriteString("delete ")
+		node.Tables.Format(ctx)
+		ctx.WriteString(" from ")
+		node.TableRefs.Format(ctx)
+	} else {
+		ctx.WriteString("delete from ")
+		node.Table.Format(ctx)
+	}
 	if node.Where != nil {
 		ctx.WriteByte(' ')
 		node.Where.Format(ctx)
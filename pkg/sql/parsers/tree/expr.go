@@ -468,6 +468,29 @@ func NewParenExpr(e Expr) *ParenExpr {
 	}
 }
 
+//the '?' placeholder of a prepared statement.
+//Expr holds the value bound to the placeholder before the statement is executed.
+type ParamExpr struct {
+	exprImpl
+	//the position of the placeholder, starting from 1
+	Offset int
+	Expr   Expr
+}
+
+func (node *ParamExpr) Format(ctx *FmtCtx) {
+	if node.Expr != nil {
+		node.Expr.Format(ctx)
+		return
+	}
+	ctx.WriteByte('?')
+}
+
+func NewParamExpr(offset int) *ParamExpr {
+	return &ParamExpr{
+		Offset: offset,
+	}
+}
+
 type FuncType int
 
 func (node *FuncType) ToString() string {
@@ -528,6 +551,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//window function with the OVER clause, nil if it is not a window function.
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -540,6 +566,10 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 	}
 	node.Exprs.Format(ctx)
 	ctx.WriteByte(')')
+	if node.WindowSpec != nil {
+		ctx.WriteByte(' ')
+		node.WindowSpec.Format(ctx)
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -831,3 +861,63 @@ func NewVarExpr(n string, s bool, g bool, e Expr) *VarExpr {
 		Expr:   e,
 	}
 }
+
+type FullTextSearchType int
+
+const (
+	FULLTEXT_DEFAULT FullTextSearchType = iota
+	FULLTEXT_NL
+	FULLTEXT_NL_QUERY_EXPANSION
+	FULLTEXT_BOOLEAN
+	FULLTEXT_QUERY_EXPANSION
+)
+
+func (s FullTextSearchType) ToString() string {
+	switch s {
+	case FULLTEXT_DEFAULT:
+		return ""
+	case FULLTEXT_NL:
+		return "in natural language mode"
+	case FULLTEXT_NL_QUERY_EXPANSION:
+		return "in natural language mode with query expansion"
+	case FULLTEXT_BOOLEAN:
+		return "in boolean mode"
+	case FULLTEXT_QUERY_EXPANSION:
+		return "with query expansion"
+	default:
+		return "Unknown FullTextSearchType"
+	}
+}
+
+//the MATCH (col_list) AGAINST (expr [search_modifier]) expression.
+type FullTextMatchExpr struct {
+	exprImpl
+	Columns []*UnresolvedName
+	Against Expr
+	Type    FullTextSearchType
+}
+
+func (node *FullTextMatchExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("match(")
+	for i, col := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteString(") against(")
+	node.Against.Format(ctx)
+	if node.Type != FULLTEXT_DEFAULT {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Type.ToString())
+	}
+	ctx.WriteByte(')')
+}
+
+func NewFullTextMatchExpr(cols []*UnresolvedName, e Expr, t FullTextSearchType) *FullTextMatchExpr {
+	return &FullTextMatchExpr{
+		Columns: cols,
+		Against: e,
+		Type:    t,
+	}
+}
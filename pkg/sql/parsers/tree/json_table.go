@@ -0,0 +1,121 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strconv"
+
+type JSONTableColumnType int
+
+const (
+	JSON_TABLE_COLUMN_PATH JSONTableColumnType = iota
+	JSON_TABLE_COLUMN_EXISTS
+	JSON_TABLE_COLUMN_ORDINALITY
+)
+
+type JSONOnResponseType int
+
+const (
+	JSON_ON_RESPONSE_NONE JSONOnResponseType = iota
+	JSON_ON_RESPONSE_NULL
+	JSON_ON_RESPONSE_ERROR
+	JSON_ON_RESPONSE_DEFAULT
+)
+
+// JSONOnResponse is the ON EMPTY or ON ERROR clause of a column of JSON_TABLE
+type JSONOnResponse struct {
+	Type    JSONOnResponseType
+	Default string
+}
+
+func (node *JSONOnResponse) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case JSON_ON_RESPONSE_NULL:
+		ctx.WriteString("null")
+	case JSON_ON_RESPONSE_ERROR:
+		ctx.WriteString("error")
+	case JSON_ON_RESPONSE_DEFAULT:
+		ctx.WriteString("default ")
+		ctx.WriteString(strconv.Quote(node.Default))
+	}
+}
+
+// JSONTableColumn is a column of JSON_TABLE:
+// name FOR ORDINALITY, name type PATH 'path' [on empty] [on error]
+// or name type EXISTS PATH 'path'.
+type JSONTableColumn struct {
+	NodeFormatter
+	Name    Identifier
+	Type    JSONTableColumnType
+	ColType ResolvableTypeReference
+	Path    string
+	OnEmpty JSONOnResponse
+	OnError JSONOnResponse
+}
+
+func (node *JSONTableColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString(string(node.Name))
+	if node.Type == JSON_TABLE_COLUMN_ORDINALITY {
+		ctx.WriteString(" for ordinality")
+		return
+	}
+	ctx.WriteByte(' ')
+	node.ColType.(*T).InternalType.Format(ctx)
+	if node.Type == JSON_TABLE_COLUMN_EXISTS {
+		ctx.WriteString(" exists")
+	}
+	ctx.WriteString(" path ")
+	ctx.WriteString(strconv.Quote(node.Path))
+	if node.OnEmpty.Type != JSON_ON_RESPONSE_NONE {
+		ctx.WriteByte(' ')
+		node.OnEmpty.Format(ctx)
+		ctx.WriteString(" on empty")
+	}
+	if node.OnError.Type != JSON_ON_RESPONSE_NONE {
+		ctx.WriteByte(' ')
+		node.OnError.Format(ctx)
+		ctx.WriteString(" on error")
+	}
+}
+
+// JSONTable is JSON_TABLE(expr, path COLUMNS (column, ...))
+type JSONTable struct {
+	TableExpr
+	Expr    Expr
+	Path    string
+	Columns []*JSONTableColumn
+}
+
+func (node *JSONTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("json_table(")
+	node.Expr.Format(ctx)
+	ctx.WriteString(", ")
+	ctx.WriteString(strconv.Quote(node.Path))
+	ctx.WriteString(" columns(")
+	for i, col := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteString("))")
+}
+
+func NewJSONTable(e Expr, path string, cols []*JSONTableColumn) *JSONTable {
+	return &JSONTable{
+		Expr:    e,
+		Path:    path,
+		Columns: cols,
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type FrameUnitType int
+
+const (
+	FRAME_UNIT_ROWS FrameUnitType = iota
+	FRAME_UNIT_RANGE
+)
+
+type FrameBoundType int
+
+const (
+	FRAME_BOUND_UNBOUNDED_PRECEDING FrameBoundType = iota
+	FRAME_BOUND_PRECEDING
+	FRAME_BOUND_CURRENT_ROW
+	FRAME_BOUND_FOLLOWING
+	FRAME_BOUND_UNBOUNDED_FOLLOWING
+)
+
+// FrameBound is a bound of the frame of a window function:
+// UNBOUNDED PRECEDING, expr PRECEDING, CURRENT ROW, expr FOLLOWING
+// or UNBOUNDED FOLLOWING.
+type FrameBound struct {
+	NodeFormatter
+	Type FrameBoundType
+	Expr Expr // offset of PRECEDING and FOLLOWING
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case FRAME_BOUND_UNBOUNDED_PRECEDING:
+		ctx.WriteString("unbounded preceding")
+	case FRAME_BOUND_PRECEDING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" preceding")
+	case FRAME_BOUND_CURRENT_ROW:
+		ctx.WriteString("current row")
+	case FRAME_BOUND_FOLLOWING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" following")
+	case FRAME_BOUND_UNBOUNDED_FOLLOWING:
+		ctx.WriteString("unbounded following")
+	}
+}
+
+// FrameClause is the frame of a window function:
+// {ROWS | RANGE} BETWEEN start AND end, the end of
+// {ROWS | RANGE} start is CURRENT ROW.
+type FrameClause struct {
+	NodeFormatter
+	Unit  FrameUnitType
+	Start *FrameBound
+	End   *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	if node.Unit == FRAME_UNIT_RANGE {
+		ctx.WriteString("range between ")
+	} else {
+		ctx.WriteString("rows between ")
+	}
+	node.Start.Format(ctx)
+	ctx.WriteString(" and ")
+	node.End.Format(ctx)
+}
+
+// WindowSpec is the OVER clause of a window function:
+// OVER ([PARTITION BY exprs] [ORDER BY orders] [frame]).
+type WindowSpec struct {
+	NodeFormatter
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	ctx.WriteString("over (")
+	sep := ""
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		sep = " "
+	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(sep)
+		node.OrderBy.Format(ctx)
+		sep = " "
+	}
+	if node.Frame != nil {
+		ctx.WriteString(sep)
+		node.Frame.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
@@ -0,0 +1,300 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildApply left joins the table of the from clause used by the correlated
+// scalar subquery sub with the apply table of it and returns the attribute
+// of the result of the subquery. The subquery is built again with the
+// constants of each value of the outer attributes while it is executed.
+func (d *decorrelator) buildApply(sub *subquery) (tree.Expr, error) {
+	names := sub.outerAttributes(true)
+	tbls := make(map[int]struct{})
+	for _, name := range names {
+		i, _ := sub.isOuter(name)
+		tbls[i] = struct{}{}
+	}
+	if len(tbls) > 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "subquery correlated with several tables is not support now")
+	}
+	i := 0
+	for k := range tbls {
+		i = k
+	}
+	attrs := make([]string, len(names)+1)
+	exprs := make(tree.SelectExprs, len(names))
+	for j, name := range names {
+		attrs[j] = fmt.Sprintf("%s_%d", sub.alias, j)
+		exprs[j] = tree.SelectExpr{Expr: name, As: tree.UnrestrictedIdentifier(attrs[j])}
+	}
+	attrs[len(names)] = fmt.Sprintf("%s_%d", sub.alias, len(names))
+	qry := &Query{}
+	stmt := &tree.Select{
+		Select: &tree.SelectClause{
+			Distinct: true,
+			Exprs:    exprs,
+			From:     &tree.From{Tables: tree.TableExprs{d.tbls[i]}},
+		},
+	}
+	if err := d.b.buildSelectStatement(stmt, qry); err != nil {
+		return nil, err
+	}
+	ts := make([]types.Type, len(attrs))
+	vals := make([]interface{}, len(names))
+	for j, col := range qry.ResultColumns() {
+		ts[j] = col.Type
+		v, err := zeroValue(col.Type)
+		if err != nil {
+			return nil, err
+		}
+		vals[j] = v
+	}
+	p := &Apply{
+		Name:  sub.alias,
+		Attrs: attrs,
+		Outer: buildCast(qry, attrs[:len(names)], ts[:len(names)]),
+	}
+	b, ctes := d.b, d.b.ctes
+	build := func(vals []interface{}) (*Query, error) {
+		saved := b.ctes
+		b.ctes = ctes
+		defer func() {
+			b.ctes = saved
+		}()
+		return sub.buildInner(b, names, vals)
+	}
+	inner, err := build(vals)
+	if err != nil {
+		return nil, err
+	}
+	ts[len(names)] = inner.ResultColumns()[0].Type
+	p.Inner = buildCast(inner, attrs[len(names):], ts[len(names):])
+	p.Build = func(vals []interface{}) (*Scope, error) {
+		qry, err := build(vals)
+		if err != nil {
+			return nil, err
+		}
+		return buildCast(qry, attrs[len(names):], ts[len(names):]), nil
+	}
+	if d.b.applies == nil {
+		d.b.applies = make(map[string]*Apply)
+	}
+	d.b.applies[sub.alias] = p
+	var cond tree.Expr
+	for j, name := range names {
+		e := tree.NewComparisonExpr(tree.EQUAL, name, tree.SetUnresolvedName(attrs[j]))
+		if cond == nil {
+			cond = e
+		} else {
+			cond = tree.NewAndExpr(cond, e)
+		}
+	}
+	d.tbls[i] = &tree.JoinTableExpr{
+		JoinType: joinTypeScalar,
+		Left:     d.tbls[i],
+		Right: &tree.AliasedTableExpr{
+			Expr: tree.NewTableName(tree.Identifier(sub.alias), tree.ObjectNamePrefix{}),
+			As:   tree.AliasClause{Alias: tree.Identifier(sub.alias)},
+		},
+		Cond: tree.NewOnJoinCond(cond),
+	}
+	return tree.SetUnresolvedName(attrs[len(names)]), nil
+}
+
+// buildScalar returns the apply table of the uncorrelated scalar subquery sub
+// and the attribute of its result. The subquery is evaluated once, the table
+// has a row of its result, which is null if the subquery is empty.
+func (d *decorrelator) buildScalar(sub *subquery) (tree.TableExpr, *tree.UnresolvedName, error) {
+	attrs := []string{fmt.Sprintf("%s_0", sub.alias)}
+	inner, err := sub.buildInner(d.b, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	ts := []types.Type{inner.ResultColumns()[0].Type}
+	p := &Apply{
+		Name:  sub.alias,
+		Attrs: attrs,
+		Inner: buildCast(inner, attrs, ts),
+	}
+	if d.b.applies == nil {
+		d.b.applies = make(map[string]*Apply)
+	}
+	d.b.applies[sub.alias] = p
+	tbl := &tree.AliasedTableExpr{
+		Expr: tree.NewTableName(tree.Identifier(sub.alias), tree.ObjectNamePrefix{}),
+		As:   tree.AliasClause{Alias: tree.Identifier(sub.alias)},
+	}
+	return tbl, tree.SetUnresolvedName(attrs[0]), nil
+}
+
+// buildInner builds the subquery with the outer attributes names replaced
+// by the constants of vals.
+func (sub *subquery) buildInner(b *build, names []*tree.UnresolvedName, vals []interface{}) (*Query, error) {
+	var err error
+
+	mp := make(map[string]tree.Expr)
+	for i, name := range names {
+		mp[tree.String(name, dialect.MYSQL)] = buildLiteral(vals[i])
+	}
+	fn := func(n tree.Expr) (tree.Expr, bool, error) {
+		if name, ok := n.(*tree.UnresolvedName); ok {
+			if e, ok := mp[tree.String(name, dialect.MYSQL)]; ok {
+				return e, true, nil
+			}
+			return n, true, nil
+		}
+		return n, false, nil
+	}
+	clause := *sub.clause
+	clause.Exprs = make(tree.SelectExprs, len(sub.clause.Exprs))
+	for i, e := range sub.clause.Exprs {
+		clause.Exprs[i] = e
+		if clause.Exprs[i].Expr, err = rewriteExpr(e.Expr, fn); err != nil {
+			return nil, err
+		}
+	}
+	if sub.clause.Where != nil {
+		e, err := rewriteExpr(sub.clause.Where.Expr, fn)
+		if err != nil {
+			return nil, err
+		}
+		clause.Where = tree.NewWhere(e)
+	}
+	clause.GroupBy = make(tree.GroupBy, len(sub.clause.GroupBy))
+	for i, e := range sub.clause.GroupBy {
+		if clause.GroupBy[i], err = rewriteExpr(e, fn); err != nil {
+			return nil, err
+		}
+	}
+	if sub.clause.Having != nil {
+		e, err := rewriteExpr(sub.clause.Having.Expr, fn)
+		if err != nil {
+			return nil, err
+		}
+		clause.Having = tree.NewWhere(e)
+	}
+	qry := &Query{}
+	if err := b.buildSelectStatement(&tree.Select{Select: &clause}, qry); err != nil {
+		return nil, err
+	}
+	if len(qry.Result) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "Operand should contain 1 column(s)")
+	}
+	return qry, nil
+}
+
+// outerAttributes returns the distinct outer attributes used by the clause
+// of sub, the where clause is skipped if where is false.
+func (sub *subquery) outerAttributes(where bool) []*tree.UnresolvedName {
+	var names []*tree.UnresolvedName
+
+	mp := make(map[string]struct{})
+	fn := func(name *tree.UnresolvedName) {
+		if _, ok := sub.isOuter(name); !ok {
+			return
+		}
+		str := tree.String(name, dialect.MYSQL)
+		if _, ok := mp[str]; !ok {
+			mp[str] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	clause := sub.clause
+	for _, e := range clause.Exprs {
+		walkNames(e.Expr, fn)
+	}
+	if where && clause.Where != nil {
+		walkNames(clause.Where.Expr, fn)
+	}
+	for _, e := range clause.GroupBy {
+		walkNames(e, fn)
+	}
+	if clause.Having != nil {
+		walkNames(clause.Having.Expr, fn)
+	}
+	return names
+}
+
+func newApplyScan(p *Apply) *Scope {
+	s := &Scope{
+		Name: p.Name,
+		Op:   p,
+	}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	for i, attr := range p.Attrs {
+		typ := p.Inner.Result.AttrsMap[attr]
+		if p.Outer != nil && i < len(p.Outer.Result.Attrs) {
+			typ = p.Outer.Result.AttrsMap[attr]
+		}
+		s.Result.Attrs = append(s.Result.Attrs, attr)
+		s.Result.AttrsMap[attr] = &Attribute{
+			Name: attr,
+			Type: typ.Type,
+		}
+	}
+	return s
+}
+
+// zeroValue returns the zero value of an outer attribute of type typ, which
+// is used to build the subquery while building the plan.
+func zeroValue(typ types.Type) (interface{}, error) {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		return int64(0), nil
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return uint64(0), nil
+	case types.T_float32, types.T_float64:
+		return float64(0), nil
+	case types.T_char, types.T_varchar:
+		return "", nil
+	case types.T_date:
+		return types.Date(0).String(), nil
+	case types.T_datetime:
+		return types.Datetime(0).String(), nil
+	}
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("correlated subquery on attribute of type '%s' is not support now", typ))
+}
+
+// buildLiteral returns the constant of the value v of an outer attribute.
+func buildLiteral(v interface{}) tree.Expr {
+	switch v := v.(type) {
+	case int64:
+		if v < 0 {
+			u := uint64(-v)
+			return tree.NewUnaryExpr(tree.UNARY_MINUS, tree.NewNumVal(constant.MakeUint64(u), strconv.FormatUint(u, 10), false))
+		}
+		return tree.NewNumVal(constant.MakeInt64(v), strconv.FormatInt(v, 10), false)
+	case uint64:
+		return tree.NewNumVal(constant.MakeUint64(v), strconv.FormatUint(v, 10), false)
+	case float64:
+		if v < 0 {
+			return tree.NewUnaryExpr(tree.UNARY_MINUS, tree.NewNumVal(constant.MakeFloat64(-v), strconv.FormatFloat(-v, 'g', -1, 64), false))
+		}
+		return tree.NewNumVal(constant.MakeFloat64(v), strconv.FormatFloat(v, 'g', -1, 64), false)
+	}
+	s := v.(string)
+	return tree.NewNumVal(constant.MakeString(s), s, false)
+}
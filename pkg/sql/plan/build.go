@@ -33,6 +33,21 @@ func New(db string, sql string, e engine.Engine) *build {
 }
 
 func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
+	pn, err := b.buildStatement(stmt)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.checkPrivilege(stmt, pn); err != nil {
+		return nil, err
+	}
+	if qry, ok := pn.(*Query); ok {
+		qry.Resolved = b.resolved
+		qry.Params, qry.Folded = b.params, b.folded
+	}
+	return pn, nil
+}
+
+func (b *build) buildStatement(stmt tree.Statement) (Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select:
 		qry := &Query{}
@@ -89,23 +104,17 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 		}
 		return plan, nil
 	case *tree.ShowDatabases:
-		plan := &ShowDatabases{}
-		if err := b.BuildShowDatabases(stmt, plan); err != nil {
-			return nil, err
-		}
-		return plan, nil
+		return b.BuildShowDatabases(stmt)
 	case *tree.ShowTables:
-		plan := &ShowTables{}
-		if err := b.BuildShowTables(stmt, plan); err != nil {
-			return nil, err
-		}
-		return plan, nil
+		return b.BuildShowTables(stmt)
 	case *tree.ShowColumns:
-		plan := &ShowColumns{}
-		if err := b.BuildShowColumns(stmt, plan); err != nil {
-			return nil, err
-		}
-		return plan, nil
+		return b.BuildShowColumns(stmt)
+	case *tree.ShowIndex:
+		return b.BuildShowIndex(stmt)
+	case *tree.ShowVariables:
+		return b.BuildShowVariables(stmt)
+	case *tree.ShowProcessList:
+		return b.BuildShowProcessList(stmt)
 	case *tree.ShowCreateTable:
 		plan := &ShowCreateTable{}
 		if err := b.BuildShowCreateTable(stmt, plan); err != nil {
@@ -130,6 +139,12 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.ExplainAnalyze:
+		plan := &ExplainAnalyze{}
+		if err := b.BuildExplainAnalyze(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	}
 	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
 }
@@ -16,12 +16,20 @@ package plan
 
 import (
 	"fmt"
+	_ "github.com/matrixorigin/matrixone/pkg/builtin/multi"
+	_ "github.com/matrixorigin/matrixone/pkg/builtin/unary"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"go/constant"
 	"log"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 var querys = []string{
@@ -205,3 +213,454 @@ func processQuery(query string, e engine.Engine) {
 	}
 
 }
+
+func TestCreateTableCompression(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string) (*CreateTable, error) {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		pn, err := New("test", sql, e).BuildStatement(stmt)
+		if err != nil {
+			return nil, err
+		}
+		return pn.(*CreateTable), nil
+	}
+
+	pn, err := build("create table t2 (a int, b varchar(10))")
+	require.NoError(t, err)
+	for _, def := range pn.Defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			require.Equal(t, compress.T(compress.Lz4), attr.Attr.Alg)
+		}
+	}
+	pn, err = build("create table t2 (a int, b varchar(10)) compression = 'ZSTD'")
+	require.NoError(t, err)
+	cnt := 0
+	for _, def := range pn.Defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			require.Equal(t, compress.T(compress.Zstd), attr.Attr.Alg)
+			cnt++
+		}
+	}
+	require.Equal(t, 2, cnt)
+	_, err = build("create table t2 (a int) compression = 'zlib'")
+	require.Error(t, err)
+	pn, err = build("create table t2 (a int compression 'none', b varchar(10), c int compression = 'lz4') compression = 'zstd'")
+	require.NoError(t, err)
+	algs := make(map[string]compress.T)
+	for _, def := range pn.Defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			algs[attr.Attr.Name] = attr.Attr.Alg
+		}
+	}
+	require.Equal(t, map[string]compress.T{"a": compress.None, "b": compress.Zstd, "c": compress.Lz4}, algs)
+	_, err = build("create table t2 (a int compression 'zlib')")
+	require.Error(t, err)
+}
+
+func TestCreateTableNullability(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	sql := "create table t2 (a int primary key, b int not null default 1, c int, d int null default 2)"
+	stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+	require.NoError(t, err)
+	pn, err := New("test", sql, e).BuildStatement(stmt)
+	require.NoError(t, err)
+	nulls := make(map[string]bool)
+	for _, def := range pn.(*CreateTable).Defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			nulls[attr.Attr.Name] = attr.Attr.Nullability
+		}
+	}
+	require.Equal(t, map[string]bool{"a": false, "b": false, "c": true, "d": true}, nulls)
+}
+
+func TestFullText(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string) (interface{}, error) {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		return New("test", sql, e).BuildStatement(stmt)
+	}
+
+	pn, err := build("create table t2 (a int, b varchar(10), c char(10), fulltext ft (b, c))")
+	require.NoError(t, err)
+	var def *engine.IndexTableDef
+	for _, d := range pn.(*CreateTable).Defs {
+		if idx, ok := d.(*engine.IndexTableDef); ok {
+			def = idx
+		}
+	}
+	require.Equal(t, &engine.IndexTableDef{Typ: engine.FullTextIndex, Name: "ft", ColNames: []string{"b", "c"}}, def)
+	_, err = build("create table t2 (a int, b varchar(10), fulltext ft (a, b))")
+	require.Error(t, err)
+	_, err = build("create table t2 (a int, b varchar(10), fulltext ft (d))")
+	require.Error(t, err)
+
+	pn, err = build("create fulltext index ft on R (orderId)")
+	require.NoError(t, err)
+	require.Equal(t, engine.FullTextIndex, pn.(*CreateIndex).Defs[0].(*engine.IndexTableDef).Typ)
+	_, err = build("create fulltext index ft on R (uid)")
+	require.Error(t, err)
+
+	// the columns of MATCH must be those of a FULLTEXT index
+	pn, err = build("create table t2 (a int, b varchar(10), c char(10), fulltext ft (b, c))")
+	require.NoError(t, err)
+	require.NoError(t, pn.(*CreateTable).Db.Create(0, "t2", pn.(*CreateTable).Defs))
+	pn, err = build("select a from t2 where match(c, b) against('10' in boolean mode)")
+	require.NoError(t, err)
+	require.False(t, Reusable(pn.(Plan)))
+	_, err = build("select match(b, c) against('10') as score from t2 order by score")
+	require.NoError(t, err)
+	_, err = build("select a from t2 where match(b) against('10')")
+	require.Error(t, err)
+	_, err = build("select orderId from R where match(orderId) against('10')")
+	require.Error(t, err)
+	_, err = build("select orderId from R where match(uid) against('10')")
+	require.Error(t, err)
+	_, err = build("select a from t2 where match(b, c) against(a)")
+	require.Error(t, err)
+}
+
+func TestJSON(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string) (interface{}, error) {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		return New("test", sql, e).BuildStatement(stmt)
+	}
+
+	pn, err := build("create table t2 (a int, b json)")
+	require.NoError(t, err)
+	for _, def := range pn.(*CreateTable).Defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name == "b" {
+			require.Equal(t, types.T_json, attr.Attr.Type.Oid)
+		}
+	}
+	_, err = build("create table t2 (a int, b json default '1')")
+	require.Error(t, err)
+	_, err = build("create table t2 (a int, b json primary key)")
+	require.Error(t, err)
+	_, err = build("create table t2 (a int, b json, index idx (b))")
+	require.Error(t, err)
+
+	_, err = build("select orderId->'$.a', orderId->>'$.a', json_extract(orderId, '$.a', '$[0]') from R")
+	require.NoError(t, err)
+	_, err = build("select json_array(uid, orderId), json_object('k', uid) from R where json_contains(orderId, '1', '$.a')")
+	require.NoError(t, err)
+
+	_, err = build(`select * from json_table('[{"a": 1}, {"a": "x"}]', '$[*]' columns(id for ordinality, a int path '$.a' null on error, b int exists path '$.b')) as jt`)
+	require.NoError(t, err)
+	_, err = build(`select * from json_table('[{"a": "x"}]', '$[*]' columns(a int path '$.a' error on error)) as jt`)
+	require.Error(t, err)
+	_, err = build(`select * from json_table(orderId, '$[*]' columns(a int path '$.a')) as jt`)
+	require.Error(t, err)
+
+	// the document is a column of a table before it in the FROM list
+	_, err = build(`select uid, jt.a from R, json_table(R.orderId, '$[*]' columns(n for ordinality, a int path '$.a')) as jt where jt.a > 1`)
+	require.NoError(t, err)
+	_, err = build(`select jt.uid, count(*) from R, json_table(orderId, '$[*]' columns(uid int path '$.a')) as jt group by jt.uid`)
+	require.NoError(t, err)
+	_, err = build(`select * from R, json_table(uid, '$[*]' columns(a int path '$.a')) as jt`)
+	require.Error(t, err)
+	_, err = build(`select * from R, S, json_table(concat(R.orderId, S.orderId), '$[*]' columns(a int path '$.a')) as jt`)
+	require.Error(t, err)
+}
+
+func TestOuterJoin(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string) (interface{}, error) {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		return New("test", sql, e).BuildStatement(stmt)
+	}
+	// getJoin returns the first join which is not an inner join
+	var getJoin func(s *Scope) *Join
+	getJoin = func(s *Scope) *Join {
+		if op, ok := s.Op.(*Join); ok && op.Type != INNER {
+			return op
+		}
+		for _, chp := range s.Children {
+			if op := getJoin(chp); op != nil {
+				return op
+			}
+		}
+		return nil
+	}
+
+	for sql, typ := range map[string]int{
+		"select * from R left join S on R.uid = S.uid":                                                     LEFT,
+		"select R.uid, count(S.price) from R left outer join S on R.uid = S.uid group by R.uid":            LEFT,
+		"select * from R left join S on R.uid = S.uid and S.price > 10 where R.price > 1":                  LEFT,
+		"select * from R right join S on R.uid = S.uid":                                                    LEFT,
+		"select * from R full join S on R.uid = S.uid":                                                     FULL,
+		"select R.uid, id from R left join S on R.uid = S.uid join T on R.orderId = T.id":                  LEFT,
+		"select * from (select uid, count(price) from S group by uid) as s2 (u, c) left join R on u = uid": LEFT,
+	} {
+		pn, err := build(sql)
+		require.NoError(t, err, sql)
+		op := getJoin(pn.(*Query).Scope)
+		require.NotNil(t, op, sql)
+		require.Equal(t, typ, op.Type, sql)
+		require.Equal(t, 2, len(op.Vars), sql)
+	}
+	{ // the preserved side of a right join is the first child
+		pn, err := build("select S.orderId from R right join S on R.uid = S.uid")
+		require.NoError(t, err)
+		s := pn.(*Query).Scope
+		for _, ok := s.Op.(*Relation); !ok; _, ok = s.Op.(*Relation) {
+			s = s.Children[0]
+		}
+		require.Equal(t, "S", s.Op.(*Relation).Name)
+	}
+	for sql, typ := range map[string]int{ // conditions checked by the join
+		"select * from R left join S on R.uid = S.uid and R.price > 10":  LEFT,
+		"select * from R right join S on R.uid = S.uid and S.price > 10": LEFT,
+		"select * from R full join S on R.uid = S.uid and S.price > 10":  FULL,
+		"select * from R left join S on R.price > S.price":               LEFT,
+	} {
+		pn, err := build(sql)
+		require.NoError(t, err, sql)
+		op := getJoin(pn.(*Query).Scope)
+		require.NotNil(t, op, sql)
+		require.Equal(t, typ, op.Type, sql)
+		require.NotNil(t, op.Cond, sql)
+	}
+	_, err := build("select * from (select uid from S) as s2 (a, b)")
+	require.Error(t, err)
+}
+
+func TestCrossJoin(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string) (*Scope, error) {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		pn, err := New("test", sql, e).BuildStatement(stmt)
+		if err != nil {
+			return nil, err
+		}
+		return pn.(*Query).Scope, nil
+	}
+	// find returns the first scope which satisfies fn
+	var find func(s *Scope, fn func(*Scope) bool) *Scope
+	find = func(s *Scope, fn func(*Scope) bool) *Scope {
+		if fn(s) {
+			return s
+		}
+		for _, chp := range s.Children {
+			if rp := find(chp, fn); rp != nil {
+				return rp
+			}
+		}
+		return nil
+	}
+	isProduct := func(s *Scope) bool {
+		if op, ok := s.Op.(*Join); ok {
+			for i := 1; i < len(op.Vars); i++ {
+				if len(op.Vars[i]) == 0 {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	isFilteredJoin := func(s *Scope) bool {
+		if _, ok := s.Op.(*Restrict); ok {
+			_, ok = s.Children[0].Op.(*Join)
+			return ok
+		}
+		return false
+	}
+
+	for _, sql := range []string{
+		"select * from R, S",
+		"select R.uid, S.uid from R cross join S",
+		"select * from R, S, T where R.uid = S.uid",
+		"select count(*) from R, T",
+	} {
+		s, err := build(sql)
+		require.NoError(t, err, sql)
+		require.NotNil(t, find(s, isProduct), sql)
+	}
+	for _, sql := range []string{
+		"select * from R, S, T where R.uid = S.uid and S.orderId = id and T.price = R.price",
+		"select count(*) from R join S on R.uid = S.uid join T on S.orderId = id and T.price = R.price",
+	} {
+		s, err := build(sql)
+		require.NoError(t, err, sql)
+		require.NotNil(t, find(s, isFilteredJoin), sql)
+		require.Nil(t, find(s, isProduct), sql)
+	}
+	{ // the transitive equalities are implied by the join
+		s, err := build("select * from R, S, T where R.uid = S.uid and S.orderId = id and R.orderId = id and R.orderId = S.orderId")
+		require.NoError(t, err)
+		require.Nil(t, find(s, isFilteredJoin))
+	}
+}
+
+func TestSubquery(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string) (*Scope, error) {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		pn, err := New("test", sql, e).BuildStatement(stmt)
+		if err != nil {
+			return nil, err
+		}
+		return pn.(*Query).Scope, nil
+	}
+	// getJoin returns the first join which is not an inner join
+	var getJoin func(s *Scope) *Join
+	getJoin = func(s *Scope) *Join {
+		if op, ok := s.Op.(*Join); ok && op.Type != INNER {
+			return op
+		}
+		for _, chp := range s.Children {
+			if op := getJoin(chp); op != nil {
+				return op
+			}
+		}
+		return nil
+	}
+
+	for sql, typ := range map[string]int{
+		"select uid from R where uid in (select uid from S)":                                         SEMI,
+		"select uid from R where uid not in (select uid from S where price > 10)":                    ANTI,
+		"select uid from R where exists (select * from S where S.uid = R.uid)":                       SEMI,
+		"select uid from R where not exists (select * from S where S.uid = R.uid)":                   ANTI,
+		"select uid from R where price > (select avg(price) from S)":                                 SEMI,
+		"select uid, (select sum(price) from S where S.uid = R.uid) from R":                          LEFT,
+		"select uid from R where exists (select * from S where S.uid = R.uid and S.price > R.price)": SEMI,
+	} {
+		s, err := build(sql)
+		require.NoError(t, err, sql)
+		op := getJoin(s)
+		require.NotNil(t, op, sql)
+		require.Equal(t, typ, op.Type, sql)
+	}
+	{ // the correlated condition besides the equalities is applied by the join
+		s, err := build("select uid from R where exists (select * from S where S.uid = R.uid and S.price > R.price)")
+		require.NoError(t, err)
+		require.NotNil(t, getJoin(s).Cond)
+	}
+	for _, sql := range []string{
+		"select uid from R where uid in (select uid, price from S)",
+		"select uid, (select count(*) from S where S.uid = R.uid) from R",
+		"select uid from R where uid in (select uid from S order by uid)",
+	} {
+		_, err := build(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestCTE(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string) (*Scope, error) {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		pn, err := New("test", sql, e).BuildStatement(stmt)
+		if err != nil {
+			return nil, err
+		}
+		return pn.(*Query).Scope, nil
+	}
+	var scans func(s *Scope) []*CTEScan
+	scans = func(s *Scope) []*CTEScan {
+		var ops []*CTEScan
+		if op, ok := s.Op.(*CTEScan); ok {
+			ops = append(ops, op)
+		}
+		for _, child := range s.Children {
+			ops = append(ops, scans(child)...)
+		}
+		return ops
+	}
+
+	{ // the cte referenced once is inlined
+		s, err := build("with c as (select uid, price from R) select uid from c where price > 10")
+		require.NoError(t, err)
+		require.Empty(t, scans(s))
+	}
+	{ // the cte referenced twice is materialized once
+		s, err := build("with c as (select uid, price from R) select c1.uid from c c1, c c2 where c1.uid = c2.uid")
+		require.NoError(t, err)
+		ops := scans(s)
+		require.Equal(t, 2, len(ops))
+		require.Same(t, ops[0].CTE, ops[1].CTE)
+		require.Nil(t, ops[0].CTE.Recursive)
+	}
+	{ // the recursive part reads the working table
+		s, err := build("with recursive c(n) as (select uid from R union all select n + 1 from c where n < 10) select n from c")
+		require.NoError(t, err)
+		ops := scans(s)
+		require.Equal(t, 1, len(ops))
+		require.Equal(t, CTEResult, ops[0].Table)
+		cte := ops[0].CTE
+		require.NotNil(t, cte.Recursive)
+		ops = scans(cte.Recursive)
+		require.Equal(t, 1, len(ops))
+		require.Equal(t, CTEWorking, ops[0].Table)
+		require.Same(t, cte, ops[0].CTE)
+		rs, err := cte.Rebuild()
+		require.NoError(t, err)
+		require.NotSame(t, cte.Recursive, rs)
+	}
+	for _, sql := range []string{
+		"with c as (select uid from R), c as (select uid from S) select uid from c",
+		"with c(a, b) as (select uid from R) select a from c",
+		"with recursive c(n) as (select n from c union all select uid from R) select n from c",
+		"with recursive c(n) as (select uid from R intersect select n from c) select n from c",
+		"with recursive c(n) as (select uid from R union all select n, n from c) select n from c",
+	} {
+		_, err := build(sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestParams(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(sql string, values ...tree.Expr) *Query {
+		stmt, params, err := parsers.ParseOneWithParams(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		for _, param := range params {
+			param.Expr = values[param.Offset-1]
+		}
+		pn, err := New("test", sql, e).BuildStatement(stmt)
+		require.NoError(t, err, sql)
+		return pn.(*Query)
+	}
+	num := func(v int64) tree.Expr {
+		return tree.NewNumVal(constant.MakeInt64(v), fmt.Sprintf("%v", v), false)
+	}
+	str := func(v string) tree.Expr {
+		return tree.NewNumVal(constant.MakeString(v), v, false)
+	}
+	neg := func(v int64) tree.Expr {
+		return tree.NewUnaryExpr(tree.UNARY_MINUS, num(v))
+	}
+
+	{ // the parameters compared with the attributes are bound again
+		qry := build("select orderId from R where uid = ? and price > ?", num(1), neg(2))
+		require.True(t, Reusable(qry))
+		require.Equal(t, 2, len(qry.Params))
+		require.Equal(t, types.T_int64, qry.Params[0].V.V.Typ.Oid)
+		require.Equal(t, types.T_int64, qry.Params[1].V.V.Typ.Oid)
+		ok, err := qry.Bind([]tree.Expr{num(3), neg(5)})
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []int64{3}, qry.Params[0].V.V.Col)
+		require.Equal(t, []int64{-5}, qry.Params[1].V.V.Col)
+		// the plan is built for an integer
+		ok, err = qry.Bind([]tree.Expr{str("3"), num(5)})
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.Equal(t, []int64{3}, qry.Params[0].V.V.Col)
+	}
+	for _, sql := range []string{
+		"select orderId from R limit ?",
+		"select orderId from R where ? > 1",
+		"select orderId from R where uid = 1 or ?",
+		"select orderId from R where price > -?",
+	} {
+		require.False(t, Reusable(build(sql, num(1))), sql)
+	}
+}
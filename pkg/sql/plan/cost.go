@@ -0,0 +1,360 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// estimations used if the columns have not been analyzed
+const (
+	defaultEqSelectivity    = 0.1
+	defaultRangeSelectivity = 1.0 / 3
+	defaultSelectivity      = 0.5
+	defaultDistinctValues   = 200
+)
+
+// getStatistics returns the statistics of the analyzed attributes of the relation.
+func (b *build) getStatistics(schema, name string, attrs []string) map[string]*engine.ColumnStatistics {
+	db, err := b.e.Database(schema)
+	if err != nil {
+		return nil
+	}
+	r, err := db.Relation(name)
+	if err != nil {
+		return nil
+	}
+	defer r.Close()
+	var mp map[string]*engine.ColumnStatistics
+	for _, attr := range attrs {
+		if stats := r.Stats(attr); stats != nil {
+			if mp == nil {
+				mp = make(map[string]*engine.ColumnStatistics)
+			}
+			mp[attr] = stats
+		}
+	}
+	return mp
+}
+
+// estimateRows returns the estimated number of rows of s.
+func (s *Scope) estimateRows() float64 {
+	switch op := s.Op.(type) {
+	case *Relation:
+		rows := float64(op.Rows)
+		if rows == 0 { // the engine does not count the rows, use the analyzed number
+			for _, stats := range op.Stats {
+				rows = maxFloat(rows, float64(stats.Rows))
+			}
+		}
+		if op.Cond != nil {
+			rows *= selectivity(op.Cond, s)
+		}
+		return rows
+	case *DerivedRelation:
+		rows := s.Children[0].estimateRows()
+		if op.Cond != nil {
+			rows *= selectivity(op.Cond, s.Children[0])
+		}
+		return rows
+	case *Restrict:
+		return s.Children[0].estimateRows() * selectivity(op.E, s.Children[0])
+	case *Join:
+		if op.Type != INNER && op.Type != NATURAL {
+			break
+		}
+		rows := s.Children[0].estimateRows()
+		for i := 1; i < len(s.Children); i++ {
+			rows *= s.Children[i].estimateRows()
+			if i < len(op.Vars) {
+				for _, v := range op.Vars[i] {
+					attr := strconv.Itoa(v)
+					rows /= maxFloat(distinctValues(s.Children[0], attr), distinctValues(s.Children[i], attr))
+				}
+			}
+		}
+		return rows
+	}
+	var rows float64
+	for _, chp := range s.Children {
+		rows += chp.estimateRows()
+	}
+	return rows
+}
+
+// columnStatistics returns the statistics of the column from which the
+// attribute of s comes, it returns nil if the column has not been analyzed.
+func columnStatistics(s *Scope, attr string) *engine.ColumnStatistics {
+	var name string
+
+	rs := findScopeWithAttribute(attr, &name, s)
+	if rs == nil {
+		return nil
+	}
+	if rel, ok := rs.Op.(*Relation); ok && rel.Stats != nil {
+		return rel.Stats[name]
+	}
+	return nil
+}
+
+// distinctValues returns the estimated number of distinct values of the
+// attribute of s, which is not greater than the number of rows of s.
+func distinctValues(s *Scope, attr string) float64 {
+	ndv := float64(defaultDistinctValues)
+	if stats := columnStatistics(s, attr); stats != nil && stats.NDV > 0 {
+		ndv = float64(stats.NDV)
+	}
+	if rows := s.estimateRows(); rows < ndv {
+		ndv = rows
+	}
+	return maxFloat(ndv, 1)
+}
+
+// selectivity returns the estimated fraction of the rows of s satisfying e.
+func selectivity(e extend.Extend, s *Scope) float64 {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return selectivity(v.E, s)
+	case *extend.UnaryExtend:
+		if v.Op == overload.Not {
+			return 1 - selectivity(v.E, s)
+		}
+	case *extend.BinaryExtend:
+		switch v.Op {
+		case overload.And:
+			return selectivity(v.Left, s) * selectivity(v.Right, s)
+		case overload.Or:
+			l, r := selectivity(v.Left, s), selectivity(v.Right, s)
+			return l + r - l*r
+		case overload.EQ, overload.NE, overload.LT, overload.LE, overload.GT, overload.GE:
+			return comparisonSelectivity(v, s)
+		}
+	}
+	return defaultSelectivity
+}
+
+// comparisonSelectivity estimates the selectivity of the comparison of an
+// attribute with a constant by the histogram of the attribute.
+func comparisonSelectivity(e *extend.BinaryExtend, s *Scope) float64 {
+	op := e.Op
+	attr, ok := e.Left.(*extend.Attribute)
+	val, vok := e.Right.(*extend.ValueExtend)
+	if !ok || !vok {
+		attr, ok = e.Right.(*extend.Attribute)
+		val, vok = e.Left.(*extend.ValueExtend)
+		op = reverseComparison(op)
+	}
+	if !ok || !vok {
+		if op == overload.EQ {
+			return defaultEqSelectivity
+		}
+		return defaultRangeSelectivity
+	}
+	stats := columnStatistics(s, attr.Name)
+	x := constantValue(val.V)
+	switch op {
+	case overload.EQ:
+		return eqSelectivity(stats, x)
+	case overload.NE:
+		return 1 - eqSelectivity(stats, x)
+	case overload.LT:
+		return ltSelectivity(stats, x, false)
+	case overload.LE:
+		return ltSelectivity(stats, x, true)
+	case overload.GT:
+		return 1 - ltSelectivity(stats, x, true)
+	case overload.GE:
+		return 1 - ltSelectivity(stats, x, false)
+	}
+	return defaultSelectivity
+}
+
+func eqSelectivity(stats *engine.ColumnStatistics, x interface{}) float64 {
+	if stats == nil || stats.Rows <= 0 || stats.NDV <= 0 || x == nil {
+		return defaultEqSelectivity
+	}
+	rows := float64(stats.Rows)
+	if r, ok := compareValue(x, stats.Min); ok && r < 0 {
+		return 0
+	}
+	if r, ok := compareValue(x, stats.Max); ok && r > 0 {
+		return 0
+	}
+	for _, bkt := range stats.Histogram {
+		if r, ok := compareValue(x, bkt.Upper); ok && r <= 0 {
+			if bkt.NDV <= 0 {
+				break
+			}
+			return float64(bkt.Count) / float64(bkt.NDV) / rows
+		}
+	}
+	return (rows - float64(stats.NullCount)) / float64(stats.NDV) / rows
+}
+
+// ltSelectivity estimates the fraction of the values less than x, or not
+// greater than x if eq is true, the values in the bucket containing x are
+// considered as uniformly distributed.
+func ltSelectivity(stats *engine.ColumnStatistics, x interface{}, eq bool) float64 {
+	if stats == nil || stats.Rows <= 0 || len(stats.Histogram) == 0 || x == nil {
+		return defaultRangeSelectivity
+	}
+	var cnt float64
+
+	if r, ok := compareValue(x, stats.Min); ok && (r < 0 || (r == 0 && !eq)) {
+		return 0
+	}
+	for _, bkt := range stats.Histogram {
+		r, ok := compareValue(x, bkt.Upper)
+		if !ok {
+			return defaultRangeSelectivity
+		}
+		switch {
+		case r > 0:
+			cnt += float64(bkt.Count)
+			continue
+		case r == 0 && eq:
+			cnt += float64(bkt.Count)
+		default:
+			cnt += float64(bkt.Count) / 2
+		}
+		break
+	}
+	return cnt / float64(stats.Rows)
+}
+
+func reverseComparison(op int) int {
+	switch op {
+	case overload.LT:
+		return overload.GT
+	case overload.LE:
+		return overload.GE
+	case overload.GT:
+		return overload.LT
+	case overload.GE:
+		return overload.LE
+	}
+	return op
+}
+
+// constantValue returns the first value of the constant vector.
+func constantValue(v *vector.Vector) interface{} {
+	if v == nil || nulls.Contains(v.Nsp, 0) {
+		return nil
+	}
+	switch col := v.Col.(type) {
+	case []int8:
+		return col[0]
+	case []int16:
+		return col[0]
+	case []int32:
+		return col[0]
+	case []int64:
+		return col[0]
+	case []uint8:
+		return col[0]
+	case []uint16:
+		return col[0]
+	case []uint32:
+		return col[0]
+	case []uint64:
+		return col[0]
+	case []float32:
+		return col[0]
+	case []float64:
+		return col[0]
+	case []types.Date:
+		return col[0]
+	case []types.Datetime:
+		return col[0]
+	case []types.Timestamp:
+		return col[0]
+	case *types.Bytes:
+		return col.Get(0)
+	}
+	return nil
+}
+
+// compareValue compares two values of the statistics, the numbers are
+// compared as float64 values, it returns false if they are not comparable.
+func compareValue(x, y interface{}) (int, bool) {
+	if xs, ok := x.([]byte); ok {
+		if ys, ok := y.([]byte); ok {
+			return bytes.Compare(xs, ys), true
+		}
+		return 0, false
+	}
+	xf, ok := numericValue(x)
+	if !ok {
+		return 0, false
+	}
+	yf, ok := numericValue(y)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case xf < yf:
+		return -1, true
+	case xf > yf:
+		return 1, true
+	}
+	return 0, true
+}
+
+func numericValue(x interface{}) (float64, bool) {
+	switch v := x.(type) {
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case types.Date:
+		return float64(v), true
+	case types.Datetime:
+		return float64(v), true
+	case types.Timestamp:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func maxFloat(x, y float64) float64 {
+	if x > y {
+		return x
+	}
+	return y
+}
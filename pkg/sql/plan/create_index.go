@@ -28,7 +28,8 @@ var (
 	errIndexTypeNotSupported = errors.New(errno.FeatureNotSupported, "unsupported index type")
 
 	// err for index support
-	errBsiUnsupported = errors.New(errno.FeatureNotSupported, "BSI index not support type char/varchar now")
+	errBsiUnsupported      = errors.New(errno.FeatureNotSupported, "BSI index not support type char/varchar now")
+	errFullTextUnsupported = errors.New(errno.FeatureNotSupported, "FULLTEXT index only support type char/varchar")
 )
 
 func (b *build) BuildCreateIndex(stmt *tree.CreateIndex, plan *CreateIndex) error {
@@ -62,7 +63,9 @@ func (b *build) BuildCreateIndex(stmt *tree.CreateIndex, plan *CreateIndex) erro
 		}
 	}
 
-	if stmt.IndexOption != nil {
+	if stmt.IndexCat == tree.INDEX_CATEGORY_FULLTEXT {
+		engineIndexType = engine.FullTextIndex
+	} else if stmt.IndexOption != nil {
 		switch stmt.IndexOption.IType {
 		case tree.INDEX_TYPE_BSI:
 			engineIndexType = engine.BsiIndex
@@ -105,6 +108,12 @@ func (b *build) BuildCreateIndex(stmt *tree.CreateIndex, plan *CreateIndex) erro
 			return errIndexTypeNotSupported
 		}
 
+	case engine.FullTextIndex:
+		cols, err := fullTextColumns(stmt.KeyParts, mpColName)
+		if err != nil {
+			return err
+		}
+		def.ColNames = cols
 	case engine.Invalid:
 		return errIndexTypeNotSupported
 	}
@@ -143,3 +152,32 @@ func bsiSupport(t types.Type) error {
 	}
 	return nil
 }
+
+// fullTextColumns returns the columns of a FULLTEXT index, mpColName is the
+// map of the relation's column names to their types.
+func fullTextColumns(keys []*tree.KeyPart, mpColName map[string]types.Type) ([]string, error) {
+	cols := make([]string, 0, len(keys))
+	nameMap := make(map[string]struct{})
+	for _, key := range keys {
+		if key.ColName == nil || key.Expr != nil {
+			return nil, errIndexTypeNotSupported
+		}
+		if key.ColName.NumParts != 1 {
+			return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("not supported '%s'", key.ColName.Parts))
+		}
+		col := key.ColName.Parts[0]
+		colType, ok := mpColName[col]
+		if !ok {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s'", col))
+		}
+		if colType.Oid != types.T_char && colType.Oid != types.T_varchar {
+			return nil, errFullTextUnsupported
+		}
+		if _, ok := nameMap[col]; ok {
+			return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Duplicate column name '%s'", col))
+		}
+		nameMap[col] = struct{}{}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"go/constant"
 	"math"
+	"strings"
 )
 
 // BuildCreateTable do semantic analyze and get table definition from tree.CreateTable to make create table plan.
@@ -63,8 +64,20 @@ func (b *build) BuildCreateTable(stmt *tree.CreateTable, plan *CreateTable) erro
 		}
 		defs = append(defs, def)
 	}
+	if err := checkFullTextIndex(defs); err != nil {
+		return err
+	}
+	if err := checkJsonKeys(defs, pkNames); err != nil {
+		return err
+	}
 
 	for _, option := range stmt.Options {
+		if n, ok := option.(*tree.TableOptionCompression); ok {
+			if err := setCompression(defs, stmt.Defs, n.Compression); err != nil {
+				return err
+			}
+			continue
+		}
 		def, _ := b.getOptionDef(option)
 		defs = append(defs, def)
 	}
@@ -83,6 +96,95 @@ func (b *build) BuildCreateTable(stmt *tree.CreateTable, plan *CreateTable) erro
 	return nil
 }
 
+// checkFullTextIndex checks that the columns of the FULLTEXT indexes are
+// char or varchar columns of the table.
+func checkFullTextIndex(defs []engine.TableDef) error {
+	mpColName := make(map[string]types.Type)
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			mpColName[attr.Attr.Name] = attr.Attr.Type
+		}
+	}
+	for _, def := range defs {
+		if idx, ok := def.(*engine.IndexTableDef); ok && idx.Typ == engine.FullTextIndex {
+			for _, col := range idx.ColNames {
+				typ, ok := mpColName[col]
+				if !ok {
+					return errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s'", col))
+				}
+				if typ.Oid != types.T_char && typ.Oid != types.T_varchar {
+					return errFullTextUnsupported
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkJsonKeys checks that no json column is a part of the primary key or
+// an index.
+func checkJsonKeys(defs []engine.TableDef, pkNames []string) error {
+	jsonCols := make(map[string]struct{})
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Type.Oid == types.T_json {
+			jsonCols[attr.Attr.Name] = struct{}{}
+		}
+	}
+	if len(jsonCols) == 0 {
+		return nil
+	}
+	names := pkNames
+	for _, def := range defs {
+		switch d := def.(type) {
+		case *engine.PrimaryIndexDef:
+			names = append(names, d.Names...)
+		case *engine.IndexTableDef:
+			names = append(names, d.ColNames...)
+		}
+	}
+	for _, name := range names {
+		if _, ok := jsonCols[name]; ok {
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("JSON column '%s' cannot be used in key specification", name))
+		}
+	}
+	return nil
+}
+
+// setCompression sets the compression algorithm of the columns which have
+// no compression algorithm of their own.
+func setCompression(defs []engine.TableDef, tblDefs tree.TableDefs, name string) error {
+	alg, err := getCompression(name)
+	if err != nil {
+		return err
+	}
+	own := make(map[string]struct{})
+	for _, def := range tblDefs {
+		if col, ok := def.(*tree.ColumnTableDef); ok {
+			for _, attr := range col.Attributes {
+				if _, ok := attr.(*tree.AttributeCompression); ok {
+					own[col.Name.Parts[0]] = struct{}{}
+				}
+			}
+		}
+	}
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			if _, ok := own[attr.Attr.Name]; !ok {
+				attr.Attr.Alg = alg
+			}
+		}
+	}
+	return nil
+}
+
+func getCompression(name string) (compress.T, error) {
+	alg, ok := compress.Algorithms[strings.ToLower(name)]
+	if !ok {
+		return 0, errors.New(errno.InvalidOptionValue, fmt.Sprintf("Invalid compression algorithm '%s'", name))
+	}
+	return compress.T(alg), nil
+}
+
 func (b *build) getOptionDef(option tree.TableOption) (engine.TableDef, error) {
 	switch n := option.(type) {
 	case *tree.TableOptionProperties:
@@ -124,18 +226,25 @@ func (b *build) getTableDef(def tree.TableDef) (engine.TableDef, []string, error
 			return nil, nil, err
 		}
 
+		alg := compress.T(compress.Lz4)
 		for _, attr := range n.Attributes {
-			if _, ok := attr.(*tree.AttributePrimaryKey); ok {
+			switch a := attr.(type) {
+			case *tree.AttributePrimaryKey:
 				primaryKeys = append(primaryKeys, n.Name.Parts[0])
+			case *tree.AttributeCompression:
+				if alg, err = getCompression(a.Compression); err != nil {
+					return nil, nil, err
+				}
 			}
 		}
 
 		return &engine.AttributeDef{
 			Attr: engine.Attribute{
-				Name:    n.Name.Parts[0],
-				Alg:     compress.Lz4,
-				Type:    *typ,
-				Default: defaultExpr,
+				Name:        n.Name.Parts[0],
+				Alg:         alg,
+				Type:        *typ,
+				Default:     defaultExpr,
+				Nullability: isNullable(n) && len(primaryKeys) == 0,
 			},
 		}, primaryKeys, nil
 	case *tree.PrimaryKeyIndex:
@@ -181,6 +290,24 @@ func (b *build) getTableDef(def tree.TableDef) (engine.TableDef, []string, error
 			Typ:      keyType,
 			ColNames: colNames,
 		}, primaryKeys, nil
+	case *tree.FullTextIndex:
+		nameMap := map[string]struct{}{}
+		colNames := make([]string, len(n.KeyParts))
+		for i, key := range n.KeyParts {
+			name := key.ColName.Parts[0] // name of index column
+
+			if _, ok := nameMap[name]; ok {
+				return nil, nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Duplicate column name '%s'", name))
+			}
+			colNames[i] = name
+			nameMap[name] = struct{}{}
+		}
+
+		return &engine.IndexTableDef{
+			Name:     n.Name,
+			Typ:      engine.FullTextIndex,
+			ColNames: colNames,
+		}, primaryKeys, nil
 	default:
 		return nil, nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table def: '%v'", def))
 	}
@@ -227,6 +354,8 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 			return &types.Type{Oid: types.T_date, Size: 4}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			return &types.Type{Oid: types.T_datetime, Size: 8}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &types.Type{Oid: types.T_json, Size: 24}, nil
 		case defines.MYSQL_TYPE_TIMESTAMP:
 			return &types.Type{Oid: types.T_timestamp, Size: 8, Precision: n.InternalType.Precision}, nil
 		case defines.MYSQL_TYPE_DECIMAL:
@@ -248,16 +377,7 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 // 		create table testTb1 (first int default 15.6) ==> create table testTb1 (first int default 16)
 //		create table testTb2 (first int default 'abc') ==> error(Invalid default value for 'first')
 func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (engine.DefaultExpr, error) {
-	allowNull := true // be false when column has not null constraint
-
-	{
-		for _, attr := range column.Attributes {
-			if nullAttr, ok := attr.(*tree.AttributeNull); ok && nullAttr.Is == false {
-				allowNull = false
-				break
-			}
-		}
-	}
+	allowNull := isNullable(column) // be false when column has not null constraint
 
 	for _, attr := range column.Attributes {
 		if d, ok := attr.(*tree.AttributeDefault); ok {
@@ -269,6 +389,10 @@ func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (
 				return engine.MakeDefaultExpr(true, nil, true), nil
 			}
 
+			if typ.Oid == types.T_json {
+				return engine.EmptyDefaultExpr, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("JSON column '%s' can't have a default value", column.Name.Parts[0]))
+			}
+
 			// check value and its type, only support constant value for default expression now.
 			var value interface{}
 			var err error
@@ -289,6 +413,16 @@ func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (
 	return engine.EmptyDefaultExpr, nil
 }
 
+// isNullable returns false if the column has the not null constraint
+func isNullable(column *tree.ColumnTableDef) bool {
+	for _, attr := range column.Attributes {
+		if nullAttr, ok := attr.(*tree.AttributeNull); ok && !nullAttr.Is {
+			return false
+		}
+	}
+	return true
+}
+
 // rangeCheck do range check for value, and do type conversion.
 func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber int) (interface{}, error) {
 	errString := "Out of range value for column '%s' at row %d"
@@ -15,43 +15,65 @@
 package plan
 
 import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
-	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
 )
 
-func (b *build) buildDeletePlan(deleteStmt *tree.Delete, plan *Delete) error {
-	selectStmt := buildSelectStmtFromDelete(deleteStmt)
-	//selectStmt = rewrite.Rewrite(selectStmt)
-	selectStmt = rewrite.AstRewrite(selectStmt)
+// buildDeletePlan builds the query whose result are the rows deleted,
+// the result of the query holds all the columns of the deleted tables.
+func (b *build) buildDeletePlan(stmt *tree.Delete, plan *Delete) error {
+	var exprs tree.SelectExprs
 
 	b.isModify = true
-	queryPlan, err := b.BuildStatement(selectStmt)
-	if err != nil {
-		return err
+	tbls, targets := stmt.TableRefs, []string(nil)
+	if len(stmt.Tables) == 0 {
+		tbls = tree.TableExprs{stmt.Table}
 	}
-	plan.Qry, _ = queryPlan.(*Query)
-	return nil
-}
-
-func buildStarProjection() tree.SelectExprs {
-	expr := tree.SelectExpr{
-		Expr: tree.UnqualifiedStar{},
+	refs := b.getTableRefs(tbls)
+	mp := make(map[*tableRef]*ModifiedTable)
+	if len(stmt.Tables) == 0 {
+		if len(refs) != 1 {
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, "The target table of the DELETE is not updatable")
+		}
+		targets = append(targets, refs[0].qualifier)
 	}
-	return tree.SelectExprs{expr}
-}
-
-func buildSelectStmtFromDelete(d *tree.Delete) tree.Statement {
-	if len(d.OrderBy) > 0 && (d.Where == nil && d.Limit == nil) {
-		d.OrderBy = nil
+	for _, tbl := range stmt.Tables {
+		targets = append(targets, string(tbl.ObjectName))
+	}
+	for i, target := range targets {
+		for _, name := range targets[:i] {
+			if name == target {
+				return errors.New(errno.DuplicateTable, fmt.Sprintf("Not unique table/alias: '%s'", target))
+			}
+		}
+		ref, err := findTableRef(target, refs)
+		if err != nil {
+			return err
+		}
+		tbl, es, err := b.buildModifiedTable(ref, exprs)
+		if err != nil {
+			return err
+		}
+		exprs = es
+		tbl.Dedup = len(refs) > 1
+		plan.Tables = append(plan.Tables, tbl)
+		mp[ref] = tbl
+	}
+	orderBy := stmt.OrderBy
+	if len(orderBy) > 0 && (stmt.Where == nil && stmt.Limit == nil) {
+		orderBy = nil
 	}
-	s := &tree.SelectClause{
-		Exprs: buildStarProjection(),
-		From:  &tree.From{Tables: tree.TableExprs{d.Table}},
-		Where: d.Where,
+	qry, err := b.buildModifyQuery(exprs, tbls, stmt.Where, orderBy, stmt.Limit)
+	if err != nil {
+		return err
 	}
-	return &tree.Select{
-		Select:  s,
-		OrderBy: d.OrderBy,
-		Limit:   d.Limit,
+	plan.Qry = qry
+	es := readExprs(tbls, stmt.Where, stmt.OrderBy)
+	for ref, tbl := range mp {
+		tbl.ReadAttrs = b.readAttrs(ref, refs, es)
 	}
+	return nil
 }
@@ -18,6 +18,9 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -26,6 +29,20 @@ func (b *build) BuildExplainQuery(stmt *tree.ExplainStmt, plan *ExplainQuery) er
 	// return nil
 }
 
+// BuildExplainAnalyze builds the plan of the query which is executed to
+// collect its runtime statistics.
+func (b *build) BuildExplainAnalyze(stmt *tree.ExplainAnalyze, plan *ExplainAnalyze) error {
+	pn, err := b.BuildStatement(stmt.Statement)
+	if err != nil {
+		return err
+	}
+	if _, ok := pn.(*Query); !ok {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("explain analyze '%v' is not support now", tree.String(stmt.Statement, dialect.MYSQL)))
+	}
+	plan.Plan = pn
+	return nil
+}
+
 func BuildExplainResultColumns() []*Attribute {
 	return []*Attribute{
 		{
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -195,6 +196,9 @@ func (b *build) buildCast(e *tree.CastExpr, qry *Query, fn func(tree.Expr, *Quer
 	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
 		typ.Size = 24
 		typ.Oid = types.T_varchar
+	case defines.MYSQL_TYPE_JSON:
+		typ.Size = 24
+		typ.Oid = types.T_json
 	default:
 		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("'%v' is not support now", e))
 	}
@@ -225,6 +229,9 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 	if !ok {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 	}
+	if e.WindowSpec != nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' is only supported as a select expression", tree.String(e, dialect.MYSQL)))
+	}
 	funcName := strings.ToLower(name.Parts[0])
 	if !flg {
 		if _, ok := transformer.TransformerNamesMap[funcName]; ok {
@@ -264,6 +271,54 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 	return buildFunctionExtend(&extend.FuncExtend{Name: funcName, Args: args})
 }
 
+// buildMatch builds MATCH (col, ...) AGAINST (search string [modifier]) as
+// the function match_against(col, ..., search string, mode, search), the
+// search string is resolved against the whole table of the columns.
+func (b *build) buildMatch(e *tree.FullTextMatchExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	args := make([]extend.Extend, 0, len(e.Columns)+2)
+	for _, col := range e.Columns {
+		arg, err := b.buildAttribute(col, qry)
+		if err != nil {
+			return nil, err
+		}
+		if typ := arg.ReturnType(); typ != types.T_char && typ != types.T_varchar {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("Incorrect arguments to MATCH, '%s' is not a char or varchar column", tree.String(col, dialect.MYSQL)))
+		}
+		args = append(args, arg)
+	}
+	against, err := fn(e.Against, qry)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := against.(*extend.ValueExtend); !ok || v.V.Typ.Oid != types.T_varchar {
+		return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("Incorrect arguments to AGAINST, '%s' is not a string constant", tree.String(e.Against, dialect.MYSQL)))
+	}
+	if b.isParam(against) { // the query is parsed when the plan is built
+		b.folded = true
+	}
+	mode := fulltext.NaturalLanguage
+	switch e.Type {
+	case tree.FULLTEXT_BOOLEAN:
+		mode = fulltext.Boolean
+	case tree.FULLTEXT_QUERY_EXPANSION, tree.FULLTEXT_NL_QUERY_EXPANSION:
+		mode = fulltext.QueryExpansion
+	}
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Ref = 1
+	vec.Col = []int64{int64(mode)}
+	q := fulltext.ParseQuery(string(against.(*extend.ValueExtend).V.Col.(*types.Bytes).Get(0)), mode)
+	search, err := b.resolveMatch(e.Columns, q, qry)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := buildValue(constant.MakeString(string(search.Marshal())), "")
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, against, &extend.ValueExtend{V: vec}, resolved)
+	return buildFunctionExtend(&extend.FuncExtend{Name: "match_against", Args: args})
+}
+
 func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	switch e.Op {
 	case tree.PLUS:
@@ -402,6 +457,16 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.Like, Left: left, Right: right}, nil
+	case tree.NOT_LIKE:
+		left, err := fn(e.Left, qry)
+		if err != nil {
+			return nil, err
+		}
+		right, err := fn(e.Right, qry)
+		if err != nil {
+			return nil, err
+		}
+		return &extend.BinaryExtend{Op: overload.NotLike, Left: left, Right: right}, nil
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 }
@@ -438,6 +503,15 @@ func (b *build) buildAttribute(e *tree.UnresolvedName, qry *Query) (extend.Exten
 					}, nil
 				}
 			}
+			// the column is not ambiguous and keeps its own name
+			if qry.Scope.isTableAttribute(e.Parts[1], e.Parts[0]) {
+				if attr, ok := qry.Scope.Result.AttrsMap[e.Parts[0]]; ok {
+					return &extend.Attribute{
+						Name: e.Parts[0],
+						Type: attr.Type.Oid,
+					}, nil
+				}
+			}
 		} else {
 			for _, attr := range qry.Scope.Result.Attrs {
 				if attr == e.Parts[0] {
@@ -502,6 +576,8 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 	switch e := n.(type) {
 	case *tree.ParenExpr:
 		return buildConstant(typ, e.Expr)
+	case *tree.ParamExpr:
+		return buildConstant(typ, e.Expr)
 	case *tree.NumVal:
 		return buildConstantValue(typ, e)
 	case *tree.UnaryExpr:
@@ -808,7 +884,7 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 		}
 		if !num.Negative() {
 			switch typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json:
 				return constant.StringVal(val), nil
 			case types.T_date:
 				return types.ParseDate(constant.StringVal(val))
@@ -99,6 +99,9 @@ func (b *build) buildFetchExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 		return buildValue(e.Value, n.String())
 	case *tree.ParenExpr:
 		return b.buildFetchExpr(e.Expr, qry)
+	case *tree.ParamExpr:
+		b.folded = true
+		return b.buildFetchExpr(e.Expr, qry)
 	case *tree.OrExpr:
 		return b.buildOr(e, qry, b.buildFetchExpr)
 	case *tree.NotExpr:
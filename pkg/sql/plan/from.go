@@ -33,11 +33,20 @@ func (b *build) buildFrom(tbls tree.TableExprs, qry *Query) error {
 	ss := newScopeSet()
 	ss.JoinType = CROSS
 	for _, tbl := range tbls {
+		if jt, alias, cols := lateralJSONTable(tbl); jt != nil && len(ss.Scopes) > 0 {
+			if err := b.buildLateralJSONTable(jt, alias, cols, ss, qry); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := b.buildTableReference(tbl, qry); err != nil {
 			return err
 		}
 		ss.Scopes = append(ss.Scopes, qry.Pop().Scopes...)
 	}
+	if len(ss.Scopes) == 1 { // the tables are all in a json table
+		ss.JoinType = RELATION
+	}
 	s, err := b.buildJoinedScope(ss)
 	if err != nil {
 		return err
@@ -49,6 +58,21 @@ func (b *build) buildFrom(tbls tree.TableExprs, qry *Query) error {
 	return nil
 }
 
+// lateralJSONTable returns the JSON_TABLE of the table expression and its
+// alias if its document is not a constant.
+func lateralJSONTable(tbl tree.TableExpr) (*tree.JSONTable, string, tree.IdentifierList) {
+	var alias string
+	var cols tree.IdentifierList
+
+	if t, ok := tbl.(*tree.AliasedTableExpr); ok {
+		tbl, alias, cols = t.Expr, string(t.As.Alias), t.As.Cols
+	}
+	if jt, ok := tbl.(*tree.JSONTable); ok && !isJSONTableConstant(jt.Expr) {
+		return jt, alias, cols
+	}
+	return nil, "", nil
+}
+
 func (b *build) buildTableReference(tbl tree.TableExpr, qry *Query) error {
 	switch tbl := tbl.(type) {
 	case *tree.Select:
@@ -60,7 +84,9 @@ func (b *build) buildTableReference(tbl tree.TableExpr, qry *Query) error {
 	case *tree.ParenTableExpr:
 		return b.buildTableReference(tbl.Expr, qry)
 	case *tree.AliasedTableExpr:
-		return b.buildAliasedTable(tbl.Expr, string(tbl.As.Alias), qry)
+		return b.buildAliasedTable(tbl.Expr, string(tbl.As.Alias), tbl.As.Cols, qry)
+	case *tree.JSONTable:
+		return b.buildJSONTable(tbl, qry)
 	}
 	return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("Unknown table expr: %T", tbl))
 }
@@ -76,7 +102,9 @@ func (b *build) buildTableReferenceInJoinedTable(tbl tree.TableExpr, qry *Query)
 	case *tree.ParenTableExpr:
 		return b.buildTableReference(tbl.Expr, qry)
 	case *tree.AliasedTableExpr:
-		return b.buildAliasedTable(tbl.Expr, string(tbl.As.Alias), qry)
+		return b.buildAliasedTable(tbl.Expr, string(tbl.As.Alias), tbl.As.Cols, qry)
+	case *tree.JSONTable:
+		return b.buildJSONTable(tbl, qry)
 	}
 	return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("Unknown table expr: %T", tbl))
 }
@@ -117,6 +145,15 @@ func (b *build) buildSubQuery(stmt tree.SelectStatement, qry *Query) error {
 }
 
 func (b *build) buildTable(tbl *tree.TableName, qry *Query) error {
+	if len(tbl.SchemaName) == 0 {
+		if c := b.getCTE(string(tbl.ObjectName)); c != nil {
+			return b.buildCTE(c, qry)
+		}
+		if p, ok := b.applies[string(tbl.ObjectName)]; ok {
+			pushDerivedScan(p.Name, newApplyScan(p), qry)
+			return nil
+		}
+	}
 	s := new(Scope)
 	rel := new(Relation)
 	rel.Name = string(tbl.ObjectName)
@@ -140,6 +177,7 @@ func (b *build) buildTable(tbl *tree.TableName, qry *Query) error {
 	}
 	rel.Rows = rows
 	rel.Attrs = attrsMap
+	rel.Stats = b.getStatistics(rel.Schema, rel.Name, attrs)
 	{ // construct projection
 		for _, attr := range attrs {
 			rel.Proj.Rs = append(rel.Proj.Rs, 0)
@@ -160,7 +198,7 @@ func (b *build) buildTable(tbl *tree.TableName, qry *Query) error {
 	return nil
 }
 
-func (b *build) buildAliasedTable(tbl tree.TableExpr, alias string, qry *Query) error {
+func (b *build) buildAliasedTable(tbl tree.TableExpr, alias string, cols tree.IdentifierList, qry *Query) error {
 	if qry.Flg {
 		switch tbl.(type) {
 		case *tree.TableName:
@@ -171,7 +209,7 @@ func (b *build) buildAliasedTable(tbl tree.TableExpr, alias string, qry *Query)
 				qry.Push(ss)
 				return nil
 			}
-		case *tree.ParenTableExpr:
+		case *tree.ParenTableExpr, *tree.JSONTable:
 			ss := newScopeSet()
 			ss.JoinType = RELATION
 			ss.Scopes = append(ss.Scopes, qry.RenameRels[alias])
@@ -188,10 +226,8 @@ func (b *build) buildAliasedTable(tbl tree.TableExpr, alias string, qry *Query)
 	}
 	ss := qry.Top()
 	child := ss.Scopes[len(ss.Scopes)-1]
-	if _, ok := child.Op.(*DerivedRelation); ok {
-		child.Name = alias
-		ss.Scopes[len(ss.Scopes)-1] = child
-		return nil
+	if rel, ok := child.Op.(*DerivedRelation); ok {
+		return renameDerivedRelation(child, rel, alias, cols)
 	}
 	s := &Scope{
 		Name:     alias,
@@ -225,6 +261,32 @@ func (b *build) buildAliasedTable(tbl tree.TableExpr, alias string, qry *Query)
 	return nil
 }
 
+// renameDerivedRelation renames the derived relation and its columns.
+func renameDerivedRelation(s *Scope, rel *DerivedRelation, alias string, cols tree.IdentifierList) error {
+	s.Name = alias
+	if len(cols) == 0 {
+		return nil
+	}
+	if len(cols) != len(s.Result.Attrs) {
+		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("derived table '%s' has %v columns but %v column names", alias, len(s.Result.Attrs), len(cols)))
+	}
+	attrsMap := make(map[string]*Attribute)
+	for i, attr := range s.Result.Attrs {
+		name := string(cols[i])
+		if _, ok := attrsMap[name]; ok {
+			return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", name))
+		}
+		attrsMap[name] = &Attribute{
+			Name: name,
+			Type: s.Result.AttrsMap[attr].Type,
+		}
+		s.Result.Attrs[i] = name
+		rel.Proj.As[i] = name
+	}
+	s.Result.AttrsMap = attrsMap
+	return nil
+}
+
 func (b *build) buildJoinedTable(stmt *tree.JoinTableExpr, qry *Query) error {
 	if err := b.buildJoinedTree(stmt, qry); err != nil {
 		return err
@@ -245,6 +307,13 @@ func (b *build) buildJoinedTable(stmt *tree.JoinTableExpr, qry *Query) error {
 func (b *build) buildJoinedTree(stmt *tree.JoinTableExpr, qry *Query) error {
 	var jt int // join type
 
+	if s, ok := qry.Joins[stmt]; ok {
+		ss := newScopeSet()
+		ss.JoinType = RELATION
+		ss.Scopes = append(ss.Scopes, s)
+		qry.Push(ss)
+		return nil
+	}
 	if err := b.buildTableReferenceInJoinedTable(stmt.Left, qry); err != nil {
 		return err
 	}
@@ -268,8 +337,14 @@ func (b *build) buildJoinedTree(stmt *tree.JoinTableExpr, qry *Query) error {
 		} else {
 			jt = INNER
 		}
+	case joinTypeSemi:
+		jt = SEMI
+	case joinTypeAnti, joinTypeNotIn:
+		jt = ANTI
+	case joinTypeScalar:
+		jt = LEFT
 	}
-	if jt == FULL || jt == LEFT || jt == RIGHT || jt == INNER {
+	if jt == FULL || jt == LEFT || jt == RIGHT || jt == INNER || jt == SEMI || jt == ANTI {
 		switch stmt.Cond.(type) {
 		case *tree.OnJoinCond:
 		case *tree.UsingJoinCond:
@@ -280,6 +355,46 @@ func (b *build) buildJoinedTree(stmt *tree.JoinTableExpr, qry *Query) error {
 	}
 	rss := qry.Pop()
 	lss := qry.Pop()
+	if jt == FULL || jt == LEFT || jt == RIGHT || jt == SEMI || jt == ANTI {
+		left, err := b.buildJoinedScope(lss)
+		if err != nil {
+			return err
+		}
+		right, err := b.buildJoinedScope(rss)
+		if err != nil {
+			return err
+		}
+		var notIn tree.Expr
+
+		expr := stmt.Cond.(*tree.OnJoinCond).Expr
+		if stmt.JoinType == joinTypeNotIn { // the first condition is the comparison of NOT IN
+			es := splitAndExpr(expr, nil)
+			notIn, expr = es[0], nil
+			for _, e := range es[1:] {
+				if expr == nil {
+					expr = e
+				} else {
+					expr = tree.NewAndExpr(expr, e)
+				}
+			}
+		}
+		s, err := b.buildOuterJoin(jt, left, right, expr, notIn)
+		if err != nil {
+			return err
+		}
+		if isSubqueryJoin(stmt) { // the joined table stands for its left side
+			s.Name = left.Name
+		}
+		if qry.Joins == nil {
+			qry.Joins = make(map[*tree.JoinTableExpr]*Scope)
+		}
+		qry.Joins[stmt] = s
+		ss := newScopeSet()
+		ss.JoinType = RELATION
+		ss.Scopes = append(ss.Scopes, s)
+		qry.Push(ss)
+		return nil
+	}
 	rjt, ljt := rss.JoinType, lss.JoinType
 	if (jt == ljt && jt == rjt) || (ljt == RELATION && rjt == RELATION) ||
 		(ljt == RELATION && rjt == jt) || (rjt == RELATION && ljt == jt) {
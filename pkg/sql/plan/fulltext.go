@@ -0,0 +1,89 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var errFullTextTable = errors.New(errno.UndefinedObject, "Can't find FULLTEXT index matching the column list")
+
+// resolveMatch resolves the query of MATCH ... AGAINST against the whole
+// table of the columns, so that the rows are scored by the statistics of
+// the table rather than those of the batches they are read in. The relation
+// matches the documents from the postings of the query, the rows are looked
+// up in their scores rather than tokenized again. The columns must be those
+// of a FULLTEXT index of the table. The query is left
+// unresolved for the relations which can't search their rows, whose rows
+// are then scored by the statistics of the batches.
+func (b *build) resolveMatch(cols []*tree.UnresolvedName, q *fulltext.Query, qry *Query) (*fulltext.Search, error) {
+	var rel *Relation
+	attrs := make([]string, len(cols))
+	for i, col := range cols {
+		table := ""
+		if col.NumParts > 1 {
+			table = col.Parts[1]
+		}
+		r := qry.Scope.findRelation(table, col.Parts[0])
+		if r == nil || (rel != nil && r != rel) {
+			return nil, errFullTextTable
+		}
+		rel, attrs[i] = r, col.Parts[0]
+	}
+	db, err := b.e.Database(rel.Schema)
+	if err != nil {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
+	}
+	r, err := db.Relation(rel.Name)
+	if err != nil {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, err.Error())
+	}
+	defer r.Close()
+	if !hasFullTextIndex(r.TableDefs(), attrs) {
+		return nil, errFullTextTable
+	}
+	searcher, ok := r.(engine.FullTextSearcher)
+	if !ok {
+		return &fulltext.Search{Query: q}, nil
+	}
+	b.resolved = true
+	return searcher.FullTextSearch(attrs, q)
+}
+
+// hasFullTextIndex returns true if a FULLTEXT index is on exactly the attrs,
+// in any order.
+func hasFullTextIndex(defs []engine.TableDef, attrs []string) bool {
+	for _, def := range defs {
+		index, ok := def.(*engine.IndexTableDef)
+		if !ok || index.Typ != engine.FullTextIndex || len(index.ColNames) != len(attrs) {
+			continue
+		}
+		mp := make(map[string]struct{}, len(attrs))
+		for _, attr := range attrs {
+			mp[attr] = struct{}{}
+		}
+		for _, name := range index.ColNames {
+			delete(mp, name)
+		}
+		if len(mp) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -29,13 +29,28 @@ func cloneGraph(gp *Graph) *Graph {
 	return gq
 }
 
-func existIndependentEdge(gp *Graph) bool {
-	for i, e := range gp.Es {
-		if isIndependentEdge(i, e.Vs, gp.Es) {
-			return true
+// connectedComponents returns the edges of each connected component of gp.
+func connectedComponents(gp *Graph) [][]int {
+	var iss [][]int
+
+	visited := make([]bool, len(gp.Es))
+	for i := range gp.Es {
+		if visited[i] {
+			continue
+		}
+		visited[i] = true
+		is := []int{i}
+		for k := 0; k < len(is); k++ {
+			for j := range gp.Es {
+				if !visited[j] && isConnected(gp.Es[is[k]].Vs, gp.Es[j].Vs) {
+					visited[j] = true
+					is = append(is, j)
+				}
+			}
 		}
+		iss = append(iss, is)
 	}
-	return false
+	return iss
 }
 
 func pruneGraph(gp *Graph) *Graph {
@@ -91,27 +106,6 @@ func pruneGraph(gp *Graph) *Graph {
 	return gp
 }
 
-func (vp *VertexSet) Contains(j int) bool {
-	for _, i := range vp.Is {
-		if i == j {
-			return true
-		}
-	}
-	return false
-}
-
-func isIndependentEdge(j int, vs []int, es []*Edge) bool {
-	for i, e := range es {
-		if i == j {
-			continue
-		}
-		if isConnected(vs, e.Vs) {
-			return false
-		}
-	}
-	return true
-}
-
 func weight(vs, ws []int) int {
 	var w int
 
@@ -152,67 +146,3 @@ func isCoverByEdge(vs, ws []int) bool {
 	}
 	return true
 }
-
-func connectedVertexs(vs, ws []int) []int {
-	rs := make([]int, 0, len(vs))
-	for _, v := range vs {
-		for _, w := range ws {
-			if v == w {
-				rs = append(rs, v)
-			}
-		}
-	}
-	return rs
-}
-
-func getEdgesCount(i int, ess []*EdgeSet) int {
-	var cnt int
-
-	for _, es := range ess {
-		if es.I1 == i || es.I2 == i {
-			cnt++
-		}
-	}
-	return cnt
-}
-
-func buildVertexSet(es []*Edge) *VertexSet {
-	vp := &VertexSet{
-		Es: make([]*Edge, len(es)),
-	}
-	for i, e := range es {
-		vp.Es[i] = e
-	}
-	return vp
-}
-
-func buildEdgeSet(es []*Edge) []*EdgeSet {
-	var ess []*EdgeSet
-
-	for i, e := range es {
-		for j := range es {
-			if i == j {
-				continue
-			}
-			if w := weight(e.Vs, es[j].Vs); w > 0 {
-				ess = append(ess, &EdgeSet{
-					W:  w,
-					I1: i,
-					I2: j,
-					E1: es[i],
-					E2: es[j],
-				})
-			}
-		}
-	}
-	return ess
-}
-
-func isEndEdgeSet(es *EdgeSet, ess []*EdgeSet) bool {
-	for i := range ess {
-		if es.I2 == ess[i].I1 {
-			return false
-		}
-	}
-	return true
-}
@@ -61,6 +61,8 @@ func (b *build) buildGroupByExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
 		return b.buildGroupByExpr(e.Expr, qry)
+	case *tree.ParamExpr:
+		return b.buildParam(e, qry, b.buildGroupByExpr)
 	case *tree.OrExpr:
 		return b.buildOr(e, qry, b.buildGroupByExpr)
 	case *tree.NotExpr:
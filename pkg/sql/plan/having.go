@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -45,6 +46,8 @@ func (b *build) buildHavingExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
 		return b.buildHavingExpr(e.Expr, qry)
+	case *tree.ParamExpr:
+		return b.buildParam(e, qry, b.buildHavingExpr)
 	case *tree.OrExpr:
 		return b.buildOr(e, qry, b.buildHavingExpr)
 	case *tree.NotExpr:
@@ -65,6 +68,8 @@ func (b *build) buildHavingExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildBetween(e, qry, b.buildHavingExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
+	case *tree.Subquery: // the uncorrelated scalar subqueries are rewritten by buildSubqueries
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(e, dialect.MYSQL)))
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
 }
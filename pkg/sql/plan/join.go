@@ -20,6 +20,8 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -75,6 +77,8 @@ func (b *build) buildQualifiedJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 	var ts []types.Type
 	var attrs, as []string
 
+	conds := b.buildSpanningTree(ss)
+	aliases := make(map[string]string) // alias of each attribute of each relation
 	nss := make([]*Scope, len(ss.Scopes))
 	{
 		ap := new(int) // alias generator
@@ -94,6 +98,7 @@ func (b *build) buildQualifiedJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 				}
 				as0 = append(as0, alias)
 				ts0 = append(ts0, s.Result.AttrsMap[s.Result.Attrs[j]].Type)
+				aliases[strconv.Itoa(i)+"."+s.Result.Attrs[j]] = alias
 			}
 			nss[i] = b.buildRename(s, s.Result.Attrs, as0, ts0)
 		}
@@ -103,9 +108,357 @@ func (b *build) buildQualifiedJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 	if err != nil {
 		return nil, err
 	}
+	{ // the conditions out of the spanning tree filter the result of the join
+		var e extend.Extend
+
+		for _, cond := range conds {
+			left, right := aliases[strconv.Itoa(cond.R)+"."+cond.Rattr], aliases[strconv.Itoa(cond.S)+"."+cond.Sattr]
+			if left == right { // implied by the join
+				continue
+			}
+			e0 := &extend.BinaryExtend{
+				Op: overload.EQ,
+				Left: &extend.Attribute{
+					Name: left,
+					Type: s.Result.AttrsMap[left].Type.Oid,
+				},
+				Right: &extend.Attribute{
+					Name: right,
+					Type: s.Result.AttrsMap[right].Type.Oid,
+				},
+			}
+			if e == nil {
+				e = e0
+			} else {
+				e = &extend.BinaryExtend{Op: overload.And, Left: e, Right: e0}
+			}
+		}
+		if e != nil {
+			rs := &Scope{
+				Children: []*Scope{s},
+				Op:       &Restrict{E: e},
+			}
+			{ // construct result
+				rs.Result.AttrsMap = make(map[string]*Attribute)
+				for _, attr := range s.Result.Attrs {
+					rs.Result.Attrs = append(rs.Result.Attrs, attr)
+					rs.Result.AttrsMap[attr] = &Attribute{
+						Name: attr,
+						Type: s.Result.AttrsMap[attr].Type,
+					}
+				}
+			}
+			s = rs
+		}
+	}
 	return b.buildRename(s, attrs, as, ts), nil
 }
 
+// buildSpanningTree keeps the join conditions of a spanning forest of the
+// relations, the other conditions are removed from ss and returned, they
+// close cycles of the join graph and are evaluated after the join.
+func (b *build) buildSpanningTree(ss *ScopeSet) []*JoinCondition {
+	var rs []*JoinCondition
+
+	ps := make([]int, len(ss.Scopes)) // disjoint sets of the relations
+	for i := range ps {
+		ps[i] = i
+	}
+	find := func(i int) int {
+		for ps[i] != i {
+			i = ps[i]
+		}
+		return i
+	}
+	edges := make(map[[2]int]struct{}) // edges of the spanning forest
+	conds := make([]*JoinCondition, 0, len(ss.Conds))
+	for _, cond := range ss.Conds {
+		edge := [2]int{cond.R, cond.S}
+		if edge[0] > edge[1] {
+			edge[0], edge[1] = edge[1], edge[0]
+		}
+		_, ok := edges[edge]
+		if i, j := find(cond.R), find(cond.S); ok || i != j {
+			ps[i] = j
+			edges[edge] = struct{}{}
+			conds = append(conds, cond)
+			continue
+		}
+		rs = append(rs, cond)
+	}
+	ss.Conds = conds
+	return rs
+}
+
+// buildOuterJoin builds the left, right, full, semi or anti join of left and
+// right. The preserved side becomes the first child of the join and the
+// null-supplying side the second one, the join is wrapped in a derived
+// relation so that the restrictions and aggregations of the query are not
+// pushed through it. Only the preserved side is output by a semi or anti join,
+// notIn is the comparison of the NOT IN of a null-aware anti join.
+func (b *build) buildOuterJoin(joinType int, left, right *Scope, expr, notIn tree.Expr) (*Scope, error) {
+	var es []tree.Expr // conditions of the null-supplying side
+	var cs []tree.Expr // conditions checked by the join for each pair of rows
+	var cond, notInCond extend.Extend
+
+	isSemi := joinType == SEMI || joinType == ANTI
+	ss := newScopeSet()
+	ss.Scopes = []*Scope{left, right}
+	if joinType == RIGHT {
+		ss.Scopes = []*Scope{right, left}
+	}
+	if err := b.buildOuterJoinCond(expr, ss, &es); err != nil {
+		return nil, err
+	}
+	if joinType == FULL {
+		// the rows of both sides without a match are output, so that no
+		// condition can filter a side before the join
+		es, cs = nil, es
+	} else {
+		es, cs = b.splitOuterJoinCond(es, ss.Scopes[1])
+	}
+	if len(es) > 0 {
+		s, err := b.buildOuterJoinRestrict(es, ss.Scopes[1])
+		if err != nil {
+			return nil, err
+		}
+		ss.Scopes[1] = s
+	}
+	attrs := make([][]string, 2) // aliases of the output attributes of each side
+	names := make([][]string, 2)
+	ts := make([][]types.Type, 2)
+	vars := make([][]int, 2)
+	tss := []*Scope{ss.Scopes[0], ss.Scopes[1]}
+	aliases := []map[string]string{make(map[string]string), make(map[string]string)}
+	{
+		ap := new(int) // alias generator
+		b.initAliasGenerator(ap, ss.Conds)
+		mp := make(map[int]struct{})
+		for _, cond := range ss.Conds {
+			if _, ok := mp[cond.Alias]; !ok {
+				mp[cond.Alias] = struct{}{}
+				vars[1] = append(vars[1], cond.Alias)
+			}
+		}
+		for i, s := range tss {
+			var as0, attrs0 []string
+			var ts0 []types.Type
+
+			tm := []*Scope{tss[1-i]}
+			for _, attr := range s.Result.Attrs {
+				typ := s.Result.AttrsMap[attr].Type
+				alias, name := b.generateAlias(i, ap, attr, s, tm, ss.Conds)
+				as0 = append(as0, alias)
+				attrs0 = append(attrs0, attr)
+				ts0 = append(ts0, typ)
+				aliases[i][attr] = alias
+				if isSemi && i == 0 { // only the preserved side is output
+					name = attr
+				}
+				if v, _ := strconv.Atoi(alias); v < len(mp) && ((i == 1 && !isSemi) || joinType == FULL) {
+					// the join attribute of the null-supplying side is output
+					// with another alias so that it can be padded with null
+					(*ap)++
+					alias = strconv.Itoa(*ap)
+					as0 = append(as0, alias)
+					attrs0 = append(attrs0, attr)
+					ts0 = append(ts0, typ)
+				}
+				attrs[i] = append(attrs[i], alias)
+				names[i] = append(names[i], name)
+				ts[i] = append(ts[i], typ)
+			}
+			ss.Scopes[i] = b.buildRename(s, attrs0, as0, ts0)
+		}
+	}
+	if len(cs) > 0 {
+		var err error
+
+		if cond, err = b.buildSemiJoinCond(cs, tss, aliases); err != nil {
+			return nil, err
+		}
+	}
+	if notIn != nil {
+		var err error
+
+		e := stripParen(notIn).(*tree.ComparisonExpr)
+		if notInCond, err = b.buildSemiJoinCond([]tree.Expr{tree.NewComparisonExpr(tree.NOT_EQUAL, e.Left, e.Right)}, tss, aliases); err != nil {
+			return nil, err
+		}
+	}
+	s := &Scope{Children: ss.Scopes}
+	s.Op = &Join{Type: joinType, Vars: vars, Cond: cond, NotIn: notInCond}
+	if isSemi {
+		attrs[1], names[1], ts[1] = nil, nil, nil
+	}
+	if joinType == RIGHT {
+		s.Op = &Join{Type: LEFT, Vars: vars, Cond: cond}
+		attrs[0], attrs[1] = attrs[1], attrs[0]
+		names[0], names[1] = names[1], names[0]
+		ts[0], ts[1] = ts[1], ts[0]
+	}
+	{ // construct result
+		s.Result.AttrsMap = make(map[string]*Attribute)
+		for _, chp := range s.Children {
+			for _, attr := range chp.Result.Attrs {
+				if _, ok := s.Result.AttrsMap[attr]; !ok {
+					s.Result.Attrs = append(s.Result.Attrs, attr)
+					s.Result.AttrsMap[attr] = &Attribute{
+						Name: attr,
+						Type: chp.Result.AttrsMap[attr].Type,
+					}
+				}
+			}
+		}
+	}
+	s = b.buildRename(s, append(attrs[0], attrs[1]...), append(names[0], names[1]...), append(ts[0], ts[1]...))
+	s.pushDownJoinAttribute(nil)
+	rs := &Scope{Children: []*Scope{s}}
+	{ // construct result and derived relation
+		rel := new(DerivedRelation)
+		rs.Result.AttrsMap = make(map[string]*Attribute)
+		for _, attr := range s.Result.Attrs {
+			typ := s.Result.AttrsMap[attr].Type
+			rs.Result.Attrs = append(rs.Result.Attrs, attr)
+			rs.Result.AttrsMap[attr] = &Attribute{
+				Type: typ,
+				Name: attr,
+			}
+			rel.Proj.Rs = append(rel.Proj.Rs, 0)
+			rel.Proj.As = append(rel.Proj.As, attr)
+			rel.Proj.Es = append(rel.Proj.Es, &extend.Attribute{
+				Name: attr,
+				Type: typ.Oid,
+			})
+		}
+		rs.Op = rel
+	}
+	return rs, nil
+}
+
+// buildOuterJoinCond splits the on condition of an outer join into the
+// equi-join conditions of the two sides and the other conditions.
+func (b *build) buildOuterJoinCond(expr tree.Expr, ss *ScopeSet, es *[]tree.Expr) error {
+	switch e := expr.(type) {
+	case nil: // a semi join without condition
+		return nil
+	case *tree.AndExpr:
+		if err := b.buildOuterJoinCond(e.Left, ss, es); err != nil {
+			return err
+		}
+		return b.buildOuterJoinCond(e.Right, ss, es)
+	case *tree.ParenExpr:
+		return b.buildOuterJoinCond(e.Expr, ss, es)
+	case *tree.ComparisonExpr:
+		left, lok := e.Left.(*tree.UnresolvedName)
+		right, rok := e.Right.(*tree.UnresolvedName)
+		if e.Op == tree.EQUAL && lok && rok {
+			li, _, lerr := b.getJoinAttribute(left, ss)
+			ri, _, rerr := b.getJoinAttribute(right, ss)
+			if lerr == nil && rerr == nil && li != ri {
+				return b.buildJoinCond(e, ss)
+			}
+		}
+	}
+	*es = append(*es, expr)
+	return nil
+}
+
+// splitOuterJoinCond splits the conditions es of an outer join into the
+// conditions which only use the attributes of the side s and the others.
+func (b *build) splitOuterJoinCond(es []tree.Expr, s *Scope) ([]tree.Expr, []tree.Expr) {
+	var rs, cs []tree.Expr
+
+	for _, expr := range es {
+		if _, err := b.buildWhereExpr(expr, &Query{Scope: s}); err != nil {
+			cs = append(cs, expr)
+		} else {
+			rs = append(rs, expr)
+		}
+	}
+	return rs, cs
+}
+
+// buildSemiJoinCond builds the conditions cs of an outer join which are
+// checked for each pair of rows of the two sides, each attribute is named by
+// its alias in the join.
+func (b *build) buildSemiJoinCond(cs []tree.Expr, ss []*Scope, aliases []map[string]string) (extend.Extend, error) {
+	var es []extend.Extend
+
+	s := &Scope{}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	jss := &ScopeSet{Scopes: ss}
+	for _, expr := range cs {
+		expr, err := rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+			name, ok := n.(*tree.UnresolvedName)
+			if !ok {
+				return n, false, nil
+			}
+			i, attr, err := b.getJoinAttribute(name, jss)
+			if err != nil {
+				return nil, true, err
+			}
+			alias := aliases[i][attr]
+			if _, ok := s.Result.AttrsMap[alias]; !ok {
+				s.Result.Attrs = append(s.Result.Attrs, alias)
+				s.Result.AttrsMap[alias] = &Attribute{
+					Name: alias,
+					Type: ss[i].Result.AttrsMap[attr].Type,
+				}
+			}
+			return tree.SetUnresolvedName(alias), true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		e, err := b.buildWhereExpr(expr, &Query{Scope: s})
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, e)
+	}
+	return b.pruneExtend(extendsToAndExtend(es), false)
+}
+
+// buildOuterJoinRestrict filters the null-supplying side s of an outer join
+// with the conditions es before the join.
+func (b *build) buildOuterJoinRestrict(es []tree.Expr, s *Scope) (*Scope, error) {
+	var e extend.Extend
+
+	qry := &Query{Scope: s}
+	for _, expr := range es {
+		e0, err := b.buildWhereExpr(expr, qry)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			e = e0
+		} else {
+			e = &extend.BinaryExtend{Op: overload.And, Left: e, Right: e0}
+		}
+	}
+	e, err := b.pruneExtend(e, false)
+	if err != nil {
+		return nil, err
+	}
+	rs := &Scope{
+		Name:     s.Name,
+		Children: []*Scope{s},
+		Op:       &Restrict{E: e},
+	}
+	{ // construct result
+		rs.Result.AttrsMap = make(map[string]*Attribute)
+		for _, attr := range s.Result.Attrs {
+			rs.Result.Attrs = append(rs.Result.Attrs, attr)
+			rs.Result.AttrsMap[attr] = &Attribute{
+				Name: attr,
+				Type: s.Result.AttrsMap[attr].Type,
+			}
+		}
+	}
+	return rs, nil
+}
+
 func (b *build) buildNaturalJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 	var attrs []string
 	var ts []types.Type
@@ -143,6 +496,8 @@ func (b *build) buildNaturalJoin(joinType int, ss *ScopeSet) (*Scope, error) {
 }
 
 func (b *build) buildHyperGraph(joinType int, ss *ScopeSet) (*Scope, error) {
+	var rs []*Scope
+
 	gp := new(Graph)
 	{ // build hyper graph
 		for _, s := range ss.Scopes {
@@ -157,127 +512,63 @@ func (b *build) buildHyperGraph(joinType int, ss *ScopeSet) (*Scope, error) {
 	if err := b.checkHyperGraph(gp, ss); err != nil {
 		return nil, err
 	}
-	ness := []*EdgeSet{}
-	{
-		ess := buildEdgeSet(gp.Es)
-		ness = b.decomposition(buildVertexSet(gp.Es), b.initVertexSet(gp.Es, ss.Scopes, ess), ess, ness, ss.Scopes)
-	}
-	return b.buildJoinTree(joinType, new(Scope), ness[0].I1, ness, ss.Scopes), nil
-}
-
-func (b *build) decomposition(vp, nvp *VertexSet, ess, ness []*EdgeSet, ss []*Scope) []*EdgeSet {
-	var j int
-	var w int
-
-	j = -1
-	for i, es := range ess {
-		if nvp.Contains(es.I1) && !nvp.Contains(es.I2) {
-			if j < 0 || es.W > w || ss[ess[j].I2].Rows() > ss[ess[i].I2].Rows() {
-				j = i
-				w = es.W
-			}
+	for _, is := range connectedComponents(gp) {
+		if len(is) == 1 {
+			rs = append(rs, ss.Scopes[is[0]])
+			continue
 		}
+		rs = append(rs, b.buildJoinOrder(joinType, gp, is, ss.Scopes))
 	}
-	if j >= 0 {
-		ness = append(ness, ess[j])
-		nvp.Is = append(nvp.Is, ess[j].I2)
-		nvp.Es = append(nvp.Es, ess[j].E2)
-	}
-	if len(vp.Es) != len(nvp.Es) {
-		ness = b.decomposition(vp, nvp, ess, ness, ss)
+	if len(rs) == 1 {
+		return rs[0], nil
 	}
-	return ness
+	return b.buildProduct(rs), nil
 }
 
-func (b *build) buildJoinTree(joinType int, root *Scope, i int, ess []*EdgeSet, ss []*Scope) *Scope {
-	op := &Join{Type: joinType}
-	root.Children = append(root.Children, ss[i])
-	for j := 0; j < len(ess); j++ {
-		if ess[j].I1 != i {
-			continue
+// buildProduct builds the cross product of the connected components of a
+// join graph. The biggest join tree becomes the root and the other components
+// are broadcast to it as views without join attributes, a product of single
+// relations is a cross join of them.
+func (b *build) buildProduct(ss []*Scope) *Scope {
+	j := 0
+	for i := range ss {
+		_, ok := ss[i].Op.(*Join)
+		_, jok := ss[j].Op.(*Join)
+		if (ok && !jok) || (ok == jok && ss[i].estimateRows() > ss[j].estimateRows()) {
+			j = i
 		}
-		es := ess[j]
-		op.Vars = append(op.Vars, connectedVertexs(es.E1.Vs, es.E2.Vs))
-		ess = append(ess[:j], ess[j+1:]...)
-		b.buildPath(joinType, root, es, ess, ss)
-		j--
 	}
-	{ // construct result
-		root.Result.AttrsMap = make(map[string]*Attribute)
-		for i := range root.Children {
-			rp := &root.Children[i].Result
-			for _, attr := range rp.Attrs {
-				if _, ok := root.Result.AttrsMap[attr]; !ok {
-					root.Result.Attrs = append(root.Result.Attrs, attr)
-					root.Result.AttrsMap[attr] = &Attribute{
-						Name: attr,
-						Type: rp.AttrsMap[attr].Type,
-					}
-				}
-			}
+	ss[0], ss[j] = ss[j], ss[0]
+	s := ss[0]
+	if op, ok := s.Op.(*Join); ok {
+		for i := 1; i < len(ss); i++ {
+			s.Children = append(s.Children, ss[i])
+			op.Vars = append(op.Vars, nil)
+		}
+	} else {
+		s = &Scope{
+			Children: ss,
+			Op:       &Join{Type: CROSS, Vars: make([][]int, len(ss))},
 		}
+		s.Result.AttrsMap = make(map[string]*Attribute)
 	}
-	{
-		vars := make([][]int, len(root.Children))
-		for i, s := range root.Children {
-			if i == 0 {
-				continue
-			}
-			for _, attr := range s.Result.Attrs {
-				v, _ := strconv.Atoi(attr)
-				for j := range op.Vars {
-					for k := range op.Vars[j] {
-						if v == op.Vars[j][k] {
-							vars[i] = append(vars[i], v)
-							goto End
-						}
+	{ // construct result
+		for _, chp := range ss {
+			for _, attr := range chp.Result.Attrs {
+				if _, ok := s.Result.AttrsMap[attr]; !ok {
+					s.Result.Attrs = append(s.Result.Attrs, attr)
+					s.Result.AttrsMap[attr] = &Attribute{
+						Name: attr,
+						Type: chp.Result.AttrsMap[attr].Type,
 					}
 				}
-			End:
 			}
 		}
-		op.Vars = vars
 	}
-	root.Op = op
-	return root
-}
-
-func (b *build) buildPath(joinType int, root *Scope, es *EdgeSet, ess []*EdgeSet, ss []*Scope) {
-	if isEndEdgeSet(es, ess) {
-		root.Children = append(root.Children, ss[es.I2])
-	} else {
-		tm := make([]*EdgeSet, 0, len(ess))
-		for j := range ess {
-			if es.I2 == ess[j].I1 {
-				tm = append(tm[:0], ess...)
-				root.Children = append(root.Children, b.buildJoinTree(joinType, new(Scope), ess[j].I1, tm, ss))
-			}
-		}
-	}
-}
-
-func (b *build) initVertexSet(es []*Edge, ss []*Scope, ess []*EdgeSet) *VertexSet {
-	var j int
-	var cnt int
-	var rows int64
-
-	vp := new(VertexSet)
-	for i := range es {
-		if j < 0 || getEdgesCount(i, ess) > cnt || ss[i].Rows() > rows {
-			j = i
-			rows = ss[i].Rows()
-			cnt = getEdgesCount(i, ess)
-		}
-	}
-	vp.Is = append(vp.Is, j)
-	vp.Es = append(vp.Es, es[j])
-	return vp
+	return s
 }
 
 func (b *build) checkHyperGraph(gp *Graph, ss *ScopeSet) error {
-	if existIndependentEdge(gp) {
-		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("isolated relation not support now"))
-	}
 	if !isEmptyGraph(pruneGraph(cloneGraph(gp))) { // check whether is a cyclic graph
 		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("cyclic join not support now"))
 	}
@@ -407,22 +698,40 @@ func (b *build) initAliasGenerator(ap *int, conds []*JoinCondition) {
 		}
 		cond.Alias = alias
 		alias++
-		for j := i + 1; j < len(conds); j++ {
-			switch {
-			case cond.R == conds[j].R && cond.Rattr == conds[j].Rattr:
-				conds[j].Alias = cond.Alias
-			case cond.R == conds[j].S && cond.Rattr == conds[j].Sattr:
-				conds[j].Alias = cond.Alias
-			case cond.S == conds[j].S && cond.Sattr == conds[j].Sattr:
-				conds[j].Alias = cond.Alias
-			case cond.S == conds[j].R && cond.Sattr == conds[j].Rattr:
-				conds[j].Alias = cond.Alias
+		// conditions sharing an attribute share the alias transitively
+		for flg := true; flg; {
+			flg = false
+			for j := i + 1; j < len(conds); j++ {
+				if conds[j].Alias >= 0 {
+					continue
+				}
+				for k := i; k < j; k++ {
+					if conds[k].Alias == cond.Alias && isSharedAttribute(conds[k], conds[j]) {
+						conds[j].Alias = cond.Alias
+						flg = true
+						break
+					}
+				}
 			}
 		}
 	}
 	*ap = alias
 }
 
+func isSharedAttribute(x, y *JoinCondition) bool {
+	switch {
+	case x.R == y.R && x.Rattr == y.Rattr:
+		return true
+	case x.R == y.S && x.Rattr == y.Sattr:
+		return true
+	case x.S == y.S && x.Sattr == y.Sattr:
+		return true
+	case x.S == y.R && x.Sattr == y.Rattr:
+		return true
+	}
+	return false
+}
+
 func (b *build) generateAlias(i int, ap *int, attr string, s *Scope, ss []*Scope, conds []*JoinCondition) (string, string) {
 	for _, cond := range conds {
 		if (cond.R == i && cond.Rattr == attr) || (cond.S == i && cond.Sattr == attr) {
@@ -0,0 +1,277 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+const (
+	// maxDPRelations is the max number of relations whose join tree is
+	// enumerated by dynamic programming, the join tree of more relations is
+	// built greedily.
+	maxDPRelations = 10
+	// buildCostFactor is the cost of inserting a row into the hash table of
+	// a build side relative to the cost of probing the hash table with a row.
+	buildCostFactor = 2
+)
+
+// joinGraph is a connected join graph, two relations are adjacent if they
+// share join variables.
+type joinGraph struct {
+	ss    []*Scope
+	vs    [][]int            // join variables of each relation
+	rows  []float64          // estimated number of rows of each relation
+	ndvs  []map[int]float64  // number of distinct values of each join variable of each relation
+	masks map[int]uint32     // relations containing each join variable
+	cards map[uint32]float64 // estimated number of rows of the join of the relations
+}
+
+// joinPlan is a join tree, the rows of the root relation probe the hash
+// tables of its children which are the build sides of the join.
+type joinPlan struct {
+	root     int
+	rows     float64
+	cost     float64
+	children []*joinPlan
+}
+
+// buildJoinOrder builds the join tree of the connected relations of is which
+// has the least cost, the cost of a join is the estimated number of the rows
+// probing it, building its hash tables and produced by it.
+func (b *build) buildJoinOrder(joinType int, gp *Graph, is []int, ss []*Scope) *Scope {
+	g := newJoinGraph(gp, is, ss)
+	var p *joinPlan
+	if len(is) <= maxDPRelations {
+		p = g.enumerate()
+	} else {
+		p = g.greedy()
+	}
+	return g.buildScope(joinType, p)
+}
+
+func newJoinGraph(gp *Graph, is []int, ss []*Scope) *joinGraph {
+	g := &joinGraph{
+		masks: make(map[int]uint32),
+		cards: make(map[uint32]float64),
+	}
+	for k, i := range is {
+		g.ss = append(g.ss, ss[i])
+		g.vs = append(g.vs, gp.Es[i].Vs)
+		g.rows = append(g.rows, maxFloat(ss[i].estimateRows(), 1))
+		ndvs := make(map[int]float64)
+		for _, v := range gp.Es[i].Vs {
+			ndvs[v] = distinctValues(ss[i], strconv.Itoa(v))
+			g.masks[v] |= 1 << k
+		}
+		g.ndvs = append(g.ndvs, ndvs)
+	}
+	return g
+}
+
+// card returns the estimated number of rows of the join of the relations of
+// mask, the join of the relations on a variable keeps the fraction of their
+// product which is one divided by the product of the numbers of distinct
+// values of the variable except the least one.
+func (g *joinGraph) card(mask uint32) float64 {
+	if rows, ok := g.cards[mask]; ok {
+		return rows
+	}
+	rows := 1.0
+	for i := range g.ss {
+		if mask&(1<<i) != 0 {
+			rows *= g.rows[i]
+		}
+	}
+	for v, vmask := range g.masks {
+		if bits.OnesCount32(vmask&mask) < 2 {
+			continue
+		}
+		div, least := 1.0, -1.0
+		for i := range g.ss {
+			if vmask&mask&(1<<i) != 0 {
+				ndv := g.ndvs[i][v]
+				div *= ndv
+				if least < 0 || ndv < least {
+					least = ndv
+				}
+			}
+		}
+		rows /= div / least
+	}
+	rows = maxFloat(rows, 1)
+	g.cards[mask] = rows
+	return rows
+}
+
+func (g *joinGraph) isAdjacent(i, j int) bool {
+	return isConnected(g.vs[i], g.vs[j])
+}
+
+// isJoinable returns true if the join tree of x rooted at i and the join
+// tree of y rooted at j can be joined by i and j, which requires that each
+// variable shared by x and y belongs to both i and j so that the relations
+// containing a variable are still connected.
+func (g *joinGraph) isJoinable(x uint32, i int, y uint32, j int) bool {
+	for _, vmask := range g.masks {
+		if vmask&x != 0 && vmask&y != 0 {
+			if vmask&(1<<i) == 0 || vmask&(1<<j) == 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// enumerate returns the join tree of the least cost by dynamic programming
+// over the subsets of the relations, the best join tree rooted at each
+// relation of a subset joins the best join tree of a smaller subset rooted
+// at the same relation with the best join tree of the remaining relations.
+func (g *joinGraph) enumerate() *joinPlan {
+	n := len(g.ss)
+	full := uint32(1)<<n - 1
+	best := make([][]*joinPlan, full+1)
+	partial := make([][]*joinPlan, full+1) // join trees without the cost of their root join
+	for i := 0; i < n; i++ {
+		best[1<<i] = make([]*joinPlan, n)
+		partial[1<<i] = make([]*joinPlan, n)
+		best[1<<i][i] = &joinPlan{root: i, rows: g.rows[i]}
+		partial[1<<i][i] = best[1<<i][i]
+	}
+	for mask := uint32(1); mask <= full; mask++ {
+		if bits.OnesCount32(mask) < 2 {
+			continue
+		}
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			rest := mask &^ (1 << i)
+			for y := rest; y > 0; y = (y - 1) & rest {
+				x := mask &^ y
+				if partial[x] == nil || partial[x][i] == nil || best[y] == nil {
+					continue
+				}
+				for j := 0; j < n; j++ {
+					c := best[y][j]
+					if c == nil || !g.isAdjacent(i, j) || !g.isJoinable(x, i, y, j) {
+						continue
+					}
+					p := partial[x][i]
+					cost := p.cost + c.cost + c.rows*buildCostFactor
+					if partial[mask] == nil {
+						partial[mask] = make([]*joinPlan, n)
+						best[mask] = make([]*joinPlan, n)
+					}
+					if q := partial[mask][i]; q == nil || cost < q.cost {
+						children := make([]*joinPlan, 0, len(p.children)+1)
+						children = append(children, p.children...)
+						partial[mask][i] = &joinPlan{
+							root:     i,
+							rows:     g.card(mask),
+							cost:     cost,
+							children: append(children, c),
+						}
+					}
+				}
+			}
+			if partial[mask] != nil && partial[mask][i] != nil {
+				p := *partial[mask][i]
+				p.cost += g.rows[i] + p.rows
+				best[mask][i] = &p
+			}
+		}
+	}
+	var p *joinPlan
+	for _, q := range best[full] {
+		if q != nil && (p == nil || q.cost < p.cost) {
+			p = q
+		}
+	}
+	return p
+}
+
+// greedy returns the maximum spanning tree of the relations weighted by the
+// number of the shared join variables, which is a join tree because the join
+// graph is acyclic. The biggest relation is the root and a smaller relation
+// is preferred as a build side.
+func (g *joinGraph) greedy() *joinPlan {
+	root := 0
+	for i := range g.ss {
+		if g.rows[i] > g.rows[root] {
+			root = i
+		}
+	}
+	ps := make([]*joinPlan, len(g.ss))
+	ps[root] = &joinPlan{root: root, rows: g.rows[root]}
+	for k := 1; k < len(g.ss); k++ {
+		i, j, w := -1, -1, 0
+		for x := range g.ss {
+			if ps[x] == nil {
+				continue
+			}
+			for y := range g.ss {
+				if ps[y] != nil {
+					continue
+				}
+				wy := weight(g.vs[x], g.vs[y])
+				if wy > w || (wy == w && wy > 0 && g.rows[y] < g.rows[j]) {
+					i, j, w = x, y, wy
+				}
+			}
+		}
+		ps[j] = &joinPlan{root: j, rows: g.rows[j]}
+		ps[i].children = append(ps[i].children, ps[j])
+	}
+	return ps[root]
+}
+
+// buildScope builds the join of the join tree, the root relation is the
+// first child of the join and the join variables of the other children
+// are the variables they share with the root relation.
+func (g *joinGraph) buildScope(joinType int, p *joinPlan) *Scope {
+	if len(p.children) == 0 {
+		return g.ss[p.root]
+	}
+	s := &Scope{Children: []*Scope{g.ss[p.root]}}
+	vars := make([][]int, len(p.children)+1)
+	for i, c := range p.children {
+		chp := g.buildScope(joinType, c)
+		for _, attr := range chp.Result.Attrs {
+			v, _ := strconv.Atoi(attr)
+			if _, ok := g.ndvs[p.root][v]; ok {
+				vars[i+1] = append(vars[i+1], v)
+			}
+		}
+		s.Children = append(s.Children, chp)
+	}
+	s.Op = &Join{Type: joinType, Vars: vars}
+	{ // construct result
+		s.Result.AttrsMap = make(map[string]*Attribute)
+		for _, chp := range s.Children {
+			for _, attr := range chp.Result.Attrs {
+				if _, ok := s.Result.AttrsMap[attr]; !ok {
+					s.Result.Attrs = append(s.Result.Attrs, attr)
+					s.Result.AttrsMap[attr] = &Attribute{
+						Name: attr,
+						Type: chp.Result.AttrsMap[attr].Type,
+					}
+				}
+			}
+		}
+	}
+	return s
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinOrder(t *testing.T) {
+	b := &build{}
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	// newRelation returns the relation whose columns are renamed to the join variables vs
+	newRelation := func(name string, rows int64, vs []int, stats map[string]*engine.ColumnStatistics) *Scope {
+		s := &Scope{Name: name}
+		s.Result.AttrsMap = make(map[string]*Attribute)
+		var attrs, as []string
+		var ts []types.Type
+		for _, v := range vs {
+			attr := name + strconv.Itoa(v)
+			s.Result.Attrs = append(s.Result.Attrs, attr)
+			s.Result.AttrsMap[attr] = &Attribute{Name: attr, Type: typ}
+			attrs = append(attrs, attr)
+			as = append(as, strconv.Itoa(v))
+			ts = append(ts, typ)
+		}
+		s.Op = &Relation{Name: name, Rows: rows, Attrs: s.Result.AttrsMap, Stats: stats}
+		return b.buildRename(s, attrs, as, ts)
+	}
+	// relations returns the names of the relations of the join tree rooted at s
+	var relations func(s *Scope) []string
+	relations = func(s *Scope) []string {
+		if rel, ok := s.Op.(*Relation); ok {
+			return []string{rel.Name}
+		}
+		var names []string
+		for _, chp := range s.Children {
+			names = append(names, relations(chp)...)
+		}
+		return names
+	}
+	build := func(ss ...*Scope) *Scope {
+		s, err := b.buildHyperGraph(INNER, &ScopeSet{Scopes: ss})
+		require.NoError(t, err)
+		return s
+	}
+
+	{ // the fact table of a star schema is probed by the dimension tables
+		s := build(
+			newRelation("d1", 10, []int{0}, nil),
+			newRelation("f", 1000000, []int{0, 1}, nil),
+			newRelation("d2", 1000, []int{1}, nil),
+		)
+		require.Equal(t, 3, len(s.Children))
+		require.Equal(t, []string{"f"}, relations(s.Children[0]))
+		require.Equal(t, [][]int{nil, {0}, {1}}, s.Op.(*Join).Vars)
+	}
+	{ // the restriction makes the fact table small
+		f := newRelation("f", 1000000, []int{0, 1}, nil)
+		f.Children[0].Op.(*Relation).Cond = &extend.BinaryExtend{
+			Op:    overload.EQ,
+			Left:  &extend.Attribute{Name: "f0", Type: types.T_int64},
+			Right: &extend.ValueExtend{V: constantVector(int64(3))},
+		}
+		stats := map[string]*engine.ColumnStatistics{
+			"f0": {Rows: 1000000, NDV: 1000000, Min: int64(0), Max: int64(999999)},
+		}
+		f.Children[0].Op.(*Relation).Stats = stats
+		s := build(
+			newRelation("d1", 100000, []int{0}, nil),
+			f,
+			newRelation("d2", 1000, []int{1}, nil),
+		)
+		require.Equal(t, []string{"d1"}, relations(s.Children[0]))
+	}
+	{ // the number of distinct values of the join variables decides the join order
+		stats := map[string]*engine.ColumnStatistics{
+			"b0": {Rows: 10000, NDV: 10},
+			"c0": {Rows: 10000, NDV: 10},
+		}
+		s := build(
+			newRelation("a", 100000, []int{0, 1}, nil),
+			newRelation("b", 10000, []int{0}, stats),
+			newRelation("c", 10000, []int{0}, stats),
+		)
+		require.Equal(t, []string{"a"}, relations(s.Children[0]))
+		require.Equal(t, 3, len(s.Children))
+	}
+	{ // the join tree of many relations is built greedily
+		var ss []*Scope
+		for i := 0; i < maxDPRelations+2; i++ {
+			ss = append(ss, newRelation("r"+strconv.Itoa(i), int64(i+1)*10, []int{i, i + 1}, nil))
+		}
+		s := build(ss...)
+		require.ElementsMatch(t, relations(s), []string{"r0", "r1", "r2", "r3", "r4", "r5", "r6", "r7", "r8", "r9", "r10", "r11"})
+		require.Equal(t, []string{"r11"}, relations(s.Children[0]))
+	}
+}
+
+func TestSelectivity(t *testing.T) {
+	stats := &engine.ColumnStatistics{
+		Rows:      1000,
+		NDV:       100,
+		NullCount: 0,
+		Min:       int64(0),
+		Max:       int64(99),
+		Histogram: []engine.Bucket{
+			{Upper: int64(24), Count: 250, NDV: 25},
+			{Upper: int64(49), Count: 250, NDV: 25},
+			{Upper: int64(74), Count: 250, NDV: 25},
+			{Upper: int64(99), Count: 250, NDV: 25},
+		},
+	}
+	s := &Scope{Op: &Relation{Rows: 1000, Stats: map[string]*engine.ColumnStatistics{"a": stats}}}
+	s.Result.AttrsMap = map[string]*Attribute{"a": {Name: "a"}}
+	s.Result.Attrs = []string{"a"}
+	cmp := func(op int, v int64) extend.Extend {
+		return &extend.BinaryExtend{
+			Op:    op,
+			Left:  &extend.Attribute{Name: "a", Type: types.T_int64},
+			Right: &extend.ValueExtend{V: constantVector(v)},
+		}
+	}
+	require.InDelta(t, 0.01, selectivity(cmp(overload.EQ, 30), s), 1e-9)
+	require.InDelta(t, 0.0, selectivity(cmp(overload.EQ, 300), s), 1e-9)
+	require.InDelta(t, 0.5, selectivity(cmp(overload.LE, 49), s), 1e-9)
+	require.InDelta(t, 0.5, selectivity(cmp(overload.GT, 49), s), 1e-9)
+	require.InDelta(t, 0.0, selectivity(cmp(overload.LT, 0), s), 1e-9)
+	require.InDelta(t, 0.25*0.5, selectivity(&extend.BinaryExtend{
+		Op:    overload.And,
+		Left:  cmp(overload.LE, 24),
+		Right: cmp(overload.GT, 49),
+	}, s), 1e-9)
+	require.InDelta(t, defaultEqSelectivity, selectivity(&extend.BinaryExtend{
+		Op:    overload.EQ,
+		Left:  &extend.Attribute{Name: "b", Type: types.T_int64},
+		Right: &extend.ValueExtend{V: constantVector(1)},
+	}, s), 1e-9)
+}
+
+func constantVector(v int64) *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = []int64{v}
+	return vec
+}
@@ -0,0 +1,398 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildJSONTable builds JSON_TABLE as a derived relation over its rows, the
+// json document is a constant here, so the rows are computed here. The
+// JSON_TABLE which refers to the tables before it in the FROM list is built
+// by buildLateralJSONTable.
+func (b *build) buildJSONTable(tbl *tree.JSONTable, qry *Query) error {
+	doc, err := jsonTableDocument(tbl.Expr)
+	if err != nil {
+		return err
+	}
+	op, err := b.newJSONTable(tbl, nil)
+	if err != nil {
+		return err
+	}
+	if op.Bat, _, err = op.Rows([]bytejson.ByteJson{doc}); err != nil {
+		return err
+	}
+
+	child := &Scope{Op: op}
+	child.Result.AttrsMap = make(map[string]*Attribute)
+	s := &Scope{Children: []*Scope{child}}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	rel := new(DerivedRelation)
+	for i, attr := range op.Attrs {
+		child.Result.Attrs = append(child.Result.Attrs, attr)
+		child.Result.AttrsMap[attr] = &Attribute{Name: attr, Type: op.Types[i]}
+		s.Result.Attrs = append(s.Result.Attrs, attr)
+		s.Result.AttrsMap[attr] = &Attribute{Name: attr, Type: op.Types[i]}
+		rel.Proj.Rs = append(rel.Proj.Rs, 0)
+		rel.Proj.As = append(rel.Proj.As, attr)
+		rel.Proj.Es = append(rel.Proj.Es, &extend.Attribute{
+			Name: attr,
+			Type: op.Types[i].Oid,
+		})
+	}
+	s.Op = rel
+	ss := newScopeSet()
+	ss.JoinType = RELATION
+	ss.Scopes = append(ss.Scopes, s)
+	qry.Push(ss)
+	return nil
+}
+
+// buildLateralJSONTable builds the JSON_TABLE whose document refers to the
+// columns of a table before it in the FROM list, ss are the tables built so
+// far. The rows of the json table are computed for each row of the table, so
+// that the table is replaced by a derived relation over the rows of both of
+// them, which the restrictions and aggregations are not pushed through.
+func (b *build) buildLateralJSONTable(tbl *tree.JSONTable, alias string, cols tree.IdentifierList, ss *ScopeSet, qry *Query) error {
+	var e extend.Extend
+	var err error
+
+	i := len(ss.Scopes) - 1
+	for ; i >= 0; i-- {
+		if e, err = b.buildWhereExpr(tbl.Expr, &Query{Scope: ss.Scopes[i]}); err == nil {
+			break
+		}
+	}
+	if i < 0 {
+		if len(ss.Scopes) > 1 {
+			s, cerr := b.buildCrossJoin(ss)
+			if cerr != nil {
+				return cerr
+			}
+			if _, cerr = b.buildWhereExpr(tbl.Expr, &Query{Scope: s}); cerr == nil {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("JSON_TABLE can only refer to the columns of one table, not '%s'", tree.String(tbl.Expr, dialect.MYSQL)))
+			}
+		}
+		return err
+	}
+	if rs, ok := qry.JSONTables[tbl]; ok { // keep the restrictions pushed down in the first pass
+		rs.Children[0].Children[0] = ss.Scopes[i]
+		ss.Scopes[i] = rs
+		return nil
+	}
+	switch e.ReturnType() {
+	case types.T_json, types.T_char, types.T_varchar:
+	default:
+		return errors.New(errno.DatatypeMismatch, fmt.Sprintf("Incorrect type of the document of JSON_TABLE '%s'", tree.String(tbl.Expr, dialect.MYSQL)))
+	}
+	op, err := b.newJSONTable(tbl, cols)
+	if err != nil {
+		return err
+	}
+	op.E, op.Name = e, alias
+	left := ss.Scopes[i]
+	s := &Scope{Op: op, Children: []*Scope{left}}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	for _, attr := range left.Result.Attrs {
+		s.Result.Attrs = append(s.Result.Attrs, attr)
+		s.Result.AttrsMap[attr] = &Attribute{Name: attr, Type: left.Result.AttrsMap[attr].Type}
+	}
+	shared := make(map[string]struct{}) // columns of both tables, which are qualified by the tables
+	for j, attr := range op.Attrs {
+		if _, ok := s.Result.AttrsMap[attr]; ok {
+			if len(alias) == 0 {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", attr))
+			}
+			shared[attr] = struct{}{}
+			attr = alias + "." + attr
+			op.Attrs[j] = attr
+		}
+		s.Result.Attrs = append(s.Result.Attrs, attr)
+		s.Result.AttrsMap[attr] = &Attribute{Name: attr, Type: op.Types[j]}
+	}
+	rs := &Scope{Children: []*Scope{s}}
+	{ // construct result and derived relation
+		rel := new(DerivedRelation)
+		rs.Result.AttrsMap = make(map[string]*Attribute)
+		for _, attr := range s.Result.Attrs {
+			name := attr
+			if _, ok := shared[attr]; ok {
+				name = traceAttribute(left, attr)
+			}
+			typ := s.Result.AttrsMap[attr].Type
+			rs.Result.Attrs = append(rs.Result.Attrs, name)
+			rs.Result.AttrsMap[name] = &Attribute{Name: name, Type: typ}
+			rel.Proj.Rs = append(rel.Proj.Rs, 0)
+			rel.Proj.As = append(rel.Proj.As, name)
+			rel.Proj.Es = append(rel.Proj.Es, &extend.Attribute{
+				Name: attr,
+				Type: typ.Oid,
+			})
+		}
+		rs.Op = rel
+	}
+	if qry.JSONTables == nil {
+		qry.JSONTables = make(map[*tree.JSONTable]*Scope)
+	}
+	qry.JSONTables[tbl] = rs
+	ss.Scopes[i] = rs
+	return nil
+}
+
+// newJSONTable returns the json table of the columns of JSON_TABLE, cols are
+// the names of the columns given by the alias of the table.
+func (b *build) newJSONTable(tbl *tree.JSONTable, cols tree.IdentifierList) (*JSONTable, error) {
+	var err error
+
+	if len(cols) > 0 && len(cols) != len(tbl.Columns) {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("JSON_TABLE has %v columns but %v column names", len(tbl.Columns), len(cols)))
+	}
+	op := &JSONTable{
+		Attrs:   make([]string, len(tbl.Columns)),
+		Types:   make([]types.Type, len(tbl.Columns)),
+		columns: tbl.Columns,
+		paths:   make([]*bytejson.Path, len(tbl.Columns)),
+	}
+	if op.path, err = bytejson.ParsePath(tbl.Path); err != nil {
+		return nil, err
+	}
+	for i, col := range tbl.Columns {
+		op.Attrs[i] = string(col.Name)
+		if len(cols) > 0 {
+			op.Attrs[i] = string(cols[i])
+		}
+		for j := 0; j < i; j++ {
+			if op.Attrs[j] == op.Attrs[i] {
+				return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", op.Attrs[i]))
+			}
+		}
+		if col.Type == tree.JSON_TABLE_COLUMN_ORDINALITY {
+			op.Types[i] = types.Type{Oid: types.T_uint32, Size: 4}
+			continue
+		}
+		typ, err := b.getTableDefType(col.ColType)
+		if err != nil {
+			return nil, err
+		}
+		op.Types[i] = *typ
+		if op.paths[i], err = bytejson.ParsePath(col.Path); err != nil {
+			return nil, err
+		}
+	}
+	return op, nil
+}
+
+// Rows returns the rows of the json table for the json documents, sels are
+// the index of the document of each row.
+func (op *JSONTable) Rows(docs []bytejson.ByteJson) (*batch.Batch, []int64, error) {
+	var err error
+	var sels []int64
+
+	vals := make([][]interface{}, len(op.columns))
+	for i, doc := range docs {
+		for j, row := range doc.Find(op.path) {
+			for k, col := range op.columns {
+				v, err := jsonTableValue(row, col, op.Types[k], op.paths[k], j+1)
+				if err != nil {
+					return nil, nil, err
+				}
+				vals[k] = append(vals[k], v)
+			}
+			sels = append(sels, int64(i))
+		}
+	}
+	bat := batch.New(true, op.Attrs)
+	for i := range op.Attrs {
+		if bat.Vecs[i], err = buildJSONTableVector(op.Types[i], vals[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	bat.Zs = make([]int64, len(sels))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat, sels, nil
+}
+
+// isJSONTableConstant returns true if the document of JSON_TABLE is a
+// constant, the other documents refer to the tables before it.
+func isJSONTableConstant(e tree.Expr) bool {
+	switch n := e.(type) {
+	case *tree.ParenExpr:
+		return isJSONTableConstant(n.Expr)
+	case *tree.NumVal:
+		return true
+	}
+	return false
+}
+
+func jsonTableDocument(e tree.Expr) (bytejson.ByteJson, error) {
+	switch n := e.(type) {
+	case *tree.ParenExpr:
+		return jsonTableDocument(n.Expr)
+	case *tree.NumVal:
+		if n.Value.Kind() == constant.String {
+			return bytejson.ParseFromString(constant.StringVal(n.Value))
+		}
+	}
+	return bytejson.ByteJson{}, errors.New(errno.FeatureNotSupported, fmt.Sprintf("JSON_TABLE only supports a json text constant or a column of a table before it in the FROM list now, not '%s'", tree.String(e, dialect.MYSQL)))
+}
+
+// jsonTableValue returns the value of the column of JSON_TABLE for the row
+// context bj which is the row-th row, a nil value is a null.
+func jsonTableValue(bj bytejson.ByteJson, col *tree.JSONTableColumn, typ types.Type, p *bytejson.Path, row int) (interface{}, error) {
+	if col.Type == tree.JSON_TABLE_COLUMN_ORDINALITY {
+		return uint32(row), nil
+	}
+	v, ok := bj.Extract([]*bytejson.Path{p})
+	if col.Type == tree.JSON_TABLE_COLUMN_EXISTS {
+		exists := bytejson.NewInt64(0)
+		if ok {
+			exists = bytejson.NewInt64(1)
+		}
+		return convertJSONTableValue(exists, typ, string(col.Name), row)
+	}
+	if !ok {
+		return jsonTableResponse(col.OnEmpty, typ, string(col.Name), row, "Missing value for JSON_TABLE column '%s'")
+	}
+	if v.IsNull() {
+		return nil, nil
+	}
+	if p.Wildcard() && typ.Oid != types.T_json {
+		return jsonTableResponse(col.OnError, typ, string(col.Name), row, "Can't store an array or an object in the scalar column '%s' of JSON_TABLE")
+	}
+	r, err := convertJSONTableValue(v, typ, string(col.Name), row)
+	if err != nil {
+		return jsonTableResponse(col.OnError, typ, string(col.Name), row, "Invalid JSON value for the column '%s' of JSON_TABLE")
+	}
+	return r, nil
+}
+
+// jsonTableResponse returns the value of the ON EMPTY or ON ERROR clause
+func jsonTableResponse(resp tree.JSONOnResponse, typ types.Type, name string, row int, msg string) (interface{}, error) {
+	switch resp.Type {
+	case tree.JSON_ON_RESPONSE_ERROR:
+		return nil, errors.New(errno.DataException, fmt.Sprintf(msg, name))
+	case tree.JSON_ON_RESPONSE_DEFAULT:
+		bj, err := bytejson.ParseFromString(resp.Default)
+		if err != nil {
+			bj = bytejson.NewString(resp.Default)
+		}
+		return convertJSONTableValue(bj, typ, name, row)
+	}
+	return nil, nil
+}
+
+// convertJSONTableValue converts the json value to a value of the type as
+// inserting its text into a column of the type does.
+func convertJSONTableValue(bj bytejson.ByteJson, typ types.Type, name string, row int) (interface{}, error) {
+	if typ.Oid == types.T_json {
+		return bj.Marshal(), nil
+	}
+	s := strings.TrimSpace(bj.Unquote())
+	var num *tree.NumVal
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64:
+		abs := strings.TrimPrefix(s, "-")
+		val := constant.MakeFromLiteral(abs, token.INT, 0)
+		if val.Kind() == constant.Unknown {
+			val = constant.MakeFromLiteral(abs, token.FLOAT, 0)
+		}
+		if val.Kind() == constant.Unknown {
+			return nil, errors.New(errno.DataException, fmt.Sprintf("Incorrect value '%s' for column '%s' at row %d", s, name, row))
+		}
+		num = tree.NewNumVal(val, s, abs != s)
+	default:
+		num = tree.NewNumVal(constant.MakeString(s), s, false)
+	}
+	v, err := buildConstantValue(typ, num)
+	if err != nil {
+		return nil, err
+	}
+	return rangeCheck(v, typ, name, row)
+}
+
+func buildJSONTableVector(typ types.Type, vs []interface{}) (*vector.Vector, error) {
+	vec := vector.New(typ)
+	vec.Or = true
+	switch typ.Oid {
+	case types.T_int8:
+		return vec, appendJSONTableValues[int8](vec, vs)
+	case types.T_int16:
+		return vec, appendJSONTableValues[int16](vec, vs)
+	case types.T_int32:
+		return vec, appendJSONTableValues[int32](vec, vs)
+	case types.T_int64:
+		return vec, appendJSONTableValues[int64](vec, vs)
+	case types.T_uint8:
+		return vec, appendJSONTableValues[uint8](vec, vs)
+	case types.T_uint16:
+		return vec, appendJSONTableValues[uint16](vec, vs)
+	case types.T_uint32:
+		return vec, appendJSONTableValues[uint32](vec, vs)
+	case types.T_uint64:
+		return vec, appendJSONTableValues[uint64](vec, vs)
+	case types.T_float32:
+		return vec, appendJSONTableValues[float32](vec, vs)
+	case types.T_float64:
+		return vec, appendJSONTableValues[float64](vec, vs)
+	case types.T_date:
+		return vec, appendJSONTableValues[types.Date](vec, vs)
+	case types.T_datetime:
+		return vec, appendJSONTableValues[types.Datetime](vec, vs)
+	case types.T_decimal64:
+		return vec, appendJSONTableValues[types.Decimal64](vec, vs)
+	case types.T_decimal128:
+		return vec, appendJSONTableValues[types.Decimal128](vec, vs)
+	case types.T_char, types.T_varchar:
+		for i, v := range vs {
+			if v != nil {
+				vs[i] = []byte(v.(string))
+			}
+		}
+		return vec, appendJSONTableValues[[]byte](vec, vs)
+	case types.T_json:
+		return vec, appendJSONTableValues[[]byte](vec, vs)
+	}
+	return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("JSON_TABLE column of type '%v' not implement now", typ))
+}
+
+func appendJSONTableValues[T any](vec *vector.Vector, vs []interface{}) error {
+	col := make([]T, len(vs))
+	for i, v := range vs {
+		if v == nil {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		col[i] = v.(T)
+	}
+	return vector.Append(vec, col)
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
+)
+
+// tableRef is a table of the from clause of update or delete, its columns
+// are referred by qualifier which is the alias or the name of the table.
+type tableRef struct {
+	qualifier string
+	schema    string
+	name      string
+}
+
+// buildModifyQuery builds the query whose result attributes are exprs,
+// the rows of the modified tables are the result of the query.
+func (b *build) buildModifyQuery(exprs tree.SelectExprs, tbls tree.TableExprs, where *tree.Where, orderBy tree.OrderBy, limit *tree.Limit) (*Query, error) {
+	var stmt tree.Statement
+
+	stmt = &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: exprs,
+			From:  &tree.From{Tables: tbls},
+			Where: where,
+		},
+		OrderBy: orderBy,
+		Limit:   limit,
+	}
+	stmt = rewrite.AstRewrite(stmt)
+	pn, err := b.BuildStatement(stmt)
+	if err != nil {
+		return nil, err
+	}
+	return pn.(*Query), nil
+}
+
+// buildModifiedTable builds the table referred by ref and appends the
+// columns of the table to the select expressions.
+func (b *build) buildModifiedTable(ref *tableRef, exprs tree.SelectExprs) (*ModifiedTable, tree.SelectExprs, error) {
+	cols, _, _, err := b.getSchemaInfo(ref.schema, ref.name)
+	if err != nil {
+		return nil, nil, err
+	}
+	tbl := &ModifiedTable{
+		Schema: ref.schema,
+		Name:   ref.name,
+		Cols:   cols,
+	}
+	for _, col := range cols {
+		attr := ref.qualifier + "." + col
+		tbl.Attrs = append(tbl.Attrs, attr)
+		exprs = appendSelectExpr(exprs, tree.SetUnresolvedName(ref.qualifier, col), attr)
+	}
+	return tbl, exprs, nil
+}
+
+// readAttrs returns the columns of the table referred by ref which are named
+// by the expressions, the names of the other tables of refs are skipped.
+func (b *build) readAttrs(ref *tableRef, refs []*tableRef, exprs []tree.Expr) []string {
+	var attrs []string
+
+	attrsMap := make(map[*tableRef]map[string]*Attribute)
+	for _, expr := range exprs {
+		walkNames(expr, func(e *tree.UnresolvedName) {
+			if r, err := b.findColumnTable(e, refs, attrsMap); err != nil || r != ref {
+				return
+			}
+			for _, attr := range attrs {
+				if attr == e.Parts[0] {
+					return
+				}
+			}
+			attrs = append(attrs, e.Parts[0])
+		})
+	}
+	return attrs
+}
+
+// readExprs returns the expressions read by update or delete besides the
+// set list, which are the where clause, the order by and the join conditions.
+func readExprs(tbls tree.TableExprs, where *tree.Where, orderBy tree.OrderBy) []tree.Expr {
+	var exprs []tree.Expr
+
+	if where != nil {
+		exprs = append(exprs, where.Expr)
+	}
+	for _, o := range orderBy {
+		exprs = append(exprs, o.Expr)
+	}
+	for _, tbl := range tbls {
+		exprs = appendJoinConds(tbl, exprs)
+	}
+	return exprs
+}
+
+func appendJoinConds(tbl tree.TableExpr, exprs []tree.Expr) []tree.Expr {
+	switch t := tbl.(type) {
+	case *tree.AliasedTableExpr:
+		return appendJoinConds(t.Expr, exprs)
+	case *tree.ParenTableExpr:
+		return appendJoinConds(t.Expr, exprs)
+	case *tree.JoinTableExpr:
+		exprs = appendJoinConds(t.Left, exprs)
+		if t.Right != nil {
+			exprs = appendJoinConds(t.Right, exprs)
+		}
+		if cond, ok := t.Cond.(*tree.OnJoinCond); ok {
+			exprs = append(exprs, cond.Expr)
+		}
+	}
+	return exprs
+}
+
+// findTableRef returns the table referred by qualifier.
+func findTableRef(qualifier string, refs []*tableRef) (*tableRef, error) {
+	var ref *tableRef
+
+	for _, r := range refs {
+		if r.qualifier == qualifier {
+			if ref != nil {
+				return nil, errors.New(errno.DuplicateTable, fmt.Sprintf("Not unique table/alias: '%s'", qualifier))
+			}
+			ref = r
+		}
+	}
+	if ref == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("Unknown table '%s'", qualifier))
+	}
+	return ref, nil
+}
+
+// getTableRefs returns the tables of the from clause which can be modified,
+// the derived tables are not returned.
+func (b *build) getTableRefs(tbls tree.TableExprs) []*tableRef {
+	var refs []*tableRef
+
+	for _, tbl := range tbls {
+		refs = b.appendTableRefs(tbl, "", refs)
+	}
+	return refs
+}
+
+func (b *build) appendTableRefs(tbl tree.TableExpr, alias string, refs []*tableRef) []*tableRef {
+	switch t := tbl.(type) {
+	case *tree.TableName:
+		ref := &tableRef{
+			qualifier: alias,
+			schema:    string(t.SchemaName),
+			name:      string(t.ObjectName),
+		}
+		if len(ref.schema) == 0 {
+			ref.schema = b.db
+		}
+		if len(ref.qualifier) == 0 {
+			ref.qualifier = ref.name
+		}
+		return append(refs, ref)
+	case *tree.AliasedTableExpr:
+		return b.appendTableRefs(t.Expr, string(t.As.Alias), refs)
+	case *tree.ParenTableExpr:
+		return b.appendTableRefs(t.Expr, alias, refs)
+	case *tree.JoinTableExpr:
+		refs = b.appendTableRefs(t.Left, "", refs)
+		if t.Right != nil {
+			refs = b.appendTableRefs(t.Right, "", refs)
+		}
+	}
+	return refs
+}
+
+// appendSelectExpr appends expr named as to exprs if there is no
+// expression with the same name.
+func appendSelectExpr(exprs tree.SelectExprs, expr tree.Expr, as string) tree.SelectExprs {
+	for _, e := range exprs {
+		if string(e.As) == as {
+			return exprs
+		}
+	}
+	return append(exprs, tree.SelectExpr{Expr: expr, As: tree.UnrestrictedIdentifier(as)})
+}
@@ -24,7 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func (b *build) buildOrderBy(orders tree.OrderBy, proj0, proj *Projection, qry *Query) ([]*Field, error) {
+func (b *build) buildOrderBy(orders tree.OrderBy, proj0, proj *Projection, ws []*Window, qry *Query) ([]*Field, error) {
 	var err error
 	var fs []*Field
 	var e extend.Extend
@@ -39,6 +39,13 @@ func (b *build) buildOrderBy(orders tree.OrderBy, proj0, proj *Projection, qry *
 				goto OUT
 			}
 		}
+		for _, w := range ws { // results of the window functions
+			for _, f := range w.Functions {
+				if ovar == f.Alias {
+					goto OUT
+				}
+			}
+		}
 		if e, err = b.buildOrderByExpr(order.Expr, qry); err != nil {
 			return nil, err
 		}
@@ -64,6 +71,8 @@ func (b *build) buildOrderByExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
 		return b.buildOrderByExpr(e.Expr, qry)
+	case *tree.ParamExpr:
+		return b.buildParam(e, qry, b.buildOrderByExpr)
 	case *tree.OrExpr:
 		return b.buildOr(e, qry, b.buildOrderByExpr)
 	case *tree.NotExpr:
@@ -82,6 +91,8 @@ func (b *build) buildOrderByExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildCast(e, qry, b.buildOrderByExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildOrderByExpr)
+	case *tree.FullTextMatchExpr:
+		return b.buildMatch(e, qry, b.buildOrderByExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
@@ -0,0 +1,141 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildParam builds the value bound to a parameter of a prepared statement.
+// The plan keeps the value, the values of the next executions are bound to
+// it by Bind.
+func (b *build) buildParam(e *tree.ParamExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	v, err := buildParamValue(e.Expr)
+	if err != nil {
+		b.folded = true
+		return fn(e.Expr, qry)
+	}
+	b.params = append(b.params, &Param{
+		Offset: e.Offset,
+		Oid:    v.V.Typ.Oid,
+		V:      v,
+	})
+	return v, nil
+}
+
+// isParam returns true if the extend is the value of a parameter.
+func (b *build) isParam(e extend.Extend) bool {
+	for _, p := range b.params {
+		if e == extend.Extend(p.V) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bind binds the values to the parameters of the plan before it is executed
+// again. It returns false if a value is not of the type the plan is built
+// with, then the plan has to be built for the values.
+func (qry *Query) Bind(values []tree.Expr) (bool, error) {
+	vs := make([]*vector.Vector, len(qry.Params))
+	for i, p := range qry.Params {
+		if p.Offset > len(values) {
+			return false, nil
+		}
+		v, err := buildParamValue(values[p.Offset-1])
+		if err != nil || v.V.Typ.Oid != p.Oid {
+			return false, nil
+		}
+		if err := convertParam(v, p.V.V); err != nil {
+			return false, err
+		}
+		vs[i] = v.V
+	}
+	for i, p := range qry.Params {
+		p.V.V = vs[i]
+	}
+	return true, nil
+}
+
+// buildParamValue builds the value bound to a parameter, which is a literal
+// or a negative number.
+func buildParamValue(n tree.Expr) (*extend.ValueExtend, error) {
+	switch e := n.(type) {
+	case *tree.NumVal:
+		v, err := buildValue(e.Value, e.String())
+		if err != nil {
+			return nil, err
+		}
+		return v.(*extend.ValueExtend), nil
+	case *tree.UnaryExpr:
+		if num, ok := e.Expr.(*tree.NumVal); ok && e.Op == tree.UNARY_MINUS {
+			v, err := buildValue(num.Value, "-"+num.String())
+			if err != nil {
+				return nil, err
+			}
+			if _, err = Neg(v.(*extend.ValueExtend)); err != nil {
+				return nil, err
+			}
+			return v.(*extend.ValueExtend), nil
+		}
+	}
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport value: %v", n))
+}
+
+// convertParam converts the value bound to a parameter into the type of vec,
+// which the value of the parameter is converted into when the plan is built.
+func convertParam(v *extend.ValueExtend, vec *vector.Vector) error {
+	if v.V.Typ.Oid == vec.Typ.Oid {
+		return nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return toInt8(v)
+	case types.T_int16:
+		return toInt16(v)
+	case types.T_int32:
+		return toInt32(v)
+	case types.T_int64:
+		return toInt64(v)
+	case types.T_uint8:
+		return toUint8(v)
+	case types.T_uint16:
+		return toUint16(v)
+	case types.T_uint32:
+		return toUint32(v)
+	case types.T_uint64:
+		return toUint64(v)
+	case types.T_float32:
+		return toFloat32(v)
+	case types.T_float64:
+		return toFloat64(v)
+	case types.T_decimal128:
+		return toDecimal(v)
+	case types.T_date:
+		return toDate(v)
+	case types.T_datetime:
+		return toDatetime(v)
+	case types.T_char:
+		return toChar(v)
+	}
+	return errors.New(errno.DatatypeMismatch, fmt.Sprintf("cannot convert %s to %s", v.V.Typ, vec.Typ))
+}
@@ -0,0 +1,250 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// PrivilegeChecker decides whether the user who initiated the sql holds a privilege.
+type PrivilegeChecker interface {
+	// HasPrivilege checks whether the privilege is granted on the object.
+	// The empty table means the database itself and the empty column means the table itself.
+	HasPrivilege(priv tree.PrivilegeType, db, table, column string) bool
+	// HasAnyPrivilege checks whether any privilege is granted on the object or on an object in it.
+	HasAnyPrivilege(db, table, column string) bool
+}
+
+// SetPrivilegeChecker sets the privilege checker of the statements.
+// The privileges are not checked if the checker is nil.
+func (b *build) SetPrivilegeChecker(pc PrivilegeChecker) {
+	b.pc = pc
+}
+
+// checkPrivilege checks the privileges required by the statement with its plan.
+func (b *build) checkPrivilege(stmt tree.Statement, pn Plan) error {
+	if b.pc == nil {
+		return nil
+	}
+	switch stmt := stmt.(type) {
+	case *tree.Select, *tree.ParenSelect:
+		// the query of update and delete is checked with the statement
+		if b.isModify {
+			return nil
+		}
+		qry := pn.(*Query)
+		if err := b.checkScopePrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, qry.Scope); err != nil {
+			return err
+		}
+		for _, s := range qry.Children {
+			if err := b.checkScopePrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, s); err != nil {
+				return err
+			}
+		}
+	case *tree.Insert:
+		p := pn.(*Insert)
+		return b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_INSERT, p.Db, p.Id, p.Bat.Attrs)
+	case *tree.Update:
+		p := pn.(*Update)
+		for _, tbl := range p.Tables {
+			if err := b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_UPDATE, tbl.Schema, tbl.Name, tbl.UpdateAttrs); err != nil {
+				return err
+			}
+		}
+		return b.checkJoinedPrivilege(p.Qry.Scope, p.Tables)
+	case *tree.Delete:
+		p := pn.(*Delete)
+		for _, tbl := range p.Tables {
+			if err := b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_DELETE, tbl.Schema, tbl.Name, nil); err != nil {
+				return err
+			}
+		}
+		return b.checkJoinedPrivilege(p.Qry.Scope, p.Tables)
+	case *tree.CreateDatabase:
+		return b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE, string(stmt.Name), "", nil)
+	case *tree.DropDatabase:
+		return b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_DROP, string(stmt.Name), "", nil)
+	case *tree.CreateTable:
+		db, tbl, err := b.tableInfo(stmt.Table)
+		if err != nil {
+			return err
+		}
+		return b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE, db, tbl, nil)
+	case *tree.DropTable:
+		p := pn.(*DropTable)
+		for i := range p.Ids {
+			if err := b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_DROP, p.Dbs[i], p.Ids[i], nil); err != nil {
+				return err
+			}
+		}
+	case *tree.CreateIndex:
+		db, tbl, err := b.tableInfo(stmt.Table)
+		if err != nil {
+			return err
+		}
+		return b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_INDEX, db, tbl, nil)
+	case *tree.DropIndex:
+		db, tbl, err := b.tableInfo(stmt.TableName)
+		if err != nil {
+			return err
+		}
+		return b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_INDEX, db, tbl, nil)
+	case *tree.ShowCreateTable:
+		db, tbl, err := b.tableInfo(stmt.Name.ToTableName())
+		if err != nil {
+			return err
+		}
+		return b.checkAnyPrivilege(db, tbl)
+	case *tree.ShowColumns:
+		name := stmt.Table.ToTableName()
+		if len(stmt.DBName) > 0 {
+			name.SchemaName = tree.Identifier(stmt.DBName)
+		}
+		db, tbl, err := b.tableInfo(name)
+		if err != nil {
+			return err
+		}
+		return b.checkAnyPrivilege(db, tbl)
+	case *tree.ShowIndex:
+		db, tbl, err := b.tableInfo(stmt.TableName)
+		if err != nil {
+			return err
+		}
+		return b.checkAnyPrivilege(db, tbl)
+	}
+	return nil
+}
+
+// checkScopePrivilege checks the privilege on the columns of the relations used by the scope.
+func (b *build) checkScopePrivilege(priv tree.PrivilegeType, s *Scope) error {
+	for _, rel := range s.relations() {
+		attrs := make([]string, 0, len(rel.Attrs))
+		for attr := range rel.Attrs {
+			attrs = append(attrs, attr)
+		}
+		sort.Strings(attrs)
+		if err := b.checkObjectPrivilege(priv, rel.Schema, rel.Name, attrs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkJoinedPrivilege checks the select privilege on the relations which
+// are only read by the update or delete of the tables, and on the columns
+// of the tables which are read by the statement.
+func (b *build) checkJoinedPrivilege(s *Scope, tbls []*ModifiedTable) error {
+	for _, tbl := range tbls {
+		if len(tbl.ReadAttrs) == 0 {
+			continue
+		}
+		attrs := append([]string{}, tbl.ReadAttrs...)
+		sort.Strings(attrs)
+		if err := b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, tbl.Schema, tbl.Name, attrs); err != nil {
+			return err
+		}
+	}
+	for _, rel := range s.relations() {
+		modified := false
+		for _, tbl := range tbls {
+			if rel.Schema == tbl.Schema && rel.Name == tbl.Name {
+				modified = true
+				break
+			}
+		}
+		if modified {
+			continue
+		}
+		attrs := make([]string, 0, len(rel.Attrs))
+		for attr := range rel.Attrs {
+			attrs = append(attrs, attr)
+		}
+		sort.Strings(attrs)
+		if err := b.checkObjectPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, rel.Schema, rel.Name, attrs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkObjectPrivilege checks the privilege on the database or the table, or on all the columns of the table.
+// The privilege on the table itself is required if there is no column.
+func (b *build) checkObjectPrivilege(priv tree.PrivilegeType, db, table string, attrs []string) error {
+	// everyone can read information_schema
+	if priv == tree.PRIVILEGE_TYPE_STATIC_SELECT && infoschema.IsName(db) {
+		return nil
+	}
+	if b.pc.HasPrivilege(priv, db, table, "") {
+		return nil
+	}
+	if len(table) != 0 && len(attrs) > 0 {
+		granted := true
+		for _, attr := range attrs {
+			if !b.pc.HasPrivilege(priv, db, table, attr) {
+				granted = false
+				break
+			}
+		}
+		if granted {
+			return nil
+		}
+	}
+	name := db
+	if len(table) != 0 {
+		name = fmt.Sprintf("%s.%s", db, table)
+	}
+	return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("%s command denied for '%s'", strings.ToUpper(priv.ToString()), name))
+}
+
+// checkAnyPrivilege checks that some privilege is granted on the table or on
+// a column of it, which is required to show the definition of the table.
+func (b *build) checkAnyPrivilege(db, table string) error {
+	if infoschema.IsName(db) || b.pc.HasAnyPrivilege(db, table, "") {
+		return nil
+	}
+	return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("SELECT command denied for '%s.%s'", db, table))
+}
+
+// relations returns the relations used by the scope.
+func (s *Scope) relations() []*Relation {
+	var rels []*Relation
+	switch op := s.Op.(type) {
+	case *Relation:
+		rels = append(rels, op)
+	case *CTEScan:
+		if op.Table == CTEResult { // the other tables are read by the cte itself
+			rels = append(rels, op.CTE.Scope.relations()...)
+			if op.CTE.Recursive != nil {
+				rels = append(rels, op.CTE.Recursive.relations()...)
+			}
+		}
+	case *Apply:
+		if op.Outer != nil {
+			rels = append(rels, op.Outer.relations()...)
+		}
+		rels = append(rels, op.Inner.relations()...)
+	}
+	for _, child := range s.Children {
+		rels = append(rels, child.relations()...)
+	}
+	return rels
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	tpeEngine "github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
+	"github.com/stretchr/testify/require"
+)
+
+// testChecker grants the privileges on the objects in the form of "db.table.column",
+// the privileges on the database or the table are granted on its tables or columns.
+type testChecker map[string][]tree.PrivilegeType
+
+func (c testChecker) HasPrivilege(priv tree.PrivilegeType, db, table, column string) bool {
+	objects := []string{fmt.Sprintf("%s..", db)}
+	if len(table) != 0 {
+		objects = append(objects, fmt.Sprintf("%s.%s.", db, table))
+	}
+	if len(column) != 0 {
+		objects = append(objects, fmt.Sprintf("%s.%s.%s", db, table, column))
+	}
+	for _, obj := range objects {
+		for _, p := range c[obj] {
+			if p == priv {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c testChecker) HasAnyPrivilege(db, table, _ string) bool {
+	for obj, privs := range c {
+		names := strings.Split(obj, ".")
+		if names[0] == db && (len(names[1]) == 0 || names[1] == table) && len(privs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCheckPrivilege(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	selectPriv := tree.PRIVILEGE_TYPE_STATIC_SELECT
+	checker := testChecker{
+		"test.t1.spID":   {selectPriv},
+		"test.t1.userID": {selectPriv},
+		"test.R.":        {selectPriv, tree.PRIVILEGE_TYPE_STATIC_INSERT},
+		"test.S.":        {tree.PRIVILEGE_TYPE_STATIC_DROP},
+		"test..":         {tree.PRIVILEGE_TYPE_STATIC_CREATE},
+	}
+
+	build := func(sql string) error {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		b := New("test", sql, e)
+		b.SetPrivilegeChecker(checker)
+		_, err = b.BuildStatement(stmt)
+		return err
+	}
+
+	allowed := []string{
+		"select spID, userID from t1",
+		"select userID from t1 where spID > 1 order by userID",
+		"select * from R",
+		"insert into R values ('1', 1, 1.0)",
+		"create table t2 (a int)",
+		"drop table S",
+	}
+	for _, sql := range allowed {
+		require.NoError(t, build(sql), sql)
+	}
+
+	denied := []string{
+		"select * from t1",
+		"select spID from t1 where score > 1",
+		"select * from R join S on R.uid = S.uid",
+		"insert into t1 values (1, 1, 1)",
+		"drop table R",
+		"create database db2",
+		"drop database test",
+	}
+	for _, sql := range denied {
+		require.Error(t, build(sql), sql)
+	}
+
+	//some privilege on the table is required to show it
+	e = infoschema.New(e, "127.0.0.1", nil)
+	checker = testChecker{
+		"test.R.uid": {selectPriv},
+		"test.S.":    {tree.PRIVILEGE_TYPE_STATIC_INSERT},
+	}
+	for _, sql := range []string{
+		"show create table R",
+		"show columns from R",
+		"show index from S",
+		"show columns from information_schema.columns",
+	} {
+		require.NoError(t, build(sql), sql)
+	}
+	for _, sql := range []string{
+		"show create table t1",
+		"show columns from T",
+		"show index from t1",
+	} {
+		require.Error(t, build(sql), sql)
+	}
+
+	//the privileges are not checked without the checker
+	stmt, err := parsers.ParseOne(dialect.MYSQL, "select * from t1")
+	require.NoError(t, err)
+	_, err = New("test", "select * from t1", e).BuildStatement(stmt)
+	require.NoError(t, err)
+}
+
+// TestCheckModifyPrivilege checks the select privilege on the columns which
+// are read by update and delete, the modified tables need primary keys.
+func TestCheckModifyPrivilege(t *testing.T) {
+	e, err := tpeEngine.NewTpeEngine(&tpeEngine.TpeConfig{
+		KvType:                    tuplecodec.KV_MEMORY,
+		SerialType:                tuplecodec.ST_JSON,
+		ValueLayoutSerializerType: "default",
+		KVLimit:                   10000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, e.Create(0, "test", 0))
+	for _, sql := range []string{
+		"create table m1 (a int primary key, b int, c int)",
+		"create table m2 (a int primary key, d int)",
+		"create table m3 (a int primary key, e int)",
+	} {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		pn, err := New("test", sql, e).BuildStatement(stmt)
+		require.NoError(t, err)
+		p := pn.(*CreateTable)
+		require.NoError(t, p.Db.Create(0, p.Id, p.Defs))
+	}
+
+	selectPriv := tree.PRIVILEGE_TYPE_STATIC_SELECT
+	deletePriv := tree.PRIVILEGE_TYPE_STATIC_DELETE
+	checker := testChecker{
+		"test.m1.":  {deletePriv},
+		"test.m1.a": {selectPriv},
+		"test.m1.c": {tree.PRIVILEGE_TYPE_STATIC_UPDATE},
+		"test.m2.":  {selectPriv},
+		"test.m3.":  {deletePriv},
+	}
+	build := func(sql string) error {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+		require.NoError(t, err)
+		b := New("test", sql, e)
+		b.SetPrivilegeChecker(checker)
+		_, err = b.BuildStatement(stmt)
+		return err
+	}
+
+	allowed := []string{
+		"update m1 set c = 1",
+		"update m1 set c = a + 1 where a > 1 order by a limit 1",
+		"update m1 join m2 on m1.a = m2.a set c = m2.d",
+		"delete from m3",
+		"delete from m1 where a > 1",
+		"delete m1 from m1 join m2 on m1.a = m2.a where m2.d > 1",
+	}
+	for _, sql := range allowed {
+		require.NoError(t, build(sql), sql)
+	}
+
+	denied := []string{
+		"update m1 set c = c + 1",
+		"update m1 set c = 1 where b > 1",
+		"update m1 set c = 1 order by b",
+		"update m1 set b = 1",
+		"delete from m3 where e > 1",
+		"delete m3 from m3 join m2 on m3.a = m2.a",
+		"delete m1 from m1 join m3 on m1.a = m3.a",
+	}
+	for _, sql := range denied {
+		require.Error(t, build(sql), sql)
+	}
+}
@@ -24,7 +24,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func (b *build) buildProjection(exprs tree.SelectExprs, proj *Projection, qry *Query) (*ResultProjection, error) {
+func (b *build) buildProjection(exprs tree.SelectExprs, proj *Projection, qry *Query) (*ResultProjection, []*Window, error) {
+	var ws []*Window
+
 	op := new(ResultProjection)
 	for _, expr := range exprs {
 		if _, ok := expr.Expr.(tree.UnqualifiedStar); ok {
@@ -44,15 +46,33 @@ func (b *build) buildProjection(exprs tree.SelectExprs, proj *Projection, qry *Q
 			}
 			continue
 		}
+		if fe, ok := expr.Expr.(*tree.FuncExpr); ok && fe.WindowSpec != nil {
+			alias := string(expr.As)
+			if len(alias) == 0 {
+				alias = tree.String(&expr, dialect.MYSQL)
+			}
+			var f *WindowFunction
+			var err error
+			if ws, f, err = b.buildWindow(fe, alias, proj, ws, qry); err != nil {
+				return nil, nil, err
+			}
+			op.Rs = append(op.Rs, 0)
+			op.Es = append(op.Es, &extend.Attribute{
+				Name: alias,
+				Type: f.Type.Oid,
+			})
+			op.As = append(op.As, alias)
+			continue
+		}
 		e, err := b.buildProjectionExpr(expr.Expr, qry)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if e, err = b.pruneExtend(e, true); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if len(e.Attributes()) == 0 {
-			return nil, errors.New(errno.FeatureNotSupported, "projection attributes is empty")
+			return nil, nil, errors.New(errno.FeatureNotSupported, "projection attributes is empty")
 		}
 		proj.Rs = append(proj.Rs, 0)
 		proj.Es = append(proj.Es, e)
@@ -74,7 +94,7 @@ func (b *build) buildProjection(exprs tree.SelectExprs, proj *Projection, qry *Q
 			op.As = append(op.As, tree.String(&expr, dialect.MYSQL))
 		}
 	}
-	return op, nil
+	return op, ws, nil
 }
 
 func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
@@ -83,6 +103,8 @@ func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, err
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
 		return b.buildProjectionExpr(e.Expr, qry)
+	case *tree.ParamExpr:
+		return b.buildParam(e, qry, b.buildProjectionExpr)
 	case *tree.OrExpr:
 		return b.buildOr(e, qry, b.buildProjectionExpr)
 	case *tree.NotExpr:
@@ -101,6 +123,8 @@ func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, err
 		return b.buildCast(e, qry, b.buildProjectionExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildProjectionExpr)
+	case *tree.FullTextMatchExpr:
+		return b.buildMatch(e, qry, b.buildProjectionExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
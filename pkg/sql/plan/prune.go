@@ -66,6 +66,16 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 		if n.Right, err = b.pruneExtend(n.Right, false); err != nil {
 			return nil, err
 		}
+		if b.isParam(n.Left) || b.isParam(n.Right) {
+			// a parameter compared with or computed with an attribute is only
+			// converted into the type of the attribute, otherwise its value
+			// is folded
+			_, lok := n.Left.(*extend.ValueExtend)
+			_, rok := n.Right.(*extend.ValueExtend)
+			if (lok && rok) || n.Op == overload.Or || n.Op == overload.And {
+				b.folded = true
+			}
+		}
 		switch n.Op {
 		case overload.Or:
 			return b.pruneOr(n)
@@ -142,6 +152,9 @@ func (b *build) pruneProjectionNot(e *extend.UnaryExtend) (extend.Extend, error)
 	if !ok {
 		return e, nil
 	}
+	if b.isParam(v) {
+		b.folded = true
+	}
 	{
 		ok = false
 		switch v.V.Typ.Oid {
@@ -202,6 +215,10 @@ func splitNotBinary(e *extend.BinaryExtend) extend.Extend {
 		return &extend.BinaryExtend{Op: overload.GE, Left: logicInverse(e.Left), Right: logicInverse(e.Right)}
 	case overload.NE:
 		return &extend.BinaryExtend{Op: overload.EQ, Left: logicInverse(e.Left), Right: logicInverse(e.Right)}
+	case overload.Like:
+		return &extend.BinaryExtend{Op: overload.NotLike, Left: e.Left, Right: e.Right}
+	case overload.NotLike:
+		return &extend.BinaryExtend{Op: overload.Like, Left: e.Left, Right: e.Right}
 	}
 	return e
 }
@@ -211,6 +228,9 @@ func (b *build) pruneUnaryMinus(e *extend.UnaryExtend) (extend.Extend, error) {
 	if !ok {
 		return e, nil
 	}
+	if b.isParam(v) {
+		b.folded = true
+	}
 	return Neg(v)
 }
 
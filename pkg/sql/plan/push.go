@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
@@ -29,6 +30,8 @@ func isCAQ(flg bool, s *Scope) bool {
 	switch s.Op.(type) {
 	case *Order:
 		return isCAQ(flg, s.Children[0])
+	case *Window:
+		return isCAQ(flg, s.Children[0])
 	case *Dedup:
 		return isCAQ(flg, s.Children[0])
 	case *Limit:
@@ -58,6 +61,8 @@ func pushDownUntransform(s *Scope, fvars []string) {
 	switch op := s.Op.(type) {
 	case *Order:
 		pushDownUntransform(s.Children[0], fvars)
+	case *Window:
+		pushDownUntransform(s.Children[0], fvars)
 	case *Dedup:
 		pushDownUntransform(s.Children[0], fvars)
 	case *Limit:
@@ -72,7 +77,7 @@ func pushDownUntransform(s *Scope, fvars []string) {
 			s.Children[0] = s.Children[0].Children[0]
 			return
 		}
-		if _, ok := s.Children[0].Op.(*Join); ok {
+		if js := getJoinScope(s.Children[0]); js != nil {
 			child := s.Children[0]
 			rs := &Scope{
 				Name:     child.Name,
@@ -102,8 +107,8 @@ func pushDownUntransform(s *Scope, fvars []string) {
 			}
 			rs.Op = &Untransform{rfvars}
 			s.Children = []*Scope{rs}
-			for i := range child.Children {
-				pushDownUntransform(child.Children[i], rfvars)
+			for i := range js.Children {
+				pushDownUntransform(js.Children[i], rfvars)
 			}
 			return
 		}
@@ -129,6 +134,27 @@ func pushDownUntransform(s *Scope, fvars []string) {
 			}
 			return
 		}
+		if rel, ok := s.Children[0].Op.(*DerivedRelation); ok {
+			// the aggregations of a derived relation are exposed by their aliases
+			mp := make(map[string]int)
+			{
+				for i, bvar := range rel.BoundVars {
+					mp[bvar.Alias] = i
+				}
+			}
+			for i := 0; i < len(op.Es); i++ {
+				if e, ok := op.Es[i].(*extend.Attribute); ok {
+					if j, ok := mp[e.Name]; ok {
+						rel.BoundVars[j].Alias = op.As[i]
+						op.Rs = append(op.Rs[:i], op.Rs[i+1:]...)
+						op.As = append(op.As[:i], op.As[i+1:]...)
+						op.Es = append(op.Es[:i], op.Es[i+1:]...)
+						i--
+					}
+				}
+			}
+			return
+		}
 		pushDownUntransform(s.Children[0], fvars)
 	case *ResultProjection:
 		pushDownUntransform(s.Children[0], fvars)
@@ -137,6 +163,18 @@ func pushDownUntransform(s *Scope, fvars []string) {
 	}
 }
 
+// getJoinScope returns the join of s, the join may be filtered by the
+// conditions out of the spanning tree of its join graph.
+func getJoinScope(s *Scope) *Scope {
+	if _, ok := s.Op.(*Restrict); ok {
+		s = s.Children[0]
+	}
+	if _, ok := s.Op.(*Join); ok {
+		return s
+	}
+	return nil
+}
+
 func pushDownRestrict(flg bool, e extend.Extend, qry *Query) extend.Extend {
 	if pushDownRestrictExtend(e, qry) {
 		return nil
@@ -201,6 +239,13 @@ func (s *Scope) pushDownJoinAttribute(attrs []string) {
 	switch op := s.Op.(type) {
 	case *Join:
 		jattrs := make([][]string, len(s.Children))
+		for _, attr := range attrs {
+			for i := range s.Children {
+				if _, ok := s.Children[i].Result.AttrsMap[attr]; ok {
+					jattrs[i] = append(jattrs[i], attr)
+				}
+			}
+		}
 		for _, vs := range op.Vars {
 			for _, v := range vs {
 				name := strconv.Itoa(v)
@@ -211,11 +256,25 @@ func (s *Scope) pushDownJoinAttribute(attrs []string) {
 				}
 			}
 		}
+		if op.Cond != nil {
+			for _, attr := range op.Cond.Attributes() {
+				for i := range s.Children {
+					if _, ok := s.Children[i].Result.AttrsMap[attr]; ok {
+						jattrs[i] = append(jattrs[i], attr)
+					}
+				}
+			}
+		}
 		for i := 0; i < len(s.Children); i++ {
+			if len(jattrs[i]) == 0 && len(s.Children[i].Result.Attrs) > 0 { // relation of a cross product
+				jattrs[i] = append(jattrs[i], s.Children[i].Result.Attrs[0])
+			}
 			s.Children[i].pushDownJoinAttribute(jattrs[i])
 		}
 	case *Order:
 		s.Children[0].pushDownJoinAttribute(attrs)
+	case *Window:
+		s.Children[0].pushDownJoinAttribute(attrs)
 	case *Dedup:
 		s.Children[0].pushDownJoinAttribute(attrs)
 	case *Limit:
@@ -223,7 +282,8 @@ func (s *Scope) pushDownJoinAttribute(attrs []string) {
 	case *Offset:
 		s.Children[0].pushDownJoinAttribute(attrs)
 	case *Restrict:
-		s.Children[0].pushDownJoinAttribute(attrs)
+		// the attributes of the condition must not be aggregated before it
+		s.Children[0].pushDownJoinAttribute(append(attrs, op.E.Attributes()...))
 	case *Projection:
 		if len(attrs) > 0 {
 			mp := make(map[string]int)
@@ -281,7 +341,9 @@ func pushDownProjection(e extend.Extend, qry *Query) (*Scope, extend.Extend, boo
 	case 1:
 		var name string
 
-		s = findScopeWithAttribute(attrs[0], &name, qry.Scope)
+		if s = findScopeWithAttribute(attrs[0], &name, qry.Scope); s == nil {
+			return nil, nil, false
+		}
 	default:
 		var name string
 
@@ -415,6 +477,22 @@ func pushDownRestrictExtend(e extend.Extend, qry *Query) bool {
 	return true
 }
 
+// relationAttribute returns the attribute of the scope s named name, the
+// name may be qualified by the scope if it is ambiguous in a cross product,
+// as it is when a table is joined with itself under two aliases.
+func relationAttribute(name string, s *Scope) (string, bool) {
+	if _, ok := s.Result.AttrsMap[name]; ok {
+		return name, true
+	}
+	if len(s.Name) > 0 && strings.HasPrefix(name, s.Name+".") {
+		attr := name[len(s.Name)+1:]
+		if _, ok := s.Result.AttrsMap[attr]; ok {
+			return attr, true
+		}
+	}
+	return "", false
+}
+
 func findScopeWithAttribute(name string, alias *string, s *Scope) *Scope {
 	switch op := s.Op.(type) {
 	case *Join:
@@ -424,16 +502,21 @@ func findScopeWithAttribute(name string, alias *string, s *Scope) *Scope {
 			}
 		}
 	case *Relation:
-		if _, ok := s.Result.AttrsMap[name]; ok {
-			*alias = name
+		if attr, ok := relationAttribute(name, s); ok {
+			*alias = attr
 			return s
 		}
 	case *DerivedRelation:
-		if _, ok := s.Result.AttrsMap[name]; ok {
-			*alias = name
+		if attr, ok := relationAttribute(name, s); ok {
+			*alias = attr
 			return s
 		}
+	case *Restrict:
+		return findScopeWithAttribute(name, alias, s.Children[0])
 	case *Rename:
+		if attr, ok := relationAttribute(name, s); ok {
+			name = attr
+		}
 		for i := range op.Es {
 			if op.As[i] == name {
 				if e, ok := op.Es[i].(*extend.Attribute); ok {
@@ -442,6 +525,9 @@ func findScopeWithAttribute(name string, alias *string, s *Scope) *Scope {
 			}
 		}
 	case *Projection:
+		if attr, ok := relationAttribute(name, s); ok {
+			name = attr
+		}
 		for i := range op.Es {
 			if op.As[i] == name {
 				if e, ok := op.Es[i].(*extend.Attribute); ok {
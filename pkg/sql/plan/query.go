@@ -40,3 +40,30 @@ func (qry *Query) Pop() *ScopeSet {
 	qry.Stack = qry.Stack[:n]
 	return ss
 }
+
+// Reusable returns true if the plan can be compiled and executed more than once,
+// which is the plan of a query that reads no common table expressions, json
+// tables and apply tables, because their rows are kept in the plan and consumed
+// by the execution. Neither is the plan of a query whose full-text searches are
+// resolved, the statistics of the tables change as the rows are written, or
+// whose parameters are folded into the plan.
+func Reusable(pn Plan) bool {
+	qry, ok := pn.(*Query)
+	if !ok || qry.Resolved || qry.Folded {
+		return false
+	}
+	return reusableScope(qry.Scope)
+}
+
+func reusableScope(s *Scope) bool {
+	switch s.Op.(type) {
+	case *CTEScan, *JSONTable, *Apply:
+		return false
+	}
+	for _, c := range s.Children {
+		if !reusableScope(c) {
+			return false
+		}
+	}
+	return true
+}
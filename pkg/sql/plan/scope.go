@@ -63,6 +63,25 @@ func (s *Scope) Prune(attrsMap map[string]uint64, fvars []string) {
 			attrsMap[f.Attr]++
 		}
 		s.Children[0].Prune(attrsMap, fvars)
+	case *Window:
+		for _, f := range op.Functions {
+			delete(attrsMap, f.Alias)
+		}
+		for _, attr := range op.Partitions {
+			attrsMap[attr]++
+		}
+		for _, f := range op.Fs {
+			attrsMap[f.Attr]++
+		}
+		for _, f := range op.Functions {
+			if len(f.Attr) > 0 {
+				attrsMap[f.Attr]++
+			}
+			if len(f.Default) > 0 {
+				attrsMap[f.Default]++
+			}
+		}
+		s.Children[0].Prune(attrsMap, fvars)
 	case *Dedup:
 		s.Children[0].Prune(attrsMap, fvars)
 	case *Limit:
@@ -258,6 +277,22 @@ func (s *Scope) Prune(attrsMap map[string]uint64, fvars []string) {
 			attrsMap[fvar]++
 		}
 		s.Children[0].Prune(attrsMap, op.FreeVars)
+	case *JSONTable:
+		if op.E == nil { // the rows are computed while building the plan
+			break
+		}
+		mp := make(map[string]uint64)
+		for _, attr := range s.Result.Attrs {
+			ref := attrsMap[attr]
+			s.Result.AttrsMap[attr].Ref = int(ref)
+			if _, ok := s.Children[0].Result.AttrsMap[attr]; ok && ref > 0 {
+				mp[attr] += ref
+			}
+		}
+		for _, attr := range op.E.Attributes() {
+			mp[attr]++
+		}
+		s.Children[0].Prune(mp, nil)
 	case *Rename:
 		for i := 0; i < len(op.As); i++ {
 			if ref, ok := attrsMap[op.As[i]]; ok {
@@ -279,7 +314,68 @@ func (s *Scope) Prune(attrsMap map[string]uint64, fvars []string) {
 			}
 		}
 		s.Children[0].Prune(mp, fvars)
+	case *SetOperation:
+		// all the attributes of the children are required, which are pruned
+		// when the children are built.
+	}
+}
+
+// isTableAttribute returns true if attr is a column of the table named
+// table which is under the scope.
+func (s *Scope) isTableAttribute(table, attr string) bool {
+	switch op := s.Op.(type) {
+	case *Relation, *DerivedRelation, *Rename:
+		// the derived relation of an outer join has no name and the tables
+		// of the join are visible through it
+		if len(s.Name) > 0 || len(s.Children) == 0 {
+			if s.Name != table {
+				return false
+			}
+			_, ok := s.Result.AttrsMap[attr]
+			return ok
+		}
+	case *JSONTable:
+		// the columns of a json table over a table are the columns of the
+		// json table and the table
+		if op.Name == table {
+			for _, name := range op.Attrs {
+				if name == attr {
+					return true
+				}
+			}
+		}
+	}
+	for _, child := range s.Children {
+		if child.isTableAttribute(table, attr) {
+			return true
+		}
+	}
+	return false
+}
+
+// findRelation returns the table which the attribute of the name belongs
+// to, table is the qualifier of the name and is empty if there is none.
+func (s *Scope) findRelation(table, attr string) *Relation {
+	switch op := s.Op.(type) {
+	case *Relation:
+		if len(table) > 0 && s.Name != table {
+			return nil
+		}
+		if _, ok := op.Attrs[attr]; ok {
+			return op
+		}
+		return nil
+	case *DerivedRelation, *Rename:
+		if len(table) > 0 && s.Name == table {
+			table = "" // the alias of a table
+		}
+	}
+	for _, child := range s.Children {
+		if rel := child.findRelation(table, attr); rel != nil {
+			return rel
+		}
 	}
+	return nil
 }
 
 func (s *Scope) getAggregations() []*Aggregation {
@@ -293,6 +389,8 @@ func (s *Scope) getAggregations() []*Aggregation {
 		return aggs
 	case *Order:
 		return s.Children[0].getAggregations()
+	case *Window:
+		return s.Children[0].getAggregations()
 	case *Dedup:
 		return s.Children[0].getAggregations()
 	case *Limit:
@@ -321,6 +419,8 @@ func (s *Scope) pruneProjection(aggs []*Aggregation) {
 	switch op := s.Op.(type) {
 	case *Order:
 		s.Children[0].pruneProjection(aggs)
+	case *Window:
+		s.Children[0].pruneProjection(aggs)
 	case *Dedup:
 		s.Children[0].pruneProjection(aggs)
 	case *Limit:
@@ -363,11 +463,14 @@ func (s *Scope) pruneProjection(aggs []*Aggregation) {
 func (s *Scope) reset() {
 	switch op := s.Op.(type) {
 	case *Join:
+		op.Result = op.Result[:0]
 		for i := 0; i < len(s.Children); i++ {
 			s.Children[i].reset()
 		}
 	case *Order:
 		s.Children[0].reset()
+	case *Window:
+		s.Children[0].reset()
 	case *Dedup:
 		s.Children[0].reset()
 	case *Limit:
@@ -401,6 +504,10 @@ func (s *Scope) reset() {
 		s.Children[0].reset()
 	case *Rename:
 		s.Children[0].reset()
+	case *JSONTable:
+		if op.E != nil {
+			s.Children[0].reset()
+		}
 	}
 }
 
@@ -431,6 +538,25 @@ func (s *Scope) classifying() []map[string]uint64 {
 			}
 		}
 	}
+	for _, e := range []extend.Extend{op.Cond, op.NotIn} {
+		if e == nil {
+			continue
+		}
+		for _, attr := range e.Attributes() {
+			for i := range s.Children {
+				if _, ok := s.Children[i].Result.AttrsMap[attr]; ok {
+					ms[i][attr]++
+				}
+			}
+		}
+	}
+	{ // a relation of a cross product is read with one of its attributes at least
+		for i := range s.Children {
+			if len(ms[i]) == 0 && len(s.Children[i].Result.Attrs) > 0 {
+				ms[i][s.Children[i].Result.Attrs[0]]++
+			}
+		}
+	}
 	return ms
 }
 
@@ -442,6 +568,8 @@ func (s *Scope) registerRelations(qry *Query) {
 		}
 	case *Order:
 		s.Children[0].registerRelations(qry)
+	case *Window:
+		s.Children[0].registerRelations(qry)
 	case *Dedup:
 		s.Children[0].registerRelations(qry)
 	case Limit:
@@ -471,7 +599,9 @@ func (s *Scope) registerRelations(qry *Query) {
 			s.Children[0].registerRelations(qry)
 		}
 	case *DerivedRelation:
-		qry.RenameRels[s.Name] = s
+		if len(s.Name) > 0 { // outer joins are derived relations without name
+			qry.RenameRels[s.Name] = s
+		}
 	}
 }
 
@@ -485,6 +615,8 @@ func printScopes(prefix []byte, ss []*Scope, buf *bytes.Buffer) {
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printJoin(op, s)))
 		case *Order:
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printOrder(op)))
+		case *Window:
+			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printWindow(op)))
 		case *Dedup:
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printDedup(op)))
 		case *Limit:
@@ -505,14 +637,48 @@ func printScopes(prefix []byte, ss []*Scope, buf *bytes.Buffer) {
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printUntransform(op)))
 		case *DerivedRelation:
 			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printDerivedRelation(op, s)))
+		case *JSONTable:
+			if op.E != nil {
+				buf.WriteString(fmt.Sprintf("%sjson table(%s): %v\n", prefix, op.E, op.Attrs))
+			} else {
+				buf.WriteString(fmt.Sprintf("%sjson table: %v\n", prefix, op.Bat.Attrs))
+			}
+		case *SetOperation:
+			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printSetOperation(op)))
+		case *CTEScan:
+			buf.WriteString(fmt.Sprintf("%s%v\n", prefix, printCTEScan(op)))
+		case *Apply:
+			buf.WriteString(fmt.Sprintf("%sapply(%s)\n", prefix, op.Name))
 		}
 	}
 }
 
 func printJoin(op *Join, s *Scope) string {
+	if op.NotIn != nil {
+		if op.Cond != nil {
+			return fmt.Sprintf("⨝(%v[%v], [%v], %s, NOT IN %s)", op.Type, op.Vars, op.Result, op.Cond, op.NotIn)
+		}
+		return fmt.Sprintf("⨝(%v[%v], [%v], NOT IN %s)", op.Type, op.Vars, op.Result, op.NotIn)
+	}
+	if op.Cond != nil {
+		return fmt.Sprintf("⨝(%v[%v], [%v], %s)", op.Type, op.Vars, op.Result, op.Cond)
+	}
 	return fmt.Sprintf("⨝(%v[%v], [%v])", op.Type, op.Vars, op.Result)
 }
 
+func printSetOperation(op *SetOperation) string {
+	name := [...]string{UNION: "∪", INTERSECT: "∩", EXCEPT: "−"}[op.Type]
+	if op.All {
+		return fmt.Sprintf("%s(all)", name)
+	}
+	return fmt.Sprintf("%s()", name)
+}
+
+func printCTEScan(op *CTEScan) string {
+	name := [...]string{CTEResult: "cte", CTEWorking: "working table", CTEIntermediate: "intermediate table"}[op.Table]
+	return fmt.Sprintf("%s(%s)", name, op.CTE.Name)
+}
+
 func printDedup(op *Dedup) string {
 	return fmt.Sprintf("δ()")
 }
@@ -31,17 +31,28 @@ func (b *build) buildSelectStatement(stmt tree.SelectStatement, qry *Query) erro
 		return b.buildSelect(stmt.Select, qry)
 	case *tree.SelectClause:
 		return b.buildSelectClause(stmt, nil, qry)
+	case *tree.UnionClause:
+		return b.buildUnion(stmt, nil, qry)
 	}
 	return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement '%T'", stmt))
 }
 
 func (b *build) buildSelect(stmt *tree.Select, qry *Query) error {
+	defer func(n int) {
+		b.ctes = b.ctes[:n]
+	}(len(b.ctes))
+	if err := b.pushCTEs(stmt); err != nil {
+		return err
+	}
 	fetch := stmt.Limit
 	wrapped := stmt.Select
 	orderBy := stmt.OrderBy
 	for s, ok := wrapped.(*tree.ParenSelect); ok; s, ok = wrapped.(*tree.ParenSelect) {
 		stmt = s.Select
 		wrapped = stmt.Select
+		if err := b.pushCTEs(stmt); err != nil {
+			return err
+		}
 		if stmt.OrderBy != nil {
 			if orderBy != nil {
 				return errors.New(errno.SyntaxErrororAccessRuleViolation, "multiple ORDER BY clauses not allowed")
@@ -66,6 +77,10 @@ func (b *build) buildSelectWithoutParens(stmt tree.SelectStatement, orderBy tree
 		if err := b.buildSelectClause(stmt, orderBy, qry); err != nil {
 			return err
 		}
+	case *tree.UnionClause:
+		if err := b.buildUnion(stmt, orderBy, qry); err != nil {
+			return err
+		}
 	default:
 		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", stmt))
 	}
@@ -87,6 +102,9 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 	if stmt.From == nil {
 		return errors.New(errno.SQLStatementNotYetComplete, "need from clause")
 	}
+	if stmt, err = b.buildSubqueries(stmt); err != nil {
+		return err
+	}
 	if err = b.buildFrom(stmt.From.Tables, qry); err != nil {
 		return err
 	}
@@ -103,7 +121,7 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 			return err
 		}
 	}
-	result, err := b.buildProjection(stmt.Exprs, proj, qry)
+	result, ws, err := b.buildProjection(stmt.Exprs, proj, qry)
 	if err != nil {
 		return err
 	}
@@ -113,7 +131,7 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		}
 	}
 	if len(orderBy) > 0 {
-		if fs, err = b.buildOrderBy(orderBy, proj, orderProj, qry); err != nil {
+		if fs, err = b.buildOrderBy(orderBy, proj, orderProj, ws, qry); err != nil {
 			return err
 		}
 	}
@@ -152,6 +170,8 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 			Flg:        true,
 			RenameRels: make(map[string]*Scope),
 			Rels:       make(map[string]map[string]*Scope),
+			Joins:      qry.Joins,
+			JSONTables: qry.JSONTables,
 		}
 		qry.Scope.registerRelations(qry0)
 		if err = b.buildFrom(stmt.From.Tables, qry0); err != nil {
@@ -160,16 +180,14 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		qry0.Scope = qry0.Pop().Scopes[0]
 		qry.Scope = qry0.Scope
 		if jp, ok := qry.Scope.Op.(*Join); ok && jp.Type == CROSS {
-			if len(jconds) > 0 {
-				ss := newScopeSet()
-				ss.Conds = jconds
-				ss.Scopes = qry.Scope.Children
-				s, err := b.buildQualifiedJoin(INNER, ss)
-				if err != nil {
-					return err
-				}
-				qry.Scope = s
+			ss := newScopeSet()
+			ss.Conds = jconds
+			ss.Scopes = qry.Scope.Children
+			s, err := b.buildQualifiedJoin(INNER, ss)
+			if err != nil {
+				return err
 			}
+			qry.Scope = s
 		}
 		qry.Scope.pushDownJoinAttribute(nil)
 	}
@@ -217,6 +235,13 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		proj.As = append(proj.As, orderProj.As...)
 		proj.Es = append(proj.Es, orderProj.Es...)
 		proj.Rs = append(proj.Rs, orderProj.Rs...)
+		if e2 != nil { // attributes of having clause which are not selected
+			for _, attr := range e2.ExtendAttributes() {
+				proj.Rs = append(proj.Rs, 0)
+				proj.As = append(proj.As, attr.Name)
+				proj.Es = append(proj.Es, attr)
+			}
+		}
 		proj = pruneProjection(proj)
 		s := &Scope{
 			Name:     qry.Scope.Name,
@@ -254,6 +279,31 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, orderBy tree.OrderBy,
 		s.Op = op
 		qry.Scope = s
 	}
+	for _, w := range ws {
+		s := &Scope{
+			Name:     qry.Scope.Name,
+			Children: []*Scope{qry.Scope},
+		}
+		{ // construct result
+			s.Result.AttrsMap = make(map[string]*Attribute)
+			for _, attr := range qry.Scope.Result.Attrs {
+				s.Result.Attrs = append(s.Result.Attrs, attr)
+				s.Result.AttrsMap[attr] = &Attribute{
+					Name: attr,
+					Type: qry.Scope.Result.AttrsMap[attr].Type,
+				}
+			}
+			for _, f := range w.Functions {
+				s.Result.Attrs = append(s.Result.Attrs, f.Alias)
+				s.Result.AttrsMap[f.Alias] = &Attribute{
+					Name: f.Alias,
+					Type: f.Type,
+				}
+			}
+		}
+		s.Op = w
+		qry.Scope = s
+	}
 	if stmt.Distinct {
 		s := &Scope{
 			Name:     qry.Scope.Name,
@@ -0,0 +1,114 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"go/constant"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
+)
+
+// showQuery is the query of a show statement, which selects the
+// rows of a table of information_schema.
+type showQuery struct {
+	table string
+	// cols are the columns of the table which are shown, names are
+	// the names of the columns in the result of the statement.
+	cols  []string
+	names []string
+	// cond is the condition on the table
+	cond tree.Expr
+	// orderBy are the columns of the table ordering the result
+	orderBy []string
+}
+
+// buildShowQuery builds the query of a show statement. The pattern of like
+// is matched against the first shown column, and where is the condition on
+// the result which refers to the columns by their names in the result.
+func (b *build) buildShowQuery(sq *showQuery, like *tree.ComparisonExpr, where *tree.Where) (Plan, error) {
+	var stmt tree.Statement
+	var exprs, outerExprs tree.SelectExprs
+
+	for i, col := range sq.cols {
+		exprs = append(exprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(col), As: tree.UnrestrictedIdentifier(sq.names[i])})
+		outerExprs = append(outerExprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(sq.names[i])})
+	}
+	orderBy := make(tree.OrderBy, len(sq.orderBy))
+	for i, col := range sq.orderBy {
+		// a shown column is ordered by its name in the result
+		name := col
+		if j := indexOfString(sq.cols, col); j >= 0 {
+			name = sq.names[j]
+		} else {
+			exprs = appendSelectExpr(exprs, tree.SetUnresolvedName(col), col)
+		}
+		orderBy[i] = tree.NewOrder(tree.SetUnresolvedName(name), tree.Ascending, false)
+	}
+	cond := sq.cond
+	if like != nil {
+		e := tree.NewComparisonExpr(tree.LIKE, tree.SetUnresolvedName(sq.cols[0]), like.Right)
+		if cond == nil {
+			cond = e
+		} else {
+			cond = tree.NewAndExpr(cond, e)
+		}
+	}
+	tbl := tree.NewTableName(tree.Identifier(sq.table), tree.ObjectNamePrefix{
+		SchemaName:     tree.Identifier(infoschema.Name),
+		ExplicitSchema: true,
+	})
+	inner := &tree.SelectClause{
+		Exprs: exprs,
+		From:  &tree.From{Tables: tree.TableExprs{tbl}},
+	}
+	if cond != nil {
+		inner.Where = tree.NewWhere(cond)
+	}
+	stmt = &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: outerExprs,
+			From: &tree.From{Tables: tree.TableExprs{
+				&tree.AliasedTableExpr{
+					Expr: &tree.ParenTableExpr{Expr: &tree.Select{Select: inner}},
+					As:   tree.AliasClause{Alias: tree.Identifier(sq.table)},
+				},
+			}},
+			Where: where,
+		},
+		OrderBy: orderBy,
+	}
+	return b.BuildStatement(rewrite.AstRewrite(stmt))
+}
+
+// stringConstant returns the string literal s
+func stringConstant(s string) tree.Expr {
+	return tree.NewNumVal(constant.MakeString(s), s, false)
+}
+
+// equalString returns the condition that col equals the string literal s
+func equalString(col, s string) tree.Expr {
+	return tree.NewComparisonExpr(tree.EQUAL, tree.SetUnresolvedName(col), stringConstant(s))
+}
+
+func indexOfString(ss []string, s string) int {
+	for i := range ss {
+		if ss[i] == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -15,23 +15,25 @@
 package plan
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/errno"
-	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func (b *build) BuildShowColumns(stmt *tree.ShowColumns, plan *ShowColumns) error {
+func (b *build) BuildShowColumns(stmt *tree.ShowColumns) (Plan, error) {
 	tbl := stmt.Table.ToTableName()
-	_, _, r, err := b.tableName(&tbl)
-	if err != nil {
-		return err
-	}
-	if stmt.Where != nil {
-		return errors.New(errno.FeatureNotSupported, "not support where for show columns")
+	if len(stmt.DBName) > 0 {
+		tbl.SchemaName = tree.Identifier(stmt.DBName)
 	}
-	if stmt.Like != nil {
-		plan.Like = []byte(stmt.Like.Right.String())
+	db, name, r, err := b.tableName(&tbl)
+	if err != nil {
+		return nil, err
 	}
-	plan.Relation = r
-	return nil
+	r.Close()
+	return b.buildShowQuery(&showQuery{
+		table:   infoschema.Columns,
+		cols:    []string{"COLUMN_NAME", "COLUMN_TYPE", "IS_NULLABLE", "COLUMN_KEY", "COLUMN_DEFAULT", "EXTRA"},
+		names:   []string{"Field", "Type", "Null", "Key", "Default", "Extra"},
+		cond:    tree.NewAndExpr(equalString("TABLE_SCHEMA", db), equalString("TABLE_NAME", name)),
+		orderBy: []string{"ORDINAL_POSITION"},
+	}, stmt.Like, stmt.Where)
 }
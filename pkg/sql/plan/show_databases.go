@@ -15,18 +15,15 @@
 package plan
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/errno"
-	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func (b *build) BuildShowDatabases(stmt *tree.ShowDatabases, plan *ShowDatabases) error {
-	if stmt.Where != nil {
-		return errors.New(errno.FeatureNotSupported, "not support where for show databases")
-	}
-	if stmt.Like != nil {
-		plan.Like = []byte(stmt.Like.Right.String())
-	}
-	plan.E = b.e
-	return nil
+func (b *build) BuildShowDatabases(stmt *tree.ShowDatabases) (Plan, error) {
+	return b.buildShowQuery(&showQuery{
+		table:   infoschema.Schemata,
+		cols:    []string{"SCHEMA_NAME"},
+		names:   []string{"Databases"},
+		orderBy: []string{"SCHEMA_NAME"},
+	}, stmt.Like, stmt.Where)
 }
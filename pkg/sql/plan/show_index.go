@@ -0,0 +1,37 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func (b *build) BuildShowIndex(stmt *tree.ShowIndex) (Plan, error) {
+	tbl := stmt.TableName
+	db, name, r, err := b.tableName(&tbl)
+	if err != nil {
+		return nil, err
+	}
+	r.Close()
+	return b.buildShowQuery(&showQuery{
+		table: infoschema.Statistics,
+		cols: []string{"TABLE_NAME", "NON_UNIQUE", "INDEX_NAME", "SEQ_IN_INDEX", "COLUMN_NAME",
+			"INDEX_TYPE", "COMMENT"},
+		names: []string{"Table", "Non_unique", "Key_name", "Seq_in_index", "Column_name",
+			"Index_type", "Comment"},
+		cond: tree.NewAndExpr(equalString("TABLE_SCHEMA", db), equalString("TABLE_NAME", name)),
+	}, nil, stmt.Where)
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// BuildShowProcessList builds the query of show processlist, the
+// statements are not truncated even without the full option.
+func (b *build) BuildShowProcessList(_ *tree.ShowProcessList) (Plan, error) {
+	return b.buildShowQuery(&showQuery{
+		table:   infoschema.ProcessList,
+		cols:    []string{"ID", "USER", "HOST", "DB", "COMMAND", "TIME", "STATE", "INFO"},
+		names:   []string{"Id", "User", "Host", "db", "Command", "Time", "State", "Info"},
+		orderBy: []string{"ID"},
+	}, nil, nil)
+}
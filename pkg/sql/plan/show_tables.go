@@ -17,23 +17,27 @@ package plan
 import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func (b *build) BuildShowTables(stmt *tree.ShowTables, plan *ShowTables) error {
+func (b *build) BuildShowTables(stmt *tree.ShowTables) (Plan, error) {
 	if len(stmt.DBName) == 0 {
 		stmt.DBName = b.db
 	}
-	db, err := b.e.Database(stmt.DBName)
-	if err != nil {
-		return errors.New(errno.InvalidSchemaName, err.Error())
+	if _, err := b.e.Database(stmt.DBName); err != nil {
+		return nil, errors.New(errno.InvalidSchemaName, err.Error())
 	}
-	if stmt.Where != nil {
-		return errors.New(errno.FeatureNotSupported, "not support where for show tables")
+	sq := &showQuery{
+		table:   infoschema.Tables,
+		cols:    []string{"TABLE_NAME"},
+		names:   []string{"Tables"},
+		cond:    equalString("TABLE_SCHEMA", stmt.DBName),
+		orderBy: []string{"TABLE_NAME"},
 	}
-	if stmt.Like != nil {
-		plan.Like = []byte(stmt.Like.Right.String())
+	if stmt.Full {
+		sq.cols = append(sq.cols, "TABLE_TYPE")
+		sq.names = append(sq.names, "Table_type")
 	}
-	plan.Db = db
-	return nil
+	return b.buildShowQuery(sq, stmt.Like, stmt.Where)
 }
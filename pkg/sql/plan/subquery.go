@@ -0,0 +1,815 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+// join types of the joined tables built for the subqueries, such a joined
+// table stands for its left side in the from clause
+const (
+	joinTypeSemi   = "SEMI"
+	joinTypeAnti   = "ANTI"
+	joinTypeNotIn  = "NOT IN" // null-aware anti join on its first condition
+	joinTypeScalar = "SCALAR" // left join with the result of a scalar subquery
+)
+
+// decorrelator rewrites the subqueries of a select clause into joins of the
+// tables of the from clause with derived tables.
+type decorrelator struct {
+	b      *build
+	tbls   tree.TableExprs
+	scopes []*Scope // scopes of the tables of the from clause
+}
+
+// subquery is a subquery split into the derived table and the conditions
+// which correlate it with the outer query.
+type subquery struct {
+	clause *tree.SelectClause
+	alias  string
+	exprs  tree.SelectExprs // exported expressions of the derived table
+	conds  []tree.Expr      // conditions of the inner query only
+	keys   []tree.Expr      // equalities of an outer attribute and an exported expression
+	es     []tree.Expr      // other conditions using the outer attributes
+	tbls   map[int]struct{} // tables of the from clause used by the subquery
+	apply  bool             // a scalar subquery correlated by other conditions than equalities
+
+	isOuter func(*tree.UnresolvedName) (int, bool)
+}
+
+func isSubqueryJoin(stmt *tree.JoinTableExpr) bool {
+	switch stmt.JoinType {
+	case joinTypeSemi, joinTypeAnti, joinTypeNotIn, joinTypeScalar:
+		return true
+	}
+	return false
+}
+
+// buildSubqueries decorrelates the subqueries of the where clause and the
+// select list of stmt. The subqueries of EXISTS, NOT EXISTS, IN and NOT IN
+// which are conjuncts of the where clause become semi or anti joins of a table
+// of the from clause with derived tables, the correlated conditions other than
+// equalities are applied to each candidate of the join. A scalar subquery
+// becomes a derived table of its result which is grouped by the correlated
+// attributes and left joined with a table of the from clause, or an apply
+// table of one row in the cross product of the from clause if it is not
+// correlated, unless it is compared with the attributes of a table in the
+// where clause, then the comparison is the condition of a semi join. The
+// other correlated scalar subqueries are evaluated for each value of the
+// outer attributes they use by an apply table left joined with the table.
+func (b *build) buildSubqueries(stmt *tree.SelectClause) (*tree.SelectClause, error) {
+	flg := stmt.Where != nil && hasSubquery(stmt.Where.Expr)
+	for _, expr := range stmt.Exprs {
+		flg = flg || hasSubquery(expr.Expr)
+	}
+	flg = flg || (stmt.Having != nil && hasSubquery(stmt.Having.Expr))
+	if !flg {
+		return stmt, nil
+	}
+	d := &decorrelator{b: b}
+	d.tbls = append(d.tbls, stmt.From.Tables...)
+	for _, tbl := range d.tbls {
+		qry := &Query{}
+		if err := b.buildTableReference(tbl, qry); err != nil {
+			return nil, err
+		}
+		d.scopes = append(d.scopes, qry.Pop().Scopes[0])
+	}
+	rs := *stmt
+	if stmt.Where != nil {
+		var es []tree.Expr
+
+		for _, expr := range splitAndExpr(stmt.Where.Expr, nil) {
+			ok, err := d.decorrelate(expr)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+			if ok, err = d.buildScalarFilter(expr); err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+			if expr, err = d.rewriteScalar(expr); err != nil {
+				return nil, err
+			}
+			es = append(es, expr)
+		}
+		rs.Where = nil
+		if len(es) > 0 {
+			e := es[0]
+			for i := 1; i < len(es); i++ {
+				e = tree.NewAndExpr(e, es[i])
+			}
+			rs.Where = tree.NewWhere(e)
+		}
+	}
+	rs.Exprs = make(tree.SelectExprs, len(stmt.Exprs))
+	for i, expr := range stmt.Exprs {
+		rs.Exprs[i] = expr
+		if hasSubquery(expr.Expr) {
+			e, err := d.rewriteScalar(expr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			rs.Exprs[i].Expr = e
+			if len(expr.As) == 0 { // the column keeps the name of the expression
+				rs.Exprs[i].As = tree.UnrestrictedIdentifier(tree.String(&expr, dialect.MYSQL))
+			}
+		}
+	}
+	if stmt.Having != nil && hasSubquery(stmt.Having.Expr) {
+		e, attrs, err := d.rewriteHaving(stmt.Having.Expr)
+		if err != nil {
+			return nil, err
+		}
+		rs.Having = tree.NewWhere(e)
+		rs.GroupBy = append(append(tree.GroupBy{}, stmt.GroupBy...), attrs...)
+	}
+	rs.From = &tree.From{Tables: d.tbls}
+	return &rs, nil
+}
+
+// decorrelate turns expr into a semi or anti join if it is an EXISTS, NOT
+// EXISTS, IN or NOT IN subquery.
+func (d *decorrelator) decorrelate(expr tree.Expr) (bool, error) {
+	switch e := stripParen(expr).(type) {
+	case *tree.Subquery:
+		if e.Exists {
+			return true, d.buildSemiJoin(joinTypeSemi, nil, e)
+		}
+	case *tree.NotExpr:
+		if sub, ok := stripParen(e.Expr).(*tree.Subquery); ok && sub.Exists {
+			return true, d.buildSemiJoin(joinTypeAnti, nil, sub)
+		}
+	case *tree.ComparisonExpr:
+		if sub, ok := stripParen(e.Right).(*tree.Subquery); ok {
+			switch e.Op {
+			case tree.IN:
+				return true, d.buildSemiJoin(joinTypeSemi, e.Left, sub)
+			case tree.NOT_IN:
+				return true, d.buildSemiJoin(joinTypeNotIn, e.Left, sub)
+			}
+		}
+	}
+	return false, nil
+}
+
+// buildSemiJoin joins the table of the from clause used by the subquery with
+// the derived table of it, left is the left side of IN or NOT IN. The
+// comparison of NOT IN is not a key of the anti join, a row of the table is
+// not output if the comparison is true or null for any candidate.
+func (d *decorrelator) buildSemiJoin(joinType string, left tree.Expr, stmt *tree.Subquery) error {
+	sub, err := d.buildSubquery(stmt, true)
+	if err != nil {
+		return err
+	}
+	if left != nil {
+		if len(sub.clause.Exprs) != 1 {
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, "Operand should contain 1 column(s)")
+		}
+		if _, ok := sub.clause.Exprs[0].Expr.(tree.UnqualifiedStar); ok {
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, "Operand should contain 1 column(s)")
+		}
+		if err := d.useOuterAttributes(left, sub); err != nil {
+			return err
+		}
+		sub.keys = append([]tree.Expr{tree.NewComparisonExpr(tree.EQUAL, left, sub.export(sub.clause.Exprs[0].Expr))}, sub.keys...)
+	}
+	if len(sub.exprs) == 0 { // the derived table of an uncorrelated EXISTS
+		if err := sub.exportFirstAttribute(d.b); err != nil {
+			return err
+		}
+	}
+	return d.join(joinType, sub, sub.conds, sub.clause.GroupBy, sub.clause.Having)
+}
+
+// buildScalarFilter turns expr into a semi join if it is a condition on a
+// table of the from clause and an uncorrelated scalar subquery, the condition
+// is applied to each row of the table and the result of the subquery.
+func (d *decorrelator) buildScalarFilter(expr tree.Expr) (bool, error) {
+	var stmts []*tree.Subquery
+
+	rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+		if stmt, ok := n.(*tree.Subquery); ok {
+			stmts = append(stmts, stmt)
+			return n, true, nil
+		}
+		return n, false, nil
+	})
+	if len(stmts) != 1 || stmts[0].Exists {
+		return false, nil
+	}
+	flg := true
+	tbls := make(map[int]struct{})
+	walkNames(expr, func(name *tree.UnresolvedName) {
+		i, ok := findTableOfAttribute(name, d.scopes)
+		if !ok {
+			flg = false
+		}
+		tbls[i] = struct{}{}
+	})
+	if !flg || len(tbls) != 1 {
+		return false, nil
+	}
+	sub, err := d.buildSubquery(stmts[0], false)
+	if err != nil {
+		return false, err
+	}
+	if len(sub.keys) > 0 || sub.apply {
+		return false, nil
+	}
+	if len(sub.clause.Exprs) != 1 {
+		return false, errors.New(errno.SyntaxErrororAccessRuleViolation, "Operand should contain 1 column(s)")
+	}
+	tbl, attr, err := d.buildScalar(sub)
+	if err != nil {
+		return false, err
+	}
+	e, err := rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+		if n == tree.Expr(stmts[0]) {
+			return attr, true, nil
+		}
+		return n, false, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	for i := range tbls {
+		d.tbls[i] = &tree.JoinTableExpr{
+			JoinType: joinTypeSemi,
+			Left:     d.tbls[i],
+			Right:    tbl,
+			Cond:     tree.NewOnJoinCond(e),
+		}
+	}
+	return true, nil
+}
+
+// rewriteScalar replaces each scalar subquery of expr with the attribute of
+// its result.
+func (d *decorrelator) rewriteScalar(expr tree.Expr) (tree.Expr, error) {
+	return rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+		stmt, ok := n.(*tree.Subquery)
+		if !ok {
+			return n, false, nil
+		}
+		if stmt.Exists {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+		}
+		sub, err := d.buildSubquery(stmt, false)
+		if err != nil {
+			return nil, true, err
+		}
+		if len(sub.clause.Exprs) != 1 {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, "Operand should contain 1 column(s)")
+		}
+		expr := sub.clause.Exprs[0].Expr
+		if len(sub.keys) == 0 && !sub.apply {
+			tbl, attr, err := d.buildScalar(sub)
+			if err != nil {
+				return nil, true, err
+			}
+			d.tbls = append(d.tbls, tbl)
+			return attr, true, nil
+		}
+		if hasCount(expr) && len(sub.clause.GroupBy) == 0 { // the count of a value without any row is not null but zero
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+		}
+		if sub.apply || len(sub.clause.GroupBy) > 0 || sub.clause.Having != nil || !hasAggregation(expr) {
+			attr, err := d.buildApply(sub)
+			return attr, true, err
+		}
+		// a correlated aggregation is an aggregation of the rows of each
+		// value of the correlated attributes
+		var groupBy tree.GroupBy
+		for _, e := range sub.exprs {
+			groupBy = append(groupBy, e.Expr)
+		}
+		attr := sub.export(expr)
+		return attr, true, d.join(joinTypeScalar, sub, sub.conds, groupBy, nil)
+	})
+}
+
+// rewriteHaving replaces each uncorrelated scalar subquery of the having
+// clause expr with the attribute of its result and returns the attributes.
+// The result is a table of one row in the cross product of the from clause,
+// so grouping by its attributes as well does not change the groups and makes
+// them available to the having clause.
+func (d *decorrelator) rewriteHaving(expr tree.Expr) (tree.Expr, []tree.Expr, error) {
+	var attrs []tree.Expr
+
+	e, err := rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+		stmt, ok := n.(*tree.Subquery)
+		if !ok {
+			return n, false, nil
+		}
+		if stmt.Exists {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+		}
+		sub, err := d.buildSubquery(stmt, false)
+		if err != nil {
+			return nil, true, err
+		}
+		if len(sub.keys) > 0 || sub.apply {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("correlated subquery '%v' in having clause is not support now", tree.String(stmt, dialect.MYSQL)))
+		}
+		if len(sub.clause.Exprs) != 1 {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, "Operand should contain 1 column(s)")
+		}
+		tbl, attr, err := d.buildScalar(sub)
+		if err != nil {
+			return nil, true, err
+		}
+		d.tbls = append(d.tbls, tbl)
+		attrs = append(attrs, attr)
+		return attr, true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return e, attrs, nil
+}
+
+// buildSubquery splits the where clause of the subquery stmt into the
+// conditions of the inner query and the correlated conditions, the
+// attributes are looked up in the from clause of the subquery first.
+func (d *decorrelator) buildSubquery(stmt *tree.Subquery, isSemi bool) (*subquery, error) {
+	d.b.subqueries++
+	alias := fmt.Sprintf("_sq%d", d.b.subqueries)
+	clause, err := d.b.getSubqueryClause(stmt.Select, alias)
+	if err != nil {
+		return nil, err
+	}
+	if clause.From == nil {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, "need from clause")
+	}
+	sub := &subquery{
+		alias:  alias,
+		clause: clause,
+		tbls:   make(map[int]struct{}),
+	}
+	var scopes []*Scope
+	for _, tbl := range clause.From.Tables {
+		qry := &Query{}
+		if err := d.b.buildTableReference(tbl, qry); err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, qry.Pop().Scopes[0])
+	}
+	isOuter := func(name *tree.UnresolvedName) (int, bool) {
+		if _, ok := findTableOfAttribute(name, scopes); ok {
+			return -1, false
+		}
+		return findTableOfAttribute(name, d.scopes)
+	}
+	sub.isOuter = isOuter
+	if !isSemi && len(sub.outerAttributes(false)) > 0 {
+		sub.apply = true
+	}
+	if clause.Where == nil {
+		return sub, nil
+	}
+	for _, expr := range splitAndExpr(clause.Where.Expr, nil) {
+		var outer []int
+
+		walkNames(expr, func(name *tree.UnresolvedName) {
+			if i, ok := isOuter(name); ok {
+				outer = append(outer, i)
+			}
+		})
+		if len(outer) == 0 {
+			sub.conds = append(sub.conds, expr)
+			continue
+		}
+		if isSemi && (len(clause.GroupBy) > 0 || clause.Having != nil || clause.Distinct || hasAggregation(clause.Exprs[0].Expr)) {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+		}
+		for _, i := range outer {
+			sub.tbls[i] = struct{}{}
+		}
+		if e, ok := stripParen(expr).(*tree.ComparisonExpr); ok && e.Op == tree.EQUAL {
+			l, r := stripParen(e.Left), stripParen(e.Right)
+			if name, ok := r.(*tree.UnresolvedName); ok && len(outer) == 1 {
+				if _, ok := isOuter(name); ok {
+					l, r = r, l
+				}
+			}
+			if name, ok := l.(*tree.UnresolvedName); ok && len(outer) == 1 {
+				if _, ok := isOuter(name); ok && hasInnerAttribute(r, isOuter) {
+					sub.keys = append(sub.keys, tree.NewComparisonExpr(tree.EQUAL, name, sub.export(r)))
+					continue
+				}
+			}
+		}
+		if !isSemi {
+			sub.apply = true
+			continue
+		}
+		// the attributes of the inner query are exported by the derived table
+		// and the condition is applied to each candidate of the semi join
+		e, err := rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+			switch name := n.(type) {
+			case *tree.UnresolvedName:
+				if _, ok := isOuter(name); ok {
+					return name, true, nil
+				}
+				return sub.export(name), true, nil
+			case *tree.Subquery:
+				return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+			}
+			return n, false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sub.es = append(sub.es, e)
+	}
+	return sub, nil
+}
+
+// useOuterAttributes records the tables of the from clause used by expr.
+func (d *decorrelator) useOuterAttributes(expr tree.Expr, sub *subquery) error {
+	var err error
+
+	walkNames(expr, func(name *tree.UnresolvedName) {
+		i, ok := findTableOfAttribute(name, d.scopes)
+		if !ok {
+			err = errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Unknown column '%v'", tree.String(name, dialect.MYSQL)))
+			return
+		}
+		sub.tbls[i] = struct{}{}
+	})
+	return err
+}
+
+// findTableOfAttribute returns the first table of the from clause which has
+// the attribute name, ss are the scopes of the tables.
+func findTableOfAttribute(name *tree.UnresolvedName, ss []*Scope) (int, bool) {
+	for i, s := range ss {
+		switch name.NumParts {
+		case 1:
+			if _, ok := s.Result.AttrsMap[name.Parts[0]]; ok {
+				return i, true
+			}
+		case 2:
+			if _, ok := s.Result.AttrsMap[name.Parts[1]+"."+name.Parts[0]]; ok {
+				return i, true
+			}
+			if _, ok := s.Result.AttrsMap[name.Parts[0]]; ok && hasScopeName(s, name.Parts[1]) {
+				return i, true
+			}
+		}
+	}
+	return -1, false
+}
+
+// hasScopeName reports whether s or a table joined by s is named name.
+func hasScopeName(s *Scope, name string) bool {
+	if s.Name == name {
+		return true
+	}
+	if len(s.Name) > 0 {
+		return false
+	}
+	for _, c := range s.Children {
+		if hasScopeName(c, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// join replaces the table of the from clause used by sub with its join with
+// the derived table of sub, the derived table is added to the from clause if
+// sub is not correlated and joinType is empty.
+func (d *decorrelator) join(joinType string, sub *subquery, conds []tree.Expr, groupBy tree.GroupBy, having *tree.Where) error {
+	clause := &tree.SelectClause{
+		Exprs:   sub.exprs,
+		From:    sub.clause.From,
+		GroupBy: groupBy,
+		Having:  having,
+	}
+	if len(conds) > 0 {
+		e := conds[0]
+		for i := 1; i < len(conds); i++ {
+			e = tree.NewAndExpr(e, conds[i])
+		}
+		clause.Where = tree.NewWhere(e)
+	}
+	tbl := &tree.AliasedTableExpr{
+		Expr: &tree.ParenTableExpr{Expr: &tree.Select{Select: clause}},
+		As:   tree.AliasClause{Alias: tree.Identifier(sub.alias)},
+	}
+	if len(sub.tbls) > 1 {
+		return errors.New(errno.SyntaxErrororAccessRuleViolation, "subquery correlated with several tables is not support now")
+	}
+	i := 0 // an uncorrelated subquery is joined with the first table
+	for k := range sub.tbls {
+		i = k
+	}
+	var cond tree.Expr
+	for _, e := range append(sub.keys, sub.es...) {
+		if cond == nil {
+			cond = e
+		} else {
+			cond = tree.NewAndExpr(cond, e)
+		}
+	}
+	d.tbls[i] = &tree.JoinTableExpr{
+		JoinType: joinType,
+		Left:     d.tbls[i],
+		Right:    tbl,
+		Cond:     tree.NewOnJoinCond(cond),
+	}
+	return nil
+}
+
+// export adds expr to the attributes of the derived table and returns the
+// attribute.
+func (sub *subquery) export(expr tree.Expr) *tree.UnresolvedName {
+	str := tree.String(expr, dialect.MYSQL)
+	for _, e := range sub.exprs {
+		if tree.String(e.Expr, dialect.MYSQL) == str {
+			return tree.SetUnresolvedName(string(e.As))
+		}
+	}
+	name := fmt.Sprintf("%s_%d", sub.alias, len(sub.exprs))
+	sub.exprs = append(sub.exprs, tree.SelectExpr{
+		Expr: expr,
+		As:   tree.UnrestrictedIdentifier(name),
+	})
+	return tree.SetUnresolvedName(name)
+}
+
+// exportFirstAttribute exports the first attribute of the from clause of sub.
+func (sub *subquery) exportFirstAttribute(b *build) error {
+	qry := &Query{}
+	if err := b.buildTableReference(sub.clause.From.Tables[0], qry); err != nil {
+		return err
+	}
+	s := qry.Pop().Scopes[0]
+	if len(s.Result.Attrs) == 0 {
+		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(sub.clause, dialect.MYSQL)))
+	}
+	if len(s.Name) > 0 && len(sub.clause.From.Tables) > 1 {
+		sub.export(tree.SetUnresolvedName(s.Name, s.Result.Attrs[0]))
+	} else {
+		sub.export(tree.SetUnresolvedName(s.Result.Attrs[0]))
+	}
+	return nil
+}
+
+// getSubqueryClause returns the select clause of a subquery, a set operation
+// is the derived table named alias of the clause.
+func (b *build) getSubqueryClause(stmt tree.SelectStatement, alias string) (*tree.SelectClause, error) {
+	for {
+		switch s := stmt.(type) {
+		case *tree.ParenSelect:
+			stmt = s.Select
+		case *tree.Select:
+			if s.Limit != nil || len(s.OrderBy) > 0 {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' in subquery is not support now", tree.String(s, dialect.MYSQL)))
+			}
+			stmt = s.Select
+		case *tree.SelectClause:
+			return s, nil
+		case *tree.UnionClause:
+			qry := &Query{}
+			if err := b.buildUnion(s, nil, qry); err != nil {
+				return nil, err
+			}
+			exprs := make(tree.SelectExprs, len(qry.Result))
+			for i, attr := range qry.Result {
+				exprs[i].Expr = tree.SetUnresolvedName(alias+"_u", attr)
+			}
+			return &tree.SelectClause{
+				Exprs: exprs,
+				From: &tree.From{
+					Tables: tree.TableExprs{&tree.AliasedTableExpr{
+						Expr: &tree.ParenTableExpr{Expr: &tree.Select{Select: s}},
+						As:   tree.AliasClause{Alias: tree.Identifier(alias + "_u")},
+					}},
+				},
+			}, nil
+		default:
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement '%T'", stmt))
+		}
+	}
+}
+
+// hasInnerAttribute reports whether expr uses an attribute of the inner
+// query and none of the outer query.
+func hasInnerAttribute(expr tree.Expr, isOuter func(*tree.UnresolvedName) (int, bool)) bool {
+	inner, outer := false, false
+	walkNames(expr, func(name *tree.UnresolvedName) {
+		if _, ok := isOuter(name); ok {
+			outer = true
+		} else {
+			inner = true
+		}
+	})
+	return inner && !outer
+}
+
+func hasSubquery(expr tree.Expr) bool {
+	flg := false
+	rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+		if _, ok := n.(*tree.Subquery); ok {
+			flg = true
+			return n, true, nil
+		}
+		return n, false, nil
+	})
+	return flg
+}
+
+func hasAggregation(expr tree.Expr) bool {
+	return hasFunction(expr, func(name string) bool {
+		_, ok := transformer.TransformerNamesMap[name]
+		return ok
+	})
+}
+
+func hasCount(expr tree.Expr) bool {
+	return hasFunction(expr, func(name string) bool {
+		return name == "count"
+	})
+}
+
+func hasFunction(expr tree.Expr, fn func(string) bool) bool {
+	flg := false
+	rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+		if e, ok := n.(*tree.FuncExpr); ok {
+			if name, ok := e.Func.FunctionReference.(*tree.UnresolvedName); ok && fn(strings.ToLower(name.Parts[0])) {
+				flg = true
+			}
+		}
+		return n, false, nil
+	})
+	return flg
+}
+
+// walkNames calls fn for each attribute of expr outside of the subqueries.
+func walkNames(expr tree.Expr, fn func(*tree.UnresolvedName)) {
+	rewriteExpr(expr, func(n tree.Expr) (tree.Expr, bool, error) {
+		switch e := n.(type) {
+		case *tree.UnresolvedName:
+			fn(e)
+			return n, true, nil
+		case *tree.Subquery:
+			return n, true, nil
+		}
+		return n, false, nil
+	})
+}
+
+func splitAndExpr(expr tree.Expr, es []tree.Expr) []tree.Expr {
+	switch e := expr.(type) {
+	case *tree.AndExpr:
+		return splitAndExpr(e.Right, splitAndExpr(e.Left, es))
+	case *tree.ParenExpr:
+		if _, ok := e.Expr.(*tree.AndExpr); ok {
+			return splitAndExpr(e.Expr, es)
+		}
+	}
+	return append(es, expr)
+}
+
+func stripParen(expr tree.Expr) tree.Expr {
+	for {
+		e, ok := expr.(*tree.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = e.Expr
+	}
+}
+
+// rewriteExpr returns a copy of expr whose nodes are replaced by fn from the
+// root, the children of a node are not visited if fn reports the node is
+// replaced.
+func rewriteExpr(expr tree.Expr, fn func(tree.Expr) (tree.Expr, bool, error)) (tree.Expr, error) {
+	var err error
+
+	if expr == nil {
+		return nil, nil
+	}
+	r, ok, err := fn(expr)
+	if err != nil || ok {
+		return r, err
+	}
+	switch e := expr.(type) {
+	case *tree.ParenExpr:
+		n := *e
+		n.Expr, err = rewriteExpr(e.Expr, fn)
+		return &n, err
+	case *tree.NotExpr:
+		n := *e
+		n.Expr, err = rewriteExpr(e.Expr, fn)
+		return &n, err
+	case *tree.UnaryExpr:
+		n := *e
+		n.Expr, err = rewriteExpr(e.Expr, fn)
+		return &n, err
+	case *tree.IsNullExpr:
+		n := *e
+		n.Expr, err = rewriteExpr(e.Expr, fn)
+		return &n, err
+	case *tree.IsNotNullExpr:
+		n := *e
+		n.Expr, err = rewriteExpr(e.Expr, fn)
+		return &n, err
+	case *tree.CastExpr:
+		n := *e
+		n.Expr, err = rewriteExpr(e.Expr, fn)
+		return &n, err
+	case *tree.AndExpr:
+		n := *e
+		if n.Left, err = rewriteExpr(e.Left, fn); err != nil {
+			return nil, err
+		}
+		n.Right, err = rewriteExpr(e.Right, fn)
+		return &n, err
+	case *tree.OrExpr:
+		n := *e
+		if n.Left, err = rewriteExpr(e.Left, fn); err != nil {
+			return nil, err
+		}
+		n.Right, err = rewriteExpr(e.Right, fn)
+		return &n, err
+	case *tree.XorExpr:
+		n := *e
+		if n.Left, err = rewriteExpr(e.Left, fn); err != nil {
+			return nil, err
+		}
+		n.Right, err = rewriteExpr(e.Right, fn)
+		return &n, err
+	case *tree.BinaryExpr:
+		n := *e
+		if n.Left, err = rewriteExpr(e.Left, fn); err != nil {
+			return nil, err
+		}
+		n.Right, err = rewriteExpr(e.Right, fn)
+		return &n, err
+	case *tree.ComparisonExpr:
+		n := *e
+		if n.Left, err = rewriteExpr(e.Left, fn); err != nil {
+			return nil, err
+		}
+		n.Right, err = rewriteExpr(e.Right, fn)
+		return &n, err
+	case *tree.RangeCond:
+		n := *e
+		if n.Left, err = rewriteExpr(e.Left, fn); err != nil {
+			return nil, err
+		}
+		if n.From, err = rewriteExpr(e.From, fn); err != nil {
+			return nil, err
+		}
+		n.To, err = rewriteExpr(e.To, fn)
+		return &n, err
+	case *tree.FuncExpr:
+		n := *e
+		n.Exprs = make(tree.Exprs, len(e.Exprs))
+		for i := range e.Exprs {
+			if n.Exprs[i], err = rewriteExpr(e.Exprs[i], fn); err != nil {
+				return nil, err
+			}
+		}
+		return &n, nil
+	case *tree.Tuple:
+		n := *e
+		n.Exprs = make(tree.Exprs, len(e.Exprs))
+		for i := range e.Exprs {
+			if n.Exprs[i], err = rewriteExpr(e.Exprs[i], fn); err != nil {
+				return nil, err
+			}
+		}
+		return &n, nil
+	}
+	return expr, nil
+}
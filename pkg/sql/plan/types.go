@@ -18,9 +18,13 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -38,6 +42,20 @@ const (
 	RELATION
 )
 
+// set operations
+const (
+	UNION = iota
+	INTERSECT
+	EXCEPT
+)
+
+// tables of a common table expression read by CTEScan
+const (
+	CTEResult       = iota // all the rows of the cte
+	CTEWorking             // rows produced by the last iteration of a recursive cte
+	CTEIntermediate        // rows produced by the iterations of a recursive cte so far
+)
+
 // Direction for ordering results.
 type Direction int8
 
@@ -70,9 +88,10 @@ type Aggregation struct {
 
 type Relation struct {
 	Rows   int64
-	Name   string                // table name
-	Schema string                // schema name
-	Attrs  map[string]*Attribute // table's column information
+	Name   string                              // table name
+	Schema string                              // schema name
+	Attrs  map[string]*Attribute               // table's column information
+	Stats  map[string]*engine.ColumnStatistics // statistics of the analyzed columns
 
 	Flg  bool // indicate if transform is required
 	Proj Projection
@@ -91,6 +110,62 @@ type DerivedRelation struct {
 	BoundVars []*Aggregation
 }
 
+// JSONTable is the rows of a JSON_TABLE. The rows of a constant json document
+// are computed while building the plan, the rows of a json document which
+// refers to a table before it in the FROM list are computed for each row of
+// the table, which is the child of the scope.
+type JSONTable struct {
+	Bat *batch.Batch
+	// E is the json document of each row of the table, nil if the document
+	// is a constant.
+	E     extend.Extend
+	Name  string // alias of the json table
+	Attrs []string
+	Types []types.Type
+
+	path    *bytejson.Path // path of the rows in the document
+	columns []*tree.JSONTableColumn
+	paths   []*bytejson.Path // path of each column in a row
+}
+
+// CTE is a common table expression whose rows are computed once and shared
+// by all its references.
+type CTE struct {
+	Name  string
+	Attrs []string
+	Scope *Scope // query of the cte, the non-recursive part of a recursive cte
+	// Recursive is the recursive part of a recursive cte which is evaluated
+	// repeatedly with the rows of the last iteration until no rows are produced,
+	// it is nil if the cte is not recursive.
+	Recursive *Scope
+	// Rebuild builds the recursive part again for the next iteration because
+	// the plan is changed by its execution, it is not safe for concurrent use.
+	Rebuild func() (*Scope, error)
+}
+
+// CTEScan reads one of the tables of a common table expression.
+type CTEScan struct {
+	Table int // CTEResult, CTEWorking or CTEIntermediate
+	CTE   *CTE
+}
+
+// Apply is the result of a correlated scalar subquery which is evaluated
+// for each value of the outer attributes it uses, it has a row of the value
+// and the result of the subquery for each value of which the subquery is not
+// empty. The apply of an uncorrelated scalar subquery has no outer attributes,
+// it has a row of the result of the subquery, or of null if it is empty.
+type Apply struct {
+	Name  string
+	Attrs []string // the outer attributes and the result of the subquery
+	Outer *Scope   // distinct values of the outer attributes, nil if uncorrelated
+	// Inner is the subquery built while building the plan, it is only used
+	// to check the privileges unless the subquery is uncorrelated.
+	Inner *Scope
+	// Build builds the subquery for the value of the outer attributes, which
+	// are int64, uint64, float64 or string, it is not safe for concurrent use.
+	Build func([]interface{}) (*Scope, error)
+}
+
 type SymbolTable struct {
 	Entries map[string]*Attribute
 }
@@ -131,10 +206,23 @@ type Query struct {
 	Aggs       []*Aggregation
 	RenameRels map[string]*Scope
 	Rels       map[string]map[string]*Scope
+	Joins      map[*tree.JoinTableExpr]*Scope // outer joins built in the first pass
+	JSONTables map[*tree.JSONTable]*Scope     // lateral json tables built in the first pass
+	Resolved   bool                           // statistics of the full-text searches are read when the plan is built
+	Params     []*Param                       // parameters of the prepared statement
+	Folded     bool                           // values of the parameters are folded when the plan is built
 
 	Children []*Scope // subquery
 }
 
+// Param is a parameter of a prepared statement, whose value is bound
+// before each execution of the plan.
+type Param struct {
+	Offset int     // position of the placeholder, starting from 1
+	Oid    types.T // type of the value before it is converted
+	V      *extend.ValueExtend
+}
+
 type Field struct {
 	Attr string
 	Type Direction
@@ -144,15 +232,47 @@ type Join struct {
 	Type   int // join type
 	Vars   [][]int
 	Result []string
+	Cond   extend.Extend // condition of an outer join besides the equalities
+	NotIn  extend.Extend // inequality of the NOT IN of a null-aware anti join
 }
 
 type Dedup struct {
 }
 
+// SetOperation combines the results of its two children, which have the
+// same attributes.
+type SetOperation struct {
+	Type int  // UNION, INTERSECT or EXCEPT
+	All  bool // keep the duplicate rows
+}
+
 type Order struct {
 	Fs []*Field
 }
 
+// Window evaluates the window functions over the partitions of the rows
+// which are ordered by Fs, the results of the functions are appended to
+// the rows as the attributes named by their aliases.
+type Window struct {
+	Partitions []string
+	Fs         []*Field
+	Functions  []*WindowFunction
+}
+
+// WindowFunction is a window function, its argument and default are
+// attributes of the rows computed by the projection under the window.
+type WindowFunction struct {
+	Op      int // window function, like window.RowNumber
+	Agg     int // transformer op of the aggregate function of window.Aggregate
+	Attr    string
+	Default string
+	Value   *vector.Vector // constant default of lag and lead
+	N       int64
+	Alias   string
+	Type    types.Type // type of the result
+	Frame   *window.Frame
+}
+
 type Limit struct {
 	Limit int64
 }
@@ -195,17 +315,6 @@ type Graph struct {
 	Es []*Edge
 }
 
-type VertexSet struct {
-	Is []int
-	Es []*Edge
-}
-
-type EdgeSet struct {
-	W      int // weight
-	I1, I2 int // subscript for E1 and E2
-	E1, E2 *Edge
-}
-
 type CreateDatabase struct {
 	IfNotExistFlag bool
 	Id             string
@@ -248,22 +357,6 @@ type DropIndex struct {
 	Relation    engine.Relation
 }
 
-type ShowDatabases struct {
-	E    engine.Engine
-	Like []byte
-	//Where     extend.Extend
-}
-
-type ShowTables struct {
-	Db   engine.Database
-	Like []byte
-}
-
-type ShowColumns struct {
-	Relation engine.Relation
-	Like     []byte
-}
-
 type ShowCreateTable struct {
 	Relation  engine.Relation
 	TableName string
@@ -282,6 +375,12 @@ type ExplainQuery struct {
 	Buffer  *explain.ExplainDataBuffer
 }
 
+// ExplainAnalyze is the plan of EXPLAIN ANALYZE, Plan is executed and its
+// executed scopes are explained with the runtime statistics.
+type ExplainAnalyze struct {
+	Plan Plan
+}
+
 type Insert struct {
 	Id       string
 	Db       string
@@ -290,14 +389,32 @@ type Insert struct {
 }
 
 type Delete struct {
-	Qry *Query
+	Qry    *Query
+	Tables []*ModifiedTable
 }
 
 type Update struct {
-	Qry         *Query
-	UpdateList  []extend.UpdateExtend
+	Qry    *Query
+	Tables []*ModifiedTable
+}
+
+// ModifiedTable is a table whose rows are deleted or updated, the rows
+// are the result of Qry, Attrs are the attributes of the result holding
+// the columns Cols of the table.
+type ModifiedTable struct {
+	Schema string
+	Name   string
+	Cols   []string
+	Attrs  []string
+	// Dedup is true if a row may appear more than once in the result,
+	// which happens when the tables are joined.
+	Dedup bool
+	// UpdateAttrs are the columns set by UpdateList, only for update.
 	UpdateAttrs []string
-	OtherAttrs  []string
+	UpdateList  []extend.UpdateExtend
+	// ReadAttrs are the columns read by the statement, which are named by
+	// the where clause, the order by, the join conditions or the set list.
+	ReadAttrs []string
 }
 
 type build struct {
@@ -306,6 +423,14 @@ type build struct {
 	db       string // name of schema
 	sql      string
 	e        engine.Engine
+	pc       PrivilegeChecker // nil means the privileges are not checked
+
+	subqueries int               // number of the subqueries decorrelated
+	ctes       []*cte            // common table expressions visible to the statement being built
+	applies    map[string]*Apply // correlated scalar subqueries evaluated for each value, by alias
+	resolved   bool              // a full-text search is resolved against the statistics of a table
+	params     []*Param          // parameters of the prepared statement
+	folded     bool              // the value of a parameter is folded into the plan
 }
 
 func (qry *Query) ResultColumns() []*Attribute {
@@ -451,68 +576,6 @@ func (d DropIndex) ResultColumns() []*Attribute {
 	return nil
 }
 
-func (s ShowDatabases) String() string {
-	var buf bytes.Buffer
-	buf.WriteString("show databases")
-	if s.Like != nil {
-		buf.WriteString(fmt.Sprintf(" likes %s", string(s.Like)))
-	}
-	return buf.String()
-}
-
-func (s ShowDatabases) ResultColumns() []*Attribute {
-	return []*Attribute{
-		{
-			Ref:  1,
-			Name: "Databases",
-			Type: types.Type{
-				Oid:  types.T_varchar,
-				Size: 24,
-			},
-		},
-	}
-}
-
-func (s ShowTables) String() string {
-	var buf bytes.Buffer
-	buf.WriteString("show tables")
-	if s.Like != nil {
-		buf.WriteString(fmt.Sprintf(" likes %s", string(s.Like)))
-	}
-	return buf.String()
-}
-
-func (s ShowTables) ResultColumns() []*Attribute {
-	return []*Attribute{
-		{
-			Ref:  1,
-			Name: fmt.Sprintf("Tables"),
-			Type: types.Type{
-				Oid:  types.T_varchar,
-				Size: 24,
-			},
-		},
-	}
-}
-
-func (s ShowColumns) String() string {
-	var buf bytes.Buffer
-	buf.WriteString("show columns")
-	return buf.String()
-}
-
-func (s ShowColumns) ResultColumns() []*Attribute {
-	attrs := []*Attribute{
-		{Ref: 1, Name: "Field", Type: types.Type{Oid: types.T_varchar, Size: 24}},
-		{Ref: 1, Name: "Type", Type: types.Type{Oid: types.T_varchar, Size: 24}},
-		{Ref: 1, Name: "Null", Type: types.Type{Oid: types.T_varchar, Size: 24}},
-		{Ref: 1, Name: "Key", Type: types.Type{Oid: types.T_varchar, Size: 24}},
-		{Ref: 1, Name: "Default", Type: types.Type{Oid: types.T_varchar, Size: 24}},
-		{Ref: 1, Name: "Extra", Type: types.Type{Oid: types.T_varchar, Size: 24}},
-	}
-	return attrs
-}
-
 func (s ShowCreateTable) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("show create table")
@@ -551,6 +614,14 @@ func (e ExplainQuery) String() string {
 	return buf.String()
 }
 
+func (e ExplainAnalyze) String() string {
+	return fmt.Sprintf("explain analyze %s", e.Plan)
+}
+
+func (e ExplainAnalyze) ResultColumns() []*Attribute {
+	return BuildExplainResultColumns()
+}
+
 func (e ExplainQuery) ResultColumns() []*Attribute {
 	return []*Attribute{
 		{
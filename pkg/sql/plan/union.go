@@ -0,0 +1,237 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var setOperations = map[tree.UnionType]int{
+	tree.UNION:     UNION,
+	tree.INTERSECT: INTERSECT,
+	tree.EXCEPT:    EXCEPT,
+}
+
+// buildUnion builds the union, intersect or except of two select statements,
+// the attributes of the result are the ones of the left statement.
+func (b *build) buildUnion(stmt *tree.UnionClause, orderBy tree.OrderBy, qry *Query) error {
+	left, right := &Query{}, &Query{}
+	if err := b.buildSelectStatement(stmt.Left, left); err != nil {
+		return err
+	}
+	if err := b.buildSelectStatement(stmt.Right, right); err != nil {
+		return err
+	}
+	if len(left.Result) != len(right.Result) {
+		return errors.New(errno.CardinalityViolation, "The used SELECT statements have a different number of columns")
+	}
+	ts := make([]types.Type, len(left.Result))
+	{ // unify the types of the columns
+		lattrs, rattrs := left.ResultColumns(), right.ResultColumns()
+		for i := range lattrs {
+			typ, ok := unionType(lattrs[i].Type, rattrs[i].Type)
+			if !ok {
+				return errors.New(errno.DatatypeMismatch, fmt.Sprintf("Incompatible types '%s' and '%s' of the column '%s' in %s", lattrs[i].Type, rattrs[i].Type, left.Result[i], stmt.Type))
+			}
+			ts[i] = typ
+		}
+	}
+	attrs := make(map[string]struct{})
+	for _, attr := range left.Result {
+		attrs[attr] = struct{}{}
+	}
+	s := &Scope{
+		Children: []*Scope{
+			buildCast(left, left.Result, ts),
+			buildCast(right, left.Result, ts),
+		},
+	}
+	{ // construct result
+		s.Result.AttrsMap = make(map[string]*Attribute)
+		for i, attr := range left.Result {
+			s.Result.Attrs = append(s.Result.Attrs, attr)
+			s.Result.AttrsMap[attr] = &Attribute{
+				Name: attr,
+				Type: ts[i],
+			}
+		}
+	}
+	s.Op = &SetOperation{
+		Type: setOperations[stmt.Type],
+		All:  stmt.All,
+	}
+	qry.Scope = s
+	qry.Result = append(qry.Result, left.Result...)
+	if len(orderBy) > 0 {
+		fs := make([]*Field, len(orderBy))
+		for i, order := range orderBy {
+			name := tree.String(order.Expr, dialect.MYSQL)
+			if _, ok := attrs[name]; !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'order clause'", name))
+			}
+			fs[i] = &Field{
+				Attr: name,
+				Type: Direction(order.Direction),
+			}
+		}
+		s := &Scope{
+			Children: []*Scope{qry.Scope},
+		}
+		{ // construct result
+			s.Result.AttrsMap = make(map[string]*Attribute)
+			for _, attr := range qry.Scope.Result.Attrs {
+				s.Result.Attrs = append(s.Result.Attrs, attr)
+				s.Result.AttrsMap[attr] = &Attribute{
+					Name: attr,
+					Type: qry.Scope.Result.AttrsMap[attr].Type,
+				}
+			}
+		}
+		s.Op = &Order{fs}
+		qry.Scope = s
+	}
+	return nil
+}
+
+// buildCast converts the result of a side of a set operation to the
+// attributes and types of the set operation.
+func buildCast(qry *Query, attrs []string, ts []types.Type) *Scope {
+	cols := qry.ResultColumns()
+	flg := len(qry.Scope.Result.Attrs) != len(attrs)
+	for i, col := range cols {
+		if col.Name != attrs[i] || col.Type.Oid != ts[i].Oid {
+			flg = true
+		}
+	}
+	if !flg {
+		return qry.Scope
+	}
+	s := &Scope{
+		Children: []*Scope{qry.Scope},
+	}
+	op := &Projection{}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	for i, col := range cols {
+		var e extend.Extend
+
+		e = &extend.Attribute{
+			Name: col.Name,
+			Type: col.Type.Oid,
+		}
+		if col.Type.Oid != ts[i].Oid {
+			e = &extend.BinaryExtend{
+				Op:    overload.Typecast,
+				Left:  e,
+				Right: &extend.ValueExtend{V: vector.New(ts[i])},
+			}
+		}
+		op.Rs = append(op.Rs, 1)
+		op.As = append(op.As, attrs[i])
+		op.Es = append(op.Es, e)
+		s.Result.Attrs = append(s.Result.Attrs, attrs[i])
+		s.Result.AttrsMap[attrs[i]] = &Attribute{
+			Name: attrs[i],
+			Type: ts[i],
+		}
+	}
+	s.Op = op
+	return s
+}
+
+// unionType returns the type which the values of a column of both sides
+// of a set operation are converted to.
+func unionType(l, r types.Type) (types.Type, bool) {
+	switch {
+	case l.Oid == r.Oid:
+		if isDecimalType(l.Oid) && l.Scale != r.Scale {
+			return types.Type{}, false
+		}
+		if r.Width > l.Width {
+			l.Width = r.Width
+		}
+		return l, true
+	case isIntegerType(l.Oid) && isIntegerType(r.Oid):
+		if isUnsignedType(l.Oid) != isUnsignedType(r.Oid) {
+			return types.Type{Oid: types.T_int64, Size: 8}, true
+		}
+		if r.Size > l.Size {
+			return r, true
+		}
+		return l, true
+	case isNumericType(l.Oid) && isNumericType(r.Oid):
+		return types.Type{Oid: types.T_float64, Size: 8}, true
+	case isStringType(l.Oid) || isStringType(r.Oid):
+		if isDecimalType(l.Oid) || isDecimalType(r.Oid) || isTimeType(l.Oid) || isTimeType(r.Oid) {
+			return types.Type{}, false
+		}
+		typ := types.Type{Oid: types.T_varchar, Size: 24, Width: l.Width}
+		if r.Width > typ.Width {
+			typ.Width = r.Width
+		}
+		return typ, true
+	case l.Oid == types.T_datetime && r.Oid == types.T_timestamp:
+		return l, true
+	case l.Oid == types.T_timestamp && r.Oid == types.T_datetime:
+		return r, true
+	case isDecimalType(l.Oid) && isDecimalType(r.Oid) && l.Scale == r.Scale:
+		typ := types.Type{Oid: types.T_decimal128, Size: 16, Width: l.Width, Scale: l.Scale}
+		if r.Width > typ.Width {
+			typ.Width = r.Width
+		}
+		return typ, true
+	}
+	return types.Type{}, false
+}
+
+func isIntegerType(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		return true
+	}
+	return isUnsignedType(t)
+}
+
+func isUnsignedType(t types.T) bool {
+	switch t {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+func isNumericType(t types.T) bool {
+	return isIntegerType(t) || t == types.T_float32 || t == types.T_float64
+}
+
+func isStringType(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar
+}
+
+func isDecimalType(t types.T) bool {
+	return t == types.T_decimal64 || t == types.T_decimal128
+}
+
+func isTimeType(t types.T) bool {
+	return t == types.T_date || t == types.T_datetime || t == types.T_timestamp
+}
@@ -16,163 +16,118 @@ package plan
 
 import (
 	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
-	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
-	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
 )
 
-func (b *build) buildUpdatePlan(updateStmt *tree.Update, plan *Update) error {
-	selectStmt, err := buildSelectStmtFromUpdate(updateStmt)
-	if err != nil {
-		return err
-	}
-	//selectStmt = rewrite.Rewrite(selectStmt)
-	selectStmt = rewrite.AstRewrite(selectStmt)
+// buildUpdatePlan builds the query whose result are the rows updated,
+// the result of the query holds all the columns of the updated tables
+// and the attributes used by the set list.
+func (b *build) buildUpdatePlan(stmt *tree.Update, plan *Update) error {
+	var exprs tree.SelectExprs
 
 	b.isModify = true
-	queryPlan, err := b.BuildStatement(selectStmt)
-	if err != nil {
-		return err
-	}
-	plan.Qry, _ = queryPlan.(*Query)
-	if err := b.buildUpdateList(updateStmt.Exprs, plan); err != nil {
-		return nil
+	tbls := tree.TableExprs{stmt.Table}
+	refs := b.getTableRefs(tbls)
+	if len(refs) > 1 && (len(stmt.OrderBy) > 0 || stmt.Limit != nil) {
+		return errors.New(errno.SyntaxErrororAccessRuleViolation, "Incorrect usage of UPDATE and ORDER BY or LIMIT")
 	}
-	return nil
-}
-
-func (b *build) buildUpdateList(exprs tree.UpdateExprs, plan *Update) error {
-	var err error
-	attrs := make(map[string]struct{})
-	qry := *plan.Qry
-	for _, expr := range exprs {
-		// get the update column name
-		updateName := string(expr.Names[0].Parts[0])
-
-		// check if it has duplicate column name
-		if _, ok := attrs[updateName]; ok {
-			return errors.New(errno.SyntaxErrororAccessRuleViolation, "duplicate column name")
-		} else {
-			attrs[updateName] = struct{}{}
-			if err != nil {
-				return err
-			}
-			plan.UpdateAttrs = append(plan.UpdateAttrs, updateName)
+	mp := make(map[*tableRef]*ModifiedTable)
+	attrsMap := make(map[*tableRef]map[string]*Attribute)
+	targets := make([]*tableRef, len(stmt.Exprs))
+	for i, expr := range stmt.Exprs {
+		if len(expr.Names) != 1 {
+			return errors.New(errno.CaseNotFound, "the set list of update must be one")
 		}
-		// build one of update extend
-		etd, err := b.buildWhereExpr(expr.Expr, &qry)
+		ref, err := b.findColumnTable(expr.Names[0], refs, attrsMap)
 		if err != nil {
 			return err
 		}
-		// construct updateExtend
-		updateExtend := extend.UpdateExtend{
-			Attr: extend.Attribute{
-				Name: updateName,
-				Type: qry.Scope.Result.AttrsMap[updateName].Type.Oid,
-			},
-			UpdateExtend: etd,
+		if _, ok := mp[ref]; !ok {
+			tbl, es, err := b.buildModifiedTable(ref, exprs)
+			if err != nil {
+				return err
+			}
+			exprs = es
+			tbl.Dedup = len(refs) > 1
+			mp[ref] = tbl
+			plan.Tables = append(plan.Tables, tbl)
 		}
-		plan.UpdateList = append(plan.UpdateList, updateExtend)
+		targets[i] = ref
 	}
-	// build other attribute
-	for _, name := range qry.Result {
-		if _, ok := attrs[name]; !ok {
-			plan.OtherAttrs = append(plan.OtherAttrs, name)
-		}
+	for _, expr := range stmt.Exprs {
+		walkNames(expr.Expr, func(e *tree.UnresolvedName) {
+			if name, ok := getColumnName(e); ok {
+				exprs = appendSelectExpr(exprs, e, name)
+			}
+		})
 	}
-	return nil
-}
-
-func buildSelectStmtFromUpdate(p *tree.Update) (tree.Statement, error) {
-	exprs, err := buildUpdateProjection(p)
+	qry, err := b.buildModifyQuery(exprs, tbls, stmt.Where, stmt.OrderBy, stmt.Limit)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	s := &tree.SelectClause{
-		Exprs: exprs,
-		From:  &tree.From{Tables: tree.TableExprs{p.Table}},
-		Where: p.Where,
+	plan.Qry = qry
+	es := readExprs(tbls, stmt.Where, stmt.OrderBy)
+	for _, expr := range stmt.Exprs {
+		es = append(es, expr.Expr)
 	}
-	return &tree.Select{
-		Select:  s,
-		OrderBy: p.OrderBy,
-		Limit:   p.Limit,
-	}, nil
-}
-
-func buildUpdateProjection(p *tree.Update) (tree.SelectExprs, error) {
-	var selectExprs tree.SelectExprs
-	// check update list
-	for _, expr := range p.Exprs {
-		if len(expr.Names) != 1 {
-			return nil, errors.New(errno.CaseNotFound, "the set list of update must be one")
+	for ref, tbl := range mp {
+		tbl.ReadAttrs = b.readAttrs(ref, refs, es)
+	}
+	for i, expr := range stmt.Exprs {
+		tbl := mp[targets[i]]
+		col := expr.Names[0].Parts[0]
+		for _, attr := range tbl.UpdateAttrs {
+			if attr == col {
+				return errors.New(errno.SyntaxErrororAccessRuleViolation, "duplicate column name")
+			}
 		}
-		if !isDuplicated(expr.Names[0], &selectExprs) {
-			selectExprs = append(selectExprs, tree.SelectExpr{Expr: expr.Names[0]})
+		e, err := b.buildWhereExpr(expr.Expr, qry)
+		if err != nil {
+			return err
 		}
+		tbl.UpdateAttrs = append(tbl.UpdateAttrs, col)
+		tbl.UpdateList = append(tbl.UpdateList, extend.UpdateExtend{
+			Attr: extend.Attribute{
+				Name: col,
+				Type: attrsMap[targets[i]][col].Type.Oid,
+			},
+			UpdateExtend: e,
+		})
 	}
-	return tree.SelectExprs{tree.SelectExpr{Expr: tree.UnqualifiedStar{}}}, nil
+	return nil
 }
 
-func buildProjectionFromExpr(expr tree.Expr, selectExprs *tree.SelectExprs) error {
-	switch e := expr.(type) {
-	case *tree.NumVal:
-		return nil
-	case *tree.ParenExpr:
-		return buildProjectionFromExpr(e.Expr, selectExprs)
-	case *tree.OrExpr:
-		if err := buildProjectionFromExpr(e.Left, selectExprs); err != nil {
-			return err
-		}
-		return buildProjectionFromExpr(e.Right, selectExprs)
-	case *tree.NotExpr:
-		return buildProjectionFromExpr(e.Expr, selectExprs)
-	case *tree.AndExpr:
-		if err := buildProjectionFromExpr(e.Left, selectExprs); err != nil {
-			return err
-		}
-		return buildProjectionFromExpr(e.Right, selectExprs)
-	case *tree.UnaryExpr:
-		return buildProjectionFromExpr(e.Expr, selectExprs)
-	case *tree.BinaryExpr:
-		if err := buildProjectionFromExpr(e.Left, selectExprs); err != nil {
-			return err
-		}
-		return buildProjectionFromExpr(e.Right, selectExprs)
-	case *tree.ComparisonExpr:
-		if err := buildProjectionFromExpr(e.Left, selectExprs); err != nil {
-			return err
+// findColumnTable returns the table of the updated column, attrsMap caches
+// the columns of the tables.
+func (b *build) findColumnTable(name *tree.UnresolvedName, refs []*tableRef, attrsMap map[*tableRef]map[string]*Attribute) (*tableRef, error) {
+	var ref *tableRef
+
+	col, _ := getColumnName(name)
+	for _, r := range refs {
+		if name.NumParts > 1 && r.qualifier != name.Parts[1] {
+			continue
 		}
-		return buildProjectionFromExpr(e.Right, selectExprs)
-	case *tree.FuncExpr:
-		for _, ex := range e.Exprs {
-			if err := buildProjectionFromExpr(ex, selectExprs); err != nil {
-				return err
+		if _, ok := attrsMap[r]; !ok {
+			_, attrs, _, err := b.getSchemaInfo(r.schema, r.name)
+			if err != nil {
+				return nil, err
 			}
+			attrsMap[r] = attrs
 		}
-		return nil
-	case *tree.CastExpr:
-		return buildProjectionFromExpr(e.Expr, selectExprs)
-	case *tree.RangeCond:
-		return errors.New(errno.SQLStatementNotYetComplete, "range condition is not supported")
-	case *tree.UnresolvedName:
-		if !isDuplicated(e, selectExprs) {
-			*selectExprs = append(*selectExprs, tree.SelectExpr{Expr: e})
+		if _, ok := attrsMap[r][name.Parts[0]]; !ok {
+			continue
 		}
-		return nil
-	}
-	return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(expr, dialect.MYSQL)))
-}
-
-func isDuplicated(name *tree.UnresolvedName, selectExprs *tree.SelectExprs) bool {
-	for _, expr := range *selectExprs {
-		e := expr.Expr.(*tree.UnresolvedName)
-		if name.Parts[0] == e.Parts[0] {
-			return true
+		if ref != nil {
+			return nil, errors.New(errno.AmbiguousColumn, fmt.Sprintf("Column '%s' in field list is ambiguous", col))
 		}
+		ref = r
+	}
+	if ref == nil {
+		return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'field list'", col))
 	}
-	return false
+	return ref, nil
 }
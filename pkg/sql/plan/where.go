@@ -16,9 +16,11 @@ package plan
 
 import (
 	"fmt"
+	"go/constant"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
@@ -30,6 +32,7 @@ func (b *build) buildWhere(stmt *tree.Where, qry *Query) (extend.Extend, []*Join
 	if err != nil {
 		return nil, nil, err
 	}
+	e = matchCondition(e)
 	if e, err = b.pruneExtend(e, false); err != nil {
 		return nil, nil, err
 	}
@@ -85,6 +88,8 @@ func (b *build) buildWhereExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
 		return b.buildWhereExpr(e.Expr, qry)
+	case *tree.ParamExpr:
+		return b.buildParam(e, qry, b.buildWhereExpr)
 	case *tree.OrExpr:
 		return b.buildOr(e, qry, b.buildWhereExpr)
 	case *tree.NotExpr:
@@ -103,8 +108,39 @@ func (b *build) buildWhereExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 		return b.buildCast(e, qry, b.buildWhereExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildWhereExpr)
+	case *tree.FullTextMatchExpr:
+		return b.buildMatch(e, qry, b.buildWhereExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute(e, qry)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
 }
+
+// matchCondition turns each MATCH ... AGAINST used as a condition into
+// MATCH ... AGAINST > 0, the rows not matched have a zero relevance, and
+// each JSON_CONTAINS into JSON_CONTAINS > 0.
+func matchCondition(e extend.Extend) extend.Extend {
+	switch v := e.(type) {
+	case *extend.MultiExtend:
+		if op, ok := extend.FunctionRegistry["match_against"]; ok && v.Op == op {
+			zero, _ := buildValue(constant.MakeFloat64(0), "0")
+			return &extend.BinaryExtend{Op: overload.GT, Left: v, Right: zero}
+		}
+		if op, ok := extend.FunctionRegistry["json_contains"]; ok && v.Op == op {
+			zero, _ := buildValue(constant.MakeInt64(0), "0")
+			return &extend.BinaryExtend{Op: overload.GT, Left: v, Right: zero}
+		}
+	case *extend.ParenExtend:
+		v.E = matchCondition(v.E)
+	case *extend.UnaryExtend:
+		if v.Op == overload.Not {
+			v.E = matchCondition(v.E)
+		}
+	case *extend.BinaryExtend:
+		if v.Op == overload.And || v.Op == overload.Or {
+			v.Left = matchCondition(v.Left)
+			v.Right = matchCondition(v.Right)
+		}
+	}
+	return e
+}
@@ -0,0 +1,331 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+var windowFunctions = map[string]int{
+	"row_number":   window.RowNumber,
+	"rank":         window.Rank,
+	"dense_rank":   window.DenseRank,
+	"percent_rank": window.PercentRank,
+	"cume_dist":    window.CumeDist,
+	"ntile":        window.Ntile,
+	"lag":          window.Lag,
+	"lead":         window.Lead,
+	"first_value":  window.FirstValue,
+	"last_value":   window.LastValue,
+	"nth_value":    window.NthValue,
+}
+
+// buildWindow builds the window function of a select expression, its
+// arguments and the expressions of its window are computed by proj, the
+// function is added to the window of ws with the same partitions and order.
+func (b *build) buildWindow(e *tree.FuncExpr, alias string, proj *Projection, ws []*Window, qry *Query) ([]*Window, *WindowFunction, error) {
+	name, ok := e.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
+	}
+	if e.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("DISTINCT is not supported by window function '%s'", tree.String(e, dialect.MYSQL)))
+	}
+	w := new(Window)
+	for _, expr := range e.WindowSpec.PartitionBy {
+		attr, err := b.buildWindowExpr(expr, proj, qry)
+		if err != nil {
+			return nil, nil, err
+		}
+		w.Partitions = append(w.Partitions, attr)
+	}
+	for _, order := range e.WindowSpec.OrderBy {
+		attr, err := b.buildWindowExpr(order.Expr, proj, qry)
+		if err != nil {
+			return nil, nil, err
+		}
+		w.Fs = append(w.Fs, &Field{
+			Attr: attr,
+			Type: Direction(order.Direction),
+		})
+	}
+
+	f := &WindowFunction{Alias: alias}
+	funcName := strings.ToLower(name.Parts[0])
+	if op, ok := windowFunctions[funcName]; ok {
+		f.Op = op
+		if err := b.buildWindowArgs(f, e, proj, qry); err != nil {
+			return nil, nil, err
+		}
+	} else if op, ok := transformer.TransformerNamesMap[funcName]; ok {
+		if len(e.Exprs) != 1 {
+			return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", tree.String(e, dialect.MYSQL)))
+		}
+		f.Op, f.Agg = window.Aggregate, op
+		if _, ok := e.Exprs[0].(*tree.NumVal); ok && op == transformer.Count { // count(*)
+			f.Agg = transformer.StarCount
+			f.Attr = qry.Scope.Result.Attrs[0]
+			b.addWindowAttribute(f.Attr, &extend.Attribute{
+				Name: f.Attr,
+				Type: qry.Scope.Result.AttrsMap[f.Attr].Type.Oid,
+			}, proj)
+			f.Type = transformer.ReturnType(op, types.T_any).ToType()
+		} else {
+			attr, err := b.buildWindowExpr(e.Exprs[0], proj, qry)
+			if err != nil {
+				return nil, nil, err
+			}
+			f.Attr = attr
+			f.Type = transformer.ReturnType(op, proj.typeOf(attr).Oid).ToType()
+		}
+	} else {
+		return nil, nil, errors.New(errno.UndefinedFunction, fmt.Sprintf("window function '%s' is not supported", funcName))
+	}
+	if e.WindowSpec.Frame != nil {
+		switch f.Op {
+		case window.FirstValue, window.LastValue, window.NthValue, window.Aggregate:
+			frame, err := buildFrame(e.WindowSpec.Frame)
+			if err != nil {
+				return nil, nil, err
+			}
+			f.Frame = frame
+		}
+	}
+
+	for _, v := range ws {
+		if v.sameWindow(w) {
+			v.Functions = append(v.Functions, f)
+			return ws, f, nil
+		}
+	}
+	w.Functions = append(w.Functions, f)
+	return append(ws, w), f, nil
+}
+
+// buildWindowArgs builds the arguments of the window function which is not
+// an aggregate function.
+func (b *build) buildWindowArgs(f *WindowFunction, e *tree.FuncExpr, proj *Projection, qry *Query) error {
+	var err error
+
+	name := window.FunctionNames[f.Op]
+	nargs := map[int][2]int{
+		window.Ntile:      {1, 1},
+		window.Lag:        {1, 3},
+		window.Lead:       {1, 3},
+		window.FirstValue: {1, 1},
+		window.LastValue:  {1, 1},
+		window.NthValue:   {2, 2},
+	}[f.Op]
+	if len(e.Exprs) < nargs[0] || len(e.Exprs) > nargs[1] {
+		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to window function '%s'", name))
+	}
+	switch f.Op {
+	case window.RowNumber, window.Rank, window.DenseRank:
+		f.Type = types.Type{Oid: types.T_int64, Size: 8}
+		return nil
+	case window.PercentRank, window.CumeDist:
+		f.Type = types.Type{Oid: types.T_float64, Size: 8}
+		return nil
+	case window.Ntile:
+		f.Type = types.Type{Oid: types.T_int64, Size: 8}
+		f.N, err = buildWindowInteger(name, e.Exprs[0])
+		return err
+	}
+	if f.Attr, err = b.buildWindowExpr(e.Exprs[0], proj, qry); err != nil {
+		return err
+	}
+	f.Type = proj.typeOf(f.Attr)
+	switch f.Op {
+	case window.Lag, window.Lead:
+		f.N = 1
+		if len(e.Exprs) > 1 {
+			if f.N, err = buildWindowInteger(name, e.Exprs[1]); err != nil {
+				return err
+			}
+		}
+		if len(e.Exprs) > 2 {
+			return b.buildWindowDefault(f, e.Exprs[2], proj, qry)
+		}
+	case window.NthValue:
+		f.N, err = buildWindowInteger(name, e.Exprs[1])
+	}
+	return err
+}
+
+// buildWindowDefault builds the default of lag and lead, which is an
+// expression of the attributes or a constant of the type of the argument.
+func (b *build) buildWindowDefault(f *WindowFunction, n tree.Expr, proj *Projection, qry *Query) error {
+	if num, ok := n.(*tree.NumVal); ok && num.Value.Kind() == constant.Unknown { // null
+		return nil
+	}
+	if v, err := buildParamValue(n); err == nil {
+		vec := vector.New(f.Type)
+		if err := convertParam(v, vec); err != nil {
+			return err
+		}
+		f.Value = v.V
+		return nil
+	}
+	attr, err := b.buildWindowExpr(n, proj, qry)
+	if err != nil {
+		return err
+	}
+	if typ := proj.typeOf(attr); typ.Oid != f.Type.Oid {
+		return errors.New(errno.DatatypeMismatch, fmt.Sprintf("the default of window function '%s' is %s, not %s", window.FunctionNames[f.Op], typ, f.Type))
+	}
+	f.Default = attr
+	return nil
+}
+
+// buildWindowExpr builds the expression computed by the projection for the
+// window and returns the attribute of its result.
+func (b *build) buildWindowExpr(n tree.Expr, proj *Projection, qry *Query) (string, error) {
+	e, err := b.buildProjectionExpr(n, qry)
+	if err != nil {
+		return "", err
+	}
+	if e, err = b.pruneExtend(e, true); err != nil {
+		return "", err
+	}
+	if len(e.Attributes()) == 0 {
+		return "", errors.New(errno.FeatureNotSupported, fmt.Sprintf("window expression '%s' must refer to a column", tree.String(n, dialect.MYSQL)))
+	}
+	b.addWindowAttribute(e.String(), e, proj)
+	return e.String(), nil
+}
+
+func (b *build) addWindowAttribute(name string, e extend.Extend, proj *Projection) {
+	for _, as := range proj.As {
+		if as == name {
+			return
+		}
+	}
+	proj.Rs = append(proj.Rs, 0)
+	proj.As = append(proj.As, name)
+	proj.Es = append(proj.Es, e)
+}
+
+func buildWindowInteger(name string, n tree.Expr) (int64, error) {
+	if num, ok := n.(*tree.NumVal); ok && num.Value.Kind() == constant.Int {
+		if v, ok := constant.Int64Val(num.Value); ok {
+			return v, nil
+		}
+	}
+	return 0, errors.New(errno.WindowingError, fmt.Sprintf("Incorrect arguments to window function '%s', '%s' is not an integer constant", name, tree.String(n, dialect.MYSQL)))
+}
+
+func buildFrame(n *tree.FrameClause) (*window.Frame, error) {
+	var err error
+
+	f := new(window.Frame)
+	if n.Unit == tree.FRAME_UNIT_RANGE {
+		f.Unit = window.Range
+	}
+	if f.Start, err = buildFrameBound(f.Unit, n.Start); err != nil {
+		return nil, err
+	}
+	if f.End, err = buildFrameBound(f.Unit, n.End); err != nil {
+		return nil, err
+	}
+	if f.Start.Type > f.End.Type || f.Start.Type == window.UnboundedFollowing || f.End.Type == window.UnboundedPreceding {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("Window frame '%s' is invalid", tree.String(n, dialect.MYSQL)))
+	}
+	return f, nil
+}
+
+func buildFrameBound(unit window.FrameUnit, n *tree.FrameBound) (window.Bound, error) {
+	b := window.Bound{Type: map[tree.FrameBoundType]window.BoundType{
+		tree.FRAME_BOUND_UNBOUNDED_PRECEDING: window.UnboundedPreceding,
+		tree.FRAME_BOUND_PRECEDING:           window.Preceding,
+		tree.FRAME_BOUND_CURRENT_ROW:         window.CurrentRow,
+		tree.FRAME_BOUND_FOLLOWING:           window.Following,
+		tree.FRAME_BOUND_UNBOUNDED_FOLLOWING: window.UnboundedFollowing,
+	}[n.Type]}
+	if n.Expr == nil {
+		return b, nil
+	}
+	if num, ok := n.Expr.(*tree.NumVal); ok {
+		if v, ok := constant.Float64Val(constant.ToFloat(num.Value)); ok && v >= 0 {
+			if unit == window.Range || v == math.Trunc(v) {
+				b.Offset = v
+				return b, nil
+			}
+		}
+	}
+	return b, errors.New(errno.WindowingError, fmt.Sprintf("Window frame bound '%s' is invalid", tree.String(n, dialect.MYSQL)))
+}
+
+// sameWindow returns true if the windows have the same partitions and order.
+func (w *Window) sameWindow(v *Window) bool {
+	if len(w.Partitions) != len(v.Partitions) || len(w.Fs) != len(v.Fs) {
+		return false
+	}
+	for i := range w.Partitions {
+		if w.Partitions[i] != v.Partitions[i] {
+			return false
+		}
+	}
+	for i := range w.Fs {
+		if *w.Fs[i] != *v.Fs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// typeOf returns the type of the attribute computed by the projection.
+func (proj *Projection) typeOf(attr string) types.Type {
+	for i, as := range proj.As {
+		if as == attr {
+			return proj.Es[i].ReturnType().ToType()
+		}
+	}
+	return types.Type{}
+}
+
+func printWindow(op *Window) string {
+	return fmt.Sprintf("ω(%v, %v, %v)", op.Partitions, op.Fs, op.Functions)
+}
+
+func (f *WindowFunction) String() string {
+	var s string
+	switch f.Op {
+	case window.Aggregate:
+		s = fmt.Sprintf("%s(%s)", transformer.TransformerNames[f.Agg], f.Attr)
+	case window.Lag, window.Lead, window.NthValue:
+		s = fmt.Sprintf("%s(%s, %v)", window.FunctionNames[f.Op], f.Attr, f.N)
+	case window.Ntile:
+		s = fmt.Sprintf("%s(%v)", window.FunctionNames[f.Op], f.N)
+	default:
+		s = fmt.Sprintf("%s(%s)", window.FunctionNames[f.Op], f.Attr)
+	}
+	if f.Frame != nil {
+		s += " " + f.Frame.String()
+	}
+	return s + " -> " + f.Alias
+}
@@ -0,0 +1,401 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// cte is a common table expression of a with clause.
+type cte struct {
+	name string
+	cols tree.IdentifierList
+	stmt tree.SelectStatement
+	pos  int // the common table expressions before pos are visible to stmt
+	refs int // number of the references to the cte
+
+	recursive bool
+	iterating bool // references read the working table while building the recursive part
+	plan      *CTE // nil if the cte is inlined
+}
+
+// pushCTEs makes the common table expressions of the with clause of stmt
+// visible, a cte referenced more than once or recursive is materialized
+// and the others are inlined as derived tables.
+func (b *build) pushCTEs(stmt *tree.Select) error {
+	if stmt.With == nil {
+		return nil
+	}
+	names := make(map[string]struct{})
+	for i, c := range stmt.With.CTEs {
+		name := string(c.Name.Alias)
+		if _, ok := names[name]; ok {
+			return errors.New(errno.DuplicateAlias, fmt.Sprintf("Not unique table/alias: '%s'", name))
+		}
+		names[name] = struct{}{}
+		sel, ok := c.Stmt.(tree.SelectStatement)
+		if !ok {
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("common table expression '%s' is not a select statement", name))
+		}
+		refs := countReferences(stmt.Select, name)
+		for _, next := range stmt.With.CTEs[i+1:] {
+			if sel, ok := next.Stmt.(tree.SelectStatement); ok {
+				refs += countReferences(sel, name)
+			}
+		}
+		b.ctes = append(b.ctes, &cte{
+			name:      name,
+			cols:      c.Name.Cols,
+			stmt:      sel,
+			pos:       len(b.ctes),
+			refs:      refs,
+			recursive: stmt.With.IsRecursive && countReferences(sel, name) > 0,
+		})
+	}
+	return nil
+}
+
+// getCTE returns the innermost visible common table expression named name.
+func (b *build) getCTE(name string) *cte {
+	for i := len(b.ctes) - 1; i >= 0; i-- {
+		if b.ctes[i].name == name {
+			return b.ctes[i]
+		}
+	}
+	return nil
+}
+
+// visibleCTEs sets the common table expressions visible to the statement
+// of c and returns the function restoring the previous ones.
+func (b *build) visibleCTEs(c *cte) func() {
+	ctes := b.ctes
+	b.ctes = append([]*cte{}, ctes[:c.pos]...)
+	return func() {
+		b.ctes = ctes
+	}
+}
+
+func (b *build) buildCTE(c *cte, qry *Query) error {
+	if qry.Flg && qry.RenameRels[c.name] != nil {
+		ss := newScopeSet()
+		ss.JoinType = RELATION
+		ss.Scopes = append(ss.Scopes, qry.RenameRels[c.name])
+		qry.Push(ss)
+		return nil
+	}
+	switch {
+	case c.iterating:
+		return b.buildCTEScan(c, CTEWorking, qry)
+	case c.recursive || c.refs > 1:
+		if c.plan == nil {
+			if err := b.buildCTEPlan(c); err != nil {
+				return err
+			}
+		}
+		return b.buildCTEScan(c, CTEResult, qry)
+	}
+	restore := b.visibleCTEs(c)
+	err := b.buildSubQuery(c.stmt, qry)
+	restore()
+	if err != nil {
+		return err
+	}
+	ss := qry.Top()
+	child := ss.Scopes[len(ss.Scopes)-1]
+	return renameDerivedRelation(child, child.Op.(*DerivedRelation), c.name, c.cols)
+}
+
+// buildCTEScan builds a derived relation named after the cte over one of its tables.
+func (b *build) buildCTEScan(c *cte, table int, qry *Query) error {
+	pushDerivedScan(c.name, newCTEScan(c.plan, table), qry)
+	return nil
+}
+
+// pushDerivedScan pushes a derived relation named name over the scope child
+// which reads the rows kept by the plan.
+func pushDerivedScan(name string, child *Scope, qry *Query) {
+	s := &Scope{
+		Name:     name,
+		Children: []*Scope{child},
+	}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	rel := new(DerivedRelation)
+	for _, attr := range child.Result.Attrs {
+		typ := child.Result.AttrsMap[attr].Type
+		s.Result.Attrs = append(s.Result.Attrs, attr)
+		s.Result.AttrsMap[attr] = &Attribute{Name: attr, Type: typ}
+		rel.Proj.Rs = append(rel.Proj.Rs, 0)
+		rel.Proj.As = append(rel.Proj.As, attr)
+		rel.Proj.Es = append(rel.Proj.Es, &extend.Attribute{
+			Name: attr,
+			Type: typ.Oid,
+		})
+	}
+	s.Op = rel
+	ss := newScopeSet()
+	ss.JoinType = RELATION
+	ss.Scopes = append(ss.Scopes, s)
+	qry.Push(ss)
+}
+
+func newCTEScan(p *CTE, table int) *Scope {
+	s := &Scope{
+		Name: p.Name,
+		Op: &CTEScan{
+			Table: table,
+			CTE:   p,
+		},
+	}
+	s.Result.AttrsMap = make(map[string]*Attribute)
+	for _, attr := range p.Attrs {
+		s.Result.Attrs = append(s.Result.Attrs, attr)
+		s.Result.AttrsMap[attr] = &Attribute{
+			Name: attr,
+			Type: p.Scope.Result.AttrsMap[attr].Type,
+		}
+	}
+	return s
+}
+
+// buildCTEPlan builds the query of the materialized cte, a recursive cte is
+// the union of its non-recursive part and its recursive part which reads the
+// rows produced by the last iteration.
+func (b *build) buildCTEPlan(c *cte) error {
+	restore := b.visibleCTEs(c)
+	defer restore()
+
+	stmt := c.stmt
+	var union *tree.UnionClause
+	if c.recursive {
+		var err error
+		if union, err = recursiveUnion(c); err != nil {
+			return err
+		}
+		stmt = union.Left
+	}
+	qry := &Query{}
+	if err := b.buildSelectStatement(stmt, qry); err != nil {
+		return err
+	}
+	attrs := qry.Result
+	if len(c.cols) > 0 {
+		if len(c.cols) != len(qry.Result) {
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("common table expression '%s' has %v columns but %v column names", c.name, len(qry.Result), len(c.cols)))
+		}
+		attrs = make([]string, len(c.cols))
+		for i, col := range c.cols {
+			attrs[i] = string(col)
+		}
+	}
+	mp := make(map[string]struct{})
+	for _, attr := range attrs {
+		if _, ok := mp[attr]; ok {
+			return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", attr))
+		}
+		mp[attr] = struct{}{}
+	}
+	ts := make([]types.Type, len(attrs))
+	for i, col := range qry.ResultColumns() {
+		ts[i] = col.Type
+	}
+	c.plan = &CTE{
+		Name:  c.name,
+		Attrs: attrs,
+		Scope: buildCast(qry, attrs, ts),
+	}
+	if !c.recursive {
+		return nil
+	}
+	if !union.All {
+		c.plan.Scope = newDedupScope(c.plan.Scope)
+	}
+	b.ctes = append(b.ctes, c)
+	ctes := b.ctes
+	c.plan.Rebuild = func() (*Scope, error) {
+		saved := b.ctes
+		b.ctes = ctes
+		defer func() {
+			b.ctes = saved
+		}()
+		return b.buildRecursivePart(c, union, ts)
+	}
+	s, err := b.buildRecursivePart(c, union, ts)
+	if err != nil {
+		return err
+	}
+	c.plan.Recursive = s
+	return nil
+}
+
+// buildRecursivePart builds the recursive part of the recursive cte whose
+// references to the cte read the rows produced by the last iteration.
+func (b *build) buildRecursivePart(c *cte, union *tree.UnionClause, ts []types.Type) (*Scope, error) {
+	c.iterating = true
+	defer func() {
+		c.iterating = false
+	}()
+	qry := &Query{}
+	if err := b.buildSelectStatement(union.Right, qry); err != nil {
+		return nil, err
+	}
+	if len(qry.Result) != len(ts) {
+		return nil, errors.New(errno.CardinalityViolation, "The used SELECT statements have a different number of columns")
+	}
+	s := buildCast(qry, c.plan.Attrs, ts)
+	if !union.All { // only the new rows are kept
+		es := &Scope{
+			Children: []*Scope{s, newCTEScan(c.plan, CTEIntermediate)},
+			Op:       &SetOperation{Type: EXCEPT},
+		}
+		es.Result = copyResult(s)
+		s = es
+	}
+	return s, nil
+}
+
+// recursiveUnion returns the union of the non-recursive part and the
+// recursive part of the recursive cte.
+func recursiveUnion(c *cte) (*tree.UnionClause, error) {
+	stmt := c.stmt
+	for {
+		switch s := stmt.(type) {
+		case *tree.ParenSelect:
+			stmt = s.Select
+			continue
+		case *tree.Select:
+			if s.With == nil && s.OrderBy == nil && s.Limit == nil {
+				stmt = s.Select
+				continue
+			}
+		case *tree.UnionClause:
+			if s.Type != tree.UNION {
+				break
+			}
+			if countReferences(s.Left, c.name) > 0 {
+				return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("Recursive Common Table Expression '%s' should have one or more non-recursive query blocks followed by one or more recursive ones", c.name))
+			}
+			return s, nil
+		}
+		return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("Recursive Common Table Expression '%s' should contain a UNION", c.name))
+	}
+}
+
+func newDedupScope(child *Scope) *Scope {
+	s := &Scope{
+		Name:     child.Name,
+		Children: []*Scope{child},
+		Op:       &Dedup{},
+	}
+	s.Result = copyResult(child)
+	return s
+}
+
+func copyResult(s *Scope) ResultAttributes {
+	r := ResultAttributes{
+		AttrsMap: make(map[string]*Attribute),
+	}
+	for _, attr := range s.Result.Attrs {
+		r.Attrs = append(r.Attrs, attr)
+		r.AttrsMap[attr] = &Attribute{
+			Name: attr,
+			Type: s.Result.AttrsMap[attr].Type,
+		}
+	}
+	return r
+}
+
+// countReferences returns the number of the references to the table named
+// name without schema in stmt.
+func countReferences(stmt tree.SelectStatement, name string) int {
+	switch s := stmt.(type) {
+	case *tree.Select:
+		n := 0
+		if s.With != nil {
+			for _, c := range s.With.CTEs {
+				if sel, ok := c.Stmt.(tree.SelectStatement); ok {
+					n += countReferences(sel, name)
+				}
+				if string(c.Name.Alias) == name { // shadowed
+					return n
+				}
+			}
+		}
+		return n + countReferences(s.Select, name)
+	case *tree.ParenSelect:
+		return countReferences(s.Select, name)
+	case *tree.UnionClause:
+		return countReferences(s.Left, name) + countReferences(s.Right, name)
+	case *tree.SelectClause:
+		n := 0
+		if s.From != nil {
+			for _, tbl := range s.From.Tables {
+				n += countTableReferences(tbl, name)
+			}
+		}
+		for _, expr := range s.Exprs {
+			n += countExprReferences(expr.Expr, name)
+		}
+		if s.Where != nil {
+			n += countExprReferences(s.Where.Expr, name)
+		}
+		if s.Having != nil {
+			n += countExprReferences(s.Having.Expr, name)
+		}
+		return n
+	}
+	return 0
+}
+
+func countTableReferences(tbl tree.TableExpr, name string) int {
+	switch t := tbl.(type) {
+	case *tree.TableName:
+		if len(t.SchemaName) == 0 && string(t.ObjectName) == name {
+			return 1
+		}
+	case *tree.AliasedTableExpr:
+		return countTableReferences(t.Expr, name)
+	case *tree.ParenTableExpr:
+		return countTableReferences(t.Expr, name)
+	case *tree.JoinTableExpr:
+		n := countTableReferences(t.Left, name) + countTableReferences(t.Right, name)
+		if cond, ok := t.Cond.(*tree.OnJoinCond); ok {
+			n += countExprReferences(cond.Expr, name)
+		}
+		return n
+	case *tree.Select:
+		return countReferences(t, name)
+	case *tree.Subquery:
+		return countReferences(t.Select, name)
+	}
+	return 0
+}
+
+func countExprReferences(expr tree.Expr, name string) int {
+	n := 0
+	rewriteExpr(expr, func(e tree.Expr) (tree.Expr, bool, error) {
+		if sub, ok := e.(*tree.Subquery); ok {
+			n += countReferences(sub.Select, name)
+			return e, true, nil
+		}
+		return e, false, nil
+	})
+	return n
+}
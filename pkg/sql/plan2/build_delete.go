@@ -20,6 +20,10 @@ import (
 )
 
 func buildDelete(stmt *tree.Delete, ctx CompilerContext, query *Query) error {
+	tbls := tree.TableExprs{stmt.Table}
+	if len(stmt.Tables) > 0 {
+		tbls = stmt.TableRefs
+	}
 	selectStmt := &tree.Select{
 		Select: &tree.SelectClause{
 			//todo confirm how to set row_id
@@ -28,7 +32,7 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext, query *Query) error {
 					Expr: tree.UnqualifiedStar{},
 				},
 			},
-			From:  &tree.From{Tables: tree.TableExprs{stmt.Table}},
+			From:  &tree.From{Tables: tbls},
 			Where: stmt.Where,
 		},
 		OrderBy: stmt.OrderBy,
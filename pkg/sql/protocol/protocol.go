@@ -115,6 +115,14 @@ func EncodeScope(s Scope, buf *bytes.Buffer) error {
 	if err := EncodeInstructions(s.Ins, buf); err != nil {
 		return err
 	}
+	// Analyze
+	flg := byte(0)
+	for _, in := range s.Ins {
+		if in.Analyze != nil {
+			flg = 1
+		}
+	}
+	buf.WriteByte(flg)
 	return nil
 }
 
@@ -151,9 +159,40 @@ func DecodeScope(data []byte) (Scope, []byte, error) {
 	if s.Ins, data, err = DecodeInstructions(data); err != nil {
 		return s, nil, err
 	}
+	// Analyze
+	if data[0] == 1 {
+		vm.NewAnalyze(s.Ins)
+	}
+	data = data[1:]
 	return s, data, nil
 }
 
+// EncodeAnalyzes encodes the runtime statistics of the instructions of a
+// scope, a nil element is encoded as empty statistics.
+func EncodeAnalyzes(as []*vm.Analyze, buf *bytes.Buffer) error {
+	vs := make([]vm.Analyze, len(as))
+	for i, a := range as {
+		if a != nil {
+			vs[i] = a.Load()
+		}
+	}
+	data, err := encoding.Encode(vs)
+	if err != nil {
+		return err
+	}
+	buf.Write(data)
+	return nil
+}
+
+func DecodeAnalyzes(data []byte) ([]vm.Analyze, error) {
+	var vs []vm.Analyze
+
+	if err := encoding.Decode(data, &vs); err != nil {
+		return nil, err
+	}
+	return vs, nil
+}
+
 func EncodeInstructions(ins vm.Instructions, buf *bytes.Buffer) error {
 	buf.Write(encoding.EncodeUint32(uint32(len(ins))))
 	for _, in := range ins {
@@ -221,14 +260,29 @@ func EncodeInstruction(in vm.Instruction, buf *bytes.Buffer) error {
 	case vm.Join:
 		arg := in.Arg.(*join.Argument)
 		data, err := encoding.Encode(JoinArgument{
-			Vars:   arg.Vars,
-			Result: arg.Result,
+			Type:       arg.Type,
+			Vars:       arg.Vars,
+			Result:     arg.Result,
+			Attrs:      arg.Attrs,
+			Types:      arg.Types,
+			ProbeAttrs: arg.ProbeAttrs,
+			ProbeTypes: arg.ProbeTypes,
 		})
 		if err != nil {
 			return err
 		}
 		buf.Write(encoding.EncodeUint32(uint32(len(data))))
 		buf.Write(data)
+		for _, e := range []extend.Extend{arg.Cond, arg.NotIn} {
+			if e == nil {
+				buf.WriteByte(0)
+				continue
+			}
+			buf.WriteByte(1)
+			if err := EncodeExtend(e, buf); err != nil {
+				return err
+			}
+		}
 		return nil
 	case vm.Times:
 		arg := in.Arg.(*times.Argument)
@@ -424,10 +478,30 @@ func DecodeInstruction(data []byte) (vm.Instruction, []byte, error) {
 			return in, nil, err
 		}
 		joinArg := &join.Argument{
-			Vars:   arg.Vars,
-			Result: arg.Result,
+			Type:       arg.Type,
+			Vars:       arg.Vars,
+			Result:     arg.Result,
+			Attrs:      arg.Attrs,
+			Types:      arg.Types,
+			ProbeAttrs: arg.ProbeAttrs,
+			ProbeTypes: arg.ProbeTypes,
+		}
+		if joinArg.Type == join.Full {
+			joinArg.Unmatched = new(join.Unmatched)
 		}
 		data = data[n:]
+		for _, e := range []*extend.Extend{&joinArg.Cond, &joinArg.NotIn} {
+			if data[0] == 1 {
+				v, d, err := DecodeExtend(data[1:])
+				if err != nil {
+					return in, nil, err
+				}
+				*e = v
+				data = d
+			} else {
+				data = data[1:]
+			}
+		}
 		in.Arg = joinArg
 	case vm.Times:
 		var arg TimesArgument
@@ -4057,7 +4131,7 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -4484,7 +4558,7 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
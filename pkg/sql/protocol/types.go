@@ -15,6 +15,7 @@
 package protocol
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
@@ -237,8 +238,13 @@ func UntransferTransformArg(arg TransformArgument) *transform.Argument {
 }
 
 type JoinArgument struct {
-	Result []string
-	Vars   [][]string
+	Type       int
+	Result     []string
+	Vars       [][]string
+	Attrs      [][]string
+	Types      [][]types.Type
+	ProbeAttrs []string
+	ProbeTypes []types.Type
 }
 
 type TimesArgument struct {
@@ -16,6 +16,7 @@ package rewrite
 
 import (
 	"go/constant"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
@@ -53,22 +54,30 @@ func AstRewrite(stmt tree.Statement) tree.Statement {
 	// rewrite select statement.
 	switch st := stmt.(type) {
 	case *tree.Select:
+		if st.With != nil {
+			for _, cte := range st.With.CTEs {
+				cte.Stmt = AstRewrite(cte.Stmt)
+			}
+		}
 		switch t := st.Select.(type) {
 		case *tree.UnionClause:
 			t.Left, t.Right = AstRewrite(t.Left), AstRewrite(t.Right)
 		case *tree.SelectClause:
-			if t.Where != nil {
-				t.Where.Expr = rewriteFilterCondition(t.Where.Expr)
-			}
-			if t.From != nil { // deal with sub-query
-				for i := range t.From.Tables {
-					t.From.Tables[i] = subTableRewrite(t.From.Tables[i])
-				}
-			}
-		case *tree.Subquery:
-			t.Select = AstRewrite(t.Select)
+			rewriteClauseFilters(t)
+		case *tree.ParenSelect:
+			AstRewrite(t.Select)
 		}
 		return st
+	case *tree.ParenSelect:
+		AstRewrite(st.Select)
+		return st
+	case *tree.ExplainAnalyze:
+		st.Statement = AstRewrite(st.Statement)
+		return st
+	case *tree.ExplainStmt:
+		if IsExplainAnalyze(st) {
+			return AstRewrite(tree.NewExplainAnalyze(st.Statement, "text"))
+		}
 	}
 	// rewrite insert statement.
 	// rewrite update statement.
@@ -76,6 +85,93 @@ func AstRewrite(stmt tree.Statement) tree.Statement {
 	return stmt
 }
 
+// IsExplainAnalyze returns true if the explain statement has the analyze option,
+// which means the statement is executed rather than only planned.
+func IsExplainAnalyze(stmt *tree.ExplainStmt) bool {
+	for _, opt := range stmt.Options {
+		if strings.EqualFold(opt.Name, "analyze") {
+			return opt.Value == "NULL" || strings.EqualFold(opt.Value, "true")
+		}
+	}
+	return false
+}
+
+// rewriteClauseFilters rewrites the filter conditions of the select clause
+// and of the subqueries it contains, in the from clause as derived tables
+// and in the expressions of the select list, where and having clause.
+func rewriteClauseFilters(t *tree.SelectClause) {
+	if t.Where != nil {
+		t.Where.Expr = rewriteFilterCondition(t.Where.Expr)
+		rewriteSubqueries(t.Where.Expr)
+	}
+	if t.Having != nil {
+		rewriteSubqueries(t.Having.Expr)
+	}
+	for _, expr := range t.Exprs {
+		rewriteSubqueries(expr.Expr)
+	}
+	if t.From != nil { // deal with sub-query
+		for i := range t.From.Tables {
+			t.From.Tables[i] = subTableRewrite(t.From.Tables[i])
+		}
+	}
+}
+
+// rewriteSubqueries rewrites the select statements of the subqueries of expr
+// in place.
+func rewriteSubqueries(expr tree.Expr) {
+	switch t := expr.(type) {
+	case *tree.Subquery:
+		AstRewrite(t.Select)
+	case *tree.ParenExpr:
+		rewriteSubqueries(t.Expr)
+	case *tree.NotExpr:
+		rewriteSubqueries(t.Expr)
+	case *tree.UnaryExpr:
+		rewriteSubqueries(t.Expr)
+	case *tree.CastExpr:
+		rewriteSubqueries(t.Expr)
+	case *tree.IsNullExpr:
+		rewriteSubqueries(t.Expr)
+	case *tree.IsNotNullExpr:
+		rewriteSubqueries(t.Expr)
+	case *tree.AndExpr:
+		rewriteSubqueries(t.Left)
+		rewriteSubqueries(t.Right)
+	case *tree.OrExpr:
+		rewriteSubqueries(t.Left)
+		rewriteSubqueries(t.Right)
+	case *tree.XorExpr:
+		rewriteSubqueries(t.Left)
+		rewriteSubqueries(t.Right)
+	case *tree.BinaryExpr:
+		rewriteSubqueries(t.Left)
+		rewriteSubqueries(t.Right)
+	case *tree.ComparisonExpr:
+		rewriteSubqueries(t.Left)
+		rewriteSubqueries(t.Right)
+	case *tree.RangeCond:
+		rewriteSubqueries(t.Left)
+		rewriteSubqueries(t.From)
+		rewriteSubqueries(t.To)
+	case *tree.FuncExpr:
+		for _, e := range t.Exprs {
+			rewriteSubqueries(e)
+		}
+	case *tree.Tuple:
+		for _, e := range t.Exprs {
+			rewriteSubqueries(e)
+		}
+	case *tree.CaseExpr:
+		rewriteSubqueries(t.Expr)
+		for _, w := range t.Whens {
+			rewriteSubqueries(w.Cond)
+			rewriteSubqueries(w.Val)
+		}
+		rewriteSubqueries(t.Else)
+	}
+}
+
 func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 	if expr == nil {
 		return nil
@@ -105,6 +201,10 @@ func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 		if notExpr, ok := t.Expr.(*tree.NotExpr); ok {
 			return tree.NewNotExpr(rewriteFilterCondition(notExpr))
 		}
+		// not exists is decorrelated by the planner
+		if _, ok := t.Expr.(*tree.Subquery); ok {
+			return t
+		}
 		return tree.NewComparisonExpr(tree.EQUAL, t.Expr, tree.NewNumVal(constant.MakeInt64(0), "0", false))
 	// rewrite to != 0
 	case *tree.UnresolvedName, *tree.NumVal, *tree.CastExpr, *tree.UnaryExpr:
@@ -162,15 +262,20 @@ func subTableRewrite(t tree.TableExpr) tree.TableExpr {
 	case *tree.JoinTableExpr:
 		if onCondition, okk := subTable.Cond.(*tree.OnJoinCond); okk {
 			onCondition.Expr = rewriteFilterCondition(onCondition.Expr)
+			rewriteSubqueries(onCondition.Expr)
 		}
-		if subQuery, okk := subTable.Left.(*tree.Subquery); okk {
-			subTable.Left = AstRewrite(subQuery)
-		}
-		if subQuery, okk := subTable.Right.(*tree.Subquery); okk {
-			subTable.Right = AstRewrite(subQuery)
+		subTable.Left = subTableRewrite(subTable.Left)
+		if subTable.Right != nil {
+			subTable.Right = subTableRewrite(subTable.Right)
 		}
+	case *tree.AliasedTableExpr:
+		subTable.Expr = subTableRewrite(subTable.Expr)
 	case *tree.ParenTableExpr:
-		subTable = tree.NewParenTableExpr(subTableRewrite(subTable.Expr))
+		subTable.Expr = subTableRewrite(subTable.Expr)
+	case *tree.Subquery:
+		AstRewrite(subTable.Select)
+	case *tree.Select:
+		AstRewrite(subTable)
 	case *tree.UnresolvedName: // Do nothing.
 	}
 	return t
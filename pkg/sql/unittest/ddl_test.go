@@ -31,18 +31,37 @@ func TestTableFunction(t *testing.T) {
 				{"ddlt1"},
 			},
 		}},
+		{sql: "show tables where `Tables` = 'ddlt2';", res: executeResult{
+			attr: []string{"Tables"},
+			data: [][]string{
+				{"ddlt2"},
+			},
+		}},
+		{sql: "show full tables like 'ddlt3';", res: executeResult{
+			attr: []string{"Tables", "Table_type"},
+			data: [][]string{
+				{"ddlt3", "BASE TABLE"},
+			},
+		}},
 		{sql: "show columns from ddlt2;", res: executeResult{
 			attr: []string{"Field", "Type", "Null", "Key", "Default", "Extra"},
 			data: [][]string{
-				{"orderId", "varchar(100)", "", "", "NULL", ""},
-				{"uid", "int(32)", "", "", "NULL", ""},
-				{"price", "float(32)", "", "", "NULL", ""},
+				{"orderId", "varchar(100)", "YES", "", "NULL", ""},
+				{"uid", "int(32)", "YES", "", "NULL", ""},
+				{"price", "float(32)", "YES", "", "NULL", ""},
 			},
 		}},
 		{sql: "show columns from ddlt2 like 'pri%';", res: executeResult{
 			attr: []string{"Field", "Type", "Null", "Key", "Default", "Extra"},
 			data: [][]string{
-				{"price", "float(32)", "", "", "NULL", ""},
+				{"price", "float(32)", "YES", "", "NULL", ""},
+			},
+		}},
+		{sql: "show columns from ddlt2 where Field = 'uid' or Type = 'float(32)';", res: executeResult{
+			attr: []string{"Field", "Type", "Null", "Key", "Default", "Extra"},
+			data: [][]string{
+				{"uid", "int(32)", "YES", "", "NULL", ""},
+				{"price", "float(32)", "YES", "", "NULL", ""},
 			},
 		}},
 		{sql: "show create table ddlt2", res: executeResult{
@@ -69,12 +88,84 @@ func TestDatabaseFunction(t *testing.T) {
 			attr: []string{"Databases"},
 		}},
 		{sql: "show databases like 'd_';"},
+		{sql: "show databases like 'information%';", res: executeResult{
+			attr: []string{"Databases"},
+			data: [][]string{
+				{"information_schema"},
+			},
+		}},
+		{sql: "create database information_schema;", err: "[42000]database information_schema already exists"},
+		{sql: "drop database information_schema;", err: "[42501]access denied to database 'information_schema'"},
 		{sql: "drop database d2;", err: "database 'd2' not exist"},
 		{sql: "drop database if exists d2;"},
 	}
 	test(t, testCases)
 }
 
+// TestInformationSchema checks the tables of information_schema
+func TestInformationSchema(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table ist1 (a int, b varchar(10), primary key(a));"},
+		{sql: "select TABLE_NAME, TABLE_TYPE from information_schema.tables where TABLE_SCHEMA = 'information_schema' and TABLE_NAME like 'S%' order by TABLE_NAME;", res: executeResult{
+			attr: []string{"TABLE_NAME", "TABLE_TYPE"},
+			data: [][]string{
+				{"SCHEMATA", "SYSTEM VIEW"},
+				{"SESSION_VARIABLES", "SYSTEM VIEW"},
+				{"STATISTICS", "SYSTEM VIEW"},
+			},
+		}},
+		{sql: "select COLUMN_NAME, ORDINAL_POSITION, DATA_TYPE from information_schema.columns where TABLE_NAME = 'ist1' order by ORDINAL_POSITION;", res: executeResult{
+			attr: []string{"COLUMN_NAME", "ORDINAL_POSITION", "DATA_TYPE"},
+			data: [][]string{
+				{"a", "1", "int"},
+				{"b", "2", "varchar"},
+			},
+		}},
+		{sql: "select count(*) from information_schema.schemata where SCHEMA_NAME = 'test';", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{
+				{"1"},
+			},
+		}},
+		{sql: "create table ist2 (a int, b varchar(10), index ist2_b using bsi (b));"},
+		{sql: "show index from ist2;", res: executeResult{
+			attr: []string{"Table", "Non_unique", "Key_name", "Seq_in_index", "Column_name", "Index_type", "Comment"},
+			data: [][]string{
+				{"ist2", "1", "ist2_b", "1", "b", "BSI", ""},
+			},
+		}},
+		{sql: "show index from ist1 where Key_name = 'PRIMARY';", res: executeResult{null: true}, com: "memory engine does not keep the primary key"},
+		{sql: "show columns from ist1 where Field like 'b%' and `Null` = 'YES';", res: executeResult{
+			attr: []string{"Field", "Type", "Null", "Key", "Default", "Extra"},
+			data: [][]string{
+				{"b", "varchar(10)", "YES", "", "NULL", ""},
+			},
+		}},
+		{sql: "show variables like 'auto%';", res: executeResult{
+			attr: []string{"Variable_name", "Value"},
+			data: [][]string{
+				{"autocommit", "ON"},
+			},
+		}},
+		{sql: "show variables where Value = '16777216';", res: executeResult{
+			attr: []string{"Variable_name", "Value"},
+			data: [][]string{
+				{"max_allowed_packet", "16777216"},
+			},
+		}},
+		{sql: "show processlist;", res: executeResult{
+			attr: []string{"Id", "User", "Host", "db", "Command", "Time", "State", "Info"},
+			data: [][]string{
+				{"1", "root", "127.0.0.1", "test", "Query", "0", "executing", ""},
+			},
+		}},
+		{sql: "create table information_schema.t (a int);", err: "[42501]access denied to database 'information_schema'"},
+		{sql: "insert into information_schema.schemata values ('def', 'd', 'utf8mb4', 'utf8mb4_bin');", err: "[42501]access denied to database 'information_schema'"},
+		{sql: "drop table ist1, ist2;"},
+	}
+	test(t, testCases)
+}
+
 // TestIndexFunction is only used to check if the whole process about index can be run through
 func TestIndexFunction(t *testing.T) {
 	testCases := []testCase{
@@ -624,3 +624,206 @@ func TestRestrictOperator(t *testing.T) {
 	}
 	test(t, testCases)
 }
+
+func TestSetOperator(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table s_table1 (a int, b varchar(10));"},
+		{sql: "create table s_table2 (c bigint, d char(5));"},
+		{sql: "create table s_table3 (e double);"},
+		{sql: "insert into s_table1 values (1, 'x'), (1, 'x'), (2, 'y'), (3, null), (3, null);"},
+		{sql: "insert into s_table2 values (1, 'x'), (3, null), (4, 'z'), (4, 'z');"},
+		{sql: "insert into s_table3 values (1.5), (2);"},
+
+		{sql: "select a, b from s_table1 union all select c, d from s_table2 order by a, b;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"1", "x"}, {"1", "x"}, {"1", "x"}, {"2", "y"}, {"3", "null"}, {"3", "null"}, {"3", "null"}, {"4", "z"}, {"4", "z"},
+			},
+		}},
+		{sql: "select a, b from s_table1 union select c, d from s_table2 order by a desc;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"4", "z"}, {"3", "null"}, {"2", "y"}, {"1", "x"},
+			},
+		}},
+		{sql: "select a, b from s_table1 intersect select c, d from s_table2 order by a;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"1", "x"}, {"3", "null"},
+			},
+		}},
+		{sql: "select a, b from s_table1 intersect all select c, d from s_table2 order by a;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"1", "x"}, {"3", "null"},
+			},
+		}},
+		{sql: "select a, b from s_table1 except select c, d from s_table2;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"2", "y"},
+			},
+		}},
+		{sql: "select a, b from s_table1 except all select c, d from s_table2 order by a;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"1", "x"}, {"2", "y"}, {"3", "null"},
+			},
+		}},
+		{sql: "select a from s_table1 union select e from s_table3 order by a limit 2 offset 1;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"1.500000"}, {"2.000000"},
+			},
+		}},
+		{sql: "(select a from s_table1 where a > 1) union all (select c from s_table2 order by c limit 1) order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"1"}, {"2"}, {"3"}, {"3"},
+			},
+		}},
+		{sql: "select a from s_table1 except select c from s_table2 union select e from s_table3 order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"1.500000"}, {"2.000000"},
+			},
+		}},
+		{sql: "select x.a, count(*) from (select a from s_table1 union all select c from s_table2) as x group by x.a order by x.a;", res: executeResult{
+			attr: []string{"x.a", "count(*)"},
+			data: [][]string{
+				{"1", "3"}, {"2", "1"}, {"3", "3"}, {"4", "2"},
+			},
+		}},
+		{sql: "select a from s_table1 where a in (select a from s_table1 where a > 2 union select a from s_table1 where a < 2) order by a;", res: executeResult{
+			attr: []string{"a"},
+			data: [][]string{
+				{"1"}, {"1"}, {"3"}, {"3"},
+			},
+		}},
+		{sql: "select a from s_table1 where a > 5 intersect select c from s_table2;", res: executeResult{
+			null: true,
+		}},
+		{sql: "select a, a from s_table1 union all select c, c from s_table2 order by a;", res: executeResult{
+			attr: []string{"a", "a"},
+			data: [][]string{
+				{"1", "1"}, {"1", "1"}, {"1", "1"}, {"2", "2"}, {"3", "3"}, {"3", "3"}, {"3", "3"}, {"4", "4"}, {"4", "4"},
+			},
+		}},
+		{sql: "select a, b from s_table1 union all select c, d from s_table2 limit 6;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"1", "x"}, {"1", "x"}, {"2", "y"}, {"3", "null"}, {"3", "null"}, {"1", "x"},
+			},
+		}},
+		{sql: "select a, b from s_table1 union select c from s_table2;", err: "[21000]The used SELECT statements have a different number of columns"},
+		{sql: "select a, b from s_table1 union select c, d from s_table2 order by c;", err: "[42703]Unknown column 'c' in 'order clause'"},
+	}
+	test(t, testCases)
+}
+
+func TestCTE(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table org (id int, name varchar(10), manager int);"},
+		{sql: "insert into org values (1, 'ceo', 0), (2, 'cto', 1), (3, 'cfo', 1), (4, 'dev', 2), (5, 'ops', 2), (6, 'intern', 4);"},
+
+		{sql: "with c as (select id, name from org where id > 3) select name from c order by name;", res: executeResult{
+			attr: []string{"name"},
+			data: [][]string{
+				{"dev"}, {"intern"}, {"ops"},
+			},
+		}},
+		{sql: "with c(x, y) as (select id, name from org where id > 3) select y from c where x < 6 order by y;", res: executeResult{
+			attr: []string{"y"},
+			data: [][]string{
+				{"dev"}, {"ops"},
+			},
+		}},
+		{sql: "with a as (select id from org), b as (select id from a where id > 4) select id from b order by id;", res: executeResult{
+			attr: []string{"id"},
+			data: [][]string{
+				{"5"}, {"6"},
+			},
+		}},
+		{sql: "with c as (select id, manager from org) select c1.id, c2.id from c c1, c c2 where c1.manager = c2.id and c2.manager = 1 order by c1.id;", res: executeResult{
+			attr: []string{"c1.id", "c2.id"},
+			data: [][]string{
+				{"4", "2"}, {"5", "2"},
+			},
+		}},
+		{sql: "with c as (select id, manager from org) select count(*) from c a join c b on a.id = b.manager;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{
+				{"5"},
+			},
+		}},
+		{sql: "with c as (select id, manager from org) select count(*) from c, org;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{
+				{"36"},
+			},
+		}},
+		{sql: "with c as (select id, manager from org) select sum(a.id), count(*) from c a join c b on a.id = b.manager;", res: executeResult{
+			attr: []string{"sum(a.id)", "count(*)"},
+			data: [][]string{
+				{"10", "5"},
+			},
+		}},
+		{sql: "select count(*) from (select id, manager from org) a join (select id, manager from org) b on a.id = b.manager;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{
+				{"5"},
+			},
+		}},
+		{sql: "select sum(b.id) from (select id, manager from org) a join org b on a.id = b.manager;", res: executeResult{
+			attr: []string{"sum(b.id)"},
+			data: [][]string{
+				{"20"},
+			},
+		}},
+		{sql: "select a.manager, count(*) from (select id, manager from org) a join org b on a.id = b.manager group by a.manager order by a.manager;", res: executeResult{
+			attr: []string{"a.manager", "count(*)"},
+			data: [][]string{
+				{"0", "2"}, {"1", "2"}, {"2", "1"},
+			},
+		}},
+		{sql: "with c as (select id, manager from org) select id from c where manager in (select id from c where manager = 1) order by id;", res: executeResult{
+			attr: []string{"id"},
+			data: [][]string{
+				{"4"}, {"5"},
+			},
+		}},
+		{sql: "with recursive sub(id, lvl) as (select id, manager from org where manager = 0 union all select org.id, sub.lvl + 1 from org, sub where org.manager = sub.id) select id, lvl from sub order by id;", res: executeResult{
+			attr: []string{"id", "lvl"},
+			data: [][]string{
+				{"1", "0"}, {"2", "1"}, {"3", "1"}, {"4", "2"}, {"5", "2"}, {"6", "3"},
+			},
+		}},
+		{sql: "with recursive up(id) as (select id from org where id = 6 union select org.manager from org, up where org.id = up.id and org.manager > 0) select id from up order by id;", res: executeResult{
+			attr: []string{"id"},
+			data: [][]string{
+				{"1"}, {"2"}, {"4"}, {"6"},
+			},
+		}},
+		{sql: "with recursive c(n) as (select id from org where id < 3 union select n + 1 from c where n < 5) select n from c order by n;", res: executeResult{
+			attr: []string{"n"},
+			data: [][]string{
+				{"1"}, {"2"}, {"3"}, {"4"}, {"5"},
+			},
+		}},
+		{sql: "with recursive c(n) as (select id from org where id < 3 union all select n + 1 from c where n < 4) select n from c order by n;", res: executeResult{
+			attr: []string{"n"},
+			data: [][]string{
+				{"1"}, {"2"}, {"2"}, {"3"}, {"3"}, {"4"}, {"4"},
+			},
+		}},
+		{sql: "with recursive c(n) as (select id from org where id = 1 union all select n + 1 from c where n < 100) select count(*) from c;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{
+				{"100"},
+			},
+		}},
+		{sql: "with recursive c(n) as (select id from org where id = 1 union all select n + 1 from c) select n from c;", err: "[54000]Recursive query aborted after 1000 iterations. Try increasing processLimitationMaxRecursionDepth to a larger value."},
+		{sql: "with recursive c(n) as (select n from c union all select id from org) select n from c;", err: "[42P19]Recursive Common Table Expression 'c' should have one or more non-recursive query blocks followed by one or more recursive ones"},
+	}
+	test(t, testCases)
+}
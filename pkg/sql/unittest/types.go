@@ -22,6 +22,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/infoschema"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -86,13 +87,38 @@ type executeResult struct {
 	data [][]string // data records the expected data, if no care about that, just set it nil
 }
 
+// testSession is the session of the frontend seen by information_schema,
+// it has a connection of the root user which runs the test.
+type testSession struct{}
+
+func (testSession) Processes() []infoschema.Process {
+	return []infoschema.Process{
+		{Id: 1, User: "root", Host: testEngineIP, Db: "test", Command: "Query", State: "executing"},
+	}
+}
+
+func (testSession) Variables() []infoschema.Variable {
+	return []infoschema.Variable{
+		{Name: "autocommit", Value: "ON"},
+		{Name: "max_allowed_packet", Value: "16777216"},
+	}
+}
+
+func (testSession) User() string {
+	return "root"
+}
+
+func (testSession) Privileges() infoschema.Privileges {
+	return nil
+}
+
 func newTestEngine() (engine.Engine, *process.Process) {
 	hm := host.New(1 << 30)
 	gm := guest.New(1<<30, hm)
 	proc := process.New(mheap.New(gm))
 	//e := memEngine.NewTestEngine()
 	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: testEngineIP})
-	return e, proc
+	return infoschema.New(e, testEngineIP, testSession{}), proc
 }
 
 func executeSQL(sql string, e engine.Engine, proc *process.Process) (*executeResult, error) {
@@ -151,8 +177,22 @@ func test(t *testing.T, testCases []testCase) {
 	}
 }
 
+// fillTestResult appends the rows of a batch of the result to r.
 func fillTestResult(r *executeResult, bat *batch.Batch) error {
-	return convertBatch(r, bat)
+	res := &executeResult{}
+	if err := convertBatch(res, bat); err != nil {
+		return err
+	}
+	if res.null {
+		if r.attr == nil {
+			r.null = true
+		}
+		return nil
+	}
+	r.null = false
+	r.attr = res.attr
+	r.data = append(r.data, res.data...)
+	return nil
 }
 
 // convertBatch will convert a batch into an executeResult
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -40,6 +41,10 @@ func String(_ interface{}, buf *bytes.Buffer) {
 func Prepare(proc *process.Process, arg interface{}) error {
 	n := arg.(*Argument)
 	n.ctr = new(Container)
+	n.ctr.typ = n.Type
+	n.ctr.cond = n.Cond
+	n.ctr.notIn = n.NotIn
+	n.ctr.unmatched = n.Unmatched
 	{
 		n.ctr.result = make(map[string]uint8)
 		for _, attr := range n.Result {
@@ -48,6 +53,7 @@ func Prepare(proc *process.Process, arg interface{}) error {
 	}
 	n.ctr.zs = make([]int64, UnitLimit)
 	n.ctr.sels = make([]int64, UnitLimit)
+	n.ctr.matches = make([][]int64, UnitLimit)
 	n.ctr.views = make([]*view, len(n.Vars))
 	{
 		n.ctr.mx = make([][]int64, len(n.Vars)+1)
@@ -91,13 +97,35 @@ func Prepare(proc *process.Process, arg interface{}) error {
 		n.ctr.views[i].intHashMap = ht.IntHashMap
 		n.ctr.views[i].strHashMap = ht.StrHashMap
 	}
+	if n.Unmatched != nil {
+		n.Unmatched.Lock()
+		if n.Unmatched.matched == nil {
+			n.Unmatched.matched = make([]bool, len(n.Bats[0].Zs))
+		}
+		n.Unmatched.Unlock()
+	}
 	return nil
 }
 
+// SetCopies sets the number of copies of the full join sharing u, the
+// default is one.
+func (u *Unmatched) SetCopies(n int) {
+	u.Lock()
+	defer u.Unlock()
+	u.copies = n
+}
+
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	n := arg.(*Argument)
 	bat := proc.Reg.InputBatch
 	if bat == nil {
+		if n.ctr.typ == Full && !n.ctr.done {
+			n.ctr.done = true
+			if err := n.ctr.processUnmatched(n, proc); err != nil {
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+		}
 		return false, nil
 	}
 	if len(bat.Zs) == 0 {
@@ -114,23 +142,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
 	defer batch.Clean(bat, proc.Mp)
 	if len(ctr.attrs) == 0 {
-		ctr.oattrs = append(ctr.oattrs, bat.Attrs...)
-		for i, attr := range bat.Attrs {
-			if _, ok := ctr.result[attr]; ok {
-				ctr.ois = append(ctr.ois, i)
-				ctr.is = append(ctr.is, len(ctr.attrs))
-				ctr.attrs = append(ctr.attrs, attr)
-			}
-		}
-		for _, v := range ctr.views {
-			for i := len(v.attrs); i < len(v.bat.Attrs); i++ {
-				if _, ok := ctr.result[v.bat.Attrs[i]]; ok {
-					v.ois = append(v.ois, i)
-					v.is = append(v.is, len(ctr.attrs))
-					ctr.attrs = append(ctr.attrs, v.bat.Attrs[i])
-				}
-			}
-		}
+		ctr.init(bat.Attrs)
 	} else {
 		batch.Reorder(bat, ctr.oattrs)
 	}
@@ -155,7 +167,27 @@ func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
 		}
 	}
 	count := len(bat.Zs)
-	if ctr.isPure {
+	if ctr.typ != Inner {
+		for i := 0; i < count; i += UnitLimit {
+			n := count - i
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			if err := ctr.probeView(i, n, bat, ctr.views[0]); err != nil {
+				return err
+			}
+			if ctr.cond != nil || ctr.notIn != nil {
+				if err := ctr.applyCond(n, i, bat, ctr.views[0], proc); err != nil {
+					batch.Clean(rbat, proc.Mp)
+					return err
+				}
+			}
+			if err := ctr.processOuterJoin(n, i, bat, rbat, proc); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return err
+			}
+		}
+	} else if ctr.isPure {
 		for i := 0; i < count; i += UnitLimit {
 			n := count - i
 			if n > UnitLimit {
@@ -192,6 +224,90 @@ func (ctr *Container) probe(bat *batch.Batch, proc *process.Process) error {
 	return nil
 }
 
+// init builds the layout of the result from the attributes of the probe side.
+func (ctr *Container) init(attrs []string) {
+	ctr.oattrs = append(ctr.oattrs, attrs...)
+	for i, attr := range attrs {
+		if _, ok := ctr.result[attr]; ok {
+			ctr.ois = append(ctr.ois, i)
+			ctr.is = append(ctr.is, len(ctr.attrs))
+			ctr.attrs = append(ctr.attrs, attr)
+		}
+	}
+	for _, v := range ctr.views {
+		if ctr.typ == Semi {
+			break
+		}
+		for i := len(v.attrs); i < len(v.bat.Attrs); i++ {
+			if _, ok := ctr.result[v.bat.Attrs[i]]; ok {
+				v.ois = append(v.ois, i)
+				v.is = append(v.is, len(ctr.attrs))
+				ctr.attrs = append(ctr.attrs, v.bat.Attrs[i])
+				if ctr.typ == Left || ctr.typ == Anti || ctr.typ == Full {
+					v.nulls = append(v.nulls, newNullVector(v.bat.Vecs[i]))
+				}
+			}
+		}
+	}
+}
+
+// processUnmatched outputs the rows of the view of a full join without a
+// match padded with nulls, which is done by the last copy of the join
+// reaching the end of the probe side.
+func (ctr *Container) processUnmatched(arg *Argument, proc *process.Process) error {
+	u := arg.Unmatched
+	u.Lock()
+	defer u.Unlock()
+	if u.copies--; u.copies > 0 {
+		return nil
+	}
+	if len(ctr.attrs) == 0 {
+		ctr.init(arg.ProbeAttrs)
+	}
+	v := ctr.views[0]
+	sels := ctr.sels[:0]
+	for i, z := range v.bat.Zs {
+		if z > 0 && !u.matched[i] {
+			sels = append(sels, int64(i))
+		}
+	}
+	ctr.sels = sels
+	if len(sels) == 0 {
+		return nil
+	}
+	mp := make(map[string]types.Type)
+	for i, attr := range arg.ProbeAttrs {
+		mp[attr] = arg.ProbeTypes[i]
+	}
+	rbat := batch.New(true, ctr.attrs)
+	for _, j := range ctr.is {
+		typ := mp[ctr.attrs[j]]
+		nv := newNull(typ)
+		rbat.Vecs[j] = vector.New(typ)
+		for range sels {
+			if err := vector.UnionOne(rbat.Vecs[j], nv, 0, proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return err
+			}
+		}
+	}
+	for i, j := range v.is {
+		vec := v.bat.Vecs[v.ois[i]]
+		rbat.Vecs[j] = vector.New(vec.Typ)
+		for _, sel := range sels {
+			if err := vector.UnionOne(rbat.Vecs[j], vec, sel, proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return err
+			}
+		}
+	}
+	for _, sel := range sels {
+		rbat.Zs = append(rbat.Zs, v.bat.Zs[sel])
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
 func (ctr *Container) processPureJoin(n, start int, bat, rbat *batch.Batch, proc *process.Process) error {
 	{
 		var flg bool
@@ -315,7 +431,7 @@ func (ctr *Container) processJoin(n, start int, bat, rbat *batch.Batch, proc *pr
 			v := ctr.views[j-1]
 			for i, k := range v.is {
 				for _, row := range rows {
-					if err := vector.UnionOne(rbat.Vecs[k], v.bat.Vecs[ctr.ois[i]], row, proc.Mp); err != nil {
+					if err := vector.UnionOne(rbat.Vecs[k], v.bat.Vecs[v.ois[i]], row, proc.Mp); err != nil {
 						return err
 					}
 				}
@@ -329,6 +445,235 @@ func (ctr *Container) processJoin(n, start int, bat, rbat *batch.Batch, proc *pr
 	return nil
 }
 
+// processOuterJoin processes the left, semi, anti and full joins, the sels
+// of the view are -1 for the rows padded with nulls.
+func (ctr *Container) processOuterJoin(n, start int, bat, rbat *batch.Batch, proc *process.Process) error {
+	v := ctr.views[0]
+	sels, vsels := ctr.mx[0][:0], ctr.mx[1][:0]
+	ctr.zs = ctr.zs[:0]
+	for i := 0; i < n; i++ {
+		row := int64(i + start)
+		if bat.Zs[row] == 0 {
+			continue
+		}
+		switch ctr.typ {
+		case Semi:
+			if v.values[i] == 0 {
+				continue
+			}
+			sels = append(sels, row)
+			ctr.zs = append(ctr.zs, bat.Zs[row])
+		case Anti:
+			if v.values[i] != 0 {
+				continue
+			}
+			sels = append(sels, row)
+			vsels = append(vsels, -1)
+			ctr.zs = append(ctr.zs, bat.Zs[row])
+		case Left, Full:
+			if v.values[i] == 0 {
+				sels = append(sels, row)
+				vsels = append(vsels, -1)
+				ctr.zs = append(ctr.zs, bat.Zs[row])
+				continue
+			}
+			matches := v.sels[v.values[i]-1]
+			if ctr.cond != nil {
+				matches = ctr.matches[i]
+			}
+			for _, sel := range matches {
+				sels = append(sels, row)
+				vsels = append(vsels, sel)
+				ctr.zs = append(ctr.zs, bat.Zs[row]*v.bat.Zs[sel])
+			}
+		}
+	}
+	ctr.mx[0], ctr.mx[1] = sels, vsels
+	if ctr.typ == Full {
+		u := ctr.unmatched
+		u.Lock()
+		for _, sel := range vsels {
+			if sel >= 0 {
+				u.matched[sel] = true
+			}
+		}
+		u.Unlock()
+	}
+	for i, j := range ctr.is {
+		for _, sel := range sels {
+			if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[ctr.ois[i]], sel, proc.Mp); err != nil {
+				return err
+			}
+		}
+	}
+	for i, j := range v.is {
+		for _, sel := range vsels {
+			var err error
+			if sel < 0 {
+				err = vector.UnionOne(rbat.Vecs[j], v.nulls[i], 0, proc.Mp)
+			} else {
+				err = vector.UnionOne(rbat.Vecs[j], v.bat.Vecs[v.ois[i]], sel, proc.Mp)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	rbat.Zs = append(rbat.Zs, ctr.zs...)
+	return nil
+}
+
+// applyCond applies each row of the probe side to its candidates in the
+// view one by one, a row keeps its match only if the condition of the join
+// holds for at least one of the candidates and the inequality of NOT IN does
+// not hold for it. The candidates satisfying the condition are recorded in
+// matches for the left and full joins.
+func (ctr *Container) applyCond(n, start int, bat *batch.Batch, v *view, proc *process.Process) error {
+	var rows []int // the probe row of each pair
+	var sels, vsels []int64
+
+	for i := 0; i < n; i++ {
+		if v.values[i] == 0 || bat.Zs[i+start] == 0 {
+			continue
+		}
+		for _, sel := range v.sels[v.values[i]-1] {
+			rows = append(rows, i)
+			sels = append(sels, int64(i+start))
+			vsels = append(vsels, sel)
+		}
+	}
+	matched := make([]bool, n)
+	if len(rows) > 0 {
+		var attrs []string
+
+		mp := make(map[string]struct{})
+		for _, e := range []extend.Extend{ctr.cond, ctr.notIn} {
+			if e == nil {
+				continue
+			}
+			for _, attr := range e.Attributes() {
+				if _, ok := mp[attr]; !ok {
+					mp[attr] = struct{}{}
+					attrs = append(attrs, attr)
+				}
+			}
+		}
+		cbat := batch.New(true, attrs)
+		cbat.Zs = make([]int64, len(rows))
+		for i := range cbat.Zs {
+			cbat.Zs[i] = 1
+		}
+		for i, attr := range attrs {
+			vec, ss := batch.GetVector(bat, attr), sels
+			if vec == nil {
+				vec, ss = batch.GetVector(v.bat, attr), vsels
+			}
+			cbat.Vecs[i] = vector.New(vec.Typ)
+			for _, sel := range ss {
+				if err := vector.UnionOne(cbat.Vecs[i], vec, sel, proc.Mp); err != nil {
+					batch.Clean(cbat, proc.Mp)
+					return err
+				}
+			}
+		}
+		flgs := make([]bool, len(rows)) // whether each pair is a match
+		if ctr.cond == nil {
+			for i := range flgs {
+				flgs[i] = true
+			}
+		} else {
+			vec, _, err := ctr.cond.Eval(cbat, proc)
+			if err != nil {
+				batch.Clean(cbat, proc.Mp)
+				return err
+			}
+			for _, sel := range vec.Col.([]int64) {
+				flgs[sel] = true
+			}
+			process.Put(proc, vec)
+		}
+		if ctr.notIn != nil {
+			vec, _, err := ctr.notIn.Eval(cbat, proc)
+			if err != nil {
+				batch.Clean(cbat, proc.Mp)
+				return err
+			}
+			for _, sel := range vec.Col.([]int64) {
+				flgs[sel] = false
+			}
+			process.Put(proc, vec)
+		}
+		for i := 0; i < n; i++ {
+			ctr.matches[i] = ctr.matches[i][:0]
+		}
+		for i, flg := range flgs {
+			if flg {
+				matched[rows[i]] = true
+				ctr.matches[rows[i]] = append(ctr.matches[rows[i]], vsels[i])
+			}
+		}
+		batch.Clean(cbat, proc.Mp)
+	}
+	for i := 0; i < n; i++ {
+		if !matched[i] {
+			v.values[i] = 0
+		}
+	}
+	return nil
+}
+
+// newNullVector returns a vector with a null of the type of vec, which is
+// the source of the nulls padded to the rows without a match.
+func newNullVector(vec *vector.Vector) *vector.Vector {
+	nv := newNull(vec.Typ)
+	nv.Ref = vec.Ref
+	return nv
+}
+
+// newNull returns a vector with a null of type typ.
+func newNull(typ types.Type) *vector.Vector {
+	nv := vector.New(typ)
+	switch typ.Oid {
+	case types.T_int8:
+		nv.Col = make([]int8, 1)
+	case types.T_int16:
+		nv.Col = make([]int16, 1)
+	case types.T_int32:
+		nv.Col = make([]int32, 1)
+	case types.T_int64:
+		nv.Col = make([]int64, 1)
+	case types.T_uint8:
+		nv.Col = make([]uint8, 1)
+	case types.T_uint16:
+		nv.Col = make([]uint16, 1)
+	case types.T_uint32:
+		nv.Col = make([]uint32, 1)
+	case types.T_uint64:
+		nv.Col = make([]uint64, 1)
+	case types.T_float32:
+		nv.Col = make([]float32, 1)
+	case types.T_float64:
+		nv.Col = make([]float64, 1)
+	case types.T_date:
+		nv.Col = make([]types.Date, 1)
+	case types.T_datetime:
+		nv.Col = make([]types.Datetime, 1)
+	case types.T_timestamp:
+		nv.Col = make([]types.Timestamp, 1)
+	case types.T_decimal64:
+		nv.Col = make([]types.Decimal64, 1)
+	case types.T_decimal128:
+		nv.Col = make([]types.Decimal128, 1)
+	case types.T_char, types.T_varchar, types.T_json:
+		nv.Col = &types.Bytes{
+			Offsets: make([]uint32, 1),
+			Lengths: make([]uint32, 1),
+		}
+	}
+	nulls.Add(nv.Nsp, 0)
+	return nv
+}
+
 func (ctr *Container) product(xs [][]int64, ys []int64) [][]int64 {
 	rs := ctr.mx1[:len(xs)+1]
 	{ // reset
@@ -355,7 +700,24 @@ func (ctr *Container) dupMatrix(xs [][]int64) [][]int64 {
 	return mx
 }
 
+// probeProductView probes the view of a cross product, every row matches
+// all rows of the view if it is not empty.
+func (ctr *Container) probeProductView(n int, v *view) {
+	var value uint64
+
+	if len(v.sels) > 0 {
+		value = 1
+	}
+	for k := 0; k < n; k++ {
+		v.values[k] = value
+	}
+}
+
 func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
+	if len(v.vecs) == 0 {
+		ctr.probeProductView(n, v)
+		return nil
+	}
 	if len(v.vecs) == 1 {
 		return ctr.probeViewWithOneVar(i, n, bat, v)
 	}
@@ -372,7 +734,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -388,7 +750,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -404,7 +766,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -420,7 +782,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -436,7 +798,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -452,7 +814,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -468,7 +830,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -484,7 +846,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -500,7 +862,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -516,7 +878,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -532,7 +894,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -548,7 +910,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -563,7 +925,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 					}
@@ -576,7 +938,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			ctr.hstr.keys[k] = append(ctr.hstr.keys[k], hashtable.StrKeyPadding[l:]...)
 		}
 	}
-	v.strHashMap.FindStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], v.values)
+	v.strHashMap.FindStringBatchWithRing(ctr.strHashStates, ctr.zValues, ctr.hstr.keys[:n], v.values)
 	for k := 0; k < n; k++ {
 		ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
 	}
@@ -598,7 +960,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -617,7 +979,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -636,7 +998,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -655,7 +1017,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -674,7 +1036,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -693,7 +1055,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -712,7 +1074,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -731,7 +1093,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -750,7 +1112,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -769,7 +1131,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -788,7 +1150,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -807,7 +1169,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -833,7 +1195,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 			}
@@ -0,0 +1,137 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+type joinTestCase struct {
+	typ    int
+	result []string
+	rows   int
+	keys   []int64 // keys of the output rows, -1 means null
+	pays   []int64 // payloads of the view of the output rows, -1 means null
+}
+
+func TestOuterJoin(t *testing.T) {
+	tcs := []joinTestCase{
+		{typ: Inner, result: []string{"0", "1", "2"}, rows: 3, pays: []int64{20, 30, 31}},
+		{typ: Left, result: []string{"0", "1", "2"}, rows: 5, pays: []int64{-1, 20, 30, 31, -1}},
+		{typ: Semi, result: []string{"0", "1"}, rows: 2, keys: []int64{2, 3}},
+		{typ: Anti, result: []string{"0", "1", "2"}, rows: 2, keys: []int64{1, -1}, pays: []int64{-1, -1}},
+	}
+	for _, tc := range tcs {
+		proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+		// the probe side is (1, 2, 3, null) and the view is (2, 3, 3, 5)
+		view := newBatch([]string{"0", "2"}, [][]int64{{2, 3, 3, 5}, {20, 30, 31, 50}}, -1)
+		constructView(view, "0")
+		arg := &Argument{
+			Type:   tc.typ,
+			Result: tc.result,
+			Vars:   [][]string{{"0"}},
+			Bats:   []*batch.Batch{view},
+		}
+		require.NoError(t, Prepare(proc, arg))
+		proc.Reg.InputBatch = newBatch([]string{"0", "1"}, [][]int64{{1, 2, 3, 0}, {10, 20, 30, 40}}, 3)
+		_, err := Call(proc, arg)
+		require.NoError(t, err)
+		rbat := proc.Reg.InputBatch
+		require.Equal(t, tc.rows, len(rbat.Zs))
+		require.Equal(t, tc.result, rbat.Attrs)
+		if tc.keys != nil {
+			vec := batch.GetVector(rbat, "0")
+			for i, key := range tc.keys {
+				if key < 0 {
+					require.True(t, nulls.Contains(vec.Nsp, uint64(i)))
+				} else {
+					require.Equal(t, key, vec.Col.([]int64)[i])
+				}
+			}
+		}
+		if tc.pays != nil {
+			vec := batch.GetVector(rbat, "2")
+			for i, pay := range tc.pays {
+				if pay < 0 {
+					require.True(t, nulls.Contains(vec.Nsp, uint64(i)))
+				} else {
+					require.False(t, nulls.Contains(vec.Nsp, uint64(i)))
+					require.Equal(t, pay, vec.Col.([]int64)[i])
+				}
+			}
+		}
+	}
+}
+
+func TestNullVector(t *testing.T) {
+	for _, oid := range []types.T{types.T_int8, types.T_float64, types.T_date, types.T_decimal128, types.T_varchar, types.T_json} {
+		vec := newNullVector(vector.New(types.Type{Oid: oid}))
+		require.Equal(t, 1, vector.Length(vec))
+		require.True(t, nulls.Contains(vec.Nsp, 0))
+	}
+}
+
+// newBatch returns a batch of int64 columns, the row null of the first
+// column is null if null is not negative.
+func newBatch(attrs []string, cols [][]int64, null int) *batch.Batch {
+	bat := batch.New(true, attrs)
+	for i, col := range cols {
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		bat.Vecs[i].Col = col
+		bat.Vecs[i].Ref = 1
+	}
+	if null >= 0 {
+		nulls.Add(bat.Vecs[0].Nsp, uint64(null))
+	}
+	bat.Zs = make([]int64, len(cols[0]))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func constructView(bat *batch.Batch, attr string) {
+	ht := &HashTable{
+		IntHashMap: &hashtable.Int64HashMap{},
+	}
+	ht.IntHashMap.Init()
+	vs := batch.GetVector(bat, attr).Col.([]int64)
+	keys := make([]uint64, len(vs))
+	for i, v := range vs {
+		keys[i] = uint64(v)
+	}
+	hashes := make([]uint64, len(vs))
+	values := make([]uint64, len(vs))
+	ht.IntHashMap.InsertBatch(len(vs), hashes, unsafe.Pointer(&keys[0]), values)
+	for i, v := range values {
+		if int(v) > len(ht.Sels) {
+			ht.Sels = append(ht.Sels, nil)
+		}
+		ht.Sels[v-1] = append(ht.Sels[v-1], int64(i))
+	}
+	bat.Ht = ht
+}
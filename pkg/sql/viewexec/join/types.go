@@ -15,15 +15,28 @@
 package join
 
 import (
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 )
 
 const (
 	UnitLimit = 256
 )
 
+// join types, the left, semi, anti and full joins have exactly one view
+const (
+	Inner = iota
+	Left  // rows of the probe side without a match are padded with nulls
+	Semi  // rows of the probe side with at least one match
+	Anti  // rows of the probe side without any match
+	Full  // a left join followed by the rows of the view without a match
+)
+
 var OneInt64s []int64
 
 type view struct {
@@ -34,6 +47,7 @@ type view struct {
 
 	attrs      []string
 	values     []uint64
+	nulls      []*vector.Vector // sources of the padded nulls of outer joins
 	sels       [][]int64
 	bat        *batch.Batch
 	vecs       []*vector.Vector
@@ -48,15 +62,25 @@ type HashTable struct {
 }
 
 type Container struct {
+	typ    int
 	isPure bool // true: primary key join
 
+	cond  extend.Extend
+	notIn extend.Extend
+
 	is  []int // subscript in the result attribute
 	ois []int // subscript in the origin batch
 
+	done bool // the unmatched rows of the view of a full join are processed
+
 	zs []int64
 
 	sels []int64
 
+	matches [][]int64 // the candidates of each row which satisfy cond
+
+	unmatched *Unmatched
+
 	attrs []string
 
 	oattrs []string // order of attributes of input batch
@@ -82,8 +106,36 @@ type Container struct {
 }
 
 type Argument struct {
+	Type   int // join type
 	Result []string
 	Vars   [][]string
-	ctr    *Container
-	Bats   []*batch.Batch
+	// Attrs and Types are the attributes of the views, they are used to
+	// build an empty view when the build side of an outer join is empty
+	Attrs [][]string
+	Types [][]types.Type
+	// Cond is the condition of a semi or anti join which is not an equality
+	// of the two sides, it is evaluated for each candidate of a probe row
+	Cond extend.Extend
+	// NotIn is the inequality of the two sides of the NOT IN of a null-aware
+	// anti join, a candidate matches unless it holds, so that the candidates
+	// with a null on either side are matches
+	NotIn extend.Extend
+	// ProbeAttrs and ProbeTypes are the attributes of the probe side of a
+	// full join, they are padded with nulls for the unmatched rows of the
+	// view even if the probe side is empty
+	ProbeAttrs []string
+	ProbeTypes []types.Type
+	// Unmatched is shared by the copies of a full join
+	Unmatched *Unmatched
+	ctr       *Container
+	Bats      []*batch.Batch
+}
+
+// Unmatched collects the rows of the view of a full join matched by the
+// copies of the join, the last copy reaching the end of the probe side
+// outputs the rows without a match.
+type Unmatched struct {
+	sync.Mutex
+	copies  int
+	matched []bool
 }
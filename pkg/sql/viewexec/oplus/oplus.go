@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -85,58 +86,81 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 	}
 }
 
+// processFreeVars merges the groups sent by the transforms partition by partition,
+// the groups of a partition are returned before the groups of the next partitions
+// are loaded.
 func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
+	if ctr.merger == nil {
+		ctr.merger = spill.NewMerger(nil, len(proc.Reg.MergeReceivers))
+	}
 	for {
-		switch ctr.state {
-		case Fill:
-			if err := ctr.fill(proc); err != nil {
-				batch.Clean(ctr.bat, proc.Mp)
+		bats, ok, err := ctr.merger.Next(proc.Mp)
+		if err != nil {
+			ctr.merger.Clean(proc.Mp)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
+		if ok {
+			if err := ctr.fillPartition(bats, proc); err != nil {
+				ctr.merger.Clean(proc.Mp)
 				proc.Reg.InputBatch = nil
-				ctr.state = Eval
 				return true, err
 			}
-			ctr.state = Eval
-		case Eval:
-			if ctr.bat != nil {
-				proc.Reg.InputBatch = ctr.bat
-				ctr.bat = nil
-				return false, nil
-			}
-			return true, nil
+			proc.Reg.InputBatch = ctr.bat
+			ctr.reset()
+			return false, nil
 		}
-	}
-}
-
-func (ctr *Container) fill(proc *process.Process) error {
-	if len(proc.Reg.MergeReceivers) == 1 {
-		for {
-			bat := <-proc.Reg.MergeReceivers[0].Ch
-			if bat == nil {
-				return nil
-			}
-			if len(bat.Zs) == 0 {
-				continue
-			}
-			ctr.bat = bat
-			return nil
+		i := ctr.merger.Receiver()
+		if i < 0 {
+			proc.Reg.InputBatch = nil
+			return true, nil
 		}
-	}
-	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		bat := <-proc.Reg.MergeReceivers[i].Ch
 		if bat == nil {
+			ctr.merger.Done(i)
 			continue
 		}
 		if len(bat.Zs) == 0 {
-			i--
 			continue
 		}
+		if err := ctr.merger.Add(i, bat, proc.Mp); err != nil {
+			ctr.merger.Clean(proc.Mp)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
+	}
+}
+
+// fillPartition merges the groups of a partition.
+func (ctr *Container) fillPartition(bats []*batch.Batch, proc *process.Process) error {
+	if len(bats) == 1 {
+		ctr.bat = bats[0]
+		return nil
+	}
+	for i, bat := range bats {
 		if err := ctr.fillBatch(bat, proc); err != nil {
+			for _, bat := range bats[i+1:] {
+				batch.Clean(bat, proc.Mp)
+			}
+			if ctr.bat != nil {
+				batch.Clean(ctr.bat, proc.Mp)
+			}
+			ctr.reset()
 			return err
 		}
 	}
 	return nil
 }
 
+// reset drops the hash table, the next partition builds a new one.
+func (ctr *Container) reset() {
+	ctr.rows = 0
+	ctr.vars = nil
+	ctr.bat = nil
+	ctr.intHashMap = nil
+	ctr.strHashMap = nil
+}
+
 func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 	if len(ctr.vars) == 0 {
 		ctr.vars = append(ctr.vars, bat.Attrs...)
@@ -17,6 +17,7 @@ package oplus
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 )
 
 const (
@@ -76,7 +77,9 @@ type Container struct {
 	hstr struct {
 		keys [][]byte
 	}
-	bat *batch.Batch
+	// merger merges the groups partition by partition
+	merger *spill.Merger
+	bat    *batch.Batch
 }
 
 type Argument struct {
@@ -602,8 +602,8 @@ func (ctr *Container) processJoinH0(n, start int, bat *batch.Batch, proc *proces
 		} else {
 			v := ctr.views[j-1]
 			for k, r := range v.bat.Rs {
-				for _, row := range rows {
-					ctr.pctr.bat.Rs[k].Add(r, 0, row)
+				for x, row := range rows {
+					ctr.pctr.bat.Rs[v.ris[k]].Mul(r, 0, row, ctr.zs[x]/v.bat.Zs[row])
 				}
 			}
 		}
@@ -656,19 +656,13 @@ func (ctr *Container) processPureJoinH0(n, start int, bat *batch.Batch, proc *pr
 		}
 	}
 	for _, v := range ctr.views {
-		if len(v.bat.Rs) > 0 {
-			ctr.sels = ctr.sels[:0]
+		for k, r := range v.bat.Rs {
 			for i := 0; i < n; i++ {
 				if bat.Zs[i+start] == 0 {
 					continue
 				}
 				row := v.sels[v.values[i]-1][0]
-				ctr.sels = append(ctr.sels, row)
-			}
-			for k, r := range v.bat.Rs {
-				for _, sel := range ctr.sels {
-					ctr.pctr.bat.Rs[v.ris[k]].Add(r, 0, sel)
-				}
+				ctr.pctr.bat.Rs[v.ris[k]].Mul(r, 0, row, bat.Zs[i+start]/v.bat.Zs[row])
 			}
 		}
 	}
@@ -7205,8 +7199,25 @@ func (ctr *Container) dupMatrix(xs [][]int64) [][]int64 {
 	return mx
 }
 
+// probeProductView probes the view of a cross product, every row matches
+// all rows of the view if it is not empty.
+func (ctr *Container) probeProductView(n int, v *view) {
+	var value uint64
+
+	if len(v.sels) > 0 {
+		value = 1
+	}
+	for k := 0; k < n; k++ {
+		v.values[k] = value
+	}
+}
+
 // probe hashtable to get matching row numbers
 func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
+	if len(v.vecs) == 0 {
+		ctr.probeProductView(n, v)
+		return nil
+	}
 	if len(v.vecs) == 1 {
 		return ctr.probeViewWithOneVar(i, n, bat, v)
 	}
@@ -7224,7 +7235,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -7240,7 +7251,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -7256,7 +7267,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7272,7 +7283,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -7288,7 +7299,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*1:(i+k+1)*1]...)
 					}
@@ -7304,7 +7315,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*2:(i+k+1)*2]...)
 					}
@@ -7320,7 +7331,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7336,7 +7347,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -7352,7 +7363,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7368,7 +7379,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -7384,7 +7395,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*4:(i+k+1)*4]...)
 					}
@@ -7400,7 +7411,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 					}
@@ -7415,7 +7426,7 @@ func (ctr *Container) probeView(i, n int, bat *batch.Batch, v *view) error {
 			} else {
 				for k := 0; k < n; k++ {
 					if vec.Nsp.Np.Contains(uint64(i + k)) {
-						ctr.zValues[i] = 0
+						ctr.zValues[k] = 0
 					} else {
 						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 					}
@@ -7453,7 +7464,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7472,7 +7483,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7491,7 +7502,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7510,7 +7521,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7529,7 +7540,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7548,7 +7559,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7567,7 +7578,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7586,7 +7597,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7605,7 +7616,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7624,7 +7635,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7643,7 +7654,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7662,7 +7673,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.h8.keys[k] = uint64(vs[i+k])
 			}
@@ -7688,7 +7699,7 @@ func (ctr *Container) probeViewWithOneVar(i, n int, bat *batch.Batch, v *view) e
 			copy(ctr.zValues[:n], OneInt64s[:n])
 			for k := 0; k < n; k++ {
 				if vec.Nsp.Np.Contains(uint64(i + k)) {
-					ctr.zValues[i] = 0
+					ctr.zValues[k] = 0
 				}
 				ctr.hstr.keys[k] = append(ctr.hstr.keys[k], vs.Get(int64(i+k))...)
 			}
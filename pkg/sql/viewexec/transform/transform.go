@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
@@ -144,21 +145,11 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 
 	bat := proc.Reg.InputBatch
 	if bat == nil { // begin eval
+		if len(ctr.spill.fs) > 0 || len(ctr.spill.parts) > 0 {
+			return ctr.evalPartitions(proc, arg)
+		}
 		if ctr.bat != nil {
-			switch ctr.typ {
-			case H8:
-				ctr.bat.Ht = ctr.intHashMap
-			case H24:
-				ctr.bat.Ht = ctr.strHashMap
-			case H32:
-				ctr.bat.Ht = ctr.strHashMap
-			case H40:
-				ctr.bat.Ht = ctr.strHashMap
-			default:
-				ctr.bat.Ht = ctr.strHashMap
-			}
-			proc.Reg.InputBatch = ctr.bat
-			ctr.bat = nil
+			proc.Reg.InputBatch = ctr.result()
 		}
 		return true, nil
 	}
@@ -171,9 +162,33 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 	}
 	defer batch.Clean(bat, proc.Mp)
 	proc.Reg.InputBatch = &batch.Batch{}
-	if len(ctr.vars) == 0 {
-		var err error
+	if len(ctr.spill.fs) > 0 {
+		if err = spill.Scatter(bat, arg.FreeVars, ctr.spill.fs, ctr.spill.level, proc.Mp); err != nil {
+			ctr.cleanPartitions()
+		}
+		return false, err
+	}
+	if err = ctr.fill(bat, arg, proc); err != nil {
+		return false, err
+	}
+	if spill.Exceed(proc, spill.Size(ctr.bat)) {
+		// the groups are sent as a partial result which will be merged by the next operator,
+		// and the following rows are written to the hash partitions.
+		if err = ctr.newPartitions(0); err != nil {
+			ctr.cleanPartitions()
+			return false, err
+		}
+		proc.Reg.InputBatch = ctr.result()
+		ctr.reset()
+	}
+	return false, nil
+}
+
+// fill aggregates the rows of the batch into the groups.
+func (ctr *Container) fill(bat *batch.Batch, arg *Argument, proc *process.Process) error {
+	var err error
 
+	if len(ctr.vars) == 0 {
 		batch.Reorder(bat, arg.FreeVars)
 		ctr.constructContainer(arg, bat)
 		ctr.vars = append(ctr.vars, bat.Attrs...)
@@ -246,7 +261,7 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 				ctr.bat.Rs = ctr.bat.Rs[:i]
 				batch.Clean(ctr.bat, proc.Mp)
 				ctr.bat = nil
-				return false, err
+				return err
 			}
 		}
 	} else {
@@ -267,9 +282,126 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 	if err != nil {
 		batch.Clean(ctr.bat, proc.Mp)
 		ctr.bat = nil
-		return false, err
+		return err
 	}
-	return false, err
+	return nil
+}
+
+// result returns the groups with the hash table.
+func (ctr *Container) result() *batch.Batch {
+	bat := ctr.bat
+	switch ctr.typ {
+	case H8:
+		bat.Ht = ctr.intHashMap
+	case H24:
+		bat.Ht = ctr.strHashMap
+	case H32:
+		bat.Ht = ctr.strHashMap
+	case H40:
+		bat.Ht = ctr.strHashMap
+	default:
+		bat.Ht = ctr.strHashMap
+	}
+	ctr.bat = nil
+	return bat
+}
+
+// reset drops the groups, the next batch will build new groups.
+func (ctr *Container) reset() {
+	ctr.n = 0
+	ctr.rows = 0
+	ctr.Is = nil
+	ctr.vars = nil
+	ctr.bat = nil
+	ctr.intHashMap = nil
+	ctr.strHashMap = nil
+}
+
+// evalPartitions aggregates the spilled hash partitions one by one, each call
+// returns the groups of a partition. If the groups of a partition exceed the
+// memory threshold, the rest of its rows are scattered to the partitions of
+// the next level, which are aggregated right after it.
+func (ctr *Container) evalPartitions(proc *process.Process, arg *Argument) (bool, error) {
+	ctr.queuePartitions()
+	for len(ctr.spill.parts) > 0 {
+		p := ctr.spill.parts[0]
+		ctr.spill.parts = ctr.spill.parts[1:]
+		if p.f.Rows() == 0 {
+			p.f.Close()
+			continue
+		}
+		ctr.reset()
+		for {
+			bat, err := p.f.Read()
+			if err != nil {
+				p.f.Close()
+				ctr.cleanPartitions()
+				return true, err
+			}
+			if bat == nil {
+				break
+			}
+			if len(ctr.spill.fs) > 0 {
+				err = spill.Scatter(bat, arg.FreeVars, ctr.spill.fs, ctr.spill.level, proc.Mp)
+			} else {
+				err = ctr.fill(bat, arg, proc)
+			}
+			batch.Clean(bat, proc.Mp)
+			if err == nil && len(ctr.spill.fs) == 0 && p.level+1 < spill.Levels && spill.Exceed(proc, spill.Size(ctr.bat)) {
+				err = ctr.newPartitions(p.level + 1)
+			}
+			if err != nil {
+				p.f.Close()
+				ctr.cleanPartitions()
+				return true, err
+			}
+		}
+		p.f.Close()
+		ctr.queuePartitions()
+		proc.Reg.InputBatch = ctr.result()
+		return len(ctr.spill.parts) == 0, nil
+	}
+	return true, nil
+}
+
+// newPartitions creates the partitions of the level which the following rows are written to.
+func (ctr *Container) newPartitions(level int) error {
+	var err error
+
+	ctr.spill.level = level
+	ctr.spill.fs = make([]*spill.File, spill.Partitions)
+	for i := range ctr.spill.fs {
+		if ctr.spill.fs[i], err = spill.New(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queuePartitions puts the partitions being written before the partitions to aggregate,
+// so that the groups are returned in the order of their level 0 partitions, which the
+// next operator relies on to evaluate a partition before it loads the next one.
+func (ctr *Container) queuePartitions() {
+	parts := make([]partition, 0, len(ctr.spill.fs)+len(ctr.spill.parts))
+	for _, f := range ctr.spill.fs {
+		parts = append(parts, partition{level: ctr.spill.level, f: f})
+	}
+	ctr.spill.parts = append(parts, ctr.spill.parts...)
+	ctr.spill.fs = nil
+}
+
+// cleanPartitions removes the spilled hash partitions.
+func (ctr *Container) cleanPartitions() {
+	for _, f := range ctr.spill.fs {
+		if f != nil {
+			f.Close()
+		}
+	}
+	for _, p := range ctr.spill.parts {
+		p.f.Close()
+	}
+	ctr.spill.fs = nil
+	ctr.spill.parts = nil
 }
 
 func (ctr *Container) processFreeVarsUnit(proc *process.Process, arg *Argument) (bool, error) {
@@ -0,0 +1,113 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/spill"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformSpill(t *testing.T) {
+	const batches, rows, groups = 4, 1000, 100
+
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	proc.Lim.Size = 1 // the groups are spilled after the first batch
+	arg := &Argument{
+		Typ:      FreeVarsAndBoundVars,
+		FreeVars: []string{"a"},
+		BoundVars: []transformer.Transformer{
+			{Op: transformer.Sum, Name: "b", Alias: "sum_b"},
+		},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	expected := make(map[int64]int64)
+	sums := make(map[int64]int64)
+	outputs, levels, part := 0, 0, 0
+	collect := func() {
+		for _, p := range arg.Ctr.spill.parts {
+			if p.level+1 > levels {
+				levels = p.level + 1
+			}
+		}
+		bat := proc.Reg.InputBatch
+		if bat == nil || len(bat.Zs) == 0 {
+			return
+		}
+		outputs++
+		// apart from the groups held in memory before spilling, the groups
+		// are returned in the order of their level 0 partitions
+		if outputs > 1 {
+			for _, h := range spill.Hash(bat.Vecs[:1], len(bat.Zs)) {
+				p := int(h % spill.Partitions)
+				require.GreaterOrEqual(t, p, part)
+				part = p
+			}
+		}
+		keys := bat.Vecs[0].Col.([]int64)
+		vs := bat.Rs[0].Eval(bat.Zs).Col.([]int64)
+		for i, k := range keys {
+			sums[k] += vs[i]
+		}
+	}
+	for i := 0; i < batches; i++ {
+		as, bs := make([]int64, rows), make([]int64, rows)
+		for j := range as {
+			as[j] = int64((i*rows + j) % groups)
+			bs[j] = int64(i*rows + j)
+			expected[as[j]] += bs[j]
+		}
+		proc.Reg.InputBatch = newBatch(t, as, bs)
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, end)
+		collect()
+	}
+	for {
+		proc.Reg.InputBatch = nil
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		collect()
+		if end {
+			break
+		}
+	}
+	require.Greater(t, outputs, 1)
+	// each partition exceeds the threshold too, and is scattered again by the next level
+	require.Greater(t, levels, 1)
+	require.Equal(t, expected, sums)
+	require.Equal(t, 0, len(arg.Ctr.spill.fs))
+	require.Equal(t, 0, len(arg.Ctr.spill.parts))
+}
+
+func newBatch(t *testing.T, as, bs []int64) *batch.Batch {
+	bat := batch.New(true, []string{"a", "b"})
+	for i, vs := range [][]int64{as, bs} {
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		require.NoError(t, vector.Append(bat.Vecs[i], vs))
+	}
+	bat.InitZsOne(len(as))
+	return bat
+}
@@ -17,6 +17,7 @@ package transform
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
@@ -75,9 +76,22 @@ type Container struct {
 	hstr struct {
 		keys [][]byte
 	}
+	// spill stores the hash partitions of the rows received after the groups exceed the memory threshold
+	spill struct {
+		level int           // level of the partitions being written
+		fs    []*spill.File // fs are the partitions being written
+		parts []partition   // parts are the partitions to aggregate
+	}
 	bat *batch.Batch
 }
 
+// partition is a spilled hash partition, the rows of a partition of one level
+// are scattered by the level-th digit of their hash in base spill.Partitions.
+type partition struct {
+	level int
+	f     *spill.File
+}
+
 type Argument struct {
 	Typ        int
 	IsMerge    bool
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 )
 
 const (
@@ -78,7 +79,9 @@ type Container struct {
 	hstr struct {
 		keys [][]byte
 	}
-	bat *batch.Batch
+	// merger merges the groups partition by partition
+	merger *spill.Merger
+	bat    *batch.Batch
 }
 
 type Argument struct {
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -50,6 +51,9 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	if len(n.FreeVars) == 0 {
 		return n.ctr.processBoundVars(proc)
 	}
+	if n.Type == AQ {
+		return n.ctr.processPartitions(n.FreeVars, proc)
+	}
 	return n.ctr.processFreeVars(n.FreeVars, proc)
 	/*
 		if n.Type == AQ {
@@ -209,6 +213,91 @@ func (ctr *Container) processFreeVars(fvars []string, proc *process.Process) (bo
 	}
 }
 
+// processPartitions merges the groups sent by the transforms of a relation partition
+// by partition, the groups of a partition are evaluated and returned before the groups
+// of the next partitions are loaded.
+func (ctr *Container) processPartitions(fvars []string, proc *process.Process) (bool, error) {
+	if ctr.merger == nil {
+		ctr.merger = spill.NewMerger(fvars, len(proc.Reg.MergeReceivers))
+	}
+	for {
+		bats, ok, err := ctr.merger.Next(proc.Mp)
+		if err != nil {
+			ctr.merger.Clean(proc.Mp)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
+		if ok {
+			if err := ctr.fillPartition(fvars, bats, proc); err != nil {
+				ctr.merger.Clean(proc.Mp)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			for i, r := range ctr.bat.Rs {
+				ctr.bat.Attrs = append(ctr.bat.Attrs, ctr.bat.As[i])
+				vec := r.Eval(ctr.bat.Zs)
+				vec.Ref = ctr.bat.Refs[i]
+				ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+			}
+			ctr.bat.Rs = nil
+			for i := range ctr.bat.Zs {
+				ctr.bat.Zs[i] = 1
+			}
+			proc.Reg.InputBatch = ctr.bat
+			ctr.reset()
+			return false, nil
+		}
+		i := ctr.merger.Receiver()
+		if i < 0 {
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+		bat := <-proc.Reg.MergeReceivers[i].Ch
+		if bat == nil {
+			ctr.merger.Done(i)
+			continue
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if err := ctr.merger.Add(i, bat, proc.Mp); err != nil {
+			ctr.merger.Clean(proc.Mp)
+			proc.Reg.InputBatch = nil
+			return true, err
+		}
+	}
+}
+
+// fillPartition merges the groups of a partition.
+func (ctr *Container) fillPartition(fvars []string, bats []*batch.Batch, proc *process.Process) error {
+	if len(bats) == 1 {
+		ctr.bat = bats[0]
+		return nil
+	}
+	for i, bat := range bats {
+		if err := ctr.fillBatch(fvars, bat, proc); err != nil {
+			for _, bat := range bats[i+1:] {
+				batch.Clean(bat, proc.Mp)
+			}
+			if ctr.bat != nil {
+				batch.Clean(ctr.bat, proc.Mp)
+			}
+			ctr.reset()
+			return err
+		}
+	}
+	return nil
+}
+
+// reset drops the hash table, the next partition builds a new one.
+func (ctr *Container) reset() {
+	ctr.rows = 0
+	ctr.vars = nil
+	ctr.bat = nil
+	ctr.intHashMap = nil
+	ctr.strHashMap = nil
+}
+
 func (ctr *Container) processFreeVarsWithCAQ(fvars []string, proc *process.Process) (bool, error) {
 	for {
 		switch ctr.state {
@@ -242,29 +331,48 @@ func (ctr *Container) processFreeVarsWithCAQ(fvars []string, proc *process.Proce
 
 func (ctr *Container) fill(fvars []string, proc *process.Process) error {
 	if len(proc.Reg.MergeReceivers) == 1 {
+		var first *batch.Batch
+
 		for {
 			bat := <-proc.Reg.MergeReceivers[0].Ch
 			if bat == nil {
+				if first != nil {
+					ctr.bat = first
+				}
 				return nil
 			}
 			if len(bat.Zs) == 0 {
 				continue
 			}
-			ctr.bat = bat
-			return nil
+			if first == nil && ctr.bat == nil {
+				first = bat
+				continue
+			}
+			// the receiver sends more than one batch, e.g. the groups were spilled
+			if first != nil {
+				if err := ctr.fillBatch(fvars, first, proc); err != nil {
+					return err
+				}
+				first = nil
+			}
+			if err := ctr.fillBatch(fvars, bat, proc); err != nil {
+				return err
+			}
 		}
 	}
+	// each receiver sends its batches until nil
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
-		if bat == nil {
-			continue
-		}
-		if len(bat.Zs) == 0 {
-			i--
-			continue
-		}
-		if err := ctr.fillBatch(fvars, bat, proc); err != nil {
-			return err
+		for {
+			bat := <-proc.Reg.MergeReceivers[i].Ch
+			if bat == nil {
+				break
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.fillBatch(fvars, bat, proc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,125 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package untransform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/spill"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUntransformSpill checks that the groups of the spilled partitions are
+// evaluated partition by partition.
+func TestUntransformSpill(t *testing.T) {
+	const batches, rows, groups = 4, 1000, 100
+
+	tproc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	tproc.Lim.Size = 1 // the groups are spilled after the first batch
+	targ := &transform.Argument{
+		Typ:      transform.FreeVarsAndBoundVars,
+		FreeVars: []string{"a"},
+		BoundVars: []transformer.Transformer{
+			{Op: transformer.Sum, Name: "b", Alias: "sum_b"},
+		},
+	}
+	require.NoError(t, transform.Prepare(tproc, targ))
+
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	reg := &process.WaitRegister{
+		Ctx: context.Background(),
+		Ch:  make(chan *batch.Batch, batches*groups),
+	}
+	proc.Reg.MergeReceivers = []*process.WaitRegister{reg}
+	arg := &Argument{FreeVars: []string{"a"}}
+	require.NoError(t, Prepare(proc, arg))
+
+	expected := make(map[int64]int64)
+	send := func() {
+		if bat := tproc.Reg.InputBatch; bat != nil && len(bat.Zs) > 0 {
+			reg.Ch <- bat
+		}
+	}
+	for i := 0; i < batches; i++ {
+		as, bs := make([]int64, rows), make([]int64, rows)
+		for j := range as {
+			as[j] = int64((i*rows + j) % groups)
+			bs[j] = int64(i*rows + j)
+			expected[as[j]] += bs[j]
+		}
+		tproc.Reg.InputBatch = newBatch(t, as, bs)
+		_, err := transform.Call(tproc, targ)
+		require.NoError(t, err)
+		send()
+	}
+	for end := false; !end; {
+		var err error
+
+		tproc.Reg.InputBatch = nil
+		end, err = transform.Call(tproc, targ)
+		require.NoError(t, err)
+		send()
+	}
+	reg.Ch <- nil
+
+	sums := make(map[int64]int64)
+	outputs, part := 0, -1
+	for end := false; !end; {
+		var err error
+
+		end, err = Call(proc, arg)
+		require.NoError(t, err)
+		bat := proc.Reg.InputBatch
+		if bat == nil {
+			continue
+		}
+		outputs++
+		// each result holds the groups of one partition
+		hs := spill.Hash(bat.Vecs[:1], len(bat.Zs))
+		p := int(hs[0] % spill.Partitions)
+		require.Greater(t, p, part)
+		part = p
+		keys := bat.Vecs[0].Col.([]int64)
+		vs := bat.Vecs[1].Col.([]int64)
+		for i, k := range keys {
+			require.Equal(t, uint64(p), hs[i]%spill.Partitions)
+			_, ok := sums[k]
+			require.False(t, ok)
+			sums[k] = vs[i]
+		}
+	}
+	require.Greater(t, outputs, 1)
+	require.Equal(t, expected, sums)
+}
+
+func newBatch(t *testing.T, as, bs []int64) *batch.Batch {
+	bat := batch.New(true, []string{"a", "b"})
+	for i, vs := range [][]int64{as, bs} {
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		require.NoError(t, vector.Append(bat.Vecs[i], vs))
+	}
+	bat.InitZsOne(len(as))
+	return bat
+}
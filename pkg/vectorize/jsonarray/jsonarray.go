@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonarray
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	JsonArray func([][]bytejson.ByteJson, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	JsonArray = jsonArray
+}
+
+// jsonArray makes a json array of the arguments for each row of rs, an
+// argument of one value is a constant.
+func jsonArray(args [][]bytejson.ByteJson, rs *types.Bytes) *types.Bytes {
+	elems := make([]bytejson.ByteJson, len(args))
+	for i := range rs.Lengths {
+		for j, arg := range args {
+			if len(arg) == 1 {
+				elems[j] = arg[0]
+			} else {
+				elems[j] = arg[i]
+			}
+		}
+		v := bytejson.NewArray(elems).Marshal()
+		rs.Offsets[i] = uint32(len(rs.Data))
+		rs.Lengths[i] = uint32(len(v))
+		rs.Data = append(rs.Data, v...)
+	}
+	return rs
+}
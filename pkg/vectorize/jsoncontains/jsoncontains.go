@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsoncontains
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	JsonContains         func(*types.Bytes, bytejson.ByteJson, *bytejson.Path, *nulls.Nulls, []int64) ([]int64, error)
+	JsonContainsFromText func(*types.Bytes, bytejson.ByteJson, *bytejson.Path, *nulls.Nulls, []int64) ([]int64, error)
+)
+
+func init() {
+	JsonContains = jsonContains
+	JsonContainsFromText = jsonContainsFromText
+}
+
+// jsonContains returns 1 if the json document contains the candidate at
+// the path p or at the root if p is nil, a row is null if nothing is at
+// the path.
+func jsonContains(xs *types.Bytes, candidate bytejson.ByteJson, p *bytejson.Path, nsp *nulls.Nulls, rs []int64) ([]int64, error) {
+	return contains(xs, candidate, p, nsp, rs, bytejson.Unmarshal)
+}
+
+// jsonContainsFromText is jsonContains for the json texts xs
+func jsonContainsFromText(xs *types.Bytes, candidate bytejson.ByteJson, p *bytejson.Path, nsp *nulls.Nulls, rs []int64) ([]int64, error) {
+	return contains(xs, candidate, p, nsp, rs, bytejson.ParseFromByteSlice)
+}
+
+func contains(xs *types.Bytes, candidate bytejson.ByteJson, p *bytejson.Path, nsp *nulls.Nulls, rs []int64, parse func([]byte) (bytejson.ByteJson, error)) ([]int64, error) {
+	for i := range rs {
+		rs[i] = 0
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		bj, err := parse(xs.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			var ok bool
+			if bj, ok = bj.Extract([]*bytejson.Path{p}); !ok {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+		}
+		if bj.Contains(candidate) {
+			rs[i] = 1
+		}
+	}
+	return rs, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsoncontains
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonContains(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{`{"a": [1, 2]}`, `{"a": 3}`, `{"b": 1}`} {
+		bj, err := bytejson.ParseFromString(s)
+		require.NoError(t, err)
+		data := bj.Marshal()
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Lengths = append(xs.Lengths, uint32(len(data)))
+		xs.Data = append(xs.Data, data...)
+	}
+	candidate, err := bytejson.ParseFromString(`2`)
+	require.NoError(t, err)
+	p, err := bytejson.ParsePath(`$.a`)
+	require.NoError(t, err)
+	nsp := new(nulls.Nulls)
+	rs, err := jsonContains(xs, candidate, p, nsp, make([]int64, 3))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 0, 0}, rs)
+	require.False(t, nulls.Contains(nsp, 1))
+	require.True(t, nulls.Contains(nsp, 2))
+
+	nsp = new(nulls.Nulls)
+	rs, err = jsonContains(xs, candidate, nil, nsp, make([]int64, 3))
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 0, 0}, rs)
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonextract
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	JsonExtract         func(*types.Bytes, []*bytejson.Path, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+	JsonExtractFromText func(*types.Bytes, []*bytejson.Path, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+)
+
+func init() {
+	JsonExtract = jsonExtract
+	JsonExtractFromText = jsonExtractFromText
+}
+
+// jsonExtract extracts the values matched by the paths from the json
+// documents xs, a row is null if nothing is matched.
+func jsonExtract(xs *types.Bytes, ps []*bytejson.Path, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	return extract(xs, ps, nsp, rs, bytejson.Unmarshal)
+}
+
+// jsonExtractFromText is jsonExtract for the json texts xs
+func jsonExtractFromText(xs *types.Bytes, ps []*bytejson.Path, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	return extract(xs, ps, nsp, rs, bytejson.ParseFromByteSlice)
+}
+
+func extract(xs *types.Bytes, ps []*bytejson.Path, nsp *nulls.Nulls, rs *types.Bytes, parse func([]byte) (bytejson.ByteJson, error)) (*types.Bytes, error) {
+	for i := range xs.Lengths {
+		var v []byte
+		if !nulls.Contains(nsp, uint64(i)) {
+			bj, err := parse(xs.Get(int64(i)))
+			if err != nil {
+				return nil, err
+			}
+			if r, ok := bj.Extract(ps); ok {
+				v = r.Marshal()
+			} else {
+				nulls.Add(nsp, uint64(i))
+			}
+		}
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		rs.Lengths = append(rs.Lengths, uint32(len(v)))
+		rs.Data = append(rs.Data, v...)
+	}
+	return rs, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonextract
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonExtractFromText(t *testing.T) {
+	xs := &types.Bytes{}
+	for _, s := range []string{`{"a": [1, 2]}`, `{"b": 1}`, `[]`} {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Lengths = append(xs.Lengths, uint32(len(s)))
+		xs.Data = append(xs.Data, s...)
+	}
+	p, err := bytejson.ParsePath(`$.a[1]`)
+	require.NoError(t, err)
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 2)
+	rs, err := jsonExtractFromText(xs, []*bytejson.Path{p}, nsp, &types.Bytes{})
+	require.NoError(t, err)
+	bj, err := bytejson.Unmarshal(rs.Get(0))
+	require.NoError(t, err)
+	require.Equal(t, "2", bj.String())
+	require.True(t, nulls.Contains(nsp, 1))
+	require.True(t, nulls.Contains(nsp, 2))
+
+	_, err = jsonExtract(rs, []*bytejson.Path{p}, nsp, &types.Bytes{})
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(nsp, 0))
+
+	xs = &types.Bytes{Data: []byte(`{`), Offsets: []uint32{0}, Lengths: []uint32{1}}
+	_, err = jsonExtractFromText(xs, []*bytejson.Path{p}, new(nulls.Nulls), &types.Bytes{})
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonobject
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+var (
+	JsonObject func([][]bytejson.ByteJson, *types.Bytes) (*types.Bytes, error)
+)
+
+var errNullKey = errors.New(errno.DataException, "JSON documents may not contain NULL member names")
+
+func init() {
+	JsonObject = jsonObject
+}
+
+// jsonObject makes a json object of the key value pairs of the arguments
+// for each row of rs, an argument of one value is a constant, a key which
+// is not a string is converted to its json text.
+func jsonObject(args [][]bytejson.ByteJson, rs *types.Bytes) (*types.Bytes, error) {
+	keys := make([]string, len(args)/2)
+	vals := make([]bytejson.ByteJson, len(args)/2)
+	for i := range rs.Lengths {
+		for j, arg := range args {
+			v := arg[0]
+			if len(arg) > 1 {
+				v = arg[i]
+			}
+			if j%2 == 1 {
+				vals[j/2] = v
+				continue
+			}
+			switch {
+			case v.IsNull():
+				return nil, errNullKey
+			case v.Type == bytejson.TpCodeString:
+				keys[j/2] = string(v.GetString())
+			default:
+				keys[j/2] = v.String()
+			}
+		}
+		obj, err := bytejson.NewObject(keys, vals)
+		if err != nil {
+			return nil, err
+		}
+		v := obj.Marshal()
+		rs.Offsets[i] = uint32(len(rs.Data))
+		rs.Lengths[i] = uint32(len(v))
+		rs.Data = append(rs.Data, v...)
+	}
+	return rs, nil
+}
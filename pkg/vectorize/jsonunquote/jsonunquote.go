@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonunquote
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	JsonUnquote         func(*types.Bytes, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+	JsonUnquoteFromText func(*types.Bytes, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+)
+
+func init() {
+	JsonUnquote = jsonUnquote
+	JsonUnquoteFromText = jsonUnquoteFromText
+}
+
+// jsonUnquote returns the text of the json strings xs without quotes and the
+// json text of other values
+func jsonUnquote(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	for i := range xs.Lengths {
+		var v string
+		if !nulls.Contains(nsp, uint64(i)) {
+			bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+			if err != nil {
+				return nil, err
+			}
+			v = bj.Unquote()
+		}
+		appendString(rs, v)
+	}
+	return rs, nil
+}
+
+// jsonUnquoteFromText unquotes the texts xs which are quoted by double
+// quotes, other texts are returned as they are.
+func jsonUnquoteFromText(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	for i := range xs.Lengths {
+		var v string
+		if !nulls.Contains(nsp, uint64(i)) {
+			x := xs.Get(int64(i))
+			v = string(x)
+			if len(x) >= 2 && x[0] == '"' && x[len(x)-1] == '"' {
+				bj, err := bytejson.ParseFromByteSlice(x)
+				if err != nil {
+					return nil, err
+				}
+				v = bj.Unquote()
+			}
+		}
+		appendString(rs, v)
+	}
+	return rs, nil
+}
+
+func appendString(rs *types.Bytes, v string) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+	rs.Data = append(rs.Data, v...)
+}
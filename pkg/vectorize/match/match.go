@@ -13,3 +13,46 @@
 // limitations under the License.
 
 package match
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+)
+
+var (
+	MatchAgainst func([]*types.Bytes, []*nulls.Nulls, *fulltext.Search, []float64) []float64
+)
+
+func init() {
+	MatchAgainst = matchAgainst
+}
+
+// matchAgainst scores the rows made of the columns xs by the search. The
+// rows are looked up in the scores of the search if it matched the documents
+// of the table from the postings, otherwise they are indexed and scored by
+// the search, only the postings of the terms of the search are kept.
+func matchAgainst(xs []*types.Bytes, nsps []*nulls.Nulls, s *fulltext.Search, rs []float64) []float64 {
+	texts := make([][]byte, 0, len(xs))
+	row := func(i int) [][]byte {
+		texts = texts[:0]
+		for j, x := range xs {
+			if nulls.Contains(nsps[j], uint64(i)) {
+				continue
+			}
+			texts = append(texts, x.Get(int64(i)))
+		}
+		return texts
+	}
+	if s.Scores != nil {
+		for i := range rs {
+			rs[i] = s.Scores[fulltext.DocKey(row(i)...)]
+		}
+		return rs
+	}
+	idx := s.NewIndex()
+	for i := range rs {
+		idx.Add(row(i)...)
+	}
+	return append(rs[:0], s.Score(idx)...)
+}
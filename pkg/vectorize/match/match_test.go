@@ -0,0 +1,83 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package match
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/stretchr/testify/require"
+)
+
+func newBytes(ss ...string) *types.Bytes {
+	bs := &types.Bytes{}
+	for _, s := range ss {
+		bs.Offsets = append(bs.Offsets, uint32(len(bs.Data)))
+		bs.Lengths = append(bs.Lengths, uint32(len(s)))
+		bs.Data = append(bs.Data, s...)
+	}
+	return bs
+}
+
+func TestMatchAgainst(t *testing.T) {
+	titles := newBytes("mysql tutorial", "database", "", "oracle")
+	bodies := newBytes("full text search", "full text search in mysql", "mysql", "full text")
+	nsps := []*nulls.Nulls{new(nulls.Nulls), new(nulls.Nulls)}
+	nulls.Add(nsps[0], 2)
+	idx := fulltext.NewIndex()
+	for i := 0; i < 4; i++ {
+		idx.Add([]byte(titles.Get(int64(i))), []byte(bodies.Get(int64(i))))
+	}
+	search := func(q string, mode fulltext.Mode) *fulltext.Search {
+		return fulltext.Corpus{idx}.Search(fulltext.ParseQuery(q, mode))
+	}
+
+	rs := MatchAgainst([]*types.Bytes{titles, bodies}, nsps, search("mysql", fulltext.NaturalLanguage), make([]float64, 4))
+	require.True(t, rs[0] > 0 && rs[1] > 0 && rs[2] > 0)
+	require.Equal(t, 0.0, rs[3])
+
+	rs = MatchAgainst([]*types.Bytes{titles, bodies}, nsps, search("+\"full text\" -mysql", fulltext.Boolean), make([]float64, 4))
+	require.Equal(t, []float64{0, 0, 0}, rs[:3])
+	require.True(t, rs[3] > 0)
+
+	// the rows of a part of the table are scored by the statistics of the
+	// whole table
+	s := search("database", fulltext.NaturalLanguage)
+	rs = MatchAgainst([]*types.Bytes{newBytes("database"), newBytes("full text search in mysql")}, []*nulls.Nulls{new(nulls.Nulls), new(nulls.Nulls)}, s, make([]float64, 1))
+	require.Equal(t, s.Score(idx)[1], rs[0])
+
+	// the rows are looked up in the documents matched from the postings
+	s = search("mysql", fulltext.NaturalLanguage)
+	require.NoError(t, s.Match(idx, func(doc uint32) ([][]byte, error) {
+		var texts [][]byte
+		for j, x := range []*types.Bytes{titles, bodies} {
+			if !nulls.Contains(nsps[j], uint64(doc)) {
+				texts = append(texts, x.Get(int64(doc)))
+			}
+		}
+		return texts, nil
+	}))
+	require.Equal(t, 3, len(s.Scores))
+	rs = MatchAgainst([]*types.Bytes{titles, bodies}, nsps, s, make([]float64, 4))
+	require.Equal(t, s.Score(idx), rs)
+
+	// the rows of a table without full-text indexes are scored by the
+	// statistics of the batch
+	s = &fulltext.Search{Query: fulltext.ParseQuery("mysql", fulltext.NaturalLanguage)}
+	rs = MatchAgainst([]*types.Bytes{titles, bodies}, nsps, s, make([]float64, 4))
+	require.Equal(t, search("mysql", fulltext.NaturalLanguage).Score(idx), rs)
+}
@@ -18,6 +18,8 @@ import (
 	"strconv"
 	"unsafe"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"golang.org/x/exp/constraints"
 )
@@ -156,6 +158,9 @@ var (
 	Uint64ToDecimal128 = uintToDecimal128[uint64]
 
 	TimestampToDatetime = timestampToDatetime
+
+	BytesToJson = bytesToJson
+	JsonToBytes = jsonToBytes
 )
 
 func numericToNumeric[T1, T2 constraints.Integer | constraints.Float](xs []T1, rs []T2) ([]T2, error) {
@@ -243,3 +248,39 @@ func uintToDecimal128[T constraints.Integer](xs []T, rs []types.Decimal128) ([]t
 func timestampToDatetime(xs []types.Timestamp, rs []types.Datetime) ([]types.Datetime, error) {
 	return types.TimestampToDatetime(xs, rs)
 }
+
+// bytesToJson parses the json texts xs into the binary format
+func bytesToJson(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		var v []byte
+		if !nulls.Contains(nsp, uint64(i)) {
+			bj, err := bytejson.ParseFromByteSlice(xs.Get(int64(i)))
+			if err != nil {
+				return nil, err
+			}
+			v = bj.Marshal()
+		}
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		rs.Lengths = append(rs.Lengths, uint32(len(v)))
+		rs.Data = append(rs.Data, v...)
+	}
+	return rs, nil
+}
+
+// jsonToBytes returns the json texts of the json values xs
+func jsonToBytes(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		var v string
+		if !nulls.Contains(nsp, uint64(i)) {
+			bj, err := bytejson.Unmarshal(xs.Get(int64(i)))
+			if err != nil {
+				return nil, err
+			}
+			v = bj.String()
+		}
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		rs.Lengths = append(rs.Lengths, uint32(len(v)))
+		rs.Data = append(rs.Data, v...)
+	}
+	return rs, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewAnalyze sets an empty Analyze for each instruction of ins which has
+// none.
+func NewAnalyze(ins Instructions) {
+	for i := range ins {
+		if ins[i].Analyze == nil {
+			ins[i].Analyze = new(Analyze)
+		}
+	}
+}
+
+// Add accumulates the statistics of b into a.
+func (a *Analyze) Add(b Analyze) {
+	atomic.AddInt64(&a.InputRows, b.InputRows)
+	atomic.AddInt64(&a.OutputRows, b.OutputRows)
+	atomic.AddInt64(&a.InputBatches, b.InputBatches)
+	atomic.AddInt64(&a.OutputBatches, b.OutputBatches)
+	atomic.AddInt64(&a.WallTime, b.WallTime)
+	atomic.AddInt64(&a.CPUTime, b.CPUTime)
+	a.setPeakMemory(b.PeakMemory)
+}
+
+// Load returns a copy of the statistics.
+func (a *Analyze) Load() Analyze {
+	return Analyze{
+		InputRows:     atomic.LoadInt64(&a.InputRows),
+		OutputRows:    atomic.LoadInt64(&a.OutputRows),
+		InputBatches:  atomic.LoadInt64(&a.InputBatches),
+		OutputBatches: atomic.LoadInt64(&a.OutputBatches),
+		WallTime:      atomic.LoadInt64(&a.WallTime),
+		CPUTime:       atomic.LoadInt64(&a.CPUTime),
+		PeakMemory:    atomic.LoadInt64(&a.PeakMemory),
+	}
+}
+
+func (a *Analyze) setPeakMemory(size int64) {
+	for {
+		old := atomic.LoadInt64(&a.PeakMemory)
+		if size <= old || atomic.CompareAndSwapInt64(&a.PeakMemory, old, size) {
+			return
+		}
+	}
+}
+
+// analyzeExec runs the instruction and records its statistics, the goroutine
+// is locked to its thread so that the cpu time of the thread is the cpu time
+// of the instruction.
+func analyzeExec(in Instruction, proc *process.Process) (bool, error) {
+	a := in.Analyze
+	if rows := batchRows(proc.Reg.InputBatch); rows >= 0 {
+		atomic.AddInt64(&a.InputRows, rows)
+		atomic.AddInt64(&a.InputBatches, 1)
+	}
+	var size int64
+
+	if proc.Mp != nil {
+		size = mheap.Size(proc.Mp)
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	t, cpu := time.Now(), threadCPUTime()
+	ok, err := execFunc[in.Op](proc, in.Arg)
+	atomic.AddInt64(&a.CPUTime, threadCPUTime()-cpu)
+	atomic.AddInt64(&a.WallTime, int64(time.Since(t)))
+	if rows := batchRows(proc.Reg.InputBatch); rows >= 0 {
+		atomic.AddInt64(&a.OutputRows, rows)
+		atomic.AddInt64(&a.OutputBatches, 1)
+	}
+	if proc.Mp != nil { // the growth of the heap during the call is held by the instruction
+		a.setPeakMemory(atomic.AddInt64(&a.memory, mheap.Size(proc.Mp)-size))
+	}
+	return ok, err
+}
+
+// batchRows returns the number of rows of bat, -1 means there is no batch.
+func batchRows(bat *batch.Batch) int64 {
+	if bat == nil || len(bat.Zs) == 0 {
+		return -1
+	}
+	return int64(len(bat.Zs))
+}
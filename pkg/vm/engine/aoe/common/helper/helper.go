@@ -78,11 +78,12 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 	for _, col := range tbl.Columns {
 		defs = append(defs, &engine.AttributeDef{
 			Attr: engine.Attribute{
-				Alg:     compress.T(col.Alg),
-				Name:    col.Name,
-				Type:    col.Type,
-				Default: col.Default,
-				Primary: col.PrimaryKey,
+				Alg:         compress.T(col.Alg),
+				Name:        col.Name,
+				Type:        col.Type,
+				Default:     col.Default,
+				Primary:     col.PrimaryKey,
+				Nullability: col.NullAbility,
 			},
 		})
 	}
@@ -97,6 +98,8 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 			tp = engine.BsiIndex
 		case aoe.Bsi:
 			tp = engine.BsiIndex
+		case aoe.FullText:
+			tp = engine.FullTextIndex
 		default:
 			tp = engine.Invalid
 		}
@@ -147,6 +150,8 @@ func IndexDefs(sid, tid uint64, mp map[string]uint64, defs []engine.TableDef) []
 				tp = aoe.ZoneMap
 			case engine.BsiIndex:
 				tp = aoe.Bsi
+			case engine.FullTextIndex:
+				tp = aoe.FullText
 			default:
 				tp = aoe.Invalid
 			}
@@ -196,6 +201,8 @@ func ColumnDefs(sid, tid uint64, defs []engine.TableDef) []aoe.ColumnInfo {
 					col.PrimaryKey = true
 				}
 			}
+			// the columns of the primary key are never nullable
+			col.NullAbility = v.Attr.Nullability && !col.PrimaryKey
 			cols = append(cols, col)
 			id++
 		}
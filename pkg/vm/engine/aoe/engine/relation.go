@@ -28,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -245,6 +246,26 @@ func (r *relation) Size(attr string) int64 {
 	return totalSize
 }
 
+func (r *relation) Stats(attr string) *engine.ColumnStatistics {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stats == nil {
+		stats, err := r.catalog.GetTableStatistics(r.pid, r.tbl.Name)
+		if err != nil || stats == nil {
+			return nil
+		}
+		r.stats = stats
+	}
+	return r.stats[attr]
+}
+
+func (r *relation) WriteStats(epoch uint64, stats map[string]*engine.ColumnStatistics) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats = nil
+	return r.catalog.SetTableStatistics(epoch, r.pid, r.tbl.Name, stats)
+}
+
 func (r *relation) Cardinality(_ string) int64 {
 	return 0
 }
@@ -270,6 +291,19 @@ func (r *relation) DelTableDef(u uint64, def engine.TableDef) error {
 	return nil
 }
 
+//FullTextSearch resolves the query against the segments of the tablets of the table on this store.
+func (r *relation) FullTextSearch(attrs []string, q *fulltext.Query) (*fulltext.Search, error) {
+	var corpus fulltext.Corpus
+	for _, tablet := range r.mp {
+		c, err := tablet.FullTextCorpus(attrs)
+		if err != nil {
+			return nil, err
+		}
+		corpus = append(corpus, c...)
+	}
+	return corpus.Search(q), nil
+}
+
 func (r *relation) NewReader(num int, e extend.Extend, _ []byte) []engine.Reader {
 	fcs := getFilterContext(e)
 	iodepth := num / int(r.cfg.QueueMaxReaderCount)
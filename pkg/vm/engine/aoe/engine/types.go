@@ -147,4 +147,5 @@ type relation struct {
 	mp       map[string]*aoedb.Relation //a map of each tablet and its relation
 	reader   *store
 	cfg      *EngineConfig
+	stats    map[string]*engine.ColumnStatistics //statistics of the analyzed columns, loaded lazily
 }
@@ -16,6 +16,7 @@ package local
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
@@ -51,6 +52,10 @@ func (r *localRoRelation) NewReader(i int, _ extend.Extend, _ []byte) []engine.R
 	panic("implement me")
 }
 
+func (r *localRoRelation) FullTextSearch(attrs []string, q *fulltext.Query) (*fulltext.Search, error) {
+	return r.impl.FullTextSearch(attrs, q)
+}
+
 func NewLocalRoRelation(impl *aoedb.Relation) *localRoRelation {
 	return &localRoRelation{
 		impl: impl,
@@ -73,6 +78,10 @@ func (r *localRoRelation) Size(attr string) int64 {
 	return r.impl.Size(attr)
 }
 
+func (r *localRoRelation) Stats(attr string) *engine.ColumnStatistics {
+	return r.impl.Stats(attr)
+}
+
 func (r *localRoRelation) Close() {
 	r.impl.Close()
 }
@@ -109,6 +109,7 @@ const (
 	NumBsi
 	FixStrBsi
 	Invalid
+	FullText
 )
 
 type SegmentInfo struct {
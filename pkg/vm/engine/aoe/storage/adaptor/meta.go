@@ -16,6 +16,7 @@ package adaptor
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -39,6 +40,7 @@ func MockTableInfo(colCnt int) *aoe.TableInfo {
 		name := fmt.Sprintf("%s%d", prefix, i)
 		colInfo := aoe.ColumnInfo{
 			Name: name,
+			Alg:  compress.Lz4,
 		}
 		if i == 1 {
 			colInfo.Type = types.Type{Oid: types.T_varchar, Size: 24}
@@ -72,6 +74,7 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 			Name: colInfo.Name,
 			Idx:  idx,
 			Type: colInfo.Type,
+			Alg:  compress.T(colInfo.Alg),
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = idx
@@ -89,6 +92,9 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 			cols = append(cols, int(col))
 		}
 		tp := metadata.ZoneMap
+		if indexInfo.Type == aoe.FullText {
+			tp = metadata.FullText
+		}
 		// if _, err = indice.MakeIndex(indexInfo.Name, metadata.IndexT(indexInfo.Type), cols...); err != nil {
 		if _, err = indice.MakeIndex(indexInfo.Name, tp, cols...); err != nil {
 			panic(err)
@@ -112,6 +118,8 @@ func IndiceInfoToIndiceSchema(info *aoe.IndexInfo) *db.IndexSchema {
 		tp = metadata.FixStrBsi
 	case aoe.ZoneMap:
 		tp = metadata.ZoneMap
+	case aoe.FullText:
+		tp = metadata.FullText
 	}
 	_, err := indice.MakeIndex(info.Name, tp, columns...)
 	if err != nil {
@@ -15,6 +15,7 @@
 package aoedb
 
 import (
+	"os"
 	"path/filepath"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/util"
@@ -281,6 +282,15 @@ func (d *DB) DropIndex(ctx *DropIndexCtx) error {
 			if !util.ContainsString(names, info.Name) {
 				continue
 			}
+			if info.Type == metadata.FullText {
+				bn := common.MakeFullTextIndexFileName(tblID, segID, info.Columns)
+				fullname := filepath.Join(filepath.Join(d.Dir, "data"), bn)
+				if err := os.Remove(fullname); err != nil && !os.IsNotExist(err) {
+					seg.Unref()
+					return err
+				}
+				continue
+			}
 			cols := info.Columns
 			for _, col := range cols {
 				if holder.HolderType() == base.SORTED_SEG {
@@ -0,0 +1,131 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	md "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+var (
+	_ engine.FullTextSearcher = (*Relation)(nil)
+)
+
+// FullTextSearch resolves the query against the segments of the table.
+func (r *Relation) FullTextSearch(attrs []string, q *fulltext.Query) (*fulltext.Search, error) {
+	corpus, err := r.FullTextCorpus(attrs)
+	if err != nil {
+		return nil, err
+	}
+	return corpus.Search(q), nil
+}
+
+// FullTextCorpus returns the full-text indexes of the segments on the
+// attributes. The index of a sorted segment is loaded from the file flushed
+// with the segment if the attributes are those of a FULLTEXT index, the
+// index of the other segments is built from their rows.
+func (r *Relation) FullTextCorpus(attrs []string) (fulltext.Corpus, error) {
+	cols := make([]uint16, len(attrs))
+	for i, attr := range attrs {
+		idx := r.Meta.Schema.GetColIdx(attr)
+		if idx < 0 {
+			return nil, db.ErrNotFound
+		}
+		cols[i] = uint16(idx)
+	}
+	indexed := hasFullTextIndex(r.Meta.GetIndexSchema(), cols)
+	var corpus fulltext.Corpus
+	for _, id := range r.Data.SegmentIds() {
+		seg := r.Data.StrongRefSegment(id)
+		if seg == nil {
+			continue
+		}
+		idx, err := segmentFullTextIndex(seg, attrs, cols, indexed)
+		seg.Unref()
+		if err != nil {
+			return nil, err
+		}
+		corpus = append(corpus, idx)
+	}
+	return corpus, nil
+}
+
+func segmentFullTextIndex(seg iface.ISegment, attrs []string, cols []uint16, indexed bool) (*fulltext.Index, error) {
+	if indexed && seg.GetType() == base.SORTED_SEG {
+		meta := seg.GetMeta()
+		filename := filepath.Join(seg.GetSegmentFile().GetDir(), common.MakeFullTextIndexFileName(meta.Table.Id, meta.Id, cols))
+		idx, err := index.DefaultRWHelper.LoadFullTextIndex(filename)
+		if err == nil {
+			return idx, nil
+		}
+		// the index is not flushed yet
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	idx := fulltext.NewIndex()
+	for _, id := range seg.BlockIds() {
+		blk := seg.StrongRefBlock(id)
+		if blk == nil {
+			continue
+		}
+		vecs := make([]*vector.Vector, len(attrs))
+		for i, attr := range attrs {
+			vec, err := blk.GetVectorCopy(attr, new(bytes.Buffer), new(bytes.Buffer))
+			if err != nil {
+				blk.Unref()
+				return nil, err
+			}
+			vecs[i] = vec
+		}
+		blk.Unref()
+		idx.AddVectors(vecs)
+	}
+	return idx, nil
+}
+
+// hasFullTextIndex reports whether there is a FULLTEXT index on the columns
+func hasFullTextIndex(indice *md.IndexSchema, cols []uint16) bool {
+	if indice == nil {
+		return false
+	}
+	for _, info := range indice.Indice {
+		if info.Type != md.FullText || len(info.Columns) != len(cols) {
+			continue
+		}
+		matched := true
+		for i, col := range cols {
+			if info.Columns[i] != col {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aoedb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFullTextIndex(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+
+	schema := metadata.NewEmptySchema("articles")
+	schema.AppendCol("id", types.Type{Oid: types.T_int32, Size: 4, Width: 4})
+	schema.AppendCol("title", types.Type{Oid: types.T_varchar, Size: 24})
+	schema.AppendCol("body", types.Type{Oid: types.T_varchar, Size: 24})
+	indice := metadata.NewIndexSchema()
+	_, err := indice.MakeIndex("ft", metadata.FullText, 1, 2)
+	assert.Nil(t, err)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+		Indice:        indice,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	rows := int(inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks)
+	bat := batch.New(true, []string{"id", "title", "body"})
+	ids := make([]int32, rows)
+	titles := make([][]byte, rows)
+	bodies := make([][]byte, rows)
+	for i := range ids {
+		ids[i] = int32(i)
+		titles[i] = []byte(fmt.Sprintf("title %d", i))
+		if i%2 == 0 {
+			bodies[i] = []byte("full text search")
+		} else {
+			bodies[i] = []byte("数据库全文检索")
+		}
+	}
+	for i, vs := range []interface{}{ids, titles, bodies} {
+		bat.Vecs[i] = vector.New(schema.ColDefs[i].Type)
+		assert.Nil(t, vector.Append(bat.Vecs[i], vs))
+	}
+	nulls.Add(bat.Vecs[1].Nsp, 0)
+	// the second segment is not closed, only the first one is sorted
+	appendCtx := CreateAppendCtx(database, gen, schema.Name, bat)
+	for i := 0; i < 2; i++ {
+		appendCtx.ID = gen.Alloc(database.GetShardId())
+		assert.Nil(t, inst.Append(appendCtx))
+	}
+
+	dataDir := filepath.Join(inst.Dir, "data")
+	ftiFiles := func() (names []string) {
+		infos, err := ioutil.ReadDir(dataDir)
+		assert.Nil(t, err)
+		for _, info := range infos {
+			if _, ok := common.ParseFullTextIndexFileName(info.Name()); ok {
+				names = append(names, info.Name())
+			}
+		}
+		return
+	}
+	testutils.WaitExpect(4000, func() bool {
+		return len(ftiFiles()) == 1
+	})
+	names := ftiFiles()
+	assert.Equal(t, 1, len(names))
+	name, _ := common.ParseFullTextIndexFileName(names[0])
+	tid, _, cols, ok := common.ParseFullTextIndexFileNameToInfo(name)
+	assert.True(t, ok)
+	assert.Equal(t, tblMeta.Id, tid)
+	assert.Equal(t, []uint16{1, 2}, cols)
+
+	search := func(s string) (matched int) {
+		idx, err := index.DefaultRWHelper.LoadFullTextIndex(filepath.Join(dataDir, names[0]))
+		assert.Nil(t, err)
+		assert.Equal(t, rows, idx.Docs())
+		q := fulltext.ParseQuery(s, fulltext.Boolean)
+		for _, score := range idx.Search(q, idx.Stats(q)) {
+			if score > 0 {
+				matched++
+			}
+		}
+		return
+	}
+	assert.Equal(t, rows/2, search("+\"text search\""))
+	assert.Equal(t, rows/2, search("全文"))
+	assert.Equal(t, rows-1, search("title"))
+
+	// the query is resolved against the whole table, the sorted segment by
+	// its index file and the other one by its rows
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	s, err := rel.FullTextSearch([]string{"title", "body"}, fulltext.ParseQuery("全文", fulltext.NaturalLanguage))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2*rows), s.Stats.Docs)
+	assert.Equal(t, uint64(rows), s.Stats.DocFreqs["全文"])
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dataDir, names[0]), []byte("invalid"), 0644))
+	_, err = rel.FullTextSearch([]string{"title", "body"}, fulltext.ParseQuery("全文", fulltext.NaturalLanguage))
+	assert.Equal(t, fulltext.ErrInvalidIndex, err)
+	// the columns which are not indexed together are searched by the rows
+	s, err = rel.FullTextSearch([]string{"body"}, fulltext.ParseQuery("全文", fulltext.NaturalLanguage))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(rows), s.Stats.DocFreqs["全文"])
+	rel.Close()
+
+	// the missing index of a sorted segment is rebuilt during replaying
+	inst.Close()
+	assert.Nil(t, os.Remove(filepath.Join(dataDir, names[0])))
+	inst, _, _ = initTestDB2(t)
+	testutils.WaitExpect(4000, func() bool {
+		return len(ftiFiles()) == 1
+	})
+	assert.Equal(t, names, ftiFiles())
+	assert.Equal(t, rows/2, search("+\"text search\""))
+
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	dropCtx := &DropIndexCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Table:         schema.Name,
+		IndexNames:    []string{"ft"},
+	}
+	assert.Nil(t, inst.DropIndex(dropCtx))
+	assert.Equal(t, 0, len(ftiFiles()))
+	inst.Close()
+}
@@ -57,6 +57,10 @@ func (r *Relation) Size(attr string) int64 {
 	return int64(r.Data.Size(attr))
 }
 
+func (r *Relation) Stats(_ string) *engine.ColumnStatistics {
+	return nil
+}
+
 func (r *Relation) ID() string {
 	return r.Meta.Schema.Name
 }
@@ -40,6 +40,7 @@ const (
 	NodeSuffix = ".nod"
 	BSISuffix  = ".bsi"
 	BBSISuffix = ".bbsi"
+	FTISuffix  = ".fti"
 
 	SpillDirName = "spill"
 	TempDirName  = "temp"
@@ -89,6 +90,46 @@ func MakeBlockBitSlicedIndexFileName(version, tid, sid, bid uint64, col uint16)
 	return fmt.Sprintf("%d_%d_%d_%d_%d.bbsi", version, tid, sid, bid, col)
 }
 
+// MakeFullTextIndexFileName returns the file name of the full-text index
+// on the columns cols of a segment
+func MakeFullTextIndexFileName(tid, sid uint64, cols []uint16) string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = strconv.Itoa(int(col))
+	}
+	return fmt.Sprintf("%d_%d_%s%s", tid, sid, strings.Join(names, "-"), FTISuffix)
+}
+
+func ParseFullTextIndexFileName(filename string) (name string, ok bool) {
+	name = strings.TrimSuffix(filename, FTISuffix)
+	if len(name) == len(filename) {
+		return name, false
+	}
+	return name, true
+}
+
+func ParseFullTextIndexFileNameToInfo(filename string) (tblID, segID uint64, cols []uint16, ok bool) {
+	infos := strings.Split(strings.TrimSuffix(filename, FTISuffix), "_")
+	if len(infos) != 3 {
+		return 0, 0, nil, false
+	}
+	var err error
+	if tblID, err = strconv.ParseUint(infos[0], 10, 64); err != nil {
+		return 0, 0, nil, false
+	}
+	if segID, err = strconv.ParseUint(infos[1], 10, 64); err != nil {
+		return 0, 0, nil, false
+	}
+	for _, info := range strings.Split(infos[2], "-") {
+		col, err := strconv.ParseUint(info, 10, 16)
+		if err != nil {
+			return 0, 0, nil, false
+		}
+		cols = append(cols, uint16(col))
+	}
+	return tblID, segID, cols, true
+}
+
 func ParseBlockBitSlicedIndexFileName(filename string) (name string, ok bool) {
 	name = strings.TrimSuffix(filename, BBSISuffix)
 	if len(name) == len(filename) {
@@ -17,15 +17,14 @@ package vector
 import (
 	"bytes"
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/colenc"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	base "github.com/matrixorigin/matrixone/pkg/container/vector"
-	"github.com/matrixorigin/matrixone/pkg/encoding"
 	buf "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/pierrec/lz4"
 	"io"
 	// log "github.com/sirupsen/logrus"
 )
@@ -218,10 +217,10 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 	case compress.None:
 		nw, err := w.Write(buf)
 		return int64(nw), err
-	case compress.Lz4:
-		nb := lz4.CompressBlockBound(len(buf))
+	case compress.Lz4, compress.Zstd, compress.Snappy:
+		nb := compress.CompressBound(len(buf), stat.CompressAlgo())
 		tmp := make([]byte, nb)
-		tmp, err = compress.Compress(buf, tmp, compress.Lz4)
+		tmp, err = compress.Compress(buf, tmp, stat.CompressAlgo())
 		if err != nil {
 			return 0, err
 		}
@@ -261,12 +260,9 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			common.GPool.Free(vec.MNode)
 			return n, err
 		}
-		t := encoding.DecodeType(data[:encoding.TypeSize])
-		v := base.New(t)
-		vec.Col = v.Col
-		err = vec.Vector.Read(data)
+		err = colenc.Read(&vec.Vector, data)
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := uint64(stat.Size())
 		originSize := uint64(stat.OriginSize())
 		tmpNode := common.GPool.Alloc(loadSize)
@@ -276,16 +272,13 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			return n, err
 		}
 		vec.MNode = common.GPool.Alloc(originSize)
-		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], compress.Lz4)
+		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], stat.CompressAlgo())
 		if err != nil {
 			common.GPool.Free(vec.MNode)
 			return n, err
 		}
 		data := vec.MNode.Buf[:originSize]
-		t := encoding.DecodeType(data[:encoding.TypeSize])
-		v := base.New(t)
-		vec.Col = v.Col
-		err = vec.Vector.Read(data)
+		err = colenc.Read(&vec.Vector, data)
 		if err != nil {
 			common.GPool.Free(vec.MNode)
 		}
@@ -310,12 +303,12 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
-		err = vec.Vector.Read(buf)
+		err = colenc.Read(&vec.Vector, buf)
 		if err != nil {
 			return n, err
 		}
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := stat.Size()
 		originSize := stat.OriginSize()
 		compressed.Reset()
@@ -334,17 +327,14 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
-		buf, err = compress.Decompress(tmpBuf, buf, compress.Lz4)
+		buf, err = compress.Decompress(tmpBuf, buf, stat.CompressAlgo())
 		if err != nil {
 			return n, err
 		}
 		if len(buf) != int(originSize) {
 			panic(fmt.Sprintf("invalid decompressed size: %d, %d is expected", len(buf), originSize))
 		}
-		t := encoding.DecodeType(buf[:encoding.TypeSize])
-		v := base.New(t)
-		vec.Col = v.Col
-		err = vec.Vector.Read(buf)
+		err = colenc.Read(&vec.Vector, buf)
 		return int64(nr), err
 	default:
 		panic("not supported")
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 // Block is a high-level wrapper of the block type in memory. It
@@ -58,6 +59,11 @@ func (blk *Block) Size(attr string) int64 {
 	return int64(data.Size(attr))
 }
 
+// Stats returns nil because the columns of a block are not analyzed.
+func (blk *Block) Stats(_ string) *engine.ColumnStatistics {
+	return nil
+}
+
 func (blk *Block) Cardinality(_ string) int64 {
 	return 0
 }
@@ -447,6 +447,20 @@ func NewReplayHandle(workDir string, catalog *metadata.Catalog, tables *table.Ta
 							fs.indicesMap[*id] = append(fs.indicesMap[*id], col)
 						}
 					}
+				} else if idx.Type == metadata.FullText {
+					// rebuild the full-text indexes missing in the sorted segments
+					for _, seg := range tbl.SegmentSet {
+						if !seg.IsSortedLocked() {
+							continue
+						}
+						filename := common.MakeFullTextIndexFileName(tbl.Id, seg.Id, idx.Columns)
+						if _, err := os.Stat(path.Join(fs.dataDir, filename)); err == nil {
+							continue
+						}
+						id := seg.AsCommonID()
+						id.Idx = idx.Columns[0]
+						fs.indicesMap[*id] = append(fs.indicesMap[*id], idx.Columns[0])
+					}
 				}
 			}
 		}
@@ -624,10 +638,24 @@ func (h *replayHandle) addDataFile(fname string) {
 	if _, ok := common.ParseBitSlicedIndexFileName(fname); ok {
 		return
 	}
+	if _, ok := common.ParseFullTextIndexFileName(fname); ok {
+		return
+	}
 	h.others = append(h.others, path.Join(h.dataDir, fname))
 }
 
 func (h *replayHandle) addIndexFile(fname string) {
+	if name, ok := common.ParseFullTextIndexFileName(fname); ok {
+		tid, sid, _, ok := common.ParseFullTextIndexFileNameToInfo(name)
+		if !ok {
+			panic("unexpected error")
+		}
+		id := &common.ID{TableID: tid, SegmentID: sid}
+		if h.files[tid] == nil || !h.files[tid].HasSegementFile(id) {
+			h.others = append(h.others, path.Join(h.dataDir, fname))
+		}
+		return
+	}
 	if name, ok := common.ParseBitSlicedIndexFileName(fname); ok {
 		version, tid, sid, col, ok := common.ParseBitSlicedIndexFileNameToInfo(name)
 		if !ok {
@@ -53,8 +53,18 @@ func (e *flushSegIndexEvent) Execute() error {
 	meta := e.Segment.GetMeta()
 	dir := e.Segment.GetSegmentFile().GetDir()
 	bsiEnabled := make([]int, 0)
+	fullTexts := make([]*metadata.IndexInfo, 0)
 	indice := meta.Table.GetIndexSchema()
 	for _, idx := range indice.Indice {
+		if idx.Type == metadata.FullText {
+			for _, col := range idx.Columns {
+				if e.FlushAll || containsCol(e.Cols, col) {
+					fullTexts = append(fullTexts, idx)
+					break
+				}
+			}
+			continue
+		}
 		if idx.Type == metadata.NumBsi || idx.Type == metadata.FixStrBsi {
 			for _, col := range idx.Columns {
 				if e.FlushAll {
@@ -105,6 +115,27 @@ func (e *flushSegIndexEvent) Execute() error {
 	}
 	wg.Wait()
 
+	for _, idx := range fullTexts {
+		blocks := make([][]*vector.Vector, len(ids))
+		for i := 0; i < len(ids); i++ {
+			blk := e.Segment.WeakRefBlock(ids[i])
+			for _, col := range idx.Columns {
+				vec, err := blk.GetVectorWrapper(int(col))
+				if err != nil {
+					panic(err)
+				}
+				blocks[i] = append(blocks[i], &vec.Vector)
+				nodes = append(nodes, vec.MNode)
+			}
+		}
+		filename := common.MakeFullTextIndexFileName(meta.Table.Id, meta.Id, idx.Columns)
+		filename = filepath.Join(dir, filename)
+		if err := index.DefaultRWHelper.FlushFullTextIndex(index.BuildFullTextIndex(blocks), filename); err != nil {
+			panic(err)
+		}
+		logutil.Infof("[SEG] FTI Flushed | %s", filename)
+	}
+
 	release := func() {
 		for _, node := range nodes {
 			common.GPool.Free(node)
@@ -113,3 +144,12 @@ func (e *flushSegIndexEvent) Execute() error {
 	defer release()
 	return nil
 }
+
+func containsCol(cols []uint16, col uint16) bool {
+	for _, c := range cols {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
@@ -93,6 +93,11 @@ func (seg *Segment) Size(attr string) int64 {
 	return int64(seg.Data.Size(attr))
 }
 
+// Stats returns nil because the columns of a segment are not analyzed.
+func (seg *Segment) Stats(_ string) *engine.ColumnStatistics {
+	return nil
+}
+
 func (seg *Segment) Cardinality(_ string) int64 {
 	return 0
 }
@@ -32,6 +32,9 @@ type Pointer struct {
 
 	// OriginLen is the original length of Column and has not been compressed
 	OriginLen uint64
+
+	// Algo is the compress algorithm of Column
+	Algo uint8
 }
 
 type IndicesMeta struct {
@@ -34,10 +34,11 @@ type FileNameFactory = func(string, common.ID) string
 
 // BlockFile file structure:
 // algo | colCntlen | metaCnt | preIdxLen | preIdx | IdxLen | Idx
-// col01 : coldata len | coldata originlen |
-// col02 : coldata len | coldata originlen |
+// col01 : coldata len | coldata originlen | [coldata algo] |
+// col02 : coldata len | coldata originlen | [coldata algo] |
 // ...
 // col01 data | col02 data |  ...
+// coldata algo only exists if algo is perColumnAlgo
 type BlockFile struct {
 	common.RefHelper
 	os.File
@@ -46,7 +47,6 @@ type BlockFile struct {
 	Meta        *FileMeta
 	SegmentFile base.ISegmentFile
 	Info        common.FileInfo
-	DataAlgo    int
 	Idx         *metadata.LogIndex
 	PrevIdx     *metadata.LogIndex
 	Range       *metadata.LogRange
@@ -168,7 +168,11 @@ func (bf *BlockFile) initPointers(id common.ID) {
 	if err = bf.Idx.UnMarshal(buf); err != nil {
 		panic(fmt.Sprintf("unexpect error: %s", err))
 	}
-	headSize := 8 + int(sz+sz_) + 24 + 3 + 8 + 2*8*int(cols)
+	colHeadSize := 2 * 8
+	if algo == perColumnAlgo {
+		colHeadSize++
+	}
+	headSize := 8 + int(sz+sz_) + 24 + 3 + 8 + colHeadSize*int(cols)
 	currOffset := headSize + int(offset)
 	for i := uint16(0); i < cols; i++ {
 		key := base.Key{
@@ -184,11 +188,18 @@ func (bf *BlockFile) initPointers(id common.ID) {
 		if err != nil {
 			panic(fmt.Sprintf("unexpect error: %s", err))
 		}
+		if algo == perColumnAlgo {
+			err = binary.Read(&bf.File, binary.BigEndian, &bf.Parts[key].Algo)
+			if err != nil {
+				panic(fmt.Sprintf("unexpect error: %s", err))
+			}
+		} else {
+			bf.Parts[key].Algo = algo
+		}
 		bf.Parts[key].Offset = int64(currOffset)
 		// log.Infof("(Offset, Len, OriginLen, Algo)=(%d %d, %d, %d)", currOffset, bf.Parts[key].Len, bf.Parts[key].OriginLen, algo)
 		currOffset += int(bf.Parts[key].Len)
 	}
-	bf.DataAlgo = int(algo)
 	if _, err = bf.Seek(int64(currOffset), io.SeekStart); err != nil {
 		panic(err)
 	}
@@ -214,7 +225,15 @@ func (bf *BlockFile) ReadPoint(ptr *base.Pointer, buf []byte) {
 }
 
 func (bf *BlockFile) DataCompressAlgo(id common.ID) int {
-	return bf.DataAlgo
+	key := base.Key{
+		Col: uint64(id.Idx),
+		ID:  id.AsBlockID(),
+	}
+	pointer, ok := bf.Parts[key]
+	if !ok {
+		panic("logic error")
+	}
+	return int(pointer.Algo)
 }
 
 func (bf *BlockFile) PartSize(colIdx uint64, id common.ID, isOrigin bool) int64 {
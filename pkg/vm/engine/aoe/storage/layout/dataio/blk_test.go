@@ -19,10 +19,12 @@ import (
 	// "fmt"
 	// "os"
 	// "path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 
+	"github.com/matrixorigin/matrixone/pkg/colenc"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvector "github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -249,9 +251,7 @@ func TestIVectorNodeWriter(t *testing.T) {
 		defer common.GPool.Free(node)
 		buf := node.Buf[:sz]
 		f.ReadPart(uint64(i), id, buf)
-		obuf := make([]byte, osz)
-		_, err = compress.Decompress(buf, obuf, compress.Lz4)
-		assert.Nil(t, err)
+		obuf := readColumn(t, f, id, i, buf, osz)
 		if i == 0 {
 			vec := vector.NewEmptyStdVector()
 			err = vec.Unmarshal(obuf)
@@ -293,12 +293,7 @@ func TestIVectorNodeWriter(t *testing.T) {
 		osz := nb.PartSize(uint64(i), id, true)
 		buf := make([]byte, sz)
 		nb.ReadPart(uint64(i), id, buf)
-		originSize := uint64(osz)
-		node1 := common.GPool.Alloc(originSize)
-		defer common.GPool.Free(node1)
-		_, err = compress.Decompress(buf, node1.Buf[:originSize], compress.Lz4)
-		assert.Nil(t, err)
-		data := node1.Buf[:originSize]
+		data := readColumn(t, nb, id, i, buf, osz)
 		t1 := encoding.DecodeType(data[:encoding.TypeSize])
 		v := gvector.New(t1)
 		err = v.Read(data)
@@ -363,3 +358,70 @@ func TestTransientBlock(t *testing.T) {
 	// ok = tblk.PreSync(uint32(bat2.Vecs[0].Length()))
 	// assert.False(t, ok)
 }
+
+func TestColumnCompressAlgo(t *testing.T) {
+	dir := initTestEnv(t)
+	rows := 1000
+	catalog := metadata.MockCatalog(dir, uint64(rows), uint64(10), nil, nil)
+	schema := metadata.MockSchema(4)
+	algs := []compress.T{compress.Lz4, compress.Zstd, compress.Snappy, compress.None}
+	for i, alg := range algs {
+		schema.ColDefs[i].Alg = alg
+	}
+	gen := shard.NewMockIndexAllocator()
+	tblMeta := metadata.MockDBTable(catalog, "db1", schema, nil, 1, gen.Shard(uint64(100)))
+	segMeta := tblMeta.SimpleGetSegment(uint64(1))
+	assert.NotNil(t, segMeta)
+	meta := segMeta.SimpleGetBlock(uint64(1))
+	assert.NotNil(t, meta)
+
+	vs := make([]int32, rows)
+	for i := range vs {
+		vs[i] = int32(i % 10)
+	}
+	vecs := make([]*gvector.Vector, len(algs))
+	for i := range vecs {
+		vecs[i] = gvector.New(schema.ColDefs[i].Type)
+		assert.Nil(t, gvector.Append(vecs[i], vs))
+	}
+	bw := NewBlockWriter(vecs, meta, dir)
+	assert.Nil(t, bw.Execute())
+	// the block is sorted by the primary key
+	sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+
+	id := *meta.AsCommonID()
+	segFile := NewUnsortedSegmentFile(dir, *meta.Segment.AsCommonID())
+	f := NewBlockFile(segFile, id, nil)
+	for i, alg := range algs {
+		colID := id
+		colID.Idx = uint16(i)
+		assert.Equal(t, int(alg), f.DataCompressAlgo(colID))
+		sz := f.PartSize(uint64(i), id, false)
+		osz := f.PartSize(uint64(i), id, true)
+		if alg == compress.None {
+			assert.Equal(t, osz, sz)
+		} else {
+			assert.Less(t, sz, osz)
+		}
+		buf := make([]byte, sz)
+		f.ReadPart(uint64(i), id, buf)
+		data := readColumn(t, f, id, i, buf, osz)
+		// the sorted column is run-length encoded
+		assert.Equal(t, colenc.RLE, colenc.Encoding(data))
+		v := gvector.New(schema.ColDefs[i].Type)
+		assert.Nil(t, colenc.Read(v, data))
+		assert.Equal(t, vs, v.Col.([]int32))
+	}
+}
+
+func readColumn(t *testing.T, f *BlockFile, id common.ID, idx int, buf []byte, osz int64) []byte {
+	id.Idx = uint16(idx)
+	algo := f.DataCompressAlgo(id)
+	if algo == compress.None {
+		return buf
+	}
+	data := make([]byte, osz)
+	_, err := compress.Decompress(buf, data, algo)
+	assert.Nil(t, err)
+	return data
+}
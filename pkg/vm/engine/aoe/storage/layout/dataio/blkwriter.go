@@ -21,7 +21,7 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/colenc"
 	gvector "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort"
@@ -29,8 +29,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
-
-	"github.com/pierrec/lz4"
 )
 
 type vecsSerializer func(*os.File, []*gvector.Vector, *metadata.Block) error
@@ -40,8 +38,8 @@ type ivecsSerializer func(*os.File, []vector.IVectorNode, *metadata.Block) error
 type blockFileGetter func(string, *metadata.Block) (*os.File, error)
 
 var (
-	defaultVecsSerializer  = lz4CompressionVecs
-	defaultIVecsSerializer = lz4CompressionIVecs
+	defaultVecsSerializer  = compressionVecs
+	defaultIVecsSerializer = compressionIVecs
 	// defaultVecsSerializer = noCompressionVecs
 )
 
@@ -280,13 +278,12 @@ func (bw *BlockWriter) executeVecs() error {
 	return bw.fileCommiter(filename)
 }
 
-func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
+func compressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
 	var (
 		err error
 		buf bytes.Buffer
 	)
-	algo := uint8(compress.Lz4)
-	if err = binary.Write(&buf, binary.BigEndian, algo); err != nil {
+	if err = binary.Write(&buf, binary.BigEndian, perColumnAlgo); err != nil {
 		return err
 	}
 	colCnt := len(meta.Segment.Table.Schema.ColDefs)
@@ -331,13 +328,13 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 	}
 	var colBufs [][]byte
 	for idx := 0; idx < colCnt; idx++ {
-		colBuf, err := data[idx].Show()
+		colBuf, err := colenc.Encode(data[idx])
 		if err != nil {
 			return err
 		}
 		colSize := len(colBuf)
-		cbuf := make([]byte, lz4.CompressBlockBound(colSize))
-		if cbuf, err = compress.Compress(colBuf, cbuf, compress.Lz4); err != nil {
+		cbuf, algo, err := compressColumn(colBuf, meta.Segment.Table.Schema.ColDefs[idx].Alg)
+		if err != nil {
 			return err
 		}
 		if err = binary.Write(&buf, binary.BigEndian, uint64(len(cbuf))); err != nil {
@@ -346,6 +343,9 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 		if err = binary.Write(&buf, binary.BigEndian, uint64(colSize)); err != nil {
 			return err
 		}
+		if err = binary.Write(&buf, binary.BigEndian, algo); err != nil {
+			return err
+		}
 		colBufs = append(colBufs, cbuf)
 	}
 	if _, err := w.Write(buf.Bytes()); err != nil {
@@ -420,13 +420,12 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 // 	return nil
 // }
 
-func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.Block) error {
+func compressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.Block) error {
 	var (
 		err error
 		buf bytes.Buffer
 	)
-	algo := uint8(compress.Lz4)
-	if err = binary.Write(&buf, binary.BigEndian, algo); err != nil {
+	if err = binary.Write(&buf, binary.BigEndian, perColumnAlgo); err != nil {
 		return err
 	}
 	colCnt := len(meta.Segment.Table.Schema.ColDefs)
@@ -476,8 +475,8 @@ func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.B
 			return err
 		}
 		colSize := len(colBuf)
-		cbuf := make([]byte, lz4.CompressBlockBound(colSize))
-		if cbuf, err = compress.Compress(colBuf, cbuf, compress.Lz4); err != nil {
+		cbuf, algo, err := compressColumn(colBuf, meta.Segment.Table.Schema.ColDefs[idx].Alg)
+		if err != nil {
 			return err
 		}
 		if err = binary.Write(&buf, binary.BigEndian, uint64(len(cbuf))); err != nil {
@@ -486,6 +485,9 @@ func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.B
 		if err = binary.Write(&buf, binary.BigEndian, uint64(colSize)); err != nil {
 			return err
 		}
+		if err = binary.Write(&buf, binary.BigEndian, algo); err != nil {
+			return err
+		}
 		colBufs = append(colBufs, cbuf)
 	}
 	if _, err := w.Write(buf.Bytes()); err != nil {
@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/matrixorigin/matrixone/pkg/colenc"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -33,8 +34,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
-
-	"github.com/pierrec/lz4"
 )
 
 const (
@@ -49,9 +48,19 @@ const (
 	blkIdxSize   = 48
 	blkRangeSize = 24
 	colSizeSize  = 8
+	colAlgoSize  = 1
 	colPosSize   = 8
 )
 
+// colPartSize returns the size of the metadata of a column part
+// in the segment file whose header has the algo.
+func colPartSize(algo uint8) int {
+	if algo == perColumnAlgo {
+		return colSizeSize*2 + colAlgoSize
+	}
+	return colSizeSize * 2
+}
+
 const Version uint64 = 1
 
 type FileDestoryer = func(string) error
@@ -242,7 +251,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	if err != nil {
 		return err
 	}
-	err = binary.Write(&metaBuf, binary.BigEndian, uint8(compress.Lz4))
+	err = binary.Write(&metaBuf, binary.BigEndian, perColumnAlgo)
 	if err != nil {
 		return err
 	}
@@ -298,7 +307,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 		startPosSize +
 		endPosSize +
 		int(blkCnt)*(blkCountSize+2*blkIdxSize+blkRangeSize) +
-		int(blkCnt)*colCnt*(colSizeSize*2) +
+		int(blkCnt)*colCnt*colPartSize(perColumnAlgo) +
 		colCnt*colPosSize
 
 	if _, err = w.Seek(int64(metaSize), io.SeekStart); err != nil {
@@ -336,7 +345,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 			}
 			indices = append(indices, zmi)
 
-			colSz, err := processColumn(pkColumn, &metaBuf, &outputBuffer)
+			colSz, err := processColumn(pkColumn, colDefs[i].Alg, &metaBuf, &outputBuffer)
 			if err != nil {
 				return err
 			}
@@ -361,7 +370,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 			return err
 		}
 		indices = append(indices, zmi)
-		colSz, err := processColumn(column, &metaBuf, &outputBuffer)
+		colSz, err := processColumn(column, colDefs[i].Alg, &metaBuf, &outputBuffer)
 		if err != nil {
 			return err
 		}
@@ -432,16 +441,16 @@ func preprocessColumn(column []*vector.Vector, sortedIdx *[]uint16, isPrimary bo
 	return nil
 }
 
-func processColumn(column []*vector.Vector, metaBuf, dataBuf *bytes.Buffer) (int, error) {
+func processColumn(column []*vector.Vector, alg compress.T, metaBuf, dataBuf *bytes.Buffer) (int, error) {
 	colSz := 0
 	for _, vec := range column {
-		colBuf, err := vec.Show()
+		colBuf, err := colenc.Encode(vec)
 		if err != nil {
 			return 0, err
 		}
 		colSize := len(colBuf)
-		cbuf := make([]byte, lz4.CompressBlockBound(colSize))
-		if cbuf, err = compress.Compress(colBuf, cbuf, compress.Lz4); err != nil {
+		cbuf, algo, err := compressColumn(colBuf, alg)
+		if err != nil {
 			return 0, err
 		}
 		if err = binary.Write(metaBuf, binary.BigEndian, uint64(len(cbuf))); err != nil {
@@ -450,6 +459,9 @@ func processColumn(column []*vector.Vector, metaBuf, dataBuf *bytes.Buffer) (int
 		if err = binary.Write(metaBuf, binary.BigEndian, uint64(colSize)); err != nil {
 			return 0, err
 		}
+		if err = binary.Write(metaBuf, binary.BigEndian, algo); err != nil {
+			return 0, err
+		}
 		if err = binary.Write(dataBuf, binary.BigEndian, cbuf); err != nil {
 			return 0, err
 		}
@@ -50,7 +50,6 @@ type SortedSegmentFile struct {
 	Meta       *FileMeta
 	BlocksMeta map[common.ID]*FileMeta
 	Info       *fileStat
-	DataAlgo   int
 }
 
 func NewSortedSegmentFile(dirname string, id common.ID) base.ISegmentFile {
@@ -164,7 +163,7 @@ func (sf *SortedSegmentFile) initPointers() {
 	sz = startPosSize +
 		endPosSize +
 		int(blkCnt)*(blkCountSize+2*blkIdxSize+blkRangeSize) +
-		int(blkCnt*colCnt)*(colSizeSize*2) +
+		int(blkCnt*colCnt)*colPartSize(algo) +
 		int(colCnt)*colPosSize
 
 	buf = make([]byte, sz)
@@ -226,6 +225,13 @@ func (sf *SortedSegmentFile) initPointers() {
 			if err = binary.Read(metaBuf, binary.BigEndian, &sf.Parts[key].OriginLen); err != nil {
 				panic(err)
 			}
+			if algo == perColumnAlgo {
+				if err = binary.Read(metaBuf, binary.BigEndian, &sf.Parts[key].Algo); err != nil {
+					panic(err)
+				}
+			} else {
+				sf.Parts[key].Algo = algo
+			}
 		}
 	}
 
@@ -279,7 +285,6 @@ func (sf *SortedSegmentFile) initPointers() {
 		panic(err)
 	}
 
-	sf.DataAlgo = int(algo)
 }
 
 func (sf *SortedSegmentFile) GetFileType() common.FileType {
@@ -326,7 +331,15 @@ func (sf *SortedSegmentFile) GetBlockSize(_ common.ID) int64 {
 }
 
 func (sf *SortedSegmentFile) DataCompressAlgo(id common.ID) int {
-	return sf.DataAlgo
+	key := base.Key{
+		Col: uint64(id.Idx),
+		ID:  id,
+	}
+	pointer, ok := sf.Parts[key]
+	if !ok {
+		panic("logic error")
+	}
+	return int(pointer.Algo)
 }
 
 func (sf *SortedSegmentFile) PartSize(colIdx uint64, id common.ID, isOrigin bool) int64 {
@@ -229,9 +229,13 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 		defer common.GPool.Free(node)
 		buf := node.Buf[:sz]
 		file.ReadPart(uint64(i), id, buf)
+		colID := id
+		colID.Idx = uint16(i)
 		obuf := make([]byte, osz)
-		_, err := compress.Decompress(buf, obuf, compress.Lz4)
-		if err != nil {
+		var err error
+		if algo := file.DataCompressAlgo(colID); algo == compress.None {
+			copy(obuf, buf)
+		} else if _, err = compress.Decompress(buf, obuf, algo); err != nil {
 			panic(err)
 		}
 		switch colDef.Type.Oid {
@@ -333,8 +337,11 @@ func (f *TransientBlockFile) PartSize(colIdx uint64, id common.ID, isOrigin bool
 	return file.PartSize(colIdx, id, isOrigin)
 }
 
-func (f *TransientBlockFile) DataCompressAlgo(common.ID) int {
-	return compress.Lz4
+func (f *TransientBlockFile) DataCompressAlgo(id common.ID) int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	file := f.files[len(f.files)-1]
+	return file.DataCompressAlgo(id)
 }
 
 func (f *TransientBlockFile) Destroy() {
@@ -19,9 +19,33 @@ import (
 	"io"
 	"os"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 )
 
+// perColumnAlgo in the algo field of the block and segment file header
+// means that each column part records its own compress algorithm.
+// The files written before have a single algo for all the column parts.
+const perColumnAlgo = uint8(0xff)
+
+// compressColumn compresses the column data with alg. The data is kept
+// uncompressed if it can not be compressed by alg, and the returned algo
+// is the one actually used.
+func compressColumn(data []byte, alg compress.T) ([]byte, uint8, error) {
+	if alg == compress.None {
+		return data, uint8(compress.None), nil
+	}
+	buf := make([]byte, compress.CompressBound(len(data), int(alg)))
+	buf, err := compress.Compress(data, buf, int(alg))
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(buf) == 0 || len(buf) >= len(data) {
+		return data, uint8(compress.None), nil
+	}
+	return buf, uint8(alg), nil
+}
+
 type fileStat struct {
 	size  int64
 	osize int64
@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+)
+
+// BuildFullTextIndex builds the full-text index of the rows of a segment,
+// blocks[i][j] is the vector of the j-th indexed column of the i-th block
+// and the document number of a row is its offset in the segment.
+func BuildFullTextIndex(blocks [][]*vector.Vector) *fulltext.Index {
+	idx := fulltext.NewIndex()
+	for _, vecs := range blocks {
+		idx.AddVectors(vecs)
+	}
+	return idx
+}
+
+// FlushFullTextIndex writes the index to a temporary file and renames it
+// to filename, so a reader never sees a partial index.
+func (h *RWHelper) FlushFullTextIndex(idx *fulltext.Index, filename string) error {
+	tmp := filename + common.TmpSuffix
+	if err := ioutil.WriteFile(tmp, idx.Marshal(), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filename); err != nil {
+		return err
+	}
+	logutil.Infof("Flush FTI file | %s", filename)
+	return nil
+}
+
+func (h *RWHelper) LoadFullTextIndex(filename string) (*fulltext.Index, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	idx := new(fulltext.Index)
+	if err = idx.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
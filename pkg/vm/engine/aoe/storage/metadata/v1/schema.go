@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
@@ -28,6 +29,7 @@ const (
 	ZoneMap IndexT = iota
 	NumBsi
 	FixStrBsi
+	FullText
 )
 
 type IndexInfo struct {
@@ -53,6 +55,20 @@ type ColDef struct {
 	Name string     `json:"name"`
 	Idx  int        `json:"idx"`
 	Type types.Type `json:"type"`
+	// Alg is the compression algorithm of the column data
+	Alg compress.T `json:"alg"`
+}
+
+// UnmarshalJSON decodes the column definition, the column data of the
+// schemas written before Alg was added is compressed with lz4.
+func (def *ColDef) UnmarshalJSON(data []byte) error {
+	type colDef ColDef
+	v := colDef{Alg: compress.Lz4}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*def = ColDef(v)
+	return nil
 }
 
 type IndexSchema struct {
@@ -181,6 +197,7 @@ func (s *Schema) AppendCol(name string, typ types.Type) {
 		Name: name,
 		Type: typ,
 		Idx:  len(s.ColDefs),
+		Alg:  compress.Lz4,
 	}
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
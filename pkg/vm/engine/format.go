@@ -68,6 +68,9 @@ func (node *PrimaryIndexDef) Format(buf *bytes.Buffer) {
 }
 
 func (node *IndexTableDef) Format(buf *bytes.Buffer) {
+	if node.Typ == FullTextIndex {
+		buf.WriteString("FULLTEXT ")
+	}
 	buf.WriteString("KEY")
 	buf.WriteString(" `")
 	buf.WriteString(node.Name)
@@ -83,8 +86,10 @@ func (node *IndexTableDef) Format(buf *bytes.Buffer) {
 	}
 	buf.WriteString(")")
 
-	buf.WriteString(" USING ")
-	buf.WriteString(node.Typ.ToString())
+	if node.Typ != FullTextIndex {
+		buf.WriteString(" USING ")
+		buf.WriteString(node.Typ.ToString())
+	}
 }
 
 func makeVal2Str(typ types.Type, value interface{}, isNull bool) string {
@@ -0,0 +1,35 @@
+package memEngine
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var (
+	_ engine.FullTextSearcher = (*relation)(nil)
+)
+
+// FullTextSearch resolves the query against the segments of the table, each
+// segment is indexed when it is read.
+func (r *relation) FullTextSearch(attrs []string, q *fulltext.Query) (*fulltext.Search, error) {
+	refCnts := make([]uint64, len(attrs))
+	for i := range refCnts {
+		refCnts[i] = 1
+	}
+	var corpus fulltext.Corpus
+	for _, rd := range r.NewReader(1, nil, nil) {
+		for {
+			bat, err := rd.Read(refCnts, attrs)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			idx := fulltext.NewIndex()
+			idx.AddVectors(bat.Vecs)
+			corpus = append(corpus, idx)
+		}
+	}
+	return corpus.Search(q), nil
+}
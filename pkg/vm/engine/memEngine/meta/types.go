@@ -8,4 +8,5 @@ type Metadata struct {
 	Name  string
 	Attrs []engine.Attribute
 	Index []engine.IndexTableDef
+	Stats map[string]*engine.ColumnStatistics
 }
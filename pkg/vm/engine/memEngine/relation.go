@@ -8,8 +8,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
-
-	"github.com/pierrec/lz4"
 )
 
 func (r *relation) Close() {}
@@ -26,6 +24,24 @@ func (_ *relation) Size(_ string) int64 {
 	return 0
 }
 
+func (r *relation) Stats(attr string) *engine.ColumnStatistics {
+	return r.md.Stats[attr]
+}
+
+func (r *relation) WriteStats(_ uint64, stats map[string]*engine.ColumnStatistics) error {
+	if r.md.Stats == nil {
+		r.md.Stats = make(map[string]*engine.ColumnStatistics)
+	}
+	for attr, st := range stats {
+		r.md.Stats[attr] = st
+	}
+	data, err := encoding.Encode(r.md)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(r.id, data)
+}
+
 func (_ *relation) Cardinality(_ string) int64 {
 	return 0
 }
@@ -102,9 +118,9 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 		if err != nil {
 			return err
 		}
-		if r.md.Attrs[i].Alg == compress.Lz4 {
-			data := make([]byte, lz4.CompressBlockBound(len(v)))
-			if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
+		if alg := int(r.md.Attrs[i].Alg); alg != compress.None {
+			data := make([]byte, compress.CompressBound(len(v), alg))
+			if data, err = compress.Compress(v, data, alg); err != nil {
 				return err
 			}
 			data = append(data, encoding.EncodeInt32(int32(len(v)))...)
@@ -88,7 +88,11 @@ func OpenCatalog(dir, name string, cfg *store.StoreCfg, scheduler tasks.TaskSche
 		checkpoints: make([]*Checkpoint, 0),
 		scheduler:   scheduler,
 	}
-	err = catalog.store.Replay(catalog.replayhandle)
+	replayer := newCheckpointReplayer()
+	if err = catalog.store.Replay(catalog.makeReplayHandle(replayer)); err != nil {
+		return catalog, err
+	}
+	err = replayer.build(catalog)
 	return catalog, err
 }
 func (catalog *Catalog) GetStore() store.Store { return catalog.store }
@@ -135,6 +139,19 @@ func (catalog *Catalog) replayCmd(txncmd txnif.TxnCmd) (err error) {
 	case CmdLogDatabase:
 		cmd := txncmd.(*EntryCommand)
 		catalog.addEntryLocked(cmd.DB)
+	case CmdUpdateTableStats:
+		cmd := txncmd.(*EntryCommand)
+		db, err := catalog.GetDatabaseByID(cmd.DBID)
+		if err != nil {
+			return err
+		}
+		tbl, err := db.GetTableEntryByID(cmd.TableID)
+		if err != nil {
+			return err
+		}
+		tbl.Lock()
+		tbl.setStatsLocked(cmd.Stats, cmd.StatsAt, nil)
+		tbl.Unlock()
 	case CmdCreateDatabase:
 		cmd := txncmd.(*EntryCommand)
 		entry := NewDBEntry(catalog, cmd.DB.name, nil)
@@ -450,7 +467,9 @@ func (catalog *Catalog) PrepareCheckpoint(startTs, endTs uint64) *CheckpointEntr
 	}
 	processor.TableFn = func(table *TableEntry) (err error) {
 		entry := table.BaseEntry
+		n := len(ckpEntry.Entries)
 		CheckpointOp(ckpEntry, entry, table, startTs, endTs)
+		table.checkpointStats(ckpEntry, startTs, endTs, len(ckpEntry.Entries) > n)
 		return
 	}
 	processor.DatabaseFn = func(database *DBEntry) (err error) {
@@ -16,11 +16,15 @@ package catalog
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -463,3 +467,84 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg1.String())
 	t.Log(tb.String())
 }
+
+func TestSchemaFormat(t *testing.T) {
+	schema := MockSchemaAll(3)
+	schema.ColDefs[1].Alg = compress.Zstd
+	schema.ColDefs[2].NullAbility = true
+	schema.ColDefs[2].Default = Default{Exist: true}
+	schema.ImplicitKey = true
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	schema2 := NewEmptySchema("")
+	n, err := schema2.ReadFrom(bytes.NewReader(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(buf)), n)
+	assert.Equal(t, schema.Name, schema2.Name)
+	assert.Equal(t, schema.BlockMaxRows, schema2.BlockMaxRows)
+	assert.Equal(t, schema.PrimaryKey, schema2.PrimaryKey)
+	assert.True(t, schema2.ImplicitKey)
+	for i, def := range schema.ColDefs {
+		assert.Equal(t, *def, *schema2.ColDefs[i])
+	}
+
+	// the schema written before the format is versioned
+	var w bytes.Buffer
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, uint32(40000)))
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, int32(1)))
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, uint16(40)))
+	_, err = common.WriteString("legacy", &w)
+	assert.Nil(t, err)
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, uint16(2)))
+	for _, name := range []string{"a", "b"} {
+		_, err = w.Write(encoding.EncodeType(types.Type{Oid: types.T_int32, Size: 4, Width: 32}))
+		assert.Nil(t, err)
+		_, err = common.WriteString(name, &w)
+		assert.Nil(t, err)
+	}
+	legacy := NewEmptySchema("")
+	_, err = legacy.ReadFrom(bytes.NewReader(w.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, "legacy", legacy.Name)
+	assert.Equal(t, uint32(40000), legacy.BlockMaxRows)
+	assert.Equal(t, int32(1), legacy.PrimaryKey)
+	assert.Equal(t, uint16(40), legacy.SegmentMaxBlocks)
+	assert.Equal(t, 2, len(legacy.ColDefs))
+	assert.Equal(t, 1, legacy.GetColIdx("b"))
+	assert.Equal(t, compress.T(compress.Lz4), legacy.ColDefs[1].Alg)
+	assert.False(t, legacy.ColDefs[1].NullAbility)
+	assert.Equal(t, 0, len(legacy.CompositeKey))
+
+	// the schema written by a newer version
+	binary.BigEndian.PutUint16(buf[4:], schemaVersion+1)
+	_, err = NewEmptySchema("").ReadFrom(bytes.NewReader(buf))
+	assert.ErrorIs(t, err, ErrUnsupported)
+
+	// the FULLTEXT indexes
+	schema = MockSchemaAll(14)
+	schema.Indexes = []*IndexInfo{NewIndexInfo("ft1", FullText, 12, 13), NewIndexInfo("ft2", FullText, 12)}
+	assert.True(t, schema.Valid())
+	buf, err = schema.Marshal()
+	assert.Nil(t, err)
+	indexed := NewEmptySchema("")
+	n, err = indexed.ReadFrom(bytes.NewReader(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(buf)), n)
+	assert.Equal(t, schema.Indexes, indexed.Indexes)
+	cnt := indexed.IndexFileCnt()
+	assert.Equal(t, len(schema.ColDefs), len(cnt))
+	assert.Equal(t, 2, cnt[int(schema.PrimaryKey)])
+	assert.Equal(t, 3, cnt[12])
+	assert.Equal(t, 1, cnt[13])
+	col, internal := indexed.IndexFile(1)
+	assert.Equal(t, 12, col)
+	assert.Equal(t, 1, internal)
+	// the zonemap of a column is after its FULLTEXT indexes
+	internal, ok := indexed.ZoneMapFile(12)
+	assert.True(t, ok)
+	assert.Equal(t, 2, internal)
+	_, ok = indexed.ZoneMapFile(int(schema.PrimaryKey))
+	assert.False(t, ok)
+	schema.Indexes = append(schema.Indexes, NewIndexInfo("ft3", FullText, 0))
+	assert.False(t, schema.Valid())
+}
@@ -37,6 +37,7 @@ const (
 	CmdLogTable
 	CmdLogSegment
 	CmdLogBlock
+	CmdUpdateTableStats
 )
 
 func init() {
@@ -76,6 +77,9 @@ func init() {
 	txnif.RegisterCmdFactory(CmdLogDatabase, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
+	txnif.RegisterCmdFactory(CmdUpdateTableStats, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
 }
 
 type EntryCommand struct {
@@ -89,6 +93,8 @@ type EntryCommand struct {
 	Table     *TableEntry
 	Segment   *SegmentEntry
 	Block     *BlockEntry
+	Stats     []byte
+	StatsAt   uint64
 }
 
 func newEmptyEntryCmd(cmdType int16) *EntryCommand {
@@ -131,6 +137,19 @@ func newTableCmd(id uint32, cmdType int16, entry *TableEntry) *EntryCommand {
 	return impl
 }
 
+func newTableStatsCmd(id uint32, entry *TableEntry, stats []byte, ts uint64) *EntryCommand {
+	impl := &EntryCommand{
+		DB:      entry.GetDB(),
+		Table:   entry,
+		Stats:   stats,
+		StatsAt: ts,
+		cmdType: CmdUpdateTableStats,
+		entry:   entry.BaseEntry,
+	}
+	impl.BaseCustomizedCmd = txnbase.NewBaseCustomizedCmd(id, impl)
+	return impl
+}
+
 func newDBCmd(id uint32, cmdType int16, entry *DBEntry) *EntryCommand {
 	impl := &EntryCommand{
 		DB:      entry,
@@ -193,6 +212,23 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		sn, err = cmd.DB.WriteTo(w)
 		n += sn
 		return
+	case CmdUpdateTableStats:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.StatsAt); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, uint32(len(cmd.Stats))); err != nil {
+			return
+		}
+		var wn int
+		wn, err = w.Write(cmd.Stats)
+		n += int64(wn) + 8 + 8 + 8 + 4
+		return
 	}
 
 	if err = binary.Write(w, binary.BigEndian, cmd.entry.GetID()); err != nil {
@@ -379,6 +415,26 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cn, err = cmd.DB.ReadFrom(r)
 		n += cn
 		return
+	case CmdUpdateTableStats:
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.StatsAt); err != nil {
+			return
+		}
+		length := uint32(0)
+		if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+			return
+		}
+		cmd.Stats = make([]byte, length)
+		if _, err = io.ReadFull(r, cmd.Stats); err != nil {
+			return
+		}
+		n += int64(length) + 8 + 8 + 8 + 4
+		return
 	}
 
 	cmd.entry = &BaseEntry{}
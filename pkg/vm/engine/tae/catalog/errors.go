@@ -21,7 +21,11 @@ var (
 	ErrDuplicate  = errors.New("tae catalog: duplicate")
 	ErrCheckpoint = errors.New("tae catalog: checkpoint")
 
-	ErrValidation = errors.New("tae catalog: validataion")
+	ErrValidation  = errors.New("tae catalog: validataion")
+	ErrUnsupported = errors.New("tae catalog: unsupported format")
+
+	ErrNotNullable = errors.New("tae catalog: null value in not nullable column")
+	ErrNoDefault   = errors.New("tae catalog: no default value")
 
 	ErrStopCurrRecur = errors.New("tae catalog: stop current recursion")
 )
@@ -0,0 +1,260 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// checkpointReplayer merges the entries of the catalog checkpoints. Every
+// checkpoint has the entries changed since the previous one, the latest
+// version of an entry is kept.
+type checkpointReplayer struct {
+	built    bool
+	dbs      map[uint64]*EntryCommand
+	tables   map[uint64]*EntryCommand
+	segments map[uint64]*EntryCommand
+	blocks   map[uint64]*EntryCommand
+}
+
+func newCheckpointReplayer() *checkpointReplayer {
+	return &checkpointReplayer{
+		dbs:      make(map[uint64]*EntryCommand),
+		tables:   make(map[uint64]*EntryCommand),
+		segments: make(map[uint64]*EntryCommand),
+		blocks:   make(map[uint64]*EntryCommand),
+	}
+}
+
+// makeReplayHandle returns the handle replaying the catalog store. The merged
+// entries of the checkpoints are added to the catalog before the first
+// command of the wal is replayed on them.
+func (catalog *Catalog) makeReplayHandle(replayer *checkpointReplayer) store.ApplyHandle {
+	return func(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error) {
+		if typ == ETCatalogCheckpoint {
+			ckp := NewEmptyCheckpointEntry()
+			if err = ckp.Unmarshal(payload); err != nil {
+				return
+			}
+			replayer.onCheckpoint(ckp)
+			catalog.ckpmu.Lock()
+			catalog.checkpoints = append(catalog.checkpoints, &Checkpoint{
+				MaxTS: ckp.MaxTS,
+				LSN:   commitId,
+			})
+			catalog.ckpmu.Unlock()
+			return
+		}
+		if group != wal.GroupC {
+			return
+		}
+		if err = replayer.build(catalog); err != nil {
+			return
+		}
+		return catalog.replayhandle(group, commitId, payload, typ, info)
+	}
+}
+
+func (r *checkpointReplayer) isEmpty() bool {
+	return len(r.dbs) == 0 && len(r.tables) == 0 && len(r.segments) == 0 && len(r.blocks) == 0
+}
+
+func (r *checkpointReplayer) onCheckpoint(ckp *CheckpointEntry) {
+	for _, cmd := range ckp.Entries {
+		switch cmd.GetType() {
+		case CmdLogDatabase:
+			r.dbs[cmd.DB.ID] = cmd
+		case CmdLogTable:
+			r.tables[cmd.Table.ID] = cmd
+		case CmdLogSegment:
+			r.segments[cmd.Segment.ID] = cmd
+		case CmdLogBlock:
+			r.blocks[cmd.Block.ID] = cmd
+		}
+	}
+}
+
+func replayedBaseEntry(entry *BaseEntry) *BaseEntry {
+	return &BaseEntry{
+		RWMutex: new(sync.RWMutex),
+		CommitInfo: CommitInfo{
+			CurrOp: entry.CurrOp,
+		},
+		ID:       entry.ID,
+		CreateAt: entry.CreateAt,
+		DeleteAt: entry.DeleteAt,
+	}
+}
+
+// build adds the merged entries to the catalog and inits the id allocator
+// with the max ids of them. The entries dropped at or before the checkpoints
+// are invisible to the txns started after the replay, they are skipped with
+// the entries under them.
+func (r *checkpointReplayer) build(catalog *Catalog) (err error) {
+	if r.built || r.isEmpty() {
+		return
+	}
+	r.built = true
+	var maxDB, maxTable, maxSegment, maxBlock uint64
+	for _, id := range sortedIDs(r.dbs) {
+		cmd := r.dbs[id]
+		if id > maxDB {
+			maxDB = id
+		}
+		if cmd.DB.DeleteAt != 0 {
+			continue
+		}
+		db := &DBEntry{
+			BaseEntry: replayedBaseEntry(cmd.DB.BaseEntry),
+			catalog:   catalog,
+			name:      cmd.DB.name,
+			entries:   make(map[uint64]*common.DLNode),
+			nameNodes: make(map[string]*nodeList),
+			link:      new(common.Link),
+		}
+		catalog.Lock()
+		err = catalog.addEntryLocked(db)
+		catalog.Unlock()
+		if err != nil {
+			return
+		}
+	}
+	for _, id := range sortedIDs(r.tables) {
+		cmd := r.tables[id]
+		if id > maxTable {
+			maxTable = id
+		}
+		if cmd.Table.DeleteAt != 0 {
+			continue
+		}
+		db, err := catalog.GetDatabaseByID(cmd.DBID)
+		if err == ErrNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+		table := &TableEntry{
+			BaseEntry: replayedBaseEntry(cmd.Table.BaseEntry),
+			db:        db,
+			schema:    cmd.Table.schema,
+			link:      new(common.Link),
+			entries:   make(map[uint64]*common.DLNode),
+			stats:     cmd.Table.stats,
+			statsAt:   cmd.Table.statsAt,
+		}
+		db.Lock()
+		err = db.addEntryLocked(table)
+		db.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	for _, id := range sortedIDs(r.segments) {
+		cmd := r.segments[id]
+		if id > maxSegment {
+			maxSegment = id
+		}
+		if cmd.Segment.DeleteAt != 0 {
+			continue
+		}
+		table, err := r.getTable(catalog, cmd.DBID, cmd.TableID)
+		if err == ErrNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+		segment := &SegmentEntry{
+			BaseEntry: replayedBaseEntry(cmd.Segment.BaseEntry),
+			table:     table,
+			link:      new(common.Link),
+			entries:   make(map[uint64]*common.DLNode),
+			state:     cmd.Segment.state,
+		}
+		table.Lock()
+		table.addEntryLocked(segment)
+		table.Unlock()
+	}
+	for _, id := range sortedIDs(r.blocks) {
+		cmd := r.blocks[id]
+		if id > maxBlock {
+			maxBlock = id
+		}
+		if cmd.Block.DeleteAt != 0 {
+			continue
+		}
+		table, err := r.getTable(catalog, cmd.DBID, cmd.TableID)
+		if err == ErrNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+		segment, err := table.GetSegmentByID(cmd.SegmentID)
+		if err == ErrNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+		block := &BlockEntry{
+			BaseEntry: replayedBaseEntry(cmd.Block.BaseEntry),
+			segment:   segment,
+			state:     cmd.Block.state,
+		}
+		segment.Lock()
+		segment.addEntryLocked(block)
+		segment.Unlock()
+	}
+	catalog.IDAlloctor.Init(maxDB, maxTable, maxSegment, maxBlock)
+	return
+}
+
+// sortedIDs returns the ids in the order of creation, the entries created in
+// the same txn keep the order of the link
+func sortedIDs(cmds map[uint64]*EntryCommand) []uint64 {
+	ids := make([]uint64, 0, len(cmds))
+	for id := range cmds {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (r *checkpointReplayer) getTable(catalog *Catalog, dbID, tableID uint64) (table *TableEntry, err error) {
+	db, err := catalog.GetDatabaseByID(dbID)
+	if err != nil {
+		return
+	}
+	return db.GetTableEntryByID(tableID)
+}
+
+// InitData creates the data of the table replayed from the checkpoints
+func (entry *TableEntry) InitData(factory TableDataFactory) {
+	entry.tableData = factory(entry)
+}
+
+// InitData creates the data of the segment replayed from the checkpoints
+func (entry *SegmentEntry) InitData(factory SegmentDataFactory) {
+	entry.segData = factory(entry)
+}
+
+// InitData creates the data of the block replayed from the checkpoints, the
+// block reattaches what was flushed to its block file
+func (entry *BlockEntry) InitData(factory BlockDataFactory) {
+	entry.blkData = factory(entry)
+}
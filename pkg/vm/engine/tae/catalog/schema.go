@@ -20,18 +20,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
+	"reflect"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 )
 
 type IndexT uint16
 
 const (
 	ZoneMap IndexT = iota
+	FullText
 )
 
 type IndexInfo struct {
@@ -53,10 +60,82 @@ func NewIndexInfo(name string, typ IndexT, colIdx ...int) *IndexInfo {
 	return index
 }
 
+// Default is the default value of the column, the value is nil if the default
+// is null
+type Default struct {
+	Exist bool
+	Value interface{}
+}
+
 type ColDef struct {
-	Name string
-	Idx  int
-	Type types.Type
+	Name        string
+	Idx         int
+	Type        types.Type
+	Alg         compress.T
+	NullAbility bool
+	Default     Default
+}
+
+const (
+	noDefault byte = iota
+	nullDefault
+	valueDefault
+)
+
+func (def *ColDef) writeDefault(w io.Writer) (err error) {
+	if !def.Default.Exist {
+		return binary.Write(w, binary.BigEndian, noDefault)
+	}
+	if def.Default.Value == nil {
+		return binary.Write(w, binary.BigEndian, nullDefault)
+	}
+	if err = binary.Write(w, binary.BigEndian, valueDefault); err != nil {
+		return
+	}
+	buf, err := common.EncodeKey(def.Default.Value, def.Type)
+	if err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint16(len(buf))); err != nil {
+		return
+	}
+	_, err = w.Write(buf)
+	return
+}
+
+func (def *ColDef) readDefault(r io.Reader) (n int64, err error) {
+	var kind byte
+	if err = binary.Read(r, binary.BigEndian, &kind); err != nil {
+		return
+	}
+	n = 1
+	if kind == noDefault {
+		return
+	}
+	def.Default.Exist = true
+	if kind == nullDefault {
+		return
+	}
+	length := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+		return
+	}
+	buf := make([]byte, length)
+	if _, err = io.ReadFull(r, buf); err != nil {
+		return
+	}
+	n += 2 + int64(length)
+	def.Default.Value = common.DecodeKey(buf, def.Type)
+	return
+}
+
+// validDefault checks the default value is of the type of the column
+func (def *ColDef) validDefault() bool {
+	if def.Default.Value == nil {
+		return def.NullAbility || !def.Default.Exist
+	}
+	zero := compute.ZeroValue(def.Type)
+	return zero != nil && reflect.TypeOf(zero) == reflect.TypeOf(def.Default.Value)
 }
 
 type Schema struct {
@@ -66,6 +145,14 @@ type Schema struct {
 	BlockMaxRows     uint32         `json:"blkrows"`
 	PrimaryKey       int32          `json:"primarykey"`
 	SegmentMaxBlocks uint16         `json:"segblocks"`
+	CompositeKey     []int32        `json:"compositekey"`
+	// ImplicitKey is true if the table has no primary key and the first
+	// column is picked as the key, the column keeps its nullability and its
+	// null keys are neither unique nor indexed
+	ImplicitKey bool `json:"implicitkey"`
+	// Indexes are the FULLTEXT indexes of the table, the zonemap of the
+	// primary key is not in them
+	Indexes []*IndexInfo `json:"indexes"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -76,10 +163,47 @@ func NewEmptySchema(name string) *Schema {
 	}
 }
 
+// The schemas written before the format is versioned begin with BlockMaxRows.
+// The versioned ones begin with schemaMagic in the place of BlockMaxRows and
+// the version follows it.
+//
+// legacy    : blkrows | pk | segblocks | name | colcnt | col * colcnt
+// col       : type | name
+// version 1 : magic | version | blkrows | pk | segblocks | name | colcnt | col * colcnt | keycnt | key * keycnt
+// version 2 : magic | version | blkrows | pk | segblocks | name | colcnt | col * colcnt | keycnt | key * keycnt | implicit
+// version 3 : magic | version | blkrows | pk | segblocks | name | colcnt | col * colcnt | keycnt | key * keycnt | implicit | idxcnt | idx * idxcnt
+// col       : type | name | alg | nullable | default
+// idx       : type | name | colcnt | col * colcnt
+const (
+	schemaMagic uint32 = math.MaxUint32
+
+	// per-column compression, nullability, default values and composite key
+	schemaVersion1 uint16 = 1
+	// implicit key of the table without a primary key
+	schemaVersion2 uint16 = 2
+	// FULLTEXT indexes
+	schemaVersion3 uint16 = 3
+	schemaVersion         = schemaVersion3
+)
+
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
+	version := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
 		return
 	}
+	if s.BlockMaxRows == schemaMagic {
+		if err = binary.Read(r, binary.BigEndian, &version); err != nil {
+			return
+		}
+		if version > schemaVersion {
+			err = fmt.Errorf("%w: schema version %d", ErrUnsupported, version)
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+			return
+		}
+		n += 4 + 2
+	}
 	if err = binary.Read(r, binary.BigEndian, &s.PrimaryKey); err != nil {
 		return
 	}
@@ -94,8 +218,11 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
 	}
-	n = sn + 4 + 4 + 4 + 2
+	n += sn + 4 + 4 + 2 + 2
 	colBuf := make([]byte, encoding.TypeSize)
+	if s.NameIndex == nil {
+		s.NameIndex = make(map[string]int)
+	}
 	for i := uint16(0); i < colCnt; i++ {
 		if _, err = r.Read(colBuf); err != nil {
 			return
@@ -107,14 +234,87 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if version == 0 {
+			// the data of the legacy schemas is compressed with lz4
+			colDef.Alg = compress.Lz4
+		} else {
+			if err = binary.Read(r, binary.BigEndian, &colDef.Alg); err != nil {
+				return
+			}
+			n += 1
+			if err = binary.Read(r, binary.BigEndian, &colDef.NullAbility); err != nil {
+				return
+			}
+			n += 1
+			if sn, err = colDef.readDefault(r); err != nil {
+				return
+			}
+			n += sn
+		}
 		s.ColDefs = append(s.ColDefs, colDef)
 		colDef.Idx = int(i)
+		s.NameIndex[colDef.Name] = colDef.Idx
+	}
+	if version == 0 {
+		return
+	}
+	keyCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &keyCnt); err != nil {
+		return
+	}
+	n += 2
+	if keyCnt > 0 {
+		s.CompositeKey = make([]int32, keyCnt)
+		if err = binary.Read(r, binary.BigEndian, s.CompositeKey); err != nil {
+			return
+		}
+		n += 4 * int64(keyCnt)
+	}
+	if version < schemaVersion2 {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.ImplicitKey); err != nil {
+		return
+	}
+	n += 1
+	if version < schemaVersion3 {
+		return
+	}
+	idxCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &idxCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < idxCnt; i++ {
+		index := new(IndexInfo)
+		if err = binary.Read(r, binary.BigEndian, &index.Type); err != nil {
+			return
+		}
+		if index.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		idxColCnt := uint16(0)
+		if err = binary.Read(r, binary.BigEndian, &idxColCnt); err != nil {
+			return
+		}
+		index.Columns = make([]uint16, idxColCnt)
+		if err = binary.Read(r, binary.BigEndian, index.Columns); err != nil {
+			return
+		}
+		n += 2 + sn + 2 + 2*int64(idxColCnt)
+		s.Indexes = append(s.Indexes, index)
 	}
 	return
 }
 
 func (s *Schema) Marshal() (buf []byte, err error) {
 	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, schemaMagic); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, schemaVersion); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxRows); err != nil {
 		return
 	}
@@ -137,16 +337,60 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = common.WriteString(colDef.Name, &w); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.Alg); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.NullAbility); err != nil {
+			return
+		}
+		if err = colDef.writeDefault(&w); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.CompositeKey))); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.CompositeKey); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.ImplicitKey); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.Indexes))); err != nil {
+		return
+	}
+	for _, index := range s.Indexes {
+		if err = binary.Write(&w, binary.BigEndian, index.Type); err != nil {
+			return
+		}
+		if _, err = common.WriteString(index.Name, &w); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint16(len(index.Columns))); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, index.Columns); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
 }
 
 func (s *Schema) AppendCol(name string, typ types.Type) {
+	s.AppendColWithDefault(name, typ, false, Default{})
+}
+
+// AppendColWithDefault appends the column which may be nullable or have the
+// default value filled for the rows appended without it
+func (s *Schema) AppendColWithDefault(name string, typ types.Type, nullable bool, def Default) {
 	colDef := &ColDef{
-		Name: name,
-		Type: typ,
-		Idx:  len(s.ColDefs),
+		Name:        name,
+		Type:        typ,
+		Idx:         len(s.ColDefs),
+		Alg:         compress.Lz4,
+		NullAbility: nullable,
+		Default:     def,
 	}
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
@@ -157,10 +401,113 @@ func (s *Schema) String() string {
 	return string(buf)
 }
 
+// SetCompositeKey makes the columns the composite primary key. The key of a
+// row is the packed values of the columns and the indexes of the key are
+// attached to the first column.
+func (s *Schema) SetCompositeKey(idxes ...int) {
+	s.CompositeKey = make([]int32, len(idxes))
+	for i, idx := range idxes {
+		s.CompositeKey[i] = int32(idx)
+	}
+	s.PrimaryKey = s.CompositeKey[0]
+}
+
+func (s *Schema) IsCompositeKey() bool {
+	return len(s.CompositeKey) > 1
+}
+
+// GetPKIdxes returns the indexes of the columns of the primary key
+func (s *Schema) GetPKIdxes() []int {
+	if !s.IsCompositeKey() {
+		return []int{int(s.PrimaryKey)}
+	}
+	idxes := make([]int, len(s.CompositeKey))
+	for i, idx := range s.CompositeKey {
+		idxes[i] = int(idx)
+	}
+	return idxes
+}
+
+// GetPKType returns the type of the key, it is varchar for the composite
+// primary key
 func (s *Schema) GetPKType() types.Type {
+	if s.IsCompositeKey() {
+		return types.T_varchar.ToType()
+	}
 	return s.ColDefs[s.PrimaryKey].Type
 }
 
+// GetPKVector returns the keys of the rows of the columns
+func (s *Schema) GetPKVector(vecs []*gvec.Vector) *gvec.Vector {
+	if !s.IsCompositeKey() {
+		return vecs[s.PrimaryKey]
+	}
+	keys := make([]*gvec.Vector, len(s.CompositeKey))
+	for i, idx := range s.CompositeKey {
+		keys[i] = vecs[idx]
+	}
+	return compute.EncodeKeys(keys)
+}
+
+// GetPKValue returns the key of the row whose column values are returned by get
+func (s *Schema) GetPKValue(get func(col int) (interface{}, error)) (interface{}, error) {
+	if !s.IsCompositeKey() {
+		return get(int(s.PrimaryKey))
+	}
+	vals := make([]interface{}, len(s.CompositeKey))
+	for i, idx := range s.CompositeKey {
+		v, err := get(int(idx))
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	return compute.EncodeKey(vals...), nil
+}
+
+// FillDefaults returns the vectors of all the columns in the order of the
+// schema from the vectors of the attrs, the omitted columns are filled with
+// their default values.
+func (s *Schema) FillDefaults(attrs []string, vecs []*gvec.Vector) ([]*gvec.Vector, error) {
+	filled := make([]*gvec.Vector, len(s.ColDefs))
+	for i, attr := range attrs {
+		idx := s.GetColIdx(attr)
+		if idx < 0 {
+			return nil, ErrNotFound
+		}
+		filled[idx] = vecs[i]
+	}
+	rows := 0
+	if len(vecs) > 0 {
+		rows = gvec.Length(vecs[0])
+	}
+	for i, colDef := range s.ColDefs {
+		if filled[i] != nil {
+			continue
+		}
+		if !colDef.Default.Exist {
+			return nil, ErrNoDefault
+		}
+		vec := gvec.New(colDef.Type)
+		for row := 0; row < rows; row++ {
+			compute.AppendValue(vec, colDef.Default.Value)
+		}
+		filled[i] = vec
+	}
+	return filled, nil
+}
+
+// CheckNulls returns ErrNotNullable if there is any null in the columns which
+// are not nullable
+func (s *Schema) CheckNulls(vecs []*gvec.Vector) error {
+	for i, colDef := range s.ColDefs {
+		if !colDef.NullAbility && vecs[i].Nsp != nil && nulls.Any(vecs[i].Nsp) {
+			return ErrNotNullable
+		}
+	}
+	return nil
+}
+
 func (s *Schema) GetPKColumnDef() *ColDef {
 	return s.ColDefs[s.PrimaryKey]
 }
@@ -199,10 +546,120 @@ func (s *Schema) Valid() bool {
 			return false
 		}
 		names[colDef.Name] = true
+		if !colDef.validDefault() {
+			return false
+		}
+	}
+	if len(s.CompositeKey) > 0 {
+		if s.CompositeKey[0] != s.PrimaryKey {
+			return false
+		}
+		keys := make(map[int32]bool)
+		for _, idx := range s.CompositeKey {
+			if idx < 0 || int(idx) >= len(s.ColDefs) || keys[idx] {
+				return false
+			}
+			keys[idx] = true
+		}
+	}
+	if s.PrimaryKey < 0 || int(s.PrimaryKey) >= len(s.ColDefs) {
+		return false
+	}
+	if !s.validIndexes() {
+		return false
+	}
+	if s.ImplicitKey {
+		return !s.IsCompositeKey()
+	}
+	for _, idx := range s.GetPKIdxes() {
+		if s.ColDefs[idx].NullAbility {
+			return false
+		}
 	}
 	return true
 }
 
+// validIndexes checks the indexes are FULLTEXT indexes on char or varchar
+// columns
+func (s *Schema) validIndexes() bool {
+	for _, index := range s.Indexes {
+		if index.Type != FullText || len(index.Columns) == 0 {
+			return false
+		}
+		for _, col := range index.Columns {
+			if int(col) >= len(s.ColDefs) {
+				return false
+			}
+			switch s.ColDefs[col].Type.Oid {
+			case types.T_char, types.T_varchar:
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IndexFileCnt returns the number of the index files of each column of a
+// block. The zonemap and the static filter of the primary key are in the
+// files of the primary key column, a FULLTEXT index is in a file of its first
+// column and the zonemap of the other columns is in their last file.
+func (s *Schema) IndexFileCnt() map[int]int {
+	cnt := make(map[int]int)
+	cnt[int(s.PrimaryKey)] = 2
+	for _, index := range s.Indexes {
+		cnt[int(index.Columns[0])]++
+	}
+	for col := range s.ColDefs {
+		if _, ok := s.ZoneMapFile(col); ok {
+			cnt[col]++
+		}
+	}
+	return cnt
+}
+
+// ZoneMapFile returns the internal index of the file of the zonemap of the
+// column, it is false if the column has no zonemap of its own. The zonemap
+// of the primary key is not counted, the columns of a composite primary key
+// have their own zonemaps.
+func (s *Schema) ZoneMapFile(col int) (internal int, ok bool) {
+	if col == int(s.PrimaryKey) && !s.IsCompositeKey() {
+		return
+	}
+	switch s.ColDefs[col].Type.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+		types.T_char, types.T_varchar:
+	default:
+		return
+	}
+	if col == int(s.PrimaryKey) {
+		internal = 2
+	}
+	for _, index := range s.Indexes {
+		if int(index.Columns[0]) == col {
+			internal++
+		}
+	}
+	return internal, true
+}
+
+// IndexFile returns the column and the internal index of the file of the
+// i-th index of Indexes
+func (s *Schema) IndexFile(i int) (col int, internal int) {
+	col = int(s.Indexes[i].Columns[0])
+	if col == int(s.PrimaryKey) {
+		internal = 2
+	}
+	for _, index := range s.Indexes[:i] {
+		if int(index.Columns[0]) == col {
+			internal++
+		}
+	}
+	return
+}
+
 // GetColIdx returns column index for the given column name
 // if found, otherwise returns -1.
 func (s *Schema) GetColIdx(attr string) int {
@@ -0,0 +1,107 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// StatsEntry is the txn entry replacing the statistics of a table, the
+// statistics are visible to all the txns once the txn is committed.
+type StatsEntry struct {
+	*sync.RWMutex
+	table *TableEntry
+	txn   txnif.AsyncTxn
+	stats []byte
+}
+
+// GetStats returns the statistics of the table, it is nil if the table has
+// not been analyzed.
+func (entry *TableEntry) GetStats() []byte {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.stats
+}
+
+// UpdateStats returns the txn entry which replaces the statistics of the
+// table with stats when txn commits.
+func (entry *TableEntry) UpdateStats(txn txnif.AsyncTxn, stats []byte) *StatsEntry {
+	return &StatsEntry{
+		RWMutex: new(sync.RWMutex),
+		table:   entry,
+		txn:     txn,
+		stats:   stats,
+	}
+}
+
+// GetStats returns the statistics to be committed.
+func (entry *StatsEntry) GetStats() []byte {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.stats
+}
+
+// SetStats replaces the statistics to be committed.
+func (entry *StatsEntry) SetStats(stats []byte) {
+	entry.Lock()
+	defer entry.Unlock()
+	entry.stats = stats
+}
+
+func (entry *StatsEntry) PrepareCommit() error   { return nil }
+func (entry *StatsEntry) PrepareRollback() error { return nil }
+func (entry *StatsEntry) ApplyRollback() error   { return nil }
+
+func (entry *StatsEntry) ApplyCommit(index *wal.Index) error {
+	entry.table.Lock()
+	defer entry.table.Unlock()
+	entry.table.setStatsLocked(entry.stats, entry.txn.GetCommitTS(), index)
+	return nil
+}
+
+func (entry *StatsEntry) MakeCommand(id uint32) (txnif.TxnCmd, error) {
+	return newTableStatsCmd(id, entry.table, entry.stats, entry.txn.GetCommitTS()), nil
+}
+
+func (entry *TableEntry) setStatsLocked(stats []byte, ts uint64, index *wal.Index) {
+	if ts < entry.statsAt {
+		return
+	}
+	entry.stats = stats
+	entry.statsAt = ts
+	entry.statsIndex = index
+}
+
+// checkpointStats adds the statistics committed in [minTs, maxTs] to the
+// checkpoint, the table is logged again with them unless it was logged
+// by the checkpoint already.
+func (entry *TableEntry) checkpointStats(ckpEntry *CheckpointEntry, minTs, maxTs uint64, logged bool) {
+	entry.RLock()
+	if entry.statsIndex == nil || entry.statsAt < minTs || entry.statsAt > maxTs {
+		entry.RUnlock()
+		return
+	}
+	ckpEntry.AddIndex(entry.statsIndex)
+	if logged || entry.InTxnOrRollbacked() || entry.HasDropped() {
+		entry.RUnlock()
+		return
+	}
+	cloned := entry.Clone()
+	entry.RUnlock()
+	ckpEntry.AddCommand(cloned.MakeLogEntry())
+}
@@ -15,6 +15,7 @@
 package catalog
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"sync"
@@ -22,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 type TableDataFactory = func(meta *TableEntry) data.Table
@@ -33,6 +35,13 @@ type TableEntry struct {
 	entries   map[uint64]*common.DLNode
 	link      *common.Link
 	tableData data.Table
+
+	// stats is the statistics of the columns collected by analyze, it is
+	// encoded by the engine and opaque to the catalog. statsAt and statsIndex
+	// are the commit ts and the log index of the txn which updated it.
+	stats      []byte
+	statsAt    uint64
+	statsIndex *wal.Index
 }
 
 func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFactory TableDataFactory) *TableEntry {
@@ -257,8 +266,18 @@ func (entry *TableEntry) WriteTo(w io.Writer) (n int64, err error) {
 		return
 	}
 	sn := int(0)
-	sn, err = w.Write(buf)
+	if sn, err = w.Write(buf); err != nil {
+		return
+	}
 	n += int64(sn)
+	if err = binary.Write(w, binary.BigEndian, entry.statsAt); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint32(len(entry.stats))); err != nil {
+		return
+	}
+	sn, err = w.Write(entry.stats)
+	n += int64(sn) + 8 + 4
 	return
 }
 
@@ -270,8 +289,26 @@ func (entry *TableEntry) ReadFrom(r io.Reader) (n int64, err error) {
 		entry.schema = NewEmptySchema("")
 	}
 	sn := int64(0)
-	sn, err = entry.schema.ReadFrom(r)
+	if sn, err = entry.schema.ReadFrom(r); err != nil {
+		return
+	}
 	n += sn
+	if err = binary.Read(r, binary.BigEndian, &entry.statsAt); err != nil {
+		return
+	}
+	length := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+		return
+	}
+	n += 8 + 4
+	if length == 0 {
+		return
+	}
+	entry.stats = make([]byte, length)
+	if _, err = io.ReadFull(r, entry.stats); err != nil {
+		return
+	}
+	n += int64(length)
 	return
 }
 
@@ -284,6 +321,8 @@ func (entry *TableEntry) Clone() CheckpointItem {
 		BaseEntry: entry.BaseEntry.Clone(),
 		schema:    entry.schema,
 		db:        entry.db,
+		stats:     entry.stats,
+		statsAt:   entry.statsAt,
 	}
 	return cloned
 }
@@ -293,6 +332,8 @@ func (entry *TableEntry) CloneCreate() CheckpointItem {
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		schema:    entry.schema,
 		db:        entry.db,
+		stats:     entry.stats,
+		statsAt:   entry.statsAt,
 	}
 	return cloned
 }
@@ -117,7 +117,7 @@ func CompareGeneric(a, b interface{}, t types.Type) int {
 		} else {
 			return 0
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
 		res := bytes.Compare(a.([]byte), b.([]byte))
 		if res > 0 {
 			return 1
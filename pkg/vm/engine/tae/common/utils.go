@@ -59,7 +59,7 @@ func DecodeKey(key []byte, typ types.Type) interface{} {
 		return encoding.DecodeDate(key)
 	case types.T_datetime:
 		return encoding.DecodeDatetime(key)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
 		return key
 	default:
 		panic("unsupported type")
@@ -140,7 +140,7 @@ func EncodeKey(key interface{}, typ types.Type) ([]byte, error) {
 		} else {
 			panic("unsupported type")
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
 		if v, ok := key.([]byte); ok {
 			return v, nil
 		} else {
@@ -351,11 +351,11 @@ func ProcessVector(vec *vector.Vector, offset uint32, length int, task func(v in
 				}
 			}
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
 		vs := vec.Col.(*types.Bytes)
 		if visibility == nil {
 			for i := range vs.Offsets[offset:] {
-				v := vs.Get(int64(i))
+				v := vs.Get(int64(i) + int64(offset))
 				if err := task(v); err != nil {
 					return err
 				}
@@ -16,6 +16,7 @@ package compute
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math"
 
 	"github.com/RoaringBitmap/roaring"
@@ -24,13 +25,23 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/orderedcodec"
 )
 
+// AppendValue appends the value to the vector, a nil value is appended as null
 func AppendValue(vec *gvec.Vector, v interface{}) {
+	if v == nil {
+		if vec.Nsp == nil {
+			vec.Nsp = &nulls.Nulls{}
+		}
+		nulls.Add(vec.Nsp, uint64(gvec.Length(vec)))
+		v = ZeroValue(vec.Typ)
+	}
 	switch vec.Typ.Oid {
 	case types.T_int8:
 		vvals := vec.Col.([]int8)
@@ -73,6 +84,9 @@ func AppendValue(vec *gvec.Vector, v interface{}) {
 		vec.Col = append(vvals, v.(types.Datetime))
 	case types.T_char, types.T_varchar, types.T_json:
 		vvals := vec.Col.(*types.Bytes)
+		if str, ok := v.(string); ok {
+			v = []byte(str)
+		}
 		offset := len(vvals.Data)
 		length := len(v.([]byte))
 		vvals.Data = append(vvals.Data, v.([]byte)...)
@@ -83,7 +97,48 @@ func AppendValue(vec *gvec.Vector, v interface{}) {
 	}
 }
 
+// ZeroValue returns the value stored in the vector for a null of the type, it
+// is nil if the type is not supported
+func ZeroValue(typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		return int8(0)
+	case types.T_int16:
+		return int16(0)
+	case types.T_int32:
+		return int32(0)
+	case types.T_int64:
+		return int64(0)
+	case types.T_uint8:
+		return uint8(0)
+	case types.T_uint16:
+		return uint16(0)
+	case types.T_uint32:
+		return uint32(0)
+	case types.T_uint64:
+		return uint64(0)
+	case types.T_decimal64:
+		return types.Decimal64(0)
+	case types.T_float32:
+		return float32(0)
+	case types.T_float64:
+		return float64(0)
+	case types.T_date:
+		return types.Date(0)
+	case types.T_datetime:
+		return types.Datetime(0)
+	case types.T_char, types.T_varchar, types.T_json:
+		return []byte{}
+	default:
+		return nil
+	}
+}
+
+// GetValue returns the value of the row, it is nil if the row is null
 func GetValue(col *gvec.Vector, row uint32) interface{} {
+	if col.Nsp != nil && nulls.Contains(col.Nsp, uint64(row)) {
+		return nil
+	}
 	vals := col.Col
 	switch col.Typ.Oid {
 	case types.T_int8:
@@ -350,6 +405,36 @@ func CopyToIBatch(data *gbat.Batch, capacity uint64) (bat batch.IBatch, err erro
 	return
 }
 
+// NullRows returns the rows of the nulls of the vector, it is nil if the
+// vector has no nulls.
+func NullRows(vec *gvec.Vector) *roaring.Bitmap {
+	if vec.Nsp == nil || !nulls.Any(vec.Nsp) {
+		return nil
+	}
+	rows := roaring.NewBitmap()
+	it := vec.Nsp.Np.Iterator()
+	for it.HasNext() {
+		rows.Add(uint32(it.Next()))
+	}
+	return rows
+}
+
+// NonNullValues returns a copy of the vector without its nulls, it is the
+// vector itself if the vector has no nulls.
+func NonNullValues(vec *gvec.Vector) *gvec.Vector {
+	nullRows := NullRows(vec)
+	if nullRows == nil {
+		return vec
+	}
+	values := gvec.New(vec.Typ)
+	for row := 0; row < gvec.Length(vec); row++ {
+		if !nullRows.Contains(uint32(row)) {
+			AppendValue(values, GetValue(vec, uint32(row)))
+		}
+	}
+	return values
+}
+
 func ApplyDeleteToVector(vec *gvec.Vector, deletes *roaring.Bitmap) *gvec.Vector {
 	if deletes == nil || deletes.GetCardinality() == 0 {
 		return vec
@@ -455,6 +540,11 @@ func ApplyUpdateToVector(vec *gvec.Vector, mask *roaring.Bitmap, vals map[uint32
 	if mask == nil || mask.GetCardinality() == 0 {
 		return vec
 	}
+	// the column data is updated in place, so the dictionary is stale
+	vec.Dict = nil
+	if vec.Nsp == nil {
+		vec.Nsp = &nulls.Nulls{}
+	}
 	iterator := mask.Iterator()
 	col := vec.Col
 	switch vec.Typ.Oid {
@@ -462,12 +552,10 @@ func ApplyUpdateToVector(vec *gvec.Vector, mask *roaring.Bitmap, vals map[uint32
 		types.T_decimal64, types.T_decimal128, types.T_float32, types.T_float64, types.T_date, types.T_datetime:
 		for iterator.HasNext() {
 			row := iterator.Next()
-			SetFixSizeTypeValue(vec, row, vals[row])
-			if vec.Nsp != nil && vec.Nsp.Np != nil {
-				if vec.Nsp.Np.Contains(uint64(row)) {
-					vec.Nsp.Np.Flip(uint64(row), uint64(row+1))
-				}
+			if vals[row] != nil {
+				SetFixSizeTypeValue(vec, row, vals[row])
 			}
+			setNull(vec.Nsp, row, vals[row] == nil)
 		}
 	case types.T_char, types.T_varchar, types.T_json:
 		data := col.(*types.Bytes)
@@ -477,17 +565,13 @@ func ApplyUpdateToVector(vec *gvec.Vector, mask *roaring.Bitmap, vals map[uint32
 			if pre != -1 {
 				UpdateOffsets(data, pre, int(row))
 			}
-			val := vals[row].([]byte)
+			val, _ := vals[row].([]byte)
 			suffix := data.Data[data.Offsets[row]+data.Lengths[row]:]
 			data.Lengths[row] = uint32(len(val))
 			val = append(val, suffix...)
 			data.Data = append(data.Data[:data.Offsets[row]], val...)
 			pre = int(row)
-			if vec.Nsp != nil && vec.Nsp.Np != nil {
-				if vec.Nsp.Np.Contains(uint64(row)) {
-					vec.Nsp.Np.Flip(uint64(row), uint64(row+1))
-				}
-			}
+			setNull(vec.Nsp, row, vals[row] == nil)
 		}
 		if pre != -1 {
 			UpdateOffsets(data, pre, len(data.Lengths)-1)
@@ -509,15 +593,14 @@ func ApplyUpdateToIVector(vec vector.IVector, mask *roaring.Bitmap, vals map[uin
 		}
 		for updateIterator.HasNext() {
 			rowIdx := updateIterator.Next()
+			if vals[rowIdx] == nil {
+				setNull(vec.(*vector.StdVector).VMask, rowIdx, true)
+				continue
+			}
 			err := vec.SetValue(int(rowIdx), vals[rowIdx])
 			if err != nil {
 				panic(err)
 			}
-			if vec.(*vector.StdVector).VMask != nil &&
-				vec.(*vector.StdVector).VMask.Np != nil &&
-				vec.(*vector.StdVector).VMask.Np.Contains(uint64(rowIdx)) {
-				vec.(*vector.StdVector).VMask.Np.Flip(uint64(rowIdx), uint64(rowIdx))
-			}
 		}
 	case container.StrVec:
 		strVec := vec.(*vector.StrVector)
@@ -533,7 +616,7 @@ func ApplyUpdateToIVector(vec vector.IVector, mask *roaring.Bitmap, vals map[uin
 			strVec2.Data.Offsets = make([]uint32, len(data.Offsets))
 			for updateIterator.HasNext() {
 				row := updateIterator.Next()
-				val := vals[row].([]byte)
+				val, _ := vals[row].([]byte)
 
 				preOffset := int(data.Offsets[pre+1])
 				length := int(data.Offsets[row] - uint32(preOffset))
@@ -548,9 +631,7 @@ func ApplyUpdateToIVector(vec vector.IVector, mask *roaring.Bitmap, vals map[uin
 				copy(strVec2.Data.Data[pos2:pos2+len(val)], val)
 				pos2 += len(val)
 
-				if strVec.VMask != nil && strVec.VMask.Np != nil && strVec.VMask.Np.Contains(uint64(row)) {
-					strVec.VMask.Np.Flip(uint64(row), uint64(row))
-				}
+				setNull(strVec2.VMask, row, vals[row] == nil)
 
 				strVec2.Data.Lengths[row] = uint32(len(val))
 				if int(row) != len(data.Offsets)-1 {
@@ -584,15 +665,13 @@ func ApplyUpdateToIVector(vec vector.IVector, mask *roaring.Bitmap, vals map[uin
 				if pre != -1 {
 					UpdateOffsets(data, pre, int(row))
 				}
-				val := vals[row].([]byte)
+				val, _ := vals[row].([]byte)
 				suffix := data.Data[data.Offsets[row]+data.Lengths[row]:]
 				data.Lengths[row] = uint32(len(val))
 				val = append(val, suffix...)
 				data.Data = append(data.Data[:data.Offsets[row]], val...)
 				pre = int(row)
-				if strVec.VMask != nil && strVec.VMask.Np != nil && strVec.VMask.Np.Contains(uint64(row)) {
-					strVec.VMask.Np.Flip(uint64(row), uint64(row))
-				}
+				setNull(strVec.VMask, row, vals[row] == nil)
 			}
 			if pre != -1 {
 				UpdateOffsets(data, pre, len(data.Offsets)-1)
@@ -604,6 +683,15 @@ func ApplyUpdateToIVector(vec vector.IVector, mask *roaring.Bitmap, vals map[uin
 	return vec
 }
 
+// setNull marks the row as null or not null
+func setNull(nsp *nulls.Nulls, row uint32, isNull bool) {
+	if isNull {
+		nulls.Add(nsp, uint64(row))
+	} else {
+		nulls.Del(nsp, uint64(row))
+	}
+}
+
 type deleteRange struct {
 	pos     uint32
 	deleted uint32
@@ -943,3 +1031,39 @@ func CheckRowExists(data *gvec.Vector, v interface{}, deletes *roaring.Bitmap) (
 		panic("unsupported type")
 	}
 }
+
+// EncodeKey packs the values into the key of the composite primary key. The
+// encoding is order-preserving, so the packed keys are sorted in the same
+// order as the tuples of the values. The decimals of a column share the same
+// scale, so they are packed as their integer representations.
+func EncodeKey(vals ...interface{}) []byte {
+	encoder := orderedcodec.NewOrderedEncoder()
+	var key []byte
+	for _, v := range vals {
+		switch dv := v.(type) {
+		case types.Decimal64:
+			key, _ = encoder.EncodeInt64(key, int64(dv))
+		case types.Decimal128:
+			buf := encoding.EncodeDecimal128(dv)
+			key, _ = encoder.EncodeInt64(key, int64(binary.LittleEndian.Uint64(buf[8:])))
+			key, _ = encoder.EncodeUint64(key, binary.LittleEndian.Uint64(buf[:8]))
+		default:
+			key, _ = encoder.EncodeKey(key, v)
+		}
+	}
+	return key
+}
+
+// EncodeKeys packs the rows of the vectors into a varchar vector of the keys
+func EncodeKeys(vecs []*gvec.Vector) *gvec.Vector {
+	keys := gvec.New(types.T_varchar.ToType())
+	rows := gvec.Length(vecs[0])
+	vals := make([]interface{}, len(vecs))
+	for row := 0; row < rows; row++ {
+		for i, vec := range vecs {
+			vals[i] = GetValue(vec, uint32(row))
+		}
+		AppendValue(keys, EncodeKey(vals...))
+	}
+	return keys
+}
@@ -15,6 +15,7 @@
 package compute
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
@@ -133,3 +134,20 @@ func TestCheckRowExists(t *testing.T) {
 	_, exist = CheckRowExists(vec, int32(55), dels)
 	require.False(t, exist)
 }
+
+func TestEncodeDecimalKey(t *testing.T) {
+	d64s := []types.Decimal64{-300, -1, 0, 2, 400}
+	for i := 1; i < len(d64s); i++ {
+		assert.Equal(t, -1, bytes.Compare(EncodeKey(d64s[i-1], int32(1)), EncodeKey(d64s[i], int32(0))))
+	}
+	d128s := []types.Decimal128{
+		types.InitDecimal128(-1 << 40),
+		types.InitDecimal128(-1),
+		types.InitDecimal128(0),
+		types.InitDecimal128UsingUint(1 << 63),
+		types.ScaleDecimal128By10(types.InitDecimal128UsingUint(1 << 63)),
+	}
+	for i := 1; i < len(d128s); i++ {
+		assert.Equal(t, -1, bytes.Compare(EncodeKey(d128s[i-1], []byte("b")), EncodeKey(d128s[i], []byte("a"))))
+	}
+}
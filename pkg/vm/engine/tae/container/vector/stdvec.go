@@ -133,8 +133,8 @@ func (v *StdVector) SetValue(idx int, val interface{}) error {
 	v.Lock()
 	defer v.Unlock()
 
-	if v.VMask != nil && v.VMask.Np != nil && v.VMask.Np.Contains(uint64(idx)) {
-		v.VMask.Np.Flip(uint64(idx), uint64(idx))
+	if v.VMask != nil {
+		nulls.Del(v.VMask, uint64(idx))
 	}
 
 	start := idx * int(v.Type.Size)
@@ -200,11 +200,15 @@ func (v *StdVector) GetValue(idx int) (interface{}, error) {
 	if !v.IsReadonly() {
 		v.RLock()
 	}
+	isNull := v.VMask != nil && nulls.Contains(v.VMask, uint64(idx))
 	start := idx * int(v.Type.Size)
 	data := v.Data[start : start+int(v.Type.Size)]
 	if !v.IsReadonly() {
 		v.RUnlock()
 	}
+	if isNull {
+		return nil, nil
+	}
 	switch v.Type.Oid {
 	case types.T_int8:
 		return encoding.DecodeInt8(data), nil
@@ -317,7 +321,7 @@ func (v *StdVector) AppendVector(vec *gvec.Vector, offset int) (n int, err error
 		return n, err
 	}
 	if vec.Nsp.Np != nil {
-		for row := startRow; row < startRow+gvec.Length(vec); row++ {
+		for row := startRow; row < startRow+n; row++ {
 			if nulls.Contains(vec.Nsp, uint64(offset+row-startRow)) {
 				nulls.Add(v.VMask, uint64(row))
 			}
@@ -439,6 +443,8 @@ func (v *StdVector) Window(start, end uint32) IVector {
 				np = common.BitMap64Window(v.VMask.Np, int(start), int(end))
 			}
 			vec.VMask = &nulls.Nulls{Np: np}
+		} else {
+			vec.VMask = nulls.Range(v.VMask, uint64(start), uint64(end), &nulls.Nulls{})
 		}
 	} else {
 		vec.VMask = &nulls.Nulls{}
@@ -151,10 +151,14 @@ func (v *StrVector) GetValue(idx int) (interface{}, error) {
 	if !v.IsReadonly() {
 		v.RLock()
 	}
+	isNull := v.VMask != nil && nulls.Contains(v.VMask, uint64(idx))
 	data := v.Data.Get(int64(idx))
 	if !v.IsReadonly() {
 		v.RUnlock()
 	}
+	if isNull {
+		return nil, nil
+	}
 	return data, nil
 }
 
@@ -221,7 +225,7 @@ func (v *StrVector) AppendVector(vec *gvec.Vector, offset int) (n int, err error
 		return n, err
 	}
 	if vec.Nsp.Np != nil {
-		for row := startRow; row < startRow+gvec.Length(vec); row++ {
+		for row := startRow; row < startRow+n; row++ {
 			if nulls.Contains(vec.Nsp, uint64(offset+row-startRow)) {
 				nulls.Add(v.VMask, uint64(row))
 			}
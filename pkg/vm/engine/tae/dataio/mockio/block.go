@@ -18,6 +18,7 @@ import (
 	"bytes"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/colenc"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -101,6 +102,11 @@ func (bf *blockFile) ReadDeletes(buf []byte) (err error) {
 	return
 }
 
+func (bf *blockFile) OpenDeletesFile() common.IRWFile {
+	bf.deletes.Ref()
+	return bf.deletes
+}
+
 func (bf *blockFile) WriteIndexMeta(buf []byte) (err error) {
 	_, err = bf.indexMeta.Write(buf)
 	return
@@ -155,15 +161,19 @@ func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch
 			return
 		}
 		defer f.Unref()
-		size := f.Stat().Size()
-		buf := make([]byte, size)
-		if _, err = f.Read(buf); err != nil {
+		var buf []byte
+		if buf, err = readDecompressed(f); err != nil {
 			return
 		}
 		vec := vector.NewVector(colTypes[i], uint64(maxRow))
 		if err = vec.Unmarshal(buf); err != nil {
 			return
 		}
+		// The flushed vector was a readonly view, the rows appended after
+		// the load go to the same vector
+		if vec.Length() < int(maxRow) {
+			vec.ResetReadonly()
+		}
 		vecs[i] = vec
 		attrs[i] = i
 	}
@@ -179,13 +189,12 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 			return
 		}
 		defer f.Unref()
-		size := f.Stat().Size()
-		buf := make([]byte, size)
-		if _, err = f.Read(buf); err != nil {
+		var buf []byte
+		if buf, err = readDecompressed(f); err != nil {
 			return
 		}
 		vec := gvec.New(colTypes[i])
-		if err = vec.Read(buf); err != nil {
+		if err = colenc.Read(vec, buf); err != nil {
 			return
 		}
 		bat.Vecs[i] = vec
@@ -200,7 +209,7 @@ func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (er
 	}
 	defer cb.Close()
 	cb.WriteTS(ts)
-	buf, err := vec.Show()
+	buf, err := colenc.Encode(vec)
 	if err != nil {
 		return err
 	}
@@ -234,6 +243,9 @@ func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*
 	if err = bf.WriteTS(ts); err != nil {
 		return err
 	}
+	if err = bf.WriteRows(uint32(bat.Length())); err != nil {
+		return err
+	}
 	for _, colIdx := range attrs {
 		cb, err := bf.OpenColumn(colIdx)
 		if err != nil {
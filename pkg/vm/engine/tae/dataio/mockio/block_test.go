@@ -19,6 +19,10 @@ import (
 	"testing"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/stretchr/testify/assert"
@@ -71,3 +75,43 @@ func TestBlock1(t *testing.T) {
 
 	block.Unref()
 }
+
+func TestBlockCompress(t *testing.T) {
+	algs := []compress.T{compress.Lz4, compress.Zstd, compress.Snappy, compress.None}
+	block := newBlock(common.NextGlobalSeqNum(), nil, len(algs), nil)
+	vs := make([]int64, 1000)
+	for i := range vs {
+		vs[i] = int64(i % 10)
+	}
+	bat := gbat.New(true, []string{"a", "b", "c", "d"})
+	for i, alg := range algs {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		colBlk.SetCompressAlgo(alg)
+		colBlk.Close()
+		bat.Vecs[i] = gvec.New(types.Type{Oid: types.T_int64, Size: 8})
+		assert.Nil(t, gvec.Append(bat.Vecs[i], vs))
+	}
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	for i, alg := range algs {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		stat := colBlk.GetDataFileStat()
+		assert.Equal(t, int(alg), stat.CompressAlgo())
+		if alg != compress.None {
+			assert.Less(t, stat.Size(), stat.OriginSize())
+		}
+		colBlk.Close()
+	}
+	typs := make([]types.Type, len(algs))
+	for i := range typs {
+		typs[i] = bat.Vecs[i].Typ
+	}
+	loaded, err := block.LoadBatch(bat.Attrs, typs)
+	assert.Nil(t, err)
+	for i := range algs {
+		assert.Equal(t, vs, loaded.Vecs[i].Col.([]int64))
+	}
+	block.Unref()
+}
@@ -15,6 +15,7 @@
 package mockio
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -27,6 +28,7 @@ type columnBlock struct {
 	indexes []*indexFile
 	updates *updatesFile
 	data    *dataFile
+	alg     compress.T
 }
 
 func newColumnBlock(block *blockFile, indexCnt int) *columnBlock {
@@ -49,8 +51,12 @@ func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	return
 }
 
+func (cb *columnBlock) SetCompressAlgo(alg compress.T) {
+	cb.alg = alg
+}
+
 func (cb *columnBlock) WriteData(buf []byte) (err error) {
-	_, err = cb.data.Write(buf)
+	_, err = cb.data.writeCompressed(buf, cb.alg)
 	return
 }
 
@@ -107,7 +113,7 @@ func (cb *columnBlock) OpenIndexFile(idx int) (vfile common.IRWFile, err error)
 
 func (cb *columnBlock) OpenUpdateFile() (vfile common.IRWFile, err error) {
 	cb.updates.Ref()
-	vfile = cb.data
+	vfile = cb.updates
 	return
 }
 
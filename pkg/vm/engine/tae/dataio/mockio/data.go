@@ -15,6 +15,7 @@
 package mockio
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 )
 
@@ -70,6 +71,45 @@ func (df *dataFile) Write(buf []byte) (n int, err error) {
 	df.buf = make([]byte, len(buf))
 	copy(df.buf, buf)
 	df.stat.size = int64(len(df.buf))
+	df.stat.osize = df.stat.size
+	df.stat.algo = compress.None
+	return
+}
+
+// writeCompressed writes buf compressed with alg, the data is written
+// uncompressed if it can not be compressed by alg.
+func (df *dataFile) writeCompressed(buf []byte, alg compress.T) (n int, err error) {
+	data, algo := buf, uint8(compress.None)
+	if alg != compress.None {
+		cbuf := make([]byte, compress.CompressBound(len(buf), int(alg)))
+		if cbuf, err = compress.Compress(buf, cbuf, int(alg)); err != nil {
+			return
+		}
+		if len(cbuf) > 0 && len(cbuf) < len(buf) {
+			data, algo = cbuf, uint8(alg)
+		}
+	}
+	if _, err = df.Write(data); err != nil {
+		return
+	}
+	df.stat.osize = int64(len(buf))
+	df.stat.algo = algo
+	n = len(buf)
+	return
+}
+
+// readDecompressed returns the decompressed data of the file.
+func readDecompressed(f common.IRWFile) (buf []byte, err error) {
+	stat := f.Stat()
+	data := make([]byte, stat.Size())
+	if _, err = f.Read(data); err != nil {
+		return
+	}
+	if stat.CompressAlgo() == compress.None {
+		return data, nil
+	}
+	buf = make([]byte, stat.OriginSize())
+	_, err = compress.Decompress(data, buf, stat.CompressAlgo())
 	return
 }
 
@@ -15,6 +15,7 @@
 package mockio
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -22,6 +23,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 )
 
+var ErrNotPersisted = errors.New("tae: mock segment file not persisted")
+
 var SegmentFileMockFactory = func(name string, id uint64) file.Segment {
 	return newSegmentFile(name, id)
 }
@@ -79,6 +82,11 @@ func (sf *segmentFile) ReadTS() uint64 {
 	return sf.ts
 }
 
+// Snapshot is not supported as the mock segment is not persisted.
+func (sf *segmentFile) Snapshot() (int64, error) {
+	return 0, ErrNotPersisted
+}
+
 func (sf *segmentFile) String() string {
 	s := fmt.Sprintf("SegmentFile[%d][\"%s\"][TS=%d][BCnt=%d]", sf.id, sf.name, sf.ts, len(sf.blocks))
 	return s
@@ -15,10 +15,12 @@
 package mockio
 
 type fileStat struct {
-	size int64
+	size  int64
+	osize int64
+	algo  uint8
 }
 
 func (stat *fileStat) Name() string      { return "" }
 func (stat *fileStat) Size() int64       { return stat.size }
-func (stat *fileStat) OriginSize() int64 { return stat.size }
-func (stat *fileStat) CompressAlgo() int { return 0 }
+func (stat *fileStat) OriginSize() int64 { return stat.osize }
+func (stat *fileStat) CompressAlgo() int { return int(stat.algo) }
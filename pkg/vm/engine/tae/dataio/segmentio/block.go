@@ -0,0 +1,305 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/colenc"
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+)
+
+type blockFile struct {
+	common.RefHelper
+	seg       *segmentFile
+	meta      *blockMeta
+	columns   []*columnBlock
+	deletes   *dataFile
+	indexMeta *dataFile
+}
+
+// newBlock opens the block of the segment file, meta is the synced meta of
+// the block or nil if the block is new.
+func newBlock(id uint64, seg *segmentFile, colCnt int, indexCnt map[int]int, meta *blockMeta) *blockFile {
+	if meta == nil {
+		meta = &blockMeta{id: id}
+	}
+	for len(meta.columns) < colCnt {
+		meta.columns = append(meta.columns, new(columnMeta))
+	}
+	for i := range meta.columns {
+		for len(meta.columns[i].indexes) < indexCnt[i] {
+			meta.columns[i].indexes = append(meta.columns[i].indexes, extent{})
+		}
+	}
+	bf := &blockFile{
+		seg:     seg,
+		meta:    meta,
+		columns: make([]*columnBlock, len(meta.columns)),
+	}
+	name := fmt.Sprintf("%d", id)
+	bf.deletes = newData(seg, bf, &meta.deletes, name+".del")
+	bf.indexMeta = newData(seg, bf, &meta.indexMeta, name+".idxmeta")
+	bf.OnZeroCB = bf.close
+	for i := range bf.columns {
+		bf.columns[i] = newColumnBlock(bf, meta.columns[i], i)
+	}
+	bf.Ref()
+	return bf
+}
+
+func (bf *blockFile) Fingerprint() *common.ID {
+	return &common.ID{
+		SegmentID: bf.seg.id.SegmentID,
+		BlockID:   bf.meta.id,
+	}
+}
+
+func (bf *blockFile) close() {
+	bf.Close()
+	bf.Destory()
+}
+
+func (bf *blockFile) WriteRows(rows uint32) (err error) {
+	bf.seg.setMeta(func() { bf.meta.rows = rows })
+	return nil
+}
+
+func (bf *blockFile) ReadRows() (rows uint32) {
+	bf.seg.getMeta(func() { rows = bf.meta.rows })
+	return
+}
+
+func (bf *blockFile) WriteTS(ts uint64) (err error) {
+	bf.seg.setMeta(func() { bf.meta.ts = ts })
+	return
+}
+
+func (bf *blockFile) ReadTS() (ts uint64, err error) {
+	bf.seg.getMeta(func() { ts = bf.meta.ts })
+	return
+}
+
+func (bf *blockFile) WriteDeletes(buf []byte) (err error) {
+	_, err = bf.deletes.Write(buf)
+	return
+}
+
+func (bf *blockFile) ReadDeletes(buf []byte) (err error) {
+	_, err = bf.deletes.Read(buf)
+	return
+}
+
+func (bf *blockFile) OpenDeletesFile() common.IRWFile {
+	bf.deletes.Ref()
+	return bf.deletes
+}
+
+func (bf *blockFile) WriteIndexMeta(buf []byte) (err error) {
+	_, err = bf.indexMeta.Write(buf)
+	return
+}
+
+func (bf *blockFile) LoadIndexMeta() (*idxCommon.IndicesMeta, error) {
+	size := bf.indexMeta.Stat().Size()
+	buf := make([]byte, size)
+	_, err := bf.indexMeta.Read(buf)
+	if err != nil {
+		return nil, err
+	}
+	indices := idxCommon.NewEmptyIndicesMeta()
+	if err = indices.Unmarshal(buf); err != nil {
+		return nil, err
+	}
+	return indices, nil
+}
+
+func (bf *blockFile) OpenColumn(colIdx int) (colBlk file.ColumnBlock, err error) {
+	if colIdx >= len(bf.columns) {
+		err = file.ErrInvalidParam
+		return
+	}
+	bf.columns[colIdx].Ref()
+	colBlk = bf.columns[colIdx]
+	return
+}
+
+func (bf *blockFile) Close() error {
+	return nil
+}
+
+func (bf *blockFile) Destory() {
+	for _, cb := range bf.columns {
+		cb.Unref()
+	}
+	bf.columns = nil
+}
+
+// Sync writes the footer of the segment file if the meta of the segment
+// has changed, the written data of the block is durable after it.
+func (bf *blockFile) Sync() error { return bf.seg.sync() }
+
+func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch.IBatch, err error) {
+	attrs := make([]int, len(bf.columns))
+	vecs := make([]vector.IVector, len(attrs))
+	var f common.IRWFile
+	for i, colBlk := range bf.columns {
+		if f, err = colBlk.OpenDataFile(); err != nil {
+			return
+		}
+		defer f.Unref()
+		var buf []byte
+		if buf, err = readDecompressed(f); err != nil {
+			return
+		}
+		vec := vector.NewVector(colTypes[i], uint64(maxRow))
+		if err = vec.Unmarshal(buf); err != nil {
+			return
+		}
+		// The flushed vector was a readonly view, the rows appended after
+		// the load go to the same vector
+		if vec.Length() < int(maxRow) {
+			vec.ResetReadonly()
+		}
+		vecs[i] = vec
+		attrs[i] = i
+	}
+	bat, err = batch.NewBatch(attrs, vecs)
+	return
+}
+
+func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat.Batch, err error) {
+	bat = gbat.New(true, attrs)
+	var f common.IRWFile
+	for i, colBlk := range bf.columns {
+		if f, err = colBlk.OpenDataFile(); err != nil {
+			return
+		}
+		defer f.Unref()
+		var buf []byte
+		if buf, err = readDecompressed(f); err != nil {
+			return
+		}
+		vec := gvec.New(colTypes[i])
+		if err = colenc.Read(vec, buf); err != nil {
+			return
+		}
+		bat.Vecs[i] = vec
+	}
+	return
+}
+
+func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (err error) {
+	cb, err := bf.OpenColumn(colIdx)
+	if err != nil {
+		return err
+	}
+	defer cb.Close()
+	cb.WriteTS(ts)
+	buf, err := colenc.Encode(vec)
+	if err != nil {
+		return err
+	}
+	err = cb.WriteData(buf)
+	return
+}
+
+func (bf *blockFile) WriteBatch(bat *gbat.Batch, ts uint64) (err error) {
+	if err = bf.WriteTS(ts); err != nil {
+		return
+	}
+	if err = bf.WriteRows(uint32(gvec.Length(bat.Vecs[0]))); err != nil {
+		return
+	}
+	for colIdx := range bat.Attrs {
+		if err = bf.WriteColumnVec(ts, colIdx, bat.Vecs[colIdx]); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*roaring.Bitmap, vals map[uint16]map[uint32]interface{}, deletes *roaring.Bitmap) (err error) {
+	attrs := bat.GetAttrs()
+	var w bytes.Buffer
+	if deletes != nil {
+		if _, err = deletes.WriteTo(&w); err != nil {
+			return
+		}
+		if err = bf.WriteDeletes(w.Bytes()); err != nil {
+			return
+		}
+	}
+	if err = bf.WriteTS(ts); err != nil {
+		return err
+	}
+	if err = bf.WriteRows(uint32(bat.Length())); err != nil {
+		return err
+	}
+	for _, colIdx := range attrs {
+		cb, err := bf.OpenColumn(colIdx)
+		if err != nil {
+			return err
+		}
+		defer cb.Close()
+		cb.WriteTS(ts)
+		vec, err := bat.GetVectorByAttr(colIdx)
+		if err != nil {
+			return err
+		}
+		updates := vals[uint16(colIdx)]
+		if updates != nil {
+			w.Reset()
+			mask := masks[uint16(colIdx)]
+			if _, err = mask.WriteTo(&w); err != nil {
+				return err
+			}
+			col := gvec.New(vec.GetDataType())
+			it := mask.Iterator()
+			for it.HasNext() {
+				row := it.Next()
+				v := updates[row]
+				compute.AppendValue(col, v)
+			}
+			buf, err := col.Show()
+			if err != nil {
+				return err
+			}
+			w.Write(buf)
+			if err = cb.WriteUpdates(w.Bytes()); err != nil {
+				return err
+			}
+		}
+		w.Reset()
+		buf, err := vec.Marshal()
+		if err != nil {
+			return err
+		}
+		if err = cb.WriteData(buf); err != nil {
+			return err
+		}
+	}
+	return
+}
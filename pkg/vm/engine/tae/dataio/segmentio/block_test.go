@@ -0,0 +1,124 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock1(t *testing.T) {
+	colCnt := 4
+	indexCnt := make(map[int]int)
+	for col := 0; col < colCnt; col++ {
+		indexCnt[col] = 2
+	}
+	seg := SegmentFileIOFactory(testutils.InitTestEnv(ModuleName, t), common.NextGlobalSeqNum())
+	defer seg.Unref()
+	var block file.Block
+	block, err := seg.OpenBlock(common.NextGlobalSeqNum(), colCnt, indexCnt)
+	assert.Nil(t, err)
+	blockTs := common.NextGlobalSeqNum()
+	block.WriteTS(blockTs)
+	readTs, _ := block.ReadTS()
+	assert.Equal(t, blockTs, readTs)
+
+	deletes := roaring.New()
+	deletes.Add(10)
+	deletes.Add(20)
+	deletesBuf, _ := deletes.ToBytes()
+	err = block.WriteDeletes(deletesBuf)
+	assert.Nil(t, err)
+
+	colBlk0, err := block.OpenColumn(colCnt)
+	assert.NotNil(t, err)
+
+	colBlk0, err = block.OpenColumn(0)
+	assert.Nil(t, err)
+	assert.NotNil(t, colBlk0)
+	var w bytes.Buffer
+	dataStr := "hello tae"
+	w.WriteString(dataStr)
+	err = colBlk0.WriteData(w.Bytes())
+	assert.Nil(t, err)
+
+	dataFile, err := colBlk0.OpenDataFile()
+	assert.Nil(t, err)
+	size := dataFile.Stat().Size()
+	assert.Equal(t, int64(len(dataStr)), dataFile.Stat().OriginSize())
+	buf := make([]byte, size)
+	_, err = dataFile.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, dataStr, string(buf))
+	t.Log(string(buf))
+
+	dataFile.Unref()
+	colBlk0.Close()
+
+	block.Unref()
+}
+
+func TestBlockCompress(t *testing.T) {
+	algs := []compress.T{compress.Lz4, compress.Zstd, compress.Snappy, compress.None}
+	seg := SegmentFileIOFactory(testutils.InitTestEnv(ModuleName, t), common.NextGlobalSeqNum())
+	defer seg.Unref()
+	block, err := seg.OpenBlock(common.NextGlobalSeqNum(), len(algs), nil)
+	assert.Nil(t, err)
+	vs := make([]int64, 1000)
+	for i := range vs {
+		vs[i] = int64(i % 10)
+	}
+	bat := gbat.New(true, []string{"a", "b", "c", "d"})
+	for i, alg := range algs {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		colBlk.SetCompressAlgo(alg)
+		colBlk.Close()
+		bat.Vecs[i] = gvec.New(types.Type{Oid: types.T_int64, Size: 8})
+		assert.Nil(t, gvec.Append(bat.Vecs[i], vs))
+	}
+	assert.Nil(t, block.WriteBatch(bat, common.NextGlobalSeqNum()))
+
+	for i, alg := range algs {
+		colBlk, err := block.OpenColumn(i)
+		assert.Nil(t, err)
+		stat := colBlk.GetDataFileStat()
+		assert.Equal(t, int(alg), stat.CompressAlgo())
+		if alg != compress.None {
+			assert.Less(t, stat.Size(), stat.OriginSize())
+		}
+		colBlk.Close()
+	}
+	typs := make([]types.Type, len(algs))
+	for i := range typs {
+		typs[i] = bat.Vecs[i].Typ
+	}
+	loaded, err := block.LoadBatch(bat.Attrs, typs)
+	assert.Nil(t, err)
+	for i := range algs {
+		assert.Equal(t, vs, loaded.Vecs[i].Col.([]int64))
+	}
+	block.Unref()
+}
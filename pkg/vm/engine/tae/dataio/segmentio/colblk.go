@@ -0,0 +1,133 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+)
+
+type columnBlock struct {
+	common.RefHelper
+	block   *blockFile
+	meta    *columnMeta
+	indexes []*dataFile
+	updates *dataFile
+	data    *dataFile
+	alg     compress.T
+}
+
+func newColumnBlock(block *blockFile, meta *columnMeta, col int) *columnBlock {
+	cb := &columnBlock{
+		block:   block,
+		meta:    meta,
+		indexes: make([]*dataFile, len(meta.indexes)),
+	}
+	seg := block.seg
+	name := fmt.Sprintf("%d.%d", block.meta.id, col)
+	for i := range cb.indexes {
+		cb.indexes[i] = newData(seg, cb, &meta.indexes[i], fmt.Sprintf("%s.idx%d", name, i))
+	}
+	cb.updates = newData(seg, cb, &meta.updates, name+".update")
+	cb.data = newData(seg, cb, &meta.data, name+".data")
+	cb.Ref()
+	return cb
+}
+
+func (cb *columnBlock) WriteTS(ts uint64) (err error) {
+	cb.block.seg.setMeta(func() { cb.meta.ts = ts })
+	return
+}
+
+func (cb *columnBlock) SetCompressAlgo(alg compress.T) {
+	cb.alg = alg
+}
+
+func (cb *columnBlock) WriteData(buf []byte) (err error) {
+	_, err = cb.data.writeCompressed(buf, cb.alg)
+	return
+}
+
+func (cb *columnBlock) WriteUpdates(buf []byte) (err error) {
+	_, err = cb.updates.Write(buf)
+	return
+}
+
+func (cb *columnBlock) WriteIndex(idx int, buf []byte) (err error) {
+	if idx >= len(cb.indexes) {
+		err = file.ErrInvalidParam
+		return
+	}
+	_, err = cb.indexes[idx].Write(buf)
+	return
+}
+
+func (cb *columnBlock) ReadTS() (ts uint64) {
+	cb.block.seg.getMeta(func() { ts = cb.meta.ts })
+	return
+}
+
+func (cb *columnBlock) ReadData(buf []byte) (err error) {
+	_, err = cb.data.Read(buf)
+	return
+}
+
+func (cb *columnBlock) ReadUpdates(buf []byte) (err error) {
+	_, err = cb.updates.Read(buf)
+	return
+}
+
+func (cb *columnBlock) ReadIndex(idx int, buf []byte) (err error) {
+	if idx >= len(cb.indexes) {
+		err = file.ErrInvalidParam
+		return
+	}
+	_, err = cb.indexes[idx].Read(buf)
+	return
+}
+
+func (cb *columnBlock) GetDataFileStat() (stat common.FileInfo) {
+	return cb.data.Stat()
+}
+
+func (cb *columnBlock) OpenIndexFile(idx int) (vfile common.IRWFile, err error) {
+	if idx >= len(cb.indexes) {
+		err = file.ErrInvalidParam
+		return
+	}
+	vfile = cb.indexes[idx]
+	vfile.Ref()
+	return
+}
+
+func (cb *columnBlock) OpenUpdateFile() (vfile common.IRWFile, err error) {
+	cb.updates.Ref()
+	vfile = cb.updates
+	return
+}
+
+func (cb *columnBlock) OpenDataFile() (vfile common.IRWFile, err error) {
+	cb.data.Ref()
+	vfile = cb.data
+	return
+}
+
+func (cb *columnBlock) Close() error {
+	cb.Unref()
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+type fileStat struct {
+	name  string
+	size  int64
+	osize int64
+	algo  uint8
+}
+
+func (stat *fileStat) Name() string      { return stat.name }
+func (stat *fileStat) Size() int64       { return stat.size }
+func (stat *fileStat) OriginSize() int64 { return stat.osize }
+func (stat *fileStat) CompressAlgo() int { return int(stat.algo) }
+
+// dataFile is a part of a block stored in an extent of the segment file,
+// every write replaces the extent with a new one.
+type dataFile struct {
+	seg   *segmentFile
+	owner common.IRef
+	ext   *extent
+	name  string
+}
+
+func newData(seg *segmentFile, owner common.IRef, ext *extent, name string) *dataFile {
+	return &dataFile{
+		seg:   seg,
+		owner: owner,
+		ext:   ext,
+		name:  name,
+	}
+}
+
+func (df *dataFile) Write(buf []byte) (n int, err error) {
+	if err = df.seg.write(df.ext, buf, len(buf), compress.None); err != nil {
+		return
+	}
+	n = len(buf)
+	return
+}
+
+func (df *dataFile) writeCompressed(buf []byte, alg compress.T) (n int, err error) {
+	data, algo := buf, uint8(compress.None)
+	if alg != compress.None {
+		cbuf := make([]byte, compress.CompressBound(len(buf), int(alg)))
+		if cbuf, err = compress.Compress(buf, cbuf, int(alg)); err != nil {
+			return
+		}
+		if len(cbuf) > 0 && len(cbuf) < len(buf) {
+			data, algo = cbuf, uint8(alg)
+		}
+	}
+	if err = df.seg.write(df.ext, data, len(buf), algo); err != nil {
+		return
+	}
+	n = len(buf)
+	return
+}
+
+func readDecompressed(f common.IRWFile) (buf []byte, err error) {
+	stat := f.Stat()
+	data := make([]byte, stat.Size())
+	if _, err = f.Read(data); err != nil {
+		return
+	}
+	if stat.CompressAlgo() == compress.None {
+		return data, nil
+	}
+	buf = make([]byte, stat.OriginSize())
+	_, err = compress.Decompress(data, buf, stat.CompressAlgo())
+	return
+}
+
+func (df *dataFile) Read(buf []byte) (n int, err error) {
+	return df.seg.read(df.ext, buf)
+}
+
+func (df *dataFile) GetFileType() common.FileType {
+	return common.DiskFile
+}
+
+func (df *dataFile) Ref()            { df.owner.Ref() }
+func (df *dataFile) Unref()          { df.owner.Unref() }
+func (df *dataFile) RefCount() int64 { return df.owner.RefCount() }
+
+func (df *dataFile) Stat() common.FileInfo { return df.seg.stat(df.ext, df.name) }
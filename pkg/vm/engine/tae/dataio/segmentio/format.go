@@ -0,0 +1,305 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+// A segment file is a sequence of extents followed by a footer:
+//
+//	| extent | extent | ... | meta | metaLen(4) | metaCRC(4) | magic(8) |
+//
+// Every write of a column data, a column index, the column updates, the
+// block deletes or the block index meta appends a new extent to the end of
+// the file, the extent replaced by it becomes garbage. Sync appends a new
+// footer describing the latest extent of every part of every block, so the
+// last valid footer of the file is the last synced state of the segment.
+// Everything after it is discarded when the segment file is opened again.
+// The garbage is reclaimed when the segment file is opened as well: if it
+// is larger than the live extents, they are copied into a new file.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"sort"
+)
+
+const (
+	// footerMagic marks the end of a footer
+	footerMagic uint64 = 0x5441455345474d31 // "TAESEGM1"
+	// trailerSize is the size of the fixed part of a footer after the meta
+	trailerSize = 16
+	// metaVersion is the version of the encoding of the meta
+	metaVersion uint16 = 1
+	// compactThreshold is the size under which a segment file is never
+	// compacted
+	compactThreshold = 1 << 20
+)
+
+var (
+	ErrChecksum      = errors.New("tae: segment file checksum mismatch")
+	ErrInvalidFooter = errors.New("tae: invalid segment file footer")
+	ErrVersion       = errors.New("tae: unsupported segment file version")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// extent is a continuous part of the segment file holding the data of a
+// part of a block, the data is compressed by alg if alg is not none.
+type extent struct {
+	offset     uint64
+	length     uint32
+	originSize uint32
+	alg        uint8
+	checksum   uint32
+}
+
+type columnMeta struct {
+	ts      uint64
+	data    extent
+	updates extent
+	indexes []extent
+}
+
+type blockMeta struct {
+	id        uint64
+	ts        uint64
+	rows      uint32
+	deletes   extent
+	indexMeta extent
+	columns   []*columnMeta
+}
+
+type segmentMeta struct {
+	ts     uint64
+	blocks []*blockMeta
+}
+
+func (e *extent) WriteTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, e.offset); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, e.length); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, e.originSize); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, e.alg); err != nil {
+		return
+	}
+	err = binary.Write(w, binary.BigEndian, e.checksum)
+	n = 21
+	return
+}
+
+func (e *extent) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &e.offset); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &e.length); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &e.originSize); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &e.alg); err != nil {
+		return
+	}
+	err = binary.Read(r, binary.BigEndian, &e.checksum)
+	n = 21
+	return
+}
+
+// liveSize returns the total length of the extents referenced by the meta.
+func (meta *segmentMeta) liveSize() (size int64) {
+	for _, blk := range meta.blocks {
+		for _, e := range blk.extents() {
+			size += int64(e.length)
+		}
+	}
+	return
+}
+
+// extents returns all the extents of the block.
+func (meta *blockMeta) extents() []*extent {
+	exts := []*extent{&meta.deletes, &meta.indexMeta}
+	for _, col := range meta.columns {
+		exts = append(exts, &col.data, &col.updates)
+		for i := range col.indexes {
+			exts = append(exts, &col.indexes[i])
+		}
+	}
+	return exts
+}
+
+func (meta *segmentMeta) Marshal() (buf []byte, err error) {
+	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, metaVersion); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, meta.ts); err != nil {
+		return
+	}
+	sort.Slice(meta.blocks, func(i, j int) bool {
+		return meta.blocks[i].id < meta.blocks[j].id
+	})
+	if err = binary.Write(&w, binary.BigEndian, uint32(len(meta.blocks))); err != nil {
+		return
+	}
+	for _, blk := range meta.blocks {
+		if err = blk.writeTo(&w); err != nil {
+			return
+		}
+	}
+	buf = w.Bytes()
+	return
+}
+
+func (meta *segmentMeta) Unmarshal(buf []byte) (err error) {
+	r := bytes.NewBuffer(buf)
+	var version uint16
+	if err = binary.Read(r, binary.BigEndian, &version); err != nil {
+		return
+	}
+	if version != metaVersion {
+		return ErrVersion
+	}
+	if err = binary.Read(r, binary.BigEndian, &meta.ts); err != nil {
+		return
+	}
+	var cnt uint32
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	meta.blocks = make([]*blockMeta, cnt)
+	for i := range meta.blocks {
+		meta.blocks[i] = new(blockMeta)
+		if err = meta.blocks[i].readFrom(r); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (meta *blockMeta) writeTo(w io.Writer) (err error) {
+	if err = binary.Write(w, binary.BigEndian, meta.id); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, meta.ts); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, meta.rows); err != nil {
+		return
+	}
+	if _, err = meta.deletes.WriteTo(w); err != nil {
+		return
+	}
+	if _, err = meta.indexMeta.WriteTo(w); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint16(len(meta.columns))); err != nil {
+		return
+	}
+	for _, col := range meta.columns {
+		if err = binary.Write(w, binary.BigEndian, col.ts); err != nil {
+			return
+		}
+		if _, err = col.data.WriteTo(w); err != nil {
+			return
+		}
+		if _, err = col.updates.WriteTo(w); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, uint16(len(col.indexes))); err != nil {
+			return
+		}
+		for i := range col.indexes {
+			if _, err = col.indexes[i].WriteTo(w); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (meta *blockMeta) readFrom(r io.Reader) (err error) {
+	if err = binary.Read(r, binary.BigEndian, &meta.id); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &meta.ts); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &meta.rows); err != nil {
+		return
+	}
+	if _, err = meta.deletes.ReadFrom(r); err != nil {
+		return
+	}
+	if _, err = meta.indexMeta.ReadFrom(r); err != nil {
+		return
+	}
+	var colCnt uint16
+	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
+		return
+	}
+	meta.columns = make([]*columnMeta, colCnt)
+	for i := range meta.columns {
+		col := new(columnMeta)
+		if err = binary.Read(r, binary.BigEndian, &col.ts); err != nil {
+			return
+		}
+		if _, err = col.data.ReadFrom(r); err != nil {
+			return
+		}
+		if _, err = col.updates.ReadFrom(r); err != nil {
+			return
+		}
+		var idxCnt uint16
+		if err = binary.Read(r, binary.BigEndian, &idxCnt); err != nil {
+			return
+		}
+		col.indexes = make([]extent, idxCnt)
+		for j := range col.indexes {
+			if _, err = col.indexes[j].ReadFrom(r); err != nil {
+				return
+			}
+		}
+		meta.columns[i] = col
+	}
+	return
+}
+
+// encodeFooter returns the footer of the meta.
+func encodeFooter(meta []byte) []byte {
+	buf := make([]byte, len(meta)+trailerSize)
+	copy(buf, meta)
+	binary.BigEndian.PutUint32(buf[len(meta):], uint32(len(meta)))
+	binary.BigEndian.PutUint32(buf[len(meta)+4:], crc32.Checksum(meta, crcTable))
+	binary.BigEndian.PutUint64(buf[len(meta)+8:], footerMagic)
+	return buf
+}
+
+// decodeTrailer returns the length and the checksum of the meta of the
+// footer ending with the trailer.
+func decodeTrailer(trailer []byte) (metaLen uint32, checksum uint32, err error) {
+	if len(trailer) != trailerSize || binary.BigEndian.Uint64(trailer[8:]) != footerMagic {
+		err = ErrInvalidFooter
+		return
+	}
+	metaLen = binary.BigEndian.Uint32(trailer)
+	checksum = binary.BigEndian.Uint32(trailer[4:])
+	return
+}
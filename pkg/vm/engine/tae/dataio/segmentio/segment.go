@@ -0,0 +1,418 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+)
+
+const suffix = ".seg"
+
+var SegmentFileIOFactory = func(dir string, id uint64) file.Segment {
+	sf, err := openSegmentFile(dir, id)
+	if err != nil {
+		panic(err)
+	}
+	return sf
+}
+
+// SegmentFileName returns the name of the file of the segment in the dir.
+func SegmentFileName(dir string, id uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%d%s", id, suffix))
+}
+
+type segmentFile struct {
+	common.RefHelper
+	sync.RWMutex
+	id     *common.ID
+	name   string
+	file   *os.File
+	size   int64 // the offset of the next extent
+	dirty  bool  // the meta has changed since the last footer
+	ts     uint64
+	blocks map[uint64]*blockFile
+	metas  map[uint64]*blockMeta // the synced blocks which are not opened
+}
+
+func openSegmentFile(dir string, id uint64) (sf *segmentFile, err error) {
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+	name := SegmentFileName(dir, id)
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return
+	}
+	sf = &segmentFile{
+		name:   name,
+		file:   f,
+		blocks: make(map[uint64]*blockFile),
+		metas:  make(map[uint64]*blockMeta),
+	}
+	sf.id = &common.ID{
+		SegmentID: id,
+	}
+	if err = sf.replay(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	sf.Ref()
+	sf.OnZeroCB = sf.close
+	return
+}
+
+// replay loads the meta of the last valid footer and truncates the file
+// after it.
+func (sf *segmentFile) replay() (err error) {
+	stat, err := sf.file.Stat()
+	if err != nil {
+		return
+	}
+	size := stat.Size()
+	if size == 0 {
+		return
+	}
+	end, meta, err := sf.readFooter(size)
+	if err != nil {
+		// the tail of the file was not synced, look for the last valid footer
+		var buf []byte
+		if buf, err = ioutil.ReadAll(sf.file); err != nil {
+			return
+		}
+		end, meta = findFooter(buf)
+		logutil.Warnf("SegmentFile %s: discard %d bytes after the last valid footer", sf.name, size-end)
+	}
+	if end < size {
+		if err = sf.file.Truncate(end); err != nil {
+			return
+		}
+	}
+	sf.size = end
+	if meta == nil {
+		return
+	}
+	if live := meta.liveSize(); end-live > live && end > compactThreshold {
+		if end, err = sf.compact(meta); err != nil {
+			return
+		}
+		logutil.Infof("SegmentFile %s: compacted from %d to %d bytes", sf.name, sf.size, end)
+		sf.size = end
+	}
+	sf.ts = meta.ts
+	for _, blk := range meta.blocks {
+		sf.metas[blk.id] = blk
+	}
+	return
+}
+
+// compact rewrites the live extents of the meta and its footer into a new
+// file which replaces the segment file, it returns the size of the new file.
+// It runs only when the segment file is opened, so no snapshot of the old
+// file can be in progress.
+func (sf *segmentFile) compact(meta *segmentMeta) (size int64, err error) {
+	tmpName := sf.name + ".tmp"
+	f, err := os.OpenFile(tmpName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(tmpName)
+		}
+	}()
+	for _, blk := range meta.blocks {
+		for _, e := range blk.extents() {
+			if e.length == 0 {
+				continue
+			}
+			buf := make([]byte, e.length)
+			if _, err = sf.file.ReadAt(buf, int64(e.offset)); err != nil {
+				return
+			}
+			if _, err = f.WriteAt(buf, size); err != nil {
+				return
+			}
+			e.offset = uint64(size)
+			size += int64(e.length)
+		}
+	}
+	buf, err := meta.Marshal()
+	if err != nil {
+		return
+	}
+	footer := encodeFooter(buf)
+	if _, err = f.WriteAt(footer, size); err != nil {
+		return
+	}
+	size += int64(len(footer))
+	if err = f.Sync(); err != nil {
+		return
+	}
+	if err = os.Rename(tmpName, sf.name); err != nil {
+		return
+	}
+	sf.file.Close()
+	sf.file = f
+	return
+}
+
+// readFooter reads the footer ending at the end of the file.
+func (sf *segmentFile) readFooter(end int64) (int64, *segmentMeta, error) {
+	if end < trailerSize {
+		return 0, nil, ErrInvalidFooter
+	}
+	trailer := make([]byte, trailerSize)
+	if _, err := sf.file.ReadAt(trailer, end-trailerSize); err != nil {
+		return 0, nil, err
+	}
+	metaLen, checksum, err := decodeTrailer(trailer)
+	if err != nil {
+		return 0, nil, err
+	}
+	if int64(metaLen) > end-trailerSize {
+		return 0, nil, ErrInvalidFooter
+	}
+	buf := make([]byte, metaLen)
+	if _, err = sf.file.ReadAt(buf, end-trailerSize-int64(metaLen)); err != nil {
+		return 0, nil, err
+	}
+	if crc32.Checksum(buf, crcTable) != checksum {
+		return 0, nil, ErrChecksum
+	}
+	meta := new(segmentMeta)
+	if err = meta.Unmarshal(buf); err != nil {
+		return 0, nil, err
+	}
+	return end, meta, nil
+}
+
+// findFooter returns the end and the meta of the last valid footer of the
+// file, it returns 0 if there is no valid footer.
+func findFooter(buf []byte) (int64, *segmentMeta) {
+	magic := make([]byte, 8)
+	binary.BigEndian.PutUint64(magic, footerMagic)
+	for end := len(buf); end > 0; {
+		i := bytes.LastIndex(buf[:end], magic)
+		if i < 0 {
+			break
+		}
+		end = i + 8
+		if end >= trailerSize {
+			metaLen, checksum, err := decodeTrailer(buf[end-trailerSize : end])
+			if err == nil && int(metaLen) <= end-trailerSize {
+				data := buf[end-trailerSize-int(metaLen) : end-trailerSize]
+				meta := new(segmentMeta)
+				if crc32.Checksum(data, crcTable) == checksum && meta.Unmarshal(data) == nil {
+					return int64(end), meta
+				}
+			}
+		}
+		end = i
+	}
+	return 0, nil
+}
+
+func (sf *segmentFile) Fingerprint() *common.ID { return sf.id }
+
+// Close writes the footer if the meta has changed since the last sync.
+func (sf *segmentFile) Close() error {
+	return sf.sync()
+}
+
+func (sf *segmentFile) close() {
+	sf.Destory()
+}
+
+func (sf *segmentFile) Destory() {
+	if err := sf.sync(); err != nil {
+		logutil.Warnf("SegmentFile %s: %v", sf.name, err)
+	}
+	sf.Lock()
+	blocks := sf.blocks
+	sf.blocks = make(map[uint64]*blockFile)
+	sf.Unlock()
+	for _, block := range blocks {
+		block.Unref()
+	}
+	sf.file.Close()
+	logutil.Infof("Destoring Segment %d", sf.id.SegmentID)
+}
+
+func (sf *segmentFile) OpenBlock(id uint64, colCnt int, indexCnt map[int]int) (block file.Block, err error) {
+	sf.Lock()
+	defer sf.Unlock()
+	bf := sf.blocks[id]
+	if bf == nil {
+		bf = newBlock(id, sf, colCnt, indexCnt, sf.metas[id])
+		delete(sf.metas, id)
+		sf.blocks[id] = bf
+	}
+	// the initial reference of the block is held by the segment file until
+	// it is destroyed, the caller owns another one
+	bf.Ref()
+	block = bf
+	return
+}
+
+func (sf *segmentFile) WriteTS(ts uint64) error {
+	sf.Lock()
+	defer sf.Unlock()
+	sf.ts = ts
+	sf.dirty = true
+	return nil
+}
+
+func (sf *segmentFile) ReadTS() uint64 {
+	sf.RLock()
+	defer sf.RUnlock()
+	return sf.ts
+}
+
+func (sf *segmentFile) String() string {
+	sf.RLock()
+	defer sf.RUnlock()
+	s := fmt.Sprintf("SegmentFile[%d][\"%s\"][TS=%d][BCnt=%d][Size=%d]", sf.id, sf.name, sf.ts, len(sf.blocks)+len(sf.metas), sf.size)
+	return s
+}
+
+// write appends the data as a new extent and replaces the extent e with it.
+func (sf *segmentFile) write(e *extent, data []byte, originSize int, alg uint8) (err error) {
+	sf.Lock()
+	defer sf.Unlock()
+	if len(data) > 0 {
+		if _, err = sf.file.WriteAt(data, sf.size); err != nil {
+			return
+		}
+	}
+	*e = extent{
+		offset:     uint64(sf.size),
+		length:     uint32(len(data)),
+		originSize: uint32(originSize),
+		alg:        alg,
+		checksum:   crc32.Checksum(data, crcTable),
+	}
+	sf.size += int64(len(data))
+	sf.dirty = true
+	return
+}
+
+// read reads the data of the extent e into buf, it returns
+// io.ErrShortBuffer if buf cannot hold the extent.
+func (sf *segmentFile) read(e *extent, buf []byte) (n int, err error) {
+	sf.RLock()
+	ext := *e
+	sf.RUnlock()
+	if ext.length == 0 {
+		return
+	}
+	if len(buf) < int(ext.length) {
+		err = io.ErrShortBuffer
+		return
+	}
+	if _, err = sf.file.ReadAt(buf[:ext.length], int64(ext.offset)); err != nil {
+		return
+	}
+	if crc32.Checksum(buf[:ext.length], crcTable) != ext.checksum {
+		err = ErrChecksum
+		return
+	}
+	n = int(ext.length)
+	return
+}
+
+// stat returns the stat of the extent e.
+func (sf *segmentFile) stat(e *extent, name string) *fileStat {
+	sf.RLock()
+	defer sf.RUnlock()
+	return &fileStat{
+		name:  name,
+		size:  int64(e.length),
+		osize: int64(e.originSize),
+		algo:  e.alg,
+	}
+}
+
+// setMeta updates a field of the meta of a block under the lock.
+func (sf *segmentFile) setMeta(fn func()) {
+	sf.Lock()
+	defer sf.Unlock()
+	fn()
+	sf.dirty = true
+}
+
+// getMeta reads a field of the meta of a block under the lock.
+func (sf *segmentFile) getMeta(fn func()) {
+	sf.RLock()
+	defer sf.RUnlock()
+	fn()
+}
+
+// Snapshot syncs the file and returns its size. The extents are appended
+// after the size, so the prefix is a consistent copy of the segment.
+func (sf *segmentFile) Snapshot() (size int64, err error) {
+	sf.Lock()
+	defer sf.Unlock()
+	if err = sf.syncLocked(); err != nil {
+		return
+	}
+	return sf.size, nil
+}
+
+// sync appends a footer of the current meta and syncs the file.
+func (sf *segmentFile) sync() (err error) {
+	sf.Lock()
+	defer sf.Unlock()
+	return sf.syncLocked()
+}
+
+func (sf *segmentFile) syncLocked() (err error) {
+	if !sf.dirty {
+		return
+	}
+	meta := &segmentMeta{ts: sf.ts}
+	for _, blk := range sf.metas {
+		meta.blocks = append(meta.blocks, blk)
+	}
+	for _, bf := range sf.blocks {
+		meta.blocks = append(meta.blocks, bf.meta)
+	}
+	buf, err := meta.Marshal()
+	if err != nil {
+		return
+	}
+	footer := encodeFooter(buf)
+	if _, err = sf.file.WriteAt(footer, sf.size); err != nil {
+		return
+	}
+	if err = sf.file.Sync(); err != nil {
+		return
+	}
+	sf.size += int64(len(footer))
+	sf.dirty = false
+	return
+}
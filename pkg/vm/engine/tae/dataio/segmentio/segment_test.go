@@ -0,0 +1,199 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segmentio
+
+import (
+	"crypto/rand"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ModuleName = "SEGMENTIO"
+)
+
+func TestSegment1(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(dir, id)
+	fp := seg.Fingerprint()
+	assert.Equal(t, id, fp.SegmentID)
+
+	blkId1 := common.NextGlobalSeqNum()
+	blk1, err := seg.OpenBlock(blkId1, 2, nil)
+	assert.Nil(t, err)
+	blkTs1 := common.NextGlobalSeqNum()
+	blk1.WriteTS(blkTs1)
+
+	ts, _ := blk1.ReadTS()
+	assert.Equal(t, blkTs1, ts)
+	blk1.Close()
+	t.Log(seg.String())
+	seg.Unref()
+}
+
+func TestSegmentReopen(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(dir, id)
+	segTs := common.NextGlobalSeqNum()
+	assert.Nil(t, seg.WriteTS(segTs))
+
+	indexCnt := map[int]int{0: 1}
+	blkId := common.NextGlobalSeqNum()
+	blk, err := seg.OpenBlock(blkId, 2, indexCnt)
+	assert.Nil(t, err)
+	blkTs := common.NextGlobalSeqNum()
+	assert.Nil(t, blk.WriteTS(blkTs))
+	assert.Nil(t, blk.WriteRows(3))
+	assert.Nil(t, blk.WriteDeletes([]byte("deletes")))
+	colBlk, err := blk.OpenColumn(0)
+	assert.Nil(t, err)
+	assert.Nil(t, colBlk.WriteData([]byte("data0")))
+	assert.Nil(t, colBlk.WriteIndex(0, []byte("index0")))
+	assert.Nil(t, colBlk.WriteUpdates([]byte("updates0")))
+	colBlk.Close()
+	assert.Nil(t, blk.Sync())
+
+	// the data written after the last sync is discarded
+	colBlk, err = blk.OpenColumn(1)
+	assert.Nil(t, err)
+	assert.Nil(t, colBlk.WriteData([]byte("data1")))
+	colBlk.Close()
+	seg.(*segmentFile).file.Close()
+
+	seg = SegmentFileIOFactory(dir, id)
+	assert.Equal(t, segTs, seg.ReadTS())
+	blk, err = seg.OpenBlock(blkId, 2, indexCnt)
+	assert.Nil(t, err)
+	ts, err := blk.ReadTS()
+	assert.Nil(t, err)
+	assert.Equal(t, blkTs, ts)
+	assert.Equal(t, uint32(3), blk.ReadRows())
+	buf := make([]byte, len("deletes"))
+	assert.Nil(t, blk.ReadDeletes(buf))
+	assert.Equal(t, "deletes", string(buf))
+
+	colBlk, err = blk.OpenColumn(0)
+	assert.Nil(t, err)
+	buf = make([]byte, colBlk.GetDataFileStat().Size())
+	assert.Nil(t, colBlk.ReadData(buf))
+	assert.Equal(t, "data0", string(buf))
+	buf = make([]byte, len("index0"))
+	assert.Nil(t, colBlk.ReadIndex(0, buf))
+	assert.Equal(t, "index0", string(buf))
+	buf = make([]byte, len("updates0"))
+	assert.Nil(t, colBlk.ReadUpdates(buf))
+	assert.Equal(t, "updates0", string(buf))
+	colBlk.Close()
+
+	colBlk, err = blk.OpenColumn(1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), colBlk.GetDataFileStat().Size())
+	colBlk.Close()
+	seg.Unref()
+}
+
+func TestSegmentCorruption(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(dir, id)
+	blkId := common.NextGlobalSeqNum()
+	blk, err := seg.OpenBlock(blkId, 1, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, blk.WriteRows(1))
+	assert.Nil(t, blk.Sync())
+	assert.Nil(t, blk.WriteRows(2))
+	assert.Nil(t, blk.Sync())
+	seg.Unref()
+
+	// a torn footer falls back to the previous one
+	name := SegmentFileName(dir, id)
+	stat, err := os.Stat(name)
+	assert.Nil(t, err)
+	assert.Nil(t, os.Truncate(name, stat.Size()-1))
+	seg = SegmentFileIOFactory(dir, id)
+	blk, err = seg.OpenBlock(blkId, 1, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), blk.ReadRows())
+
+	// a corrupted extent is detected by its checksum
+	colBlk, err := blk.OpenColumn(0)
+	assert.Nil(t, err)
+	assert.Nil(t, colBlk.WriteData([]byte("hello tae")))
+	f := seg.(*segmentFile).file
+	_, err = f.WriteAt([]byte("j"), int64(colBlk.(*columnBlock).meta.data.offset))
+	assert.Nil(t, err)
+	buf := make([]byte, colBlk.GetDataFileStat().Size())
+	assert.Equal(t, ErrChecksum, colBlk.ReadData(buf))
+	colBlk.Close()
+	seg.Unref()
+}
+
+func TestSegmentCompact(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	id := common.NextGlobalSeqNum()
+	seg := SegmentFileIOFactory(dir, id)
+	blkId := common.NextGlobalSeqNum()
+	blk, err := seg.OpenBlock(blkId, 1, nil)
+	assert.Nil(t, err)
+	colBlk, err := blk.OpenColumn(0)
+	assert.Nil(t, err)
+	data := make([]byte, compactThreshold/2)
+	for i := 0; i < 4; i++ {
+		_, err = rand.Read(data)
+		assert.Nil(t, err)
+		assert.Nil(t, colBlk.WriteData(data))
+		assert.Nil(t, blk.Sync())
+	}
+	colBlk.Close()
+	blk.Close()
+	seg.Unref()
+	name := SegmentFileName(dir, id)
+	stat, err := os.Stat(name)
+	assert.Nil(t, err)
+	assert.True(t, stat.Size() > 2*compactThreshold)
+
+	// the extents replaced by the later writes are dropped on reopen
+	seg = SegmentFileIOFactory(dir, id)
+	stat, err = os.Stat(name)
+	assert.Nil(t, err)
+	assert.True(t, stat.Size() < compactThreshold)
+	blk, err = seg.OpenBlock(blkId, 1, nil)
+	assert.Nil(t, err)
+	colBlk, err = blk.OpenColumn(0)
+	assert.Nil(t, err)
+	buf := make([]byte, colBlk.GetDataFileStat().Size())
+	assert.Nil(t, colBlk.ReadData(buf))
+	assert.Equal(t, data, buf)
+
+	// a short buffer is rejected
+	assert.Equal(t, io.ErrShortBuffer, colBlk.ReadData(buf[:1]))
+	colBlk.Close()
+
+	// the compacted file keeps growing from its new end
+	blk.Close()
+	seg.Unref()
+	seg = SegmentFileIOFactory(dir, id)
+	stat2, err := os.Stat(name)
+	assert.Nil(t, err)
+	assert.Equal(t, stat.Size(), stat2.Size())
+	seg.Unref()
+}
@@ -0,0 +1,870 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/segmentio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+const (
+	BackupVersion    = 3
+	BackupManifest   = "MANIFEST"
+	BackupHistoryDir = "history"
+)
+
+var (
+	ErrBackupEmpty      = errors.New("tae: backup of an empty catalog")
+	ErrBackupExists     = errors.New("tae: backup already exists")
+	ErrInvalidBackup    = errors.New("tae: invalid backup")
+	ErrRestoreTS        = errors.New("tae: restore ts out of backup range")
+	ErrRestoreDirExists = errors.New("tae: restore dir not empty")
+	ErrRestoreReplay    = errors.New("tae: restored catalog does not match the backup")
+)
+
+// Manifest describes a backup. A backup is a copy of the files of the db
+// taken after the blocks are flushed and the catalog is checkpointed at TS,
+// it holds the txns committed before the backup started. It also holds the
+// row versions of the tables committed in [CheckpointTS, TS], the backup
+// can be restored to any ts in the range. The versions before CheckpointTS
+// are not kept as the blocks dropped before the catalog checkpoint can be
+// gc'ed.
+type Manifest struct {
+	Version      int
+	CheckpointTS uint64
+	TS           uint64
+	Files        []*FileManifest
+	Databases    []*DatabaseManifest
+}
+
+type FileManifest struct {
+	Name string
+	Size int64
+}
+
+type DatabaseManifest struct {
+	ID       uint64
+	Name     string
+	CreateAt uint64
+	DeleteAt uint64
+	Tables   []*TableManifest
+}
+
+type TableManifest struct {
+	ID       uint64
+	Name     string
+	CreateAt uint64
+	DeleteAt uint64
+	Schema   []byte
+	Blocks   int
+}
+
+// Backup copies the running db into target, target is a directory or a
+// gzipped tarball if it ends with .tar.gz or .tgz. The blocks are flushed
+// and the catalog is checkpointed first, then the segment files are copied
+// up to their synced size together with the catalog and the wal files, the
+// copy is restored by Open as a db closed at the checkpoint. The versions
+// of the rows since the previous checkpoint are written into the history
+// of the backup for the restore to an earlier ts.
+func (db *DB) Backup(target string) (manifest *Manifest, err error) {
+	ckpTs := db.Catalog.GetCheckpointed().MaxTS
+	if err = db.flushAll(); err != nil {
+		return
+	}
+	ts := db.Scheduler.GetSafeTS()
+
+	// The txns committed at or before ts are synced to the wal, the
+	// entries written after the snapshot are not copied
+	walFiles, err := snapshotLogStore(db.Dir, WALDir)
+	if err != nil {
+		return
+	}
+	defer walFiles.Close()
+
+	// The catalog is checkpointed on the checkpoint worker, no later
+	// checkpoint is written into the snapshot of the catalog store
+	var catalogFiles *logSnapshot
+	task, err := db.Scheduler.ScheduleScopedFn(&tasks.Context{Waitable: true}, tasks.CheckpointTask, nil, func() (err error) {
+		if err = db.Catalog.Checkpoint(ts); err != nil {
+			return
+		}
+		catalogFiles, err = snapshotLogStore(db.Dir, CATALOGDir)
+		return
+	})
+	if err != nil {
+		return
+	}
+	err = task.WaitDone()
+	if catalogFiles != nil {
+		defer catalogFiles.Close()
+	}
+	if err != nil {
+		return
+	}
+
+	manifest = &Manifest{
+		Version:      BackupVersion,
+		CheckpointTS: ckpTs,
+		TS:           ts,
+	}
+	if len(collectDatabases(db.Catalog, ts)) == 0 {
+		return nil, ErrBackupEmpty
+	}
+
+	w, err := newBackupWriter(target)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if err = db.backupHistory(w, manifest); err != nil {
+		return nil, err
+	}
+	if err = db.backupSegments(w, manifest); err != nil {
+		return nil, err
+	}
+	for _, snapshot := range []*logSnapshot{catalogFiles, walFiles} {
+		if err = backupLogStore(w, snapshot, manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	buf, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err = copyFile(w, BackupManifest, bytes.NewReader(buf), int64(len(buf))); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// backupHistory writes the databases and the tables of [CheckpointTS, TS]
+// into the manifest and the row versions of each table into the history.
+func (db *DB) backupHistory(w backupWriter, manifest *Manifest) (err error) {
+	dbIt := db.Catalog.MakeDBIt(true)
+	for ; dbIt.Valid(); dbIt.Next() {
+		entry := dbIt.Get().GetPayload().(*catalog.DBEntry)
+		createAt, deleteAt, ok := backupRange(entry.BaseEntry, manifest.CheckpointTS, manifest.TS)
+		if !ok {
+			continue
+		}
+		database := &DatabaseManifest{
+			ID:       entry.GetID(),
+			Name:     entry.GetName(),
+			CreateAt: createAt,
+			DeleteAt: deleteAt,
+		}
+		tableIt := entry.MakeTableIt(true)
+		for ; tableIt.Valid(); tableIt.Next() {
+			tableEntry := tableIt.Get().GetPayload().(*catalog.TableEntry)
+			var table *TableManifest
+			if table, err = backupTable(w, tableEntry, manifest); err != nil {
+				return
+			}
+			if table != nil {
+				database.Tables = append(database.Tables, table)
+			}
+		}
+		manifest.Databases = append(manifest.Databases, database)
+	}
+	return
+}
+
+func backupTable(w backupWriter, entry *catalog.TableEntry, manifest *Manifest) (table *TableManifest, err error) {
+	createAt, deleteAt, ok := backupRange(entry.BaseEntry, manifest.CheckpointTS, manifest.TS)
+	if !ok {
+		return
+	}
+	schema, err := entry.GetSchema().Marshal()
+	if err != nil {
+		return
+	}
+	table = &TableManifest{
+		ID:       entry.GetID(),
+		Name:     entry.GetSchema().Name,
+		CreateAt: createAt,
+		DeleteAt: deleteAt,
+		Schema:   schema,
+	}
+	var buf bytes.Buffer
+	segIt := entry.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		segment := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		blkIt := segment.MakeBlockIt(true)
+		for ; blkIt.Valid(); blkIt.Next() {
+			block := blkIt.Get().GetPayload().(*catalog.BlockEntry)
+			_, deleteAt, ok := backupRange(block.BaseEntry, manifest.CheckpointTS, manifest.TS)
+			if !ok || block.GetBlockData() == nil {
+				continue
+			}
+			view, err := block.GetBlockData().CollectVersions(manifest.TS)
+			if err == data.ErrBlockClosed {
+				// The block was gc'ed after a newer checkpoint, its rows are
+				// not visible after it was dropped
+				if deleteAt > manifest.CheckpointTS {
+					manifest.CheckpointTS = deleteAt
+				}
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			if view.Length() == 0 {
+				continue
+			}
+			vbuf, err := view.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(encoding.EncodeUint32(uint32(len(vbuf))))
+			buf.Write(vbuf)
+			table.Blocks++
+		}
+	}
+	err = copyFile(w, historyFile(table.ID), bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err == nil {
+		manifest.Files = append(manifest.Files, &FileManifest{Name: historyFile(table.ID), Size: int64(buf.Len())})
+	}
+	return
+}
+
+// backupRange returns the commit ts of the creation and the deletion of the
+// entry. The entry is not backed up if it was not created at ts or it was
+// deleted before the checkpoint ts.
+func backupRange(entry *catalog.BaseEntry, ckpTs, ts uint64) (createAt, deleteAt uint64, ok bool) {
+	entry.RLock()
+	createAt, deleteAt = entry.CreateAt, entry.DeleteAt
+	entry.RUnlock()
+	if createAt == 0 || createAt > ts {
+		return 0, 0, false
+	}
+	if deleteAt > ts {
+		deleteAt = 0
+	}
+	if deleteAt != 0 && deleteAt <= ckpTs {
+		return 0, 0, false
+	}
+	return createAt, deleteAt, true
+}
+
+func historyFile(id uint64) string {
+	return fmt.Sprintf("%s/%d", BackupHistoryDir, id)
+}
+
+// collectDatabases returns the databases and the tables visible at ts.
+func collectDatabases(c *catalog.Catalog, ts uint64) (databases []*DatabaseManifest) {
+	dbIt := c.MakeDBIt(true)
+	for ; dbIt.Valid(); dbIt.Next() {
+		entry := dbIt.Get().GetPayload().(*catalog.DBEntry)
+		if !visibleAt(entry.BaseEntry, ts) {
+			continue
+		}
+		database := &DatabaseManifest{
+			ID:   entry.GetID(),
+			Name: entry.GetName(),
+		}
+		tableIt := entry.MakeTableIt(true)
+		for ; tableIt.Valid(); tableIt.Next() {
+			table := tableIt.Get().GetPayload().(*catalog.TableEntry)
+			if !visibleAt(table.BaseEntry, ts) {
+				continue
+			}
+			database.Tables = append(database.Tables, &TableManifest{
+				ID:   table.GetID(),
+				Name: table.GetSchema().Name,
+			})
+		}
+		databases = append(databases, database)
+	}
+	return
+}
+
+func visibleAt(entry *catalog.BaseEntry, ts uint64) bool {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.CreateAt != 0 && entry.CreateAt <= ts && (entry.DeleteAt == 0 || entry.DeleteAt > ts)
+}
+
+// backupSegments copies the segment files of the data dir. The files of the
+// segments in the catalog are synced and copied up to the snapshot size, the
+// other files are not written anymore and are copied as they are.
+func (db *DB) backupSegments(w backupWriter, manifest *Manifest) error {
+	sizes := make(map[string]int64)
+	processor := new(catalog.LoopProcessor)
+	processor.SegmentFn = func(segment *catalog.SegmentEntry) error {
+		if segment.GetSegmentData() == nil {
+			return nil
+		}
+		size, err := segment.GetSegmentData().GetSegmentFile().Snapshot()
+		if err != nil {
+			return err
+		}
+		sizes[filepath.Base(segmentio.SegmentFileName("", segment.GetID()))] = size
+		return nil
+	}
+	if err := db.Catalog.RecurLoop(processor); err != nil {
+		return err
+	}
+	dir := filepath.Join(db.Dir, DATADir)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		size, ok := sizes[entry.Name()]
+		if !ok {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			size = info.Size()
+		}
+		if err = backupFile(w, filepath.Join(dir, entry.Name()), DATADir+"/"+entry.Name(), size, manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logSnapshot is the version files of a log store opened with their sizes.
+// The files are opened as the compaction may remove the old ones, the files
+// removed before they are opened were merged into the later checkpoints.
+type logSnapshot struct {
+	files []*os.File
+	sizes []int64
+}
+
+// snapshotLogStore opens the version files of the log store and takes their
+// sizes. The data is only written by the syncs, the last file may have a
+// partial tail which is skipped by the replay.
+func snapshotLogStore(dir, name string) (snapshot *logSnapshot, err error) {
+	paths, err := store.VersionFiles(dir, name)
+	if err != nil {
+		return
+	}
+	snapshot = new(logSnapshot)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			snapshot.Close()
+			return nil, err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			snapshot.Close()
+			return nil, err
+		}
+		snapshot.files = append(snapshot.files, f)
+		snapshot.sizes = append(snapshot.sizes, info.Size())
+	}
+	return
+}
+
+func (snapshot *logSnapshot) Close() {
+	for _, f := range snapshot.files {
+		f.Close()
+	}
+	snapshot.files = nil
+}
+
+// backupLogStore copies the version files of a log store up to the sizes of
+// the snapshot, what is written after the snapshot is not restored.
+func backupLogStore(w backupWriter, snapshot *logSnapshot, manifest *Manifest) error {
+	for i, f := range snapshot.files {
+		name := filepath.Base(f.Name())
+		if err := copyFile(w, name, f, snapshot.sizes[i]); err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, &FileManifest{Name: name, Size: snapshot.sizes[i]})
+	}
+	return nil
+}
+
+func backupFile(w backupWriter, path, name string, size int64, manifest *Manifest) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = copyFile(w, name, f, size); err != nil {
+		return err
+	}
+	manifest.Files = append(manifest.Files, &FileManifest{Name: name, Size: size})
+	return nil
+}
+
+// copyFile writes the first size bytes of r as the file name of the backup.
+func copyFile(w backupWriter, name string, r io.Reader, size int64) error {
+	fw, err := w.Create(name, size)
+	if err != nil {
+		return err
+	}
+	if _, err = io.CopyN(fw, r, size); err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+// Restore creates the db in dirname from the backup with the databases,
+// tables and rows visible at ts and opens it, a zero ts restores to the ts
+// of the backup. The db at the ts of the backup is opened from the copied
+// files and the replayed catalog is checked against the manifest, the db at
+// an earlier ts is rebuilt from the row versions of the history. dirname is
+// removed if the files of the backup can not be extracted.
+func Restore(backup, dirname string, ts uint64, opts *options.Options) (db *DB, err error) {
+	if entries, err := os.ReadDir(dirname); err == nil && len(entries) > 0 {
+		return nil, ErrRestoreDirExists
+	}
+	manifest, err := extractBackup(backup, dirname)
+	if err != nil {
+		os.RemoveAll(dirname)
+		return
+	}
+	if ts == 0 {
+		ts = manifest.TS
+	}
+	if ts < manifest.CheckpointTS || ts > manifest.TS {
+		os.RemoveAll(dirname)
+		return nil, ErrRestoreTS
+	}
+	history := filepath.Join(dirname, BackupHistoryDir)
+	if ts == manifest.TS {
+		if err = os.RemoveAll(history); err != nil {
+			return
+		}
+		if db, err = Open(dirname, opts); err != nil {
+			return
+		}
+		if err = checkRestored(db.Catalog, manifest); err != nil {
+			db.Close()
+			return nil, err
+		}
+		return
+	}
+
+	// The copied files hold the db at the ts of the backup, the db is
+	// rebuilt from the history instead
+	for _, file := range manifest.Files {
+		if !strings.HasPrefix(file.Name, BackupHistoryDir+"/") {
+			if err = os.Remove(filepath.Join(dirname, filepath.FromSlash(file.Name))); err != nil {
+				return
+			}
+		}
+	}
+	if err = os.RemoveAll(filepath.Join(dirname, DATADir)); err != nil {
+		return
+	}
+	if db, err = Open(dirname, opts); err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			db.Close()
+			db = nil
+		}
+	}()
+	for _, database := range manifest.Databases {
+		if !visibleBetween(database.CreateAt, database.DeleteAt, ts) {
+			continue
+		}
+		txn := db.StartTxn(nil)
+		if _, err = txn.CreateDatabase(database.Name); err != nil {
+			txn.Rollback()
+			return
+		}
+		if err = txn.Commit(); err != nil {
+			return
+		}
+		for _, table := range database.Tables {
+			if !visibleBetween(table.CreateAt, table.DeleteAt, ts) {
+				continue
+			}
+			if err = restoreTable(db, database.Name, table, filepath.Join(dirname, filepath.FromSlash(historyFile(table.ID))), ts); err != nil {
+				return
+			}
+		}
+	}
+	err = os.RemoveAll(history)
+	return
+}
+
+// visibleBetween returns true if an entry created at createAt and deleted
+// at deleteAt is visible at ts.
+func visibleBetween(createAt, deleteAt, ts uint64) bool {
+	return createAt <= ts && (deleteAt == 0 || deleteAt > ts)
+}
+
+// restoreTable creates the table and appends the row versions of the history
+// file visible at ts.
+func restoreTable(db *DB, dbName string, table *TableManifest, path string, ts uint64) (err error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	schema := catalog.NewEmptySchema(table.Name)
+	if _, err = schema.ReadFrom(bytes.NewReader(table.Schema)); err != nil {
+		return
+	}
+	txn := db.StartTxn(nil)
+	defer func() {
+		if err != nil {
+			txn.Rollback()
+		}
+	}()
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		return
+	}
+	rel, err := database.CreateRelation(schema)
+	if err != nil {
+		return
+	}
+	for i := 0; i < table.Blocks; i++ {
+		if len(buf) < 4 {
+			return ErrInvalidBackup
+		}
+		size := int(encoding.DecodeUint32(buf[:4]))
+		if len(buf) < 4+size {
+			return ErrInvalidBackup
+		}
+		view := model.NewVersionView(0)
+		if err = view.Unmarshal(buf[4:4+size], schema.Types()); err != nil {
+			return
+		}
+		buf = buf[4+size:]
+		sels := view.VisibleRows(ts)
+		if len(sels) == 0 {
+			continue
+		}
+		bat := gbat.New(true, schema.Attrs())
+		for colIdx, vec := range view.Columns {
+			if len(sels) < view.Length() {
+				gvec.Shrink(vec, sels)
+			}
+			bat.Vecs[colIdx] = vec
+		}
+		if err = rel.Append(bat); err != nil {
+			return
+		}
+	}
+	return txn.Commit()
+}
+
+// checkRestored checks the databases and the tables of the manifest visible
+// at the ts of the backup are replayed by the catalog.
+func checkRestored(c *catalog.Catalog, manifest *Manifest) error {
+	restored := make(map[uint64]*DatabaseManifest)
+	for _, database := range collectDatabases(c, manifest.TS) {
+		restored[database.ID] = database
+	}
+	for _, database := range manifest.Databases {
+		if !visibleBetween(database.CreateAt, database.DeleteAt, manifest.TS) {
+			continue
+		}
+		other, ok := restored[database.ID]
+		if !ok || other.Name != database.Name {
+			return ErrRestoreReplay
+		}
+		tables := make(map[uint64]string)
+		for _, table := range other.Tables {
+			tables[table.ID] = table.Name
+		}
+		for _, table := range database.Tables {
+			if !visibleBetween(table.CreateAt, table.DeleteAt, manifest.TS) {
+				continue
+			}
+			if name, ok := tables[table.ID]; !ok || name != table.Name {
+				return ErrRestoreReplay
+			}
+		}
+	}
+	return nil
+}
+
+// extractBackup copies the files of the backup into dirname and returns the
+// manifest. The manifest is written last by Backup, the backup is invalid
+// if it is missing or a file does not match it.
+func extractBackup(backup, dirname string) (manifest *Manifest, err error) {
+	r, err := newBackupReader(backup)
+	if err != nil {
+		return
+	}
+	defer r.Close()
+	sizes := make(map[string]int64)
+	for {
+		name, fr, err := r.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if name == BackupManifest {
+			manifest = new(Manifest)
+			if err = json.NewDecoder(fr).Decode(manifest); err != nil {
+				return nil, ErrInvalidBackup
+			}
+			continue
+		}
+		if sizes[name], err = extractFile(filepath.Join(dirname, filepath.FromSlash(name)), fr); err != nil {
+			return nil, err
+		}
+	}
+	if manifest == nil || manifest.Version != BackupVersion || len(manifest.Files) != len(sizes) {
+		return nil, ErrInvalidBackup
+	}
+	for _, file := range manifest.Files {
+		if size, ok := sizes[file.Name]; !ok || size != file.Size {
+			return nil, ErrInvalidBackup
+		}
+	}
+	return
+}
+
+func extractFile(path string, r io.Reader) (n int64, err error) {
+	if err = os.MkdirAll(filepath.Dir(path), os.FileMode(0755)); err != nil {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, os.FileMode(0644))
+	if err != nil {
+		return
+	}
+	if n, err = io.Copy(f, r); err != nil {
+		f.Close()
+		return
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return
+	}
+	err = f.Close()
+	return
+}
+
+type backupWriter interface {
+	io.Closer
+	// Create returns the writer of the file name of size bytes, the
+	// previous file must be closed before.
+	Create(name string, size int64) (io.WriteCloser, error)
+}
+
+type backupReader interface {
+	io.Closer
+	// Next returns the name and the reader of the next file, it returns
+	// io.EOF after the last file.
+	Next() (string, io.Reader, error)
+}
+
+type dirWriter struct {
+	dir string
+}
+
+type tarWriter struct {
+	file *os.File
+	gz   *gzip.Writer
+	tw   *tar.Writer
+}
+
+type dirReader struct {
+	dir   string
+	names []string
+	file  *os.File
+}
+
+type tarReader struct {
+	file *os.File
+	gz   *gzip.Reader
+	tr   *tar.Reader
+}
+
+// nopWriteCloser is the writer of a file of a tarball, which is closed by
+// the next header.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func isTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+func newBackupWriter(target string) (backupWriter, error) {
+	if _, err := os.Stat(target); err == nil {
+		if !isTarball(target) {
+			if entries, err := os.ReadDir(target); err == nil && len(entries) == 0 {
+				return &dirWriter{dir: target}, nil
+			}
+		}
+		return nil, ErrBackupExists
+	}
+	if !isTarball(target) {
+		if err := os.MkdirAll(target, os.FileMode(0755)); err != nil {
+			return nil, err
+		}
+		return &dirWriter{dir: target}, nil
+	}
+	file, err := os.OpenFile(target, os.O_RDWR|os.O_CREATE|os.O_EXCL, os.FileMode(0644))
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(file)
+	return &tarWriter{
+		file: file,
+		gz:   gz,
+		tw:   tar.NewWriter(gz),
+	}, nil
+}
+
+func (w *dirWriter) Create(name string, size int64) (io.WriteCloser, error) {
+	path := filepath.Join(w.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), os.FileMode(0755)); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, os.FileMode(0644))
+}
+
+func (w *dirWriter) Close() error { return nil }
+
+func (w *tarWriter) Create(name string, size int64) (io.WriteCloser, error) {
+	hdr := &tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: size,
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return nil, err
+	}
+	return nopWriteCloser{w.tw}, nil
+}
+
+func (w *tarWriter) Close() error {
+	if err := w.tw.Close(); err != nil {
+		w.file.Close()
+		return err
+	}
+	if err := w.gz.Close(); err != nil {
+		w.file.Close()
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		w.file.Close()
+		return err
+	}
+	return w.file.Close()
+}
+
+func newBackupReader(path string) (backupReader, error) {
+	if !isTarball(path) {
+		r := &dirReader{dir: path}
+		err := filepath.Walk(path, func(name string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(path, name)
+			if err != nil {
+				return err
+			}
+			r.names = append(r.names, filepath.ToSlash(rel))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &tarReader{
+		file: file,
+		gz:   gz,
+		tr:   tar.NewReader(gz),
+	}, nil
+}
+
+func (r *dirReader) Next() (string, io.Reader, error) {
+	if r.file != nil {
+		r.file.Close()
+		r.file = nil
+	}
+	if len(r.names) == 0 {
+		return "", nil, io.EOF
+	}
+	name := r.names[0]
+	r.names = r.names[1:]
+	file, err := os.Open(filepath.Join(r.dir, filepath.FromSlash(name)))
+	if err != nil {
+		return "", nil, err
+	}
+	r.file = file
+	return name, file, nil
+}
+
+func (r *dirReader) Close() error {
+	if r.file != nil {
+		return r.file.Close()
+	}
+	return nil
+}
+
+func (r *tarReader) Next() (string, io.Reader, error) {
+	hdr, err := r.tr.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	return hdr.Name, r.tr, nil
+}
+
+func (r *tarReader) Close() error {
+	r.gz.Close()
+	return r.file.Close()
+}
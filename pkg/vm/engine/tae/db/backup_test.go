@@ -0,0 +1,251 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupRestore(t *testing.T) {
+	newOpts := func() *options.Options {
+		opts := new(options.Options)
+		opts.CheckpointCfg = new(options.CheckpointCfg)
+		opts.CheckpointCfg.ScannerInterval = 10000
+		opts.CheckpointCfg.ExecutionLevels = 20
+		opts.CheckpointCfg.ExecutionInterval = 20000
+		return opts
+	}
+	db := initDB(t, newOpts())
+	defer db.Close()
+	schema := catalog.MockSchema(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 0
+	bat := compute.MockBatch(schema.Types(), 25, int(schema.PrimaryKey), nil)
+	filter := func(row uint32) *handle.Filter {
+		return &handle.Filter{Op: handle.FilterEq, Val: compute.GetValue(bat.Vecs[schema.PrimaryKey], row)}
+	}
+
+	rows := func(rel handle.Relation) (n int) {
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			view, err := it.GetBlock().GetColumnDataById(int(schema.PrimaryKey), nil, nil)
+			assert.Nil(t, err)
+			n += gvec.Length(view.AppliedVec)
+			if view.DeleteMask != nil {
+				n -= int(view.DeleteMask.GetCardinality())
+			}
+			it.Next()
+		}
+		return
+	}
+
+	schema2 := catalog.MockSchema(2)
+	schema2.BlockMaxRows = 10
+	schema2.SegmentMaxBlocks = 2
+
+	var tss []uint64
+	{
+		txn := db.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+		tss = append(tss, txn.GetCommitTS())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		id, offset, err := rel.GetByFilter(filter(2))
+		assert.Nil(t, err)
+		assert.Nil(t, rel.RangeDelete(id, offset, offset))
+		assert.Nil(t, txn.Commit())
+		tss = append(tss, txn.GetCommitTS())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		id, offset, err := rel.GetByFilter(filter(15))
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Update(id, offset, 1, int32(9999)))
+		assert.Nil(t, txn.Commit())
+		tss = append(tss, txn.GetCommitTS())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, err := txn.CreateDatabase("db2")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema2)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(compute.MockBatch(schema2.Types(), 5, 0, nil)))
+		assert.Nil(t, txn.Commit())
+		tss = append(tss, txn.GetCommitTS())
+	}
+
+	for i, target := range []string{
+		filepath.Join(db.Dir, "backup"),
+		filepath.Join(db.Dir, "backup.tar.gz"),
+	} {
+		manifest, err := db.Backup(target)
+		assert.Nil(t, err)
+		assert.True(t, manifest.TS >= tss[3])
+		assert.Equal(t, 2, len(manifest.Databases))
+		_, err = db.Backup(target)
+		assert.Equal(t, ErrBackupExists, err)
+
+		restored, err := Restore(target, filepath.Join(db.Dir, fmt.Sprintf("restore-%d", i)), 0, newOpts())
+		assert.Nil(t, err)
+		txn := restored.StartTxn(nil)
+		database, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 24, rows(rel))
+		_, _, err = rel.GetByFilter(filter(2))
+		assert.NotNil(t, err)
+		id, offset, err := rel.GetByFilter(filter(15))
+		assert.Nil(t, err)
+		v, err := rel.GetValue(id, offset, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(9999), v)
+		assert.Nil(t, txn.Commit())
+		txn = restored.StartTxn(nil)
+		database, err = txn.GetDatabase("db2")
+		assert.Nil(t, err)
+		rel, err = database.GetRelationByName(schema2.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 5, rows(rel))
+		assert.Nil(t, txn.Commit())
+		restored.Close()
+
+		_, err = Restore(target, filepath.Join(db.Dir, fmt.Sprintf("restore-%d", i)), 0, newOpts())
+		assert.Equal(t, ErrRestoreDirExists, err)
+	}
+
+	// The writes after the backup are not restored
+	{
+		txn := db.StartTxn(nil)
+		_, err := txn.CreateDatabase("db3")
+		assert.Nil(t, err)
+		assert.Nil(t, txn.Commit())
+		restored, err := Restore(filepath.Join(db.Dir, "backup"), filepath.Join(db.Dir, "restore-2"), 0, newOpts())
+		assert.Nil(t, err)
+		txn = restored.StartTxn(nil)
+		_, err = txn.GetDatabase("db3")
+		assert.NotNil(t, err)
+		assert.Nil(t, txn.Commit())
+		restored.Close()
+	}
+
+	// The first backup is restored to the ts of each txn committed before
+	// it, the second one is restorable since the checkpoint of the first
+	{
+		backup := filepath.Join(db.Dir, "backup")
+		restore := func(ts uint64) *DB {
+			restored, err := Restore(backup, filepath.Join(db.Dir, fmt.Sprintf("pitr-%d", ts)), ts, newOpts())
+			assert.Nil(t, err)
+			return restored
+		}
+		value := func(rel handle.Relation, row uint32) interface{} {
+			id, offset, err := rel.GetByFilter(filter(row))
+			assert.Nil(t, err)
+			v, err := rel.GetValue(id, offset, 1)
+			assert.Nil(t, err)
+			return v
+		}
+
+		restored := restore(tss[0])
+		txn := restored.StartTxn(nil)
+		database, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 25, rows(rel))
+		assert.Equal(t, compute.GetValue(bat.Vecs[1], 15), value(rel, 15))
+		_, err = txn.GetDatabase("db2")
+		assert.NotNil(t, err)
+		assert.Nil(t, txn.Commit())
+		restored.Close()
+
+		restored = restore(tss[2])
+		txn = restored.StartTxn(nil)
+		database, err = txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err = database.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 24, rows(rel))
+		_, _, err = rel.GetByFilter(filter(2))
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(9999), value(rel, 15))
+		_, err = txn.GetDatabase("db2")
+		assert.NotNil(t, err)
+		assert.Nil(t, txn.Commit())
+		restored.Close()
+
+		// The restored db is opened again from its own files
+		restored, err = Open(filepath.Join(db.Dir, fmt.Sprintf("pitr-%d", tss[2])), newOpts())
+		assert.Nil(t, err)
+		txn = restored.StartTxn(nil)
+		database, err = txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err = database.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, 24, rows(rel))
+		assert.Nil(t, txn.Commit())
+		restored.Close()
+
+		dir := filepath.Join(db.Dir, "pitr-out")
+		_, err = Restore(backup, dir, db.Scheduler.GetSafeTS()+1, newOpts())
+		assert.Equal(t, ErrRestoreTS, err)
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+		_, err = Restore(filepath.Join(db.Dir, "backup.tar.gz"), dir, tss[0], newOpts())
+		assert.Equal(t, ErrRestoreTS, err)
+	}
+
+	// A backup without the manifest is not complete
+	{
+		assert.Nil(t, os.Remove(filepath.Join(db.Dir, "backup", BackupManifest)))
+		dir := filepath.Join(db.Dir, "restore-3")
+		_, err := Restore(filepath.Join(db.Dir, "backup"), dir, 0, newOpts())
+		assert.Equal(t, ErrInvalidBackup, err)
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestBackupEmpty(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	target := filepath.Join(db.Dir, "backup")
+	_, err := db.Backup(target)
+	assert.Equal(t, ErrBackupEmpty, err)
+	_, err = os.Stat(target)
+	assert.True(t, os.IsNotExist(err))
+}
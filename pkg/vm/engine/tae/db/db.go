@@ -77,22 +77,42 @@ func (db *DB) startWorkers() (err error) {
 	return
 }
 
-func (db *DB) stopWorkers() (err error) {
-	db.TimedScanner.Stop()
-	db.CKPDriver.Stop()
-	return
-}
-
 func (db *DB) Close() error {
 	if err := db.Closed.Load(); err != nil {
 		panic(err)
 	}
-	db.stopWorkers()
+	db.TimedScanner.Stop()
+	// Flush the blocks and checkpoint the catalog, Open reattaches the
+	// checkpointed entries to what was flushed
+	err := db.flushAll()
+	if err == nil {
+		err = db.Catalog.Checkpoint(db.Scheduler.GetSafeTS())
+	}
+	db.CKPDriver.Stop()
 	db.Closed.Store(ErrClosed)
 	close(db.ClosedC)
 	db.Scheduler.Stop()
 	db.TxnMgr.Stop()
 	db.Wal.Close()
 	db.Opts.Catalog.Close()
-	return db.DBLocker.Close()
+	if lockErr := db.DBLocker.Close(); err == nil {
+		err = lockErr
+	}
+	return err
+}
+
+// flushAll flushes the committed changes of the blocks to the block files.
+// The blocks not created yet and the dropped blocks are skipped.
+func (db *DB) flushAll() error {
+	processor := new(catalog.LoopProcessor)
+	processor.BlockFn = func(block *catalog.BlockEntry) error {
+		block.RLock()
+		skip := block.IsDroppedCommitted() || !block.HasCreated()
+		block.RUnlock()
+		if skip || block.GetBlockData() == nil {
+			return nil
+		}
+		return block.GetBlockData().ForceCompact()
+	}
+	return db.Catalog.RecurLoop(processor)
 }
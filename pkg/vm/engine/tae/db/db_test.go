@@ -1301,3 +1301,164 @@ func TestLogIndex1(t *testing.T) {
 		assert.Nil(t, txn.Commit())
 	}
 }
+
+func TestReopen1(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchemaAll(3)
+	schema.PrimaryKey = 2
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := compute.MockBatch(schema.Types(), 25, int(schema.PrimaryKey), nil)
+	pkVal := func(row uint32) interface{} {
+		return compute.GetValue(bat.Vecs[schema.PrimaryKey], row)
+	}
+
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.CreateDatabase("db")
+		rel, _ := db.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	// Compact the first block to a non-appendable block
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		blk := rel.MakeBlockIt().GetBlock()
+		blkData := blk.GetMeta().(*catalog.BlockEntry).GetBlockData()
+		factory, taskType, scopes, err := blkData.BuildCompactionTaskFactory()
+		assert.Nil(t, err)
+		task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+		assert.Nil(t, err)
+		assert.Nil(t, task.WaitDone())
+		assert.Nil(t, txn.Commit())
+	}
+	// Delete and update rows of both the non-appendable and the appendable blocks
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		for _, row := range []uint32{5, 22} {
+			id, offset, err := rel.GetByFilter(handle.NewEQFilter(pkVal(row)))
+			assert.Nil(t, err)
+			assert.Nil(t, rel.RangeDelete(id, offset, offset))
+		}
+		for _, row := range []uint32{3, 15} {
+			id, offset, err := rel.GetByFilter(handle.NewEQFilter(pkVal(row)))
+			assert.Nil(t, err)
+			assert.Nil(t, rel.Update(id, offset, 0, int8(-1)))
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	dir := tae.Dir
+	assert.Nil(t, tae.Close())
+
+	// The index nodes of the closed db are still registered in the mock
+	// index buffer manager
+	idxCommon.MockIndexBufferManager = buffer.NewNodeManager(1024*1024*150, nil)
+	tae, err := Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	t.Log(tae.Catalog.SimplePPString(common.PPL1))
+	checkRows := func(expectRows int) {
+		txn := tae.StartTxn(nil)
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		rows := 0
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			view, err := it.GetBlock().GetColumnDataById(int(schema.PrimaryKey), nil, nil)
+			assert.Nil(t, err)
+			rows += gvec.Length(view.AppliedVec)
+			if view.DeleteMask != nil {
+				rows -= int(view.DeleteMask.GetCardinality())
+			}
+			it.Next()
+		}
+		assert.Equal(t, expectRows, rows)
+		for _, row := range []uint32{5, 22} {
+			_, _, err = rel.GetByFilter(handle.NewEQFilter(pkVal(row)))
+			assert.NotNil(t, err)
+		}
+		for _, row := range []uint32{3, 15, 24} {
+			id, offset, err := rel.GetByFilter(handle.NewEQFilter(pkVal(row)))
+			assert.Nil(t, err)
+			v, err := rel.GetValue(id, offset, 0)
+			assert.Nil(t, err)
+			if row == 24 {
+				assert.Equal(t, compute.GetValue(bat.Vecs[0], row), v)
+			} else {
+				assert.Equal(t, int8(-1), v)
+			}
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	checkRows(23)
+
+	// The reopened db keeps allocating ids and timestamps after the replayed ones
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		more := compute.MockBatch(schema.Types(), 35, int(schema.PrimaryKey), nil)
+		more = compute.SplitBatch(more, 7)[5]
+		assert.Nil(t, rel.Append(more))
+		assert.Nil(t, txn.Commit())
+	}
+	checkRows(28)
+}
+
+func TestReopenStats(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchemaAll(3)
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.CreateDatabase("db")
+		_, err := db.CreateRelation(schema)
+		assert.Nil(t, err)
+		assert.Nil(t, txn.Commit())
+	}
+	updateStats := func(stats []byte) {
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		meta := rel.GetMeta().(*catalog.TableEntry)
+		prev := meta.GetStats()
+		assert.Nil(t, rel.UpdateStats(stats))
+		// The statistics are replaced when the txn commits
+		assert.Equal(t, prev, meta.GetStats())
+		assert.Nil(t, txn.Commit())
+		assert.Equal(t, stats, meta.GetStats())
+	}
+	reopen := func() {
+		dir := tae.Dir
+		assert.Nil(t, tae.Close())
+		idxCommon.MockIndexBufferManager = buffer.NewNodeManager(1024*1024*150, nil)
+		var err error
+		tae, err = Open(dir, nil)
+		assert.Nil(t, err)
+	}
+	checkStats := func(stats []byte) {
+		txn := tae.StartTxn(nil)
+		db, err := txn.GetDatabase("db")
+		assert.Nil(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, stats, rel.GetMeta().(*catalog.TableEntry).GetStats())
+		assert.Nil(t, txn.Commit())
+	}
+
+	// The statistics are checkpointed along with the created table
+	updateStats([]byte("stats1"))
+	reopen()
+	checkStats([]byte("stats1"))
+
+	// The table checkpointed before is logged again with the new statistics
+	updateStats([]byte("stats2"))
+	reopen()
+	defer tae.Close()
+	checkStats([]byte("stats2"))
+}
@@ -65,7 +65,9 @@ func TestGCBlock1(t *testing.T) {
 	blkData.Destroy()
 	assert.Equal(t, 0, tae.MTBufMgr.Count())
 
-	assert.Equal(t, 2, idxCommon.MockIndexBufferManager.Count())
+	// the zonemap and the static filter of the primary key and the zonemaps
+	// of the other columns
+	assert.Equal(t, 2+len(schema.ColDefs)-1, idxCommon.MockIndexBufferManager.Count())
 	err = task.GetNewBlock().GetMeta().(*catalog.BlockEntry).GetBlockData().Destroy()
 	assert.Nil(t, err)
 	assert.Equal(t, 0, idxCommon.MockIndexBufferManager.Count())
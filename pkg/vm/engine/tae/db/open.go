@@ -15,13 +15,15 @@
 package db
 
 import (
+	"path/filepath"
 	"sync/atomic"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
-	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/segmentio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	w "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker"
@@ -33,6 +35,7 @@ import (
 const (
 	WALDir     = "wal"
 	CATALOGDir = "catalog"
+	DATADir    = "data"
 )
 
 func Open(dirname string, opts *options.Options) (db *DB, err error) {
@@ -62,16 +65,40 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		Closed:      new(atomic.Value),
 	}
 
-	db.Wal = wal.NewDriver(dirname, WALDir, nil)
+	// The entries of the wal were checkpointed before the db was closed, the
+	// replay only restores the lsn of the groups
+	walStore, err := store.NewBaseStore(dirname, WALDir, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = walStore.Replay(noopApplyHandle); err != nil {
+		walStore.Close()
+		return nil, err
+	}
+	db.Wal = wal.NewDriverWithStore(walStore, true)
 	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
-	dataFactory := tables.NewDataFactory(mockio.SegmentFileMockFactory, mutBufMgr, db.Scheduler)
-	db.Opts.Catalog = catalog.MockCatalog(dirname, CATALOGDir, nil, db.Scheduler)
+	dataFactory := tables.NewDataFactory(segmentio.SegmentFileIOFactory, mutBufMgr, db.Scheduler, filepath.Join(dirname, DATADir))
+	if db.Opts.Catalog, err = catalog.OpenCatalog(dirname, CATALOGDir, nil, db.Scheduler); err != nil {
+		db.Scheduler.Stop()
+		db.Wal.Close()
+		return nil, err
+	}
 	db.Catalog = db.Opts.Catalog
 
+	// Reattach the replayed entries to their segment and block files
+	maxTs, err := reattachData(db.Catalog, dataFactory)
+	if err != nil {
+		db.Scheduler.Stop()
+		db.Wal.Close()
+		db.Catalog.Close()
+		return nil, err
+	}
+
 	// Init and start txn manager
 	txnStoreFactory := txnimpl.TxnStoreFactory(db.Opts.Catalog, db.Wal, txnBufMgr, dataFactory)
 	txnFactory := txnimpl.TxnFactory(db.Opts.Catalog)
 	db.TxnMgr = txnbase.NewTxnManager(txnStoreFactory, txnFactory)
+	db.TxnMgr.Init(0, maxTs)
 	db.TxnMgr.Start()
 
 	db.DBLocker, dbLocker = dbLocker, nil
@@ -95,3 +122,41 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 
 	return
 }
+
+func noopApplyHandle(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) (err error) {
+	return
+}
+
+// reattachData creates the data of the entries replayed from the catalog
+// checkpoints and returns the max ts of the catalog and the block files.
+// Only what was flushed and checkpointed is restored, the data of the txns
+// committed after the last checkpoint of a crashed db is not replayed from
+// the wal.
+func reattachData(c *catalog.Catalog, dataFactory *tables.DataFactory) (maxTs uint64, err error) {
+	maxTs = c.GetCheckpointed().MaxTS
+	processor := new(catalog.LoopProcessor)
+	processor.TableFn = func(table *catalog.TableEntry) (err error) {
+		if table.GetTableData() == nil {
+			table.InitData(dataFactory.MakeTableFactory())
+		}
+		return
+	}
+	processor.SegmentFn = func(segment *catalog.SegmentEntry) (err error) {
+		if segment.GetSegmentData() == nil {
+			segment.InitData(dataFactory.MakeSegmentFactory())
+		}
+		return
+	}
+	processor.BlockFn = func(block *catalog.BlockEntry) (err error) {
+		if block.GetBlockData() == nil {
+			segFile := block.GetSegment().GetSegmentData().GetSegmentFile()
+			block.InitData(dataFactory.MakeBlockFactory(segFile))
+		}
+		if ts := block.GetBlockData().GetMaxCheckpointTS(); ts > maxTs {
+			maxTs = ts
+		}
+		return
+	}
+	err = c.RecurLoop(processor)
+	return
+}
@@ -16,22 +16,29 @@ package db
 
 import (
 	"bytes"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	ops "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/panjf2000/ants/v2"
 	"github.com/stretchr/testify/assert"
@@ -48,7 +55,7 @@ func TestTables1(t *testing.T) {
 	rel, _ := database.CreateRelation(schema)
 	tableMeta := rel.GetMeta().(*catalog.TableEntry)
 
-	dataFactory := tables.NewDataFactory(mockio.SegmentFileMockFactory, db.MTBufMgr, db.Scheduler)
+	dataFactory := tables.NewDataFactory(mockio.SegmentFileMockFactory, db.MTBufMgr, db.Scheduler, db.Dir)
 	tableFactory := dataFactory.MakeTableFactory()
 	table := tableFactory(tableMeta)
 	handle := table.GetHandle()
@@ -698,3 +705,337 @@ func TestMergeBlocks2(t *testing.T) {
 	t.Logf("Checkpointed: %d", tae.Wal.GetCheckpointed())
 	t.Logf("PendingCnt: %d", tae.Wal.GetPenddingCnt())
 }
+
+func TestCompositeKey(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	schema.SetCompositeKey(12, 2)
+	assert.True(t, schema.Valid())
+
+	col12 := gvec.New(schema.ColDefs[12].Type)
+	col2 := gvec.New(schema.ColDefs[2].Type)
+	for i := 0; i < 20; i++ {
+		compute.AppendValue(col12, []byte(fmt.Sprintf("k%d", i%4)))
+		compute.AppendValue(col2, int32(i/4))
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(12, col12)
+	provider.AddColumnProvider(2, col2)
+	bat := compute.MockBatch(schema.Types(), 20, -1, provider)
+
+	// the key of the 9th row is ("k1", 2)
+	filter := handle.NewEQFilter(compute.EncodeKey([]byte("k1"), int32(2)))
+	checkRow := func(rel handle.Relation) {
+		id, row, err := rel.GetByFilter(filter)
+		assert.Nil(t, err)
+		v, err := rel.GetValue(id, row, 3)
+		assert.Nil(t, err)
+		assert.Equal(t, compute.GetValue(bat.Vecs[3], 9), v)
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		checkRow(rel)
+		assert.NotNil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		checkRow(rel)
+		assert.NotNil(t, rel.Append(bat))
+		assert.Nil(t, txn.Rollback())
+	}
+
+	// compact the blocks and merge them
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		for _, meta := range blks {
+			task, err := jobs.NewCompactBlockTask(nil, txn, meta, db.Scheduler)
+			assert.Nil(t, err)
+			assert.Nil(t, task.OnExec())
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		checkRow(rel)
+		assert.NotNil(t, rel.Append(bat))
+		assert.Nil(t, txn.Rollback())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		assert.Equal(t, 2, len(blks))
+		factory := jobs.CompactSegmentTaskFactory(blks, db.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		checkRow(rel)
+		assert.NotNil(t, rel.Append(bat))
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blk := it.GetBlock()
+			view12, _ := blk.GetColumnDataById(12, nil, nil)
+			view2, _ := blk.GetColumnDataById(2, nil, nil)
+			keys := compute.EncodeKeys([]*gvec.Vector{view12.AppliedVec, view2.AppliedVec})
+			for i := 1; i < gvec.Length(keys); i++ {
+				prev := compute.GetValue(keys, uint32(i-1)).(string)
+				curr := compute.GetValue(keys, uint32(i)).(string)
+				assert.True(t, prev < curr)
+			}
+			it.Next()
+		}
+		assert.Nil(t, txn.Rollback())
+	}
+}
+
+func TestNullableColumn(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	schema := catalog.NewEmptySchema("nullable")
+	schema.AppendCol("pk", types.Type{Oid: types.T_int32, Size: 4, Width: 32})
+	schema.AppendColWithDefault("i64", types.Type{Oid: types.T_int64, Size: 8, Width: 64}, true, catalog.Default{Exist: true})
+	schema.AppendColWithDefault("str", types.Type{Oid: types.T_varchar, Size: 24, Width: 100}, true, catalog.Default{Exist: true, Value: []byte("none")})
+	schema.AppendCol("u8", types.Type{Oid: types.T_uint8, Size: 1, Width: 8})
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	assert.True(t, schema.Valid())
+
+	// every third row of the nullable columns is null
+	i64 := gvec.New(schema.ColDefs[1].Type)
+	str := gvec.New(schema.ColDefs[2].Type)
+	for i := 0; i < 20; i++ {
+		if i%3 == 0 {
+			compute.AppendValue(i64, nil)
+			compute.AppendValue(str, nil)
+		} else {
+			compute.AppendValue(i64, int64(i))
+			compute.AppendValue(str, []byte(fmt.Sprintf("s%d", i)))
+		}
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(1, i64)
+	provider.AddColumnProvider(2, str)
+	bat := compute.MockBatch(schema.Types(), 20, 0, provider)
+
+	checkRows := func(rel handle.Relation) {
+		for i := 0; i < 20; i++ {
+			filter := handle.NewEQFilter(compute.GetValue(bat.Vecs[0], uint32(i)))
+			id, row, err := rel.GetByFilter(filter)
+			assert.Nil(t, err)
+			v1, err := rel.GetValue(id, row, 1)
+			assert.Nil(t, err)
+			v2, err := rel.GetValue(id, row, 2)
+			assert.Nil(t, err)
+			if i%3 == 0 {
+				assert.Nil(t, v1)
+				assert.Nil(t, v2)
+			} else {
+				assert.Equal(t, int64(i), v1)
+				assert.NotNil(t, v2)
+			}
+		}
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		notNull := compute.MockBatch(schema.Types(), 20, 0, provider)
+		nulls.Add(notNull.Vecs[3].Nsp, 1)
+		assert.Equal(t, catalog.ErrNotNullable, rel.Append(notNull))
+		assert.Nil(t, rel.Append(bat))
+		checkRows(rel)
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		checkRows(rel)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		for _, meta := range blks {
+			task, err := jobs.NewCompactBlockTask(nil, txn, meta, db.Scheduler)
+			assert.Nil(t, err)
+			assert.Nil(t, task.OnExec())
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		checkRows(rel)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		factory := jobs.CompactSegmentTaskFactory(blks, db.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		checkRows(rel)
+		assert.Nil(t, txn.Commit())
+	}
+
+	// the omitted columns are filled with the defaults
+	vecs, err := schema.FillDefaults([]string{"u8", "pk"}, []*gvec.Vector{bat.Vecs[3], bat.Vecs[0]})
+	assert.Nil(t, err)
+	assert.Equal(t, bat.Vecs[0], vecs[0])
+	assert.Nil(t, compute.GetValue(vecs[1], 5))
+	assert.Equal(t, "none", compute.GetValue(vecs[2], 5))
+	_, err = schema.FillDefaults([]string{"i64"}, []*gvec.Vector{bat.Vecs[1]})
+	assert.Equal(t, catalog.ErrNoDefault, err)
+}
+
+func TestFullTextIndex(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+
+	worker := ops.NewOpWorker("xx")
+	worker.Start()
+	defer worker.Stop()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 8
+	schema.PrimaryKey = 2
+	schema.Indexes = []*catalog.IndexInfo{catalog.NewIndexInfo("ft", catalog.FullText, 12)}
+	texts := gvec.New(schema.ColDefs[12].Type)
+	for i := 0; i < 20; i++ {
+		compute.AppendValue(texts, []byte(fmt.Sprintf("apple banana %d", i%3)))
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(12, texts)
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), provider)
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+
+	q := fulltext.ParseQuery("banana 1", fulltext.NaturalLanguage)
+	// check compares the statistics of the indexes of the blocks with those
+	// of the visible rows and returns the number of the flushed indexes
+	check := func() (flushed int) {
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		var corpus fulltext.Corpus
+		expected := fulltext.NewIndex()
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blk := it.GetBlock()
+			idx, err := blk.GetFullTextIndex([]int{12})
+			assert.Nil(t, err)
+			corpus = append(corpus, idx)
+			view, err := blk.GetColumnDataById(12, nil, nil)
+			assert.Nil(t, err)
+			expected.AddVectors([]*gvec.Vector{view.ApplyDeletes()})
+			if !blk.IsAppendableBlock() {
+				metas, err := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile().LoadIndexMeta()
+				assert.Nil(t, err)
+				for _, meta := range metas.Metas {
+					if meta.IdxType == idxCommon.FullTextIndex {
+						flushed++
+					}
+				}
+			}
+			it.Next()
+		}
+		assert.Equal(t, expected.Stats(q), corpus.Stats(q))
+		assert.Nil(t, txn.Commit())
+		return
+	}
+	assert.Equal(t, 0, check())
+
+	// the index is flushed with the compacted block
+	ctx := &tasks.Context{Waitable: true}
+	var newBlockFp *common.ID
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		task, err := jobs.NewCompactBlockTask(ctx, txn, rel.MakeBlockIt().GetBlock().GetMeta().(*catalog.BlockEntry), db.Scheduler)
+		assert.Nil(t, err)
+		worker.SendOp(task)
+		assert.Nil(t, task.WaitDone())
+		newBlockFp = task.GetNewBlock().Fingerprint()
+		assert.Nil(t, txn.Commit())
+	}
+	assert.Equal(t, 1, check())
+
+	// the deleted rows are removed from the flushed index
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		seg, _ := rel.GetSegment(newBlockFp.SegmentID)
+		blk, _ := seg.GetBlock(newBlockFp.BlockID)
+		assert.Nil(t, blk.RangeDelete(1, 2))
+		assert.Nil(t, txn.Commit())
+	}
+	assert.Equal(t, 1, check())
+
+	// the index is flushed with the merged blocks
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		task, err := jobs.NewMergeBlocksTask(ctx, txn, blks, nil, nil, db.Scheduler)
+		assert.Nil(t, err)
+		worker.SendOp(task)
+		assert.Nil(t, task.WaitDone())
+		assert.Nil(t, txn.Commit())
+	}
+	assert.Equal(t, 2, check())
+}
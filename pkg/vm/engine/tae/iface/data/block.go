@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
@@ -61,10 +62,14 @@ type Block interface {
 	GetTotalChanges() int
 	CollectChangesInRange(startTs, endTs uint64) *model.BlockView
 	CollectAppendLogIndexes(startTs, endTs uint64) []*wal.Index
+	CollectVersions(ts uint64) (*model.VersionView, error)
 
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
+	MayContainsByFilter(filter *handle.Filter) bool
+	MayContainsColumnRange(col int, min, max interface{}) bool
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (interface{}, error)
+	GetFullTextIndex(txn txnif.AsyncTxn, cols []int) (*fulltext.Index, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
 
@@ -21,6 +21,7 @@ var (
 	ErrAppendableBlockNotFound   = errors.New("tae: no appendable block")
 	ErrNotAppendable             = errors.New("tae: not appendable")
 	ErrStaleRequest              = errors.New("tae: stale request")
+	ErrBlockClosed               = errors.New("tae: block closed")
 
 	ErrPossibleDuplicate = errors.New("tae: possible duplicate")
 	ErrDuplicate         = errors.New("tae: duplicate")
@@ -18,6 +18,7 @@ import (
 	"io"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -35,7 +36,7 @@ type Block interface {
 	WriteRows(rows uint32) error
 	ReadRows() uint32
 
-	// OpenDeletesFile() common.IRWFile
+	OpenDeletesFile() common.IRWFile
 	WriteDeletes(buf []byte) error
 	ReadDeletes(buf []byte) error
 
@@ -55,6 +56,8 @@ type Block interface {
 
 type ColumnBlock interface {
 	io.Closer
+	// SetCompressAlgo sets the compress algorithm of the column data written later
+	SetCompressAlgo(alg compress.T)
 	WriteTS(ts uint64) error
 	WriteData(buf []byte) error
 	WriteIndex(idx int, buf []byte) error
@@ -21,6 +21,9 @@ type Segment interface {
 	OpenBlock(id uint64, colCnt int, indexCnt map[int]int) (Block, error)
 	WriteTS(ts uint64) error
 	ReadTS() uint64
+	// Snapshot syncs the segment and returns the size of the file, the
+	// prefix of the size is not changed by the later writes.
+	Snapshot() (int64, error)
 	String() string
 	// IsAppendable() bool
 }
@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
@@ -41,6 +42,7 @@ type Filter struct {
 	Op  FilterOp
 	Col *vector.Vector
 	Val interface{}
+	Max interface{} // the upper bound of FilterBtw, Val is the lower bound
 }
 
 func NewEQFilter(v interface{}) *Filter {
@@ -50,6 +52,16 @@ func NewEQFilter(v interface{}) *Filter {
 	}
 }
 
+// NewBtwFilter returns the filter of the keys in [min, max], a nil bound
+// means the range is unbounded on that side.
+func NewBtwFilter(min, max interface{}) *Filter {
+	return &Filter{
+		Op:  FilterBtw,
+		Val: min,
+		Max: max,
+	}
+}
+
 type BlockReader interface {
 	io.Closer
 	ID() uint64
@@ -61,6 +73,16 @@ type BlockReader interface {
 	Fingerprint() *common.ID
 	Rows() int
 	BatchDedup(col *vector.Vector) error
+	// MayContainsByFilter returns false if no primary key of the block
+	// matches the filter, which is checked by the indexes of the block.
+	MayContainsByFilter(filter *Filter) bool
+	// MayContainsColumnRange returns false if no value of the column of the
+	// block is in [min, max], which is checked by the zonemap of the column.
+	// A nil bound means the range is unbounded on that side.
+	MayContainsColumnRange(col int, min, max interface{}) bool
+	// GetFullTextIndex returns the full-text index of the visible rows of the
+	// block on the columns, the documents are numbered by the rows of the block
+	GetFullTextIndex(cols []int) (*fulltext.Index, error)
 
 	IsAppendableBlock() bool
 
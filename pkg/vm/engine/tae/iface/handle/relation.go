@@ -46,6 +46,13 @@ type Relation interface {
 
 	BatchDedup(col *vector.Vector) error
 	Append(data *batch.Batch) error
+	// GetLocalBatches returns the rows appended by the txn which are not committed
+	GetLocalBatches() ([]*batch.Batch, error)
+	// GetStats returns the statistics of the relation collected by analyze
+	GetStats() ([]byte, error)
+	// UpdateStats replaces the statistics of the relation collected by
+	// analyze when the txn commits
+	UpdateStats(stats []byte) error
 
 	GetMeta() interface{}
 	CreateSegment() (Segment, error)
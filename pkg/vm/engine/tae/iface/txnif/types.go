@@ -171,6 +171,9 @@ type TxnStore interface {
 	LogBlockID(tid, bid uint64)
 
 	Append(id uint64, data *batch.Batch) error
+	GetLocalBatches(id uint64) ([]*batch.Batch, error)
+	GetStats(id uint64) ([]byte, error)
+	UpdateStats(id uint64, stats []byte) error
 	// RangeDeleteLocalRows(id uint64, start, end uint32) error
 	// UpdateLocalValue(id uint64, row uint32, col uint16, v interface{}) error
 	// AddUpdateNode(id uint64, node UpdateNode) error
@@ -34,11 +34,13 @@ type IAppendableBlockIndexHolder interface {
 type INonAppendableBlockIndexHolder interface {
 	IBlockIndexHolder
 	MayContainsKey(key interface{}) bool
+	MayContainsColumnRange(col uint16, min, max interface{}) (bool, error)
 	MayContainsAnyKeys(keys *vector.Vector) (error, *roaring.Bitmap)
 	InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error
 }
 
 type IBlockIndexHolder interface {
 	GetHostBlockId() uint64
+	MayContainsRange(min, max interface{}) (bool, error)
 	Destroy() error
 }
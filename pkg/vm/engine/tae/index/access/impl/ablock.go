@@ -69,6 +69,10 @@ func (holder *appendableBlockIndexHolder) Search(key interface{}) (rowOffset uin
 	return rowOffset, nil
 }
 
+func (holder *appendableBlockIndexHolder) MayContainsRange(min, max interface{}) (bool, error) {
+	return holder.zoneMapIndex.MayContainsRange(min, max)
+}
+
 func (holder *appendableBlockIndexHolder) BatchDedup(keys *vector.Vector) error {
 	//logutil.Infof("%v", keys.String())
 	var filter *roaring.Bitmap
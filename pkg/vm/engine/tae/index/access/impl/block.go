@@ -30,12 +30,17 @@ type nonAppendableBlockIndexHolder struct {
 	host              data.Block
 	zoneMapIndex      *io.BlockZoneMapIndexReader
 	staticFilterIndex *io.StaticFilterIndexReader
+	columnZoneMaps    map[uint16]*io.BlockZoneMapIndexReader // the zonemaps of the columns other than the primary key
 	schema            *catalog.Schema
 }
 
 func (holder *nonAppendableBlockIndexHolder) MayContainsKey(key interface{}) bool {
 	var err error
 	var exist bool
+	if holder.zoneMapIndex == nil || holder.staticFilterIndex == nil {
+		// the index is not loaded yet
+		return true
+	}
 	exist, err = holder.zoneMapIndex.MayContainsKey(key)
 	if err != nil {
 		return false
@@ -53,6 +58,24 @@ func (holder *nonAppendableBlockIndexHolder) MayContainsKey(key interface{}) boo
 	return true
 }
 
+func (holder *nonAppendableBlockIndexHolder) MayContainsRange(min, max interface{}) (bool, error) {
+	if holder.zoneMapIndex == nil {
+		return true, nil
+	}
+	return holder.zoneMapIndex.MayContainsRange(min, max)
+}
+
+// MayContainsColumnRange returns false if no value of the column is in
+// [min, max], a nil bound means the range is unbounded on that side. It is
+// true if the column has no zonemap.
+func (holder *nonAppendableBlockIndexHolder) MayContainsColumnRange(col uint16, min, max interface{}) (bool, error) {
+	reader := holder.columnZoneMaps[col]
+	if reader == nil {
+		return true, nil
+	}
+	return reader.MayContainsRange(min, max)
+}
+
 // MayContainsAnyKeys returns nil, nil if no keys is duplicated, otherwise return ErrDuplicate and the indexes of
 // duplicated keys in the input vector.
 func (holder *nonAppendableBlockIndexHolder) MayContainsAnyKeys(keys *vector.Vector) (error, *roaring.Bitmap) {
@@ -83,21 +106,25 @@ func NewEmptyNonAppendableBlockIndexHolder() *nonAppendableBlockIndexHolder {
 func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error {
 	holder.host = host
 	holder.schema = schema
-	pkIdx := schema.PrimaryKey
+	holder.columnZoneMaps = make(map[uint16]*io.BlockZoneMapIndexReader)
 	blkFile := host.GetBlockFile()
 	idxMetas, err := blkFile.LoadIndexMeta()
 	if err != nil {
 		return err
 	}
 
-	colFile, err := blkFile.OpenColumn(int(pkIdx))
-	if err != nil {
-		return err
-	}
 	for _, meta := range idxMetas.Metas {
+		// the full-text indexes are loaded when searched
+		if meta.IdxType == common.FullTextIndex {
+			continue
+		}
 		internal := meta.InternalIdx
-		colFile.GetDataFileStat()
+		colFile, err := blkFile.OpenColumn(int(meta.ColIdx))
+		if err != nil {
+			return err
+		}
 		idxFile, err := colFile.OpenIndexFile(int(internal))
+		colFile.Close()
 		if err != nil {
 			return err
 		}
@@ -120,6 +147,10 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 			if err != nil {
 				return err
 			}
+			if zm, ok := schema.ZoneMapFile(int(meta.ColIdx)); ok && zm == int(internal) {
+				holder.columnZoneMaps[meta.ColIdx] = reader
+				continue
+			}
 			holder.zoneMapIndex = reader
 		case common.StaticFilterIndex:
 			size := idxFile.Stat().Size()
@@ -154,6 +185,11 @@ func (holder *nonAppendableBlockIndexHolder) Destroy() (err error) {
 	if err = holder.staticFilterIndex.Destroy(); err != nil {
 		return err
 	}
+	for _, reader := range holder.columnZoneMaps {
+		if err = reader.Destroy(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
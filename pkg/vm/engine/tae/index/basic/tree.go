@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -85,7 +86,15 @@ func (art *simpleARTMap) BatchInsert(keys *vector.Vector, start int, count int,
 func (art *simpleARTMap) BatchInsertLocked(keys *vector.Vector, start int, count int, offset uint32, verify bool) error {
 	existence := make(map[interface{}]bool)
 
+	row := uint64(start)
 	processor := func(v interface{}) error {
+		// the null keys of an implicit key are not unique, they are not indexed
+		if keys.Nsp != nil && nulls.Contains(keys.Nsp, row) {
+			row++
+			offset++
+			return nil
+		}
+		row++
 		encoded, err := common.EncodeKey(v, art.typ)
 		if err != nil {
 			return err
@@ -130,6 +130,24 @@ func (zm *ZoneMap) MayContainsKey(key interface{}) (bool, error) {
 	return true, nil
 }
 
+// MayContainsRange returns false if no key in [min, max] is in the zone map,
+// a nil bound means the range is unbounded on that side.
+func (zm *ZoneMap) MayContainsRange(min, max interface{}) (bool, error) {
+	// TODO: mismatch error
+	zm.mu.RLock()
+	defer zm.mu.RUnlock()
+	if !zm.initialized {
+		return false, nil
+	}
+	if min != nil && common.CompareGeneric(min, zm.max, zm.typ) > 0 {
+		return false, nil
+	}
+	if max != nil && common.CompareGeneric(max, zm.min, zm.typ) < 0 {
+		return false, nil
+	}
+	return true, nil
+}
+
 func (zm *ZoneMap) MayContainsAnyKeys(keys *vector.Vector) (bool, *roaring.Bitmap, error) {
 	// TODO: mismatch error
 	zm.mu.RLock()
@@ -130,6 +130,37 @@ func TestZoneMapNumeric(t *testing.T) {
 	require.True(t, res)
 }
 
+func TestZoneMapRange(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	zm := NewZoneMap(typ, nil)
+	res, err := zm.MayContainsRange(nil, nil)
+	require.NoError(t, err)
+	require.False(t, res)
+
+	vec := common.MockVec(typ, 100, 100)
+	require.NoError(t, zm.BatchUpdate(vec, 0, -1))
+
+	kases := []struct {
+		min, max interface{}
+		res      bool
+	}{
+		{nil, nil, true},
+		{int32(0), int32(99), false},
+		{int32(0), int32(100), true},
+		{int32(150), int32(160), true},
+		{int32(199), nil, true},
+		{int32(200), nil, false},
+		{nil, int32(99), false},
+		{nil, int32(100), true},
+		{int32(0), int32(1000), true},
+	}
+	for _, kase := range kases {
+		res, err = zm.MayContainsRange(kase.min, kase.max)
+		require.NoError(t, err)
+		require.Equal(t, kase.res, res)
+	}
+}
+
 func TestZoneMapString(t *testing.T) {
 	typ := types.Type{Oid: types.T_char}
 	zm := NewZoneMap(typ, nil)
@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	gCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+)
+
+type FullTextIndexWriter struct {
+	cType       common.CompressType
+	host        gCommon.IRWFile
+	inner       *fulltext.Index
+	colIdx      uint16
+	internalIdx uint16
+}
+
+func NewFullTextIndexWriter() *FullTextIndexWriter {
+	return &FullTextIndexWriter{}
+}
+
+func (writer *FullTextIndexWriter) Init(host gCommon.IRWFile, cType common.CompressType, colIdx uint16, internalIdx uint16) error {
+	writer.host = host
+	writer.cType = cType
+	writer.colIdx = colIdx
+	writer.internalIdx = internalIdx
+	writer.inner = fulltext.NewIndex()
+	return nil
+}
+
+// AddValues adds a document for each row of the columns of the index
+func (writer *FullTextIndexWriter) AddValues(values []*vector.Vector) error {
+	writer.inner.AddVectors(values)
+	return nil
+}
+
+func (writer *FullTextIndexWriter) Finalize() (*common.IndexMeta, error) {
+	meta := common.NewEmptyIndexMeta()
+	meta.SetIndexType(common.FullTextIndex)
+	meta.SetCompressType(writer.cType)
+	meta.SetIndexedColumn(writer.colIdx)
+	meta.SetInternalIndex(writer.internalIdx)
+
+	iBuf := writer.inner.Marshal()
+	rawSize := uint32(len(iBuf))
+	compressed := common.Compress(iBuf, writer.cType)
+	exactSize := uint32(len(compressed))
+	meta.SetSize(rawSize, exactSize)
+	if _, err := writer.host.Write(compressed); err != nil {
+		return nil, err
+	}
+	writer.inner = nil
+	return meta, nil
+}
+
+// LoadFullTextIndex reads the full-text index described by meta from host
+func LoadFullTextIndex(host gCommon.IRWFile, meta *common.IndexMeta) (*fulltext.Index, error) {
+	data := make([]byte, meta.Size)
+	if _, err := host.Read(data); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, meta.RawSize)
+	if err := common.Decompress(data, buf, meta.CompType); err != nil {
+		return nil, err
+	}
+	idx := fulltext.NewIndex()
+	if err := idx.Unmarshal(buf); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
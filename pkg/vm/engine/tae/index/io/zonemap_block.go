@@ -123,6 +123,12 @@ func (reader *BlockZoneMapIndexReader) MayContainsKey(key interface{}) (bool, er
 	return handle.GetNode().(*blockZoneMapIndexNode).inner.MayContainsKey(key)
 }
 
+func (reader *BlockZoneMapIndexReader) MayContainsRange(min, max interface{}) (bool, error) {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return handle.GetNode().(*blockZoneMapIndexNode).inner.MayContainsRange(min, max)
+}
+
 type BlockZoneMapIndexWriter struct {
 	cType       common.CompressType
 	host        gCommon.IRWFile
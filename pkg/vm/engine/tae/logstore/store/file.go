@@ -53,6 +53,30 @@ func ParseVersion(name, prefix, suffix string) (int, error) {
 	return v, nil
 }
 
+// VersionFiles returns the paths of the version files of name in the dir
+// sorted by version.
+func VersionFiles(dir, name string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[string]int)
+	paths := make([]string, 0)
+	for _, info := range infos {
+		version, err := ParseVersion(info.Name(), name, suffix)
+		if err != nil {
+			continue
+		}
+		p := path.Join(dir, info.Name())
+		versions[p] = version
+		paths = append(paths, p)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return versions[paths[i]] < versions[paths[j]]
+	})
+	return paths, nil
+}
+
 type files struct {
 	files []*vFile
 }
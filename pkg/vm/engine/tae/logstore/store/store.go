@@ -513,6 +513,9 @@ func (s *baseStore) Replay(h ApplyHandle) error {
 		return err
 	}
 	for group, checkpointed := range r.checkpointrange {
+		if len(checkpointed.Intervals) == 0 {
+			continue
+		}
 		s.checkpointed.ids[group] = checkpointed.Intervals[0].End
 	}
 	for _, ent := range r.entrys {
@@ -132,6 +132,9 @@ func (base *syncBase) OnReplay(r *replayer) {
 		base.synced.ids[k] = v
 	}
 	for groupId, ckps := range r.checkpointrange {
+		if len(ckps.Intervals) == 0 {
+			continue
+		}
 		base.checkpointed.ids[groupId] = ckps.Intervals[0].End
 	}
 }
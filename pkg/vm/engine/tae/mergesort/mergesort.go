@@ -101,6 +101,15 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 	return nil
 }
 
+// SortBlockColumnsByKey sorts the key and shuffles all the columns in the order
+// of the key, the key is the packed composite primary key of the columns
+func SortBlockColumnsByKey(cols []*vector.Vector, key *vector.Vector) error {
+	all := make([]*vector.Vector, 0, len(cols)+1)
+	all = append(all, cols...)
+	all = append(all, key)
+	return SortBlockColumns(all, len(cols))
+}
+
 func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint32, fromLayout, toLayout []uint32) (ret []*vector.Vector, mapping []uint32) {
 	switch column[0].Typ.Oid {
 	case types.T_int8:
@@ -95,6 +95,7 @@ func shuffleNullableBlock(col *vector.Vector, idx []uint32) {
 	for i, j := range idx {
 		if nulls.Contains(uint64(j)) {
 			newNulls.AddInt(i)
+			newOffsets[i] = offset
 		} else {
 			copy(newData[offset:], data.Get(int64(j)))
 			newOffsets[i] = offset
@@ -291,6 +292,7 @@ func multiplexNullableBlocks(col []*vector.Vector, src []uint32, fromLayout, toL
 	strings := make([][]byte, maxTo)
 	merged := make([]*types.Bytes, to)
 	newNulls := make([]*roaring.Bitmap, to)
+	ret = make([]*vector.Vector, to)
 
 	emptySlice := make([]byte, 0)
 
@@ -311,8 +313,7 @@ func multiplexNullableBlocks(col []*vector.Vector, src []uint32, fromLayout, toL
 					nextNulls[s] = -1
 				}
 			} else {
-				d, _ := data[s], cursors[s]
-				strings[j] = d.Get(int64(s))
+				strings[j] = data[s].Get(int64(cursors[s]))
 				offset += uint32(len(strings[j]))
 			}
 
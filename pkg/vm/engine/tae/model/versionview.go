@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	movec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+var ErrInvalidVersionView = errors.New("tae: invalid version view")
+
+// VersionView is the committed history of the rows of a block up to Ts.
+// Row i of Columns is a version of a block row which is visible in
+// [InsertTs[i], DeleteTs[i]), a zero DeleteTs means the version is
+// still visible at Ts. An update of a row ends the current version
+// of the row and starts a new one.
+type VersionView struct {
+	Ts       uint64
+	InsertTs []uint64
+	DeleteTs []uint64
+	Columns  []*movec.Vector
+}
+
+func NewVersionView(ts uint64) *VersionView {
+	return &VersionView{
+		Ts: ts,
+	}
+}
+
+func (view *VersionView) Length() int {
+	return len(view.InsertTs)
+}
+
+// VisibleRows returns the rows visible at ts
+func (view *VersionView) VisibleRows(ts uint64) (sels []int64) {
+	for i, insertTs := range view.InsertTs {
+		if insertTs > ts {
+			continue
+		}
+		if deleteTs := view.DeleteTs[i]; deleteTs != 0 && deleteTs <= ts {
+			continue
+		}
+		sels = append(sels, int64(i))
+	}
+	return
+}
+
+func (view *VersionView) Marshal() (buf []byte, err error) {
+	var byteBuf bytes.Buffer
+	byteBuf.Write(encoding.EncodeUint64(view.Ts))
+	byteBuf.Write(encoding.EncodeUint32(uint32(len(view.InsertTs))))
+	byteBuf.Write(encoding.EncodeUint64Slice(view.InsertTs))
+	byteBuf.Write(encoding.EncodeUint64Slice(view.DeleteTs))
+	byteBuf.Write(encoding.EncodeUint16(uint16(len(view.Columns))))
+	for _, vec := range view.Columns {
+		data, err := vec.Show()
+		if err != nil {
+			return nil, err
+		}
+		byteBuf.Write(encoding.EncodeUint32(uint32(len(data))))
+		byteBuf.Write(data)
+	}
+	buf = byteBuf.Bytes()
+	return
+}
+
+func (view *VersionView) Unmarshal(buf []byte, colTypes []types.Type) (err error) {
+	if len(buf) < 12 {
+		return ErrInvalidVersionView
+	}
+	view.Ts = encoding.DecodeUint64(buf[:8])
+	rows := int(encoding.DecodeUint32(buf[8:12]))
+	buf = buf[12:]
+	if len(buf) < rows*16+2 {
+		return ErrInvalidVersionView
+	}
+	view.InsertTs = append([]uint64{}, encoding.DecodeUint64Slice(buf[:rows*8])...)
+	buf = buf[rows*8:]
+	view.DeleteTs = append([]uint64{}, encoding.DecodeUint64Slice(buf[:rows*8])...)
+	buf = buf[rows*8:]
+	cols := int(encoding.DecodeUint16(buf[:2]))
+	buf = buf[2:]
+	if cols != len(colTypes) {
+		return ErrInvalidVersionView
+	}
+	view.Columns = make([]*movec.Vector, cols)
+	for i := range view.Columns {
+		if len(buf) < 4 {
+			return ErrInvalidVersionView
+		}
+		size := int(encoding.DecodeUint32(buf[:4]))
+		buf = buf[4:]
+		if len(buf) < size {
+			return ErrInvalidVersionView
+		}
+		view.Columns[i] = movec.New(colTypes[i])
+		if err = view.Columns[i].Read(buf[:size]); err != nil {
+			return
+		}
+		buf = buf[size:]
+	}
+	return
+}
@@ -57,11 +57,14 @@ func (db *txnDatabase) Create(_ uint64, name string, defs []engine.TableDef) err
 	schema := TableInfoToSchema(&info)
 	schema.BlockMaxRows = 40000
 	schema.SegmentMaxBlocks = 20
+	if !schema.Valid() {
+		return catalog.ErrValidation
+	}
 	_, err = db.handle.CreateRelation(schema)
-	return err
+	return txnError(err)
 }
 
 func (db *txnDatabase) Delete(_ uint64, name string) error {
 	_, err := db.handle.DropRelationByName(name)
-	return err
+	return txnError(err)
 }
@@ -22,7 +22,7 @@ import (
 )
 
 var (
-	_ engine.Engine = (*txnEngine)(nil)
+	_ engine.Txn = (*txnEngine)(nil)
 )
 
 func NewEngine(txn txnif.AsyncTxn) *txnEngine {
@@ -33,12 +33,12 @@ func NewEngine(txn txnif.AsyncTxn) *txnEngine {
 
 func (e *txnEngine) Delete(_ uint64, name string) (err error) {
 	_, err = e.txn.DropDatabase(name)
-	return
+	return txnError(err)
 }
 
 func (e *txnEngine) Create(_ uint64, name string, _ int) (err error) {
 	_, err = e.txn.CreateDatabase(name)
-	return
+	return txnError(err)
 }
 
 func (e *txnEngine) Databases() (dbs []string) {
@@ -57,3 +57,20 @@ func (e *txnEngine) Database(name string) (db engine.Database, err error) {
 func (e *txnEngine) Node(ip string) *engine.NodeInfo {
 	return &engine.NodeInfo{Mcpu: runtime.NumCPU()}
 }
+
+func (e *txnEngine) Commit() error {
+	return txnError(e.txn.Commit())
+}
+
+func (e *txnEngine) Rollback() error {
+	return txnError(e.txn.Rollback())
+}
+
+// txnError converts the errors of the conflicts to engine.ErrTxnConflict
+func txnError(err error) error {
+	switch err {
+	case txnif.TxnWWConflictErr, txnif.TxnRWConflictErr, txnif.TxnRollbacked:
+		return engine.ErrTxnConflict
+	}
+	return err
+}
@@ -0,0 +1,761 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ModuleName = "MOENGINE"
+)
+
+func countRows(t *testing.T, txn engine.Txn, dbName, tblName string) int {
+	db, err := txn.Database(dbName)
+	assert.Nil(t, err)
+	rel, err := db.Relation(tblName)
+	assert.Nil(t, err)
+	return readRows(t, rel, nil)
+}
+
+func readRows(t *testing.T, rel engine.Relation, e extend.Extend) (rows int) {
+	attrs := rel.(*txnRelation).Attribute()
+	for _, reader := range rel.NewReader(2, e, nil) {
+		for {
+			bat, err := reader.Read([]uint64{1}, []string{attrs[0].Name})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			rows += vector.Length(bat.Vecs[0])
+		}
+	}
+	return
+}
+
+func TestTxnEngine(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	e := NewTAEEngine(tae)
+
+	assert.Nil(t, e.Create(0, "db", 0))
+	assert.Equal(t, []string{"db"}, e.Databases())
+
+	tblInfo := MockTableInfo(4)
+	tblInfo.Columns[0].PrimaryKey = true
+	_, _, _, _, defs, _ := helper.UnTransfer(*tblInfo)
+	txn1, err := e.StartTxn()
+	assert.Nil(t, err)
+	database, err := txn1.Database("db")
+	assert.Nil(t, err)
+	assert.Nil(t, database.Create(0, tblInfo.Name, defs))
+	rel, err := database.Relation(tblInfo.Name)
+	assert.Nil(t, err)
+	attrs := rel.(*txnRelation).Attribute()
+	typs := make([]types.Type, len(attrs))
+	for i, attr := range attrs {
+		typs[i] = attr.Type
+	}
+	assert.Nil(t, rel.Write(0, compute.MockBatch(typs, 10, 0, nil)))
+	assert.Equal(t, 10, countRows(t, txn1, "db", tblInfo.Name))
+
+	// the changes are not visible to the other transactions before commit
+	txn2, err := e.StartTxn()
+	assert.Nil(t, err)
+	database, err = txn2.Database("db")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(database.Relations()))
+	assert.Nil(t, txn1.Commit())
+	assert.Equal(t, 0, len(database.Relations()))
+	assert.Nil(t, txn2.Rollback())
+
+	txn3, err := e.StartTxn()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, countRows(t, txn3, "db", tblInfo.Name))
+	assert.Nil(t, txn3.Commit())
+
+	// the conflicts of the transactions
+	txn4, err := e.StartTxn()
+	assert.Nil(t, err)
+	txn5, err := e.StartTxn()
+	assert.Nil(t, err)
+	assert.Nil(t, txn4.Create(0, "db2", 0))
+	assert.Equal(t, engine.ErrTxnConflict, txn5.Create(0, "db2", 0))
+	assert.Nil(t, txn5.Rollback())
+	assert.Nil(t, txn4.Commit())
+	assert.Equal(t, []string{"db", "db2"}, e.Databases())
+}
+
+func TestTxnEngineRestart(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	e := NewTAEEngine(tae)
+	assert.Nil(t, e.Create(0, "db", 0))
+
+	tblInfo := MockTableInfo(4)
+	tblInfo.Columns[0].PrimaryKey = true
+	_, _, _, _, defs, _ := helper.UnTransfer(*tblInfo)
+	var typs []types.Type
+	write := func(rows uint64, offset int) {
+		txn, err := e.StartTxn()
+		assert.Nil(t, err)
+		database, err := txn.Database("db")
+		assert.Nil(t, err)
+		if typs == nil {
+			assert.Nil(t, database.Create(0, tblInfo.Name, defs))
+		}
+		rel, err := database.Relation(tblInfo.Name)
+		assert.Nil(t, err)
+		attrs := rel.(*txnRelation).Attribute()
+		typs = make([]types.Type, len(attrs))
+		for i, attr := range attrs {
+			typs[i] = attr.Type
+		}
+		bat := compute.MockBatch(typs, rows+uint64(offset), 0, nil)
+		if offset > 0 {
+			bat = compute.SplitBatch(bat, 2)[1]
+		}
+		assert.Nil(t, rel.Write(0, bat))
+		assert.Nil(t, txn.Commit())
+	}
+	write(10, 0)
+	assert.Nil(t, tae.Close())
+
+	// the committed tables and rows are read back after the restart
+	tae, err = db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	e = NewTAEEngine(tae)
+	assert.Equal(t, []string{"db"}, e.Databases())
+	txn, err := e.StartTxn()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, countRows(t, txn, "db", tblInfo.Name))
+	assert.Nil(t, txn.Commit())
+
+	write(10, 10)
+	txn, err = e.StartTxn()
+	assert.Nil(t, err)
+	assert.Equal(t, 20, countRows(t, txn, "db", tblInfo.Name))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestTxnEngineBackup(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	e := NewTAEEngine(tae)
+	target := filepath.Join(dir, "backup.tar.gz")
+	assert.Equal(t, db.ErrBackupEmpty, e.Backup(target))
+	assert.Nil(t, e.Create(0, "db", 0))
+
+	tblInfo := MockTableInfo(4)
+	tblInfo.Columns[0].PrimaryKey = true
+	_, _, _, _, defs, _ := helper.UnTransfer(*tblInfo)
+	txn, err := e.StartTxn()
+	assert.Nil(t, err)
+	database, err := txn.Database("db")
+	assert.Nil(t, err)
+	assert.Nil(t, database.Create(0, tblInfo.Name, defs))
+	rel, err := database.Relation(tblInfo.Name)
+	assert.Nil(t, err)
+	attrs := rel.(*txnRelation).Attribute()
+	typs := make([]types.Type, len(attrs))
+	for i, attr := range attrs {
+		typs[i] = attr.Type
+	}
+	assert.Nil(t, rel.Write(0, compute.MockBatch(typs, 10, 0, nil)))
+	assert.Nil(t, txn.Commit())
+
+	// the backup is taken while the engine is running
+	var b engine.Backuper = e
+	assert.Nil(t, b.Backup(target))
+	assert.Nil(t, e.Create(0, "db2", 0))
+
+	restored, err := db.Restore(target, filepath.Join(dir, "restore"), 0, nil)
+	assert.Nil(t, err)
+	defer restored.Close()
+	re := NewTAEEngine(restored)
+	assert.Equal(t, []string{"db"}, re.Databases())
+	txn, err = re.StartTxn()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, countRows(t, txn, "db", tblInfo.Name))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestTxnRelation(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(4)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	txn := tae.StartTxn(nil)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	rel, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(compute.MockBatch(schema.Types(), 40, int(schema.PrimaryKey), nil)))
+	assert.Nil(t, txn.Commit())
+
+	e := NewEngine(tae.StartTxn(nil))
+	defer e.Rollback()
+	edb, err := e.Database("db")
+	assert.Nil(t, err)
+	erel, err := edb.Relation(schema.Name)
+	assert.Nil(t, err)
+	pk := schema.ColDefs[schema.PrimaryKey].Name
+
+	// the statistics
+	trel := erel.(*txnRelation)
+	assert.Equal(t, int64(40), trel.Rows())
+	assert.Equal(t, int64(40*4), trel.Size(pk))
+	assert.Equal(t, int64(40), trel.CardinalNumber(pk))
+	assert.Equal(t, int64(0), trel.CardinalNumber(schema.ColDefs[0].Name))
+	// the statistics of ANALYZE TABLE are kept in the table entry
+	w, ok := erel.(engine.StatisticsWriter)
+	assert.True(t, ok)
+	assert.Nil(t, trel.Stats(pk))
+	assert.Nil(t, w.WriteStats(0, map[string]*engine.ColumnStatistics{
+		pk: {Rows: 40, NDV: 40, Min: int32(0), Max: int32(39)},
+	}))
+	assert.Nil(t, w.WriteStats(0, map[string]*engine.ColumnStatistics{
+		schema.ColDefs[0].Name: {Rows: 40, NDV: 3, NullCount: 1},
+	}))
+	// the statistics of the other columns are kept
+	assert.Equal(t, int64(40), trel.Stats(pk).NDV)
+	assert.Equal(t, int32(39), trel.Stats(pk).Max)
+	assert.Equal(t, int64(1), trel.Stats(schema.ColDefs[0].Name).NullCount)
+	assert.Nil(t, trel.Stats(schema.ColDefs[1].Name))
+	// the cardinal number of the analyzed columns is the NDV
+	assert.Equal(t, int64(40), trel.CardinalNumber(pk))
+	assert.Equal(t, int64(3), trel.CardinalNumber(schema.ColDefs[0].Name))
+	assert.Equal(t, int64(0), trel.CardinalNumber(schema.ColDefs[1].Name))
+	// the statistics are invisible to the other txns until it commits
+	{
+		e := NewEngine(tae.StartTxn(nil))
+		edb, err := e.Database("db")
+		assert.Nil(t, err)
+		erel, err := edb.Relation(schema.Name)
+		assert.Nil(t, err)
+		assert.Nil(t, erel.Stats(pk))
+		assert.Nil(t, e.Rollback())
+	}
+
+	// the index on the primary key and the zonemaps of the other columns are
+	// maintained by TAE
+	idxs := trel.Index()
+	assert.Equal(t, 4, len(idxs))
+	assert.Equal(t, []string{pk}, idxs[0].ColNames)
+	for i, col := range []int{0, 1, 3} {
+		assert.Equal(t, engine.ZoneMap, idxs[i+1].Typ)
+		assert.Equal(t, []string{schema.ColDefs[col].Name}, idxs[i+1].ColNames)
+	}
+	assert.Nil(t, trel.AddTableDef(0, &engine.IndexTableDef{Typ: engine.ZoneMap, ColNames: []string{pk}, Name: "idx"}))
+	assert.Nil(t, trel.CreateIndex(0, []engine.TableDef{&engine.IndexTableDef{Typ: engine.ZoneMap, ColNames: []string{schema.ColDefs[0].Name}}}))
+	assert.Equal(t, ErrNotSupported, trel.CreateIndex(0, []engine.TableDef{&engine.IndexTableDef{Typ: engine.ZoneMap, ColNames: []string{"none"}}}))
+	assert.Equal(t, ErrNotSupported, trel.CreateIndex(0, []engine.TableDef{&engine.IndexTableDef{Typ: engine.FullTextIndex, ColNames: []string{schema.ColDefs[0].Name}, Name: "ft"}}))
+	assert.Equal(t, ErrNotSupported, trel.DropIndex(0, idxs[0].Name))
+	assert.Equal(t, ErrNotSupported, trel.DropIndex(0, idxs[1].Name))
+	assert.Equal(t, catalog.ErrNotFound, trel.DropIndex(0, "none"))
+	assert.Equal(t, ErrNotSupported, trel.DelTableDef(0, idxs[1]))
+
+	// the blocks are skipped by the zonemap of the primary key
+	cmp := func(op int, left, right extend.Extend) extend.Extend {
+		return &extend.BinaryExtend{Op: op, Left: left, Right: right}
+	}
+	attr := &extend.Attribute{Name: pk, Type: types.T_int32}
+	value := func(v int64) extend.Extend {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		vector.SetCol(vec, []int64{v})
+		return &extend.ValueExtend{V: vec}
+	}
+	assert.Equal(t, 40, readRows(t, erel, nil))
+	assert.Equal(t, 10, readRows(t, erel, cmp(overload.EQ, attr, value(15))))
+	assert.Equal(t, 20, readRows(t, erel, cmp(overload.GE, attr, value(25))))
+	assert.Equal(t, 20, readRows(t, erel, cmp(overload.GT, value(13), attr)))
+	assert.Equal(t, 10, readRows(t, erel, cmp(overload.And, cmp(overload.GT, attr, value(12)), cmp(overload.LT, attr, value(18)))))
+	assert.Equal(t, 0, readRows(t, erel, cmp(overload.GT, attr, value(100))))
+	// the value out of the range of the type is not used to skip the blocks
+	assert.Equal(t, 40, readRows(t, erel, cmp(overload.LT, attr, value(1<<40))))
+	assert.Equal(t, 40, readRows(t, erel, cmp(overload.Or, cmp(overload.EQ, attr, value(1)), cmp(overload.EQ, attr, value(35)))))
+
+	// the error of reading the rows of the txn is returned by the readers
+	errRel := newRelation(&localErrRelation{Relation: trel.handle})
+	for _, reader := range errRel.NewReader(2, nil, nil) {
+		bat, err := reader.Read([]uint64{1}, []string{pk})
+		assert.Equal(t, errLocalBatches, err)
+		assert.Nil(t, bat)
+	}
+}
+
+var errLocalBatches = errors.New("local batches")
+
+type localErrRelation struct {
+	handle.Relation
+}
+
+func (rel *localErrRelation) GetLocalBatches() ([]*batch.Batch, error) {
+	return nil, errLocalBatches
+}
+
+func TestWriteDefaults(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	e := NewTAEEngine(tae)
+	assert.Nil(t, e.Create(0, "db", 0))
+
+	tblInfo := MockTableInfo(4)
+	tblInfo.Columns[0].PrimaryKey = true
+	tblInfo.Columns[2].Default = engine.MakeDefaultExpr(true, int32(7), false)
+	tblInfo.Columns[3].Default = engine.MakeDefaultExpr(true, nil, true)
+	tblInfo.Columns[3].NullAbility = true
+	_, _, _, _, defs, _ := helper.UnTransfer(*tblInfo)
+	txn, err := e.StartTxn()
+	assert.Nil(t, err)
+	defer txn.Rollback()
+	database, err := txn.Database("db")
+	assert.Nil(t, err)
+	assert.Nil(t, database.Create(0, tblInfo.Name, defs))
+	rel, err := database.Relation(tblInfo.Name)
+	assert.Nil(t, err)
+	attrs := rel.(*txnRelation).Attribute()
+	assert.False(t, attrs[1].HasDefaultExpr())
+	assert.Equal(t, tblInfo.Columns[2].Default, attrs[2].Default)
+	assert.Equal(t, tblInfo.Columns[3].Default, attrs[3].Default)
+
+	// the attributes are reordered and the omitted ones are filled with the
+	// defaults
+	mock := compute.MockBatch([]types.Type{attrs[0].Type, attrs[1].Type}, 10, 0, nil)
+	bat := batch.New(true, []string{attrs[1].Name, attrs[0].Name})
+	bat.Vecs = []*vector.Vector{mock.Vecs[1], mock.Vecs[0]}
+	assert.Nil(t, rel.Write(0, bat))
+	bat = batch.New(true, []string{attrs[0].Name})
+	bat.Vecs = []*vector.Vector{mock.Vecs[0]}
+	assert.Equal(t, catalog.ErrNoDefault, rel.Write(0, bat))
+
+	names := []string{attrs[0].Name, attrs[2].Name, attrs[3].Name}
+	rows := 0
+	for _, reader := range rel.NewReader(1, nil, nil) {
+		for {
+			bat, err := reader.Read([]uint64{1, 1, 1}, names)
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			for i := 0; i < vector.Length(bat.Vecs[0]); i++ {
+				assert.Equal(t, int32(7), compute.GetValue(bat.Vecs[1], uint32(i)))
+				assert.Nil(t, compute.GetValue(bat.Vecs[2], uint32(i)))
+			}
+			rows += vector.Length(bat.Vecs[0])
+		}
+	}
+	assert.Equal(t, 10, rows)
+}
+
+func TestTableInfoToSchema(t *testing.T) {
+	// the first column is the key of the table without a primary key and
+	// keeps its nullability, the not null column may have a default
+	tblInfo := MockTableInfo(3)
+	tblInfo.Columns[0].NullAbility = true
+	tblInfo.Columns[0].Default = engine.MakeDefaultExpr(true, nil, true)
+	tblInfo.Columns[1].Default = engine.MakeDefaultExpr(true, "a", false)
+	schema := TableInfoToSchema(tblInfo)
+	assert.True(t, schema.Valid())
+	assert.True(t, schema.ImplicitKey)
+	assert.True(t, schema.ColDefs[0].NullAbility)
+	assert.False(t, schema.ColDefs[1].NullAbility)
+	assert.Equal(t, []byte("a"), schema.ColDefs[1].Default.Value)
+	assert.False(t, schema.ColDefs[2].NullAbility)
+	assert.False(t, schema.ColDefs[2].Default.Exist)
+	info := SchemaToTableInfo(schema)
+	for _, col := range info.Columns {
+		assert.False(t, col.PrimaryKey)
+	}
+	assert.True(t, info.Columns[0].NullAbility)
+
+	// the null default of the primary key is no default
+	tblInfo = MockTableInfo(2)
+	tblInfo.Columns[1].PrimaryKey = true
+	tblInfo.Columns[1].Default = engine.MakeDefaultExpr(true, nil, true)
+	schema = TableInfoToSchema(tblInfo)
+	assert.True(t, schema.Valid())
+	assert.False(t, schema.ImplicitKey)
+	assert.Equal(t, int32(1), schema.PrimaryKey)
+	assert.False(t, schema.ColDefs[1].Default.Exist)
+
+	// the FULLTEXT indexes are kept, the column of a valid one is a string
+	tblInfo = MockTableInfo(3)
+	tblInfo.Indices = append(tblInfo.Indices, aoe.IndexInfo{Name: "ft", Type: aoe.FullText, ColumnNames: []string{"mock_1"}})
+	schema = TableInfoToSchema(tblInfo)
+	assert.True(t, schema.Valid())
+	assert.Equal(t, []*catalog.IndexInfo{catalog.NewIndexInfo("ft", catalog.FullText, 1)}, schema.Indexes)
+	info = SchemaToTableInfo(schema)
+	assert.Equal(t, []string{"mock_1"}, info.Indices[0].ColumnNames)
+	tblInfo.Indices[len(tblInfo.Indices)-1].ColumnNames = []string{"mock_2"}
+	assert.False(t, TableInfoToSchema(tblInfo).Valid())
+	tblInfo.Indices[len(tblInfo.Indices)-1].ColumnNames = []string{"none"}
+	assert.False(t, TableInfoToSchema(tblInfo).Valid())
+}
+
+func TestFullTextSearch(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	e := NewTAEEngine(tae)
+	assert.Nil(t, e.Create(0, "db", 0))
+
+	tblInfo := MockTableInfo(2)
+	tblInfo.Columns[0].PrimaryKey = true
+	_, _, _, _, defs, _ := helper.UnTransfer(*tblInfo)
+	defs = append(defs, &engine.IndexTableDef{Typ: engine.FullTextIndex, ColNames: []string{"mock_1"}, Name: "ft"})
+	txn, err := e.StartTxn()
+	assert.Nil(t, err)
+	database, err := txn.Database("db")
+	assert.Nil(t, err)
+	assert.Nil(t, database.Create(0, tblInfo.Name, defs))
+	rel, err := database.Relation(tblInfo.Name)
+	assert.Nil(t, err)
+	typs := []types.Type{tblInfo.Columns[0].Type, tblInfo.Columns[1].Type}
+	bat := compute.MockBatch(typs, 15, 0, nil)
+	bats := compute.SplitBatch(bat, 3)
+	assert.Nil(t, rel.Write(0, bats[0]))
+	assert.Nil(t, rel.Write(0, bats[1]))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn()
+	assert.Nil(t, err)
+	defer txn.Rollback()
+	database, err = txn.Database("db")
+	assert.Nil(t, err)
+	rel, err = database.Relation(tblInfo.Name)
+	assert.Nil(t, err)
+	idxs := rel.(*txnRelation).Index()
+	assert.Equal(t, 3, len(idxs))
+	assert.Equal(t, engine.ZoneMap, idxs[1].Typ)
+	assert.Equal(t, engine.FullTextIndex, idxs[2].Typ)
+	assert.Nil(t, rel.AddTableDef(0, idxs[2]))
+	assert.Equal(t, ErrNotSupported, rel.(*txnRelation).DropIndex(0, "ft"))
+
+	// the rows appended by the txn are searched along with the blocks
+	assert.Nil(t, rel.Write(0, bats[2]))
+	q := fulltext.ParseQuery(string(bat.Vecs[1].Col.(*types.Bytes).Get(0)), fulltext.NaturalLanguage)
+	search, err := rel.(engine.FullTextSearcher).FullTextSearch([]string{"mock_1"}, q)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(15), search.Stats.Docs)
+	// the documents matched from the postings are scored by the search
+	text := bat.Vecs[1].Col.(*types.Bytes).Get(0)
+	assert.True(t, search.Scores[fulltext.DocKey(text)] > 0)
+	assert.Equal(t, 0.0, search.Scores[fulltext.DocKey([]byte("none"))])
+	_, err = rel.(engine.FullTextSearcher).FullTextSearch([]string{"none"}, q)
+	assert.Equal(t, catalog.ErrNotFound, err)
+}
+
+func TestReadDictBlocks(t *testing.T) {
+	const rows = 100
+
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	idxCommon.MockIndexBufferManager = buffer.NewNodeManager(1024*1024*150, nil)
+
+	schema := catalog.NewEmptySchema("dict")
+	schema.AppendCol("i", types.Type{Oid: types.T_int64, Size: 8, Width: 64})
+	schema.AppendCol("s", types.Type{Oid: types.T_varchar, Size: 24, Width: 100})
+	schema.PrimaryKey = 0
+	schema.BlockMaxRows = rows
+	schema.SegmentMaxBlocks = 2
+	bat := batch.New(true, []string{"i", "s"})
+	is := make([]int64, rows)
+	ss := make([][]byte, rows)
+	for i := range is {
+		is[i] = int64(i)
+		ss[i] = []byte(fmt.Sprintf("v%d", i%7))
+	}
+	bat.Vecs[0] = vector.New(schema.ColDefs[0].Type)
+	assert.Nil(t, vector.Append(bat.Vecs[0], is))
+	bat.Vecs[1] = vector.New(schema.ColDefs[1].Type)
+	assert.Nil(t, vector.Append(bat.Vecs[1], ss))
+	txn := tae.StartTxn(nil)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	rel, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	// the block is compacted into a persisted block, whose column s is dictionary encoded
+	txn = tae.StartTxn(nil)
+	database, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	rel, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	meta := rel.MakeBlockIt().GetBlock().GetMeta().(*catalog.BlockEntry)
+	task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+	assert.Nil(t, err)
+	assert.Nil(t, task.OnExec())
+	assert.Nil(t, txn.Commit())
+
+	e := NewTAEEngine(tae)
+	etxn, err := e.StartTxn()
+	assert.Nil(t, err)
+	defer etxn.Rollback()
+	edb, err := etxn.Database("db")
+	assert.Nil(t, err)
+	erel, err := edb.Relation(schema.Name)
+	assert.Nil(t, err)
+	value := &extend.ValueExtend{V: vector.New(schema.ColDefs[1].Type)}
+	assert.Nil(t, vector.Append(value.V, [][]byte{[]byte("v3")}))
+	cond := &extend.BinaryExtend{
+		Op:    overload.EQ,
+		Left:  &extend.Attribute{Name: "s", Type: types.T_varchar},
+		Right: value,
+	}
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	arg := &restrict.Argument{E: cond}
+	assert.Nil(t, restrict.Prepare(proc, arg))
+	var found []int64
+	blocks := 0
+	for _, reader := range erel.NewReader(1, nil, nil) {
+		for {
+			bat, err := reader.Read([]uint64{1, 2}, []string{"i", "s"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			blocks++
+			assert.NotNil(t, vector.GetDict(bat.Vecs[1]))
+			bat.InitZsOne(vector.Length(bat.Vecs[0]))
+			proc.Reg.InputBatch = bat
+			_, err = restrict.Call(proc, arg)
+			assert.Nil(t, err)
+			found = append(found, proc.Reg.InputBatch.Vecs[0].Col.([]int64)...)
+		}
+	}
+	assert.Equal(t, 1, blocks)
+	var expected []int64
+	for i := 3; i < rows; i += 7 {
+		expected = append(expected, int64(i))
+	}
+	assert.Equal(t, expected, found)
+}
+
+func TestColumnZoneMap(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	idxCommon.MockIndexBufferManager = buffer.NewNodeManager(1024*1024*150, nil)
+
+	schema := catalog.MockSchemaAll(4)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), 40, int(schema.PrimaryKey), nil)
+	vals := bat.Vecs[3].Col.([]int64)
+	for i := range vals {
+		vals[i] = int64(i)
+	}
+	txn := tae.StartTxn(nil)
+	database, err := txn.CreateDatabase("db")
+	assert.Nil(t, err)
+	rel, err := database.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	// the blocks are compacted into the persisted blocks with the zonemaps of
+	// all the columns
+	txn = tae.StartTxn(nil)
+	database, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	rel, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	var metas []*catalog.BlockEntry
+	for it := rel.MakeBlockIt(); it.Valid(); it.Next() {
+		metas = append(metas, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+	}
+	assert.Nil(t, txn.Commit())
+	assert.Equal(t, 4, len(metas))
+	for _, meta := range metas {
+		txn = tae.StartTxn(nil)
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+
+	e := NewEngine(tae.StartTxn(nil))
+	defer e.Rollback()
+	edb, err := e.Database("db")
+	assert.Nil(t, err)
+	erel, err := edb.Relation(schema.Name)
+	assert.Nil(t, err)
+
+	cmp := func(op int, left, right extend.Extend) extend.Extend {
+		return &extend.BinaryExtend{Op: op, Left: left, Right: right}
+	}
+	attr := &extend.Attribute{Name: schema.ColDefs[3].Name, Type: types.T_int64}
+	value := func(v int64) extend.Extend {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		vector.SetCol(vec, []int64{v})
+		return &extend.ValueExtend{V: vec}
+	}
+	assert.Equal(t, 40, readRows(t, erel, nil))
+	assert.Equal(t, 10, readRows(t, erel, cmp(overload.EQ, attr, value(15))))
+	assert.Equal(t, 20, readRows(t, erel, cmp(overload.GE, attr, value(25))))
+	assert.Equal(t, 10, readRows(t, erel, cmp(overload.GT, value(9), attr)))
+	assert.Equal(t, 0, readRows(t, erel, cmp(overload.GT, attr, value(100))))
+
+	// the columns of an appendable block have no zonemaps, it is never
+	// skipped by them
+	for i := range vals {
+		vals[i] = int64(100 + i)
+	}
+	pks := bat.Vecs[schema.PrimaryKey].Col.([]int32)
+	for i := range pks {
+		pks[i] += 40
+	}
+	txn = tae.StartTxn(nil)
+	database, err = txn.GetDatabase("db")
+	assert.Nil(t, err)
+	rel, err = database.GetRelationByName(schema.Name)
+	assert.Nil(t, err)
+	assert.Nil(t, rel.Append(bat))
+	assert.Nil(t, txn.Commit())
+
+	e2 := NewEngine(tae.StartTxn(nil))
+	defer e2.Rollback()
+	edb, err = e2.Database("db")
+	assert.Nil(t, err)
+	erel, err = edb.Relation(schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, 80, readRows(t, erel, nil))
+	assert.Equal(t, 50, readRows(t, erel, cmp(overload.EQ, attr, value(15))))
+	assert.Equal(t, 40, readRows(t, erel, cmp(overload.GE, attr, value(100))))
+}
+
+func TestImplicitKeyNulls(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	tae, err := db.Open(dir, nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	idxCommon.MockIndexBufferManager = buffer.NewNodeManager(1024*1024*150, nil)
+
+	// the first column of the table without a primary key is nullable, a
+	// block holds 5 rows
+	tblInfo := MockTableInfo(2)
+	tblInfo.Columns[0].NullAbility = true
+	schema := TableInfoToSchema(tblInfo)
+	schema.BlockMaxRows = 5
+	schema.SegmentMaxBlocks = 2
+	ttxn := tae.StartTxn(nil)
+	tdb, err := ttxn.CreateDatabase("db")
+	assert.Nil(t, err)
+	_, err = tdb.CreateRelation(schema)
+	assert.Nil(t, err)
+	assert.Nil(t, ttxn.Commit())
+	e := NewTAEEngine(tae)
+
+	write := func(keys []int32, nullRows ...uint64) error {
+		txn, err := e.StartTxn()
+		assert.Nil(t, err)
+		database, err := txn.Database("db")
+		assert.Nil(t, err)
+		rel, err := database.Relation(tblInfo.Name)
+		assert.Nil(t, err)
+		bat := batch.New(true, []string{"mock_0", "mock_1"})
+		bat.Vecs[0] = vector.New(tblInfo.Columns[0].Type)
+		assert.Nil(t, vector.Append(bat.Vecs[0], keys))
+		nulls.Add(bat.Vecs[0].Nsp, nullRows...)
+		vals := make([][]byte, len(keys))
+		for i := range vals {
+			vals[i] = []byte(fmt.Sprintf("v%d", i))
+		}
+		bat.Vecs[1] = vector.New(tblInfo.Columns[1].Type)
+		assert.Nil(t, vector.Append(bat.Vecs[1], vals))
+		if err = rel.Write(0, bat); err != nil {
+			assert.Nil(t, txn.Rollback())
+			return err
+		}
+		return txn.Commit()
+	}
+	count := func() int {
+		txn, err := e.StartTxn()
+		assert.Nil(t, err)
+		defer txn.Rollback()
+		return countRows(t, txn, "db", tblInfo.Name)
+	}
+
+	// the null keys are neither duplicated with each other nor with a zero
+	assert.Nil(t, write([]int32{0, 0, 0}, 0, 1))
+	assert.Nil(t, write([]int32{0, 1}, 0))
+	assert.Equal(t, txnbase.ErrDuplicated, write([]int32{0}))
+	assert.Equal(t, 5, count())
+
+	// the null keys of a persisted block are not indexed either
+	ttxn = tae.StartTxn(nil)
+	tdb, err = ttxn.GetDatabase("db")
+	assert.Nil(t, err)
+	trel, err := tdb.GetRelationByName(tblInfo.Name)
+	assert.Nil(t, err)
+	meta := trel.MakeBlockIt().GetBlock().GetMeta().(*catalog.BlockEntry)
+	task, err := jobs.NewCompactBlockTask(nil, ttxn, meta, tae.Scheduler)
+	assert.Nil(t, err)
+	assert.Nil(t, task.OnExec())
+	assert.Nil(t, ttxn.Commit())
+	assert.Nil(t, write([]int32{0, 2}, 0))
+	assert.Equal(t, txnbase.ErrDuplicated, write([]int32{1}))
+	assert.Equal(t, 7, count())
+}
@@ -0,0 +1,248 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+// reversedOps maps the comparison to the one with the operands swapped
+var reversedOps = map[int]int{
+	overload.EQ: overload.EQ,
+	overload.LT: overload.GT,
+	overload.LE: overload.GE,
+	overload.GT: overload.LT,
+	overload.GE: overload.LE,
+}
+
+// comparison is the comparison between the attribute and the constant
+type comparison struct {
+	attr string
+	op   int
+	val  *vector.Vector
+}
+
+// comparisons returns the comparisons between the attributes and the constants
+// which are and-ed in the extend, the attributes are on the left side.
+func comparisons(e extend.Extend) []comparison {
+	cmps := make([]comparison, 0)
+	for _, ae := range extend.AndExtends(e, nil) {
+		be, ok := ae.(*extend.BinaryExtend)
+		if !ok {
+			continue
+		}
+		op, ok := reversedOps[be.Op]
+		if !ok {
+			continue
+		}
+		attr, ok := be.Right.(*extend.Attribute)
+		val, isVal := be.Left.(*extend.ValueExtend)
+		if !ok || !isVal {
+			op = be.Op
+			attr, ok = be.Left.(*extend.Attribute)
+			val, isVal = be.Right.(*extend.ValueExtend)
+			if !ok || !isVal {
+				continue
+			}
+		}
+		cmps = append(cmps, comparison{attr: attr.Name, op: op, val: val.V})
+	}
+	return cmps
+}
+
+// newPKFilter returns the filter of the primary key built from the comparisons
+// between the primary key and the constants which are and-ed in the extend.
+// The bounds of the filter are inclusive, so a block is skipped only if none of
+// its keys can match. It is nil if there is no such comparison.
+func newPKFilter(schema *catalog.Schema, e extend.Extend) *handle.Filter {
+	if e == nil {
+		return nil
+	}
+	if schema.IsCompositeKey() {
+		return newCompositeKeyFilter(schema, comparisons(e))
+	}
+	pk := schema.ColDefs[schema.PrimaryKey]
+	min, max := valueRange(comparisons(e), pk)
+	if min == nil && max == nil {
+		return nil
+	}
+	if min != nil && max != nil && common.CompareGeneric(min, max, pk.Type) == 0 {
+		return handle.NewEQFilter(min)
+	}
+	return handle.NewBtwFilter(min, max)
+}
+
+// columnFilter is the range of the values of a column other than the primary
+// key, a nil bound means the range is unbounded on that side.
+type columnFilter struct {
+	col      int
+	min, max interface{}
+}
+
+// newColumnFilters returns the ranges of the columns with zonemaps built from
+// the comparisons between the columns and the constants which are and-ed in
+// the extend, the primary key is filtered by newPKFilter.
+func newColumnFilters(schema *catalog.Schema, e extend.Extend) []*columnFilter {
+	if e == nil {
+		return nil
+	}
+	cmps := comparisons(e)
+	var filters []*columnFilter
+	for col, def := range schema.ColDefs {
+		if _, ok := schema.ZoneMapFile(col); !ok {
+			continue
+		}
+		if min, max := valueRange(cmps, def); min != nil || max != nil {
+			filters = append(filters, &columnFilter{col: col, min: min, max: max})
+		}
+	}
+	return filters
+}
+
+// valueRange returns the inclusive bounds of the values of the column which
+// may satisfy the comparisons, a bound is nil if it is not limited.
+func valueRange(cmps []comparison, def *catalog.ColDef) (min, max interface{}) {
+	for _, cmp := range cmps {
+		if cmp.attr != def.Name {
+			continue
+		}
+		v := columnValue(cmp.val, def.Type)
+		if v == nil {
+			continue
+		}
+		op := cmp.op
+		if op == overload.EQ || op == overload.GT || op == overload.GE {
+			if min == nil || common.CompareGeneric(v, min, def.Type) > 0 {
+				min = v
+			}
+		}
+		if op == overload.EQ || op == overload.LT || op == overload.LE {
+			if max == nil || common.CompareGeneric(v, max, def.Type) < 0 {
+				max = v
+			}
+		}
+	}
+	return
+}
+
+// newCompositeKeyFilter returns the filter of the packed composite primary key,
+// it is only built if every column of the key is equal to a constant.
+func newCompositeKeyFilter(schema *catalog.Schema, cmps []comparison) *handle.Filter {
+	vals := make([]interface{}, len(schema.CompositeKey))
+	for _, cmp := range cmps {
+		if cmp.op != overload.EQ {
+			continue
+		}
+		for i, idx := range schema.CompositeKey {
+			def := schema.ColDefs[idx]
+			if def.Name != cmp.attr {
+				continue
+			}
+			if v := columnValue(cmp.val, def.Type); v != nil {
+				vals[i] = v
+			}
+		}
+	}
+	for _, v := range vals {
+		if v == nil {
+			return nil
+		}
+	}
+	return handle.NewEQFilter(compute.EncodeKey(vals...))
+}
+
+// columnValue converts the constant to the value of the type of the column,
+// it is nil if the constant can not be converted exactly.
+func columnValue(vec *vector.Vector, typ types.Type) interface{} {
+	if vector.Length(vec) != 1 || nulls.Contains(vec.Nsp, 0) {
+		return nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_int64:
+		return intValue(vec.Col.([]int64)[0], typ)
+	case types.T_uint64:
+		v := vec.Col.([]uint64)[0]
+		if v > math.MaxInt64 {
+			if typ.Oid == types.T_uint64 {
+				return v
+			}
+			return nil
+		}
+		return intValue(int64(v), typ)
+	case types.T_float64:
+		v := vec.Col.([]float64)[0]
+		switch typ.Oid {
+		case types.T_float32:
+			return float32(v)
+		case types.T_float64:
+			return v
+		}
+	case types.T_char, types.T_varchar:
+		if typ.Oid == types.T_char || typ.Oid == types.T_varchar {
+			return vec.Col.(*types.Bytes).Get(0)
+		}
+	}
+	return nil
+}
+
+func intValue(v int64, typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		if v >= math.MinInt8 && v <= math.MaxInt8 {
+			return int8(v)
+		}
+	case types.T_int16:
+		if v >= math.MinInt16 && v <= math.MaxInt16 {
+			return int16(v)
+		}
+	case types.T_int32:
+		if v >= math.MinInt32 && v <= math.MaxInt32 {
+			return int32(v)
+		}
+	case types.T_int64:
+		return v
+	case types.T_uint8:
+		if v >= 0 && v <= math.MaxUint8 {
+			return uint8(v)
+		}
+	case types.T_uint16:
+		if v >= 0 && v <= math.MaxUint16 {
+			return uint16(v)
+		}
+	case types.T_uint32:
+		if v >= 0 && v <= math.MaxUint32 {
+			return uint32(v)
+		}
+	case types.T_uint64:
+		if v >= 0 {
+			return uint64(v)
+		}
+	case types.T_float32:
+		return float32(v)
+	case types.T_float64:
+		return float64(v)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+var (
+	_ engine.FullTextSearcher = (*txnRelation)(nil)
+)
+
+// FullTextSearch resolves the query against the blocks of the table and the
+// rows appended by the txn, the index flushed with a block is used if there
+// is a FULLTEXT index on the attributes. The documents in the postings of the
+// query are matched, only their rows are read from the blocks.
+func (rel *txnRelation) FullTextSearch(attrs []string, q *fulltext.Query) (*fulltext.Search, error) {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	cols := make([]int, len(attrs))
+	for i, attr := range attrs {
+		if cols[i] = schema.GetColIdx(attr); cols[i] < 0 {
+			return nil, catalog.ErrNotFound
+		}
+	}
+	var corpus fulltext.Corpus
+	var texts []func(uint32) ([][]byte, error)
+	it := rel.handle.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		idx, err := blk.GetFullTextIndex(cols)
+		if err != nil {
+			return nil, err
+		}
+		corpus = append(corpus, idx)
+		texts = append(texts, blockTexts(blk, cols))
+		it.Next()
+	}
+	local, err := rel.handle.GetLocalBatches()
+	if err != nil {
+		return nil, err
+	}
+	for _, bat := range local {
+		idx := fulltext.NewIndex()
+		vecs := make([]*vector.Vector, len(cols))
+		for i, col := range cols {
+			vecs[i] = bat.Vecs[col]
+		}
+		idx.AddVectors(vecs)
+		corpus = append(corpus, idx)
+		texts = append(texts, vectorTexts(vecs))
+	}
+	search := corpus.Search(q)
+	for i, idx := range corpus {
+		if err = search.Match(idx, texts[i]); err != nil {
+			return nil, err
+		}
+	}
+	return search, nil
+}
+
+// blockTexts returns the texts of the documents of the full-text index of
+// the block, the columns are read by the first document.
+func blockTexts(blk handle.Block, cols []int) func(uint32) ([][]byte, error) {
+	var get func(uint32) ([][]byte, error)
+	return func(doc uint32) ([][]byte, error) {
+		if get == nil {
+			vecs := make([]*vector.Vector, len(cols))
+			for i, col := range cols {
+				view, err := blk.GetColumnDataById(col, nil, nil)
+				if err != nil {
+					return nil, err
+				}
+				vecs[i] = view.AppliedVec
+			}
+			get = vectorTexts(vecs)
+		}
+		return get(doc)
+	}
+}
+
+// vectorTexts returns the texts of the rows of the vectors which are not null
+func vectorTexts(vecs []*vector.Vector) func(uint32) ([][]byte, error) {
+	return func(row uint32) ([][]byte, error) {
+		texts := make([][]byte, 0, len(vecs))
+		for _, vec := range vecs {
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				continue
+			}
+			texts = append(texts, vec.Col.(*types.Bytes).Get(int64(row)))
+		}
+		return texts, nil
+	}
+}
@@ -17,8 +17,10 @@ package moengine
 import (
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 )
@@ -29,16 +31,32 @@ func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
 		Columns: make([]aoe.ColumnInfo, 0),
 		Indices: make([]aoe.IndexInfo, 0),
 	}
-	for idx, colDef := range schema.ColDefs {
+	for _, colDef := range schema.ColDefs {
 		col := aoe.ColumnInfo{
-			Name: colDef.Name,
-			Type: colDef.Type,
-		}
-		if idx == int(schema.PrimaryKey) {
-			col.PrimaryKey = true
+			Name:        colDef.Name,
+			Type:        colDef.Type,
+			Alg:         int(colDef.Alg),
+			Default:     defaultExpr(colDef),
+			NullAbility: colDef.NullAbility,
 		}
 		tblInfo.Columns = append(tblInfo.Columns, col)
 	}
+	if !schema.ImplicitKey {
+		for _, idx := range schema.GetPKIdxes() {
+			tblInfo.Columns[idx].PrimaryKey = true
+		}
+	}
+	for _, index := range schema.Indexes {
+		info := aoe.IndexInfo{
+			Name: index.Name,
+			Type: aoe.FullText,
+		}
+		for _, col := range index.Columns {
+			info.Columns = append(info.Columns, uint64(col))
+			info.ColumnNames = append(info.ColumnNames, schema.ColDefs[col].Name)
+		}
+		tblInfo.Indices = append(tblInfo.Indices, info)
+	}
 	return tblInfo
 }
 
@@ -54,6 +72,7 @@ func MockTableInfo(colCnt int) *aoe.TableInfo {
 		name := fmt.Sprintf("%s%d", prefix, i)
 		colInfo := aoe.ColumnInfo{
 			Name: name,
+			Alg:  compress.Lz4,
 		}
 		if i == 1 {
 			colInfo.Type = types.Type{Oid: types.T(types.T_varchar), Size: 24}
@@ -83,19 +102,78 @@ func MockIndexInfo() *aoe.IndexInfo {
 
 func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 	schema := catalog.NewEmptySchema(info.Name)
+	pks := make([]int, 0)
 	for idx, colInfo := range info.Columns {
 		newInfo := &catalog.ColDef{
-			Name: colInfo.Name,
-			Idx:  idx,
-			Type: colInfo.Type,
+			Name:        colInfo.Name,
+			Idx:         idx,
+			Type:        colInfo.Type,
+			Alg:         compress.T(colInfo.Alg),
+			NullAbility: colInfo.NullAbility,
+			Default:     columnDefault(colInfo.Default, colInfo.NullAbility),
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = int32(idx)
+			pks = append(pks, idx)
 			logutil.Debugf("Table to schema, schema.PrimaryKey is %d, its name is %v.", schema.PrimaryKey, colInfo.Name)
 		}
 		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
 		schema.ColDefs = append(schema.ColDefs, newInfo)
 	}
+	if len(pks) > 1 {
+		schema.SetCompositeKey(pks...)
+	}
+	// the first column is the key if there is no primary key
+	if len(pks) == 0 {
+		schema.ImplicitKey = true
+	}
+	// the zonemap of the primary key is always kept, the FULLTEXT indexes are
+	// the only other indexes
+	for _, info := range info.Indices {
+		if info.Type != aoe.FullText {
+			continue
+		}
+		index := catalog.NewIndexInfo(info.Name, catalog.FullText)
+		for _, name := range info.ColumnNames {
+			// an unknown column is out of range and the schema is invalid
+			index.Columns = append(index.Columns, uint16(schema.GetColIdx(name)))
+		}
+		schema.Indexes = append(schema.Indexes, index)
+	}
 
 	return schema
 }
+
+// columnDefault returns the default of the column from the default of the
+// attribute, the char values are kept as bytes.
+func columnDefault(expr engine.DefaultExpr, nullable bool) catalog.Default {
+	def := catalog.Default{Exist: expr.Exist}
+	if expr.Exist && expr.IsNull && !nullable {
+		// the null default of the column that is not nullable is no default
+		return catalog.Default{}
+	}
+	if !expr.Exist || expr.IsNull {
+		return def
+	}
+	if v, ok := expr.Value.(string); ok {
+		def.Value = []byte(v)
+	} else {
+		def.Value = expr.Value
+	}
+	return def
+}
+
+// defaultExpr returns the default of the attribute from the default of the
+// column.
+func defaultExpr(colDef *catalog.ColDef) engine.DefaultExpr {
+	if !colDef.Default.Exist {
+		return engine.EmptyDefaultExpr
+	}
+	if colDef.Default.Value == nil {
+		return engine.MakeDefaultExpr(true, nil, true)
+	}
+	if v, ok := colDef.Default.Value.([]byte); ok {
+		return engine.MakeDefaultExpr(true, string(v), false)
+	}
+	return engine.MakeDefaultExpr(true, colDef.Default.Value, false)
+}
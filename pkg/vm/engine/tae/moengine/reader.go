@@ -27,7 +27,7 @@ var (
 	_ engine.Reader = (*txnReader)(nil)
 )
 
-func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
+func newReader(rel handle.Relation, it handle.BlockIt, filter *handle.Filter) *txnReader {
 	attrCnt := len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs)
 	cds := make([]*bytes.Buffer, attrCnt)
 	dds := make([]*bytes.Buffer, attrCnt)
@@ -40,20 +40,58 @@ func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
 		decompressed: dds,
 		handle:       rel,
 		it:           it,
+		filter:       filter,
 	}
 }
 
 func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
-	r.it.Lock()
-	if !r.it.Valid() {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for {
+		r.it.Lock()
+		if !r.it.Valid() {
+			r.it.Unlock()
+			return r.readLocal(refCount, attrs), nil
+		}
+		h := r.it.GetBlock()
+		r.it.Next()
 		r.it.Unlock()
-		return nil, nil
+		if !r.mayContain(h) {
+			continue
+		}
+		block := newBlock(h)
+		return block.Read(refCount, attrs, r.compressed, r.decompressed)
+	}
+}
+
+// mayContain returns false if the block is skipped by the filters
+func (r *txnReader) mayContain(h handle.Block) bool {
+	if r.filter != nil && !h.MayContainsByFilter(r.filter) {
+		return false
+	}
+	for _, f := range r.colFilters {
+		if !h.MayContainsColumnRange(f.col, f.min, f.max) {
+			return false
+		}
+	}
+	return true
+}
+
+// readLocal returns the next batch of the rows appended by the txn
+func (r *txnReader) readLocal(refCount []uint64, attrs []string) *batch.Batch {
+	if len(r.local) == 0 {
+		return nil
+	}
+	local := r.local[0]
+	r.local = r.local[1:]
+	schema := r.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		bat.Vecs[i] = local.Vecs[schema.GetColIdx(attr)]
+		bat.Vecs[i].Ref = refCount[i]
 	}
-	h := r.it.GetBlock()
-	r.it.Next()
-	r.it.Unlock()
-	block := newBlock(h)
-	return block.Read(refCount, attrs, r.compressed, r.decompressed)
+	return bat
 }
 
 func (r *txnReader) NewFilter() engine.Filter {
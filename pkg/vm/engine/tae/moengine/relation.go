@@ -15,7 +15,10 @@
 package moengine
 
 import (
+	"errors"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
@@ -24,9 +27,18 @@ import (
 )
 
 var (
-	_ engine.Relation = (*txnRelation)(nil)
+	_ engine.Relation         = (*txnRelation)(nil)
+	_ engine.StatisticsWriter = (*txnRelation)(nil)
+)
+
+var (
+	ErrNotSupported = errors.New("tae: not supported")
 )
 
+// primaryIndexName is the name of the index on the primary key, the zonemap
+// and the bloom filter of it are maintained by TAE for every block.
+const primaryIndexName = "PRIMARY"
+
 func newRelation(h handle.Relation) *txnRelation {
 	return &txnRelation{
 		handle: h,
@@ -43,28 +55,164 @@ func (rel *txnRelation) Nodes() (nodes engine.Nodes) {
 	return
 }
 
-func (_ *txnRelation) Size(_ string) int64 {
-	return 0
+func (rel *txnRelation) Size(attr string) int64 {
+	return rel.handle.Size(attr)
+}
+
+// Stats returns the statistics of the column collected by analyze, they are
+// kept in the table entry of the catalog.
+func (rel *txnRelation) Stats(attr string) *engine.ColumnStatistics {
+	stats, err := rel.stats()
+	if err != nil {
+		return nil
+	}
+	return stats[attr]
+}
+
+// WriteStats replaces the statistics of the columns when the txn commits,
+// the statistics of the other columns are kept.
+func (rel *txnRelation) WriteStats(_ uint64, stats map[string]*engine.ColumnStatistics) error {
+	mp, err := rel.stats()
+	if err != nil {
+		return err
+	}
+	if mp == nil {
+		mp = make(map[string]*engine.ColumnStatistics)
+	}
+	for attr, st := range stats {
+		mp[attr] = st
+	}
+	data, err := encoding.Encode(mp)
+	if err != nil {
+		return err
+	}
+	return rel.handle.UpdateStats(data)
+}
+
+func (rel *txnRelation) stats() (map[string]*engine.ColumnStatistics, error) {
+	data, err := rel.handle.GetStats()
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	var mp map[string]*engine.ColumnStatistics
+	if err := encoding.Decode(data, &mp); err != nil {
+		return nil, err
+	}
+	return mp, nil
 }
 
-func (_ *txnRelation) CardinalNumber(_ string) int64 {
+// CardinalNumber returns the number of the distinct values of the column,
+// it is exact for the primary key. The other columns use the NDV collected
+// by analyze, it is 0 if the column has not been analyzed.
+func (rel *txnRelation) CardinalNumber(attr string) int64 {
+	if n := rel.handle.GetCardinality(attr); n > 0 {
+		return n
+	}
+	if stats := rel.Stats(attr); stats != nil {
+		return stats.NDV
+	}
 	return 0
 }
 
-func (_ *txnRelation) CreateIndex(_ uint64, _ []engine.TableDef) error {
-	panic("implement me")
+// CreateIndex only accepts the indexes maintained by TAE already: the zonemap
+// of the primary key, the zonemap of a column and the FULLTEXT indexes created
+// along with the table. Building a new index on the existing blocks is not
+// supported by TAE.
+func (rel *txnRelation) CreateIndex(_ uint64, defs []engine.TableDef) error {
+	for _, def := range defs {
+		if err := rel.checkIndexDef(def); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (_ *txnRelation) DropIndex(_ uint64, _ string) error {
-	panic("implement me")
+// DropIndex is not supported as the indexes of TAE are part of the blocks.
+func (rel *txnRelation) DropIndex(_ uint64, name string) error {
+	if name == primaryIndexName {
+		return ErrNotSupported
+	}
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	if col := schema.GetColIdx(name); col >= 0 {
+		if _, ok := schema.ZoneMapFile(col); ok {
+			return ErrNotSupported
+		}
+	}
+	for _, index := range schema.Indexes {
+		if index.Name == name {
+			return ErrNotSupported
+		}
+	}
+	return catalog.ErrNotFound
+}
+
+// AddTableDef only accepts the indexes accepted by CreateIndex, the schema of
+// a TAE table can not be altered.
+func (rel *txnRelation) AddTableDef(_ uint64, def engine.TableDef) error {
+	return rel.checkIndexDef(def)
+}
+
+// DelTableDef is not supported as the schema of a TAE table can not be
+// altered.
+func (rel *txnRelation) DelTableDef(_ uint64, _ engine.TableDef) error {
+	return ErrNotSupported
 }
 
-func (_ *txnRelation) AddTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+func (rel *txnRelation) checkIndexDef(def engine.TableDef) error {
+	idx, ok := def.(*engine.IndexTableDef)
+	if !ok {
+		return ErrNotSupported
+	}
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	var names []string
+	switch idx.Typ {
+	case engine.ZoneMap:
+		names = pkNames(schema)
+		if len(idx.ColNames) == 1 {
+			if col := schema.GetColIdx(idx.ColNames[0]); col >= 0 {
+				if _, ok := schema.ZoneMapFile(col); ok {
+					return nil
+				}
+			}
+		}
+	case engine.FullTextIndex:
+		// the FULLTEXT indexes are only created along with the table
+		for _, index := range schema.Indexes {
+			if index.Name == idx.Name {
+				names = indexNames(schema, index)
+			}
+		}
+	default:
+		return ErrNotSupported
+	}
+	if len(names) == 0 || len(idx.ColNames) != len(names) {
+		return ErrNotSupported
+	}
+	for i, name := range names {
+		if idx.ColNames[i] != name {
+			return ErrNotSupported
+		}
+	}
+	return nil
 }
 
-func (_ *txnRelation) DelTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+// pkNames returns the names of the columns of the primary key
+func pkNames(schema *catalog.Schema) []string {
+	idxes := schema.GetPKIdxes()
+	names := make([]string, len(idxes))
+	for i, idx := range idxes {
+		names[i] = schema.ColDefs[idx].Name
+	}
+	return names
+}
+
+// indexNames returns the names of the columns of the index
+func indexNames(schema *catalog.Schema, index *catalog.IndexInfo) []string {
+	names := make([]string, len(index.Columns))
+	for i, col := range index.Columns {
+		names[i] = schema.ColDefs[col].Name
+	}
+	return names
 }
 
 func (rel *txnRelation) TableDefs() []engine.TableDef {
@@ -78,15 +226,42 @@ func (rel *txnRelation) Rows() int64 {
 	return rel.handle.Rows()
 }
 
-func (_ *txnRelation) Index() []*engine.IndexTableDef {
-	panic("implement me")
+// Index returns the zonemap of the primary key, the zonemaps of the other
+// columns and the FULLTEXT indexes of the table.
+func (rel *txnRelation) Index() []*engine.IndexTableDef {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	defs := []*engine.IndexTableDef{{
+		Typ:      engine.ZoneMap,
+		ColNames: pkNames(schema),
+		Name:     primaryIndexName,
+	}}
+	for col, def := range schema.ColDefs {
+		if _, ok := schema.ZoneMapFile(col); ok {
+			defs = append(defs, &engine.IndexTableDef{
+				Typ:      engine.ZoneMap,
+				ColNames: []string{def.Name},
+				Name:     def.Name,
+			})
+		}
+	}
+	for _, index := range schema.Indexes {
+		defs = append(defs, &engine.IndexTableDef{
+			Typ:      engine.FullTextIndex,
+			ColNames: indexNames(schema, index),
+			Name:     index.Name,
+		})
+	}
+	return defs
 }
 
 func (rel *txnRelation) GetPriKeyOrHideKey() ([]engine.Attribute, bool) {
 	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
-	attrs := make([]engine.Attribute, 1)
-	attrs[0].Name = schema.ColDefs[schema.PrimaryKey].Name
-	attrs[0].Type = schema.ColDefs[schema.PrimaryKey].Type
+	idxes := schema.GetPKIdxes()
+	attrs := make([]engine.Attribute, len(idxes))
+	for i, idx := range idxes {
+		attrs[i].Name = schema.ColDefs[idx].Name
+		attrs[i].Type = schema.ColDefs[idx].Type
+	}
 	return attrs, true
 }
 
@@ -96,19 +271,39 @@ func (rel *txnRelation) Attribute() []engine.Attribute {
 	for idx, attr := range attrs {
 		attr.Name = meta.GetSchema().ColDefs[idx].Name
 		attr.Type = meta.GetSchema().ColDefs[idx].Type
+		attr.Default = defaultExpr(meta.GetSchema().ColDefs[idx])
+		attr.Nullability = meta.GetSchema().ColDefs[idx].NullAbility
 		attrs[idx] = attr
 	}
 	return attrs
 }
 
+// Write appends the batch whose attributes may be in any order, the omitted
+// attributes are filled with their default values.
 func (rel *txnRelation) Write(_ uint64, bat *batch.Batch) error {
-	return rel.handle.Append(bat)
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	vecs, err := schema.FillDefaults(bat.Attrs, bat.Vecs)
+	if err != nil {
+		return err
+	}
+	filled := batch.New(true, schema.Attrs())
+	filled.Vecs = vecs
+	return rel.handle.Append(filled)
 }
 
-func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte) (rds []engine.Reader) {
+func (rel *txnRelation) NewReader(num int, e extend.Extend, _ []byte) (rds []engine.Reader) {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	filter := newPKFilter(schema, e)
+	colFilters := newColumnFilters(schema, e)
 	it := rel.handle.MakeBlockIt()
+	local, err := rel.handle.GetLocalBatches()
 	for i := 0; i < num; i++ {
-		reader := newReader(rel.handle, it)
+		reader := newReader(rel.handle, it, filter)
+		reader.colFilters = colFilters
+		reader.err = err
+		if i == 0 {
+			reader.local = local
+		}
 		rds = append(rds, reader)
 	}
 	return
@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"runtime"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+var (
+	_ engine.TxnEngine = (*taeEngine)(nil)
+	_ engine.Backuper  = (*taeEngine)(nil)
+)
+
+// NewTAEEngine returns the engine of the db, every operation of it is
+// committed in its own transaction.
+func NewTAEEngine(db *db.DB) *taeEngine {
+	return &taeEngine{
+		db: db,
+	}
+}
+
+func (e *taeEngine) StartTxn() (engine.Txn, error) {
+	return NewEngine(e.db.StartTxn(nil)), nil
+}
+
+// autocommit runs fn in a new transaction and commits it if fn succeeds
+func (e *taeEngine) autocommit(fn func(engine.Txn) error) error {
+	txn, err := e.StartTxn()
+	if err != nil {
+		return err
+	}
+	if err = fn(txn); err != nil {
+		txn.Rollback()
+		return err
+	}
+	return txn.Commit()
+}
+
+func (e *taeEngine) Delete(epoch uint64, name string) error {
+	return e.autocommit(func(txn engine.Txn) error {
+		return txn.Delete(epoch, name)
+	})
+}
+
+func (e *taeEngine) Create(epoch uint64, name string, typ int) error {
+	return e.autocommit(func(txn engine.Txn) error {
+		return txn.Create(epoch, name, typ)
+	})
+}
+
+func (e *taeEngine) Databases() (dbs []string) {
+	e.autocommit(func(txn engine.Txn) error {
+		dbs = txn.Databases()
+		return nil
+	})
+	return
+}
+
+// Database returns the database read at the time it is opened, the changes
+// made through it are not committed, use StartTxn to change the database.
+func (e *taeEngine) Database(name string) (db engine.Database, err error) {
+	err = e.autocommit(func(txn engine.Txn) error {
+		db, err = txn.Database(name)
+		return err
+	})
+	return
+}
+
+// Backup takes a backup of the running db into the target.
+func (e *taeEngine) Backup(target string) error {
+	_, err := e.db.Backup(target)
+	return err
+}
+
+func (e *taeEngine) Node(ip string) *engine.NodeInfo {
+	return &engine.NodeInfo{Mcpu: runtime.NumCPU()}
+}
@@ -17,10 +17,17 @@ package moengine
 import (
 	"bytes"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
+type taeEngine struct {
+	db *db.DB
+}
+
 type txnEngine struct {
 	txn txnif.AsyncTxn
 }
@@ -44,6 +51,10 @@ type txnBlock struct {
 type txnReader struct {
 	handle       handle.Relation
 	it           handle.BlockIt
+	filter       *handle.Filter  // the filter of the primary key to skip the blocks
+	colFilters   []*columnFilter // the filters of the other columns to skip the blocks
+	local        []*batch.Batch  // the rows appended by the txn
+	err          error           // the error of making the reader, returned by Read
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
 }
@@ -84,7 +84,7 @@ func (appender *blockAppender) ApplyAppend(bat *gbat.Batch, offset, length uint3
 		return err
 	})
 
-	pks := bat.Vecs[appender.node.block.meta.GetSchema().PrimaryKey]
+	pks := appender.node.block.meta.GetSchema().GetPKVector(bat.Vecs)
 	// logutil.Infof("Append into %d: %s", appender.node.meta.GetID(), pks.String())
 	err = appender.indexAppender.BatchInsert(pks, offset, int(length), from, false)
 	if err != nil {
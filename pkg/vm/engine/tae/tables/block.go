@@ -17,6 +17,7 @@ package tables
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -63,8 +64,7 @@ type dataBlock struct {
 
 func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeManager, scheduler tasks.TaskScheduler) *dataBlock {
 	colCnt := len(meta.GetSchema().ColDefs)
-	indexCnt := make(map[int]int)
-	indexCnt[int(meta.GetSchema().PrimaryKey)] = 2
+	indexCnt := meta.GetSchema().IndexFileCnt()
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
 		panic(err)
@@ -74,6 +74,7 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 		if colBlk, err := file.OpenColumn(i); err != nil {
 			panic(err)
 		} else {
+			colBlk.SetCompressAlgo(meta.GetSchema().ColDefs[i].Alg)
 			colFiles[i], err = colBlk.OpenDataFile()
 			if err != nil {
 				panic(err)
@@ -99,14 +100,28 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 	} else {
 		block.indexHolder = impl.NewEmptyNonAppendableBlockIndexHolder()
 	}
+	if err = block.replayFile(); err != nil {
+		panic(err)
+	}
 	return block
 }
 
 func (blk *dataBlock) ReplayData() (err error) {
 	if blk.meta.IsAppendable() {
-		w, _ := blk.getVectorWrapper(int(blk.meta.GetSchema().PrimaryKey))
-		defer common.GPool.Free(w.MNode)
-		blk.indexHolder.(acif.IAppendableBlockIndexHolder).BatchInsert(&w.Vector, 0, gvec.Length(&w.Vector), 0, false)
+		// The appendable block file is in the format of the node, the keys
+		// are read from the loaded node
+		h := blk.bufMgr.Pin(blk.node)
+		defer h.Close()
+		schema := blk.meta.GetSchema()
+		rows := blk.node.rows
+		vecs := make([]*gvec.Vector, len(schema.ColDefs))
+		for _, idx := range schema.GetPKIdxes() {
+			if vecs[idx], err = blk.node.GetVectorCopy(rows, idx, nil, nil); err != nil {
+				return
+			}
+		}
+		pks := schema.GetPKVector(vecs)
+		blk.indexHolder.(acif.IAppendableBlockIndexHolder).BatchInsert(pks, 0, gvec.Length(pks), 0, false)
 		return
 	}
 	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).InitFromHost(blk, blk.meta.GetSchema(), idxCommon.MockIndexBufferManager /* TODO: use dedicated index buffer manager */)
@@ -552,6 +567,47 @@ func (blk *dataBlock) getVectorWrapper(colIdx int) (wrapper *vector.VectorWrappe
 	return
 }
 
+// getPKVector returns the keys of the rows stored in the files of the columns
+// of the primary key, the buffers of the columns are released by free
+func (blk *dataBlock) getPKVector() (pks *gvec.Vector, free func(), err error) {
+	schema := blk.meta.GetSchema()
+	vecs := make([]*gvec.Vector, len(schema.ColDefs))
+	wrappers := make([]*vector.VectorWrapper, 0)
+	free = func() {
+		for _, w := range wrappers {
+			common.GPool.Free(w.MNode)
+		}
+	}
+	for _, idx := range schema.GetPKIdxes() {
+		var w *vector.VectorWrapper
+		if w, err = blk.getVectorWrapper(idx); err != nil {
+			free()
+			return
+		}
+		wrappers = append(wrappers, w)
+		vecs[idx] = &w.Vector
+	}
+	pks = schema.GetPKVector(vecs)
+	return
+}
+
+// getPKView returns the keys of the rows visible to the txn and the mask of
+// the deleted rows
+func (blk *dataBlock) getPKView(txn txnif.AsyncTxn) (pks *gvec.Vector, deletes *roaring.Bitmap, err error) {
+	schema := blk.meta.GetSchema()
+	vecs := make([]*gvec.Vector, len(schema.ColDefs))
+	for _, idx := range schema.GetPKIdxes() {
+		var view *model.ColumnView
+		if view, err = blk.GetColumnDataById(txn, idx, nil, nil); err != nil {
+			return
+		}
+		vecs[idx] = view.AppliedVec
+		deletes = view.DeleteMask
+	}
+	pks = schema.GetPKVector(vecs)
+	return
+}
+
 func (blk *dataBlock) ablkGetByFilter(ts uint64, filter *handle.Filter) (offset uint32, err error) {
 	readLock := blk.mvcc.GetSharedLock()
 	defer readLock.Unlock()
@@ -571,13 +627,12 @@ func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset u
 		err = txnbase.ErrNotFound
 		return
 	}
-	pkColumn, err := blk.getVectorWrapper(int(blk.meta.GetSchema().PrimaryKey))
+	pks, free, err := blk.getPKVector()
 	if err != nil {
 		return
 	}
-	defer common.GPool.Free(pkColumn.MNode)
-	data := &pkColumn.Vector
-	offset, exist := compute.CheckRowExists(data, filter.Val, nil)
+	defer free()
+	offset, exist := compute.CheckRowExists(pks, filter.Val, withNullRows(nil, pks))
 	if !exist {
 		err = txnbase.ErrNotFound
 		return
@@ -601,6 +656,43 @@ func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (of
 	return blk.blkGetByFilter(txn.GetStartTS(), filter)
 }
 
+func (blk *dataBlock) MayContainsByFilter(filter *handle.Filter) bool {
+	if blk.indexHolder == nil {
+		return true
+	}
+	var exist bool
+	var err error
+	switch filter.Op {
+	case handle.FilterEq:
+		if blk.meta.IsAppendable() {
+			// the keys of an appendable block are all in its tree index
+			readLock := blk.mvcc.GetSharedLock()
+			_, err = blk.indexHolder.(acif.IAppendableBlockIndexHolder).Search(filter.Val)
+			readLock.Unlock()
+			return err != errors.ErrKeyNotFound
+		}
+		exist = blk.indexHolder.(acif.INonAppendableBlockIndexHolder).MayContainsKey(filter.Val)
+	case handle.FilterBtw:
+		exist, err = blk.indexHolder.MayContainsRange(filter.Val, filter.Max)
+	default:
+		return true
+	}
+	return exist || err != nil
+}
+
+func (blk *dataBlock) MayContainsColumnRange(col int, min, max interface{}) bool {
+	schema := blk.meta.GetSchema()
+	if col == int(schema.PrimaryKey) && !schema.IsCompositeKey() {
+		return blk.MayContainsByFilter(handle.NewBtwFilter(min, max))
+	}
+	// the columns of an appendable block have no zonemaps
+	if blk.indexHolder == nil || blk.meta.IsAppendable() {
+		return true
+	}
+	exist, err := blk.indexHolder.(acif.INonAppendableBlockIndexHolder).MayContainsColumnRange(uint16(col), min, max)
+	return exist || err != nil
+}
+
 func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *gvec.Vector) (err error) {
 	if blk.meta.IsAppendable() {
 		readLock := blk.mvcc.GetSharedLock()
@@ -624,12 +716,13 @@ func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *gvec.Vector) (err erro
 	if visibilityMap == nil {
 		panic("unexpected error")
 	}
-	view, err := blk.GetColumnDataById(txn, int(blk.meta.GetSchema().PrimaryKey), nil, nil)
+	keys, deletes, err := blk.getPKView(txn)
 	if err != nil {
 		return err
 	}
+	deletes = withNullRows(deletes, keys)
 	deduplicate := func(v interface{}) error {
-		if _, exist := compute.CheckRowExists(view.AppliedVec, v, view.DeleteMask); exist {
+		if _, exist := compute.CheckRowExists(keys, v, deletes); exist {
 			return txnbase.ErrDuplicated
 		}
 		return nil
@@ -640,6 +733,19 @@ func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *gvec.Vector) (err erro
 	return
 }
 
+// withNullRows returns the deletes along with the rows of the null keys, which
+// are not unique and never match a key.
+func withNullRows(deletes *roaring.Bitmap, keys *gvec.Vector) *roaring.Bitmap {
+	nullRows := compute.NullRows(keys)
+	if nullRows == nil {
+		return deletes
+	}
+	if deletes == nil {
+		return nullRows
+	}
+	return roaring.Or(deletes, nullRows)
+}
+
 func (blk *dataBlock) CollectAppendLogIndexes(startTs, endTs uint64) (indexes []*wal.Index) {
 	readLock := blk.mvcc.GetSharedLock()
 	defer readLock.Unlock()
@@ -668,3 +774,135 @@ func (blk *dataBlock) CollectChangesInRange(startTs, endTs uint64) (view *model.
 	blk.mvcc.RUnlock()
 	return
 }
+
+func (blk *dataBlock) CollectVersions(ts uint64) (view *model.VersionView, err error) {
+	if blk.IsClosed() {
+		return nil, data.ErrBlockClosed
+	}
+	view = model.NewVersionView(ts)
+	blk.meta.RLock()
+	createAt, deleteAt := blk.meta.CreateAt, blk.meta.DeleteAt
+	blk.meta.RUnlock()
+	if createAt == 0 || createAt > ts {
+		return
+	}
+	if deleteAt > ts {
+		deleteAt = 0
+	}
+
+	schema := blk.meta.GetSchema()
+	vecs := make([]*gvec.Vector, len(schema.ColDefs))
+	var inserts []uint64
+	if blk.meta.IsAppendable() {
+		h := blk.node.mgr.Pin(blk.node)
+		if h == nil {
+			panic("not expected")
+		}
+		defer h.Close()
+		blk.mvcc.RLock()
+		inserts = blk.mvcc.CollectAppendsLocked(ts)
+		blk.mvcc.RUnlock()
+		for i := range vecs {
+			if vecs[i], err = blk.node.GetVectorCopy(uint32(len(inserts)), i, nil, nil); err != nil {
+				return
+			}
+		}
+	} else {
+		for i := range vecs {
+			if vecs[i], err = blk.getVectorWithBuffer(i, new(bytes.Buffer), new(bytes.Buffer)); err != nil {
+				return
+			}
+		}
+		inserts = make([]uint64, gvec.Length(vecs[0]))
+		for i := range inserts {
+			inserts[i] = createAt
+		}
+	}
+
+	blk.mvcc.RLock()
+	defer blk.mvcc.RUnlock()
+	deleteChain := blk.mvcc.GetDeleteChain()
+	deleteChain.RLock()
+	deletes := deleteChain.CollectDeleteTSLocked(ts)
+	deleteChain.RUnlock()
+	updates := make(map[uint16]map[uint32][]uint64)
+	for i := range schema.ColDefs {
+		chain := blk.mvcc.GetColumnChain(uint16(i))
+		chain.RLock()
+		if rows := chain.CollectUpdateTSLocked(ts); len(rows) > 0 {
+			updates[uint16(i)] = rows
+		}
+		chain.RUnlock()
+	}
+
+	// Every update of a row ends the current version of the row and
+	// starts a new version with the values at the update ts
+	type version struct {
+		row        uint32
+		start, end uint64
+	}
+	sels := make([]int64, 0, len(inserts))
+	extras := make([]version, 0)
+	for row, insertTs := range inserts {
+		if insertTs == 0 {
+			continue
+		}
+		deleteTs := deleteAt
+		if commitTs, ok := deletes[uint32(row)]; ok && (deleteTs == 0 || commitTs < deleteTs) {
+			deleteTs = commitTs
+		}
+		if deleteTs != 0 && deleteTs <= insertTs {
+			continue
+		}
+		var updateTs []uint64
+		for _, rows := range updates {
+			for _, commitTs := range rows[uint32(row)] {
+				if commitTs > insertTs && (deleteTs == 0 || commitTs < deleteTs) {
+					updateTs = append(updateTs, commitTs)
+				}
+			}
+		}
+		sort.Slice(updateTs, func(i, j int) bool { return updateTs[i] < updateTs[j] })
+		bounds := []uint64{insertTs}
+		for _, commitTs := range updateTs {
+			if commitTs != bounds[len(bounds)-1] {
+				bounds = append(bounds, commitTs)
+			}
+		}
+		bounds = append(bounds, deleteTs)
+		sels = append(sels, int64(row))
+		view.InsertTs = append(view.InsertTs, bounds[0])
+		view.DeleteTs = append(view.DeleteTs, bounds[1])
+		for i := 1; i < len(bounds)-1; i++ {
+			extras = append(extras, version{row: uint32(row), start: bounds[i], end: bounds[i+1]})
+		}
+	}
+
+	// The first version of a row is the raw row and the later versions
+	// are appended after the raw rows
+	vals := make([][]interface{}, len(vecs))
+	for _, extra := range extras {
+		view.InsertTs = append(view.InsertTs, extra.start)
+		view.DeleteTs = append(view.DeleteTs, extra.end)
+		for colIdx := range vecs {
+			v := compute.GetValue(vecs[colIdx], extra.row)
+			if _, ok := updates[uint16(colIdx)]; ok {
+				chain := blk.mvcc.GetColumnChain(uint16(colIdx))
+				chain.RLock()
+				if updated, err := chain.GetCommittedValueLocked(extra.row, extra.start); err == nil {
+					v = updated
+				}
+				chain.RUnlock()
+			}
+			vals[colIdx] = append(vals[colIdx], v)
+		}
+	}
+	for colIdx, vec := range vecs {
+		gvec.Shrink(vec, sels)
+		for _, v := range vals[colIdx] {
+			compute.AppendValue(vec, v)
+		}
+	}
+	view.Columns = vecs
+	return
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
 
@@ -116,7 +117,7 @@ func (blk *dataBlock) SyncBlockData(ts uint64, rows uint32) (err error) {
 
 func (blk *dataBlock) ForceCompact() (err error) {
 	if !blk.meta.IsAppendable() {
-		panic("todo")
+		return blk.BlkFlushChanges()
 	}
 	ts := blk.mvcc.LoadMaxVisible()
 	if blk.node.GetBlockMaxFlushTS() >= ts {
@@ -173,3 +174,60 @@ func (blk *dataBlock) ABlkFlushData(ts uint64, bat batch.IBatch, masks map[uint1
 	logutil.Infof("FLUSH ABLK | [%s] | Done | MaxRow=%d | MaxTs=%d", blk.meta.String(), bat.Length(), ts)
 	return
 }
+
+// BlkFlushChanges writes the updates and deletes of the non-appendable block
+// committed at or before the max visible ts to the block file
+func (blk *dataBlock) BlkFlushChanges() (err error) {
+	ts := blk.mvcc.LoadMaxVisible()
+	flushTs, err := blk.file.ReadTS()
+	if err != nil || ts <= flushTs {
+		return
+	}
+	schema := blk.meta.GetSchema()
+	readLock := blk.mvcc.GetSharedLock()
+	masks := make(map[int]*roaring.Bitmap)
+	vals := make(map[int]map[uint32]interface{})
+	for i := range schema.ColDefs {
+		chain := blk.mvcc.GetColumnChain(uint16(i))
+		chain.RLock()
+		masks[i], vals[i] = chain.CollectUpdatesLocked(ts)
+		chain.RUnlock()
+	}
+	dnode := blk.mvcc.GetDeleteChain().CollectDeletesLocked(ts, false).(*updates.DeleteNode)
+	readLock.Unlock()
+	if dnode != nil {
+		var buf []byte
+		if buf, err = dnode.GetDeleteMaskLocked().ToBytes(); err != nil {
+			return
+		}
+		if err = blk.file.WriteDeletes(buf); err != nil {
+			return
+		}
+	}
+	for i, mask := range masks {
+		if mask == nil {
+			continue
+		}
+		var buf []byte
+		if buf, err = encodeUpdates(schema.ColDefs[i].Type, mask, vals[i]); err != nil {
+			return
+		}
+		colBlk, err := blk.file.OpenColumn(i)
+		if err != nil {
+			return err
+		}
+		err = colBlk.WriteUpdates(buf)
+		colBlk.Close()
+		if err != nil {
+			return err
+		}
+	}
+	if err = blk.file.WriteTS(ts); err != nil {
+		return
+	}
+	if err = blk.file.Sync(); err != nil {
+		return
+	}
+	logutil.Infof("FLUSH BLK | [%s] | Done | MaxTs=%d", blk.meta.String(), ts)
+	return
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"github.com/RoaringBitmap/roaring"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/io"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+)
+
+// GetFullTextIndex returns the full-text index of the rows of the block which
+// are visible to the txn. The index flushed with a non-appendable block is
+// used if the columns are those of a FULLTEXT index which are not updated,
+// the deleted rows are removed from it. The index of the other blocks is
+// built from their rows. The documents of the index are numbered by the
+// rows of the block.
+func (blk *dataBlock) GetFullTextIndex(txn txnif.AsyncTxn, cols []int) (idx *fulltext.Index, err error) {
+	if !blk.meta.IsAppendable() {
+		if idx, err = blk.loadFullTextIndex(txn, cols); idx != nil || err != nil {
+			return
+		}
+	}
+	idx = fulltext.NewIndex()
+	vecs := make([]*gvec.Vector, len(cols))
+	var deletes *roaring.Bitmap
+	for i, col := range cols {
+		view, err := blk.GetColumnDataById(txn, col, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		// the rows of the appendable block are not visible
+		if view == nil {
+			return idx, nil
+		}
+		vecs[i], deletes = view.AppliedVec, view.DeleteMask
+	}
+	// the documents are numbered by the rows of the block as those of
+	// the flushed index
+	idx.AddVectors(vecs)
+	if deletes != nil {
+		idx.Delete(deletes.ToArray()...)
+	}
+	return
+}
+
+// loadFullTextIndex returns nil if there is no flushed index to use
+func (blk *dataBlock) loadFullTextIndex(txn txnif.AsyncTxn, cols []int) (*fulltext.Index, error) {
+	schema := blk.meta.GetSchema()
+	pos := fullTextIndexPos(schema, cols)
+	if pos < 0 {
+		return nil, nil
+	}
+	ts := txn.GetStartTS()
+	blk.mvcc.RLock()
+	for _, col := range cols {
+		chain := blk.mvcc.GetColumnChain(uint16(col))
+		chain.RLock()
+		mask, _ := chain.CollectUpdatesLocked(ts)
+		chain.RUnlock()
+		if mask != nil && !mask.IsEmpty() {
+			blk.mvcc.RUnlock()
+			return nil, nil
+		}
+	}
+	dnode := blk.mvcc.GetDeleteChain().CollectDeletesLocked(ts, false).(*updates.DeleteNode)
+	blk.mvcc.RUnlock()
+
+	col, internal := schema.IndexFile(pos)
+	metas, err := blk.file.LoadIndexMeta()
+	if err != nil {
+		return nil, err
+	}
+	for _, meta := range metas.Metas {
+		if meta.IdxType != idxCommon.FullTextIndex || int(meta.ColIdx) != col || int(meta.InternalIdx) != internal {
+			continue
+		}
+		colFile, err := blk.file.OpenColumn(col)
+		if err != nil {
+			return nil, err
+		}
+		defer colFile.Close()
+		idxFile, err := colFile.OpenIndexFile(internal)
+		if err != nil {
+			return nil, err
+		}
+		defer idxFile.Unref()
+		idx, err := io.LoadFullTextIndex(idxFile, &meta)
+		if err != nil {
+			return nil, err
+		}
+		if dnode != nil {
+			idx.Delete(dnode.GetDeleteMaskLocked().ToArray()...)
+		}
+		return idx, nil
+	}
+	return nil, nil
+}
+
+// fullTextIndexPos returns the position of the FULLTEXT index on the columns
+// in the indexes of the schema, or -1 if there is no such index
+func fullTextIndexPos(schema *catalog.Schema, cols []int) int {
+	for i, index := range schema.Indexes {
+		if index.Type != catalog.FullText || len(index.Columns) != len(cols) {
+			continue
+		}
+		matched := true
+		for j, col := range cols {
+			if int(index.Columns[j]) != col {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i
+		}
+	}
+	return -1
+}
@@ -87,8 +87,11 @@ func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
 		vec := view.ApplyDeletes()
 		bat.Vecs[i] = vec
 	}
-	if err = mergesort.SortBlockColumns(bat.Vecs, int(task.meta.GetSchema().PrimaryKey)); err != nil {
-		return
+	schema := task.meta.GetSchema()
+	if schema.IsCompositeKey() {
+		err = mergesort.SortBlockColumnsByKey(bat.Vecs, schema.GetPKVector(bat.Vecs))
+	} else {
+		err = mergesort.SortBlockColumns(bat.Vecs, int(schema.PrimaryKey))
 	}
 	return
 }
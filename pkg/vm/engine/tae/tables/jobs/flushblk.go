@@ -44,8 +44,8 @@ func NewFlushBlkTask(ctx *tasks.Context, bf file.Block, ts uint64, meta *catalog
 func (task *flushBlkTask) Scope() *common.ID { return task.meta.AsCommonID() }
 
 func (task *flushBlkTask) Execute() (err error) {
-	pkColumnData := task.data.Vecs[task.meta.GetSchema().PrimaryKey]
-	if err = BuildAndFlushBlockIndex(task.file, task.meta, pkColumnData); err != nil {
+	pkColumnData := task.meta.GetSchema().GetPKVector(task.data.Vecs)
+	if err = BuildAndFlushBlockIndex(task.file, task.meta, pkColumnData, task.data.Vecs); err != nil {
 		return
 	}
 	if err = task.file.WriteBatch(task.data, task.ts); err != nil {
@@ -18,11 +18,30 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/io"
 )
 
-func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector) (err error) {
+// getPKVector returns the keys of the rows of the block which are not deleted
+func getPKVector(block handle.Block, schema *catalog.Schema) (*vector.Vector, error) {
+	vecs := make([]*vector.Vector, len(schema.ColDefs))
+	for _, idx := range schema.GetPKIdxes() {
+		view, err := block.GetColumnDataById(idx, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		vecs[idx] = view.ApplyDeletes()
+	}
+	return schema.GetPKVector(vecs), nil
+}
+
+// BuildAndFlushBlockIndex writes the indexes of the block. The columns are
+// the data of the block by the column index, they are used by the FULLTEXT
+// indexes and the zonemaps of the columns other than the primary key. They
+// may be nil for an appendable block, whose full-text indexes are built from
+// its rows when searched and whose columns have no zonemaps.
+func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector, columns []*vector.Vector) (err error) {
 	// write indexes, collect their meta, and refresh host's index holder
 	schema := meta.GetSchema()
 	pkColumn, err := file.OpenColumn(int(schema.PrimaryKey))
@@ -70,6 +89,32 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 		return err
 	}
 	metas.AddIndex(*sfMeta)
+
+	if !meta.IsAppendable() {
+		for i, index := range schema.Indexes {
+			col, internal := schema.IndexFile(i)
+			vecs := make([]*vector.Vector, len(index.Columns))
+			for j, idx := range index.Columns {
+				vecs[j] = columns[idx]
+			}
+			var ftMeta *idxCommon.IndexMeta
+			if ftMeta, err = flushFullTextIndex(file, col, internal, vecs); err != nil {
+				return
+			}
+			metas.AddIndex(*ftMeta)
+		}
+		for col := range schema.ColDefs {
+			internal, ok := schema.ZoneMapFile(col)
+			if !ok || columns[col] == nil {
+				continue
+			}
+			var cmMeta *idxCommon.IndexMeta
+			if cmMeta, err = flushColumnZoneMap(file, col, internal, columns[col]); err != nil {
+				return
+			}
+			metas.AddIndex(*cmMeta)
+		}
+	}
 	metaBuf, err := metas.Marshal()
 	if err != nil {
 		return err
@@ -81,3 +126,45 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 	}
 	return nil
 }
+
+func flushFullTextIndex(file file.Block, col, internal int, vecs []*vector.Vector) (meta *idxCommon.IndexMeta, err error) {
+	colFile, err := file.OpenColumn(col)
+	if err != nil {
+		return
+	}
+	defer colFile.Close()
+	idxFile, err := colFile.OpenIndexFile(internal)
+	if err != nil {
+		return
+	}
+	defer idxFile.Unref()
+	writer := io.NewFullTextIndexWriter()
+	if err = writer.Init(idxFile, idxCommon.Plain, uint16(col), uint16(internal)); err != nil {
+		return
+	}
+	if err = writer.AddValues(vecs); err != nil {
+		return
+	}
+	return writer.Finalize()
+}
+
+func flushColumnZoneMap(file file.Block, col, internal int, vec *vector.Vector) (meta *idxCommon.IndexMeta, err error) {
+	colFile, err := file.OpenColumn(col)
+	if err != nil {
+		return
+	}
+	defer colFile.Close()
+	idxFile, err := colFile.OpenIndexFile(internal)
+	if err != nil {
+		return
+	}
+	defer idxFile.Unref()
+	writer := io.NewBlockZoneMapIndexWriter()
+	if err = writer.Init(idxFile, idxCommon.Plain, uint16(col), uint16(internal)); err != nil {
+		return
+	}
+	if err = writer.AddValues(vec); err != nil {
+		return
+	}
+	return writer.Finalize()
+}
@@ -131,10 +131,10 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	var toAddr []uint32
 	ids := make([]*common.ID, 0, len(task.compacted))
 	for i, block := range task.compacted {
-		if view, err = block.GetColumnDataById(int(schema.PrimaryKey), nil, nil); err != nil {
+		var vec *gvec.Vector
+		if vec, err = getPKVector(block, schema); err != nil {
 			return
 		}
-		vec := view.ApplyDeletes()
 		vecs = append(vecs, vec)
 		rows[i] = uint32(gvec.Length(vec))
 		fromAddr = append(fromAddr, uint32(length))
@@ -165,7 +165,12 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	var flushTask tasks.Task
 	length = 0
 	var blk handle.Block
-	for _, vec := range vecs {
+	// the keys and the columns of the new blocks are kept to build their
+	// indexes once all the columns are merged
+	keys := append([]*vector.Vector{}, vecs...)
+	columns := make([][]*vector.Vector, len(vecs))
+	for pos, vec := range vecs {
+		columns[pos] = make([]*vector.Vector, len(schema.ColDefs))
 		toAddr = append(toAddr, uint32(length))
 		length += gvec.Length(vec)
 		blk, err = toSegEntry.CreateNonAppendableBlock()
@@ -174,19 +179,18 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 		task.createdBlks = append(task.createdBlks, blk.GetMeta().(*catalog.BlockEntry))
 		meta := blk.GetMeta().(*catalog.BlockEntry)
-		closure := meta.GetBlockData().FlushColumnDataClosure(ts, int(schema.PrimaryKey), vec, false)
-		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, meta.AsCommonID(), closure)
-		if err != nil {
-			return
-		}
-		if err = flushTask.WaitDone(); err != nil {
-			return
-		}
-		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
-			return
-		}
-		if err = meta.GetBlockData().ReplayData(); err != nil {
-			return
+		// the packed keys of the composite primary key are only indexed, the
+		// columns of the key are flushed along with the other columns
+		if !schema.IsCompositeKey() {
+			columns[pos][schema.PrimaryKey] = vec
+			closure := meta.GetBlockData().FlushColumnDataClosure(ts, int(schema.PrimaryKey), vec, false)
+			flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, meta.AsCommonID(), closure)
+			if err != nil {
+				return
+			}
+			if err = flushTask.WaitDone(); err != nil {
+				return
+			}
 		}
 		// bf := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile()
 		// if bf.WriteColumnVec(task.txn.GetStartTS(), int(schema.PrimaryKey), vec); err != nil {
@@ -195,7 +199,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	}
 
 	for i := 0; i < len(schema.ColDefs); i++ {
-		if i == int(schema.PrimaryKey) {
+		if i == int(schema.PrimaryKey) && !schema.IsCompositeKey() {
 			continue
 		}
 		vecs = vecs[:0]
@@ -208,6 +212,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 		vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to)
 		for pos, vec := range vecs {
+			columns[pos][i] = vec
 			blk := task.createdBlks[pos]
 			closure := blk.GetBlockData().FlushColumnDataClosure(ts, i, vec, false)
 			flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
@@ -219,6 +224,14 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			}
 		}
 	}
+	for pos, meta := range task.createdBlks {
+		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, keys[pos], columns[pos]); err != nil {
+			return
+		}
+		if err = meta.GetBlockData().ReplayData(); err != nil {
+			return
+		}
+	}
 	for i, blk := range task.createdBlks {
 		closure := blk.GetBlockData().SyncBlockDataClosure(ts, rows[i])
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
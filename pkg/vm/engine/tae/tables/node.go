@@ -177,7 +177,8 @@ func (node *appendableNode) OnUnload() {
 	ts := node.block.mvcc.LoadMaxVisible()
 	needCkp := true
 	if err := node.flushData(ts, node.data); err != nil {
-		if err == data.ErrStaleRequest {
+		if err == data.ErrStaleRequest || err == tasks.ErrSchedule {
+			// the scheduler is stopped while closing, the data is still in the wal
 			needCkp = false
 		} else {
 			panic(err)
@@ -0,0 +1,152 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"bytes"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+// replayFile restores the rows, updates and deletes flushed to the block file
+// of a reopened segment. They were committed at or before the ts of the file,
+// the block file of a new block has no ts and nothing is restored.
+func (blk *dataBlock) replayFile() (err error) {
+	ts, err := blk.file.ReadTS()
+	if err != nil || ts == 0 {
+		return
+	}
+	rows := blk.file.ReadRows()
+	deletes, err := blk.loadDeletes()
+	if err != nil {
+		return
+	}
+	blk.mvcc.Lock()
+	if blk.meta.IsAppendable() {
+		blk.node.rows = rows
+		blk.mvcc.OnReplayAppendNode(ts, rows)
+	} else {
+		blk.mvcc.SetMaxVisible(ts)
+	}
+	if deletes != nil {
+		blk.mvcc.OnReplayDeletes(ts, deletes)
+	}
+	for i := range blk.meta.GetSchema().ColDefs {
+		var mask *roaring.Bitmap
+		var vals map[uint32]interface{}
+		if mask, vals, err = blk.loadUpdates(i); err != nil {
+			break
+		}
+		if mask == nil {
+			continue
+		}
+		if err = blk.mvcc.OnReplayUpdates(uint16(i), ts, mask, vals); err != nil {
+			break
+		}
+	}
+	blk.mvcc.Unlock()
+	if err != nil {
+		return
+	}
+	// The changes in the file are durable, they need no wal
+	blk.SetMaxCheckpointTS(ts)
+	if rows == 0 {
+		return
+	}
+	return blk.ReplayData()
+}
+
+// loadDeletes returns nil if no delete was flushed to the block file
+func (blk *dataBlock) loadDeletes() (deletes *roaring.Bitmap, err error) {
+	f := blk.file.OpenDeletesFile()
+	defer f.Unref()
+	buf, err := readAll(f)
+	if err != nil || len(buf) == 0 {
+		return
+	}
+	deletes = roaring.New()
+	err = deletes.UnmarshalBinary(buf)
+	return
+}
+
+// loadUpdates returns a nil mask if no update of the column was flushed to
+// the block file
+func (blk *dataBlock) loadUpdates(colIdx int) (mask *roaring.Bitmap, vals map[uint32]interface{}, err error) {
+	colBlk, err := blk.file.OpenColumn(colIdx)
+	if err != nil {
+		return
+	}
+	defer colBlk.Close()
+	f, err := colBlk.OpenUpdateFile()
+	if err != nil {
+		return
+	}
+	defer f.Unref()
+	buf, err := readAll(f)
+	if err != nil || len(buf) == 0 {
+		return
+	}
+	// The mask of the updated rows is followed by the vector of the values
+	r := bytes.NewReader(buf)
+	mask = roaring.New()
+	if _, err = mask.ReadFrom(r); err != nil {
+		return
+	}
+	col := gvec.New(blk.meta.GetSchema().ColDefs[colIdx].Type)
+	if err = col.Read(buf[len(buf)-r.Len():]); err != nil {
+		return
+	}
+	vals = make(map[uint32]interface{})
+	it := mask.Iterator()
+	for i := 0; it.HasNext(); i++ {
+		vals[it.Next()] = compute.GetValue(col, uint32(i))
+	}
+	return
+}
+
+// encodeUpdates encodes the updates of a column in the format of the update
+// file of the column block
+func encodeUpdates(typ types.Type, mask *roaring.Bitmap, vals map[uint32]interface{}) (buf []byte, err error) {
+	var w bytes.Buffer
+	if _, err = mask.WriteTo(&w); err != nil {
+		return
+	}
+	col := gvec.New(typ)
+	it := mask.Iterator()
+	for it.HasNext() {
+		compute.AppendValue(col, vals[it.Next()])
+	}
+	var colBuf []byte
+	if colBuf, err = col.Show(); err != nil {
+		return
+	}
+	w.Write(colBuf)
+	buf = w.Bytes()
+	return
+}
+
+func readAll(f common.IRWFile) (buf []byte, err error) {
+	size := f.Stat().Size()
+	if size == 0 {
+		return
+	}
+	buf = make([]byte, size)
+	_, err = f.Read(buf)
+	return
+}
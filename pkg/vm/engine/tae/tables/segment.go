@@ -33,8 +33,8 @@ type dataSegment struct {
 	scheduler tasks.TaskScheduler
 }
 
-func newSegment(meta *catalog.SegmentEntry, factory file.SegmentFileFactory, bufMgr base.INodeManager) *dataSegment {
-	segFile := factory("xxx", meta.GetID())
+func newSegment(meta *catalog.SegmentEntry, factory file.SegmentFileFactory, bufMgr base.INodeManager, dir string) *dataSegment {
+	segFile := factory(dir, meta.GetID())
 	seg := &dataSegment{
 		meta:      meta,
 		file:      segFile,
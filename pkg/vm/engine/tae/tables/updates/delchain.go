@@ -243,3 +243,25 @@ func (chain *DeleteChain) CollectDeletesLocked(ts uint64, collectIndex bool) txn
 	}, false)
 	return merged
 }
+
+// CollectDeleteTSLocked returns the commit ts of each row deleted by the txns
+// committed at or before ts
+func (chain *DeleteChain) CollectDeleteTSLocked(ts uint64) map[uint32]uint64 {
+	commits := make(map[uint32]uint64)
+	chain.LoopChainLocked(func(n *DeleteNode) bool {
+		n.RLock()
+		defer n.RUnlock()
+		if n.txn != nil || n.GetCommitTSLocked() > ts {
+			return true
+		}
+		it := n.mask.Iterator()
+		for it.HasNext() {
+			row := it.Next()
+			if prev, ok := commits[row]; !ok || prev > n.GetCommitTSLocked() {
+				commits[row] = n.GetCommitTSLocked()
+			}
+		}
+		return true
+	}, false)
+	return commits
+}
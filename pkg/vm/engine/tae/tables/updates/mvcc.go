@@ -19,6 +19,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -202,6 +203,44 @@ func (n *MVCCHandle) AddAppendNodeLocked(txn txnif.AsyncTxn, maxRow uint32) *App
 	return an
 }
 
+// OnReplayAppendNode adds the append node of the rows replayed from the
+// block file, the rows were committed at ts
+func (n *MVCCHandle) OnReplayAppendNode(ts uint64, maxRow uint32) {
+	n.appends = append(n.appends, MockAppendNode(ts, maxRow, n))
+	n.SetMaxVisible(ts)
+}
+
+// OnReplayDeletes adds the deletes replayed from the block file as a merged
+// node committed at ts
+func (n *MVCCHandle) OnReplayDeletes(ts uint64, mask *roaring.Bitmap) {
+	node := NewMergedNode(ts)
+	node.mask = mask
+	node.AttachTo(n.deletes)
+	n.deletes.AddDeleteCnt(uint32(mask.GetCardinality()))
+	n.IncChangeNodeCnt()
+	n.SetMaxVisible(ts)
+}
+
+// OnReplayUpdates adds the updates of the column replayed from the block
+// file as a node committed at ts
+func (n *MVCCHandle) OnReplayUpdates(colIdx uint16, ts uint64, mask *roaring.Bitmap, vals map[uint32]interface{}) (err error) {
+	chain := n.columns[colIdx]
+	chain.Lock()
+	defer chain.Unlock()
+	node := NewCommittedColumnNode(ts, ts, chain.id, nil)
+	node.AttachTo(chain)
+	it := mask.Iterator()
+	for it.HasNext() {
+		row := it.Next()
+		if err = chain.TryUpdateNodeLocked(row, vals[row], node); err != nil {
+			return
+		}
+	}
+	n.IncChangeNodeCnt()
+	n.SetMaxVisible(ts)
+	return
+}
+
 func (n *MVCCHandle) IsVisibleLocked(row uint32, ts uint64) bool {
 	maxRow, ok := n.GetMaxVisibleRowLocked(ts)
 	if !ok {
@@ -214,6 +253,27 @@ func (n *MVCCHandle) IsDeletedLocked(row uint32, ts uint64) bool {
 	return n.deletes.IsDeleted(row, ts)
 }
 
+// CollectAppendsLocked returns the commit ts of each appended row, the rows
+// appended by the txns not committed at or before ts have a zero commit ts
+func (n *MVCCHandle) CollectAppendsLocked(ts uint64) (commits []uint64) {
+	start := uint32(0)
+	for _, node := range n.appends {
+		node.RLock()
+		txn, commitTs, maxRow := node.txn, node.commitTs, node.maxRow
+		node.RUnlock()
+		if maxRow > uint32(len(commits)) {
+			commits = append(commits, make([]uint64, int(maxRow)-len(commits))...)
+		}
+		if txn == nil && commitTs <= ts {
+			for i := start; i < maxRow; i++ {
+				commits[i] = commitTs
+			}
+		}
+		start = maxRow
+	}
+	return
+}
+
 func (n *MVCCHandle) CollectAppendLogIndexesLocked(startTs, endTs uint64) (indexes []*wal.Index) {
 	if len(n.appends) == 0 {
 		return
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
@@ -208,3 +209,42 @@ func (chain *ColumnChain) CollectCommittedInRangeLocked(startTs, endTs uint64) (
 	}
 	return
 }
+
+// CollectUpdateTSLocked returns the commit ts of the updates of each row
+// committed at or before ts
+func (chain *ColumnChain) CollectUpdateTSLocked(ts uint64) map[uint32][]uint64 {
+	commits := make(map[uint32][]uint64)
+	chain.LoopChainLocked(func(n *ColumnNode) bool {
+		n.RLock()
+		defer n.RUnlock()
+		if n.txn != nil || n.GetCommitTSLocked() > ts {
+			return true
+		}
+		for row := range n.txnVals {
+			commits[row] = append(commits[row], n.GetCommitTSLocked())
+		}
+		return true
+	}, false)
+	return commits
+}
+
+// GetCommittedValueLocked returns the value of the row written by the last
+// update committed at or before ts
+func (chain *ColumnChain) GetCommittedValueLocked(row uint32, ts uint64) (v interface{}, err error) {
+	var commitTs uint64
+	chain.LoopChainLocked(func(n *ColumnNode) bool {
+		n.RLock()
+		defer n.RUnlock()
+		if n.txn != nil || n.GetCommitTSLocked() > ts || n.GetCommitTSLocked() < commitTs {
+			return true
+		}
+		if val, ok := n.txnVals[row]; ok {
+			v, commitTs = val, n.GetCommitTSLocked()
+		}
+		return true
+	}, false)
+	if v == nil {
+		err = txnbase.ErrNotFound
+	}
+	return
+}
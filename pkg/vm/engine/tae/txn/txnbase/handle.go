@@ -69,6 +69,9 @@ func (rel *TxnRelation) MakeBlockIt() handle.BlockIt
 func (rel *TxnRelation) MakeReader() handle.Reader                                            { return nil }
 func (rel *TxnRelation) BatchDedup(col *vector.Vector) error                                  { return nil }
 func (rel *TxnRelation) Append(data *batch.Batch) error                                       { return nil }
+func (rel *TxnRelation) GetLocalBatches() ([]*batch.Batch, error)                             { return nil, nil }
+func (rel *TxnRelation) GetStats() (stats []byte, err error)                                  { return }
+func (rel *TxnRelation) UpdateStats([]byte) (err error)                                       { return }
 func (rel *TxnRelation) GetMeta() interface{}                                                 { return nil }
 func (rel *TxnRelation) GetSegment(id uint64) (seg handle.Segment, err error)                 { return }
 func (rel *TxnRelation) SoftDeleteSegment(id uint64) (err error)                              { return }
@@ -127,6 +130,8 @@ func (blk *TxnBlock) GetColumnDataByName(attr string, compressed, decompressed *
 func (blk *TxnBlock) GetSegment() (seg handle.Segment) { return }
 
 func (blk *TxnBlock) BatchDedup(*vector.Vector) (err error)                       { return }
+func (blk *TxnBlock) MayContainsByFilter(*handle.Filter) bool                     { return true }
+func (blk *TxnBlock) MayContainsColumnRange(int, interface{}, interface{}) bool   { return true }
 func (blk *TxnBlock) Append(*batch.Batch, uint32) (n uint32, err error)           { return }
 func (blk *TxnBlock) Update(uint32, uint16, interface{}) (err error)              { return }
 func (blk *TxnBlock) RangeDelete(uint32, uint32) (err error)                      { return }
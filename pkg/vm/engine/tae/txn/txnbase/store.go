@@ -30,6 +30,9 @@ func (store *NoopTxnStore) BindTxn(txn txnif.AsyncTxn)
 func (store *NoopTxnStore) Close() error                                            { return nil }
 func (store *NoopTxnStore) RangeDeleteLocalRows(id uint64, start, end uint32) error { return nil }
 func (store *NoopTxnStore) Append(id uint64, data *batch.Batch) error               { return nil }
+func (store *NoopTxnStore) GetLocalBatches(id uint64) ([]*batch.Batch, error)       { return nil, nil }
+func (store *NoopTxnStore) GetStats(id uint64) ([]byte, error)                      { return nil, nil }
+func (store *NoopTxnStore) UpdateStats(id uint64, stats []byte) error               { return nil }
 func (store *NoopTxnStore) UpdateLocalValue(id uint64, row uint32, col uint16, v interface{}) error {
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
@@ -130,6 +131,14 @@ func (blk *txnBlock) BatchDedup(pks *gvec.Vector) (err error) {
 	return blkData.BatchDedup(blk.Txn, pks)
 }
 
+func (blk *txnBlock) MayContainsByFilter(filter *handle.Filter) bool {
+	return blk.entry.GetBlockData().MayContainsByFilter(filter)
+}
+
+func (blk *txnBlock) MayContainsColumnRange(col int, min, max interface{}) bool {
+	return blk.entry.GetBlockData().MayContainsColumnRange(col, min, max)
+}
+
 func (blk *txnBlock) RangeDelete(start, end uint32) (err error) {
 	return blk.Txn.GetStore().RangeDelete(blk.entry.AsCommonID(), start, end)
 }
@@ -148,6 +157,10 @@ func (blk *txnBlock) Rows() int { return blk.entry.GetBlockData().Rows(blk.Txn,
 func (blk *txnBlock) GetColumnDataById(colIdx int, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error) {
 	return blk.entry.GetBlockData().GetColumnDataById(blk.Txn, colIdx, compressed, decompressed)
 }
+func (blk *txnBlock) GetFullTextIndex(cols []int) (*fulltext.Index, error) {
+	return blk.entry.GetBlockData().GetFullTextIndex(blk.Txn, cols)
+}
+
 func (blk *txnBlock) GetColumnDataByName(attr string, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error) {
 	return blk.entry.GetBlockData().GetColumnDataByName(blk.Txn, attr, compressed, decompressed)
 }
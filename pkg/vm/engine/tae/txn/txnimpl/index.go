@@ -100,9 +100,16 @@ func (idx *simpleTableIndex) Delete(vv interface{}) error {
 	return nil
 }
 
-func (idx *simpleTableIndex) Find(v interface{}) (uint32, error) {
+func (idx *simpleTableIndex) Find(vv interface{}) (uint32, error) {
 	idx.RLock()
 	defer idx.RUnlock()
+	var v interface{}
+	switch vv.(type) {
+	case []uint8:
+		v = string(vv.([]uint8))
+	default:
+		v = vv
+	}
 	row, ok := idx.tree[v]
 	if !ok {
 		return 0, txnbase.ErrNotFound
@@ -116,11 +116,50 @@ func (h *txnRelation) SimplePPString(level common.PPLevel) string {
 func (h *txnRelation) GetMeta() interface{}   { return h.entry }
 func (h *txnRelation) GetSchema() interface{} { return h.entry.GetSchema() }
 
-func (h *txnRelation) Close() error                     { return nil }
-func (h *txnRelation) Rows() int64                      { return 0 }
-func (h *txnRelation) Size(attr string) int64           { return 0 }
-func (h *txnRelation) GetCardinality(attr string) int64 { return 0 }
-func (h *txnRelation) MakeReader() handle.Reader        { return nil }
+func (h *txnRelation) Close() error              { return nil }
+func (h *txnRelation) MakeReader() handle.Reader { return nil }
+
+// Rows returns the coarse number of the rows in the blocks visible to the txn
+func (h *txnRelation) Rows() int64 {
+	rows := int64(0)
+	it := h.MakeBlockIt()
+	for it.Valid() {
+		rows += int64(it.GetBlock().Rows())
+		it.Next()
+	}
+	return rows
+}
+
+// Size returns the estimated size of the column in the blocks visible to the txn
+func (h *txnRelation) Size(attr string) int64 {
+	colIdx, ok := h.entry.GetSchema().NameIndex[attr]
+	if !ok {
+		return 0
+	}
+	size := int64(0)
+	it := h.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		meta := blk.GetMeta().(*catalog.BlockEntry)
+		size += int64(catalog.EstimateColumnBlockSize(colIdx, uint32(blk.Rows()), meta))
+		it.Next()
+	}
+	return size
+}
+
+// GetCardinality returns the number of the distinct values of the column.
+// Only that of the primary key is known, it is 0 for the other columns and
+// the columns of the composite primary key.
+func (h *txnRelation) GetCardinality(attr string) int64 {
+	schema := h.entry.GetSchema()
+	if schema.IsCompositeKey() || schema.ImplicitKey {
+		return 0
+	}
+	if colIdx, ok := schema.NameIndex[attr]; !ok || colIdx != int(schema.PrimaryKey) {
+		return 0
+	}
+	return h.Rows()
+}
 
 func (h *txnRelation) BatchDedup(col *vector.Vector) error {
 	return h.Txn.GetStore().BatchDedup(h.entry.GetID(), col)
@@ -130,6 +169,18 @@ func (h *txnRelation) Append(data *batch.Batch) error {
 	return h.Txn.GetStore().Append(h.entry.GetID(), data)
 }
 
+func (h *txnRelation) GetLocalBatches() ([]*batch.Batch, error) {
+	return h.Txn.GetStore().GetLocalBatches(h.entry.GetID())
+}
+
+func (h *txnRelation) GetStats() ([]byte, error) {
+	return h.Txn.GetStore().GetStats(h.entry.GetID())
+}
+
+func (h *txnRelation) UpdateStats(stats []byte) error {
+	return h.Txn.GetStore().UpdateStats(h.entry.GetID(), stats)
+}
+
 func (h *txnRelation) GetSegment(id uint64) (seg handle.Segment, err error) {
 	fp := h.entry.AsCommonID()
 	fp.SegmentID = id
@@ -143,6 +143,38 @@ func (store *txnStore) Append(id uint64, data *batch.Batch) error {
 	return table.Append(data)
 }
 
+// GetStats returns the statistics of the table seen by the txn
+func (store *txnStore) GetStats(id uint64) ([]byte, error) {
+	table, err := store.getOrSetTable(id)
+	if err != nil {
+		return nil, err
+	}
+	return table.GetStats(), nil
+}
+
+// UpdateStats replaces the statistics of the table when the txn commits
+func (store *txnStore) UpdateStats(id uint64, stats []byte) error {
+	store.IncreateWriteCnt()
+	table, err := store.getOrSetTable(id)
+	if err != nil {
+		return err
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	table.UpdateStats(stats)
+	return nil
+}
+
+// GetLocalBatches returns the rows appended to the table by the txn
+func (store *txnStore) GetLocalBatches(id uint64) ([]*batch.Batch, error) {
+	table := store.tables[id]
+	if table == nil || table.IsDeleted() {
+		return nil, nil
+	}
+	return table.GetLocalBatches()
+}
+
 func (store *txnStore) RangeDelete(id *common.ID, start, end uint32) (err error) {
 	store.IncreateWriteCnt()
 	table, err := store.getOrSetTable(id.TableID)
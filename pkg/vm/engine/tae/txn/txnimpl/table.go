@@ -46,6 +46,9 @@ type Table interface {
 	GetID() uint64
 	RangeDeleteLocalRows(start, end uint32) error
 	Append(data *batch.Batch) error
+	GetLocalBatches() ([]*gbat.Batch, error)
+	GetStats() []byte
+	UpdateStats(stats []byte)
 	LocalDeletesToString() string
 	IsLocalDeleted(row uint32) bool
 	GetLocalPhysicalAxis(row uint32) (int, uint32)
@@ -110,6 +113,7 @@ type txnTable struct {
 
 	txnEntries []txnif.TxnEntry
 	csnStart   uint32
+	stats      *catalog.StatsEntry
 }
 
 func newTxnTable(store *txnStore, handle handle.Relation) *txnTable {
@@ -403,7 +407,13 @@ func (tbl *txnTable) AddUpdateNode(node txnif.UpdateNode) error {
 }
 
 func (tbl *txnTable) Append(data *batch.Batch) error {
-	err := tbl.BatchDedup(data.Vecs[tbl.entry.GetSchema().PrimaryKey])
+	schema := tbl.entry.GetSchema()
+	if err := schema.CheckNulls(data.Vecs); err != nil {
+		return err
+	}
+	pks := schema.GetPKVector(data.Vecs)
+	// the null keys of an implicit key are not unique
+	err := tbl.BatchDedup(compute.NonNullValues(pks))
 	if err != nil {
 		return err
 	}
@@ -432,7 +442,7 @@ func (tbl *txnTable) Append(data *batch.Batch) error {
 		space := n.GetSpace()
 		logutil.Debugf("Appended: %d, Space:%d", appended, space)
 		start := tbl.rows
-		if err = tbl.index.BatchInsert(data.Vecs[tbl.GetSchema().PrimaryKey], int(offset), int(appended), start, false); err != nil {
+		if err = tbl.indexKeys(pks, int(offset), int(appended), start); err != nil {
 			break
 		}
 		offset += appended
@@ -461,8 +471,7 @@ func (tbl *txnTable) RangeDeleteLocalRows(start, end uint32) error {
 		err = node.RangeDelete(firstOffset, lastOffset)
 		if err == nil {
 			for i := firstOffset; i <= lastOffset; i++ {
-				v, _ := node.GetValue(int(tbl.entry.GetSchema().PrimaryKey), i)
-				if err = tbl.index.Delete(v); err != nil {
+				if err = tbl.index.Delete(tbl.getLocalKey(node, i)); err != nil {
 					break
 				}
 			}
@@ -473,8 +482,7 @@ func (tbl *txnTable) RangeDeleteLocalRows(start, end uint32) error {
 		node = tbl.inodes[last]
 		err = node.RangeDelete(0, lastOffset)
 		for i := uint32(0); i <= lastOffset; i++ {
-			v, _ := node.GetValue(int(tbl.entry.GetSchema().PrimaryKey), i)
-			if err = tbl.index.Delete(v); err != nil {
+			if err = tbl.index.Delete(tbl.getLocalKey(node, i)); err != nil {
 				break
 			}
 		}
@@ -485,8 +493,7 @@ func (tbl *txnTable) RangeDeleteLocalRows(start, end uint32) error {
 					break
 				}
 				for i := uint32(0); i <= txnbase.MaxNodeRows; i++ {
-					v, _ := node.GetValue(int(tbl.entry.GetSchema().PrimaryKey), i)
-					if err = tbl.index.Delete(v); err != nil {
+					if err = tbl.index.Delete(tbl.getLocalKey(node, i)); err != nil {
 						break
 					}
 				}
@@ -496,6 +503,14 @@ func (tbl *txnTable) RangeDeleteLocalRows(start, end uint32) error {
 	return err
 }
 
+// getLocalKey returns the primary key of the row of the local insert node
+func (tbl *txnTable) getLocalKey(n InsertNode, row uint32) interface{} {
+	v, _ := tbl.entry.GetSchema().GetPKValue(func(col int) (interface{}, error) {
+		return n.GetValue(col, row)
+	})
+	return v
+}
+
 func (tbl *txnTable) LocalDeletesToString() string {
 	s := fmt.Sprintf("<txnTable-%d>[LocalDeletes]:\n", tbl.GetID())
 	for i, n := range tbl.inodes {
@@ -663,8 +678,7 @@ func (tbl *txnTable) UpdateLocalValue(row uint32, col uint16, value interface{})
 	if err = n.RangeDelete(uint32(noffset), uint32(noffset)); err != nil {
 		return err
 	}
-	v, _ := n.GetValue(int(tbl.entry.GetSchema().PrimaryKey), row)
-	if err = tbl.index.Delete(v); err != nil {
+	if err = tbl.index.Delete(tbl.getLocalKey(n, noffset)); err != nil {
 		panic(err)
 	}
 	err = tbl.Append(window)
@@ -684,7 +698,7 @@ func (tbl *txnTable) PreCommitDededup() (err error) {
 		return
 	}
 	schema := tbl.entry.GetSchema()
-	pks := tbl.index.KeyToVector(schema.ColDefs[schema.PrimaryKey].Type)
+	pks := tbl.index.KeyToVector(schema.GetPKType())
 	segIt := tbl.entry.MakeSegmentIt(false)
 	for segIt.Valid() {
 		seg := segIt.Get().GetPayload().(*catalog.SegmentEntry)
@@ -770,8 +784,32 @@ func (tbl *txnTable) BatchDedup(pks *gvec.Vector) (err error) {
 	return
 }
 
+// indexKeys inserts the keys of the rows [offset, offset+count) appended as
+// the rows from row into the local index, the null keys are not indexed.
+func (tbl *txnTable) indexKeys(pks *gvec.Vector, offset, count int, row uint32) error {
+	nullRows := compute.NullRows(pks)
+	if nullRows == nil {
+		return tbl.index.BatchInsert(pks, offset, count, row, false)
+	}
+	for i := offset; i < offset+count; {
+		if nullRows.Contains(uint32(i)) {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < offset+count && !nullRows.Contains(uint32(j)) {
+			j++
+		}
+		if err := tbl.index.BatchInsert(pks, i, j-i, row+uint32(i-offset), false); err != nil {
+			return err
+		}
+		i = j
+	}
+	return nil
+}
+
 func (tbl *txnTable) BatchDedupLocal(bat *gbat.Batch) error {
-	return tbl.BatchDedupLocalByCol(bat.Vecs[tbl.GetSchema().PrimaryKey])
+	return tbl.BatchDedupLocalByCol(tbl.GetSchema().GetPKVector(bat.Vecs))
 }
 
 func (tbl *txnTable) BatchDedupLocalByCol(col *gvec.Vector) error {
@@ -791,6 +829,41 @@ func (tbl *txnTable) GetLocalValue(row uint32, col uint16) (interface{}, error)
 	return n.GetValue(int(col), noffset)
 }
 
+// GetStats returns the statistics of the table updated by the txn, or the
+// committed ones if the txn did not update them.
+func (tbl *txnTable) GetStats() []byte {
+	if tbl.stats != nil {
+		return tbl.stats.GetStats()
+	}
+	return tbl.entry.GetStats()
+}
+
+// UpdateStats replaces the statistics of the table when the txn commits.
+func (tbl *txnTable) UpdateStats(stats []byte) {
+	if tbl.stats != nil {
+		tbl.stats.SetStats(stats)
+		return
+	}
+	tbl.stats = tbl.entry.UpdateStats(tbl.store.txn, stats)
+	tbl.txnEntries = append(tbl.txnEntries, tbl.stats)
+}
+
+func (tbl *txnTable) GetLocalBatches() (bats []*gbat.Batch, err error) {
+	for _, n := range tbl.inodes {
+		if n.Rows() == 0 {
+			continue
+		}
+		h := tbl.store.nodesMgr.Pin(n)
+		bat, err := n.Window(0, n.Rows()-1)
+		h.Close()
+		if err != nil {
+			return nil, err
+		}
+		bats = append(bats, bat)
+	}
+	return
+}
+
 func (tbl *txnTable) PrepareRollback() (err error) {
 	for _, txnEntry := range tbl.txnEntries {
 		if err = txnEntry.PrepareRollback(); err != nil {
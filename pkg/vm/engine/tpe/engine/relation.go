@@ -53,6 +53,10 @@ func (trel *TpeRelation) Size(s string) int64 {
 	return size
 }
 
+func (trel *TpeRelation) Stats(_ string) *engine.ColumnStatistics {
+	return nil
+}
+
 func (trel *TpeRelation) Cardinality(_ string) int64 {
 	return 1
 }
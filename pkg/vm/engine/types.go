@@ -15,15 +15,25 @@
 package engine
 
 import (
+	"encoding/gob"
+	"errors"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
 )
 
+func init() {
+	// the values of the statistics which are not of the builtin types
+	gob.Register(types.Date(0))
+	gob.Register(types.Datetime(0))
+	gob.Register(types.Timestamp(0))
+}
+
 type Nodes []Node
 
 type Node struct {
@@ -42,6 +52,8 @@ type Attribute struct {
 	Type    types.Type  // type of attribute
 	Default DefaultExpr // default value of this attribute.
 	Primary bool        // if true, it is primary key
+	// Nullability is true if the attribute is nullable
+	Nullability bool
 }
 
 type DefaultExpr struct {
@@ -72,6 +84,48 @@ type NodeInfo struct {
 type Statistics interface {
 	Rows() int64
 	Size(string) int64
+	// Stats returns the statistics of the column collected by analyze,
+	// it returns nil if the column has not been analyzed.
+	Stats(string) *ColumnStatistics
+}
+
+// ColumnStatistics is the statistics of a column, the values are of the
+// go type of the column, such as int64 for bigint and []byte for varchar,
+// they are nil for the decimal columns.
+type ColumnStatistics struct {
+	Rows      int64 // number of rows of the relation when the column is analyzed
+	NDV       int64 // number of distinct values
+	NullCount int64
+	Min       interface{}
+	Max       interface{}
+	// Histogram is the equi-depth histogram of the not null values, the
+	// buckets are ordered by their upper bounds.
+	Histogram []Bucket
+}
+
+// Bucket is a bucket of an equi-depth histogram, which contains the values
+// greater than the upper bound of the previous bucket and not greater than
+// its upper bound.
+type Bucket struct {
+	Upper interface{}
+	Count int64 // number of values in the bucket
+	NDV   int64 // number of distinct values in the bucket
+}
+
+// StatisticsWriter is implemented by the relations which persist the
+// statistics of their columns collected by analyze.
+type StatisticsWriter interface {
+	// WriteStats replaces the statistics of the columns.
+	WriteStats(uint64, map[string]*ColumnStatistics) error
+}
+
+// FullTextSearcher is implemented by the relations which keep full-text
+// indexes, MATCH ... AGAINST is resolved against the indexes of the whole
+// relation rather than the rows of a batch.
+type FullTextSearcher interface {
+	// FullTextSearch resolves the query against the full-text index on
+	// the attributes.
+	FullTextSearch([]string, *fulltext.Query) (*fulltext.Search, error)
 }
 
 type ListPartition struct {
@@ -107,6 +161,8 @@ func (node IndexT) ToString() string {
 		return "ZONEMAP"
 	case BsiIndex:
 		return "BSI"
+	case FullTextIndex:
+		return "FULLTEXT"
 	default:
 		return "INVAILD"
 	}
@@ -116,6 +172,7 @@ const (
 	Invalid IndexT = iota
 	ZoneMap
 	BsiIndex
+	FullTextIndex
 )
 
 type AttributeDef struct {
@@ -208,6 +265,34 @@ type Engine interface {
 	Node(string) *NodeInfo
 }
 
+// ErrTxnConflict is returned when the transaction conflicts with another one,
+// the transaction is rolled back and it can be retried.
+var ErrTxnConflict = errors.New("engine: transaction conflict")
+
+// TxnEngine is implemented by the engines which support transactions, the
+// operations of the Engine itself are committed one by one.
+type TxnEngine interface {
+	Engine
+	// StartTxn starts a transaction, the changes made through the returned
+	// Txn are visible only to it until it is committed.
+	StartTxn() (Txn, error)
+}
+
+// Backuper is implemented by the engines which take an online backup of
+// their data while they are running.
+type Backuper interface {
+	// Backup copies the data into the target, which is a directory or a
+	// gzipped tarball of the host.
+	Backup(string) error
+}
+
+// Txn is an Engine whose operations are in a transaction.
+type Txn interface {
+	Engine
+	Commit() error
+	Rollback() error
+}
+
 // MakeDefaultExpr returns a new DefaultExpr
 func MakeDefaultExpr(exist bool, value interface{}, isNull bool) DefaultExpr {
 	return DefaultExpr{
@@ -21,12 +21,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dedup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deleteTag"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/jsontable"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergededup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
@@ -34,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/oplus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
@@ -66,6 +69,10 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	MergeOffset: mergeoffset.String,
 	MergeOrder:  mergeorder.String,
 	MergeTop:    mergetop.String,
+	MergeSet:    mergeset.String,
+
+	Window:    window.String,
+	JSONTable: jsontable.String,
 
 	DeleteTag: deleteTag.String,
 	UpdateTag: updateTag.String,
@@ -94,6 +101,10 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	MergeOffset: mergeoffset.Prepare,
 	MergeOrder:  mergeorder.Prepare,
 	MergeTop:    mergetop.Prepare,
+	MergeSet:    mergeset.Prepare,
+
+	Window:    window.Prepare,
+	JSONTable: jsontable.Prepare,
 
 	DeleteTag: deleteTag.Prepare,
 	UpdateTag: updateTag.Prepare,
@@ -122,6 +133,10 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	MergeOffset: mergeoffset.Call,
 	MergeOrder:  mergeorder.Call,
 	MergeTop:    mergetop.Call,
+	MergeSet:    mergeset.Call,
+
+	Window:    window.Call,
+	JSONTable: jsontable.Call,
 
 	DeleteTag: deleteTag.Call,
 	UpdateTag: updateTag.Call,
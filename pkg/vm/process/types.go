@@ -52,6 +52,8 @@ type Limitation struct {
 	BatchSize int64
 	// PartitionRows, max rows for partition.
 	PartitionRows int64
+	// MaxRecursionDepth, max number of iterations of a recursive cte.
+	MaxRecursionDepth int64
 }
 
 // Process contains context used in query execution
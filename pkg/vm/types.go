@@ -37,6 +37,10 @@ const (
 	MergeOffset
 	MergeOrder
 	MergeTop
+	MergeSet
+
+	Window
+	JSONTable
 
 	DeleteTag
 	UpdateTag
@@ -48,6 +52,33 @@ type Instruction struct {
 	Op int
 	// Arg contains the operand of this instruction.
 	Arg interface{}
+	// Analyze records the runtime statistics of this instruction,
+	// nil means the statistics are not recorded.
+	Analyze *Analyze
+}
+
+// Analyze contains the runtime statistics of an instruction, it is shared
+// by the copies of the instruction which run in parallel.
+type Analyze struct {
+	// InputRows is the number of rows of the batches received.
+	InputRows int64
+	// OutputRows is the number of rows of the batches produced.
+	OutputRows int64
+	// InputBatches is the number of the batches received.
+	InputBatches int64
+	// OutputBatches is the number of the batches produced.
+	OutputBatches int64
+	// WallTime is the elapsed time of the instruction in nanoseconds.
+	WallTime int64
+	// CPUTime is the cpu time of the instruction in nanoseconds,
+	// it is always zero on the platforms which can not measure it.
+	CPUTime int64
+	// PeakMemory is the peak size in bytes of the memory held by the
+	// instruction, which is the sum of the growth of the memory heap of
+	// the process across the calls of the instruction.
+	PeakMemory int64
+	// memory is the current size of the memory held by the instruction.
+	memory int64
 }
 
 type Instructions []Instruction
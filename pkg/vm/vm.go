@@ -41,10 +41,8 @@ func Prepare(ins Instructions, proc *process.Process) error {
 	return nil
 }
 
-func Run(ins Instructions, proc *process.Process) (bool, error) {
+func Run(ins Instructions, proc *process.Process) (end bool, err error) {
 	var ok bool
-	var end bool
-	var err error
 
 	defer func() {
 		if e := recover(); e != nil {
@@ -52,7 +50,12 @@ func Run(ins Instructions, proc *process.Process) (bool, error) {
 		}
 	}()
 	for _, in := range ins {
-		if ok, err = execFunc[in.Op](proc, in.Arg); err != nil {
+		if in.Analyze != nil {
+			ok, err = analyzeExec(in, proc)
+		} else {
+			ok, err = execFunc[in.Op](proc, in.Arg)
+		}
+		if err != nil {
 			return ok || end, err
 		}
 		if ok { // ok is true shows that at least one operator has done its work